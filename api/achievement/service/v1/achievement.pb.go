@@ -942,6 +942,61 @@ func (x *AddAchievementScoreReq) GetScore() int32 {
 	return 0
 }
 
+type AddAchievementDbAndCacheReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Achievement []*AddAchievementDbAndCacheReq_Achievement `protobuf:"bytes,1,rep,name=achievement,proto3" json:"achievement,omitempty"`
+	Active      []*AddAchievementDbAndCacheReq_Active      `protobuf:"bytes,2,rep,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *AddAchievementDbAndCacheReq) Reset() {
+	*x = AddAchievementDbAndCacheReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAchievementDbAndCacheReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAchievementDbAndCacheReq) ProtoMessage() {}
+
+func (x *AddAchievementDbAndCacheReq) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAchievementDbAndCacheReq.ProtoReflect.Descriptor instead.
+func (*AddAchievementDbAndCacheReq) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddAchievementDbAndCacheReq) GetAchievement() []*AddAchievementDbAndCacheReq_Achievement {
+	if x != nil {
+		return x.Achievement
+	}
+	return nil
+}
+
+func (x *AddAchievementDbAndCacheReq) GetActive() []*AddAchievementDbAndCacheReq_Active {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
 type GetUserMedalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -953,7 +1008,7 @@ type GetUserMedalReq struct {
 func (x *GetUserMedalReq) Reset() {
 	*x = GetUserMedalReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_achievement_service_v1_achievement_proto_msgTypes[17]
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -966,7 +1021,7 @@ func (x *GetUserMedalReq) String() string {
 func (*GetUserMedalReq) ProtoMessage() {}
 
 func (x *GetUserMedalReq) ProtoReflect() protoreflect.Message {
-	mi := &file_achievement_service_v1_achievement_proto_msgTypes[17]
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1034,7 @@ func (x *GetUserMedalReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserMedalReq.ProtoReflect.Descriptor instead.
 func (*GetUserMedalReq) Descriptor() ([]byte, []int) {
-	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{17}
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserMedalReq) GetUuid() string {
@@ -1021,7 +1076,7 @@ type GetUserMedalReply struct {
 func (x *GetUserMedalReply) Reset() {
 	*x = GetUserMedalReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_achievement_service_v1_achievement_proto_msgTypes[18]
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1034,7 +1089,7 @@ func (x *GetUserMedalReply) String() string {
 func (*GetUserMedalReply) ProtoMessage() {}
 
 func (x *GetUserMedalReply) ProtoReflect() protoreflect.Message {
-	mi := &file_achievement_service_v1_achievement_proto_msgTypes[18]
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1102,7 @@ func (x *GetUserMedalReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserMedalReply.ProtoReflect.Descriptor instead.
 func (*GetUserMedalReply) Descriptor() ([]byte, []int) {
-	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{18}
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetUserMedalReply) GetCreation1() int32 {
@@ -1145,90 +1200,586 @@ func (x *GetUserMedalReply) GetView1() int32 {
 	if x != nil {
 		return x.View1
 	}
-	return 0
+	return 0
+}
+
+func (x *GetUserMedalReply) GetView2() int32 {
+	if x != nil {
+		return x.View2
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetView3() int32 {
+	if x != nil {
+		return x.View3
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetComment1() int32 {
+	if x != nil {
+		return x.Comment1
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetComment2() int32 {
+	if x != nil {
+		return x.Comment2
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetComment3() int32 {
+	if x != nil {
+		return x.Comment3
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetCollect1() int32 {
+	if x != nil {
+		return x.Collect1
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetCollect2() int32 {
+	if x != nil {
+		return x.Collect2
+	}
+	return 0
+}
+
+func (x *GetUserMedalReply) GetCollect3() int32 {
+	if x != nil {
+		return x.Collect3
+	}
+	return 0
+}
+
+type GetUserActiveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetUserActiveReq) Reset() {
+	*x = GetUserActiveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserActiveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActiveReq) ProtoMessage() {}
+
+func (x *GetUserActiveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserActiveReq.ProtoReflect.Descriptor instead.
+func (*GetUserActiveReq) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserActiveReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetUserActiveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agree int32 `protobuf:"varint,1,opt,name=agree,proto3" json:"agree,omitempty"`
+	View  int32 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *GetUserActiveReply) Reset() {
+	*x = GetUserActiveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserActiveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActiveReply) ProtoMessage() {}
+
+func (x *GetUserActiveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserActiveReply.ProtoReflect.Descriptor instead.
+func (*GetUserActiveReply) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserActiveReply) GetAgree() int32 {
+	if x != nil {
+		return x.Agree
+	}
+	return 0
+}
+
+func (x *GetUserActiveReply) GetView() int32 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+type PurgeUserDataReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *PurgeUserDataReq) Reset() {
+	*x = PurgeUserDataReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeUserDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserDataReq) ProtoMessage() {}
+
+func (x *PurgeUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserDataReq.ProtoReflect.Descriptor instead.
+func (*PurgeUserDataReq) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PurgeUserDataReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetUserDataReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetUserDataReq) Reset() {
+	*x = GetUserDataReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDataReq) ProtoMessage() {}
+
+func (x *GetUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDataReq.ProtoReflect.Descriptor instead.
+func (*GetUserDataReq) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserDataReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetUserDataReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*GetUserDataReply_File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *GetUserDataReply) Reset() {
+	*x = GetUserDataReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDataReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDataReply) ProtoMessage() {}
+
+func (x *GetUserDataReply) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDataReply.ProtoReflect.Descriptor instead.
+func (*GetUserDataReply) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserDataReply) GetFiles() []*GetUserDataReply_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GetScoreRankReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size int32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *GetScoreRankReq) Reset() {
+	*x = GetScoreRankReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScoreRankReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreRankReq) ProtoMessage() {}
+
+func (x *GetScoreRankReq) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreRankReq.ProtoReflect.Descriptor instead.
+func (*GetScoreRankReq) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetScoreRankReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetScoreRankReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Achievement []*GetScoreRankReply_Achievement `protobuf:"bytes,1,rep,name=achievement,proto3" json:"achievement,omitempty"`
+}
+
+func (x *GetScoreRankReply) Reset() {
+	*x = GetScoreRankReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScoreRankReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreRankReply) ProtoMessage() {}
+
+func (x *GetScoreRankReply) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreRankReply.ProtoReflect.Descriptor instead.
+func (*GetScoreRankReply) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetScoreRankReply) GetAchievement() []*GetScoreRankReply_Achievement {
+	if x != nil {
+		return x.Achievement
+	}
+	return nil
+}
+
+type GetAchievementListReply_Achievement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid     string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	View     int32  `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	Agree    int32  `protobuf:"varint,3,opt,name=agree,proto3" json:"agree,omitempty"`
+	Followed int32  `protobuf:"varint,4,opt,name=followed,proto3" json:"followed,omitempty"`
+	Follow   int32  `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *GetAchievementListReply_Achievement) Reset() {
+	*x = GetAchievementListReply_Achievement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAchievementListReply_Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAchievementListReply_Achievement) ProtoMessage() {}
+
+func (x *GetAchievementListReply_Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAchievementListReply_Achievement.ProtoReflect.Descriptor instead.
+func (*GetAchievementListReply_Achievement) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetAchievementListReply_Achievement) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetAchievementListReply_Achievement) GetView() int32 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *GetAchievementListReply_Achievement) GetAgree() int32 {
+	if x != nil {
+		return x.Agree
+	}
+	return 0
+}
+
+func (x *GetAchievementListReply_Achievement) GetFollowed() int32 {
+	if x != nil {
+		return x.Followed
+	}
+	return 0
+}
+
+func (x *GetAchievementListReply_Achievement) GetFollow() int32 {
+	if x != nil {
+		return x.Follow
+	}
+	return 0
+}
+
+type AddAchievementDbAndCacheReq_Achievement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Agree   int32  `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
+	View    int32  `protobuf:"varint,3,opt,name=view,proto3" json:"view,omitempty"`
+	Collect int32  `protobuf:"varint,4,opt,name=collect,proto3" json:"collect,omitempty"`
+	Score   int32  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *AddAchievementDbAndCacheReq_Achievement) Reset() {
+	*x = AddAchievementDbAndCacheReq_Achievement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *GetUserMedalReply) GetView2() int32 {
-	if x != nil {
-		return x.View2
-	}
-	return 0
+func (x *AddAchievementDbAndCacheReq_Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetUserMedalReply) GetView3() int32 {
-	if x != nil {
-		return x.View3
+func (*AddAchievementDbAndCacheReq_Achievement) ProtoMessage() {}
+
+func (x *AddAchievementDbAndCacheReq_Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserMedalReply) GetComment1() int32 {
-	if x != nil {
-		return x.Comment1
-	}
-	return 0
+// Deprecated: Use AddAchievementDbAndCacheReq_Achievement.ProtoReflect.Descriptor instead.
+func (*AddAchievementDbAndCacheReq_Achievement) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *GetUserMedalReply) GetComment2() int32 {
+func (x *AddAchievementDbAndCacheReq_Achievement) GetUuid() string {
 	if x != nil {
-		return x.Comment2
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserMedalReply) GetComment3() int32 {
+func (x *AddAchievementDbAndCacheReq_Achievement) GetAgree() int32 {
 	if x != nil {
-		return x.Comment3
+		return x.Agree
 	}
 	return 0
 }
 
-func (x *GetUserMedalReply) GetCollect1() int32 {
+func (x *AddAchievementDbAndCacheReq_Achievement) GetView() int32 {
 	if x != nil {
-		return x.Collect1
+		return x.View
 	}
 	return 0
 }
 
-func (x *GetUserMedalReply) GetCollect2() int32 {
+func (x *AddAchievementDbAndCacheReq_Achievement) GetCollect() int32 {
 	if x != nil {
-		return x.Collect2
+		return x.Collect
 	}
 	return 0
 }
 
-func (x *GetUserMedalReply) GetCollect3() int32 {
+func (x *AddAchievementDbAndCacheReq_Achievement) GetScore() int32 {
 	if x != nil {
-		return x.Collect3
+		return x.Score
 	}
 	return 0
 }
 
-type GetUserActiveReq struct {
+type AddAchievementDbAndCacheReq_Active struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Uuid  string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Agree int32  `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
 }
 
-func (x *GetUserActiveReq) Reset() {
-	*x = GetUserActiveReq{}
+func (x *AddAchievementDbAndCacheReq_Active) Reset() {
+	*x = AddAchievementDbAndCacheReq_Active{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_achievement_service_v1_achievement_proto_msgTypes[19]
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserActiveReq) String() string {
+func (x *AddAchievementDbAndCacheReq_Active) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActiveReq) ProtoMessage() {}
+func (*AddAchievementDbAndCacheReq_Active) ProtoMessage() {}
 
-func (x *GetUserActiveReq) ProtoReflect() protoreflect.Message {
-	mi := &file_achievement_service_v1_achievement_proto_msgTypes[19]
+func (x *AddAchievementDbAndCacheReq_Active) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,44 +1790,51 @@ func (x *GetUserActiveReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActiveReq.ProtoReflect.Descriptor instead.
-func (*GetUserActiveReq) Descriptor() ([]byte, []int) {
-	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AddAchievementDbAndCacheReq_Active.ProtoReflect.Descriptor instead.
+func (*AddAchievementDbAndCacheReq_Active) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{17, 1}
 }
 
-func (x *GetUserActiveReq) GetUuid() string {
+func (x *AddAchievementDbAndCacheReq_Active) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type GetUserActiveReply struct {
+func (x *AddAchievementDbAndCacheReq_Active) GetAgree() int32 {
+	if x != nil {
+		return x.Agree
+	}
+	return 0
+}
+
+type GetUserDataReply_File struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Agree int32 `protobuf:"varint,1,opt,name=agree,proto3" json:"agree,omitempty"`
-	View  int32 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *GetUserActiveReply) Reset() {
-	*x = GetUserActiveReply{}
+func (x *GetUserDataReply_File) Reset() {
+	*x = GetUserDataReply_File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_achievement_service_v1_achievement_proto_msgTypes[20]
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserActiveReply) String() string {
+func (x *GetUserDataReply_File) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActiveReply) ProtoMessage() {}
+func (*GetUserDataReply_File) ProtoMessage() {}
 
-func (x *GetUserActiveReply) ProtoReflect() protoreflect.Message {
-	mi := &file_achievement_service_v1_achievement_proto_msgTypes[20]
+func (x *GetUserDataReply_File) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,54 +1845,52 @@ func (x *GetUserActiveReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActiveReply.ProtoReflect.Descriptor instead.
-func (*GetUserActiveReply) Descriptor() ([]byte, []int) {
-	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetUserDataReply_File.ProtoReflect.Descriptor instead.
+func (*GetUserDataReply_File) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *GetUserActiveReply) GetAgree() int32 {
+func (x *GetUserDataReply_File) GetName() string {
 	if x != nil {
-		return x.Agree
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserActiveReply) GetView() int32 {
+func (x *GetUserDataReply_File) GetContent() []byte {
 	if x != nil {
-		return x.View
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-type GetAchievementListReply_Achievement struct {
+type GetScoreRankReply_Achievement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uuid     string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	View     int32  `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	Agree    int32  `protobuf:"varint,3,opt,name=agree,proto3" json:"agree,omitempty"`
-	Followed int32  `protobuf:"varint,4,opt,name=followed,proto3" json:"followed,omitempty"`
-	Follow   int32  `protobuf:"varint,5,opt,name=follow,proto3" json:"follow,omitempty"`
+	Score    int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Followed int32  `protobuf:"varint,3,opt,name=followed,proto3" json:"followed,omitempty"`
 }
 
-func (x *GetAchievementListReply_Achievement) Reset() {
-	*x = GetAchievementListReply_Achievement{}
+func (x *GetScoreRankReply_Achievement) Reset() {
+	*x = GetScoreRankReply_Achievement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_achievement_service_v1_achievement_proto_msgTypes[21]
+		mi := &file_achievement_service_v1_achievement_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAchievementListReply_Achievement) String() string {
+func (x *GetScoreRankReply_Achievement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAchievementListReply_Achievement) ProtoMessage() {}
+func (*GetScoreRankReply_Achievement) ProtoMessage() {}
 
-func (x *GetAchievementListReply_Achievement) ProtoReflect() protoreflect.Message {
-	mi := &file_achievement_service_v1_achievement_proto_msgTypes[21]
+func (x *GetScoreRankReply_Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_achievement_service_v1_achievement_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,46 +1901,32 @@ func (x *GetAchievementListReply_Achievement) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAchievementListReply_Achievement.ProtoReflect.Descriptor instead.
-func (*GetAchievementListReply_Achievement) Descriptor() ([]byte, []int) {
-	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use GetScoreRankReply_Achievement.ProtoReflect.Descriptor instead.
+func (*GetScoreRankReply_Achievement) Descriptor() ([]byte, []int) {
+	return file_achievement_service_v1_achievement_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *GetAchievementListReply_Achievement) GetUuid() string {
+func (x *GetScoreRankReply_Achievement) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetAchievementListReply_Achievement) GetView() int32 {
-	if x != nil {
-		return x.View
-	}
-	return 0
-}
-
-func (x *GetAchievementListReply_Achievement) GetAgree() int32 {
+func (x *GetScoreRankReply_Achievement) GetScore() int32 {
 	if x != nil {
-		return x.Agree
+		return x.Score
 	}
 	return 0
 }
 
-func (x *GetAchievementListReply_Achievement) GetFollowed() int32 {
+func (x *GetScoreRankReply_Achievement) GetFollowed() int32 {
 	if x != nil {
 		return x.Followed
 	}
 	return 0
 }
 
-func (x *GetAchievementListReply_Achievement) GetFollow() int32 {
-	if x != nil {
-		return x.Follow
-	}
-	return 0
-}
-
 var File_achievement_service_v1_achievement_proto protoreflect.FileDescriptor
 
 var file_achievement_service_v1_achievement_proto_rawDesc = []byte{
@@ -1531,170 +2073,245 @@ var file_achievement_service_v1_achievement_proto_rawDesc = []byte{
 	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
 	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15,
-	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
-	0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xdf, 0x04, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x37, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x37, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x31, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72,
-	0x65, 0x65, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x33, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x33, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72,
-	0x65, 0x65, 0x34, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x35, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x35, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x36, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72,
-	0x65, 0x65, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x31, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65,
-	0x77, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x32, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x33, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x76, 0x69, 0x65, 0x77, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x31, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x33, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x33, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x31, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x32, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x32, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x33, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x33, 0x22, 0x40, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x32,
-	0xd7, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x57, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65,
-	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x72,
-	0x65, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x63,
-	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x12, 0x25, 0x2e,
-	0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5b,
-	0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69,
-	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xf5, 0x02, 0x0a, 0x1b, 0x41,
+	0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x62, 0x41,
+	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x59, 0x0a, 0x0b, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x63, 0x68,
+	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x1a, 0x7b, 0x0a, 0x0b, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x32,
+	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0xdf, 0x04, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x33, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x34, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x35, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x37, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x37, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x32, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x33, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x33, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x34, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x34, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x35, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x35, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x36, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x67, 0x72, 0x65, 0x65, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x69, 0x65, 0x77, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77,
+	0x31, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x33,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x33, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x32, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x32, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x33, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x33, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x31, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x31, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x32, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x32, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x33, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x33, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x34, 0x0a, 0x04, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4f,
+	0x0a, 0x0b, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x53, 0x0a, 0x0b, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x32, 0xb0, 0x10, 0x0a, 0x0b, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
 	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41,
-	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59,
-	0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x27, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65,
-	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x17, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x2a, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69,
-	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x53, 0x65, 0x74,
-	0x12, 0x25, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61,
-	0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61,
-	0x6c, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52,
 	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x16,
-	0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x44, 0x62, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69,
+	0x65, 0x77, 0x12, 0x25, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x61,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x2e, 0x61,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x27, 0x2e, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x2a, 0x2e, 0x61, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
 	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x65, 0x64, 0x61, 0x6c, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x19, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x44, 0x62,
-	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2c, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65,
-	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x5d, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61,
+	0x6c, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
 	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
 	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x4f, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x64, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x12, 0x63, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x64, 0x61, 0x6c, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2c, 0x2e,
+	0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x44, 0x62,
+	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e,
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4d,
 	0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x59, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x65, 0x64, 0x61, 0x6c, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x22,
+	0x00, 0x12, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27,
 	0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x12,
 	0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x63, 0x68, 0x69,
-	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41,
-	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x63, 0x68,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61,
+	0x6c, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x61, 0x63, 0x68,
 	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x27, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x61,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x41, 0x64, 0x64,
+	0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0d, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x20, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x2e, 0x61,
 	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
 	0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x13, 0x41,
-	0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67,
-	0x65, 0x74, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x1f, 0x5a, 0x1d, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74,
+	0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x1f, 0x5a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1709,77 +2326,99 @@ func file_achievement_service_v1_achievement_proto_rawDescGZIP() []byte {
 	return file_achievement_service_v1_achievement_proto_rawDescData
 }
 
-var file_achievement_service_v1_achievement_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_achievement_service_v1_achievement_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_achievement_service_v1_achievement_proto_goTypes = []interface{}{
-	(*SetAchievementAgreeReq)(nil),              // 0: achievement.v1.SetAchievementAgreeReq
-	(*CancelAchievementAgreeReq)(nil),           // 1: achievement.v1.CancelAchievementAgreeReq
-	(*SetAchievementViewReq)(nil),               // 2: achievement.v1.SetAchievementViewReq
-	(*SetAchievementCollectReq)(nil),            // 3: achievement.v1.SetAchievementCollectReq
-	(*CancelAchievementCollectReq)(nil),         // 4: achievement.v1.CancelAchievementCollectReq
-	(*SetAchievementFollowReq)(nil),             // 5: achievement.v1.SetAchievementFollowReq
-	(*CancelAchievementFollowReq)(nil),          // 6: achievement.v1.CancelAchievementFollowReq
-	(*SetUserMedalReq)(nil),                     // 7: achievement.v1.SetUserMedalReq
-	(*CancelUserMedalSetReq)(nil),               // 8: achievement.v1.CancelUserMedalSetReq
-	(*SetUserMedalDbAndCacheReq)(nil),           // 9: achievement.v1.SetUserMedalDbAndCacheReq
-	(*CancelUserMedalDbAndCacheReq)(nil),        // 10: achievement.v1.CancelUserMedalDbAndCacheReq
-	(*AccessUserMedalReq)(nil),                  // 11: achievement.v1.AccessUserMedalReq
-	(*GetAchievementListReq)(nil),               // 12: achievement.v1.GetAchievementListReq
-	(*GetAchievementListReply)(nil),             // 13: achievement.v1.GetAchievementListReply
-	(*GetUserAchievementReq)(nil),               // 14: achievement.v1.GetUserAchievementReq
-	(*GetUserAchievementReply)(nil),             // 15: achievement.v1.GetUserAchievementReply
-	(*AddAchievementScoreReq)(nil),              // 16: achievement.v1.AddAchievementScoreReq
-	(*GetUserMedalReq)(nil),                     // 17: achievement.v1.GetUserMedalReq
-	(*GetUserMedalReply)(nil),                   // 18: achievement.v1.GetUserMedalReply
-	(*GetUserActiveReq)(nil),                    // 19: achievement.v1.GetUserActiveReq
-	(*GetUserActiveReply)(nil),                  // 20: achievement.v1.GetUserActiveReply
-	(*GetAchievementListReply_Achievement)(nil), // 21: achievement.v1.GetAchievementListReply.Achievement
-	(*emptypb.Empty)(nil),                       // 22: google.protobuf.Empty
+	(*SetAchievementAgreeReq)(nil),                  // 0: achievement.v1.SetAchievementAgreeReq
+	(*CancelAchievementAgreeReq)(nil),               // 1: achievement.v1.CancelAchievementAgreeReq
+	(*SetAchievementViewReq)(nil),                   // 2: achievement.v1.SetAchievementViewReq
+	(*SetAchievementCollectReq)(nil),                // 3: achievement.v1.SetAchievementCollectReq
+	(*CancelAchievementCollectReq)(nil),             // 4: achievement.v1.CancelAchievementCollectReq
+	(*SetAchievementFollowReq)(nil),                 // 5: achievement.v1.SetAchievementFollowReq
+	(*CancelAchievementFollowReq)(nil),              // 6: achievement.v1.CancelAchievementFollowReq
+	(*SetUserMedalReq)(nil),                         // 7: achievement.v1.SetUserMedalReq
+	(*CancelUserMedalSetReq)(nil),                   // 8: achievement.v1.CancelUserMedalSetReq
+	(*SetUserMedalDbAndCacheReq)(nil),               // 9: achievement.v1.SetUserMedalDbAndCacheReq
+	(*CancelUserMedalDbAndCacheReq)(nil),            // 10: achievement.v1.CancelUserMedalDbAndCacheReq
+	(*AccessUserMedalReq)(nil),                      // 11: achievement.v1.AccessUserMedalReq
+	(*GetAchievementListReq)(nil),                   // 12: achievement.v1.GetAchievementListReq
+	(*GetAchievementListReply)(nil),                 // 13: achievement.v1.GetAchievementListReply
+	(*GetUserAchievementReq)(nil),                   // 14: achievement.v1.GetUserAchievementReq
+	(*GetUserAchievementReply)(nil),                 // 15: achievement.v1.GetUserAchievementReply
+	(*AddAchievementScoreReq)(nil),                  // 16: achievement.v1.AddAchievementScoreReq
+	(*AddAchievementDbAndCacheReq)(nil),             // 17: achievement.v1.AddAchievementDbAndCacheReq
+	(*GetUserMedalReq)(nil),                         // 18: achievement.v1.GetUserMedalReq
+	(*GetUserMedalReply)(nil),                       // 19: achievement.v1.GetUserMedalReply
+	(*GetUserActiveReq)(nil),                        // 20: achievement.v1.GetUserActiveReq
+	(*GetUserActiveReply)(nil),                      // 21: achievement.v1.GetUserActiveReply
+	(*PurgeUserDataReq)(nil),                        // 22: achievement.v1.PurgeUserDataReq
+	(*GetUserDataReq)(nil),                          // 23: achievement.v1.GetUserDataReq
+	(*GetUserDataReply)(nil),                        // 24: achievement.v1.GetUserDataReply
+	(*GetScoreRankReq)(nil),                         // 25: achievement.v1.GetScoreRankReq
+	(*GetScoreRankReply)(nil),                       // 26: achievement.v1.GetScoreRankReply
+	(*GetAchievementListReply_Achievement)(nil),     // 27: achievement.v1.GetAchievementListReply.Achievement
+	(*AddAchievementDbAndCacheReq_Achievement)(nil), // 28: achievement.v1.AddAchievementDbAndCacheReq.Achievement
+	(*AddAchievementDbAndCacheReq_Active)(nil),      // 29: achievement.v1.AddAchievementDbAndCacheReq.Active
+	(*GetUserDataReply_File)(nil),                   // 30: achievement.v1.GetUserDataReply.File
+	(*GetScoreRankReply_Achievement)(nil),           // 31: achievement.v1.GetScoreRankReply.Achievement
+	(*emptypb.Empty)(nil),                           // 32: google.protobuf.Empty
 }
 var file_achievement_service_v1_achievement_proto_depIdxs = []int32{
-	21, // 0: achievement.v1.GetAchievementListReply.achievement:type_name -> achievement.v1.GetAchievementListReply.Achievement
-	0,  // 1: achievement.v1.Achievement.SetAchievementAgree:input_type -> achievement.v1.SetAchievementAgreeReq
-	1,  // 2: achievement.v1.Achievement.CancelAchievementAgree:input_type -> achievement.v1.CancelAchievementAgreeReq
-	2,  // 3: achievement.v1.Achievement.SetAchievementView:input_type -> achievement.v1.SetAchievementViewReq
-	3,  // 4: achievement.v1.Achievement.SetAchievementCollect:input_type -> achievement.v1.SetAchievementCollectReq
-	4,  // 5: achievement.v1.Achievement.CancelAchievementCollect:input_type -> achievement.v1.CancelAchievementCollectReq
-	5,  // 6: achievement.v1.Achievement.SetAchievementFollow:input_type -> achievement.v1.SetAchievementFollowReq
-	6,  // 7: achievement.v1.Achievement.CancelAchievementFollow:input_type -> achievement.v1.CancelAchievementFollowReq
-	8,  // 8: achievement.v1.Achievement.CancelUserMedalSet:input_type -> achievement.v1.CancelUserMedalSetReq
-	7,  // 9: achievement.v1.Achievement.SetUserMedal:input_type -> achievement.v1.SetUserMedalReq
-	9,  // 10: achievement.v1.Achievement.SetUserMedalDbAndCache:input_type -> achievement.v1.SetUserMedalDbAndCacheReq
-	10, // 11: achievement.v1.Achievement.CancelUserMedalDbAndCache:input_type -> achievement.v1.CancelUserMedalDbAndCacheReq
-	11, // 12: achievement.v1.Achievement.AccessUserMedal:input_type -> achievement.v1.AccessUserMedalReq
-	11, // 13: achievement.v1.Achievement.AccessUserMedalDbAndCache:input_type -> achievement.v1.AccessUserMedalReq
-	12, // 14: achievement.v1.Achievement.GetAchievementList:input_type -> achievement.v1.GetAchievementListReq
-	14, // 15: achievement.v1.Achievement.GetUserAchievement:input_type -> achievement.v1.GetUserAchievementReq
-	17, // 16: achievement.v1.Achievement.GetUserMedal:input_type -> achievement.v1.GetUserMedalReq
-	19, // 17: achievement.v1.Achievement.GetUserActive:input_type -> achievement.v1.GetUserActiveReq
-	16, // 18: achievement.v1.Achievement.AddAchievementScore:input_type -> achievement.v1.AddAchievementScoreReq
-	22, // 19: achievement.v1.Achievement.GetHealth:input_type -> google.protobuf.Empty
-	22, // 20: achievement.v1.Achievement.SetAchievementAgree:output_type -> google.protobuf.Empty
-	22, // 21: achievement.v1.Achievement.CancelAchievementAgree:output_type -> google.protobuf.Empty
-	22, // 22: achievement.v1.Achievement.SetAchievementView:output_type -> google.protobuf.Empty
-	22, // 23: achievement.v1.Achievement.SetAchievementCollect:output_type -> google.protobuf.Empty
-	22, // 24: achievement.v1.Achievement.CancelAchievementCollect:output_type -> google.protobuf.Empty
-	22, // 25: achievement.v1.Achievement.SetAchievementFollow:output_type -> google.protobuf.Empty
-	22, // 26: achievement.v1.Achievement.CancelAchievementFollow:output_type -> google.protobuf.Empty
-	22, // 27: achievement.v1.Achievement.CancelUserMedalSet:output_type -> google.protobuf.Empty
-	22, // 28: achievement.v1.Achievement.SetUserMedal:output_type -> google.protobuf.Empty
-	22, // 29: achievement.v1.Achievement.SetUserMedalDbAndCache:output_type -> google.protobuf.Empty
-	22, // 30: achievement.v1.Achievement.CancelUserMedalDbAndCache:output_type -> google.protobuf.Empty
-	22, // 31: achievement.v1.Achievement.AccessUserMedal:output_type -> google.protobuf.Empty
-	22, // 32: achievement.v1.Achievement.AccessUserMedalDbAndCache:output_type -> google.protobuf.Empty
-	13, // 33: achievement.v1.Achievement.GetAchievementList:output_type -> achievement.v1.GetAchievementListReply
-	15, // 34: achievement.v1.Achievement.GetUserAchievement:output_type -> achievement.v1.GetUserAchievementReply
-	18, // 35: achievement.v1.Achievement.GetUserMedal:output_type -> achievement.v1.GetUserMedalReply
-	20, // 36: achievement.v1.Achievement.GetUserActive:output_type -> achievement.v1.GetUserActiveReply
-	22, // 37: achievement.v1.Achievement.AddAchievementScore:output_type -> google.protobuf.Empty
-	22, // 38: achievement.v1.Achievement.GetHealth:output_type -> google.protobuf.Empty
-	20, // [20:39] is the sub-list for method output_type
-	1,  // [1:20] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	27, // 0: achievement.v1.GetAchievementListReply.achievement:type_name -> achievement.v1.GetAchievementListReply.Achievement
+	28, // 1: achievement.v1.AddAchievementDbAndCacheReq.achievement:type_name -> achievement.v1.AddAchievementDbAndCacheReq.Achievement
+	29, // 2: achievement.v1.AddAchievementDbAndCacheReq.active:type_name -> achievement.v1.AddAchievementDbAndCacheReq.Active
+	30, // 3: achievement.v1.GetUserDataReply.files:type_name -> achievement.v1.GetUserDataReply.File
+	31, // 4: achievement.v1.GetScoreRankReply.achievement:type_name -> achievement.v1.GetScoreRankReply.Achievement
+	0,  // 5: achievement.v1.Achievement.SetAchievementAgree:input_type -> achievement.v1.SetAchievementAgreeReq
+	1,  // 6: achievement.v1.Achievement.CancelAchievementAgree:input_type -> achievement.v1.CancelAchievementAgreeReq
+	2,  // 7: achievement.v1.Achievement.SetAchievementView:input_type -> achievement.v1.SetAchievementViewReq
+	3,  // 8: achievement.v1.Achievement.SetAchievementCollect:input_type -> achievement.v1.SetAchievementCollectReq
+	4,  // 9: achievement.v1.Achievement.CancelAchievementCollect:input_type -> achievement.v1.CancelAchievementCollectReq
+	5,  // 10: achievement.v1.Achievement.SetAchievementFollow:input_type -> achievement.v1.SetAchievementFollowReq
+	6,  // 11: achievement.v1.Achievement.CancelAchievementFollow:input_type -> achievement.v1.CancelAchievementFollowReq
+	8,  // 12: achievement.v1.Achievement.CancelUserMedalSet:input_type -> achievement.v1.CancelUserMedalSetReq
+	7,  // 13: achievement.v1.Achievement.SetUserMedal:input_type -> achievement.v1.SetUserMedalReq
+	9,  // 14: achievement.v1.Achievement.SetUserMedalDbAndCache:input_type -> achievement.v1.SetUserMedalDbAndCacheReq
+	10, // 15: achievement.v1.Achievement.CancelUserMedalDbAndCache:input_type -> achievement.v1.CancelUserMedalDbAndCacheReq
+	11, // 16: achievement.v1.Achievement.AccessUserMedal:input_type -> achievement.v1.AccessUserMedalReq
+	11, // 17: achievement.v1.Achievement.AccessUserMedalDbAndCache:input_type -> achievement.v1.AccessUserMedalReq
+	12, // 18: achievement.v1.Achievement.GetAchievementList:input_type -> achievement.v1.GetAchievementListReq
+	14, // 19: achievement.v1.Achievement.GetUserAchievement:input_type -> achievement.v1.GetUserAchievementReq
+	18, // 20: achievement.v1.Achievement.GetUserMedal:input_type -> achievement.v1.GetUserMedalReq
+	20, // 21: achievement.v1.Achievement.GetUserActive:input_type -> achievement.v1.GetUserActiveReq
+	16, // 22: achievement.v1.Achievement.AddAchievementScore:input_type -> achievement.v1.AddAchievementScoreReq
+	17, // 23: achievement.v1.Achievement.AddAchievementDbAndCache:input_type -> achievement.v1.AddAchievementDbAndCacheReq
+	23, // 24: achievement.v1.Achievement.GetUserData:input_type -> achievement.v1.GetUserDataReq
+	22, // 25: achievement.v1.Achievement.PurgeUserData:input_type -> achievement.v1.PurgeUserDataReq
+	25, // 26: achievement.v1.Achievement.GetScoreRank:input_type -> achievement.v1.GetScoreRankReq
+	32, // 27: achievement.v1.Achievement.GetHealth:input_type -> google.protobuf.Empty
+	32, // 28: achievement.v1.Achievement.SetAchievementAgree:output_type -> google.protobuf.Empty
+	32, // 29: achievement.v1.Achievement.CancelAchievementAgree:output_type -> google.protobuf.Empty
+	32, // 30: achievement.v1.Achievement.SetAchievementView:output_type -> google.protobuf.Empty
+	32, // 31: achievement.v1.Achievement.SetAchievementCollect:output_type -> google.protobuf.Empty
+	32, // 32: achievement.v1.Achievement.CancelAchievementCollect:output_type -> google.protobuf.Empty
+	32, // 33: achievement.v1.Achievement.SetAchievementFollow:output_type -> google.protobuf.Empty
+	32, // 34: achievement.v1.Achievement.CancelAchievementFollow:output_type -> google.protobuf.Empty
+	32, // 35: achievement.v1.Achievement.CancelUserMedalSet:output_type -> google.protobuf.Empty
+	32, // 36: achievement.v1.Achievement.SetUserMedal:output_type -> google.protobuf.Empty
+	32, // 37: achievement.v1.Achievement.SetUserMedalDbAndCache:output_type -> google.protobuf.Empty
+	32, // 38: achievement.v1.Achievement.CancelUserMedalDbAndCache:output_type -> google.protobuf.Empty
+	32, // 39: achievement.v1.Achievement.AccessUserMedal:output_type -> google.protobuf.Empty
+	32, // 40: achievement.v1.Achievement.AccessUserMedalDbAndCache:output_type -> google.protobuf.Empty
+	13, // 41: achievement.v1.Achievement.GetAchievementList:output_type -> achievement.v1.GetAchievementListReply
+	15, // 42: achievement.v1.Achievement.GetUserAchievement:output_type -> achievement.v1.GetUserAchievementReply
+	19, // 43: achievement.v1.Achievement.GetUserMedal:output_type -> achievement.v1.GetUserMedalReply
+	21, // 44: achievement.v1.Achievement.GetUserActive:output_type -> achievement.v1.GetUserActiveReply
+	32, // 45: achievement.v1.Achievement.AddAchievementScore:output_type -> google.protobuf.Empty
+	32, // 46: achievement.v1.Achievement.AddAchievementDbAndCache:output_type -> google.protobuf.Empty
+	24, // 47: achievement.v1.Achievement.GetUserData:output_type -> achievement.v1.GetUserDataReply
+	32, // 48: achievement.v1.Achievement.PurgeUserData:output_type -> google.protobuf.Empty
+	26, // 49: achievement.v1.Achievement.GetScoreRank:output_type -> achievement.v1.GetScoreRankReply
+	32, // 50: achievement.v1.Achievement.GetHealth:output_type -> google.protobuf.Empty
+	28, // [28:51] is the sub-list for method output_type
+	5,  // [5:28] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_achievement_service_v1_achievement_proto_init() }
@@ -1993,7 +2632,7 @@ func file_achievement_service_v1_achievement_proto_init() {
 			}
 		}
 		file_achievement_service_v1_achievement_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserMedalReq); i {
+			switch v := v.(*AddAchievementDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2005,7 +2644,7 @@ func file_achievement_service_v1_achievement_proto_init() {
 			}
 		}
 		file_achievement_service_v1_achievement_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserMedalReply); i {
+			switch v := v.(*GetUserMedalReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2017,7 +2656,7 @@ func file_achievement_service_v1_achievement_proto_init() {
 			}
 		}
 		file_achievement_service_v1_achievement_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserActiveReq); i {
+			switch v := v.(*GetUserMedalReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2029,7 +2668,7 @@ func file_achievement_service_v1_achievement_proto_init() {
 			}
 		}
 		file_achievement_service_v1_achievement_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserActiveReply); i {
+			switch v := v.(*GetUserActiveReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2041,6 +2680,78 @@ func file_achievement_service_v1_achievement_proto_init() {
 			}
 		}
 		file_achievement_service_v1_achievement_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserActiveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScoreRankReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScoreRankReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAchievementListReply_Achievement); i {
 			case 0:
 				return &v.state
@@ -2052,6 +2763,54 @@ func file_achievement_service_v1_achievement_proto_init() {
 				return nil
 			}
 		}
+		file_achievement_service_v1_achievement_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAchievementDbAndCacheReq_Achievement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAchievementDbAndCacheReq_Active); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataReply_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_achievement_service_v1_achievement_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScoreRankReply_Achievement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2059,7 +2818,7 @@ func file_achievement_service_v1_achievement_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_achievement_service_v1_achievement_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2027,6 +2027,177 @@ var _ interface {
 	ErrorName() string
 } = AddAchievementScoreReqValidationError{}
 
+// Validate checks the field values on AddAchievementDbAndCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddAchievementDbAndCacheReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAchievementDbAndCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAchievementDbAndCacheReqMultiError, or nil if none found.
+func (m *AddAchievementDbAndCacheReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAchievementDbAndCacheReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAchievement() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAchievementDbAndCacheReqValidationError{
+						field:  fmt.Sprintf("Achievement[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAchievementDbAndCacheReqValidationError{
+						field:  fmt.Sprintf("Achievement[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAchievementDbAndCacheReqValidationError{
+					field:  fmt.Sprintf("Achievement[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetActive() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAchievementDbAndCacheReqValidationError{
+						field:  fmt.Sprintf("Active[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAchievementDbAndCacheReqValidationError{
+						field:  fmt.Sprintf("Active[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAchievementDbAndCacheReqValidationError{
+					field:  fmt.Sprintf("Active[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddAchievementDbAndCacheReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAchievementDbAndCacheReqMultiError is an error wrapping multiple
+// validation errors returned by AddAchievementDbAndCacheReq.ValidateAll() if
+// the designated constraints aren't met.
+type AddAchievementDbAndCacheReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAchievementDbAndCacheReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAchievementDbAndCacheReqMultiError) AllErrors() []error { return m }
+
+// AddAchievementDbAndCacheReqValidationError is the validation error returned
+// by AddAchievementDbAndCacheReq.Validate if the designated constraints
+// aren't met.
+type AddAchievementDbAndCacheReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAchievementDbAndCacheReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAchievementDbAndCacheReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAchievementDbAndCacheReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAchievementDbAndCacheReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAchievementDbAndCacheReqValidationError) ErrorName() string {
+	return "AddAchievementDbAndCacheReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAchievementDbAndCacheReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAchievementDbAndCacheReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAchievementDbAndCacheReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAchievementDbAndCacheReqValidationError{}
+
 // Validate checks the field values on GetUserMedalReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2505,54 +2676,53 @@ var _ interface {
 	ErrorName() string
 } = GetUserActiveReplyValidationError{}
 
-// Validate checks the field values on GetAchievementListReply_Achievement with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetAchievementListReply_Achievement) Validate() error {
+// Validate checks the field values on PurgeUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserDataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAchievementListReply_Achievement
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetAchievementListReply_AchievementMultiError, or nil if none found.
-func (m *GetAchievementListReply_Achievement) ValidateAll() error {
+// ValidateAll checks the field values on PurgeUserDataReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeUserDataReqMultiError, or nil if none found.
+func (m *PurgeUserDataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAchievementListReply_Achievement) validate(all bool) error {
+func (m *PurgeUserDataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Uuid
-
-	// no validation rules for View
-
-	// no validation rules for Agree
-
-	// no validation rules for Followed
-
-	// no validation rules for Follow
+	if !_PurgeUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := PurgeUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetAchievementListReply_AchievementMultiError(errors)
+		return PurgeUserDataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAchievementListReply_AchievementMultiError is an error wrapping multiple
-// validation errors returned by
-// GetAchievementListReply_Achievement.ValidateAll() if the designated
-// constraints aren't met.
-type GetAchievementListReply_AchievementMultiError []error
+// PurgeUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by PurgeUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeUserDataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAchievementListReply_AchievementMultiError) Error() string {
+func (m PurgeUserDataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2561,12 +2731,11 @@ func (m GetAchievementListReply_AchievementMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAchievementListReply_AchievementMultiError) AllErrors() []error { return m }
+func (m PurgeUserDataReqMultiError) AllErrors() []error { return m }
 
-// GetAchievementListReply_AchievementValidationError is the validation error
-// returned by GetAchievementListReply_Achievement.Validate if the designated
-// constraints aren't met.
-type GetAchievementListReply_AchievementValidationError struct {
+// PurgeUserDataReqValidationError is the validation error returned by
+// PurgeUserDataReq.Validate if the designated constraints aren't met.
+type PurgeUserDataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2574,24 +2743,22 @@ type GetAchievementListReply_AchievementValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAchievementListReply_AchievementValidationError) Field() string { return e.field }
+func (e PurgeUserDataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAchievementListReply_AchievementValidationError) Reason() string { return e.reason }
+func (e PurgeUserDataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAchievementListReply_AchievementValidationError) Cause() error { return e.cause }
+func (e PurgeUserDataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAchievementListReply_AchievementValidationError) Key() bool { return e.key }
+func (e PurgeUserDataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAchievementListReply_AchievementValidationError) ErrorName() string {
-	return "GetAchievementListReply_AchievementValidationError"
-}
+func (e PurgeUserDataReqValidationError) ErrorName() string { return "PurgeUserDataReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAchievementListReply_AchievementValidationError) Error() string {
+func (e PurgeUserDataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2603,14 +2770,14 @@ func (e GetAchievementListReply_AchievementValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAchievementListReply_Achievement.%s: %s%s",
+		"invalid %sPurgeUserDataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAchievementListReply_AchievementValidationError{}
+var _ error = PurgeUserDataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2618,4 +2785,1046 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAchievementListReply_AchievementValidationError{}
+} = PurgeUserDataReqValidationError{}
+
+var _PurgeUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserDataReqMultiError,
+// or nil if none found.
+func (m *GetUserDataReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserDataReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReqMultiError) AllErrors() []error { return m }
+
+// GetUserDataReqValidationError is the validation error returned by
+// GetUserDataReq.Validate if the designated constraints aren't met.
+type GetUserDataReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReqValidationError) ErrorName() string { return "GetUserDataReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReqValidationError{}
+
+var _GetUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReplyMultiError, or nil if none found.
+func (m *GetUserDataReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserDataReplyValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserDataReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReplyMultiError) AllErrors() []error { return m }
+
+// GetUserDataReplyValidationError is the validation error returned by
+// GetUserDataReply.Validate if the designated constraints aren't met.
+type GetUserDataReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReplyValidationError) ErrorName() string { return "GetUserDataReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReplyValidationError{}
+
+// Validate checks the field values on GetScoreRankReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetScoreRankReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScoreRankReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScoreRankReqMultiError, or nil if none found.
+func (m *GetScoreRankReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScoreRankReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return GetScoreRankReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScoreRankReqMultiError is an error wrapping multiple validation errors
+// returned by GetScoreRankReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetScoreRankReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScoreRankReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScoreRankReqMultiError) AllErrors() []error { return m }
+
+// GetScoreRankReqValidationError is the validation error returned by
+// GetScoreRankReq.Validate if the designated constraints aren't met.
+type GetScoreRankReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScoreRankReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScoreRankReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScoreRankReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScoreRankReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScoreRankReqValidationError) ErrorName() string { return "GetScoreRankReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetScoreRankReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScoreRankReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScoreRankReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScoreRankReqValidationError{}
+
+// Validate checks the field values on GetScoreRankReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetScoreRankReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScoreRankReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScoreRankReplyMultiError, or nil if none found.
+func (m *GetScoreRankReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScoreRankReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAchievement() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetScoreRankReplyValidationError{
+						field:  fmt.Sprintf("Achievement[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetScoreRankReplyValidationError{
+						field:  fmt.Sprintf("Achievement[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetScoreRankReplyValidationError{
+					field:  fmt.Sprintf("Achievement[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetScoreRankReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScoreRankReplyMultiError is an error wrapping multiple validation errors
+// returned by GetScoreRankReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetScoreRankReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScoreRankReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScoreRankReplyMultiError) AllErrors() []error { return m }
+
+// GetScoreRankReplyValidationError is the validation error returned by
+// GetScoreRankReply.Validate if the designated constraints aren't met.
+type GetScoreRankReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScoreRankReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScoreRankReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScoreRankReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScoreRankReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScoreRankReplyValidationError) ErrorName() string {
+	return "GetScoreRankReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScoreRankReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScoreRankReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScoreRankReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScoreRankReplyValidationError{}
+
+// Validate checks the field values on GetAchievementListReply_Achievement with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAchievementListReply_Achievement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAchievementListReply_Achievement
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAchievementListReply_AchievementMultiError, or nil if none found.
+func (m *GetAchievementListReply_Achievement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAchievementListReply_Achievement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	// no validation rules for View
+
+	// no validation rules for Agree
+
+	// no validation rules for Followed
+
+	// no validation rules for Follow
+
+	if len(errors) > 0 {
+		return GetAchievementListReply_AchievementMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAchievementListReply_AchievementMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAchievementListReply_Achievement.ValidateAll() if the designated
+// constraints aren't met.
+type GetAchievementListReply_AchievementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAchievementListReply_AchievementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAchievementListReply_AchievementMultiError) AllErrors() []error { return m }
+
+// GetAchievementListReply_AchievementValidationError is the validation error
+// returned by GetAchievementListReply_Achievement.Validate if the designated
+// constraints aren't met.
+type GetAchievementListReply_AchievementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAchievementListReply_AchievementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAchievementListReply_AchievementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAchievementListReply_AchievementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAchievementListReply_AchievementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAchievementListReply_AchievementValidationError) ErrorName() string {
+	return "GetAchievementListReply_AchievementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAchievementListReply_AchievementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAchievementListReply_Achievement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAchievementListReply_AchievementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAchievementListReply_AchievementValidationError{}
+
+// Validate checks the field values on AddAchievementDbAndCacheReq_Achievement
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddAchievementDbAndCacheReq_Achievement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AddAchievementDbAndCacheReq_Achievement with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AddAchievementDbAndCacheReq_AchievementMultiError, or nil if none found.
+func (m *AddAchievementDbAndCacheReq_Achievement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAchievementDbAndCacheReq_Achievement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	// no validation rules for Agree
+
+	// no validation rules for View
+
+	// no validation rules for Collect
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return AddAchievementDbAndCacheReq_AchievementMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAchievementDbAndCacheReq_AchievementMultiError is an error wrapping
+// multiple validation errors returned by
+// AddAchievementDbAndCacheReq_Achievement.ValidateAll() if the designated
+// constraints aren't met.
+type AddAchievementDbAndCacheReq_AchievementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAchievementDbAndCacheReq_AchievementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAchievementDbAndCacheReq_AchievementMultiError) AllErrors() []error { return m }
+
+// AddAchievementDbAndCacheReq_AchievementValidationError is the validation
+// error returned by AddAchievementDbAndCacheReq_Achievement.Validate if the
+// designated constraints aren't met.
+type AddAchievementDbAndCacheReq_AchievementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAchievementDbAndCacheReq_AchievementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAchievementDbAndCacheReq_AchievementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAchievementDbAndCacheReq_AchievementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAchievementDbAndCacheReq_AchievementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAchievementDbAndCacheReq_AchievementValidationError) ErrorName() string {
+	return "AddAchievementDbAndCacheReq_AchievementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAchievementDbAndCacheReq_AchievementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAchievementDbAndCacheReq_Achievement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAchievementDbAndCacheReq_AchievementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAchievementDbAndCacheReq_AchievementValidationError{}
+
+// Validate checks the field values on AddAchievementDbAndCacheReq_Active with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddAchievementDbAndCacheReq_Active) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAchievementDbAndCacheReq_Active
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddAchievementDbAndCacheReq_ActiveMultiError, or nil if none found.
+func (m *AddAchievementDbAndCacheReq_Active) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAchievementDbAndCacheReq_Active) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	// no validation rules for Agree
+
+	if len(errors) > 0 {
+		return AddAchievementDbAndCacheReq_ActiveMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAchievementDbAndCacheReq_ActiveMultiError is an error wrapping multiple
+// validation errors returned by
+// AddAchievementDbAndCacheReq_Active.ValidateAll() if the designated
+// constraints aren't met.
+type AddAchievementDbAndCacheReq_ActiveMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAchievementDbAndCacheReq_ActiveMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAchievementDbAndCacheReq_ActiveMultiError) AllErrors() []error { return m }
+
+// AddAchievementDbAndCacheReq_ActiveValidationError is the validation error
+// returned by AddAchievementDbAndCacheReq_Active.Validate if the designated
+// constraints aren't met.
+type AddAchievementDbAndCacheReq_ActiveValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAchievementDbAndCacheReq_ActiveValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAchievementDbAndCacheReq_ActiveValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAchievementDbAndCacheReq_ActiveValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAchievementDbAndCacheReq_ActiveValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAchievementDbAndCacheReq_ActiveValidationError) ErrorName() string {
+	return "AddAchievementDbAndCacheReq_ActiveValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAchievementDbAndCacheReq_ActiveValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAchievementDbAndCacheReq_Active.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAchievementDbAndCacheReq_ActiveValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAchievementDbAndCacheReq_ActiveValidationError{}
+
+// Validate checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReply_FileMultiError, or nil if none found.
+func (m *GetUserDataReply_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReply_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetUserDataReply_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReply_FileMultiError is an error wrapping multiple validation
+// errors returned by GetUserDataReply_File.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserDataReply_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReply_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReply_FileMultiError) AllErrors() []error { return m }
+
+// GetUserDataReply_FileValidationError is the validation error returned by
+// GetUserDataReply_File.Validate if the designated constraints aren't met.
+type GetUserDataReply_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReply_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReply_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReply_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReply_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReply_FileValidationError) ErrorName() string {
+	return "GetUserDataReply_FileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReply_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReply_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReply_FileValidationError{}
+
+// Validate checks the field values on GetScoreRankReply_Achievement with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetScoreRankReply_Achievement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScoreRankReply_Achievement with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetScoreRankReply_AchievementMultiError, or nil if none found.
+func (m *GetScoreRankReply_Achievement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScoreRankReply_Achievement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	// no validation rules for Score
+
+	// no validation rules for Followed
+
+	if len(errors) > 0 {
+		return GetScoreRankReply_AchievementMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScoreRankReply_AchievementMultiError is an error wrapping multiple
+// validation errors returned by GetScoreRankReply_Achievement.ValidateAll()
+// if the designated constraints aren't met.
+type GetScoreRankReply_AchievementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScoreRankReply_AchievementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScoreRankReply_AchievementMultiError) AllErrors() []error { return m }
+
+// GetScoreRankReply_AchievementValidationError is the validation error
+// returned by GetScoreRankReply_Achievement.Validate if the designated
+// constraints aren't met.
+type GetScoreRankReply_AchievementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScoreRankReply_AchievementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScoreRankReply_AchievementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScoreRankReply_AchievementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScoreRankReply_AchievementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScoreRankReply_AchievementValidationError) ErrorName() string {
+	return "GetScoreRankReply_AchievementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScoreRankReply_AchievementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScoreRankReply_Achievement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScoreRankReply_AchievementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScoreRankReply_AchievementValidationError{}
@@ -41,6 +41,9 @@ const (
 	AchievementErrorReason_GET_ACTIVE_FAILED                 AchievementErrorReason = 14
 	AchievementErrorReason_ADD_ACHIEVEMENT_SCORE_FAILED      AchievementErrorReason = 15
 	AchievementErrorReason_REDUCE_ACHIEVEMENT_SCORE_FAILED   AchievementErrorReason = 16
+	AchievementErrorReason_ADD_ACHIEVEMENT_FAILED            AchievementErrorReason = 17
+	AchievementErrorReason_GET_USER_DATA_FAILED              AchievementErrorReason = 18
+	AchievementErrorReason_PURGE_USER_DATA_FAILED            AchievementErrorReason = 19
 )
 
 // Enum value maps for AchievementErrorReason.
@@ -63,6 +66,9 @@ var (
 		14: "GET_ACTIVE_FAILED",
 		15: "ADD_ACHIEVEMENT_SCORE_FAILED",
 		16: "REDUCE_ACHIEVEMENT_SCORE_FAILED",
+		17: "ADD_ACHIEVEMENT_FAILED",
+		18: "GET_USER_DATA_FAILED",
+		19: "PURGE_USER_DATA_FAILED",
 	}
 	AchievementErrorReason_value = map[string]int32{
 		"UNKNOWN_ERROR":                     0,
@@ -82,6 +88,9 @@ var (
 		"GET_ACTIVE_FAILED":                 14,
 		"ADD_ACHIEVEMENT_SCORE_FAILED":      15,
 		"REDUCE_ACHIEVEMENT_SCORE_FAILED":   16,
+		"ADD_ACHIEVEMENT_FAILED":            17,
+		"GET_USER_DATA_FAILED":              18,
+		"PURGE_USER_DATA_FAILED":            19,
 	}
 )
 
@@ -120,7 +129,7 @@ var file_achievement_service_v1_achievement_error_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
 	0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xaa, 0x04, 0x0a, 0x16, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xfc, 0x04, 0x0a, 0x16, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76,
 	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
 	0x52, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x54, 0x5f, 0x41, 0x43, 0x48, 0x49, 0x45,
@@ -154,10 +163,15 @@ var file_achievement_service_v1_achievement_error_proto_rawDesc = []byte{
 	0x49, 0x45, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x46,
 	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x44, 0x55, 0x43,
 	0x45, 0x5f, 0x41, 0x43, 0x48, 0x49, 0x45, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43,
-	0x4f, 0x52, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x10, 0x1a, 0x04, 0xa0, 0x45,
-	0xf4, 0x03, 0x42, 0x22, 0x5a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x62, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x52, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16,
+	0x41, 0x44, 0x44, 0x5f, 0x41, 0x43, 0x48, 0x49, 0x45, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x11, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x45, 0x54, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x12, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x52, 0x47, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x13, 0x1a, 0x04,
+	0xa0, 0x45, 0xf4, 0x03, 0x42, 0x22, 0x5a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -214,3 +214,39 @@ func IsReduceAchievementScoreFailed(err error) bool {
 func ErrorReduceAchievementScoreFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, AchievementErrorReason_REDUCE_ACHIEVEMENT_SCORE_FAILED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsAddAchievementFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AchievementErrorReason_ADD_ACHIEVEMENT_FAILED.String() && e.Code == 500
+}
+
+func ErrorAddAchievementFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, AchievementErrorReason_ADD_ACHIEVEMENT_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AchievementErrorReason_GET_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, AchievementErrorReason_GET_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPurgeUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AchievementErrorReason_PURGE_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorPurgeUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, AchievementErrorReason_PURGE_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
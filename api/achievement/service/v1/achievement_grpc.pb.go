@@ -41,6 +41,10 @@ type AchievementClient interface {
 	GetUserMedal(ctx context.Context, in *GetUserMedalReq, opts ...grpc.CallOption) (*GetUserMedalReply, error)
 	GetUserActive(ctx context.Context, in *GetUserActiveReq, opts ...grpc.CallOption) (*GetUserActiveReply, error)
 	AddAchievementScore(ctx context.Context, in *AddAchievementScoreReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddAchievementDbAndCache(ctx context.Context, in *AddAchievementDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error)
+	PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetScoreRank(ctx context.Context, in *GetScoreRankReq, opts ...grpc.CallOption) (*GetScoreRankReply, error)
 	GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -214,6 +218,42 @@ func (c *achievementClient) AddAchievementScore(ctx context.Context, in *AddAchi
 	return out, nil
 }
 
+func (c *achievementClient) AddAchievementDbAndCache(ctx context.Context, in *AddAchievementDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/achievement.v1.Achievement/AddAchievementDbAndCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *achievementClient) GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error) {
+	out := new(GetUserDataReply)
+	err := c.cc.Invoke(ctx, "/achievement.v1.Achievement/GetUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *achievementClient) PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/achievement.v1.Achievement/PurgeUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *achievementClient) GetScoreRank(ctx context.Context, in *GetScoreRankReq, opts ...grpc.CallOption) (*GetScoreRankReply, error) {
+	out := new(GetScoreRankReply)
+	err := c.cc.Invoke(ctx, "/achievement.v1.Achievement/GetScoreRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *achievementClient) GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/achievement.v1.Achievement/GetHealth", in, out, opts...)
@@ -245,6 +285,10 @@ type AchievementServer interface {
 	GetUserMedal(context.Context, *GetUserMedalReq) (*GetUserMedalReply, error)
 	GetUserActive(context.Context, *GetUserActiveReq) (*GetUserActiveReply, error)
 	AddAchievementScore(context.Context, *AddAchievementScoreReq) (*emptypb.Empty, error)
+	AddAchievementDbAndCache(context.Context, *AddAchievementDbAndCacheReq) (*emptypb.Empty, error)
+	GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error)
+	PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error)
+	GetScoreRank(context.Context, *GetScoreRankReq) (*GetScoreRankReply, error)
 	GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAchievementServer()
 }
@@ -307,6 +351,18 @@ func (UnimplementedAchievementServer) GetUserActive(context.Context, *GetUserAct
 func (UnimplementedAchievementServer) AddAchievementScore(context.Context, *AddAchievementScoreReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAchievementScore not implemented")
 }
+func (UnimplementedAchievementServer) AddAchievementDbAndCache(context.Context, *AddAchievementDbAndCacheReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAchievementDbAndCache not implemented")
+}
+func (UnimplementedAchievementServer) GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
+}
+func (UnimplementedAchievementServer) PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserData not implemented")
+}
+func (UnimplementedAchievementServer) GetScoreRank(context.Context, *GetScoreRankReq) (*GetScoreRankReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreRank not implemented")
+}
 func (UnimplementedAchievementServer) GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
 }
@@ -647,6 +703,78 @@ func _Achievement_AddAchievementScore_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Achievement_AddAchievementDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAchievementDbAndCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AchievementServer).AddAchievementDbAndCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/achievement.v1.Achievement/AddAchievementDbAndCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AchievementServer).AddAchievementDbAndCache(ctx, req.(*AddAchievementDbAndCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Achievement_GetUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AchievementServer).GetUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/achievement.v1.Achievement/GetUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AchievementServer).GetUserData(ctx, req.(*GetUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Achievement_PurgeUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AchievementServer).PurgeUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/achievement.v1.Achievement/PurgeUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AchievementServer).PurgeUserData(ctx, req.(*PurgeUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Achievement_GetScoreRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreRankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AchievementServer).GetScoreRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/achievement.v1.Achievement/GetScoreRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AchievementServer).GetScoreRank(ctx, req.(*GetScoreRankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Achievement_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -744,6 +872,22 @@ var Achievement_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddAchievementScore",
 			Handler:    _Achievement_AddAchievementScore_Handler,
 		},
+		{
+			MethodName: "AddAchievementDbAndCache",
+			Handler:    _Achievement_AddAchievementDbAndCache_Handler,
+		},
+		{
+			MethodName: "GetUserData",
+			Handler:    _Achievement_GetUserData_Handler,
+		},
+		{
+			MethodName: "PurgeUserData",
+			Handler:    _Achievement_PurgeUserData_Handler,
+		},
+		{
+			MethodName: "GetScoreRank",
+			Handler:    _Achievement_GetScoreRank_Handler,
+		},
 		{
 			MethodName: "GetHealth",
 			Handler:    _Achievement_GetHealth_Handler,
@@ -24,16 +24,19 @@ const (
 type BffErrorReason int32
 
 const (
-	BffErrorReason_UNKNOWN_ERROR BffErrorReason = 0
+	BffErrorReason_UNKNOWN_ERROR     BffErrorReason = 0
+	BffErrorReason_PERMISSION_DENIED BffErrorReason = 1
 )
 
 // Enum value maps for BffErrorReason.
 var (
 	BffErrorReason_name = map[int32]string{
 		0: "UNKNOWN_ERROR",
+		1: "PERMISSION_DENIED",
 	}
 	BffErrorReason_value = map[string]int32{
-		"UNKNOWN_ERROR": 0,
+		"UNKNOWN_ERROR":     0,
+		"PERMISSION_DENIED": 1,
 	}
 )
 
@@ -71,11 +74,13 @@ var file_bff_interface_v1_bff_error_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x62, 0x66, 0x66, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x06, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a,
-	0x29, 0x0a, 0x0e, 0x42, 0x66, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x46, 0x0a, 0x0e, 0x42, 0x66, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f,
 	0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x00, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x19, 0x5a, 0x17, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x66, 0x66, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x52, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93,
+	0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x19, 0x5a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x66, 0x66, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -22,3 +22,15 @@ func IsUnknownError(err error) bool {
 func ErrorUnknownError(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, BffErrorReason_UNKNOWN_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+func IsPermissionDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == BffErrorReason_PERMISSION_DENIED.String() && e.Code == 403
+}
+
+func ErrorPermissionDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, BffErrorReason_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
+}
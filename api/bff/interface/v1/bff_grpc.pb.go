@@ -27,11 +27,17 @@ type BffClient interface {
 	UserRegister(ctx context.Context, in *UserRegisterReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	LoginByPassword(ctx context.Context, in *LoginByPasswordReq, opts ...grpc.CallOption) (*LoginReply, error)
 	LoginByCode(ctx context.Context, in *LoginByCodeReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByWeChat(ctx context.Context, in *LoginByWeChatReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByQQ(ctx context.Context, in *LoginByQQReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByGithub(ctx context.Context, in *LoginByGithubReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByGitee(ctx context.Context, in *LoginByGiteeReq, opts ...grpc.CallOption) (*LoginReply, error)
+	GetOauthUrl(ctx context.Context, in *GetOauthUrlReq, opts ...grpc.CallOption) (*GetOauthUrlReply, error)
+	LoginByOauth(ctx context.Context, in *LoginByOauthReq, opts ...grpc.CallOption) (*LoginReply, error)
 	LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*LoginReply, error)
+	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogoutAllDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	LoginByTotp(ctx context.Context, in *LoginByTotpReq, opts ...grpc.CallOption) (*LoginReply, error)
+	EnrollTotp(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EnrollTotpReply, error)
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...grpc.CallOption) (*ConfirmTotpReply, error)
+	DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendPhoneCode(ctx context.Context, in *SendPhoneCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendEmailCode(ctx context.Context, in *SendEmailCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCosSessionKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCosSessionKeyReply, error)
@@ -55,19 +61,29 @@ type BffClient interface {
 	SetUserPhone(ctx context.Context, in *SetUserPhoneReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserEmail(ctx context.Context, in *SetUserEmailReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserPassword(ctx context.Context, in *SetUserPasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserWechat(ctx context.Context, in *SetUserWechatReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserQQ(ctx context.Context, in *SetUserQQReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserGitee(ctx context.Context, in *SetUserGiteeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserGithub(ctx context.Context, in *SetUserGithubReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserIdentity(ctx context.Context, in *SetUserIdentityReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserFollow(ctx context.Context, in *SetUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelUserFollow(ctx context.Context, in *CancelUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MuteUser(ctx context.Context, in *MuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserBlocks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserBlocksReply, error)
+	GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsReq, opts ...grpc.CallOption) (*GetFollowRecommendationsReply, error)
+	SetProfilePrivate(ctx context.Context, in *SetProfilePrivateReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFollowRequests(ctx context.Context, in *GetFollowRequestsReq, opts ...grpc.CallOption) (*GetFollowRequestsReply, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserHandle(ctx context.Context, in *SetUserHandleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserHandle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserHandleReply, error)
+	GetUserByHandle(ctx context.Context, in *GetUserByHandleReq, opts ...grpc.CallOption) (*GetUserByHandleReply, error)
 	ChangeUserPassword(ctx context.Context, in *ChangeUserPasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnbindUserPhone(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnbindUserEmail(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserWechat(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserQQ(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserGitee(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserGithub(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnbindUserIdentity(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAccount(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportUserData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportUserDataReply, error)
+	GetUserDataExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserDataExportReply, error)
 	// -------------------------creation----------------------------
 	GetLeaderBoard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLeaderBoardReply, error)
 	GetCollectArticleList(ctx context.Context, in *GetCollectArticleListReq, opts ...grpc.CallOption) (*GetArticleListReply, error)
@@ -215,11 +231,25 @@ type BffClient interface {
 	CancelSubCommentAgree(ctx context.Context, in *CancelSubCommentAgreeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetMessageNotification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMessageNotificationReply, error)
 	GetMessageSystemNotification(ctx context.Context, in *GetMessageSystemNotificationReq, opts ...grpc.CallOption) (*GetMessageSystemNotificationReply, error)
+	GetMessageMention(ctx context.Context, in *GetMessageMentionReq, opts ...grpc.CallOption) (*GetMessageMentionReply, error)
 	GetMailBoxLastTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMailBoxLastTimeReply, error)
 	SetMailBoxLastTime(ctx context.Context, in *SetMailBoxLastTimeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMailBoxCommentCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMailBoxSubCommentCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMailBoxSystemNotificationCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveMailBoxMentionCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AppealReview(ctx context.Context, in *AppealReviewReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserAppealList(ctx context.Context, in *GetUserAppealListReq, opts ...grpc.CallOption) (*GetAppealListReply, error)
+	GetContentFlagList(ctx context.Context, in *GetContentFlagListReq, opts ...grpc.CallOption) (*GetContentFlagListReply, error)
+	PublishContentFlag(ctx context.Context, in *PublishContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveContentFlag(ctx context.Context, in *RemoveContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...grpc.CallOption) (*GetAuditLogListReply, error)
+	GetAppealList(ctx context.Context, in *GetAppealListReq, opts ...grpc.CallOption) (*GetAppealListReply, error)
+	AcceptAppeal(ctx context.Context, in *AcceptAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectAppeal(ctx context.Context, in *RejectAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type bffClient struct {
@@ -266,45 +296,99 @@ func (c *bffClient) LoginByCode(ctx context.Context, in *LoginByCodeReq, opts ..
 	return out, nil
 }
 
-func (c *bffClient) LoginByWeChat(ctx context.Context, in *LoginByWeChatReq, opts ...grpc.CallOption) (*LoginReply, error) {
-	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginByWeChat", in, out, opts...)
+func (c *bffClient) GetOauthUrl(ctx context.Context, in *GetOauthUrlReq, opts ...grpc.CallOption) (*GetOauthUrlReply, error) {
+	out := new(GetOauthUrlReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetOauthUrl", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) LoginByQQ(ctx context.Context, in *LoginByQQReq, opts ...grpc.CallOption) (*LoginReply, error) {
+func (c *bffClient) LoginByOauth(ctx context.Context, in *LoginByOauthReq, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginByQQ", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginByOauth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginPasswordReset", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) LoginByGithub(ctx context.Context, in *LoginByGithubReq, opts ...grpc.CallOption) (*LoginReply, error) {
+func (c *bffClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginByGithub", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) LogoutAllDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LogoutAllDevices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) LoginByGitee(ctx context.Context, in *LoginByGiteeReq, opts ...grpc.CallOption) (*LoginReply, error) {
+func (c *bffClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) LoginByTotp(ctx context.Context, in *LoginByTotpReq, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginByGitee", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginByTotp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) EnrollTotp(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EnrollTotpReply, error) {
+	out := new(EnrollTotpReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/EnrollTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...grpc.CallOption) (*ConfirmTotpReply, error) {
+	out := new(ConfirmTotpReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/ConfirmTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/LoginPasswordReset", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/DisableTotp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -518,54 +602,144 @@ func (c *bffClient) SetUserPassword(ctx context.Context, in *SetUserPasswordReq,
 	return out, nil
 }
 
-func (c *bffClient) SetUserWechat(ctx context.Context, in *SetUserWechatReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) SetUserIdentity(ctx context.Context, in *SetUserIdentityReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserWechat", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserIdentity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) SetUserQQ(ctx context.Context, in *SetUserQQReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) SetUserFollow(ctx context.Context, in *SetUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserQQ", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserFollow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) SetUserGitee(ctx context.Context, in *SetUserGiteeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) CancelUserFollow(ctx context.Context, in *CancelUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserGitee", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/CancelUserFollow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) SetUserGithub(ctx context.Context, in *SetUserGithubReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserGithub", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/BlockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) SetUserFollow(ctx context.Context, in *SetUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserFollow", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnblockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) CancelUserFollow(ctx context.Context, in *CancelUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) MuteUser(ctx context.Context, in *MuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/CancelUserFollow", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/MuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) UnmuteUser(ctx context.Context, in *UnmuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnmuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetUserBlocks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserBlocksReply, error) {
+	out := new(GetUserBlocksReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetUserBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsReq, opts ...grpc.CallOption) (*GetFollowRecommendationsReply, error) {
+	out := new(GetFollowRecommendationsReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetFollowRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) SetProfilePrivate(ctx context.Context, in *SetProfilePrivateReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetProfilePrivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetFollowRequests(ctx context.Context, in *GetFollowRequestsReq, opts ...grpc.CallOption) (*GetFollowRequestsReply, error) {
+	out := new(GetFollowRequestsReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetFollowRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/ApproveFollowRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/RejectFollowRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) SetUserHandle(ctx context.Context, in *SetUserHandleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetUserHandle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserHandleReply, error) {
+	out := new(GetUserHandleReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetUserHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetUserByHandle(ctx context.Context, in *GetUserByHandleReq, opts ...grpc.CallOption) (*GetUserByHandleReply, error) {
+	out := new(GetUserByHandleReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetUserByHandle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -599,36 +773,36 @@ func (c *bffClient) UnbindUserEmail(ctx context.Context, in *UnbindUserAccountRe
 	return out, nil
 }
 
-func (c *bffClient) UnbindUserWechat(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) UnbindUserIdentity(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnbindUserWechat", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnbindUserIdentity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) UnbindUserQQ(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *bffClient) DeleteAccount(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnbindUserQQ", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/DeleteAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) UnbindUserGitee(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnbindUserGitee", in, out, opts...)
+func (c *bffClient) ExportUserData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportUserDataReply, error) {
+	out := new(ExportUserDataReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/ExportUserData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bffClient) UnbindUserGithub(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnbindUserGithub", in, out, opts...)
+func (c *bffClient) GetUserDataExport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUserDataExportReply, error) {
+	out := new(GetUserDataExportReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetUserDataExport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1949,6 +2123,15 @@ func (c *bffClient) GetMessageSystemNotification(ctx context.Context, in *GetMes
 	return out, nil
 }
 
+func (c *bffClient) GetMessageMention(ctx context.Context, in *GetMessageMentionReq, opts ...grpc.CallOption) (*GetMessageMentionReply, error) {
+	out := new(GetMessageMentionReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetMessageMention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bffClient) GetMailBoxLastTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMailBoxLastTimeReply, error) {
 	out := new(GetMailBoxLastTimeReply)
 	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetMailBoxLastTime", in, out, opts...)
@@ -1994,6 +2177,123 @@ func (c *bffClient) RemoveMailBoxSystemNotificationCount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *bffClient) RemoveMailBoxMentionCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/RemoveMailBoxMentionCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) AppealReview(ctx context.Context, in *AppealReviewReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/AppealReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetUserAppealList(ctx context.Context, in *GetUserAppealListReq, opts ...grpc.CallOption) (*GetAppealListReply, error) {
+	out := new(GetAppealListReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetUserAppealList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetContentFlagList(ctx context.Context, in *GetContentFlagListReq, opts ...grpc.CallOption) (*GetContentFlagListReply, error) {
+	out := new(GetContentFlagListReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetContentFlagList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) PublishContentFlag(ctx context.Context, in *PublishContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/PublishContentFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) RemoveContentFlag(ctx context.Context, in *RemoveContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/RemoveContentFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/UnsuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/SetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...grpc.CallOption) (*GetAuditLogListReply, error) {
+	out := new(GetAuditLogListReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetAuditLogList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) GetAppealList(ctx context.Context, in *GetAppealListReq, opts ...grpc.CallOption) (*GetAppealListReply, error) {
+	out := new(GetAppealListReply)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/GetAppealList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) AcceptAppeal(ctx context.Context, in *AcceptAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/AcceptAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) RejectAppeal(ctx context.Context, in *RejectAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/bff.v1.Bff/RejectAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BffServer is the server API for Bff service.
 // All implementations must embed UnimplementedBffServer
 // for forward compatibility
@@ -2002,11 +2302,17 @@ type BffServer interface {
 	UserRegister(context.Context, *UserRegisterReq) (*emptypb.Empty, error)
 	LoginByPassword(context.Context, *LoginByPasswordReq) (*LoginReply, error)
 	LoginByCode(context.Context, *LoginByCodeReq) (*LoginReply, error)
-	LoginByWeChat(context.Context, *LoginByWeChatReq) (*LoginReply, error)
-	LoginByQQ(context.Context, *LoginByQQReq) (*LoginReply, error)
-	LoginByGithub(context.Context, *LoginByGithubReq) (*LoginReply, error)
-	LoginByGitee(context.Context, *LoginByGiteeReq) (*LoginReply, error)
+	GetOauthUrl(context.Context, *GetOauthUrlReq) (*GetOauthUrlReply, error)
+	LoginByOauth(context.Context, *LoginByOauthReq) (*LoginReply, error)
 	LoginPasswordReset(context.Context, *LoginPasswordResetReq) (*emptypb.Empty, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*LoginReply, error)
+	Logout(context.Context, *LogoutReq) (*emptypb.Empty, error)
+	LogoutAllDevices(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	ListSessions(context.Context, *emptypb.Empty) (*ListSessionsReply, error)
+	LoginByTotp(context.Context, *LoginByTotpReq) (*LoginReply, error)
+	EnrollTotp(context.Context, *emptypb.Empty) (*EnrollTotpReply, error)
+	ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpReply, error)
+	DisableTotp(context.Context, *DisableTotpReq) (*emptypb.Empty, error)
 	SendPhoneCode(context.Context, *SendPhoneCodeReq) (*emptypb.Empty, error)
 	SendEmailCode(context.Context, *SendEmailCodeReq) (*emptypb.Empty, error)
 	GetCosSessionKey(context.Context, *emptypb.Empty) (*GetCosSessionKeyReply, error)
@@ -2030,19 +2336,29 @@ type BffServer interface {
 	SetUserPhone(context.Context, *SetUserPhoneReq) (*emptypb.Empty, error)
 	SetUserEmail(context.Context, *SetUserEmailReq) (*emptypb.Empty, error)
 	SetUserPassword(context.Context, *SetUserPasswordReq) (*emptypb.Empty, error)
-	SetUserWechat(context.Context, *SetUserWechatReq) (*emptypb.Empty, error)
-	SetUserQQ(context.Context, *SetUserQQReq) (*emptypb.Empty, error)
-	SetUserGitee(context.Context, *SetUserGiteeReq) (*emptypb.Empty, error)
-	SetUserGithub(context.Context, *SetUserGithubReq) (*emptypb.Empty, error)
+	SetUserIdentity(context.Context, *SetUserIdentityReq) (*emptypb.Empty, error)
 	SetUserFollow(context.Context, *SetUserFollowReq) (*emptypb.Empty, error)
 	CancelUserFollow(context.Context, *CancelUserFollowReq) (*emptypb.Empty, error)
+	BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error)
+	MuteUser(context.Context, *MuteUserReq) (*emptypb.Empty, error)
+	UnmuteUser(context.Context, *UnmuteUserReq) (*emptypb.Empty, error)
+	GetUserBlocks(context.Context, *emptypb.Empty) (*GetUserBlocksReply, error)
+	GetFollowRecommendations(context.Context, *GetFollowRecommendationsReq) (*GetFollowRecommendationsReply, error)
+	SetProfilePrivate(context.Context, *SetProfilePrivateReq) (*emptypb.Empty, error)
+	GetFollowRequests(context.Context, *GetFollowRequestsReq) (*GetFollowRequestsReply, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestReq) (*emptypb.Empty, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestReq) (*emptypb.Empty, error)
+	SetUserHandle(context.Context, *SetUserHandleReq) (*emptypb.Empty, error)
+	GetUserHandle(context.Context, *emptypb.Empty) (*GetUserHandleReply, error)
+	GetUserByHandle(context.Context, *GetUserByHandleReq) (*GetUserByHandleReply, error)
 	ChangeUserPassword(context.Context, *ChangeUserPasswordReq) (*emptypb.Empty, error)
 	UnbindUserPhone(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
 	UnbindUserEmail(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserWechat(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserQQ(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserGitee(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserGithub(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	UnbindUserIdentity(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	DeleteAccount(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	ExportUserData(context.Context, *emptypb.Empty) (*ExportUserDataReply, error)
+	GetUserDataExport(context.Context, *emptypb.Empty) (*GetUserDataExportReply, error)
 	// -------------------------creation----------------------------
 	GetLeaderBoard(context.Context, *emptypb.Empty) (*GetLeaderBoardReply, error)
 	GetCollectArticleList(context.Context, *GetCollectArticleListReq) (*GetArticleListReply, error)
@@ -2190,11 +2506,25 @@ type BffServer interface {
 	CancelSubCommentAgree(context.Context, *CancelSubCommentAgreeReq) (*emptypb.Empty, error)
 	GetMessageNotification(context.Context, *emptypb.Empty) (*GetMessageNotificationReply, error)
 	GetMessageSystemNotification(context.Context, *GetMessageSystemNotificationReq) (*GetMessageSystemNotificationReply, error)
+	GetMessageMention(context.Context, *GetMessageMentionReq) (*GetMessageMentionReply, error)
 	GetMailBoxLastTime(context.Context, *emptypb.Empty) (*GetMailBoxLastTimeReply, error)
 	SetMailBoxLastTime(context.Context, *SetMailBoxLastTimeReq) (*emptypb.Empty, error)
 	RemoveMailBoxCommentCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	RemoveMailBoxSubCommentCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	RemoveMailBoxSystemNotificationCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	RemoveMailBoxMentionCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	AppealReview(context.Context, *AppealReviewReq) (*emptypb.Empty, error)
+	GetUserAppealList(context.Context, *GetUserAppealListReq) (*GetAppealListReply, error)
+	GetContentFlagList(context.Context, *GetContentFlagListReq) (*GetContentFlagListReply, error)
+	PublishContentFlag(context.Context, *PublishContentFlagReq) (*emptypb.Empty, error)
+	RemoveContentFlag(context.Context, *RemoveContentFlagReq) (*emptypb.Empty, error)
+	SuspendUser(context.Context, *SuspendUserReq) (*emptypb.Empty, error)
+	UnsuspendUser(context.Context, *UnsuspendUserReq) (*emptypb.Empty, error)
+	SetUserRole(context.Context, *SetUserRoleReq) (*emptypb.Empty, error)
+	GetAuditLogList(context.Context, *GetAuditLogListReq) (*GetAuditLogListReply, error)
+	GetAppealList(context.Context, *GetAppealListReq) (*GetAppealListReply, error)
+	AcceptAppeal(context.Context, *AcceptAppealReq) (*emptypb.Empty, error)
+	RejectAppeal(context.Context, *RejectAppealReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedBffServer()
 }
 
@@ -2214,21 +2544,39 @@ func (UnimplementedBffServer) LoginByPassword(context.Context, *LoginByPasswordR
 func (UnimplementedBffServer) LoginByCode(context.Context, *LoginByCodeReq) (*LoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginByCode not implemented")
 }
-func (UnimplementedBffServer) LoginByWeChat(context.Context, *LoginByWeChatReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByWeChat not implemented")
-}
-func (UnimplementedBffServer) LoginByQQ(context.Context, *LoginByQQReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByQQ not implemented")
+func (UnimplementedBffServer) GetOauthUrl(context.Context, *GetOauthUrlReq) (*GetOauthUrlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthUrl not implemented")
 }
-func (UnimplementedBffServer) LoginByGithub(context.Context, *LoginByGithubReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByGithub not implemented")
-}
-func (UnimplementedBffServer) LoginByGitee(context.Context, *LoginByGiteeReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByGitee not implemented")
+func (UnimplementedBffServer) LoginByOauth(context.Context, *LoginByOauthReq) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByOauth not implemented")
 }
 func (UnimplementedBffServer) LoginPasswordReset(context.Context, *LoginPasswordResetReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginPasswordReset not implemented")
 }
+func (UnimplementedBffServer) RefreshToken(context.Context, *RefreshTokenReq) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedBffServer) Logout(context.Context, *LogoutReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedBffServer) LogoutAllDevices(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllDevices not implemented")
+}
+func (UnimplementedBffServer) ListSessions(context.Context, *emptypb.Empty) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedBffServer) LoginByTotp(context.Context, *LoginByTotpReq) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByTotp not implemented")
+}
+func (UnimplementedBffServer) EnrollTotp(context.Context, *emptypb.Empty) (*EnrollTotpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedBffServer) ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (UnimplementedBffServer) DisableTotp(context.Context, *DisableTotpReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
 func (UnimplementedBffServer) SendPhoneCode(context.Context, *SendPhoneCodeReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPhoneCode not implemented")
 }
@@ -2298,17 +2646,8 @@ func (UnimplementedBffServer) SetUserEmail(context.Context, *SetUserEmailReq) (*
 func (UnimplementedBffServer) SetUserPassword(context.Context, *SetUserPasswordReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserPassword not implemented")
 }
-func (UnimplementedBffServer) SetUserWechat(context.Context, *SetUserWechatReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserWechat not implemented")
-}
-func (UnimplementedBffServer) SetUserQQ(context.Context, *SetUserQQReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserQQ not implemented")
-}
-func (UnimplementedBffServer) SetUserGitee(context.Context, *SetUserGiteeReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserGitee not implemented")
-}
-func (UnimplementedBffServer) SetUserGithub(context.Context, *SetUserGithubReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserGithub not implemented")
+func (UnimplementedBffServer) SetUserIdentity(context.Context, *SetUserIdentityReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserIdentity not implemented")
 }
 func (UnimplementedBffServer) SetUserFollow(context.Context, *SetUserFollowReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserFollow not implemented")
@@ -2316,6 +2655,45 @@ func (UnimplementedBffServer) SetUserFollow(context.Context, *SetUserFollowReq)
 func (UnimplementedBffServer) CancelUserFollow(context.Context, *CancelUserFollowReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUserFollow not implemented")
 }
+func (UnimplementedBffServer) BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedBffServer) UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedBffServer) MuteUser(context.Context, *MuteUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedBffServer) UnmuteUser(context.Context, *UnmuteUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedBffServer) GetUserBlocks(context.Context, *emptypb.Empty) (*GetUserBlocksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBlocks not implemented")
+}
+func (UnimplementedBffServer) GetFollowRecommendations(context.Context, *GetFollowRecommendationsReq) (*GetFollowRecommendationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRecommendations not implemented")
+}
+func (UnimplementedBffServer) SetProfilePrivate(context.Context, *SetProfilePrivateReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfilePrivate not implemented")
+}
+func (UnimplementedBffServer) GetFollowRequests(context.Context, *GetFollowRequestsReq) (*GetFollowRequestsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRequests not implemented")
+}
+func (UnimplementedBffServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedBffServer) RejectFollowRequest(context.Context, *RejectFollowRequestReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedBffServer) SetUserHandle(context.Context, *SetUserHandleReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserHandle not implemented")
+}
+func (UnimplementedBffServer) GetUserHandle(context.Context, *emptypb.Empty) (*GetUserHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserHandle not implemented")
+}
+func (UnimplementedBffServer) GetUserByHandle(context.Context, *GetUserByHandleReq) (*GetUserByHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByHandle not implemented")
+}
 func (UnimplementedBffServer) ChangeUserPassword(context.Context, *ChangeUserPasswordReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserPassword not implemented")
 }
@@ -2325,17 +2703,17 @@ func (UnimplementedBffServer) UnbindUserPhone(context.Context, *UnbindUserAccoun
 func (UnimplementedBffServer) UnbindUserEmail(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserEmail not implemented")
 }
-func (UnimplementedBffServer) UnbindUserWechat(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserWechat not implemented")
+func (UnimplementedBffServer) UnbindUserIdentity(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserIdentity not implemented")
 }
-func (UnimplementedBffServer) UnbindUserQQ(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserQQ not implemented")
+func (UnimplementedBffServer) DeleteAccount(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
-func (UnimplementedBffServer) UnbindUserGitee(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserGitee not implemented")
+func (UnimplementedBffServer) ExportUserData(context.Context, *emptypb.Empty) (*ExportUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
 }
-func (UnimplementedBffServer) UnbindUserGithub(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserGithub not implemented")
+func (UnimplementedBffServer) GetUserDataExport(context.Context, *emptypb.Empty) (*GetUserDataExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDataExport not implemented")
 }
 func (UnimplementedBffServer) GetLeaderBoard(context.Context, *emptypb.Empty) (*GetLeaderBoardReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderBoard not implemented")
@@ -2775,6 +3153,9 @@ func (UnimplementedBffServer) GetMessageNotification(context.Context, *emptypb.E
 func (UnimplementedBffServer) GetMessageSystemNotification(context.Context, *GetMessageSystemNotificationReq) (*GetMessageSystemNotificationReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessageSystemNotification not implemented")
 }
+func (UnimplementedBffServer) GetMessageMention(context.Context, *GetMessageMentionReq) (*GetMessageMentionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageMention not implemented")
+}
 func (UnimplementedBffServer) GetMailBoxLastTime(context.Context, *emptypb.Empty) (*GetMailBoxLastTimeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMailBoxLastTime not implemented")
 }
@@ -2790,6 +3171,45 @@ func (UnimplementedBffServer) RemoveMailBoxSubCommentCount(context.Context, *emp
 func (UnimplementedBffServer) RemoveMailBoxSystemNotificationCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMailBoxSystemNotificationCount not implemented")
 }
+func (UnimplementedBffServer) RemoveMailBoxMentionCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMailBoxMentionCount not implemented")
+}
+func (UnimplementedBffServer) AppealReview(context.Context, *AppealReviewReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+}
+func (UnimplementedBffServer) GetUserAppealList(context.Context, *GetUserAppealListReq) (*GetAppealListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAppealList not implemented")
+}
+func (UnimplementedBffServer) GetContentFlagList(context.Context, *GetContentFlagListReq) (*GetContentFlagListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentFlagList not implemented")
+}
+func (UnimplementedBffServer) PublishContentFlag(context.Context, *PublishContentFlagReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishContentFlag not implemented")
+}
+func (UnimplementedBffServer) RemoveContentFlag(context.Context, *RemoveContentFlagReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContentFlag not implemented")
+}
+func (UnimplementedBffServer) SuspendUser(context.Context, *SuspendUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedBffServer) UnsuspendUser(context.Context, *UnsuspendUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendUser not implemented")
+}
+func (UnimplementedBffServer) SetUserRole(context.Context, *SetUserRoleReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedBffServer) GetAuditLogList(context.Context, *GetAuditLogListReq) (*GetAuditLogListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogList not implemented")
+}
+func (UnimplementedBffServer) GetAppealList(context.Context, *GetAppealListReq) (*GetAppealListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppealList not implemented")
+}
+func (UnimplementedBffServer) AcceptAppeal(context.Context, *AcceptAppealReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAppeal not implemented")
+}
+func (UnimplementedBffServer) RejectAppeal(context.Context, *RejectAppealReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAppeal not implemented")
+}
 func (UnimplementedBffServer) mustEmbedUnimplementedBffServer() {}
 
 // UnsafeBffServer may be embedded to opt out of forward compatibility for this service.
@@ -2875,92 +3295,200 @@ func _Bff_LoginByCode_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_LoginByWeChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByWeChatReq)
+func _Bff_GetOauthUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOauthUrlReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).LoginByWeChat(ctx, in)
+		return srv.(BffServer).GetOauthUrl(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/LoginByWeChat",
+		FullMethod: "/bff.v1.Bff/GetOauthUrl",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).LoginByWeChat(ctx, req.(*LoginByWeChatReq))
+		return srv.(BffServer).GetOauthUrl(ctx, req.(*GetOauthUrlReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_LoginByQQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByQQReq)
+func _Bff_LoginByOauth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByOauthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).LoginByQQ(ctx, in)
+		return srv.(BffServer).LoginByOauth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/LoginByQQ",
+		FullMethod: "/bff.v1.Bff/LoginByOauth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).LoginByQQ(ctx, req.(*LoginByQQReq))
+		return srv.(BffServer).LoginByOauth(ctx, req.(*LoginByOauthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_LoginByGithub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByGithubReq)
+func _Bff_LoginPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginPasswordResetReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).LoginByGithub(ctx, in)
+		return srv.(BffServer).LoginPasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/LoginByGithub",
+		FullMethod: "/bff.v1.Bff/LoginPasswordReset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).LoginByGithub(ctx, req.(*LoginByGithubReq))
+		return srv.(BffServer).LoginPasswordReset(ctx, req.(*LoginPasswordResetReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_LoginByGitee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByGiteeReq)
+func _Bff_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).LoginByGitee(ctx, in)
+		return srv.(BffServer).RefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/LoginByGitee",
+		FullMethod: "/bff.v1.Bff/RefreshToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).LoginByGitee(ctx, req.(*LoginByGiteeReq))
+		return srv.(BffServer).RefreshToken(ctx, req.(*RefreshTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_LoginPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginPasswordResetReq)
+func _Bff_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).LoginPasswordReset(ctx, in)
+		return srv.(BffServer).Logout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/LoginPasswordReset",
+		FullMethod: "/bff.v1.Bff/Logout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).LoginPasswordReset(ctx, req.(*LoginPasswordResetReq))
+		return srv.(BffServer).Logout(ctx, req.(*LogoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_LogoutAllDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).LogoutAllDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/LogoutAllDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).LogoutAllDevices(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).ListSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_LoginByTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).LoginByTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/LoginByTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).LoginByTotp(ctx, req.(*LoginByTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/EnrollTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).EnrollTotp(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).ConfirmTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/ConfirmTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).ConfirmTotp(ctx, req.(*ConfirmTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/DisableTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).DisableTotp(ctx, req.(*DisableTotpReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3379,110 +3907,290 @@ func _Bff_SetUserPassword_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_SetUserWechat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserWechatReq)
+func _Bff_SetUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserIdentityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).SetUserWechat(ctx, in)
+		return srv.(BffServer).SetUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/SetUserWechat",
+		FullMethod: "/bff.v1.Bff/SetUserIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).SetUserWechat(ctx, req.(*SetUserWechatReq))
+		return srv.(BffServer).SetUserIdentity(ctx, req.(*SetUserIdentityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_SetUserQQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserQQReq)
+func _Bff_SetUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFollowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).SetUserQQ(ctx, in)
+		return srv.(BffServer).SetUserFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/SetUserQQ",
+		FullMethod: "/bff.v1.Bff/SetUserFollow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).SetUserQQ(ctx, req.(*SetUserQQReq))
+		return srv.(BffServer).SetUserFollow(ctx, req.(*SetUserFollowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_SetUserGitee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserGiteeReq)
+func _Bff_CancelUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelUserFollowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).SetUserGitee(ctx, in)
+		return srv.(BffServer).CancelUserFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/SetUserGitee",
+		FullMethod: "/bff.v1.Bff/CancelUserFollow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).SetUserGitee(ctx, req.(*SetUserGiteeReq))
+		return srv.(BffServer).CancelUserFollow(ctx, req.(*CancelUserFollowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_SetUserGithub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserGithubReq)
+func _Bff_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).SetUserGithub(ctx, in)
+		return srv.(BffServer).BlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/SetUserGithub",
+		FullMethod: "/bff.v1.Bff/BlockUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).SetUserGithub(ctx, req.(*SetUserGithubReq))
+		return srv.(BffServer).BlockUser(ctx, req.(*BlockUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_SetUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserFollowReq)
+func _Bff_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).SetUserFollow(ctx, in)
+		return srv.(BffServer).UnblockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/SetUserFollow",
+		FullMethod: "/bff.v1.Bff/UnblockUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).SetUserFollow(ctx, req.(*SetUserFollowReq))
+		return srv.(BffServer).UnblockUser(ctx, req.(*UnblockUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_CancelUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelUserFollowReq)
+func _Bff_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).CancelUserFollow(ctx, in)
+		return srv.(BffServer).MuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/CancelUserFollow",
+		FullMethod: "/bff.v1.Bff/MuteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).CancelUserFollow(ctx, req.(*CancelUserFollowReq))
+		return srv.(BffServer).MuteUser(ctx, req.(*MuteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/UnmuteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).UnmuteUser(ctx, req.(*UnmuteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetUserBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetUserBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetUserBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetUserBlocks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetFollowRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRecommendationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetFollowRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetFollowRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetFollowRecommendations(ctx, req.(*GetFollowRecommendationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_SetProfilePrivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfilePrivateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).SetProfilePrivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/SetProfilePrivate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).SetProfilePrivate(ctx, req.(*SetProfilePrivateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetFollowRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetFollowRequests(ctx, req.(*GetFollowRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/ApproveFollowRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/RejectFollowRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_SetUserHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserHandleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).SetUserHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/SetUserHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).SetUserHandle(ctx, req.(*SetUserHandleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetUserHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetUserHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetUserHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetUserHandle(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetUserByHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByHandleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetUserByHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetUserByHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetUserByHandle(ctx, req.(*GetUserByHandleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3541,74 +4249,74 @@ func _Bff_UnbindUserEmail_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_UnbindUserWechat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bff_UnbindUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnbindUserAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).UnbindUserWechat(ctx, in)
+		return srv.(BffServer).UnbindUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/UnbindUserWechat",
+		FullMethod: "/bff.v1.Bff/UnbindUserIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).UnbindUserWechat(ctx, req.(*UnbindUserAccountReq))
+		return srv.(BffServer).UnbindUserIdentity(ctx, req.(*UnbindUserAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_UnbindUserQQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bff_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnbindUserAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).UnbindUserQQ(ctx, in)
+		return srv.(BffServer).DeleteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/UnbindUserQQ",
+		FullMethod: "/bff.v1.Bff/DeleteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).UnbindUserQQ(ctx, req.(*UnbindUserAccountReq))
+		return srv.(BffServer).DeleteAccount(ctx, req.(*UnbindUserAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_UnbindUserGitee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbindUserAccountReq)
+func _Bff_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).UnbindUserGitee(ctx, in)
+		return srv.(BffServer).ExportUserData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/UnbindUserGitee",
+		FullMethod: "/bff.v1.Bff/ExportUserData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).UnbindUserGitee(ctx, req.(*UnbindUserAccountReq))
+		return srv.(BffServer).ExportUserData(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bff_UnbindUserGithub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbindUserAccountReq)
+func _Bff_GetUserDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BffServer).UnbindUserGithub(ctx, in)
+		return srv.(BffServer).GetUserDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bff.v1.Bff/UnbindUserGithub",
+		FullMethod: "/bff.v1.Bff/GetUserDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BffServer).UnbindUserGithub(ctx, req.(*UnbindUserAccountReq))
+		return srv.(BffServer).GetUserDataExport(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6241,6 +6949,24 @@ func _Bff_GetMessageSystemNotification_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bff_GetMessageMention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageMentionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetMessageMention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetMessageMention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetMessageMention(ctx, req.(*GetMessageMentionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bff_GetMailBoxLastTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -6331,6 +7057,240 @@ func _Bff_RemoveMailBoxSystemNotificationCount_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bff_RemoveMailBoxMentionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).RemoveMailBoxMentionCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/RemoveMailBoxMentionCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).RemoveMailBoxMentionCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).AppealReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/AppealReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).AppealReview(ctx, req.(*AppealReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetUserAppealList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAppealListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetUserAppealList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetUserAppealList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetUserAppealList(ctx, req.(*GetUserAppealListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetContentFlagList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentFlagListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetContentFlagList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetContentFlagList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetContentFlagList(ctx, req.(*GetContentFlagListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_PublishContentFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishContentFlagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).PublishContentFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/PublishContentFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).PublishContentFlag(ctx, req.(*PublishContentFlagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_RemoveContentFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContentFlagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).RemoveContentFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/RemoveContentFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).RemoveContentFlag(ctx, req.(*RemoveContentFlagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/SuspendUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).SuspendUser(ctx, req.(*SuspendUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_UnsuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).UnsuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/UnsuspendUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).UnsuspendUser(ctx, req.(*UnsuspendUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/SetUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).SetUserRole(ctx, req.(*SetUserRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetAuditLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetAuditLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetAuditLogList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetAuditLogList(ctx, req.(*GetAuditLogListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_GetAppealList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).GetAppealList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/GetAppealList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).GetAppealList(ctx, req.(*GetAppealListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_AcceptAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).AcceptAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/AcceptAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).AcceptAppeal(ctx, req.(*AcceptAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_RejectAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).RejectAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bff.v1.Bff/RejectAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).RejectAppeal(ctx, req.(*RejectAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bff_ServiceDesc is the grpc.ServiceDesc for Bff service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -6355,24 +7315,48 @@ var Bff_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Bff_LoginByCode_Handler,
 		},
 		{
-			MethodName: "LoginByWeChat",
-			Handler:    _Bff_LoginByWeChat_Handler,
+			MethodName: "GetOauthUrl",
+			Handler:    _Bff_GetOauthUrl_Handler,
 		},
 		{
-			MethodName: "LoginByQQ",
-			Handler:    _Bff_LoginByQQ_Handler,
+			MethodName: "LoginByOauth",
+			Handler:    _Bff_LoginByOauth_Handler,
 		},
 		{
-			MethodName: "LoginByGithub",
-			Handler:    _Bff_LoginByGithub_Handler,
+			MethodName: "LoginPasswordReset",
+			Handler:    _Bff_LoginPasswordReset_Handler,
 		},
 		{
-			MethodName: "LoginByGitee",
-			Handler:    _Bff_LoginByGitee_Handler,
+			MethodName: "RefreshToken",
+			Handler:    _Bff_RefreshToken_Handler,
 		},
 		{
-			MethodName: "LoginPasswordReset",
-			Handler:    _Bff_LoginPasswordReset_Handler,
+			MethodName: "Logout",
+			Handler:    _Bff_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllDevices",
+			Handler:    _Bff_LogoutAllDevices_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Bff_ListSessions_Handler,
+		},
+		{
+			MethodName: "LoginByTotp",
+			Handler:    _Bff_LoginByTotp_Handler,
+		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _Bff_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "ConfirmTotp",
+			Handler:    _Bff_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _Bff_DisableTotp_Handler,
 		},
 		{
 			MethodName: "SendPhoneCode",
@@ -6467,28 +7451,68 @@ var Bff_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Bff_SetUserPassword_Handler,
 		},
 		{
-			MethodName: "SetUserWechat",
-			Handler:    _Bff_SetUserWechat_Handler,
+			MethodName: "SetUserIdentity",
+			Handler:    _Bff_SetUserIdentity_Handler,
 		},
 		{
-			MethodName: "SetUserQQ",
-			Handler:    _Bff_SetUserQQ_Handler,
+			MethodName: "SetUserFollow",
+			Handler:    _Bff_SetUserFollow_Handler,
 		},
 		{
-			MethodName: "SetUserGitee",
-			Handler:    _Bff_SetUserGitee_Handler,
+			MethodName: "CancelUserFollow",
+			Handler:    _Bff_CancelUserFollow_Handler,
 		},
 		{
-			MethodName: "SetUserGithub",
-			Handler:    _Bff_SetUserGithub_Handler,
+			MethodName: "BlockUser",
+			Handler:    _Bff_BlockUser_Handler,
 		},
 		{
-			MethodName: "SetUserFollow",
-			Handler:    _Bff_SetUserFollow_Handler,
+			MethodName: "UnblockUser",
+			Handler:    _Bff_UnblockUser_Handler,
 		},
 		{
-			MethodName: "CancelUserFollow",
-			Handler:    _Bff_CancelUserFollow_Handler,
+			MethodName: "MuteUser",
+			Handler:    _Bff_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _Bff_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "GetUserBlocks",
+			Handler:    _Bff_GetUserBlocks_Handler,
+		},
+		{
+			MethodName: "GetFollowRecommendations",
+			Handler:    _Bff_GetFollowRecommendations_Handler,
+		},
+		{
+			MethodName: "SetProfilePrivate",
+			Handler:    _Bff_SetProfilePrivate_Handler,
+		},
+		{
+			MethodName: "GetFollowRequests",
+			Handler:    _Bff_GetFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _Bff_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _Bff_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "SetUserHandle",
+			Handler:    _Bff_SetUserHandle_Handler,
+		},
+		{
+			MethodName: "GetUserHandle",
+			Handler:    _Bff_GetUserHandle_Handler,
+		},
+		{
+			MethodName: "GetUserByHandle",
+			Handler:    _Bff_GetUserByHandle_Handler,
 		},
 		{
 			MethodName: "ChangeUserPassword",
@@ -6503,20 +7527,20 @@ var Bff_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Bff_UnbindUserEmail_Handler,
 		},
 		{
-			MethodName: "UnbindUserWechat",
-			Handler:    _Bff_UnbindUserWechat_Handler,
+			MethodName: "UnbindUserIdentity",
+			Handler:    _Bff_UnbindUserIdentity_Handler,
 		},
 		{
-			MethodName: "UnbindUserQQ",
-			Handler:    _Bff_UnbindUserQQ_Handler,
+			MethodName: "DeleteAccount",
+			Handler:    _Bff_DeleteAccount_Handler,
 		},
 		{
-			MethodName: "UnbindUserGitee",
-			Handler:    _Bff_UnbindUserGitee_Handler,
+			MethodName: "ExportUserData",
+			Handler:    _Bff_ExportUserData_Handler,
 		},
 		{
-			MethodName: "UnbindUserGithub",
-			Handler:    _Bff_UnbindUserGithub_Handler,
+			MethodName: "GetUserDataExport",
+			Handler:    _Bff_GetUserDataExport_Handler,
 		},
 		{
 			MethodName: "GetLeaderBoard",
@@ -7102,6 +8126,10 @@ var Bff_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessageSystemNotification",
 			Handler:    _Bff_GetMessageSystemNotification_Handler,
 		},
+		{
+			MethodName: "GetMessageMention",
+			Handler:    _Bff_GetMessageMention_Handler,
+		},
 		{
 			MethodName: "GetMailBoxLastTime",
 			Handler:    _Bff_GetMailBoxLastTime_Handler,
@@ -7122,6 +8150,58 @@ var Bff_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMailBoxSystemNotificationCount",
 			Handler:    _Bff_RemoveMailBoxSystemNotificationCount_Handler,
 		},
+		{
+			MethodName: "RemoveMailBoxMentionCount",
+			Handler:    _Bff_RemoveMailBoxMentionCount_Handler,
+		},
+		{
+			MethodName: "AppealReview",
+			Handler:    _Bff_AppealReview_Handler,
+		},
+		{
+			MethodName: "GetUserAppealList",
+			Handler:    _Bff_GetUserAppealList_Handler,
+		},
+		{
+			MethodName: "GetContentFlagList",
+			Handler:    _Bff_GetContentFlagList_Handler,
+		},
+		{
+			MethodName: "PublishContentFlag",
+			Handler:    _Bff_PublishContentFlag_Handler,
+		},
+		{
+			MethodName: "RemoveContentFlag",
+			Handler:    _Bff_RemoveContentFlag_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _Bff_SuspendUser_Handler,
+		},
+		{
+			MethodName: "UnsuspendUser",
+			Handler:    _Bff_UnsuspendUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _Bff_SetUserRole_Handler,
+		},
+		{
+			MethodName: "GetAuditLogList",
+			Handler:    _Bff_GetAuditLogList_Handler,
+		},
+		{
+			MethodName: "GetAppealList",
+			Handler:    _Bff_GetAppealList_Handler,
+		},
+		{
+			MethodName: "AcceptAppeal",
+			Handler:    _Bff_AcceptAppeal_Handler,
+		},
+		{
+			MethodName: "RejectAppeal",
+			Handler:    _Bff_RejectAppeal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bff/interface/v1/bff.proto",
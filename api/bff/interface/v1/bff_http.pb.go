@@ -20,10 +20,14 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationBffAcceptAppeal = "/bff.v1.Bff/AcceptAppeal"
 const OperationBffAccessUserMedal = "/bff.v1.Bff/AccessUserMedal"
 const OperationBffAddColumnIncludes = "/bff.v1.Bff/AddColumnIncludes"
+const OperationBffAppealReview = "/bff.v1.Bff/AppealReview"
+const OperationBffApproveFollowRequest = "/bff.v1.Bff/ApproveFollowRequest"
 const OperationBffArticleDraftMark = "/bff.v1.Bff/ArticleDraftMark"
 const OperationBffArticleStatisticJudge = "/bff.v1.Bff/ArticleStatisticJudge"
+const OperationBffBlockUser = "/bff.v1.Bff/BlockUser"
 const OperationBffCancelArticleAgree = "/bff.v1.Bff/CancelArticleAgree"
 const OperationBffCancelArticleCollect = "/bff.v1.Bff/CancelArticleCollect"
 const OperationBffCancelColumnAgree = "/bff.v1.Bff/CancelColumnAgree"
@@ -37,19 +41,25 @@ const OperationBffCancelUserFollow = "/bff.v1.Bff/CancelUserFollow"
 const OperationBffCancelUserMedalSet = "/bff.v1.Bff/CancelUserMedalSet"
 const OperationBffChangeUserPassword = "/bff.v1.Bff/ChangeUserPassword"
 const OperationBffColumnStatisticJudge = "/bff.v1.Bff/ColumnStatisticJudge"
+const OperationBffConfirmTotp = "/bff.v1.Bff/ConfirmTotp"
 const OperationBffCreateArticleDraft = "/bff.v1.Bff/CreateArticleDraft"
 const OperationBffCreateCollectionsDraft = "/bff.v1.Bff/CreateCollectionsDraft"
 const OperationBffCreateColumnDraft = "/bff.v1.Bff/CreateColumnDraft"
 const OperationBffCreateCommentDraft = "/bff.v1.Bff/CreateCommentDraft"
 const OperationBffCreateTalkDraft = "/bff.v1.Bff/CreateTalkDraft"
+const OperationBffDeleteAccount = "/bff.v1.Bff/DeleteAccount"
 const OperationBffDeleteArticle = "/bff.v1.Bff/DeleteArticle"
 const OperationBffDeleteArticleDraft = "/bff.v1.Bff/DeleteArticleDraft"
 const OperationBffDeleteCollections = "/bff.v1.Bff/DeleteCollections"
 const OperationBffDeleteColumn = "/bff.v1.Bff/DeleteColumn"
 const OperationBffDeleteColumnIncludes = "/bff.v1.Bff/DeleteColumnIncludes"
 const OperationBffDeleteTalk = "/bff.v1.Bff/DeleteTalk"
+const OperationBffDisableTotp = "/bff.v1.Bff/DisableTotp"
+const OperationBffEnrollTotp = "/bff.v1.Bff/EnrollTotp"
+const OperationBffExportUserData = "/bff.v1.Bff/ExportUserData"
 const OperationBffGetAccount = "/bff.v1.Bff/GetAccount"
 const OperationBffGetAchievementList = "/bff.v1.Bff/GetAchievementList"
+const OperationBffGetAppealList = "/bff.v1.Bff/GetAppealList"
 const OperationBffGetArticleContentReview = "/bff.v1.Bff/GetArticleContentReview"
 const OperationBffGetArticleCount = "/bff.v1.Bff/GetArticleCount"
 const OperationBffGetArticleCountVisitor = "/bff.v1.Bff/GetArticleCountVisitor"
@@ -60,6 +70,7 @@ const OperationBffGetArticleListHot = "/bff.v1.Bff/GetArticleListHot"
 const OperationBffGetArticleListStatistic = "/bff.v1.Bff/GetArticleListStatistic"
 const OperationBffGetArticleSearch = "/bff.v1.Bff/GetArticleSearch"
 const OperationBffGetArticleStatistic = "/bff.v1.Bff/GetArticleStatistic"
+const OperationBffGetAuditLogList = "/bff.v1.Bff/GetAuditLogList"
 const OperationBffGetAvatarReview = "/bff.v1.Bff/GetAvatarReview"
 const OperationBffGetCollectArticleCount = "/bff.v1.Bff/GetCollectArticleCount"
 const OperationBffGetCollectArticleList = "/bff.v1.Bff/GetCollectArticleList"
@@ -89,10 +100,13 @@ const OperationBffGetCommentContentReview = "/bff.v1.Bff/GetCommentContentReview
 const OperationBffGetCommentList = "/bff.v1.Bff/GetCommentList"
 const OperationBffGetCommentListHot = "/bff.v1.Bff/GetCommentListHot"
 const OperationBffGetCommentUser = "/bff.v1.Bff/GetCommentUser"
+const OperationBffGetContentFlagList = "/bff.v1.Bff/GetContentFlagList"
 const OperationBffGetCosSessionKey = "/bff.v1.Bff/GetCosSessionKey"
 const OperationBffGetCoverReview = "/bff.v1.Bff/GetCoverReview"
 const OperationBffGetFollowList = "/bff.v1.Bff/GetFollowList"
 const OperationBffGetFollowListCount = "/bff.v1.Bff/GetFollowListCount"
+const OperationBffGetFollowRecommendations = "/bff.v1.Bff/GetFollowRecommendations"
+const OperationBffGetFollowRequests = "/bff.v1.Bff/GetFollowRequests"
 const OperationBffGetFollowedList = "/bff.v1.Bff/GetFollowedList"
 const OperationBffGetFollowedListCount = "/bff.v1.Bff/GetFollowedListCount"
 const OperationBffGetHealth = "/bff.v1.Bff/GetHealth"
@@ -103,10 +117,12 @@ const OperationBffGetLastCommentDraft = "/bff.v1.Bff/GetLastCommentDraft"
 const OperationBffGetLastTalkDraft = "/bff.v1.Bff/GetLastTalkDraft"
 const OperationBffGetLeaderBoard = "/bff.v1.Bff/GetLeaderBoard"
 const OperationBffGetMailBoxLastTime = "/bff.v1.Bff/GetMailBoxLastTime"
+const OperationBffGetMessageMention = "/bff.v1.Bff/GetMessageMention"
 const OperationBffGetMessageNotification = "/bff.v1.Bff/GetMessageNotification"
 const OperationBffGetMessageSystemNotification = "/bff.v1.Bff/GetMessageSystemNotification"
 const OperationBffGetNews = "/bff.v1.Bff/GetNews"
 const OperationBffGetNewsSearch = "/bff.v1.Bff/GetNewsSearch"
+const OperationBffGetOauthUrl = "/bff.v1.Bff/GetOauthUrl"
 const OperationBffGetProfile = "/bff.v1.Bff/GetProfile"
 const OperationBffGetProfileList = "/bff.v1.Bff/GetProfileList"
 const OperationBffGetProfileUpdate = "/bff.v1.Bff/GetProfileUpdate"
@@ -124,12 +140,15 @@ const OperationBffGetTalkSearch = "/bff.v1.Bff/GetTalkSearch"
 const OperationBffGetTalkStatistic = "/bff.v1.Bff/GetTalkStatistic"
 const OperationBffGetTimeLineUsers = "/bff.v1.Bff/GetTimeLineUsers"
 const OperationBffGetUserAchievement = "/bff.v1.Bff/GetUserAchievement"
+const OperationBffGetUserAppealList = "/bff.v1.Bff/GetUserAppealList"
 const OperationBffGetUserArticleAgree = "/bff.v1.Bff/GetUserArticleAgree"
 const OperationBffGetUserArticleCollect = "/bff.v1.Bff/GetUserArticleCollect"
 const OperationBffGetUserArticleList = "/bff.v1.Bff/GetUserArticleList"
 const OperationBffGetUserArticleListAll = "/bff.v1.Bff/GetUserArticleListAll"
 const OperationBffGetUserArticleListSimple = "/bff.v1.Bff/GetUserArticleListSimple"
 const OperationBffGetUserArticleListVisitor = "/bff.v1.Bff/GetUserArticleListVisitor"
+const OperationBffGetUserBlocks = "/bff.v1.Bff/GetUserBlocks"
+const OperationBffGetUserByHandle = "/bff.v1.Bff/GetUserByHandle"
 const OperationBffGetUserColumnAgree = "/bff.v1.Bff/GetUserColumnAgree"
 const OperationBffGetUserColumnCollect = "/bff.v1.Bff/GetUserColumnCollect"
 const OperationBffGetUserColumnList = "/bff.v1.Bff/GetUserColumnList"
@@ -141,8 +160,10 @@ const OperationBffGetUserCommentArticleReplyList = "/bff.v1.Bff/GetUserCommentAr
 const OperationBffGetUserCommentRepliedList = "/bff.v1.Bff/GetUserCommentRepliedList"
 const OperationBffGetUserCommentTalkRepliedList = "/bff.v1.Bff/GetUserCommentTalkRepliedList"
 const OperationBffGetUserCommentTalkReplyList = "/bff.v1.Bff/GetUserCommentTalkReplyList"
+const OperationBffGetUserDataExport = "/bff.v1.Bff/GetUserDataExport"
 const OperationBffGetUserFollow = "/bff.v1.Bff/GetUserFollow"
 const OperationBffGetUserFollows = "/bff.v1.Bff/GetUserFollows"
+const OperationBffGetUserHandle = "/bff.v1.Bff/GetUserHandle"
 const OperationBffGetUserInfo = "/bff.v1.Bff/GetUserInfo"
 const OperationBffGetUserInfoVisitor = "/bff.v1.Bff/GetUserInfoVisitor"
 const OperationBffGetUserMedal = "/bff.v1.Bff/GetUserMedal"
@@ -160,15 +181,23 @@ const OperationBffGetUserTalkList = "/bff.v1.Bff/GetUserTalkList"
 const OperationBffGetUserTalkListSimple = "/bff.v1.Bff/GetUserTalkListSimple"
 const OperationBffGetUserTalkListVisitor = "/bff.v1.Bff/GetUserTalkListVisitor"
 const OperationBffGetUserTimeLineListVisitor = "/bff.v1.Bff/GetUserTimeLineListVisitor"
+const OperationBffListSessions = "/bff.v1.Bff/ListSessions"
 const OperationBffLoginByCode = "/bff.v1.Bff/LoginByCode"
-const OperationBffLoginByGitee = "/bff.v1.Bff/LoginByGitee"
-const OperationBffLoginByGithub = "/bff.v1.Bff/LoginByGithub"
+const OperationBffLoginByOauth = "/bff.v1.Bff/LoginByOauth"
 const OperationBffLoginByPassword = "/bff.v1.Bff/LoginByPassword"
-const OperationBffLoginByQQ = "/bff.v1.Bff/LoginByQQ"
-const OperationBffLoginByWeChat = "/bff.v1.Bff/LoginByWeChat"
+const OperationBffLoginByTotp = "/bff.v1.Bff/LoginByTotp"
 const OperationBffLoginPasswordReset = "/bff.v1.Bff/LoginPasswordReset"
+const OperationBffLogout = "/bff.v1.Bff/Logout"
+const OperationBffLogoutAllDevices = "/bff.v1.Bff/LogoutAllDevices"
+const OperationBffMuteUser = "/bff.v1.Bff/MuteUser"
+const OperationBffPublishContentFlag = "/bff.v1.Bff/PublishContentFlag"
+const OperationBffRefreshToken = "/bff.v1.Bff/RefreshToken"
+const OperationBffRejectAppeal = "/bff.v1.Bff/RejectAppeal"
+const OperationBffRejectFollowRequest = "/bff.v1.Bff/RejectFollowRequest"
 const OperationBffRemoveComment = "/bff.v1.Bff/RemoveComment"
+const OperationBffRemoveContentFlag = "/bff.v1.Bff/RemoveContentFlag"
 const OperationBffRemoveMailBoxCommentCount = "/bff.v1.Bff/RemoveMailBoxCommentCount"
+const OperationBffRemoveMailBoxMentionCount = "/bff.v1.Bff/RemoveMailBoxMentionCount"
 const OperationBffRemoveMailBoxSubCommentCount = "/bff.v1.Bff/RemoveMailBoxSubCommentCount"
 const OperationBffRemoveMailBoxSystemNotificationCount = "/bff.v1.Bff/RemoveMailBoxSystemNotificationCount"
 const OperationBffRemoveSubComment = "/bff.v1.Bff/RemoveSubComment"
@@ -192,6 +221,7 @@ const OperationBffSetColumnCollect = "/bff.v1.Bff/SetColumnCollect"
 const OperationBffSetColumnView = "/bff.v1.Bff/SetColumnView"
 const OperationBffSetCommentAgree = "/bff.v1.Bff/SetCommentAgree"
 const OperationBffSetMailBoxLastTime = "/bff.v1.Bff/SetMailBoxLastTime"
+const OperationBffSetProfilePrivate = "/bff.v1.Bff/SetProfilePrivate"
 const OperationBffSetProfileUpdate = "/bff.v1.Bff/SetProfileUpdate"
 const OperationBffSetSubCommentAgree = "/bff.v1.Bff/SetSubCommentAgree"
 const OperationBffSetTalkAgree = "/bff.v1.Bff/SetTalkAgree"
@@ -199,29 +229,33 @@ const OperationBffSetTalkCollect = "/bff.v1.Bff/SetTalkCollect"
 const OperationBffSetTalkView = "/bff.v1.Bff/SetTalkView"
 const OperationBffSetUserEmail = "/bff.v1.Bff/SetUserEmail"
 const OperationBffSetUserFollow = "/bff.v1.Bff/SetUserFollow"
-const OperationBffSetUserGitee = "/bff.v1.Bff/SetUserGitee"
-const OperationBffSetUserGithub = "/bff.v1.Bff/SetUserGithub"
+const OperationBffSetUserHandle = "/bff.v1.Bff/SetUserHandle"
+const OperationBffSetUserIdentity = "/bff.v1.Bff/SetUserIdentity"
 const OperationBffSetUserMedal = "/bff.v1.Bff/SetUserMedal"
 const OperationBffSetUserPassword = "/bff.v1.Bff/SetUserPassword"
 const OperationBffSetUserPhone = "/bff.v1.Bff/SetUserPhone"
-const OperationBffSetUserQQ = "/bff.v1.Bff/SetUserQQ"
-const OperationBffSetUserWechat = "/bff.v1.Bff/SetUserWechat"
+const OperationBffSetUserRole = "/bff.v1.Bff/SetUserRole"
 const OperationBffSubscribeColumn = "/bff.v1.Bff/SubscribeColumn"
 const OperationBffSubscribeJudge = "/bff.v1.Bff/SubscribeJudge"
+const OperationBffSuspendUser = "/bff.v1.Bff/SuspendUser"
 const OperationBffTalkStatisticJudge = "/bff.v1.Bff/TalkStatisticJudge"
 const OperationBffUnbindUserEmail = "/bff.v1.Bff/UnbindUserEmail"
-const OperationBffUnbindUserGitee = "/bff.v1.Bff/UnbindUserGitee"
-const OperationBffUnbindUserGithub = "/bff.v1.Bff/UnbindUserGithub"
+const OperationBffUnbindUserIdentity = "/bff.v1.Bff/UnbindUserIdentity"
 const OperationBffUnbindUserPhone = "/bff.v1.Bff/UnbindUserPhone"
-const OperationBffUnbindUserQQ = "/bff.v1.Bff/UnbindUserQQ"
-const OperationBffUnbindUserWechat = "/bff.v1.Bff/UnbindUserWechat"
+const OperationBffUnblockUser = "/bff.v1.Bff/UnblockUser"
+const OperationBffUnmuteUser = "/bff.v1.Bff/UnmuteUser"
+const OperationBffUnsuspendUser = "/bff.v1.Bff/UnsuspendUser"
 const OperationBffUserRegister = "/bff.v1.Bff/UserRegister"
 
 type BffHTTPServer interface {
+	AcceptAppeal(context.Context, *AcceptAppealReq) (*emptypb.Empty, error)
 	AccessUserMedal(context.Context, *AccessUserMedalReq) (*emptypb.Empty, error)
 	AddColumnIncludes(context.Context, *AddColumnIncludesReq) (*emptypb.Empty, error)
+	AppealReview(context.Context, *AppealReviewReq) (*emptypb.Empty, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestReq) (*emptypb.Empty, error)
 	ArticleDraftMark(context.Context, *ArticleDraftMarkReq) (*emptypb.Empty, error)
 	ArticleStatisticJudge(context.Context, *ArticleStatisticJudgeReq) (*ArticleStatisticJudgeReply, error)
+	BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error)
 	CancelArticleAgree(context.Context, *CancelArticleAgreeReq) (*emptypb.Empty, error)
 	CancelArticleCollect(context.Context, *CancelArticleCollectReq) (*emptypb.Empty, error)
 	CancelColumnAgree(context.Context, *CancelColumnAgreeReq) (*emptypb.Empty, error)
@@ -235,19 +269,25 @@ type BffHTTPServer interface {
 	CancelUserMedalSet(context.Context, *CancelUserMedalSetReq) (*emptypb.Empty, error)
 	ChangeUserPassword(context.Context, *ChangeUserPasswordReq) (*emptypb.Empty, error)
 	ColumnStatisticJudge(context.Context, *ColumnStatisticJudgeReq) (*ColumnStatisticJudgeReply, error)
+	ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpReply, error)
 	CreateArticleDraft(context.Context, *emptypb.Empty) (*CreateArticleDraftReply, error)
 	CreateCollectionsDraft(context.Context, *emptypb.Empty) (*CreateCollectionsDraftReply, error)
 	CreateColumnDraft(context.Context, *emptypb.Empty) (*CreateColumnDraftReply, error)
 	CreateCommentDraft(context.Context, *emptypb.Empty) (*CreateCommentDraftReply, error)
 	CreateTalkDraft(context.Context, *emptypb.Empty) (*CreateTalkDraftReply, error)
+	DeleteAccount(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
 	DeleteArticle(context.Context, *DeleteArticleReq) (*emptypb.Empty, error)
 	DeleteArticleDraft(context.Context, *DeleteArticleDraftReq) (*emptypb.Empty, error)
 	DeleteCollections(context.Context, *DeleteCollectionsReq) (*emptypb.Empty, error)
 	DeleteColumn(context.Context, *DeleteColumnReq) (*emptypb.Empty, error)
 	DeleteColumnIncludes(context.Context, *DeleteColumnIncludesReq) (*emptypb.Empty, error)
 	DeleteTalk(context.Context, *DeleteTalkReq) (*emptypb.Empty, error)
+	DisableTotp(context.Context, *DisableTotpReq) (*emptypb.Empty, error)
+	EnrollTotp(context.Context, *emptypb.Empty) (*EnrollTotpReply, error)
+	ExportUserData(context.Context, *emptypb.Empty) (*ExportUserDataReply, error)
 	GetAccount(context.Context, *emptypb.Empty) (*GetAccountReply, error)
 	GetAchievementList(context.Context, *GetAchievementListReq) (*GetAchievementListReply, error)
+	GetAppealList(context.Context, *GetAppealListReq) (*GetAppealListReply, error)
 	GetArticleContentReview(context.Context, *GetArticleContentReviewReq) (*GetArticleContentReviewReply, error)
 	GetArticleCount(context.Context, *emptypb.Empty) (*GetArticleCountReply, error)
 	GetArticleCountVisitor(context.Context, *GetArticleCountVisitorReq) (*GetArticleCountReply, error)
@@ -258,6 +298,7 @@ type BffHTTPServer interface {
 	GetArticleListStatistic(context.Context, *GetArticleListStatisticReq) (*GetArticleListStatisticReply, error)
 	GetArticleSearch(context.Context, *GetArticleSearchReq) (*GetArticleSearchReply, error)
 	GetArticleStatistic(context.Context, *GetArticleStatisticReq) (*GetArticleStatisticReply, error)
+	GetAuditLogList(context.Context, *GetAuditLogListReq) (*GetAuditLogListReply, error)
 	GetAvatarReview(context.Context, *GetAvatarReviewReq) (*GetAvatarReviewReply, error)
 	GetCollectArticleCount(context.Context, *GetCollectArticleCountReq) (*GetCollectArticleCountReply, error)
 	GetCollectArticleList(context.Context, *GetCollectArticleListReq) (*GetArticleListReply, error)
@@ -287,10 +328,13 @@ type BffHTTPServer interface {
 	GetCommentList(context.Context, *GetCommentListReq) (*GetCommentListReply, error)
 	GetCommentListHot(context.Context, *GetCommentListReq) (*GetCommentListReply, error)
 	GetCommentUser(context.Context, *emptypb.Empty) (*GetCommentUserReply, error)
+	GetContentFlagList(context.Context, *GetContentFlagListReq) (*GetContentFlagListReply, error)
 	GetCosSessionKey(context.Context, *emptypb.Empty) (*GetCosSessionKeyReply, error)
 	GetCoverReview(context.Context, *GetCoverReviewReq) (*GetCoverReviewReply, error)
 	GetFollowList(context.Context, *GetFollowListReq) (*GetFollowListReply, error)
 	GetFollowListCount(context.Context, *GetFollowListCountReq) (*GetFollowListCountReply, error)
+	GetFollowRecommendations(context.Context, *GetFollowRecommendationsReq) (*GetFollowRecommendationsReply, error)
+	GetFollowRequests(context.Context, *GetFollowRequestsReq) (*GetFollowRequestsReply, error)
 	GetFollowedList(context.Context, *GetFollowedListReq) (*GetFollowedListReply, error)
 	GetFollowedListCount(context.Context, *GetFollowedListCountReq) (*GetFollowedListCountReply, error)
 	GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
@@ -301,10 +345,12 @@ type BffHTTPServer interface {
 	GetLastTalkDraft(context.Context, *emptypb.Empty) (*GetLastTalkDraftReply, error)
 	GetLeaderBoard(context.Context, *emptypb.Empty) (*GetLeaderBoardReply, error)
 	GetMailBoxLastTime(context.Context, *emptypb.Empty) (*GetMailBoxLastTimeReply, error)
+	GetMessageMention(context.Context, *GetMessageMentionReq) (*GetMessageMentionReply, error)
 	GetMessageNotification(context.Context, *emptypb.Empty) (*GetMessageNotificationReply, error)
 	GetMessageSystemNotification(context.Context, *GetMessageSystemNotificationReq) (*GetMessageSystemNotificationReply, error)
 	GetNews(context.Context, *GetNewsReq) (*GetNewsReply, error)
 	GetNewsSearch(context.Context, *GetNewsSearchReq) (*GetNewsSearchReply, error)
+	GetOauthUrl(context.Context, *GetOauthUrlReq) (*GetOauthUrlReply, error)
 	GetProfile(context.Context, *emptypb.Empty) (*GetProfileReply, error)
 	GetProfileList(context.Context, *GetProfileListReq) (*GetProfileListReply, error)
 	GetProfileUpdate(context.Context, *emptypb.Empty) (*GetProfileUpdateReply, error)
@@ -322,12 +368,15 @@ type BffHTTPServer interface {
 	GetTalkStatistic(context.Context, *GetTalkStatisticReq) (*GetTalkStatisticReply, error)
 	GetTimeLineUsers(context.Context, *emptypb.Empty) (*GetTimeLineUsersReply, error)
 	GetUserAchievement(context.Context, *GetUserAchievementReq) (*GetUserAchievementReply, error)
+	GetUserAppealList(context.Context, *GetUserAppealListReq) (*GetAppealListReply, error)
 	GetUserArticleAgree(context.Context, *emptypb.Empty) (*GetUserArticleAgreeReply, error)
 	GetUserArticleCollect(context.Context, *emptypb.Empty) (*GetUserArticleCollectReply, error)
 	GetUserArticleList(context.Context, *GetUserArticleListReq) (*GetArticleListReply, error)
 	GetUserArticleListAll(context.Context, *emptypb.Empty) (*GetArticleListReply, error)
 	GetUserArticleListSimple(context.Context, *GetUserArticleListSimpleReq) (*GetArticleListReply, error)
 	GetUserArticleListVisitor(context.Context, *GetUserArticleListVisitorReq) (*GetArticleListReply, error)
+	GetUserBlocks(context.Context, *emptypb.Empty) (*GetUserBlocksReply, error)
+	GetUserByHandle(context.Context, *GetUserByHandleReq) (*GetUserByHandleReply, error)
 	GetUserColumnAgree(context.Context, *emptypb.Empty) (*GetUserColumnAgreeReply, error)
 	GetUserColumnCollect(context.Context, *emptypb.Empty) (*GetUserColumnCollectReply, error)
 	GetUserColumnList(context.Context, *GetUserColumnListReq) (*GetColumnListReply, error)
@@ -339,8 +388,10 @@ type BffHTTPServer interface {
 	GetUserCommentRepliedList(context.Context, *GetUserCommentRepliedListReq) (*GetUserCommentRepliedListReply, error)
 	GetUserCommentTalkRepliedList(context.Context, *GetUserCommentTalkRepliedListReq) (*GetUserCommentTalkRepliedListReply, error)
 	GetUserCommentTalkReplyList(context.Context, *GetUserCommentTalkReplyListReq) (*GetUserCommentTalkReplyListReply, error)
+	GetUserDataExport(context.Context, *emptypb.Empty) (*GetUserDataExportReply, error)
 	GetUserFollow(context.Context, *GetUserFollowReq) (*GetUserFollowReply, error)
 	GetUserFollows(context.Context, *emptypb.Empty) (*GetUserFollowsReply, error)
+	GetUserHandle(context.Context, *emptypb.Empty) (*GetUserHandleReply, error)
 	GetUserInfo(context.Context, *emptypb.Empty) (*GetUserInfoReply, error)
 	GetUserInfoVisitor(context.Context, *GetUserInfoVisitorReq) (*GetUserInfoReply, error)
 	GetUserMedal(context.Context, *GetUserMedalReq) (*GetUserMedalReply, error)
@@ -358,15 +409,23 @@ type BffHTTPServer interface {
 	GetUserTalkListSimple(context.Context, *GetUserTalkListSimpleReq) (*GetTalkListReply, error)
 	GetUserTalkListVisitor(context.Context, *GetUserTalkListVisitorReq) (*GetTalkListReply, error)
 	GetUserTimeLineListVisitor(context.Context, *GetUserTimeLineListReq) (*GetUserTimeLineListReply, error)
+	ListSessions(context.Context, *emptypb.Empty) (*ListSessionsReply, error)
 	LoginByCode(context.Context, *LoginByCodeReq) (*LoginReply, error)
-	LoginByGitee(context.Context, *LoginByGiteeReq) (*LoginReply, error)
-	LoginByGithub(context.Context, *LoginByGithubReq) (*LoginReply, error)
+	LoginByOauth(context.Context, *LoginByOauthReq) (*LoginReply, error)
 	LoginByPassword(context.Context, *LoginByPasswordReq) (*LoginReply, error)
-	LoginByQQ(context.Context, *LoginByQQReq) (*LoginReply, error)
-	LoginByWeChat(context.Context, *LoginByWeChatReq) (*LoginReply, error)
+	LoginByTotp(context.Context, *LoginByTotpReq) (*LoginReply, error)
 	LoginPasswordReset(context.Context, *LoginPasswordResetReq) (*emptypb.Empty, error)
+	Logout(context.Context, *LogoutReq) (*emptypb.Empty, error)
+	LogoutAllDevices(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	MuteUser(context.Context, *MuteUserReq) (*emptypb.Empty, error)
+	PublishContentFlag(context.Context, *PublishContentFlagReq) (*emptypb.Empty, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*LoginReply, error)
+	RejectAppeal(context.Context, *RejectAppealReq) (*emptypb.Empty, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestReq) (*emptypb.Empty, error)
 	RemoveComment(context.Context, *RemoveCommentReq) (*emptypb.Empty, error)
+	RemoveContentFlag(context.Context, *RemoveContentFlagReq) (*emptypb.Empty, error)
 	RemoveMailBoxCommentCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	RemoveMailBoxMentionCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	RemoveMailBoxSubCommentCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	RemoveMailBoxSystemNotificationCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	RemoveSubComment(context.Context, *RemoveSubCommentReq) (*emptypb.Empty, error)
@@ -390,6 +449,7 @@ type BffHTTPServer interface {
 	SetColumnView(context.Context, *SetColumnViewReq) (*emptypb.Empty, error)
 	SetCommentAgree(context.Context, *SetCommentAgreeReq) (*emptypb.Empty, error)
 	SetMailBoxLastTime(context.Context, *SetMailBoxLastTimeReq) (*emptypb.Empty, error)
+	SetProfilePrivate(context.Context, *SetProfilePrivateReq) (*emptypb.Empty, error)
 	SetProfileUpdate(context.Context, *SetProfileUpdateReq) (*emptypb.Empty, error)
 	SetSubCommentAgree(context.Context, *SetSubCommentAgreeReq) (*emptypb.Empty, error)
 	SetTalkAgree(context.Context, *SetTalkAgreeReq) (*emptypb.Empty, error)
@@ -397,22 +457,22 @@ type BffHTTPServer interface {
 	SetTalkView(context.Context, *SetTalkViewReq) (*emptypb.Empty, error)
 	SetUserEmail(context.Context, *SetUserEmailReq) (*emptypb.Empty, error)
 	SetUserFollow(context.Context, *SetUserFollowReq) (*emptypb.Empty, error)
-	SetUserGitee(context.Context, *SetUserGiteeReq) (*emptypb.Empty, error)
-	SetUserGithub(context.Context, *SetUserGithubReq) (*emptypb.Empty, error)
+	SetUserHandle(context.Context, *SetUserHandleReq) (*emptypb.Empty, error)
+	SetUserIdentity(context.Context, *SetUserIdentityReq) (*emptypb.Empty, error)
 	SetUserMedal(context.Context, *SetUserMedalReq) (*emptypb.Empty, error)
 	SetUserPassword(context.Context, *SetUserPasswordReq) (*emptypb.Empty, error)
 	SetUserPhone(context.Context, *SetUserPhoneReq) (*emptypb.Empty, error)
-	SetUserQQ(context.Context, *SetUserQQReq) (*emptypb.Empty, error)
-	SetUserWechat(context.Context, *SetUserWechatReq) (*emptypb.Empty, error)
+	SetUserRole(context.Context, *SetUserRoleReq) (*emptypb.Empty, error)
 	SubscribeColumn(context.Context, *SubscribeColumnReq) (*emptypb.Empty, error)
 	SubscribeJudge(context.Context, *SubscribeJudgeReq) (*SubscribeJudgeReply, error)
+	SuspendUser(context.Context, *SuspendUserReq) (*emptypb.Empty, error)
 	TalkStatisticJudge(context.Context, *TalkStatisticJudgeReq) (*TalkStatisticJudgeReply, error)
 	UnbindUserEmail(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserGitee(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserGithub(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	UnbindUserIdentity(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
 	UnbindUserPhone(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserQQ(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserWechat(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error)
+	UnmuteUser(context.Context, *UnmuteUserReq) (*emptypb.Empty, error)
+	UnsuspendUser(context.Context, *UnsuspendUserReq) (*emptypb.Empty, error)
 	UserRegister(context.Context, *UserRegisterReq) (*emptypb.Empty, error)
 }
 
@@ -422,11 +482,17 @@ func RegisterBffHTTPServer(s *http.Server, srv BffHTTPServer) {
 	r.POST("/v1/user/register", _Bff_UserRegister0_HTTP_Handler(srv))
 	r.POST("/v1/user/login/password", _Bff_LoginByPassword0_HTTP_Handler(srv))
 	r.POST("/v1/user/login/code", _Bff_LoginByCode0_HTTP_Handler(srv))
-	r.POST("/v1/user/login/wechat", _Bff_LoginByWeChat0_HTTP_Handler(srv))
-	r.POST("/v1/user/login/qq", _Bff_LoginByQQ0_HTTP_Handler(srv))
-	r.POST("/v1/user/login/github", _Bff_LoginByGithub0_HTTP_Handler(srv))
-	r.POST("/v1/user/login/gitee", _Bff_LoginByGitee0_HTTP_Handler(srv))
+	r.GET("/v1/user/oauth/url", _Bff_GetOauthUrl0_HTTP_Handler(srv))
+	r.POST("/v1/user/login/oauth", _Bff_LoginByOauth0_HTTP_Handler(srv))
 	r.POST("/v1/user/login/password/reset", _Bff_LoginPasswordReset0_HTTP_Handler(srv))
+	r.POST("/v1/user/token/refresh", _Bff_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/v1/user/logout", _Bff_Logout0_HTTP_Handler(srv))
+	r.POST("/v1/user/logout/all", _Bff_LogoutAllDevices0_HTTP_Handler(srv))
+	r.GET("/v1/get/user/sessions", _Bff_ListSessions0_HTTP_Handler(srv))
+	r.POST("/v1/user/login/totp", _Bff_LoginByTotp0_HTTP_Handler(srv))
+	r.POST("/v1/user/totp/enroll", _Bff_EnrollTotp0_HTTP_Handler(srv))
+	r.POST("/v1/user/totp/confirm", _Bff_ConfirmTotp0_HTTP_Handler(srv))
+	r.POST("/v1/user/totp/disable", _Bff_DisableTotp0_HTTP_Handler(srv))
 	r.POST("/v1/user/code/phone", _Bff_SendPhoneCode0_HTTP_Handler(srv))
 	r.POST("/v1/user/code/email", _Bff_SendEmailCode0_HTTP_Handler(srv))
 	r.GET("/v1/get/cos/session/key", _Bff_GetCosSessionKey0_HTTP_Handler(srv))
@@ -450,19 +516,29 @@ func RegisterBffHTTPServer(s *http.Server, srv BffHTTPServer) {
 	r.POST("/v1/set/user/phone", _Bff_SetUserPhone0_HTTP_Handler(srv))
 	r.POST("/v1/set/user/email", _Bff_SetUserEmail0_HTTP_Handler(srv))
 	r.POST("/v1/set/user/password", _Bff_SetUserPassword0_HTTP_Handler(srv))
-	r.POST("/v1/set/user/wechat", _Bff_SetUserWechat0_HTTP_Handler(srv))
-	r.POST("/v1/set/user/qq", _Bff_SetUserQQ0_HTTP_Handler(srv))
-	r.POST("/v1/set/user/gitee", _Bff_SetUserGitee0_HTTP_Handler(srv))
-	r.POST("/v1/set/user/github", _Bff_SetUserGithub0_HTTP_Handler(srv))
+	r.POST("/v1/set/user/identity", _Bff_SetUserIdentity0_HTTP_Handler(srv))
 	r.POST("/v1/set/user/follow", _Bff_SetUserFollow0_HTTP_Handler(srv))
 	r.POST("/v1/cancel/user/follow", _Bff_CancelUserFollow0_HTTP_Handler(srv))
+	r.POST("/v1/block/user", _Bff_BlockUser0_HTTP_Handler(srv))
+	r.POST("/v1/unblock/user", _Bff_UnblockUser0_HTTP_Handler(srv))
+	r.POST("/v1/mute/user", _Bff_MuteUser0_HTTP_Handler(srv))
+	r.POST("/v1/unmute/user", _Bff_UnmuteUser0_HTTP_Handler(srv))
+	r.GET("/v1/get/user/blocks", _Bff_GetUserBlocks0_HTTP_Handler(srv))
+	r.GET("/v1/get/follow/recommendations", _Bff_GetFollowRecommendations0_HTTP_Handler(srv))
+	r.POST("/v1/set/profile/private", _Bff_SetProfilePrivate0_HTTP_Handler(srv))
+	r.GET("/v1/get/follow/requests", _Bff_GetFollowRequests0_HTTP_Handler(srv))
+	r.POST("/v1/approve/follow/request", _Bff_ApproveFollowRequest0_HTTP_Handler(srv))
+	r.POST("/v1/reject/follow/request", _Bff_RejectFollowRequest0_HTTP_Handler(srv))
+	r.POST("/v1/set/user/handle", _Bff_SetUserHandle0_HTTP_Handler(srv))
+	r.GET("/v1/get/user/handle", _Bff_GetUserHandle0_HTTP_Handler(srv))
+	r.GET("/v1/get/user/by/handle", _Bff_GetUserByHandle0_HTTP_Handler(srv))
 	r.POST("/v1/change/user/password", _Bff_ChangeUserPassword0_HTTP_Handler(srv))
 	r.POST("/v1/unbind/user/phone", _Bff_UnbindUserPhone0_HTTP_Handler(srv))
 	r.POST("/v1/unbind/user/email", _Bff_UnbindUserEmail0_HTTP_Handler(srv))
-	r.POST("/v1/unbind/user/wechat", _Bff_UnbindUserWechat0_HTTP_Handler(srv))
-	r.POST("/v1/unbind/user/qq", _Bff_UnbindUserQQ0_HTTP_Handler(srv))
-	r.POST("/v1/unbind/user/gitee", _Bff_UnbindUserGitee0_HTTP_Handler(srv))
-	r.POST("/v1/unbind/user/github", _Bff_UnbindUserGithub0_HTTP_Handler(srv))
+	r.POST("/v1/unbind/user/identity", _Bff_UnbindUserIdentity0_HTTP_Handler(srv))
+	r.POST("/v1/delete/account", _Bff_DeleteAccount0_HTTP_Handler(srv))
+	r.POST("/v1/export/user/data", _Bff_ExportUserData0_HTTP_Handler(srv))
+	r.GET("/v1/get/user/data/export", _Bff_GetUserDataExport0_HTTP_Handler(srv))
 	r.GET("/v1/get/leaderboard", _Bff_GetLeaderBoard0_HTTP_Handler(srv))
 	r.GET("/v1/get/collect/article/list", _Bff_GetCollectArticleList0_HTTP_Handler(srv))
 	r.GET("/v1/get/collect/article/count", _Bff_GetCollectArticleCount0_HTTP_Handler(srv))
@@ -609,11 +685,25 @@ func RegisterBffHTTPServer(s *http.Server, srv BffHTTPServer) {
 	r.POST("/v1/cancel/subcomment/agree", _Bff_CancelSubCommentAgree0_HTTP_Handler(srv))
 	r.GET("/v1/get/message/notification", _Bff_GetMessageNotification0_HTTP_Handler(srv))
 	r.POST("/v1/get/message/system/notification", _Bff_GetMessageSystemNotification0_HTTP_Handler(srv))
+	r.POST("/v1/get/message/mention", _Bff_GetMessageMention0_HTTP_Handler(srv))
 	r.GET("/v1/get/mailbox/last/time", _Bff_GetMailBoxLastTime0_HTTP_Handler(srv))
 	r.POST("/v1/set/mailbox/last/time", _Bff_SetMailBoxLastTime0_HTTP_Handler(srv))
 	r.POST("/v1/remove/mailbox/comment/count", _Bff_RemoveMailBoxCommentCount0_HTTP_Handler(srv))
 	r.POST("/v1/remove/mailbox/subcomment/count", _Bff_RemoveMailBoxSubCommentCount0_HTTP_Handler(srv))
 	r.POST("/v1/remove/mailbox/system/notification", _Bff_RemoveMailBoxSystemNotificationCount0_HTTP_Handler(srv))
+	r.POST("/v1/remove/mailbox/mention/count", _Bff_RemoveMailBoxMentionCount0_HTTP_Handler(srv))
+	r.POST("/v1/appeal/review", _Bff_AppealReview0_HTTP_Handler(srv))
+	r.GET("/v1/get/user/appeal/list", _Bff_GetUserAppealList0_HTTP_Handler(srv))
+	r.GET("/v1/admin/get/content/flag/list", _Bff_GetContentFlagList0_HTTP_Handler(srv))
+	r.POST("/v1/admin/publish/content/flag", _Bff_PublishContentFlag0_HTTP_Handler(srv))
+	r.POST("/v1/admin/remove/content/flag", _Bff_RemoveContentFlag0_HTTP_Handler(srv))
+	r.POST("/v1/admin/suspend/user", _Bff_SuspendUser0_HTTP_Handler(srv))
+	r.POST("/v1/admin/unsuspend/user", _Bff_UnsuspendUser0_HTTP_Handler(srv))
+	r.POST("/v1/admin/set/user/role", _Bff_SetUserRole0_HTTP_Handler(srv))
+	r.GET("/v1/admin/get/audit/log/list", _Bff_GetAuditLogList0_HTTP_Handler(srv))
+	r.GET("/v1/admin/get/appeal/list", _Bff_GetAppealList0_HTTP_Handler(srv))
+	r.POST("/v1/admin/accept/appeal", _Bff_AcceptAppeal0_HTTP_Handler(srv))
+	r.POST("/v1/admin/reject/appeal", _Bff_RejectAppeal0_HTTP_Handler(srv))
 }
 
 func _Bff_GetHealth5_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
@@ -692,15 +782,34 @@ func _Bff_LoginByCode0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) er
 	}
 }
 
-func _Bff_LoginByWeChat0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetOauthUrl0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LoginByWeChatReq
+		var in GetOauthUrlReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetOauthUrl)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOauthUrl(ctx, req.(*GetOauthUrlReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetOauthUrlReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_LoginByOauth0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginByOauthReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffLoginByWeChat)
+		http.SetOperation(ctx, OperationBffLoginByOauth)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LoginByWeChat(ctx, req.(*LoginByWeChatReq))
+			return srv.LoginByOauth(ctx, req.(*LoginByOauthReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -711,34 +820,34 @@ func _Bff_LoginByWeChat0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _Bff_LoginByQQ0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_LoginPasswordReset0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LoginByQQReq
+		var in LoginPasswordResetReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffLoginByQQ)
+		http.SetOperation(ctx, OperationBffLoginPasswordReset)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LoginByQQ(ctx, req.(*LoginByQQReq))
+			return srv.LoginPasswordReset(ctx, req.(*LoginPasswordResetReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LoginReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_LoginByGithub0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_RefreshToken0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LoginByGithubReq
+		var in RefreshTokenReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffLoginByGithub)
+		http.SetOperation(ctx, OperationBffRefreshToken)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LoginByGithub(ctx, req.(*LoginByGithubReq))
+			return srv.RefreshToken(ctx, req.(*RefreshTokenReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -749,15 +858,72 @@ func _Bff_LoginByGithub0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _Bff_LoginByGitee0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_Logout0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_LogoutAllDevices0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffLogoutAllDevices)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAllDevices(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_ListSessions0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_LoginByTotp0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LoginByGiteeReq
+		var in LoginByTotpReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffLoginByGitee)
+		http.SetOperation(ctx, OperationBffLoginByTotp)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LoginByGitee(ctx, req.(*LoginByGiteeReq))
+			return srv.LoginByTotp(ctx, req.(*LoginByTotpReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -768,15 +934,53 @@ func _Bff_LoginByGitee0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) e
 	}
 }
 
-func _Bff_LoginPasswordReset0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_EnrollTotp0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LoginPasswordResetReq
+		var in emptypb.Empty
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffLoginPasswordReset)
+		http.SetOperation(ctx, OperationBffEnrollTotp)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.LoginPasswordReset(ctx, req.(*LoginPasswordResetReq))
+			return srv.EnrollTotp(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTotpReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_ConfirmTotp0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTotpReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffConfirmTotp)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTotp(ctx, req.(*ConfirmTotpReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConfirmTotpReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_DisableTotp0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableTotpReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffDisableTotp)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableTotp(ctx, req.(*DisableTotpReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1224,15 +1428,15 @@ func _Bff_SetUserPassword0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context
 	}
 }
 
-func _Bff_SetUserWechat0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_SetUserIdentity0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in SetUserWechatReq
+		var in SetUserIdentityReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffSetUserWechat)
+		http.SetOperation(ctx, OperationBffSetUserIdentity)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetUserWechat(ctx, req.(*SetUserWechatReq))
+			return srv.SetUserIdentity(ctx, req.(*SetUserIdentityReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1243,15 +1447,15 @@ func _Bff_SetUserWechat0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _Bff_SetUserQQ0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_SetUserFollow0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in SetUserQQReq
+		var in SetUserFollowReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffSetUserQQ)
+		http.SetOperation(ctx, OperationBffSetUserFollow)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetUserQQ(ctx, req.(*SetUserQQReq))
+			return srv.SetUserFollow(ctx, req.(*SetUserFollowReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1262,15 +1466,15 @@ func _Bff_SetUserQQ0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) erro
 	}
 }
 
-func _Bff_SetUserGitee0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_CancelUserFollow0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in SetUserGiteeReq
+		var in CancelUserFollowReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffSetUserGitee)
+		http.SetOperation(ctx, OperationBffCancelUserFollow)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetUserGitee(ctx, req.(*SetUserGiteeReq))
+			return srv.CancelUserFollow(ctx, req.(*CancelUserFollowReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1281,15 +1485,15 @@ func _Bff_SetUserGitee0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) e
 	}
 }
 
-func _Bff_SetUserGithub0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_BlockUser0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in SetUserGithubReq
+		var in BlockUserReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffSetUserGithub)
+		http.SetOperation(ctx, OperationBffBlockUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetUserGithub(ctx, req.(*SetUserGithubReq))
+			return srv.BlockUser(ctx, req.(*BlockUserReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1300,15 +1504,15 @@ func _Bff_SetUserGithub0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _Bff_SetUserFollow0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_UnblockUser0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in SetUserFollowReq
+		var in UnblockUserReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffSetUserFollow)
+		http.SetOperation(ctx, OperationBffUnblockUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetUserFollow(ctx, req.(*SetUserFollowReq))
+			return srv.UnblockUser(ctx, req.(*UnblockUserReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1319,15 +1523,15 @@ func _Bff_SetUserFollow0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _Bff_CancelUserFollow0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_MuteUser0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CancelUserFollowReq
+		var in MuteUserReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffCancelUserFollow)
+		http.SetOperation(ctx, OperationBffMuteUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CancelUserFollow(ctx, req.(*CancelUserFollowReq))
+			return srv.MuteUser(ctx, req.(*MuteUserReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1338,15 +1542,15 @@ func _Bff_CancelUserFollow0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Contex
 	}
 }
 
-func _Bff_ChangeUserPassword0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_UnmuteUser0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ChangeUserPasswordReq
+		var in UnmuteUserReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffChangeUserPassword)
+		http.SetOperation(ctx, OperationBffUnmuteUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ChangeUserPassword(ctx, req.(*ChangeUserPasswordReq))
+			return srv.UnmuteUser(ctx, req.(*UnmuteUserReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1357,53 +1561,53 @@ func _Bff_ChangeUserPassword0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Cont
 	}
 }
 
-func _Bff_UnbindUserPhone0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetUserBlocks0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnbindUserAccountReq
-		if err := ctx.Bind(&in); err != nil {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffUnbindUserPhone)
+		http.SetOperation(ctx, OperationBffGetUserBlocks)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnbindUserPhone(ctx, req.(*UnbindUserAccountReq))
+			return srv.GetUserBlocks(ctx, req.(*emptypb.Empty))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*GetUserBlocksReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_UnbindUserEmail0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetFollowRecommendations0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnbindUserAccountReq
-		if err := ctx.Bind(&in); err != nil {
+		var in GetFollowRecommendationsReq
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffUnbindUserEmail)
+		http.SetOperation(ctx, OperationBffGetFollowRecommendations)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnbindUserEmail(ctx, req.(*UnbindUserAccountReq))
+			return srv.GetFollowRecommendations(ctx, req.(*GetFollowRecommendationsReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*GetFollowRecommendationsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_UnbindUserWechat0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_SetProfilePrivate0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnbindUserAccountReq
+		var in SetProfilePrivateReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffUnbindUserWechat)
+		http.SetOperation(ctx, OperationBffSetProfilePrivate)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnbindUserWechat(ctx, req.(*UnbindUserAccountReq))
+			return srv.SetProfilePrivate(ctx, req.(*SetProfilePrivateReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1414,34 +1618,34 @@ func _Bff_UnbindUserWechat0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Contex
 	}
 }
 
-func _Bff_UnbindUserQQ0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetFollowRequests0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnbindUserAccountReq
-		if err := ctx.Bind(&in); err != nil {
+		var in GetFollowRequestsReq
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffUnbindUserQQ)
+		http.SetOperation(ctx, OperationBffGetFollowRequests)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnbindUserQQ(ctx, req.(*UnbindUserAccountReq))
+			return srv.GetFollowRequests(ctx, req.(*GetFollowRequestsReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*GetFollowRequestsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_UnbindUserGitee0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_ApproveFollowRequest0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnbindUserAccountReq
+		var in ApproveFollowRequestReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffUnbindUserGitee)
+		http.SetOperation(ctx, OperationBffApproveFollowRequest)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnbindUserGitee(ctx, req.(*UnbindUserAccountReq))
+			return srv.ApproveFollowRequest(ctx, req.(*ApproveFollowRequestReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1452,15 +1656,15 @@ func _Bff_UnbindUserGitee0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context
 	}
 }
 
-func _Bff_UnbindUserGithub0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_RejectFollowRequest0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnbindUserAccountReq
+		var in RejectFollowRequestReq
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffUnbindUserGithub)
+		http.SetOperation(ctx, OperationBffRejectFollowRequest)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnbindUserGithub(ctx, req.(*UnbindUserAccountReq))
+			return srv.RejectFollowRequest(ctx, req.(*RejectFollowRequestReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -1471,254 +1675,444 @@ func _Bff_UnbindUserGithub0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Contex
 	}
 }
 
-func _Bff_GetLeaderBoard0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_SetUserHandle0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in emptypb.Empty
-		if err := ctx.BindQuery(&in); err != nil {
+		var in SetUserHandleReq
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetLeaderBoard)
+		http.SetOperation(ctx, OperationBffSetUserHandle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetLeaderBoard(ctx, req.(*emptypb.Empty))
+			return srv.SetUserHandle(ctx, req.(*SetUserHandleReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetLeaderBoardReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectArticleList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetUserHandle0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectArticleListReq
+		var in emptypb.Empty
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectArticleList)
+		http.SetOperation(ctx, OperationBffGetUserHandle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectArticleList(ctx, req.(*GetCollectArticleListReq))
+			return srv.GetUserHandle(ctx, req.(*emptypb.Empty))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetArticleListReply)
+		reply := out.(*GetUserHandleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectArticleCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetUserByHandle0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectArticleCountReq
+		var in GetUserByHandleReq
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectArticleCount)
+		http.SetOperation(ctx, OperationBffGetUserByHandle)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectArticleCount(ctx, req.(*GetCollectArticleCountReq))
+			return srv.GetUserByHandle(ctx, req.(*GetUserByHandleReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectArticleCountReply)
+		reply := out.(*GetUserByHandleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectTalkList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_ChangeUserPassword0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectTalkListReq
-		if err := ctx.BindQuery(&in); err != nil {
+		var in ChangeUserPasswordReq
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectTalkList)
+		http.SetOperation(ctx, OperationBffChangeUserPassword)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectTalkList(ctx, req.(*GetCollectTalkListReq))
+			return srv.ChangeUserPassword(ctx, req.(*ChangeUserPasswordReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetTalkListReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectTalkCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_UnbindUserPhone0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectTalkCountReq
-		if err := ctx.BindQuery(&in); err != nil {
+		var in UnbindUserAccountReq
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectTalkCount)
+		http.SetOperation(ctx, OperationBffUnbindUserPhone)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectTalkCount(ctx, req.(*GetCollectTalkCountReq))
+			return srv.UnbindUserPhone(ctx, req.(*UnbindUserAccountReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectTalkCountReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectColumnList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_UnbindUserEmail0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectColumnListReq
-		if err := ctx.BindQuery(&in); err != nil {
+		var in UnbindUserAccountReq
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectColumnList)
+		http.SetOperation(ctx, OperationBffUnbindUserEmail)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectColumnList(ctx, req.(*GetCollectColumnListReq))
+			return srv.UnbindUserEmail(ctx, req.(*UnbindUserAccountReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetColumnListReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectColumnCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_UnbindUserIdentity0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectColumnCountReq
-		if err := ctx.BindQuery(&in); err != nil {
+		var in UnbindUserAccountReq
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectColumnCount)
+		http.SetOperation(ctx, OperationBffUnbindUserIdentity)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectColumnCount(ctx, req.(*GetCollectColumnCountReq))
+			return srv.UnbindUserIdentity(ctx, req.(*UnbindUserAccountReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectColumnCountReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollections0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_DeleteAccount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectionsReq
-		if err := ctx.BindQuery(&in); err != nil {
+		var in UnbindUserAccountReq
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollections)
+		http.SetOperation(ctx, OperationBffDeleteAccount)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollections(ctx, req.(*GetCollectionsReq))
+			return srv.DeleteAccount(ctx, req.(*UnbindUserAccountReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectionsReply)
+		reply := out.(*emptypb.Empty)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectionsList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_ExportUserData0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectionsListReq
+		var in emptypb.Empty
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectionsList)
+		http.SetOperation(ctx, OperationBffExportUserData)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectionsList(ctx, req.(*GetCollectionsListReq))
+			return srv.ExportUserData(ctx, req.(*emptypb.Empty))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectionsListReply)
+		reply := out.(*ExportUserDataReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectionsListAll0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetUserDataExport0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectionsListAll)
+		http.SetOperation(ctx, OperationBffGetUserDataExport)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectionsListAll(ctx, req.(*emptypb.Empty))
+			return srv.GetUserDataExport(ctx, req.(*emptypb.Empty))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectionsListReply)
+		reply := out.(*GetUserDataExportReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectionsCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetLeaderBoard0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
-		if err := ctx.Bind(&in); err != nil {
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectionsCount)
+		http.SetOperation(ctx, OperationBffGetLeaderBoard)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectionsCount(ctx, req.(*emptypb.Empty))
+			return srv.GetLeaderBoard(ctx, req.(*emptypb.Empty))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectionsCountReply)
+		reply := out.(*GetLeaderBoardReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectionsListByVisitor0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetCollectArticleList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectionsListByVisitorReq
+		var in GetCollectArticleListReq
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectionsListByVisitor)
+		http.SetOperation(ctx, OperationBffGetCollectArticleList)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectionsListByVisitor(ctx, req.(*GetCollectionsListByVisitorReq))
+			return srv.GetCollectArticleList(ctx, req.(*GetCollectArticleListReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectionsListReply)
+		reply := out.(*GetArticleListReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetCollectionsVisitorCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetCollectArticleCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCollectionsVisitorCountReq
+		var in GetCollectArticleCountReq
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBffGetCollectionsVisitorCount)
+		http.SetOperation(ctx, OperationBffGetCollectArticleCount)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCollectionsVisitorCount(ctx, req.(*GetCollectionsVisitorCountReq))
+			return srv.GetCollectArticleCount(ctx, req.(*GetCollectArticleCountReq))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCollectionsCountReply)
+		reply := out.(*GetCollectArticleCountReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Bff_GetLastCollectionsDraft0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+func _Bff_GetCollectTalkList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectTalkListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectTalkList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectTalkList(ctx, req.(*GetCollectTalkListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTalkListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectTalkCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectTalkCountReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectTalkCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectTalkCount(ctx, req.(*GetCollectTalkCountReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectTalkCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectColumnList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectColumnListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectColumnList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectColumnList(ctx, req.(*GetCollectColumnListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetColumnListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectColumnCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectColumnCountReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectColumnCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectColumnCount(ctx, req.(*GetCollectColumnCountReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectColumnCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollections0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectionsReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollections)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollections(ctx, req.(*GetCollectionsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectionsList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectionsListReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectionsList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionsList(ctx, req.(*GetCollectionsListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionsListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectionsListAll0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectionsListAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionsListAll(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionsListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectionsCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectionsCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionsCount(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionsCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectionsListByVisitor0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectionsListByVisitorReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectionsListByVisitor)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionsListByVisitor(ctx, req.(*GetCollectionsListByVisitorReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionsListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetCollectionsVisitorCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectionsVisitorCountReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetCollectionsVisitorCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollectionsVisitorCount(ctx, req.(*GetCollectionsVisitorCountReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCollectionsCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetLastCollectionsDraft0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
 		if err := ctx.BindQuery(&in); err != nil {
@@ -4245,6 +4639,25 @@ func _Bff_GetMessageSystemNotification0_HTTP_Handler(srv BffHTTPServer) func(ctx
 	}
 }
 
+func _Bff_GetMessageMention0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMessageMentionReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetMessageMention)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMessageMention(ctx, req.(*GetMessageMentionReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMessageMentionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Bff_GetMailBoxLastTime0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
@@ -4340,11 +4753,262 @@ func _Bff_RemoveMailBoxSystemNotificationCount0_HTTP_Handler(srv BffHTTPServer)
 	}
 }
 
+func _Bff_RemoveMailBoxMentionCount0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffRemoveMailBoxMentionCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveMailBoxMentionCount(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_AppealReview0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppealReviewReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffAppealReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AppealReview(ctx, req.(*AppealReviewReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetUserAppealList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserAppealListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetUserAppealList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserAppealList(ctx, req.(*GetUserAppealListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetContentFlagList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetContentFlagListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetContentFlagList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetContentFlagList(ctx, req.(*GetContentFlagListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetContentFlagListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_PublishContentFlag0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishContentFlagReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffPublishContentFlag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishContentFlag(ctx, req.(*PublishContentFlagReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_RemoveContentFlag0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveContentFlagReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffRemoveContentFlag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveContentFlag(ctx, req.(*RemoveContentFlagReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_SuspendUser0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuspendUserReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffSuspendUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuspendUser(ctx, req.(*SuspendUserReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_UnsuspendUser0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnsuspendUserReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffUnsuspendUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnsuspendUser(ctx, req.(*UnsuspendUserReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_SetUserRole0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetUserRoleReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffSetUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetUserRole(ctx, req.(*SetUserRoleReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetAuditLogList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAuditLogListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetAuditLogList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAuditLogList(ctx, req.(*GetAuditLogListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAuditLogListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_GetAppealList0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppealListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffGetAppealList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppealList(ctx, req.(*GetAppealListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_AcceptAppeal0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptAppealReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffAcceptAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptAppeal(ctx, req.(*AcceptAppealReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Bff_RejectAppeal0_HTTP_Handler(srv BffHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectAppealReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBffRejectAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectAppeal(ctx, req.(*RejectAppealReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type BffHTTPClient interface {
+	AcceptAppeal(ctx context.Context, req *AcceptAppealReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	AccessUserMedal(ctx context.Context, req *AccessUserMedalReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	AddColumnIncludes(ctx context.Context, req *AddColumnIncludesReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AppealReview(ctx context.Context, req *AppealReviewReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	ApproveFollowRequest(ctx context.Context, req *ApproveFollowRequestReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	ArticleDraftMark(ctx context.Context, req *ArticleDraftMarkReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	ArticleStatisticJudge(ctx context.Context, req *ArticleStatisticJudgeReq, opts ...http.CallOption) (rsp *ArticleStatisticJudgeReply, err error)
+	BlockUser(ctx context.Context, req *BlockUserReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	CancelArticleAgree(ctx context.Context, req *CancelArticleAgreeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	CancelArticleCollect(ctx context.Context, req *CancelArticleCollectReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	CancelColumnAgree(ctx context.Context, req *CancelColumnAgreeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -4358,19 +5022,25 @@ type BffHTTPClient interface {
 	CancelUserMedalSet(ctx context.Context, req *CancelUserMedalSetReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	ChangeUserPassword(ctx context.Context, req *ChangeUserPasswordReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	ColumnStatisticJudge(ctx context.Context, req *ColumnStatisticJudgeReq, opts ...http.CallOption) (rsp *ColumnStatisticJudgeReply, err error)
+	ConfirmTotp(ctx context.Context, req *ConfirmTotpReq, opts ...http.CallOption) (rsp *ConfirmTotpReply, err error)
 	CreateArticleDraft(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CreateArticleDraftReply, err error)
 	CreateCollectionsDraft(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CreateCollectionsDraftReply, err error)
 	CreateColumnDraft(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CreateColumnDraftReply, err error)
 	CreateCommentDraft(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CreateCommentDraftReply, err error)
 	CreateTalkDraft(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CreateTalkDraftReply, err error)
+	DeleteAccount(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteArticle(ctx context.Context, req *DeleteArticleReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteArticleDraft(ctx context.Context, req *DeleteArticleDraftReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteCollections(ctx context.Context, req *DeleteCollectionsReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteColumn(ctx context.Context, req *DeleteColumnReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteColumnIncludes(ctx context.Context, req *DeleteColumnIncludesReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteTalk(ctx context.Context, req *DeleteTalkReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DisableTotp(ctx context.Context, req *DisableTotpReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	EnrollTotp(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *EnrollTotpReply, err error)
+	ExportUserData(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ExportUserDataReply, err error)
 	GetAccount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetAccountReply, err error)
 	GetAchievementList(ctx context.Context, req *GetAchievementListReq, opts ...http.CallOption) (rsp *GetAchievementListReply, err error)
+	GetAppealList(ctx context.Context, req *GetAppealListReq, opts ...http.CallOption) (rsp *GetAppealListReply, err error)
 	GetArticleContentReview(ctx context.Context, req *GetArticleContentReviewReq, opts ...http.CallOption) (rsp *GetArticleContentReviewReply, err error)
 	GetArticleCount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetArticleCountReply, err error)
 	GetArticleCountVisitor(ctx context.Context, req *GetArticleCountVisitorReq, opts ...http.CallOption) (rsp *GetArticleCountReply, err error)
@@ -4381,6 +5051,7 @@ type BffHTTPClient interface {
 	GetArticleListStatistic(ctx context.Context, req *GetArticleListStatisticReq, opts ...http.CallOption) (rsp *GetArticleListStatisticReply, err error)
 	GetArticleSearch(ctx context.Context, req *GetArticleSearchReq, opts ...http.CallOption) (rsp *GetArticleSearchReply, err error)
 	GetArticleStatistic(ctx context.Context, req *GetArticleStatisticReq, opts ...http.CallOption) (rsp *GetArticleStatisticReply, err error)
+	GetAuditLogList(ctx context.Context, req *GetAuditLogListReq, opts ...http.CallOption) (rsp *GetAuditLogListReply, err error)
 	GetAvatarReview(ctx context.Context, req *GetAvatarReviewReq, opts ...http.CallOption) (rsp *GetAvatarReviewReply, err error)
 	GetCollectArticleCount(ctx context.Context, req *GetCollectArticleCountReq, opts ...http.CallOption) (rsp *GetCollectArticleCountReply, err error)
 	GetCollectArticleList(ctx context.Context, req *GetCollectArticleListReq, opts ...http.CallOption) (rsp *GetArticleListReply, err error)
@@ -4410,10 +5081,13 @@ type BffHTTPClient interface {
 	GetCommentList(ctx context.Context, req *GetCommentListReq, opts ...http.CallOption) (rsp *GetCommentListReply, err error)
 	GetCommentListHot(ctx context.Context, req *GetCommentListReq, opts ...http.CallOption) (rsp *GetCommentListReply, err error)
 	GetCommentUser(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetCommentUserReply, err error)
+	GetContentFlagList(ctx context.Context, req *GetContentFlagListReq, opts ...http.CallOption) (rsp *GetContentFlagListReply, err error)
 	GetCosSessionKey(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetCosSessionKeyReply, err error)
 	GetCoverReview(ctx context.Context, req *GetCoverReviewReq, opts ...http.CallOption) (rsp *GetCoverReviewReply, err error)
 	GetFollowList(ctx context.Context, req *GetFollowListReq, opts ...http.CallOption) (rsp *GetFollowListReply, err error)
 	GetFollowListCount(ctx context.Context, req *GetFollowListCountReq, opts ...http.CallOption) (rsp *GetFollowListCountReply, err error)
+	GetFollowRecommendations(ctx context.Context, req *GetFollowRecommendationsReq, opts ...http.CallOption) (rsp *GetFollowRecommendationsReply, err error)
+	GetFollowRequests(ctx context.Context, req *GetFollowRequestsReq, opts ...http.CallOption) (rsp *GetFollowRequestsReply, err error)
 	GetFollowedList(ctx context.Context, req *GetFollowedListReq, opts ...http.CallOption) (rsp *GetFollowedListReply, err error)
 	GetFollowedListCount(ctx context.Context, req *GetFollowedListCountReq, opts ...http.CallOption) (rsp *GetFollowedListCountReply, err error)
 	GetHealth(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -4424,10 +5098,12 @@ type BffHTTPClient interface {
 	GetLastTalkDraft(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetLastTalkDraftReply, err error)
 	GetLeaderBoard(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetLeaderBoardReply, err error)
 	GetMailBoxLastTime(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetMailBoxLastTimeReply, err error)
+	GetMessageMention(ctx context.Context, req *GetMessageMentionReq, opts ...http.CallOption) (rsp *GetMessageMentionReply, err error)
 	GetMessageNotification(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetMessageNotificationReply, err error)
 	GetMessageSystemNotification(ctx context.Context, req *GetMessageSystemNotificationReq, opts ...http.CallOption) (rsp *GetMessageSystemNotificationReply, err error)
 	GetNews(ctx context.Context, req *GetNewsReq, opts ...http.CallOption) (rsp *GetNewsReply, err error)
 	GetNewsSearch(ctx context.Context, req *GetNewsSearchReq, opts ...http.CallOption) (rsp *GetNewsSearchReply, err error)
+	GetOauthUrl(ctx context.Context, req *GetOauthUrlReq, opts ...http.CallOption) (rsp *GetOauthUrlReply, err error)
 	GetProfile(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetProfileReply, err error)
 	GetProfileList(ctx context.Context, req *GetProfileListReq, opts ...http.CallOption) (rsp *GetProfileListReply, err error)
 	GetProfileUpdate(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetProfileUpdateReply, err error)
@@ -4445,12 +5121,15 @@ type BffHTTPClient interface {
 	GetTalkStatistic(ctx context.Context, req *GetTalkStatisticReq, opts ...http.CallOption) (rsp *GetTalkStatisticReply, err error)
 	GetTimeLineUsers(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetTimeLineUsersReply, err error)
 	GetUserAchievement(ctx context.Context, req *GetUserAchievementReq, opts ...http.CallOption) (rsp *GetUserAchievementReply, err error)
+	GetUserAppealList(ctx context.Context, req *GetUserAppealListReq, opts ...http.CallOption) (rsp *GetAppealListReply, err error)
 	GetUserArticleAgree(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserArticleAgreeReply, err error)
 	GetUserArticleCollect(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserArticleCollectReply, err error)
 	GetUserArticleList(ctx context.Context, req *GetUserArticleListReq, opts ...http.CallOption) (rsp *GetArticleListReply, err error)
 	GetUserArticleListAll(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetArticleListReply, err error)
 	GetUserArticleListSimple(ctx context.Context, req *GetUserArticleListSimpleReq, opts ...http.CallOption) (rsp *GetArticleListReply, err error)
 	GetUserArticleListVisitor(ctx context.Context, req *GetUserArticleListVisitorReq, opts ...http.CallOption) (rsp *GetArticleListReply, err error)
+	GetUserBlocks(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserBlocksReply, err error)
+	GetUserByHandle(ctx context.Context, req *GetUserByHandleReq, opts ...http.CallOption) (rsp *GetUserByHandleReply, err error)
 	GetUserColumnAgree(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserColumnAgreeReply, err error)
 	GetUserColumnCollect(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserColumnCollectReply, err error)
 	GetUserColumnList(ctx context.Context, req *GetUserColumnListReq, opts ...http.CallOption) (rsp *GetColumnListReply, err error)
@@ -4462,8 +5141,10 @@ type BffHTTPClient interface {
 	GetUserCommentRepliedList(ctx context.Context, req *GetUserCommentRepliedListReq, opts ...http.CallOption) (rsp *GetUserCommentRepliedListReply, err error)
 	GetUserCommentTalkRepliedList(ctx context.Context, req *GetUserCommentTalkRepliedListReq, opts ...http.CallOption) (rsp *GetUserCommentTalkRepliedListReply, err error)
 	GetUserCommentTalkReplyList(ctx context.Context, req *GetUserCommentTalkReplyListReq, opts ...http.CallOption) (rsp *GetUserCommentTalkReplyListReply, err error)
+	GetUserDataExport(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserDataExportReply, err error)
 	GetUserFollow(ctx context.Context, req *GetUserFollowReq, opts ...http.CallOption) (rsp *GetUserFollowReply, err error)
 	GetUserFollows(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserFollowsReply, err error)
+	GetUserHandle(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserHandleReply, err error)
 	GetUserInfo(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserInfoReply, err error)
 	GetUserInfoVisitor(ctx context.Context, req *GetUserInfoVisitorReq, opts ...http.CallOption) (rsp *GetUserInfoReply, err error)
 	GetUserMedal(ctx context.Context, req *GetUserMedalReq, opts ...http.CallOption) (rsp *GetUserMedalReply, err error)
@@ -4481,15 +5162,23 @@ type BffHTTPClient interface {
 	GetUserTalkListSimple(ctx context.Context, req *GetUserTalkListSimpleReq, opts ...http.CallOption) (rsp *GetTalkListReply, err error)
 	GetUserTalkListVisitor(ctx context.Context, req *GetUserTalkListVisitorReq, opts ...http.CallOption) (rsp *GetTalkListReply, err error)
 	GetUserTimeLineListVisitor(ctx context.Context, req *GetUserTimeLineListReq, opts ...http.CallOption) (rsp *GetUserTimeLineListReply, err error)
+	ListSessions(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
 	LoginByCode(ctx context.Context, req *LoginByCodeReq, opts ...http.CallOption) (rsp *LoginReply, err error)
-	LoginByGitee(ctx context.Context, req *LoginByGiteeReq, opts ...http.CallOption) (rsp *LoginReply, err error)
-	LoginByGithub(ctx context.Context, req *LoginByGithubReq, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginByOauth(ctx context.Context, req *LoginByOauthReq, opts ...http.CallOption) (rsp *LoginReply, err error)
 	LoginByPassword(ctx context.Context, req *LoginByPasswordReq, opts ...http.CallOption) (rsp *LoginReply, err error)
-	LoginByQQ(ctx context.Context, req *LoginByQQReq, opts ...http.CallOption) (rsp *LoginReply, err error)
-	LoginByWeChat(ctx context.Context, req *LoginByWeChatReq, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginByTotp(ctx context.Context, req *LoginByTotpReq, opts ...http.CallOption) (rsp *LoginReply, err error)
 	LoginPasswordReset(ctx context.Context, req *LoginPasswordResetReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	Logout(ctx context.Context, req *LogoutReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	LogoutAllDevices(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	MuteUser(ctx context.Context, req *MuteUserReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	PublishContentFlag(ctx context.Context, req *PublishContentFlagReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenReq, opts ...http.CallOption) (rsp *LoginReply, err error)
+	RejectAppeal(ctx context.Context, req *RejectAppealReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RejectFollowRequest(ctx context.Context, req *RejectFollowRequestReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	RemoveComment(ctx context.Context, req *RemoveCommentReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RemoveContentFlag(ctx context.Context, req *RemoveContentFlagReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	RemoveMailBoxCommentCount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RemoveMailBoxMentionCount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	RemoveMailBoxSubCommentCount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	RemoveMailBoxSystemNotificationCount(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	RemoveSubComment(ctx context.Context, req *RemoveSubCommentReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -4513,6 +5202,7 @@ type BffHTTPClient interface {
 	SetColumnView(ctx context.Context, req *SetColumnViewReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetCommentAgree(ctx context.Context, req *SetCommentAgreeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetMailBoxLastTime(ctx context.Context, req *SetMailBoxLastTimeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	SetProfilePrivate(ctx context.Context, req *SetProfilePrivateReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetProfileUpdate(ctx context.Context, req *SetProfileUpdateReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetSubCommentAgree(ctx context.Context, req *SetSubCommentAgreeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetTalkAgree(ctx context.Context, req *SetTalkAgreeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -4520,22 +5210,22 @@ type BffHTTPClient interface {
 	SetTalkView(ctx context.Context, req *SetTalkViewReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetUserEmail(ctx context.Context, req *SetUserEmailReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetUserFollow(ctx context.Context, req *SetUserFollowReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	SetUserGitee(ctx context.Context, req *SetUserGiteeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	SetUserGithub(ctx context.Context, req *SetUserGithubReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	SetUserHandle(ctx context.Context, req *SetUserHandleReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	SetUserIdentity(ctx context.Context, req *SetUserIdentityReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetUserMedal(ctx context.Context, req *SetUserMedalReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetUserPassword(ctx context.Context, req *SetUserPasswordReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SetUserPhone(ctx context.Context, req *SetUserPhoneReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	SetUserQQ(ctx context.Context, req *SetUserQQReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	SetUserWechat(ctx context.Context, req *SetUserWechatReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	SetUserRole(ctx context.Context, req *SetUserRoleReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SubscribeColumn(ctx context.Context, req *SubscribeColumnReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SubscribeJudge(ctx context.Context, req *SubscribeJudgeReq, opts ...http.CallOption) (rsp *SubscribeJudgeReply, err error)
+	SuspendUser(ctx context.Context, req *SuspendUserReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	TalkStatisticJudge(ctx context.Context, req *TalkStatisticJudgeReq, opts ...http.CallOption) (rsp *TalkStatisticJudgeReply, err error)
 	UnbindUserEmail(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	UnbindUserGitee(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	UnbindUserGithub(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UnbindUserIdentity(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UnbindUserPhone(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	UnbindUserQQ(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	UnbindUserWechat(ctx context.Context, req *UnbindUserAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UnblockUser(ctx context.Context, req *UnblockUserReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UnmuteUser(ctx context.Context, req *UnmuteUserReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UnsuspendUser(ctx context.Context, req *UnsuspendUserReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UserRegister(ctx context.Context, req *UserRegisterReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
 
@@ -4547,6 +5237,19 @@ func NewBffHTTPClient(client *http.Client) BffHTTPClient {
 	return &BffHTTPClientImpl{client}
 }
 
+func (c *BffHTTPClientImpl) AcceptAppeal(ctx context.Context, in *AcceptAppealReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/admin/accept/appeal"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffAcceptAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) AccessUserMedal(ctx context.Context, in *AccessUserMedalReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/access/user/medal"
@@ -4573,6 +5276,32 @@ func (c *BffHTTPClientImpl) AddColumnIncludes(ctx context.Context, in *AddColumn
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) AppealReview(ctx context.Context, in *AppealReviewReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/appeal/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffAppealReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/approve/follow/request"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffApproveFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) ArticleDraftMark(ctx context.Context, in *ArticleDraftMarkReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/article/draft/mark"
@@ -4599,6 +5328,19 @@ func (c *BffHTTPClientImpl) ArticleStatisticJudge(ctx context.Context, in *Artic
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) BlockUser(ctx context.Context, in *BlockUserReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/block/user"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffBlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) CancelArticleAgree(ctx context.Context, in *CancelArticleAgreeReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/cancel/article/agree"
@@ -4768,6 +5510,19 @@ func (c *BffHTTPClientImpl) ColumnStatisticJudge(ctx context.Context, in *Column
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...http.CallOption) (*ConfirmTotpReply, error) {
+	var out ConfirmTotpReply
+	pattern := "/v1/user/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffConfirmTotp))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) CreateArticleDraft(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*CreateArticleDraftReply, error) {
 	var out CreateArticleDraftReply
 	pattern := "/v1/create/article/draft"
@@ -4833,6 +5588,19 @@ func (c *BffHTTPClientImpl) CreateTalkDraft(ctx context.Context, in *emptypb.Emp
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) DeleteAccount(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/delete/account"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffDeleteAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticleReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/delete/article"
@@ -4846,11 +5614,50 @@ func (c *BffHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticle
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) DeleteArticleDraft(ctx context.Context, in *DeleteArticleDraftReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) DeleteArticleDraft(ctx context.Context, in *DeleteArticleDraftReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/delete/article/draft"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffDeleteArticleDraft))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) DeleteCollections(ctx context.Context, in *DeleteCollectionsReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/delete/collections"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffDeleteCollections))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) DeleteColumn(ctx context.Context, in *DeleteColumnReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/delete/column"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffDeleteColumn))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) DeleteColumnIncludes(ctx context.Context, in *DeleteColumnIncludesReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/delete/article/draft"
+	pattern := "/v1/delete/column/includes"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffDeleteArticleDraft))
+	opts = append(opts, http.Operation(OperationBffDeleteColumnIncludes))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -4859,11 +5666,11 @@ func (c *BffHTTPClientImpl) DeleteArticleDraft(ctx context.Context, in *DeleteAr
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) DeleteCollections(ctx context.Context, in *DeleteCollectionsReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) DeleteTalk(ctx context.Context, in *DeleteTalkReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/delete/collections"
+	pattern := "/v1/delete/talk"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffDeleteCollections))
+	opts = append(opts, http.Operation(OperationBffDeleteTalk))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -4872,11 +5679,11 @@ func (c *BffHTTPClientImpl) DeleteCollections(ctx context.Context, in *DeleteCol
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) DeleteColumn(ctx context.Context, in *DeleteColumnReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/delete/column"
+	pattern := "/v1/user/totp/disable"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffDeleteColumn))
+	opts = append(opts, http.Operation(OperationBffDisableTotp))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -4885,11 +5692,11 @@ func (c *BffHTTPClientImpl) DeleteColumn(ctx context.Context, in *DeleteColumnRe
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) DeleteColumnIncludes(ctx context.Context, in *DeleteColumnIncludesReq, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/delete/column/includes"
+func (c *BffHTTPClientImpl) EnrollTotp(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*EnrollTotpReply, error) {
+	var out EnrollTotpReply
+	pattern := "/v1/user/totp/enroll"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffDeleteColumnIncludes))
+	opts = append(opts, http.Operation(OperationBffEnrollTotp))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -4898,11 +5705,11 @@ func (c *BffHTTPClientImpl) DeleteColumnIncludes(ctx context.Context, in *Delete
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) DeleteTalk(ctx context.Context, in *DeleteTalkReq, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/delete/talk"
+func (c *BffHTTPClientImpl) ExportUserData(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ExportUserDataReply, error) {
+	var out ExportUserDataReply
+	pattern := "/v1/export/user/data"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffDeleteTalk))
+	opts = append(opts, http.Operation(OperationBffExportUserData))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -4937,6 +5744,19 @@ func (c *BffHTTPClientImpl) GetAchievementList(ctx context.Context, in *GetAchie
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetAppealList(ctx context.Context, in *GetAppealListReq, opts ...http.CallOption) (*GetAppealListReply, error) {
+	var out GetAppealListReply
+	pattern := "/v1/admin/get/appeal/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetAppealList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetArticleContentReview(ctx context.Context, in *GetArticleContentReviewReq, opts ...http.CallOption) (*GetArticleContentReviewReply, error) {
 	var out GetArticleContentReviewReply
 	pattern := "/v1/get/article/content/review"
@@ -5067,6 +5887,19 @@ func (c *BffHTTPClientImpl) GetArticleStatistic(ctx context.Context, in *GetArti
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...http.CallOption) (*GetAuditLogListReply, error) {
+	var out GetAuditLogListReply
+	pattern := "/v1/admin/get/audit/log/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetAuditLogList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetAvatarReview(ctx context.Context, in *GetAvatarReviewReq, opts ...http.CallOption) (*GetAvatarReviewReply, error) {
 	var out GetAvatarReviewReply
 	pattern := "/v1/get/avatar/review"
@@ -5444,6 +6277,19 @@ func (c *BffHTTPClientImpl) GetCommentUser(ctx context.Context, in *emptypb.Empt
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetContentFlagList(ctx context.Context, in *GetContentFlagListReq, opts ...http.CallOption) (*GetContentFlagListReply, error) {
+	var out GetContentFlagListReply
+	pattern := "/v1/admin/get/content/flag/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetContentFlagList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetCosSessionKey(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetCosSessionKeyReply, error) {
 	var out GetCosSessionKeyReply
 	pattern := "/v1/get/cos/session/key"
@@ -5496,6 +6342,32 @@ func (c *BffHTTPClientImpl) GetFollowListCount(ctx context.Context, in *GetFollo
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsReq, opts ...http.CallOption) (*GetFollowRecommendationsReply, error) {
+	var out GetFollowRecommendationsReply
+	pattern := "/v1/get/follow/recommendations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetFollowRecommendations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) GetFollowRequests(ctx context.Context, in *GetFollowRequestsReq, opts ...http.CallOption) (*GetFollowRequestsReply, error) {
+	var out GetFollowRequestsReply
+	pattern := "/v1/get/follow/requests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetFollowRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetFollowedList(ctx context.Context, in *GetFollowedListReq, opts ...http.CallOption) (*GetFollowedListReply, error) {
 	var out GetFollowedListReply
 	pattern := "/v1/get/followed/list"
@@ -5626,6 +6498,19 @@ func (c *BffHTTPClientImpl) GetMailBoxLastTime(ctx context.Context, in *emptypb.
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetMessageMention(ctx context.Context, in *GetMessageMentionReq, opts ...http.CallOption) (*GetMessageMentionReply, error) {
+	var out GetMessageMentionReply
+	pattern := "/v1/get/message/mention"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffGetMessageMention))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetMessageNotification(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetMessageNotificationReply, error) {
 	var out GetMessageNotificationReply
 	pattern := "/v1/get/message/notification"
@@ -5678,6 +6563,19 @@ func (c *BffHTTPClientImpl) GetNewsSearch(ctx context.Context, in *GetNewsSearch
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetOauthUrl(ctx context.Context, in *GetOauthUrlReq, opts ...http.CallOption) (*GetOauthUrlReply, error) {
+	var out GetOauthUrlReply
+	pattern := "/v1/user/oauth/url"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetOauthUrl))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetProfile(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetProfileReply, error) {
 	var out GetProfileReply
 	pattern := "/v1/get/user/profile"
@@ -5899,6 +6797,19 @@ func (c *BffHTTPClientImpl) GetUserAchievement(ctx context.Context, in *GetUserA
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetUserAppealList(ctx context.Context, in *GetUserAppealListReq, opts ...http.CallOption) (*GetAppealListReply, error) {
+	var out GetAppealListReply
+	pattern := "/v1/get/user/appeal/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetUserAppealList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetUserArticleAgree(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserArticleAgreeReply, error) {
 	var out GetUserArticleAgreeReply
 	pattern := "/v1/get/user/article/agree"
@@ -5977,6 +6888,32 @@ func (c *BffHTTPClientImpl) GetUserArticleListVisitor(ctx context.Context, in *G
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetUserBlocks(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserBlocksReply, error) {
+	var out GetUserBlocksReply
+	pattern := "/v1/get/user/blocks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetUserBlocks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) GetUserByHandle(ctx context.Context, in *GetUserByHandleReq, opts ...http.CallOption) (*GetUserByHandleReply, error) {
+	var out GetUserByHandleReply
+	pattern := "/v1/get/user/by/handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetUserByHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetUserColumnAgree(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserColumnAgreeReply, error) {
 	var out GetUserColumnAgreeReply
 	pattern := "/v1/get/user/column/agree"
@@ -6120,6 +7057,19 @@ func (c *BffHTTPClientImpl) GetUserCommentTalkReplyList(ctx context.Context, in
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetUserDataExport(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserDataExportReply, error) {
+	var out GetUserDataExportReply
+	pattern := "/v1/get/user/data/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetUserDataExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetUserFollow(ctx context.Context, in *GetUserFollowReq, opts ...http.CallOption) (*GetUserFollowReply, error) {
 	var out GetUserFollowReply
 	pattern := "/v1/get/user/follow"
@@ -6146,6 +7096,19 @@ func (c *BffHTTPClientImpl) GetUserFollows(ctx context.Context, in *emptypb.Empt
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) GetUserHandle(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserHandleReply, error) {
+	var out GetUserHandleReply
+	pattern := "/v1/get/user/handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffGetUserHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) GetUserInfo(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserInfoReply, error) {
 	var out GetUserInfoReply
 	pattern := "/v1/get/user/info"
@@ -6367,6 +7330,19 @@ func (c *BffHTTPClientImpl) GetUserTimeLineListVisitor(ctx context.Context, in *
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/v1/get/user/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBffListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) LoginByCode(ctx context.Context, in *LoginByCodeReq, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/v1/user/login/code"
@@ -6380,11 +7356,11 @@ func (c *BffHTTPClientImpl) LoginByCode(ctx context.Context, in *LoginByCodeReq,
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) LoginByGitee(ctx context.Context, in *LoginByGiteeReq, opts ...http.CallOption) (*LoginReply, error) {
+func (c *BffHTTPClientImpl) LoginByOauth(ctx context.Context, in *LoginByOauthReq, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
-	pattern := "/v1/user/login/gitee"
+	pattern := "/v1/user/login/oauth"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffLoginByGitee))
+	opts = append(opts, http.Operation(OperationBffLoginByOauth))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6393,11 +7369,11 @@ func (c *BffHTTPClientImpl) LoginByGitee(ctx context.Context, in *LoginByGiteeRe
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) LoginByGithub(ctx context.Context, in *LoginByGithubReq, opts ...http.CallOption) (*LoginReply, error) {
+func (c *BffHTTPClientImpl) LoginByPassword(ctx context.Context, in *LoginByPasswordReq, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
-	pattern := "/v1/user/login/github"
+	pattern := "/v1/user/login/password"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffLoginByGithub))
+	opts = append(opts, http.Operation(OperationBffLoginByPassword))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6406,11 +7382,11 @@ func (c *BffHTTPClientImpl) LoginByGithub(ctx context.Context, in *LoginByGithub
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) LoginByPassword(ctx context.Context, in *LoginByPasswordReq, opts ...http.CallOption) (*LoginReply, error) {
+func (c *BffHTTPClientImpl) LoginByTotp(ctx context.Context, in *LoginByTotpReq, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
-	pattern := "/v1/user/login/password"
+	pattern := "/v1/user/login/totp"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffLoginByPassword))
+	opts = append(opts, http.Operation(OperationBffLoginByTotp))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6419,11 +7395,63 @@ func (c *BffHTTPClientImpl) LoginByPassword(ctx context.Context, in *LoginByPass
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) LoginByQQ(ctx context.Context, in *LoginByQQReq, opts ...http.CallOption) (*LoginReply, error) {
-	var out LoginReply
-	pattern := "/v1/user/login/qq"
+func (c *BffHTTPClientImpl) LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/user/login/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffLoginPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) Logout(ctx context.Context, in *LogoutReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/user/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) LogoutAllDevices(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/user/logout/all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffLogoutAllDevices))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) MuteUser(ctx context.Context, in *MuteUserReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/mute/user"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffMuteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) PublishContentFlag(ctx context.Context, in *PublishContentFlagReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/admin/publish/content/flag"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffLoginByQQ))
+	opts = append(opts, http.Operation(OperationBffPublishContentFlag))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6432,11 +7460,11 @@ func (c *BffHTTPClientImpl) LoginByQQ(ctx context.Context, in *LoginByQQReq, opt
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) LoginByWeChat(ctx context.Context, in *LoginByWeChatReq, opts ...http.CallOption) (*LoginReply, error) {
+func (c *BffHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
-	pattern := "/v1/user/login/wechat"
+	pattern := "/v1/user/token/refresh"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffLoginByWeChat))
+	opts = append(opts, http.Operation(OperationBffRefreshToken))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6445,11 +7473,24 @@ func (c *BffHTTPClientImpl) LoginByWeChat(ctx context.Context, in *LoginByWeChat
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) RejectAppeal(ctx context.Context, in *RejectAppealReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/user/login/password/reset"
+	pattern := "/v1/admin/reject/appeal"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffLoginPasswordReset))
+	opts = append(opts, http.Operation(OperationBffRejectAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *BffHTTPClientImpl) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/reject/follow/request"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffRejectFollowRequest))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6471,6 +7512,19 @@ func (c *BffHTTPClientImpl) RemoveComment(ctx context.Context, in *RemoveComment
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) RemoveContentFlag(ctx context.Context, in *RemoveContentFlagReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/admin/remove/content/flag"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffRemoveContentFlag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) RemoveMailBoxCommentCount(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/remove/mailbox/comment/count"
@@ -6484,6 +7538,19 @@ func (c *BffHTTPClientImpl) RemoveMailBoxCommentCount(ctx context.Context, in *e
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) RemoveMailBoxMentionCount(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/remove/mailbox/mention/count"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffRemoveMailBoxMentionCount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) RemoveMailBoxSubCommentCount(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/remove/mailbox/subcomment/count"
@@ -6783,6 +7850,19 @@ func (c *BffHTTPClientImpl) SetMailBoxLastTime(ctx context.Context, in *SetMailB
 	return &out, err
 }
 
+func (c *BffHTTPClientImpl) SetProfilePrivate(ctx context.Context, in *SetProfilePrivateReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/set/profile/private"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBffSetProfilePrivate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *BffHTTPClientImpl) SetProfileUpdate(ctx context.Context, in *SetProfileUpdateReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/set/user/profile/update"
@@ -6874,11 +7954,11 @@ func (c *BffHTTPClientImpl) SetUserFollow(ctx context.Context, in *SetUserFollow
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) SetUserGitee(ctx context.Context, in *SetUserGiteeReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) SetUserHandle(ctx context.Context, in *SetUserHandleReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/set/user/gitee"
+	pattern := "/v1/set/user/handle"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffSetUserGitee))
+	opts = append(opts, http.Operation(OperationBffSetUserHandle))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6887,11 +7967,11 @@ func (c *BffHTTPClientImpl) SetUserGitee(ctx context.Context, in *SetUserGiteeRe
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) SetUserGithub(ctx context.Context, in *SetUserGithubReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) SetUserIdentity(ctx context.Context, in *SetUserIdentityReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/set/user/github"
+	pattern := "/v1/set/user/identity"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffSetUserGithub))
+	opts = append(opts, http.Operation(OperationBffSetUserIdentity))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6939,11 +8019,11 @@ func (c *BffHTTPClientImpl) SetUserPhone(ctx context.Context, in *SetUserPhoneRe
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) SetUserQQ(ctx context.Context, in *SetUserQQReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/set/user/qq"
+	pattern := "/v1/admin/set/user/role"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffSetUserQQ))
+	opts = append(opts, http.Operation(OperationBffSetUserRole))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6952,11 +8032,11 @@ func (c *BffHTTPClientImpl) SetUserQQ(ctx context.Context, in *SetUserQQReq, opt
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) SetUserWechat(ctx context.Context, in *SetUserWechatReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) SubscribeColumn(ctx context.Context, in *SubscribeColumnReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/set/user/wechat"
+	pattern := "/v1/subscribe/column"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffSetUserWechat))
+	opts = append(opts, http.Operation(OperationBffSubscribeColumn))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6965,11 +8045,11 @@ func (c *BffHTTPClientImpl) SetUserWechat(ctx context.Context, in *SetUserWechat
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) SubscribeColumn(ctx context.Context, in *SubscribeColumnReq, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/v1/subscribe/column"
+func (c *BffHTTPClientImpl) SubscribeJudge(ctx context.Context, in *SubscribeJudgeReq, opts ...http.CallOption) (*SubscribeJudgeReply, error) {
+	var out SubscribeJudgeReply
+	pattern := "/v1/subscribe/column/judge"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffSubscribeColumn))
+	opts = append(opts, http.Operation(OperationBffSubscribeJudge))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -6978,11 +8058,11 @@ func (c *BffHTTPClientImpl) SubscribeColumn(ctx context.Context, in *SubscribeCo
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) SubscribeJudge(ctx context.Context, in *SubscribeJudgeReq, opts ...http.CallOption) (*SubscribeJudgeReply, error) {
-	var out SubscribeJudgeReply
-	pattern := "/v1/subscribe/column/judge"
+func (c *BffHTTPClientImpl) SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/admin/suspend/user"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffSubscribeJudge))
+	opts = append(opts, http.Operation(OperationBffSuspendUser))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -7017,11 +8097,11 @@ func (c *BffHTTPClientImpl) UnbindUserEmail(ctx context.Context, in *UnbindUserA
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) UnbindUserGitee(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) UnbindUserIdentity(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/unbind/user/gitee"
+	pattern := "/v1/unbind/user/identity"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffUnbindUserGitee))
+	opts = append(opts, http.Operation(OperationBffUnbindUserIdentity))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -7030,11 +8110,11 @@ func (c *BffHTTPClientImpl) UnbindUserGitee(ctx context.Context, in *UnbindUserA
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) UnbindUserGithub(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) UnbindUserPhone(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/unbind/user/github"
+	pattern := "/v1/unbind/user/phone"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffUnbindUserGithub))
+	opts = append(opts, http.Operation(OperationBffUnbindUserPhone))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -7043,11 +8123,11 @@ func (c *BffHTTPClientImpl) UnbindUserGithub(ctx context.Context, in *UnbindUser
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) UnbindUserPhone(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/unbind/user/phone"
+	pattern := "/v1/unblock/user"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffUnbindUserPhone))
+	opts = append(opts, http.Operation(OperationBffUnblockUser))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -7056,11 +8136,11 @@ func (c *BffHTTPClientImpl) UnbindUserPhone(ctx context.Context, in *UnbindUserA
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) UnbindUserQQ(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) UnmuteUser(ctx context.Context, in *UnmuteUserReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/unbind/user/qq"
+	pattern := "/v1/unmute/user"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffUnbindUserQQ))
+	opts = append(opts, http.Operation(OperationBffUnmuteUser))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -7069,11 +8149,11 @@ func (c *BffHTTPClientImpl) UnbindUserQQ(ctx context.Context, in *UnbindUserAcco
 	return &out, err
 }
 
-func (c *BffHTTPClientImpl) UnbindUserWechat(ctx context.Context, in *UnbindUserAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+func (c *BffHTTPClientImpl) UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
-	pattern := "/v1/unbind/user/wechat"
+	pattern := "/v1/admin/unsuspend/user"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBffUnbindUserWechat))
+	opts = append(opts, http.Operation(OperationBffUnsuspendUser))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -975,6 +975,10 @@ func (m *SendCommentReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for CreationId
+
+	// no validation rules for CreationType
+
 	if len(errors) > 0 {
 		return SendCommentReqMultiError(errors)
 	}
@@ -1101,6 +1105,10 @@ func (m *SendSubCommentReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for RootId
+
+	// no validation rules for ParentId
+
 	if len(errors) > 0 {
 		return SendSubCommentReqMultiError(errors)
 	}
@@ -6751,6 +6759,366 @@ var _ interface {
 
 var _RemoveSubCommentReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
+// Validate checks the field values on PurgeUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserDataReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeUserDataReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeUserDataReqMultiError, or nil if none found.
+func (m *PurgeUserDataReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeUserDataReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PurgeUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := PurgeUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgeUserDataReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by PurgeUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeUserDataReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeUserDataReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeUserDataReqMultiError) AllErrors() []error { return m }
+
+// PurgeUserDataReqValidationError is the validation error returned by
+// PurgeUserDataReq.Validate if the designated constraints aren't met.
+type PurgeUserDataReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeUserDataReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeUserDataReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeUserDataReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeUserDataReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeUserDataReqValidationError) ErrorName() string { return "PurgeUserDataReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeUserDataReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeUserDataReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeUserDataReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeUserDataReqValidationError{}
+
+var _PurgeUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserDataReqMultiError,
+// or nil if none found.
+func (m *GetUserDataReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserDataReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReqMultiError) AllErrors() []error { return m }
+
+// GetUserDataReqValidationError is the validation error returned by
+// GetUserDataReq.Validate if the designated constraints aren't met.
+type GetUserDataReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReqValidationError) ErrorName() string { return "GetUserDataReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReqValidationError{}
+
+var _GetUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReplyMultiError, or nil if none found.
+func (m *GetUserDataReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserDataReplyValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserDataReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReplyMultiError) AllErrors() []error { return m }
+
+// GetUserDataReplyValidationError is the validation error returned by
+// GetUserDataReply.Validate if the designated constraints aren't met.
+type GetUserDataReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReplyValidationError) ErrorName() string { return "GetUserDataReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReplyValidationError{}
+
 // Validate checks the field values on GetCommentListReply_Comment with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -8403,3 +8771,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetCommentListStatisticReply_CountValidationError{}
+
+// Validate checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReply_FileMultiError, or nil if none found.
+func (m *GetUserDataReply_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReply_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetUserDataReply_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReply_FileMultiError is an error wrapping multiple validation
+// errors returned by GetUserDataReply_File.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserDataReply_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReply_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReply_FileMultiError) AllErrors() []error { return m }
+
+// GetUserDataReply_FileValidationError is the validation error returned by
+// GetUserDataReply_File.Validate if the designated constraints aren't met.
+type GetUserDataReply_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReply_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReply_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReply_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReply_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReply_FileValidationError) ErrorName() string {
+	return "GetUserDataReply_FileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReply_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReply_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReply_FileValidationError{}
@@ -43,6 +43,9 @@ const (
 	CommentErrorReason_CANCEL_AGREE_FAILED                               CommentErrorReason = 17
 	CommentErrorReason_REMOVE_COMMENT_FAILED                             CommentErrorReason = 18
 	CommentErrorReason_RECORD_NOT_FOUND                                  CommentErrorReason = 19
+	CommentErrorReason_GET_USER_DATA_FAILED                              CommentErrorReason = 20
+	CommentErrorReason_PURGE_USER_DATA_FAILED                            CommentErrorReason = 21
+	CommentErrorReason_USER_BLOCKED                                      CommentErrorReason = 22
 )
 
 // Enum value maps for CommentErrorReason.
@@ -67,6 +70,9 @@ var (
 		17: "CANCEL_AGREE_FAILED",
 		18: "REMOVE_COMMENT_FAILED",
 		19: "RECORD_NOT_FOUND",
+		20: "GET_USER_DATA_FAILED",
+		21: "PURGE_USER_DATA_FAILED",
+		22: "USER_BLOCKED",
 	}
 	CommentErrorReason_value = map[string]int32{
 		"UNKNOWN_ERROR":                                     0,
@@ -88,6 +94,9 @@ var (
 		"CANCEL_AGREE_FAILED":                               17,
 		"REMOVE_COMMENT_FAILED":                             18,
 		"RECORD_NOT_FOUND":                                  19,
+		"GET_USER_DATA_FAILED":                              20,
+		"PURGE_USER_DATA_FAILED":                            21,
+		"USER_BLOCKED":                                      22,
 	}
 )
 
@@ -125,7 +134,7 @@ var file_comment_service_v1_comment_error_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
 	0x74, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x86, 0x05, 0x0a, 0x12, 0x43, 0x6f,
+	0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xd4, 0x05, 0x0a, 0x12, 0x43, 0x6f,
 	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
 	0x52, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
@@ -165,10 +174,15 @@ var file_comment_service_v1_comment_error_proto_rawDesc = []byte{
 	0x45, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x11, 0x12, 0x19, 0x0a, 0x15, 0x52,
 	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41,
 	0x49, 0x4c, 0x45, 0x44, 0x10, 0x12, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x13, 0x1a, 0x04, 0xa0, 0x45,
-	0xf4, 0x03, 0x42, 0x1e, 0x5a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x13, 0x12, 0x18, 0x0a, 0x14,
+	0x47, 0x45, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x14, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x52, 0x47, 0x45, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x15, 0x12, 0x16, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+	0x45, 0x44, 0x10, 0x16, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03,
+	0x42, 0x1e, 0x5a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -238,3 +238,39 @@ func IsRecordNotFound(err error) bool {
 func ErrorRecordNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, CommentErrorReason_RECORD_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
+
+func IsGetUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CommentErrorReason_GET_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, CommentErrorReason_GET_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPurgeUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CommentErrorReason_PURGE_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorPurgeUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, CommentErrorReason_PURGE_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsUserBlocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CommentErrorReason_USER_BLOCKED.String() && e.Code == 403
+}
+
+func ErrorUserBlocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, CommentErrorReason_USER_BLOCKED.String(), fmt.Sprintf(format, args...))
+}
@@ -63,6 +63,8 @@ type CommentClient interface {
 	RemoveSubComment(ctx context.Context, in *RemoveSubCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveCommentDbAndCache(ctx context.Context, in *RemoveCommentDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveSubCommentDbAndCache(ctx context.Context, in *RemoveSubCommentDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error)
+	PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -434,6 +436,24 @@ func (c *commentClient) RemoveSubCommentDbAndCache(ctx context.Context, in *Remo
 	return out, nil
 }
 
+func (c *commentClient) GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error) {
+	out := new(GetUserDataReply)
+	err := c.cc.Invoke(ctx, "/comment.v1.Comment/GetUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/comment.v1.Comment/PurgeUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentClient) GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/comment.v1.Comment/GetHealth", in, out, opts...)
@@ -487,6 +507,8 @@ type CommentServer interface {
 	RemoveSubComment(context.Context, *RemoveSubCommentReq) (*emptypb.Empty, error)
 	RemoveCommentDbAndCache(context.Context, *RemoveCommentDbAndCacheReq) (*emptypb.Empty, error)
 	RemoveSubCommentDbAndCache(context.Context, *RemoveSubCommentDbAndCacheReq) (*emptypb.Empty, error)
+	GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error)
+	PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error)
 	GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCommentServer()
 }
@@ -615,6 +637,12 @@ func (UnimplementedCommentServer) RemoveCommentDbAndCache(context.Context, *Remo
 func (UnimplementedCommentServer) RemoveSubCommentDbAndCache(context.Context, *RemoveSubCommentDbAndCacheReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubCommentDbAndCache not implemented")
 }
+func (UnimplementedCommentServer) GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
+}
+func (UnimplementedCommentServer) PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserData not implemented")
+}
 func (UnimplementedCommentServer) GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
 }
@@ -1351,6 +1379,42 @@ func _Comment_RemoveSubCommentDbAndCache_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comment_GetUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.v1.Comment/GetUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetUserData(ctx, req.(*GetUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_PurgeUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).PurgeUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.v1.Comment/PurgeUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).PurgeUserData(ctx, req.(*PurgeUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Comment_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1536,6 +1600,14 @@ var Comment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveSubCommentDbAndCache",
 			Handler:    _Comment_RemoveSubCommentDbAndCache_Handler,
 		},
+		{
+			MethodName: "GetUserData",
+			Handler:    _Comment_GetUserData_Handler,
+		},
+		{
+			MethodName: "PurgeUserData",
+			Handler:    _Comment_PurgeUserData_Handler,
+		},
 		{
 			MethodName: "GetHealth",
 			Handler:    _Comment_GetHealth_Handler,
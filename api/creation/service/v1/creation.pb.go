@@ -2974,6 +2974,7 @@ type GetArticleStatisticReply struct {
 	Collect int32  `protobuf:"varint,3,opt,name=collect,proto3" json:"collect,omitempty"`
 	View    int32  `protobuf:"varint,4,opt,name=view,proto3" json:"view,omitempty"`
 	Comment int32  `protobuf:"varint,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	RawView int32  `protobuf:"varint,6,opt,name=rawView,proto3" json:"rawView,omitempty"`
 }
 
 func (x *GetArticleStatisticReply) Reset() {
@@ -3043,6 +3044,13 @@ func (x *GetArticleStatisticReply) GetComment() int32 {
 	return 0
 }
 
+func (x *GetArticleStatisticReply) GetRawView() int32 {
+	if x != nil {
+		return x.RawView
+	}
+	return 0
+}
+
 type GetUserArticleAgreeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4111,6 +4119,53 @@ func (x *SetArticleViewDbAndCacheReq) GetUuid() string {
 	return ""
 }
 
+type AddCreationViewDbAndCacheReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View []*AddCreationViewDbAndCacheReq_View `protobuf:"bytes,1,rep,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *AddCreationViewDbAndCacheReq) Reset() {
+	*x = AddCreationViewDbAndCacheReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCreationViewDbAndCacheReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCreationViewDbAndCacheReq) ProtoMessage() {}
+
+func (x *AddCreationViewDbAndCacheReq) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCreationViewDbAndCacheReq.ProtoReflect.Descriptor instead.
+func (*AddCreationViewDbAndCacheReq) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AddCreationViewDbAndCacheReq) GetView() []*AddCreationViewDbAndCacheReq_View {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
 type SetArticleAgreeDbAndCacheReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4124,7 +4179,7 @@ type SetArticleAgreeDbAndCacheReq struct {
 func (x *SetArticleAgreeDbAndCacheReq) Reset() {
 	*x = SetArticleAgreeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[72]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4137,7 +4192,7 @@ func (x *SetArticleAgreeDbAndCacheReq) String() string {
 func (*SetArticleAgreeDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetArticleAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[72]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4150,7 +4205,7 @@ func (x *SetArticleAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetArticleAgreeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetArticleAgreeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{72}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *SetArticleAgreeDbAndCacheReq) GetId() int32 {
@@ -4188,7 +4243,7 @@ type SetArticleCollectDbAndCacheReq struct {
 func (x *SetArticleCollectDbAndCacheReq) Reset() {
 	*x = SetArticleCollectDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[73]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4201,7 +4256,7 @@ func (x *SetArticleCollectDbAndCacheReq) String() string {
 func (*SetArticleCollectDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetArticleCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[73]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4214,7 +4269,7 @@ func (x *SetArticleCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetArticleCollectDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetArticleCollectDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{73}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *SetArticleCollectDbAndCacheReq) GetId() int32 {
@@ -4256,7 +4311,7 @@ type CreateArticleDraftReq struct {
 func (x *CreateArticleDraftReq) Reset() {
 	*x = CreateArticleDraftReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[74]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4269,7 +4324,7 @@ func (x *CreateArticleDraftReq) String() string {
 func (*CreateArticleDraftReq) ProtoMessage() {}
 
 func (x *CreateArticleDraftReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[74]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4282,7 +4337,7 @@ func (x *CreateArticleDraftReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateArticleDraftReq.ProtoReflect.Descriptor instead.
 func (*CreateArticleDraftReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{74}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *CreateArticleDraftReq) GetUuid() string {
@@ -4303,7 +4358,7 @@ type CreateArticleDraftReply struct {
 func (x *CreateArticleDraftReply) Reset() {
 	*x = CreateArticleDraftReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[75]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4316,7 +4371,7 @@ func (x *CreateArticleDraftReply) String() string {
 func (*CreateArticleDraftReply) ProtoMessage() {}
 
 func (x *CreateArticleDraftReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[75]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4329,7 +4384,7 @@ func (x *CreateArticleDraftReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateArticleDraftReply.ProtoReflect.Descriptor instead.
 func (*CreateArticleDraftReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{75}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *CreateArticleDraftReply) GetId() int32 {
@@ -4351,7 +4406,7 @@ type ArticleDraftMarkReq struct {
 func (x *ArticleDraftMarkReq) Reset() {
 	*x = ArticleDraftMarkReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[76]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4364,7 +4419,7 @@ func (x *ArticleDraftMarkReq) String() string {
 func (*ArticleDraftMarkReq) ProtoMessage() {}
 
 func (x *ArticleDraftMarkReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[76]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4377,7 +4432,7 @@ func (x *ArticleDraftMarkReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleDraftMarkReq.ProtoReflect.Descriptor instead.
 func (*ArticleDraftMarkReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{76}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ArticleDraftMarkReq) GetId() int32 {
@@ -4405,7 +4460,7 @@ type GetArticleDraftListReq struct {
 func (x *GetArticleDraftListReq) Reset() {
 	*x = GetArticleDraftListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[77]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4418,7 +4473,7 @@ func (x *GetArticleDraftListReq) String() string {
 func (*GetArticleDraftListReq) ProtoMessage() {}
 
 func (x *GetArticleDraftListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[77]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4431,7 +4486,7 @@ func (x *GetArticleDraftListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArticleDraftListReq.ProtoReflect.Descriptor instead.
 func (*GetArticleDraftListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{77}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetArticleDraftListReq) GetUuid() string {
@@ -4452,7 +4507,7 @@ type GetArticleDraftListReply struct {
 func (x *GetArticleDraftListReply) Reset() {
 	*x = GetArticleDraftListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[78]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4465,7 +4520,7 @@ func (x *GetArticleDraftListReply) String() string {
 func (*GetArticleDraftListReply) ProtoMessage() {}
 
 func (x *GetArticleDraftListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[78]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4478,7 +4533,7 @@ func (x *GetArticleDraftListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArticleDraftListReply.ProtoReflect.Descriptor instead.
 func (*GetArticleDraftListReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{78}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetArticleDraftListReply) GetDraft() []*GetArticleDraftListReply_Draft {
@@ -4501,7 +4556,7 @@ type SendArticleReq struct {
 func (x *SendArticleReq) Reset() {
 	*x = SendArticleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[79]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4514,7 +4569,7 @@ func (x *SendArticleReq) String() string {
 func (*SendArticleReq) ProtoMessage() {}
 
 func (x *SendArticleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[79]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4527,7 +4582,7 @@ func (x *SendArticleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendArticleReq.ProtoReflect.Descriptor instead.
 func (*SendArticleReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{79}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *SendArticleReq) GetId() int32 {
@@ -4564,7 +4619,7 @@ type SendArticleEditReq struct {
 func (x *SendArticleEditReq) Reset() {
 	*x = SendArticleEditReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[80]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4577,7 +4632,7 @@ func (x *SendArticleEditReq) String() string {
 func (*SendArticleEditReq) ProtoMessage() {}
 
 func (x *SendArticleEditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[80]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4590,7 +4645,7 @@ func (x *SendArticleEditReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendArticleEditReq.ProtoReflect.Descriptor instead.
 func (*SendArticleEditReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{80}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *SendArticleEditReq) GetId() int32 {
@@ -4626,7 +4681,7 @@ type DeleteArticleReq struct {
 func (x *DeleteArticleReq) Reset() {
 	*x = DeleteArticleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[81]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4639,7 +4694,7 @@ func (x *DeleteArticleReq) String() string {
 func (*DeleteArticleReq) ProtoMessage() {}
 
 func (x *DeleteArticleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[81]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4652,7 +4707,7 @@ func (x *DeleteArticleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteArticleReq.ProtoReflect.Descriptor instead.
 func (*DeleteArticleReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{81}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DeleteArticleReq) GetId() int32 {
@@ -4681,7 +4736,7 @@ type DeleteArticleDraftReq struct {
 func (x *DeleteArticleDraftReq) Reset() {
 	*x = DeleteArticleDraftReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[82]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4694,7 +4749,7 @@ func (x *DeleteArticleDraftReq) String() string {
 func (*DeleteArticleDraftReq) ProtoMessage() {}
 
 func (x *DeleteArticleDraftReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[82]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4707,7 +4762,7 @@ func (x *DeleteArticleDraftReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteArticleDraftReq.ProtoReflect.Descriptor instead.
 func (*DeleteArticleDraftReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{82}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *DeleteArticleDraftReq) GetId() int32 {
@@ -4737,7 +4792,7 @@ type SetArticleAgreeReq struct {
 func (x *SetArticleAgreeReq) Reset() {
 	*x = SetArticleAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[83]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4750,7 +4805,7 @@ func (x *SetArticleAgreeReq) String() string {
 func (*SetArticleAgreeReq) ProtoMessage() {}
 
 func (x *SetArticleAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[83]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4763,7 +4818,7 @@ func (x *SetArticleAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetArticleAgreeReq.ProtoReflect.Descriptor instead.
 func (*SetArticleAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{83}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *SetArticleAgreeReq) GetId() int32 {
@@ -4792,14 +4847,16 @@ type SetArticleViewReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid     string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UserUuid string `protobuf:"bytes,3,opt,name=userUuid,proto3" json:"userUuid,omitempty"`
+	Ip       string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
 }
 
 func (x *SetArticleViewReq) Reset() {
 	*x = SetArticleViewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[84]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4812,7 +4869,7 @@ func (x *SetArticleViewReq) String() string {
 func (*SetArticleViewReq) ProtoMessage() {}
 
 func (x *SetArticleViewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[84]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4825,7 +4882,7 @@ func (x *SetArticleViewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetArticleViewReq.ProtoReflect.Descriptor instead.
 func (*SetArticleViewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{84}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *SetArticleViewReq) GetId() int32 {
@@ -4842,6 +4899,20 @@ func (x *SetArticleViewReq) GetUuid() string {
 	return ""
 }
 
+func (x *SetArticleViewReq) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *SetArticleViewReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
 type SetArticleCollectReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4856,7 +4927,7 @@ type SetArticleCollectReq struct {
 func (x *SetArticleCollectReq) Reset() {
 	*x = SetArticleCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[85]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4869,7 +4940,7 @@ func (x *SetArticleCollectReq) String() string {
 func (*SetArticleCollectReq) ProtoMessage() {}
 
 func (x *SetArticleCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[85]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4882,7 +4953,7 @@ func (x *SetArticleCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetArticleCollectReq.ProtoReflect.Descriptor instead.
 func (*SetArticleCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{85}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *SetArticleCollectReq) GetId() int32 {
@@ -4926,7 +4997,7 @@ type CancelArticleAgreeReq struct {
 func (x *CancelArticleAgreeReq) Reset() {
 	*x = CancelArticleAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[86]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4939,7 +5010,7 @@ func (x *CancelArticleAgreeReq) String() string {
 func (*CancelArticleAgreeReq) ProtoMessage() {}
 
 func (x *CancelArticleAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[86]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4952,7 +5023,7 @@ func (x *CancelArticleAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelArticleAgreeReq.ProtoReflect.Descriptor instead.
 func (*CancelArticleAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{86}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *CancelArticleAgreeReq) GetId() int32 {
@@ -4989,7 +5060,7 @@ type CancelArticleAgreeDbAndCacheReq struct {
 func (x *CancelArticleAgreeDbAndCacheReq) Reset() {
 	*x = CancelArticleAgreeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[87]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5002,7 +5073,7 @@ func (x *CancelArticleAgreeDbAndCacheReq) String() string {
 func (*CancelArticleAgreeDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelArticleAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[87]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5015,7 +5086,7 @@ func (x *CancelArticleAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelArticleAgreeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelArticleAgreeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{87}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *CancelArticleAgreeDbAndCacheReq) GetId() int32 {
@@ -5052,7 +5123,7 @@ type CancelArticleCollectReq struct {
 func (x *CancelArticleCollectReq) Reset() {
 	*x = CancelArticleCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[88]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5065,7 +5136,7 @@ func (x *CancelArticleCollectReq) String() string {
 func (*CancelArticleCollectReq) ProtoMessage() {}
 
 func (x *CancelArticleCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[88]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5078,7 +5149,7 @@ func (x *CancelArticleCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelArticleCollectReq.ProtoReflect.Descriptor instead.
 func (*CancelArticleCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{88}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CancelArticleCollectReq) GetId() int32 {
@@ -5115,7 +5186,7 @@ type CancelArticleCollectDbAndCacheReq struct {
 func (x *CancelArticleCollectDbAndCacheReq) Reset() {
 	*x = CancelArticleCollectDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[89]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5128,7 +5199,7 @@ func (x *CancelArticleCollectDbAndCacheReq) String() string {
 func (*CancelArticleCollectDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelArticleCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[89]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5141,7 +5212,7 @@ func (x *CancelArticleCollectDbAndCacheReq) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CancelArticleCollectDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelArticleCollectDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{89}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CancelArticleCollectDbAndCacheReq) GetId() int32 {
@@ -5177,7 +5248,7 @@ type ArticleStatisticJudgeReq struct {
 func (x *ArticleStatisticJudgeReq) Reset() {
 	*x = ArticleStatisticJudgeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[90]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5190,7 +5261,7 @@ func (x *ArticleStatisticJudgeReq) String() string {
 func (*ArticleStatisticJudgeReq) ProtoMessage() {}
 
 func (x *ArticleStatisticJudgeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[90]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5203,7 +5274,7 @@ func (x *ArticleStatisticJudgeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleStatisticJudgeReq.ProtoReflect.Descriptor instead.
 func (*ArticleStatisticJudgeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{90}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ArticleStatisticJudgeReq) GetId() int32 {
@@ -5232,7 +5303,7 @@ type ArticleStatisticJudgeReply struct {
 func (x *ArticleStatisticJudgeReply) Reset() {
 	*x = ArticleStatisticJudgeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[91]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5245,7 +5316,7 @@ func (x *ArticleStatisticJudgeReply) String() string {
 func (*ArticleStatisticJudgeReply) ProtoMessage() {}
 
 func (x *ArticleStatisticJudgeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[91]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5258,7 +5329,7 @@ func (x *ArticleStatisticJudgeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArticleStatisticJudgeReply.ProtoReflect.Descriptor instead.
 func (*ArticleStatisticJudgeReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{91}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ArticleStatisticJudgeReply) GetAgree() bool {
@@ -5286,7 +5357,7 @@ type GetTalkListReq struct {
 func (x *GetTalkListReq) Reset() {
 	*x = GetTalkListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[92]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5299,7 +5370,7 @@ func (x *GetTalkListReq) String() string {
 func (*GetTalkListReq) ProtoMessage() {}
 
 func (x *GetTalkListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[92]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5312,7 +5383,7 @@ func (x *GetTalkListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkListReq.ProtoReflect.Descriptor instead.
 func (*GetTalkListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{92}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *GetTalkListReq) GetPage() int32 {
@@ -5333,7 +5404,7 @@ type GetTalkListReply struct {
 func (x *GetTalkListReply) Reset() {
 	*x = GetTalkListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[93]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5346,7 +5417,7 @@ func (x *GetTalkListReply) String() string {
 func (*GetTalkListReply) ProtoMessage() {}
 
 func (x *GetTalkListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[93]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5359,7 +5430,7 @@ func (x *GetTalkListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkListReply.ProtoReflect.Descriptor instead.
 func (*GetTalkListReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{93}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *GetTalkListReply) GetTalk() []*GetTalkListReply_Talk {
@@ -5380,7 +5451,7 @@ type GetTalkCountReq struct {
 func (x *GetTalkCountReq) Reset() {
 	*x = GetTalkCountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[94]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5393,7 +5464,7 @@ func (x *GetTalkCountReq) String() string {
 func (*GetTalkCountReq) ProtoMessage() {}
 
 func (x *GetTalkCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[94]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5406,7 +5477,7 @@ func (x *GetTalkCountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkCountReq.ProtoReflect.Descriptor instead.
 func (*GetTalkCountReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{94}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetTalkCountReq) GetUuid() string {
@@ -5427,7 +5498,7 @@ type GetTalkCountVisitorReq struct {
 func (x *GetTalkCountVisitorReq) Reset() {
 	*x = GetTalkCountVisitorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[95]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5440,7 +5511,7 @@ func (x *GetTalkCountVisitorReq) String() string {
 func (*GetTalkCountVisitorReq) ProtoMessage() {}
 
 func (x *GetTalkCountVisitorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[95]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5453,7 +5524,7 @@ func (x *GetTalkCountVisitorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkCountVisitorReq.ProtoReflect.Descriptor instead.
 func (*GetTalkCountVisitorReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{95}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetTalkCountVisitorReq) GetUuid() string {
@@ -5474,7 +5545,7 @@ type GetTalkCountReply struct {
 func (x *GetTalkCountReply) Reset() {
 	*x = GetTalkCountReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[96]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5487,7 +5558,7 @@ func (x *GetTalkCountReply) String() string {
 func (*GetTalkCountReply) ProtoMessage() {}
 
 func (x *GetTalkCountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[96]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5500,7 +5571,7 @@ func (x *GetTalkCountReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkCountReply.ProtoReflect.Descriptor instead.
 func (*GetTalkCountReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{96}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetTalkCountReply) GetCount() int32 {
@@ -5521,7 +5592,7 @@ type GetTalkListHotReq struct {
 func (x *GetTalkListHotReq) Reset() {
 	*x = GetTalkListHotReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[97]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5534,7 +5605,7 @@ func (x *GetTalkListHotReq) String() string {
 func (*GetTalkListHotReq) ProtoMessage() {}
 
 func (x *GetTalkListHotReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[97]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5547,7 +5618,7 @@ func (x *GetTalkListHotReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkListHotReq.ProtoReflect.Descriptor instead.
 func (*GetTalkListHotReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{97}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetTalkListHotReq) GetPage() int32 {
@@ -5568,7 +5639,7 @@ type GetTalkListHotReply struct {
 func (x *GetTalkListHotReply) Reset() {
 	*x = GetTalkListHotReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[98]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5581,7 +5652,7 @@ func (x *GetTalkListHotReply) String() string {
 func (*GetTalkListHotReply) ProtoMessage() {}
 
 func (x *GetTalkListHotReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[98]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5594,7 +5665,7 @@ func (x *GetTalkListHotReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkListHotReply.ProtoReflect.Descriptor instead.
 func (*GetTalkListHotReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{98}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *GetTalkListHotReply) GetTalk() []*GetTalkListHotReply_Talk {
@@ -5616,7 +5687,7 @@ type GetUserTalkListReq struct {
 func (x *GetUserTalkListReq) Reset() {
 	*x = GetUserTalkListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[99]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5629,7 +5700,7 @@ func (x *GetUserTalkListReq) String() string {
 func (*GetUserTalkListReq) ProtoMessage() {}
 
 func (x *GetUserTalkListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[99]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5642,7 +5713,7 @@ func (x *GetUserTalkListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTalkListReq.ProtoReflect.Descriptor instead.
 func (*GetUserTalkListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{99}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *GetUserTalkListReq) GetPage() int32 {
@@ -5671,7 +5742,7 @@ type GetUserTalkListVisitorReq struct {
 func (x *GetUserTalkListVisitorReq) Reset() {
 	*x = GetUserTalkListVisitorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[100]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5684,7 +5755,7 @@ func (x *GetUserTalkListVisitorReq) String() string {
 func (*GetUserTalkListVisitorReq) ProtoMessage() {}
 
 func (x *GetUserTalkListVisitorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[100]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5697,7 +5768,7 @@ func (x *GetUserTalkListVisitorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTalkListVisitorReq.ProtoReflect.Descriptor instead.
 func (*GetUserTalkListVisitorReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{100}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GetUserTalkListVisitorReq) GetPage() int32 {
@@ -5725,7 +5796,7 @@ type GetTalkListStatisticReq struct {
 func (x *GetTalkListStatisticReq) Reset() {
 	*x = GetTalkListStatisticReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[101]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5738,7 +5809,7 @@ func (x *GetTalkListStatisticReq) String() string {
 func (*GetTalkListStatisticReq) ProtoMessage() {}
 
 func (x *GetTalkListStatisticReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[101]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5751,7 +5822,7 @@ func (x *GetTalkListStatisticReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkListStatisticReq.ProtoReflect.Descriptor instead.
 func (*GetTalkListStatisticReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{101}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetTalkListStatisticReq) GetIds() []int32 {
@@ -5772,7 +5843,7 @@ type GetTalkListStatisticReply struct {
 func (x *GetTalkListStatisticReply) Reset() {
 	*x = GetTalkListStatisticReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[102]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5785,7 +5856,7 @@ func (x *GetTalkListStatisticReply) String() string {
 func (*GetTalkListStatisticReply) ProtoMessage() {}
 
 func (x *GetTalkListStatisticReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[102]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5798,7 +5869,7 @@ func (x *GetTalkListStatisticReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkListStatisticReply.ProtoReflect.Descriptor instead.
 func (*GetTalkListStatisticReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{102}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetTalkListStatisticReply) GetCount() []*GetTalkListStatisticReply_Count {
@@ -5820,7 +5891,7 @@ type GetTalkStatisticReq struct {
 func (x *GetTalkStatisticReq) Reset() {
 	*x = GetTalkStatisticReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[103]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5833,7 +5904,7 @@ func (x *GetTalkStatisticReq) String() string {
 func (*GetTalkStatisticReq) ProtoMessage() {}
 
 func (x *GetTalkStatisticReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[103]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5846,7 +5917,7 @@ func (x *GetTalkStatisticReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkStatisticReq.ProtoReflect.Descriptor instead.
 func (*GetTalkStatisticReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{103}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetTalkStatisticReq) GetId() int32 {
@@ -5878,7 +5949,7 @@ type GetTalkStatisticReply struct {
 func (x *GetTalkStatisticReply) Reset() {
 	*x = GetTalkStatisticReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[104]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5891,7 +5962,7 @@ func (x *GetTalkStatisticReply) String() string {
 func (*GetTalkStatisticReply) ProtoMessage() {}
 
 func (x *GetTalkStatisticReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[104]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5904,7 +5975,7 @@ func (x *GetTalkStatisticReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkStatisticReply.ProtoReflect.Descriptor instead.
 func (*GetTalkStatisticReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{104}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *GetTalkStatisticReply) GetUuid() string {
@@ -5953,7 +6024,7 @@ type GetLastTalkDraftReq struct {
 func (x *GetLastTalkDraftReq) Reset() {
 	*x = GetLastTalkDraftReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[105]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5966,7 +6037,7 @@ func (x *GetLastTalkDraftReq) String() string {
 func (*GetLastTalkDraftReq) ProtoMessage() {}
 
 func (x *GetLastTalkDraftReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[105]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5979,7 +6050,7 @@ func (x *GetLastTalkDraftReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastTalkDraftReq.ProtoReflect.Descriptor instead.
 func (*GetLastTalkDraftReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{105}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *GetLastTalkDraftReq) GetUuid() string {
@@ -6001,7 +6072,7 @@ type GetLastTalkDraftReply struct {
 func (x *GetLastTalkDraftReply) Reset() {
 	*x = GetLastTalkDraftReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[106]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6014,7 +6085,7 @@ func (x *GetLastTalkDraftReply) String() string {
 func (*GetLastTalkDraftReply) ProtoMessage() {}
 
 func (x *GetLastTalkDraftReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[106]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6027,7 +6098,7 @@ func (x *GetLastTalkDraftReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastTalkDraftReply.ProtoReflect.Descriptor instead.
 func (*GetLastTalkDraftReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{106}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetLastTalkDraftReply) GetId() int32 {
@@ -6057,7 +6128,7 @@ type GetTalkSearchReq struct {
 func (x *GetTalkSearchReq) Reset() {
 	*x = GetTalkSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[107]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6070,7 +6141,7 @@ func (x *GetTalkSearchReq) String() string {
 func (*GetTalkSearchReq) ProtoMessage() {}
 
 func (x *GetTalkSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[107]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6083,7 +6154,7 @@ func (x *GetTalkSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkSearchReq.ProtoReflect.Descriptor instead.
 func (*GetTalkSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{107}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetTalkSearchReq) GetPage() int32 {
@@ -6119,7 +6190,7 @@ type GetTalkSearchReply struct {
 func (x *GetTalkSearchReply) Reset() {
 	*x = GetTalkSearchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[108]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6132,7 +6203,7 @@ func (x *GetTalkSearchReply) String() string {
 func (*GetTalkSearchReply) ProtoMessage() {}
 
 func (x *GetTalkSearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[108]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6145,7 +6216,7 @@ func (x *GetTalkSearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkSearchReply.ProtoReflect.Descriptor instead.
 func (*GetTalkSearchReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{108}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetTalkSearchReply) GetList() []*GetTalkSearchReply_List {
@@ -6173,7 +6244,7 @@ type GetUserTalkAgreeReq struct {
 func (x *GetUserTalkAgreeReq) Reset() {
 	*x = GetUserTalkAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[109]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6186,7 +6257,7 @@ func (x *GetUserTalkAgreeReq) String() string {
 func (*GetUserTalkAgreeReq) ProtoMessage() {}
 
 func (x *GetUserTalkAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[109]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6199,7 +6270,7 @@ func (x *GetUserTalkAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTalkAgreeReq.ProtoReflect.Descriptor instead.
 func (*GetUserTalkAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{109}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *GetUserTalkAgreeReq) GetUuid() string {
@@ -6220,7 +6291,7 @@ type GetUserTalkCollectReq struct {
 func (x *GetUserTalkCollectReq) Reset() {
 	*x = GetUserTalkCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[110]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6233,7 +6304,7 @@ func (x *GetUserTalkCollectReq) String() string {
 func (*GetUserTalkCollectReq) ProtoMessage() {}
 
 func (x *GetUserTalkCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[110]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6246,7 +6317,7 @@ func (x *GetUserTalkCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTalkCollectReq.ProtoReflect.Descriptor instead.
 func (*GetUserTalkCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{110}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetUserTalkCollectReq) GetUuid() string {
@@ -6267,7 +6338,7 @@ type GetUserTalkAgreeReply struct {
 func (x *GetUserTalkAgreeReply) Reset() {
 	*x = GetUserTalkAgreeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[111]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6280,7 +6351,7 @@ func (x *GetUserTalkAgreeReply) String() string {
 func (*GetUserTalkAgreeReply) ProtoMessage() {}
 
 func (x *GetUserTalkAgreeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[111]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6293,7 +6364,7 @@ func (x *GetUserTalkAgreeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTalkAgreeReply.ProtoReflect.Descriptor instead.
 func (*GetUserTalkAgreeReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{111}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetUserTalkAgreeReply) GetAgree() map[int32]bool {
@@ -6314,7 +6385,7 @@ type GetUserTalkCollectReply struct {
 func (x *GetUserTalkCollectReply) Reset() {
 	*x = GetUserTalkCollectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[112]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6327,7 +6398,7 @@ func (x *GetUserTalkCollectReply) String() string {
 func (*GetUserTalkCollectReply) ProtoMessage() {}
 
 func (x *GetUserTalkCollectReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[112]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6340,7 +6411,7 @@ func (x *GetUserTalkCollectReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTalkCollectReply.ProtoReflect.Descriptor instead.
 func (*GetUserTalkCollectReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{112}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetUserTalkCollectReply) GetCollect() map[int32]bool {
@@ -6362,7 +6433,7 @@ type GetTalkImageReviewReq struct {
 func (x *GetTalkImageReviewReq) Reset() {
 	*x = GetTalkImageReviewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[113]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6375,7 +6446,7 @@ func (x *GetTalkImageReviewReq) String() string {
 func (*GetTalkImageReviewReq) ProtoMessage() {}
 
 func (x *GetTalkImageReviewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[113]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6388,7 +6459,7 @@ func (x *GetTalkImageReviewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkImageReviewReq.ProtoReflect.Descriptor instead.
 func (*GetTalkImageReviewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{113}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetTalkImageReviewReq) GetPage() int32 {
@@ -6416,7 +6487,7 @@ type GetTalkImageReviewReply struct {
 func (x *GetTalkImageReviewReply) Reset() {
 	*x = GetTalkImageReviewReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[114]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6429,7 +6500,7 @@ func (x *GetTalkImageReviewReply) String() string {
 func (*GetTalkImageReviewReply) ProtoMessage() {}
 
 func (x *GetTalkImageReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[114]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6442,7 +6513,7 @@ func (x *GetTalkImageReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkImageReviewReply.ProtoReflect.Descriptor instead.
 func (*GetTalkImageReviewReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{114}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetTalkImageReviewReply) GetReview() []*GetTalkImageReviewReply_Review {
@@ -6464,7 +6535,7 @@ type GetTalkContentReviewReq struct {
 func (x *GetTalkContentReviewReq) Reset() {
 	*x = GetTalkContentReviewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[115]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6477,7 +6548,7 @@ func (x *GetTalkContentReviewReq) String() string {
 func (*GetTalkContentReviewReq) ProtoMessage() {}
 
 func (x *GetTalkContentReviewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[115]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6490,7 +6561,7 @@ func (x *GetTalkContentReviewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkContentReviewReq.ProtoReflect.Descriptor instead.
 func (*GetTalkContentReviewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{115}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetTalkContentReviewReq) GetPage() int32 {
@@ -6518,7 +6589,7 @@ type GetTalkContentReviewReply struct {
 func (x *GetTalkContentReviewReply) Reset() {
 	*x = GetTalkContentReviewReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[116]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6531,7 +6602,7 @@ func (x *GetTalkContentReviewReply) String() string {
 func (*GetTalkContentReviewReply) ProtoMessage() {}
 
 func (x *GetTalkContentReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[116]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6544,7 +6615,7 @@ func (x *GetTalkContentReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTalkContentReviewReply.ProtoReflect.Descriptor instead.
 func (*GetTalkContentReviewReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{116}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *GetTalkContentReviewReply) GetReview() []*GetTalkContentReviewReply_Review {
@@ -6565,7 +6636,7 @@ type CreateTalkDraftReq struct {
 func (x *CreateTalkDraftReq) Reset() {
 	*x = CreateTalkDraftReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[117]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6578,7 +6649,7 @@ func (x *CreateTalkDraftReq) String() string {
 func (*CreateTalkDraftReq) ProtoMessage() {}
 
 func (x *CreateTalkDraftReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[117]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6591,7 +6662,7 @@ func (x *CreateTalkDraftReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTalkDraftReq.ProtoReflect.Descriptor instead.
 func (*CreateTalkDraftReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{117}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *CreateTalkDraftReq) GetUuid() string {
@@ -6612,7 +6683,7 @@ type CreateTalkDraftReply struct {
 func (x *CreateTalkDraftReply) Reset() {
 	*x = CreateTalkDraftReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[118]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6625,7 +6696,7 @@ func (x *CreateTalkDraftReply) String() string {
 func (*CreateTalkDraftReply) ProtoMessage() {}
 
 func (x *CreateTalkDraftReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[118]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6638,7 +6709,7 @@ func (x *CreateTalkDraftReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTalkDraftReply.ProtoReflect.Descriptor instead.
 func (*CreateTalkDraftReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{118}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *CreateTalkDraftReply) GetId() int32 {
@@ -6661,7 +6732,7 @@ type SendTalkReq struct {
 func (x *SendTalkReq) Reset() {
 	*x = SendTalkReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[119]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6674,7 +6745,7 @@ func (x *SendTalkReq) String() string {
 func (*SendTalkReq) ProtoMessage() {}
 
 func (x *SendTalkReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[119]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6687,7 +6758,7 @@ func (x *SendTalkReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTalkReq.ProtoReflect.Descriptor instead.
 func (*SendTalkReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{119}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *SendTalkReq) GetId() int32 {
@@ -6724,7 +6795,7 @@ type SendTalkEditReq struct {
 func (x *SendTalkEditReq) Reset() {
 	*x = SendTalkEditReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[120]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6737,7 +6808,7 @@ func (x *SendTalkEditReq) String() string {
 func (*SendTalkEditReq) ProtoMessage() {}
 
 func (x *SendTalkEditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[120]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6750,7 +6821,7 @@ func (x *SendTalkEditReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTalkEditReq.ProtoReflect.Descriptor instead.
 func (*SendTalkEditReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{120}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *SendTalkEditReq) GetId() int32 {
@@ -6787,7 +6858,7 @@ type CreateTalkReq struct {
 func (x *CreateTalkReq) Reset() {
 	*x = CreateTalkReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[121]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6800,7 +6871,7 @@ func (x *CreateTalkReq) String() string {
 func (*CreateTalkReq) ProtoMessage() {}
 
 func (x *CreateTalkReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[121]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6813,7 +6884,7 @@ func (x *CreateTalkReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTalkReq.ProtoReflect.Descriptor instead.
 func (*CreateTalkReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{121}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *CreateTalkReq) GetId() int32 {
@@ -6850,7 +6921,7 @@ type EditTalkReq struct {
 func (x *EditTalkReq) Reset() {
 	*x = EditTalkReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[122]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6863,7 +6934,7 @@ func (x *EditTalkReq) String() string {
 func (*EditTalkReq) ProtoMessage() {}
 
 func (x *EditTalkReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[122]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6876,7 +6947,7 @@ func (x *EditTalkReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditTalkReq.ProtoReflect.Descriptor instead.
 func (*EditTalkReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{122}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *EditTalkReq) GetId() int32 {
@@ -6912,7 +6983,7 @@ type DeleteTalkReq struct {
 func (x *DeleteTalkReq) Reset() {
 	*x = DeleteTalkReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[123]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6925,7 +6996,7 @@ func (x *DeleteTalkReq) String() string {
 func (*DeleteTalkReq) ProtoMessage() {}
 
 func (x *DeleteTalkReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[123]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6938,7 +7009,7 @@ func (x *DeleteTalkReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTalkReq.ProtoReflect.Descriptor instead.
 func (*DeleteTalkReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{123}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *DeleteTalkReq) GetId() int32 {
@@ -6968,7 +7039,7 @@ type CreateTalkDbCacheAndSearchReq struct {
 func (x *CreateTalkDbCacheAndSearchReq) Reset() {
 	*x = CreateTalkDbCacheAndSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[124]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6981,7 +7052,7 @@ func (x *CreateTalkDbCacheAndSearchReq) String() string {
 func (*CreateTalkDbCacheAndSearchReq) ProtoMessage() {}
 
 func (x *CreateTalkDbCacheAndSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[124]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6994,7 +7065,7 @@ func (x *CreateTalkDbCacheAndSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTalkDbCacheAndSearchReq.ProtoReflect.Descriptor instead.
 func (*CreateTalkDbCacheAndSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{124}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *CreateTalkDbCacheAndSearchReq) GetId() int32 {
@@ -7031,7 +7102,7 @@ type EditTalkCosAndSearchReq struct {
 func (x *EditTalkCosAndSearchReq) Reset() {
 	*x = EditTalkCosAndSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[125]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7044,7 +7115,7 @@ func (x *EditTalkCosAndSearchReq) String() string {
 func (*EditTalkCosAndSearchReq) ProtoMessage() {}
 
 func (x *EditTalkCosAndSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[125]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7057,7 +7128,7 @@ func (x *EditTalkCosAndSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditTalkCosAndSearchReq.ProtoReflect.Descriptor instead.
 func (*EditTalkCosAndSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{125}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *EditTalkCosAndSearchReq) GetId() int32 {
@@ -7093,7 +7164,7 @@ type DeleteTalkCacheAndSearchReq struct {
 func (x *DeleteTalkCacheAndSearchReq) Reset() {
 	*x = DeleteTalkCacheAndSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[126]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7106,7 +7177,7 @@ func (x *DeleteTalkCacheAndSearchReq) String() string {
 func (*DeleteTalkCacheAndSearchReq) ProtoMessage() {}
 
 func (x *DeleteTalkCacheAndSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[126]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7119,7 +7190,7 @@ func (x *DeleteTalkCacheAndSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTalkCacheAndSearchReq.ProtoReflect.Descriptor instead.
 func (*DeleteTalkCacheAndSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{126}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *DeleteTalkCacheAndSearchReq) GetId() int32 {
@@ -7141,14 +7212,16 @@ type SetTalkViewReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid     string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UserUuid string `protobuf:"bytes,3,opt,name=userUuid,proto3" json:"userUuid,omitempty"`
+	Ip       string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
 }
 
 func (x *SetTalkViewReq) Reset() {
 	*x = SetTalkViewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[127]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7161,7 +7234,7 @@ func (x *SetTalkViewReq) String() string {
 func (*SetTalkViewReq) ProtoMessage() {}
 
 func (x *SetTalkViewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[127]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7174,7 +7247,7 @@ func (x *SetTalkViewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTalkViewReq.ProtoReflect.Descriptor instead.
 func (*SetTalkViewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{127}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *SetTalkViewReq) GetId() int32 {
@@ -7191,6 +7264,20 @@ func (x *SetTalkViewReq) GetUuid() string {
 	return ""
 }
 
+func (x *SetTalkViewReq) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *SetTalkViewReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
 type SetTalkViewDbAndCacheReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -7203,7 +7290,7 @@ type SetTalkViewDbAndCacheReq struct {
 func (x *SetTalkViewDbAndCacheReq) Reset() {
 	*x = SetTalkViewDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[128]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7216,7 +7303,7 @@ func (x *SetTalkViewDbAndCacheReq) String() string {
 func (*SetTalkViewDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetTalkViewDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[128]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7229,7 +7316,7 @@ func (x *SetTalkViewDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTalkViewDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetTalkViewDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{128}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *SetTalkViewDbAndCacheReq) GetId() int32 {
@@ -7258,7 +7345,7 @@ type TalkStatisticJudgeReq struct {
 func (x *TalkStatisticJudgeReq) Reset() {
 	*x = TalkStatisticJudgeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[129]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7271,7 +7358,7 @@ func (x *TalkStatisticJudgeReq) String() string {
 func (*TalkStatisticJudgeReq) ProtoMessage() {}
 
 func (x *TalkStatisticJudgeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[129]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7284,7 +7371,7 @@ func (x *TalkStatisticJudgeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalkStatisticJudgeReq.ProtoReflect.Descriptor instead.
 func (*TalkStatisticJudgeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{129}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *TalkStatisticJudgeReq) GetId() int32 {
@@ -7313,7 +7400,7 @@ type TalkStatisticJudgeReply struct {
 func (x *TalkStatisticJudgeReply) Reset() {
 	*x = TalkStatisticJudgeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[130]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7326,7 +7413,7 @@ func (x *TalkStatisticJudgeReply) String() string {
 func (*TalkStatisticJudgeReply) ProtoMessage() {}
 
 func (x *TalkStatisticJudgeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[130]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7339,7 +7426,7 @@ func (x *TalkStatisticJudgeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalkStatisticJudgeReply.ProtoReflect.Descriptor instead.
 func (*TalkStatisticJudgeReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{130}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *TalkStatisticJudgeReply) GetAgree() bool {
@@ -7369,7 +7456,7 @@ type SetTalkAgreeReq struct {
 func (x *SetTalkAgreeReq) Reset() {
 	*x = SetTalkAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[131]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7382,7 +7469,7 @@ func (x *SetTalkAgreeReq) String() string {
 func (*SetTalkAgreeReq) ProtoMessage() {}
 
 func (x *SetTalkAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[131]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7395,7 +7482,7 @@ func (x *SetTalkAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTalkAgreeReq.ProtoReflect.Descriptor instead.
 func (*SetTalkAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{131}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *SetTalkAgreeReq) GetId() int32 {
@@ -7432,7 +7519,7 @@ type SetTalkAgreeDbAndCacheReq struct {
 func (x *SetTalkAgreeDbAndCacheReq) Reset() {
 	*x = SetTalkAgreeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[132]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7445,7 +7532,7 @@ func (x *SetTalkAgreeDbAndCacheReq) String() string {
 func (*SetTalkAgreeDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetTalkAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[132]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7458,7 +7545,7 @@ func (x *SetTalkAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTalkAgreeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetTalkAgreeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{132}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *SetTalkAgreeDbAndCacheReq) GetId() int32 {
@@ -7496,7 +7583,7 @@ type SetTalkCollectReq struct {
 func (x *SetTalkCollectReq) Reset() {
 	*x = SetTalkCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[133]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7509,7 +7596,7 @@ func (x *SetTalkCollectReq) String() string {
 func (*SetTalkCollectReq) ProtoMessage() {}
 
 func (x *SetTalkCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[133]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7522,7 +7609,7 @@ func (x *SetTalkCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTalkCollectReq.ProtoReflect.Descriptor instead.
 func (*SetTalkCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{133}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *SetTalkCollectReq) GetId() int32 {
@@ -7567,7 +7654,7 @@ type SetTalkCollectDbAndCacheReq struct {
 func (x *SetTalkCollectDbAndCacheReq) Reset() {
 	*x = SetTalkCollectDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[134]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7580,7 +7667,7 @@ func (x *SetTalkCollectDbAndCacheReq) String() string {
 func (*SetTalkCollectDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetTalkCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[134]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7593,7 +7680,7 @@ func (x *SetTalkCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTalkCollectDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetTalkCollectDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{134}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *SetTalkCollectDbAndCacheReq) GetId() int32 {
@@ -7637,7 +7724,7 @@ type CancelTalkAgreeReq struct {
 func (x *CancelTalkAgreeReq) Reset() {
 	*x = CancelTalkAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[135]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7650,7 +7737,7 @@ func (x *CancelTalkAgreeReq) String() string {
 func (*CancelTalkAgreeReq) ProtoMessage() {}
 
 func (x *CancelTalkAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[135]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7663,7 +7750,7 @@ func (x *CancelTalkAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTalkAgreeReq.ProtoReflect.Descriptor instead.
 func (*CancelTalkAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{135}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *CancelTalkAgreeReq) GetId() int32 {
@@ -7700,7 +7787,7 @@ type CancelTalkAgreeDbAndCacheReq struct {
 func (x *CancelTalkAgreeDbAndCacheReq) Reset() {
 	*x = CancelTalkAgreeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[136]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7713,7 +7800,7 @@ func (x *CancelTalkAgreeDbAndCacheReq) String() string {
 func (*CancelTalkAgreeDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelTalkAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[136]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7726,7 +7813,7 @@ func (x *CancelTalkAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTalkAgreeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelTalkAgreeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{136}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *CancelTalkAgreeDbAndCacheReq) GetId() int32 {
@@ -7763,7 +7850,7 @@ type CancelTalkCollectReq struct {
 func (x *CancelTalkCollectReq) Reset() {
 	*x = CancelTalkCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[137]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7776,7 +7863,7 @@ func (x *CancelTalkCollectReq) String() string {
 func (*CancelTalkCollectReq) ProtoMessage() {}
 
 func (x *CancelTalkCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[137]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7789,7 +7876,7 @@ func (x *CancelTalkCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTalkCollectReq.ProtoReflect.Descriptor instead.
 func (*CancelTalkCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{137}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *CancelTalkCollectReq) GetId() int32 {
@@ -7826,7 +7913,7 @@ type CancelTalkCollectDbAndCacheReq struct {
 func (x *CancelTalkCollectDbAndCacheReq) Reset() {
 	*x = CancelTalkCollectDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[138]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7839,7 +7926,7 @@ func (x *CancelTalkCollectDbAndCacheReq) String() string {
 func (*CancelTalkCollectDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelTalkCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[138]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7852,7 +7939,7 @@ func (x *CancelTalkCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTalkCollectDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelTalkCollectDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{138}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *CancelTalkCollectDbAndCacheReq) GetId() int32 {
@@ -7887,7 +7974,7 @@ type GetLastColumnDraftReq struct {
 func (x *GetLastColumnDraftReq) Reset() {
 	*x = GetLastColumnDraftReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[139]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7900,7 +7987,7 @@ func (x *GetLastColumnDraftReq) String() string {
 func (*GetLastColumnDraftReq) ProtoMessage() {}
 
 func (x *GetLastColumnDraftReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[139]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7913,7 +8000,7 @@ func (x *GetLastColumnDraftReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastColumnDraftReq.ProtoReflect.Descriptor instead.
 func (*GetLastColumnDraftReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{139}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *GetLastColumnDraftReq) GetUuid() string {
@@ -7935,7 +8022,7 @@ type GetLastColumnDraftReply struct {
 func (x *GetLastColumnDraftReply) Reset() {
 	*x = GetLastColumnDraftReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[140]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7948,7 +8035,7 @@ func (x *GetLastColumnDraftReply) String() string {
 func (*GetLastColumnDraftReply) ProtoMessage() {}
 
 func (x *GetLastColumnDraftReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[140]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7961,7 +8048,7 @@ func (x *GetLastColumnDraftReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastColumnDraftReply.ProtoReflect.Descriptor instead.
 func (*GetLastColumnDraftReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{140}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *GetLastColumnDraftReply) GetId() int32 {
@@ -7991,7 +8078,7 @@ type GetColumnSearchReq struct {
 func (x *GetColumnSearchReq) Reset() {
 	*x = GetColumnSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[141]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8004,7 +8091,7 @@ func (x *GetColumnSearchReq) String() string {
 func (*GetColumnSearchReq) ProtoMessage() {}
 
 func (x *GetColumnSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[141]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8017,7 +8104,7 @@ func (x *GetColumnSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnSearchReq.ProtoReflect.Descriptor instead.
 func (*GetColumnSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{141}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *GetColumnSearchReq) GetPage() int32 {
@@ -8053,7 +8140,7 @@ type GetColumnSearchReply struct {
 func (x *GetColumnSearchReply) Reset() {
 	*x = GetColumnSearchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[142]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8066,7 +8153,7 @@ func (x *GetColumnSearchReply) String() string {
 func (*GetColumnSearchReply) ProtoMessage() {}
 
 func (x *GetColumnSearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[142]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8079,7 +8166,7 @@ func (x *GetColumnSearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnSearchReply.ProtoReflect.Descriptor instead.
 func (*GetColumnSearchReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{142}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *GetColumnSearchReply) GetList() []*GetColumnSearchReply_List {
@@ -8108,7 +8195,7 @@ type GetColumnImageReviewReq struct {
 func (x *GetColumnImageReviewReq) Reset() {
 	*x = GetColumnImageReviewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[143]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8121,7 +8208,7 @@ func (x *GetColumnImageReviewReq) String() string {
 func (*GetColumnImageReviewReq) ProtoMessage() {}
 
 func (x *GetColumnImageReviewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[143]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8134,7 +8221,7 @@ func (x *GetColumnImageReviewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnImageReviewReq.ProtoReflect.Descriptor instead.
 func (*GetColumnImageReviewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{143}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *GetColumnImageReviewReq) GetPage() int32 {
@@ -8162,7 +8249,7 @@ type GetColumnImageReviewReply struct {
 func (x *GetColumnImageReviewReply) Reset() {
 	*x = GetColumnImageReviewReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[144]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8175,7 +8262,7 @@ func (x *GetColumnImageReviewReply) String() string {
 func (*GetColumnImageReviewReply) ProtoMessage() {}
 
 func (x *GetColumnImageReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[144]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8188,7 +8275,7 @@ func (x *GetColumnImageReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnImageReviewReply.ProtoReflect.Descriptor instead.
 func (*GetColumnImageReviewReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{144}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *GetColumnImageReviewReply) GetReview() []*GetColumnImageReviewReply_Review {
@@ -8210,7 +8297,7 @@ type GetColumnContentReviewReq struct {
 func (x *GetColumnContentReviewReq) Reset() {
 	*x = GetColumnContentReviewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[145]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8223,7 +8310,7 @@ func (x *GetColumnContentReviewReq) String() string {
 func (*GetColumnContentReviewReq) ProtoMessage() {}
 
 func (x *GetColumnContentReviewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[145]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8236,7 +8323,7 @@ func (x *GetColumnContentReviewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnContentReviewReq.ProtoReflect.Descriptor instead.
 func (*GetColumnContentReviewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{145}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *GetColumnContentReviewReq) GetPage() int32 {
@@ -8264,7 +8351,7 @@ type GetColumnContentReviewReply struct {
 func (x *GetColumnContentReviewReply) Reset() {
 	*x = GetColumnContentReviewReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[146]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8277,7 +8364,7 @@ func (x *GetColumnContentReviewReply) String() string {
 func (*GetColumnContentReviewReply) ProtoMessage() {}
 
 func (x *GetColumnContentReviewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[146]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8290,7 +8377,7 @@ func (x *GetColumnContentReviewReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnContentReviewReply.ProtoReflect.Descriptor instead.
 func (*GetColumnContentReviewReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{146}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *GetColumnContentReviewReply) GetReview() []*GetColumnContentReviewReply_Review {
@@ -8311,7 +8398,7 @@ type CreateColumnDraftReq struct {
 func (x *CreateColumnDraftReq) Reset() {
 	*x = CreateColumnDraftReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[147]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8324,7 +8411,7 @@ func (x *CreateColumnDraftReq) String() string {
 func (*CreateColumnDraftReq) ProtoMessage() {}
 
 func (x *CreateColumnDraftReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[147]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8337,7 +8424,7 @@ func (x *CreateColumnDraftReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateColumnDraftReq.ProtoReflect.Descriptor instead.
 func (*CreateColumnDraftReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{147}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *CreateColumnDraftReq) GetUuid() string {
@@ -8358,7 +8445,7 @@ type CreateColumnDraftReply struct {
 func (x *CreateColumnDraftReply) Reset() {
 	*x = CreateColumnDraftReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[148]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8371,7 +8458,7 @@ func (x *CreateColumnDraftReply) String() string {
 func (*CreateColumnDraftReply) ProtoMessage() {}
 
 func (x *CreateColumnDraftReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[148]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8384,7 +8471,7 @@ func (x *CreateColumnDraftReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateColumnDraftReply.ProtoReflect.Descriptor instead.
 func (*CreateColumnDraftReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{148}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *CreateColumnDraftReply) GetId() int32 {
@@ -8407,7 +8494,7 @@ type SendColumnReq struct {
 func (x *SendColumnReq) Reset() {
 	*x = SendColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[149]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8420,7 +8507,7 @@ func (x *SendColumnReq) String() string {
 func (*SendColumnReq) ProtoMessage() {}
 
 func (x *SendColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[149]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8433,7 +8520,7 @@ func (x *SendColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendColumnReq.ProtoReflect.Descriptor instead.
 func (*SendColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{149}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *SendColumnReq) GetId() int32 {
@@ -8470,7 +8557,7 @@ type CreateColumnReq struct {
 func (x *CreateColumnReq) Reset() {
 	*x = CreateColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[150]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8483,7 +8570,7 @@ func (x *CreateColumnReq) String() string {
 func (*CreateColumnReq) ProtoMessage() {}
 
 func (x *CreateColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[150]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8496,7 +8583,7 @@ func (x *CreateColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateColumnReq.ProtoReflect.Descriptor instead.
 func (*CreateColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{150}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *CreateColumnReq) GetId() int32 {
@@ -8533,7 +8620,7 @@ type CreateColumnDbCacheAndSearchReq struct {
 func (x *CreateColumnDbCacheAndSearchReq) Reset() {
 	*x = CreateColumnDbCacheAndSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[151]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8546,7 +8633,7 @@ func (x *CreateColumnDbCacheAndSearchReq) String() string {
 func (*CreateColumnDbCacheAndSearchReq) ProtoMessage() {}
 
 func (x *CreateColumnDbCacheAndSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[151]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8559,7 +8646,7 @@ func (x *CreateColumnDbCacheAndSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateColumnDbCacheAndSearchReq.ProtoReflect.Descriptor instead.
 func (*CreateColumnDbCacheAndSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{151}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *CreateColumnDbCacheAndSearchReq) GetId() int32 {
@@ -8595,7 +8682,7 @@ type SubscribeColumnReq struct {
 func (x *SubscribeColumnReq) Reset() {
 	*x = SubscribeColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[152]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[153]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8608,7 +8695,7 @@ func (x *SubscribeColumnReq) String() string {
 func (*SubscribeColumnReq) ProtoMessage() {}
 
 func (x *SubscribeColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[152]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[153]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8621,7 +8708,7 @@ func (x *SubscribeColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeColumnReq.ProtoReflect.Descriptor instead.
 func (*SubscribeColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{152}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *SubscribeColumnReq) GetId() int32 {
@@ -8650,7 +8737,7 @@ type CancelSubscribeColumnReq struct {
 func (x *CancelSubscribeColumnReq) Reset() {
 	*x = CancelSubscribeColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[153]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8663,7 +8750,7 @@ func (x *CancelSubscribeColumnReq) String() string {
 func (*CancelSubscribeColumnReq) ProtoMessage() {}
 
 func (x *CancelSubscribeColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[153]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8676,7 +8763,7 @@ func (x *CancelSubscribeColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelSubscribeColumnReq.ProtoReflect.Descriptor instead.
 func (*CancelSubscribeColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{153}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *CancelSubscribeColumnReq) GetId() int32 {
@@ -8705,7 +8792,7 @@ type SubscribeJudgeReq struct {
 func (x *SubscribeJudgeReq) Reset() {
 	*x = SubscribeJudgeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[154]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8718,7 +8805,7 @@ func (x *SubscribeJudgeReq) String() string {
 func (*SubscribeJudgeReq) ProtoMessage() {}
 
 func (x *SubscribeJudgeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[154]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8731,7 +8818,7 @@ func (x *SubscribeJudgeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeJudgeReq.ProtoReflect.Descriptor instead.
 func (*SubscribeJudgeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{154}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *SubscribeJudgeReq) GetId() int32 {
@@ -8759,7 +8846,7 @@ type SubscribeJudgeReply struct {
 func (x *SubscribeJudgeReply) Reset() {
 	*x = SubscribeJudgeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[155]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[156]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8772,7 +8859,7 @@ func (x *SubscribeJudgeReply) String() string {
 func (*SubscribeJudgeReply) ProtoMessage() {}
 
 func (x *SubscribeJudgeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[155]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[156]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8785,7 +8872,7 @@ func (x *SubscribeJudgeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeJudgeReply.ProtoReflect.Descriptor instead.
 func (*SubscribeJudgeReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{155}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *SubscribeJudgeReply) GetSubscribe() bool {
@@ -8808,7 +8895,7 @@ type EditColumnCosAndSearchReq struct {
 func (x *EditColumnCosAndSearchReq) Reset() {
 	*x = EditColumnCosAndSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[156]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8821,7 +8908,7 @@ func (x *EditColumnCosAndSearchReq) String() string {
 func (*EditColumnCosAndSearchReq) ProtoMessage() {}
 
 func (x *EditColumnCosAndSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[156]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8834,7 +8921,7 @@ func (x *EditColumnCosAndSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditColumnCosAndSearchReq.ProtoReflect.Descriptor instead.
 func (*EditColumnCosAndSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{156}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *EditColumnCosAndSearchReq) GetId() int32 {
@@ -8869,7 +8956,7 @@ type GetColumnListReq struct {
 func (x *GetColumnListReq) Reset() {
 	*x = GetColumnListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[157]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[158]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8882,7 +8969,7 @@ func (x *GetColumnListReq) String() string {
 func (*GetColumnListReq) ProtoMessage() {}
 
 func (x *GetColumnListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[157]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[158]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8895,7 +8982,7 @@ func (x *GetColumnListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnListReq.ProtoReflect.Descriptor instead.
 func (*GetColumnListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{157}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *GetColumnListReq) GetPage() int32 {
@@ -8916,7 +9003,7 @@ type GetColumnListReply struct {
 func (x *GetColumnListReply) Reset() {
 	*x = GetColumnListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[158]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[159]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8929,7 +9016,7 @@ func (x *GetColumnListReply) String() string {
 func (*GetColumnListReply) ProtoMessage() {}
 
 func (x *GetColumnListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[158]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[159]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8942,7 +9029,7 @@ func (x *GetColumnListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnListReply.ProtoReflect.Descriptor instead.
 func (*GetColumnListReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{158}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *GetColumnListReply) GetColumn() []*GetColumnListReply_Column {
@@ -8963,7 +9050,7 @@ type GetColumnListHotReq struct {
 func (x *GetColumnListHotReq) Reset() {
 	*x = GetColumnListHotReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[159]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[160]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8976,7 +9063,7 @@ func (x *GetColumnListHotReq) String() string {
 func (*GetColumnListHotReq) ProtoMessage() {}
 
 func (x *GetColumnListHotReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[159]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[160]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8989,7 +9076,7 @@ func (x *GetColumnListHotReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnListHotReq.ProtoReflect.Descriptor instead.
 func (*GetColumnListHotReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{159}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *GetColumnListHotReq) GetPage() int32 {
@@ -9010,7 +9097,7 @@ type GetColumnListHotReply struct {
 func (x *GetColumnListHotReply) Reset() {
 	*x = GetColumnListHotReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[160]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[161]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9023,7 +9110,7 @@ func (x *GetColumnListHotReply) String() string {
 func (*GetColumnListHotReply) ProtoMessage() {}
 
 func (x *GetColumnListHotReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[160]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[161]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9036,7 +9123,7 @@ func (x *GetColumnListHotReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnListHotReply.ProtoReflect.Descriptor instead.
 func (*GetColumnListHotReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{160}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *GetColumnListHotReply) GetColumn() []*GetColumnListHotReply_Column {
@@ -9057,7 +9144,7 @@ type GetColumnListStatisticReq struct {
 func (x *GetColumnListStatisticReq) Reset() {
 	*x = GetColumnListStatisticReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[161]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9070,7 +9157,7 @@ func (x *GetColumnListStatisticReq) String() string {
 func (*GetColumnListStatisticReq) ProtoMessage() {}
 
 func (x *GetColumnListStatisticReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[161]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9083,7 +9170,7 @@ func (x *GetColumnListStatisticReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnListStatisticReq.ProtoReflect.Descriptor instead.
 func (*GetColumnListStatisticReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{161}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *GetColumnListStatisticReq) GetIds() []int32 {
@@ -9104,7 +9191,7 @@ type GetColumnListStatisticReply struct {
 func (x *GetColumnListStatisticReply) Reset() {
 	*x = GetColumnListStatisticReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[162]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[163]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9117,7 +9204,7 @@ func (x *GetColumnListStatisticReply) String() string {
 func (*GetColumnListStatisticReply) ProtoMessage() {}
 
 func (x *GetColumnListStatisticReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[162]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[163]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9130,7 +9217,7 @@ func (x *GetColumnListStatisticReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnListStatisticReply.ProtoReflect.Descriptor instead.
 func (*GetColumnListStatisticReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{162}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *GetColumnListStatisticReply) GetCount() []*GetColumnListStatisticReply_Count {
@@ -9152,7 +9239,7 @@ type GetColumnStatisticReq struct {
 func (x *GetColumnStatisticReq) Reset() {
 	*x = GetColumnStatisticReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[163]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9165,7 +9252,7 @@ func (x *GetColumnStatisticReq) String() string {
 func (*GetColumnStatisticReq) ProtoMessage() {}
 
 func (x *GetColumnStatisticReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[163]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9178,7 +9265,7 @@ func (x *GetColumnStatisticReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnStatisticReq.ProtoReflect.Descriptor instead.
 func (*GetColumnStatisticReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{163}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *GetColumnStatisticReq) GetId() int32 {
@@ -9209,7 +9296,7 @@ type GetColumnStatisticReply struct {
 func (x *GetColumnStatisticReply) Reset() {
 	*x = GetColumnStatisticReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[164]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[165]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9222,7 +9309,7 @@ func (x *GetColumnStatisticReply) String() string {
 func (*GetColumnStatisticReply) ProtoMessage() {}
 
 func (x *GetColumnStatisticReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[164]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[165]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9235,7 +9322,7 @@ func (x *GetColumnStatisticReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnStatisticReply.ProtoReflect.Descriptor instead.
 func (*GetColumnStatisticReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{164}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *GetColumnStatisticReply) GetUuid() string {
@@ -9278,7 +9365,7 @@ type GetUserColumnListReq struct {
 func (x *GetUserColumnListReq) Reset() {
 	*x = GetUserColumnListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[165]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[166]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9291,7 +9378,7 @@ func (x *GetUserColumnListReq) String() string {
 func (*GetUserColumnListReq) ProtoMessage() {}
 
 func (x *GetUserColumnListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[165]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[166]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9304,7 +9391,7 @@ func (x *GetUserColumnListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserColumnListReq.ProtoReflect.Descriptor instead.
 func (*GetUserColumnListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{165}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *GetUserColumnListReq) GetPage() int32 {
@@ -9333,7 +9420,7 @@ type GetUserColumnListVisitorReq struct {
 func (x *GetUserColumnListVisitorReq) Reset() {
 	*x = GetUserColumnListVisitorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[166]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[167]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9346,7 +9433,7 @@ func (x *GetUserColumnListVisitorReq) String() string {
 func (*GetUserColumnListVisitorReq) ProtoMessage() {}
 
 func (x *GetUserColumnListVisitorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[166]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[167]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9359,7 +9446,7 @@ func (x *GetUserColumnListVisitorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserColumnListVisitorReq.ProtoReflect.Descriptor instead.
 func (*GetUserColumnListVisitorReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{166}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *GetUserColumnListVisitorReq) GetPage() int32 {
@@ -9387,7 +9474,7 @@ type GetColumnArticleListReq struct {
 func (x *GetColumnArticleListReq) Reset() {
 	*x = GetColumnArticleListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[167]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[168]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9400,7 +9487,7 @@ func (x *GetColumnArticleListReq) String() string {
 func (*GetColumnArticleListReq) ProtoMessage() {}
 
 func (x *GetColumnArticleListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[167]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[168]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9413,7 +9500,7 @@ func (x *GetColumnArticleListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnArticleListReq.ProtoReflect.Descriptor instead.
 func (*GetColumnArticleListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{167}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *GetColumnArticleListReq) GetId() int32 {
@@ -9434,7 +9521,7 @@ type GetColumnCountReq struct {
 func (x *GetColumnCountReq) Reset() {
 	*x = GetColumnCountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[168]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[169]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9447,7 +9534,7 @@ func (x *GetColumnCountReq) String() string {
 func (*GetColumnCountReq) ProtoMessage() {}
 
 func (x *GetColumnCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[168]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[169]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9460,7 +9547,7 @@ func (x *GetColumnCountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnCountReq.ProtoReflect.Descriptor instead.
 func (*GetColumnCountReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{168}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *GetColumnCountReq) GetUuid() string {
@@ -9481,7 +9568,7 @@ type GetColumnCountVisitorReq struct {
 func (x *GetColumnCountVisitorReq) Reset() {
 	*x = GetColumnCountVisitorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[169]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[170]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9494,7 +9581,7 @@ func (x *GetColumnCountVisitorReq) String() string {
 func (*GetColumnCountVisitorReq) ProtoMessage() {}
 
 func (x *GetColumnCountVisitorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[169]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[170]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9507,7 +9594,7 @@ func (x *GetColumnCountVisitorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnCountVisitorReq.ProtoReflect.Descriptor instead.
 func (*GetColumnCountVisitorReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{169}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *GetColumnCountVisitorReq) GetUuid() string {
@@ -9528,7 +9615,7 @@ type GetColumnCountReply struct {
 func (x *GetColumnCountReply) Reset() {
 	*x = GetColumnCountReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[170]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[171]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9541,7 +9628,7 @@ func (x *GetColumnCountReply) String() string {
 func (*GetColumnCountReply) ProtoMessage() {}
 
 func (x *GetColumnCountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[170]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[171]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9554,7 +9641,7 @@ func (x *GetColumnCountReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnCountReply.ProtoReflect.Descriptor instead.
 func (*GetColumnCountReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{170}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *GetColumnCountReply) GetCount() int32 {
@@ -9576,7 +9663,7 @@ type GetSubscribeListReq struct {
 func (x *GetSubscribeListReq) Reset() {
 	*x = GetSubscribeListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[171]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[172]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9589,7 +9676,7 @@ func (x *GetSubscribeListReq) String() string {
 func (*GetSubscribeListReq) ProtoMessage() {}
 
 func (x *GetSubscribeListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[171]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[172]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9602,7 +9689,7 @@ func (x *GetSubscribeListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeListReq.ProtoReflect.Descriptor instead.
 func (*GetSubscribeListReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{171}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *GetSubscribeListReq) GetPage() int32 {
@@ -9630,7 +9717,7 @@ type GetSubscribeListReply struct {
 func (x *GetSubscribeListReply) Reset() {
 	*x = GetSubscribeListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[172]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[173]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9643,7 +9730,7 @@ func (x *GetSubscribeListReply) String() string {
 func (*GetSubscribeListReply) ProtoMessage() {}
 
 func (x *GetSubscribeListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[172]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[173]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9656,7 +9743,7 @@ func (x *GetSubscribeListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeListReply.ProtoReflect.Descriptor instead.
 func (*GetSubscribeListReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{172}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *GetSubscribeListReply) GetSubscribe() []*GetSubscribeListReply_Subscribe {
@@ -9677,7 +9764,7 @@ type GetSubscribeListCountReq struct {
 func (x *GetSubscribeListCountReq) Reset() {
 	*x = GetSubscribeListCountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[173]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[174]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9690,7 +9777,7 @@ func (x *GetSubscribeListCountReq) String() string {
 func (*GetSubscribeListCountReq) ProtoMessage() {}
 
 func (x *GetSubscribeListCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[173]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[174]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9703,7 +9790,7 @@ func (x *GetSubscribeListCountReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeListCountReq.ProtoReflect.Descriptor instead.
 func (*GetSubscribeListCountReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{173}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *GetSubscribeListCountReq) GetUuid() string {
@@ -9724,7 +9811,7 @@ type GetSubscribeListCountReply struct {
 func (x *GetSubscribeListCountReply) Reset() {
 	*x = GetSubscribeListCountReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[174]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[175]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9737,7 +9824,7 @@ func (x *GetSubscribeListCountReply) String() string {
 func (*GetSubscribeListCountReply) ProtoMessage() {}
 
 func (x *GetSubscribeListCountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[174]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[175]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9750,7 +9837,7 @@ func (x *GetSubscribeListCountReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribeListCountReply.ProtoReflect.Descriptor instead.
 func (*GetSubscribeListCountReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{174}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *GetSubscribeListCountReply) GetCount() int32 {
@@ -9772,7 +9859,7 @@ type GetColumnSubscribesReq struct {
 func (x *GetColumnSubscribesReq) Reset() {
 	*x = GetColumnSubscribesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[175]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[176]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9785,7 +9872,7 @@ func (x *GetColumnSubscribesReq) String() string {
 func (*GetColumnSubscribesReq) ProtoMessage() {}
 
 func (x *GetColumnSubscribesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[175]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[176]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9798,7 +9885,7 @@ func (x *GetColumnSubscribesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnSubscribesReq.ProtoReflect.Descriptor instead.
 func (*GetColumnSubscribesReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{175}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{176}
 }
 
 func (x *GetColumnSubscribesReq) GetUuid() string {
@@ -9826,7 +9913,7 @@ type GetColumnSubscribesReply struct {
 func (x *GetColumnSubscribesReply) Reset() {
 	*x = GetColumnSubscribesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[176]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[177]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9839,7 +9926,7 @@ func (x *GetColumnSubscribesReply) String() string {
 func (*GetColumnSubscribesReply) ProtoMessage() {}
 
 func (x *GetColumnSubscribesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[176]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[177]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9852,7 +9939,7 @@ func (x *GetColumnSubscribesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnSubscribesReply.ProtoReflect.Descriptor instead.
 func (*GetColumnSubscribesReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{176}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{177}
 }
 
 func (x *GetColumnSubscribesReply) GetSubscribes() []*GetColumnSubscribesReply_Subscribes {
@@ -9873,7 +9960,7 @@ type GetUserColumnAgreeReq struct {
 func (x *GetUserColumnAgreeReq) Reset() {
 	*x = GetUserColumnAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[177]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[178]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9886,7 +9973,7 @@ func (x *GetUserColumnAgreeReq) String() string {
 func (*GetUserColumnAgreeReq) ProtoMessage() {}
 
 func (x *GetUserColumnAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[177]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[178]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9899,7 +9986,7 @@ func (x *GetUserColumnAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserColumnAgreeReq.ProtoReflect.Descriptor instead.
 func (*GetUserColumnAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{177}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{178}
 }
 
 func (x *GetUserColumnAgreeReq) GetUuid() string {
@@ -9920,7 +10007,7 @@ type GetUserColumnCollectReq struct {
 func (x *GetUserColumnCollectReq) Reset() {
 	*x = GetUserColumnCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[178]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[179]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9933,7 +10020,7 @@ func (x *GetUserColumnCollectReq) String() string {
 func (*GetUserColumnCollectReq) ProtoMessage() {}
 
 func (x *GetUserColumnCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[178]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[179]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9946,7 +10033,7 @@ func (x *GetUserColumnCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserColumnCollectReq.ProtoReflect.Descriptor instead.
 func (*GetUserColumnCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{178}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{179}
 }
 
 func (x *GetUserColumnCollectReq) GetUuid() string {
@@ -9967,7 +10054,7 @@ type GetUserColumnAgreeReply struct {
 func (x *GetUserColumnAgreeReply) Reset() {
 	*x = GetUserColumnAgreeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[179]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[180]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9980,7 +10067,7 @@ func (x *GetUserColumnAgreeReply) String() string {
 func (*GetUserColumnAgreeReply) ProtoMessage() {}
 
 func (x *GetUserColumnAgreeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[179]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[180]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9993,7 +10080,7 @@ func (x *GetUserColumnAgreeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserColumnAgreeReply.ProtoReflect.Descriptor instead.
 func (*GetUserColumnAgreeReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{179}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{180}
 }
 
 func (x *GetUserColumnAgreeReply) GetAgree() map[int32]bool {
@@ -10014,7 +10101,7 @@ type GetUserColumnCollectReply struct {
 func (x *GetUserColumnCollectReply) Reset() {
 	*x = GetUserColumnCollectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[180]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[181]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10027,7 +10114,7 @@ func (x *GetUserColumnCollectReply) String() string {
 func (*GetUserColumnCollectReply) ProtoMessage() {}
 
 func (x *GetUserColumnCollectReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[180]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[181]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10040,7 +10127,7 @@ func (x *GetUserColumnCollectReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserColumnCollectReply.ProtoReflect.Descriptor instead.
 func (*GetUserColumnCollectReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{180}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *GetUserColumnCollectReply) GetCollect() map[int32]bool {
@@ -10061,7 +10148,7 @@ type GetUserSubscribeColumnReq struct {
 func (x *GetUserSubscribeColumnReq) Reset() {
 	*x = GetUserSubscribeColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[181]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[182]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10074,7 +10161,7 @@ func (x *GetUserSubscribeColumnReq) String() string {
 func (*GetUserSubscribeColumnReq) ProtoMessage() {}
 
 func (x *GetUserSubscribeColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[181]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[182]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10087,7 +10174,7 @@ func (x *GetUserSubscribeColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSubscribeColumnReq.ProtoReflect.Descriptor instead.
 func (*GetUserSubscribeColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{181}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *GetUserSubscribeColumnReq) GetUuid() string {
@@ -10108,7 +10195,7 @@ type GetUserSubscribeColumnReply struct {
 func (x *GetUserSubscribeColumnReply) Reset() {
 	*x = GetUserSubscribeColumnReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[182]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[183]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10121,7 +10208,7 @@ func (x *GetUserSubscribeColumnReply) String() string {
 func (*GetUserSubscribeColumnReply) ProtoMessage() {}
 
 func (x *GetUserSubscribeColumnReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[182]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[183]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10134,7 +10221,7 @@ func (x *GetUserSubscribeColumnReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSubscribeColumnReply.ProtoReflect.Descriptor instead.
 func (*GetUserSubscribeColumnReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{182}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *GetUserSubscribeColumnReply) GetSubscribe() map[int32]bool {
@@ -10157,7 +10244,7 @@ type SendColumnEditReq struct {
 func (x *SendColumnEditReq) Reset() {
 	*x = SendColumnEditReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[183]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[184]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10170,7 +10257,7 @@ func (x *SendColumnEditReq) String() string {
 func (*SendColumnEditReq) ProtoMessage() {}
 
 func (x *SendColumnEditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[183]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[184]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10183,7 +10270,7 @@ func (x *SendColumnEditReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendColumnEditReq.ProtoReflect.Descriptor instead.
 func (*SendColumnEditReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{183}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *SendColumnEditReq) GetId() int32 {
@@ -10220,7 +10307,7 @@ type EditColumnReq struct {
 func (x *EditColumnReq) Reset() {
 	*x = EditColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[184]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[185]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10233,7 +10320,7 @@ func (x *EditColumnReq) String() string {
 func (*EditColumnReq) ProtoMessage() {}
 
 func (x *EditColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[184]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[185]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10246,7 +10333,7 @@ func (x *EditColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditColumnReq.ProtoReflect.Descriptor instead.
 func (*EditColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{184}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *EditColumnReq) GetId() int32 {
@@ -10282,7 +10369,7 @@ type DeleteColumnReq struct {
 func (x *DeleteColumnReq) Reset() {
 	*x = DeleteColumnReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[185]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[186]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10295,7 +10382,7 @@ func (x *DeleteColumnReq) String() string {
 func (*DeleteColumnReq) ProtoMessage() {}
 
 func (x *DeleteColumnReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[185]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[186]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10308,7 +10395,7 @@ func (x *DeleteColumnReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteColumnReq.ProtoReflect.Descriptor instead.
 func (*DeleteColumnReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{185}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *DeleteColumnReq) GetId() int32 {
@@ -10337,7 +10424,7 @@ type DeleteColumnCacheAndSearchReq struct {
 func (x *DeleteColumnCacheAndSearchReq) Reset() {
 	*x = DeleteColumnCacheAndSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[186]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[187]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10350,7 +10437,7 @@ func (x *DeleteColumnCacheAndSearchReq) String() string {
 func (*DeleteColumnCacheAndSearchReq) ProtoMessage() {}
 
 func (x *DeleteColumnCacheAndSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[186]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[187]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10363,7 +10450,7 @@ func (x *DeleteColumnCacheAndSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteColumnCacheAndSearchReq.ProtoReflect.Descriptor instead.
 func (*DeleteColumnCacheAndSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{186}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *DeleteColumnCacheAndSearchReq) GetId() int32 {
@@ -10392,7 +10479,7 @@ type ColumnStatisticJudgeReq struct {
 func (x *ColumnStatisticJudgeReq) Reset() {
 	*x = ColumnStatisticJudgeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[187]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[188]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10405,7 +10492,7 @@ func (x *ColumnStatisticJudgeReq) String() string {
 func (*ColumnStatisticJudgeReq) ProtoMessage() {}
 
 func (x *ColumnStatisticJudgeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[187]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[188]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10418,7 +10505,7 @@ func (x *ColumnStatisticJudgeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnStatisticJudgeReq.ProtoReflect.Descriptor instead.
 func (*ColumnStatisticJudgeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{187}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *ColumnStatisticJudgeReq) GetId() int32 {
@@ -10447,7 +10534,7 @@ type ColumnStatisticJudgeReply struct {
 func (x *ColumnStatisticJudgeReply) Reset() {
 	*x = ColumnStatisticJudgeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[188]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[189]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10460,7 +10547,7 @@ func (x *ColumnStatisticJudgeReply) String() string {
 func (*ColumnStatisticJudgeReply) ProtoMessage() {}
 
 func (x *ColumnStatisticJudgeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[188]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[189]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10473,7 +10560,7 @@ func (x *ColumnStatisticJudgeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnStatisticJudgeReply.ProtoReflect.Descriptor instead.
 func (*ColumnStatisticJudgeReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{188}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *ColumnStatisticJudgeReply) GetAgree() bool {
@@ -10503,7 +10590,7 @@ type SetColumnAgreeReq struct {
 func (x *SetColumnAgreeReq) Reset() {
 	*x = SetColumnAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[189]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[190]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10516,7 +10603,7 @@ func (x *SetColumnAgreeReq) String() string {
 func (*SetColumnAgreeReq) ProtoMessage() {}
 
 func (x *SetColumnAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[189]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[190]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10529,7 +10616,7 @@ func (x *SetColumnAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnAgreeReq.ProtoReflect.Descriptor instead.
 func (*SetColumnAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{189}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *SetColumnAgreeReq) GetId() int32 {
@@ -10566,7 +10653,7 @@ type SetColumnAgreeDbAndCacheReq struct {
 func (x *SetColumnAgreeDbAndCacheReq) Reset() {
 	*x = SetColumnAgreeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[190]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[191]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10579,7 +10666,7 @@ func (x *SetColumnAgreeDbAndCacheReq) String() string {
 func (*SetColumnAgreeDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetColumnAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[190]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[191]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10592,7 +10679,7 @@ func (x *SetColumnAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnAgreeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetColumnAgreeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{190}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *SetColumnAgreeDbAndCacheReq) GetId() int32 {
@@ -10629,7 +10716,7 @@ type CancelColumnAgreeReq struct {
 func (x *CancelColumnAgreeReq) Reset() {
 	*x = CancelColumnAgreeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[191]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[192]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10642,7 +10729,7 @@ func (x *CancelColumnAgreeReq) String() string {
 func (*CancelColumnAgreeReq) ProtoMessage() {}
 
 func (x *CancelColumnAgreeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[191]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[192]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10655,7 +10742,7 @@ func (x *CancelColumnAgreeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelColumnAgreeReq.ProtoReflect.Descriptor instead.
 func (*CancelColumnAgreeReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{191}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *CancelColumnAgreeReq) GetId() int32 {
@@ -10692,7 +10779,7 @@ type CancelColumnAgreeDbAndCacheReq struct {
 func (x *CancelColumnAgreeDbAndCacheReq) Reset() {
 	*x = CancelColumnAgreeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[192]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[193]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10705,7 +10792,7 @@ func (x *CancelColumnAgreeDbAndCacheReq) String() string {
 func (*CancelColumnAgreeDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelColumnAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[192]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[193]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10718,7 +10805,7 @@ func (x *CancelColumnAgreeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelColumnAgreeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelColumnAgreeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{192}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *CancelColumnAgreeDbAndCacheReq) GetId() int32 {
@@ -10756,7 +10843,7 @@ type SetColumnCollectReq struct {
 func (x *SetColumnCollectReq) Reset() {
 	*x = SetColumnCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[193]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[194]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10769,7 +10856,7 @@ func (x *SetColumnCollectReq) String() string {
 func (*SetColumnCollectReq) ProtoMessage() {}
 
 func (x *SetColumnCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[193]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[194]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10782,7 +10869,7 @@ func (x *SetColumnCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnCollectReq.ProtoReflect.Descriptor instead.
 func (*SetColumnCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{193}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *SetColumnCollectReq) GetId() int32 {
@@ -10827,7 +10914,7 @@ type SetColumnCollectDbAndCacheReq struct {
 func (x *SetColumnCollectDbAndCacheReq) Reset() {
 	*x = SetColumnCollectDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[194]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[195]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10840,7 +10927,7 @@ func (x *SetColumnCollectDbAndCacheReq) String() string {
 func (*SetColumnCollectDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetColumnCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[194]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[195]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10853,7 +10940,7 @@ func (x *SetColumnCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnCollectDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetColumnCollectDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{194}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *SetColumnCollectDbAndCacheReq) GetId() int32 {
@@ -10897,7 +10984,7 @@ type CancelColumnCollectReq struct {
 func (x *CancelColumnCollectReq) Reset() {
 	*x = CancelColumnCollectReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[195]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[196]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10910,7 +10997,7 @@ func (x *CancelColumnCollectReq) String() string {
 func (*CancelColumnCollectReq) ProtoMessage() {}
 
 func (x *CancelColumnCollectReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[195]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[196]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10923,7 +11010,7 @@ func (x *CancelColumnCollectReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelColumnCollectReq.ProtoReflect.Descriptor instead.
 func (*CancelColumnCollectReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{195}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *CancelColumnCollectReq) GetId() int32 {
@@ -10960,7 +11047,7 @@ type CancelColumnCollectDbAndCacheReq struct {
 func (x *CancelColumnCollectDbAndCacheReq) Reset() {
 	*x = CancelColumnCollectDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[196]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[197]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10973,7 +11060,7 @@ func (x *CancelColumnCollectDbAndCacheReq) String() string {
 func (*CancelColumnCollectDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelColumnCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[196]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[197]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10986,7 +11073,7 @@ func (x *CancelColumnCollectDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelColumnCollectDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelColumnCollectDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{196}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *CancelColumnCollectDbAndCacheReq) GetId() int32 {
@@ -11015,14 +11102,16 @@ type SetColumnViewReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid     string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UserUuid string `protobuf:"bytes,3,opt,name=userUuid,proto3" json:"userUuid,omitempty"`
+	Ip       string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
 }
 
 func (x *SetColumnViewReq) Reset() {
 	*x = SetColumnViewReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[197]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[198]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11035,7 +11124,7 @@ func (x *SetColumnViewReq) String() string {
 func (*SetColumnViewReq) ProtoMessage() {}
 
 func (x *SetColumnViewReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[197]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[198]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11048,7 +11137,7 @@ func (x *SetColumnViewReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnViewReq.ProtoReflect.Descriptor instead.
 func (*SetColumnViewReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{197}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *SetColumnViewReq) GetId() int32 {
@@ -11065,6 +11154,20 @@ func (x *SetColumnViewReq) GetUuid() string {
 	return ""
 }
 
+func (x *SetColumnViewReq) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *SetColumnViewReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
 type SetColumnViewDbAndCacheReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -11077,7 +11180,7 @@ type SetColumnViewDbAndCacheReq struct {
 func (x *SetColumnViewDbAndCacheReq) Reset() {
 	*x = SetColumnViewDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[198]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[199]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11090,7 +11193,7 @@ func (x *SetColumnViewDbAndCacheReq) String() string {
 func (*SetColumnViewDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetColumnViewDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[198]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[199]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11103,7 +11206,7 @@ func (x *SetColumnViewDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnViewDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetColumnViewDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{198}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *SetColumnViewDbAndCacheReq) GetId() int32 {
@@ -11133,7 +11236,7 @@ type AddColumnIncludesReq struct {
 func (x *AddColumnIncludesReq) Reset() {
 	*x = AddColumnIncludesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[199]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[200]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11146,7 +11249,7 @@ func (x *AddColumnIncludesReq) String() string {
 func (*AddColumnIncludesReq) ProtoMessage() {}
 
 func (x *AddColumnIncludesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[199]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[200]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11159,7 +11262,7 @@ func (x *AddColumnIncludesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddColumnIncludesReq.ProtoReflect.Descriptor instead.
 func (*AddColumnIncludesReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{199}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *AddColumnIncludesReq) GetId() int32 {
@@ -11196,7 +11299,7 @@ type AddColumnIncludesDbAndCacheReq struct {
 func (x *AddColumnIncludesDbAndCacheReq) Reset() {
 	*x = AddColumnIncludesDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[200]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[201]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11209,7 +11312,7 @@ func (x *AddColumnIncludesDbAndCacheReq) String() string {
 func (*AddColumnIncludesDbAndCacheReq) ProtoMessage() {}
 
 func (x *AddColumnIncludesDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[200]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[201]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11222,7 +11325,7 @@ func (x *AddColumnIncludesDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddColumnIncludesDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*AddColumnIncludesDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{200}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *AddColumnIncludesDbAndCacheReq) GetId() int32 {
@@ -11259,7 +11362,7 @@ type DeleteColumnIncludesReq struct {
 func (x *DeleteColumnIncludesReq) Reset() {
 	*x = DeleteColumnIncludesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[201]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[202]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11272,7 +11375,7 @@ func (x *DeleteColumnIncludesReq) String() string {
 func (*DeleteColumnIncludesReq) ProtoMessage() {}
 
 func (x *DeleteColumnIncludesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[201]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[202]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11285,7 +11388,7 @@ func (x *DeleteColumnIncludesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteColumnIncludesReq.ProtoReflect.Descriptor instead.
 func (*DeleteColumnIncludesReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{201}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *DeleteColumnIncludesReq) GetId() int32 {
@@ -11322,7 +11425,7 @@ type DeleteColumnIncludesDbAndCacheReq struct {
 func (x *DeleteColumnIncludesDbAndCacheReq) Reset() {
 	*x = DeleteColumnIncludesDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[202]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[203]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11335,7 +11438,7 @@ func (x *DeleteColumnIncludesDbAndCacheReq) String() string {
 func (*DeleteColumnIncludesDbAndCacheReq) ProtoMessage() {}
 
 func (x *DeleteColumnIncludesDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[202]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[203]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11348,7 +11451,7 @@ func (x *DeleteColumnIncludesDbAndCacheReq) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DeleteColumnIncludesDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*DeleteColumnIncludesDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{202}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *DeleteColumnIncludesDbAndCacheReq) GetId() int32 {
@@ -11384,7 +11487,7 @@ type SetColumnSubscribeDbAndCacheReq struct {
 func (x *SetColumnSubscribeDbAndCacheReq) Reset() {
 	*x = SetColumnSubscribeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[203]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[204]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11397,7 +11500,7 @@ func (x *SetColumnSubscribeDbAndCacheReq) String() string {
 func (*SetColumnSubscribeDbAndCacheReq) ProtoMessage() {}
 
 func (x *SetColumnSubscribeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[203]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[204]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11410,7 +11513,7 @@ func (x *SetColumnSubscribeDbAndCacheReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetColumnSubscribeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*SetColumnSubscribeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{203}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *SetColumnSubscribeDbAndCacheReq) GetId() int32 {
@@ -11439,7 +11542,7 @@ type CancelColumnSubscribeDbAndCacheReq struct {
 func (x *CancelColumnSubscribeDbAndCacheReq) Reset() {
 	*x = CancelColumnSubscribeDbAndCacheReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[204]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[205]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11452,7 +11555,7 @@ func (x *CancelColumnSubscribeDbAndCacheReq) String() string {
 func (*CancelColumnSubscribeDbAndCacheReq) ProtoMessage() {}
 
 func (x *CancelColumnSubscribeDbAndCacheReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[204]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[205]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11465,7 +11568,7 @@ func (x *CancelColumnSubscribeDbAndCacheReq) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CancelColumnSubscribeDbAndCacheReq.ProtoReflect.Descriptor instead.
 func (*CancelColumnSubscribeDbAndCacheReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{204}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *CancelColumnSubscribeDbAndCacheReq) GetId() int32 {
@@ -11493,7 +11596,7 @@ type GetNewsReq struct {
 func (x *GetNewsReq) Reset() {
 	*x = GetNewsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[205]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[206]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11506,7 +11609,7 @@ func (x *GetNewsReq) String() string {
 func (*GetNewsReq) ProtoMessage() {}
 
 func (x *GetNewsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[205]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[206]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11519,7 +11622,7 @@ func (x *GetNewsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewsReq.ProtoReflect.Descriptor instead.
 func (*GetNewsReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{205}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *GetNewsReq) GetPage() int32 {
@@ -11540,7 +11643,7 @@ type GetNewsReply struct {
 func (x *GetNewsReply) Reset() {
 	*x = GetNewsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[206]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[207]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11553,7 +11656,7 @@ func (x *GetNewsReply) String() string {
 func (*GetNewsReply) ProtoMessage() {}
 
 func (x *GetNewsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[206]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[207]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11566,7 +11669,7 @@ func (x *GetNewsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewsReply.ProtoReflect.Descriptor instead.
 func (*GetNewsReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{206}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{207}
 }
 
 func (x *GetNewsReply) GetNews() []*GetNewsReply_News {
@@ -11589,7 +11692,7 @@ type GetNewsSearchReq struct {
 func (x *GetNewsSearchReq) Reset() {
 	*x = GetNewsSearchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[207]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[208]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11602,7 +11705,7 @@ func (x *GetNewsSearchReq) String() string {
 func (*GetNewsSearchReq) ProtoMessage() {}
 
 func (x *GetNewsSearchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[207]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[208]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11615,7 +11718,7 @@ func (x *GetNewsSearchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewsSearchReq.ProtoReflect.Descriptor instead.
 func (*GetNewsSearchReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{207}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *GetNewsSearchReq) GetPage() int32 {
@@ -11651,7 +11754,7 @@ type GetNewsSearchReply struct {
 func (x *GetNewsSearchReply) Reset() {
 	*x = GetNewsSearchReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[208]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[209]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11664,7 +11767,7 @@ func (x *GetNewsSearchReply) String() string {
 func (*GetNewsSearchReply) ProtoMessage() {}
 
 func (x *GetNewsSearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[208]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[209]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11677,7 +11780,7 @@ func (x *GetNewsSearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewsSearchReply.ProtoReflect.Descriptor instead.
 func (*GetNewsSearchReply) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{208}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{209}
 }
 
 func (x *GetNewsSearchReply) GetList() []*GetNewsSearchReply_List {
@@ -11707,7 +11810,7 @@ type AddCreationCommentReq struct {
 func (x *AddCreationCommentReq) Reset() {
 	*x = AddCreationCommentReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[209]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[210]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11720,7 +11823,7 @@ func (x *AddCreationCommentReq) String() string {
 func (*AddCreationCommentReq) ProtoMessage() {}
 
 func (x *AddCreationCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[209]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[210]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11733,7 +11836,7 @@ func (x *AddCreationCommentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCreationCommentReq.ProtoReflect.Descriptor instead.
 func (*AddCreationCommentReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{209}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *AddCreationCommentReq) GetCreationId() int32 {
@@ -11770,7 +11873,7 @@ type ReduceCreationCommentReq struct {
 func (x *ReduceCreationCommentReq) Reset() {
 	*x = ReduceCreationCommentReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[210]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[211]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11783,7 +11886,7 @@ func (x *ReduceCreationCommentReq) String() string {
 func (*ReduceCreationCommentReq) ProtoMessage() {}
 
 func (x *ReduceCreationCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[210]
+	mi := &file_creation_service_v1_creation_proto_msgTypes[211]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11796,7 +11899,7 @@ func (x *ReduceCreationCommentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReduceCreationCommentReq.ProtoReflect.Descriptor instead.
 func (*ReduceCreationCommentReq) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{210}
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *ReduceCreationCommentReq) GetCreationId() int32 {
@@ -11820,33 +11923,31 @@ func (x *ReduceCreationCommentReq) GetUuid() string {
 	return ""
 }
 
-type GetLeaderBoardReply_Board struct {
+type PurgeUserDataReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetLeaderBoardReply_Board) Reset() {
-	*x = GetLeaderBoardReply_Board{}
+func (x *PurgeUserDataReq) Reset() {
+	*x = PurgeUserDataReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[211]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[212]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLeaderBoardReply_Board) String() string {
+func (x *PurgeUserDataReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaderBoardReply_Board) ProtoMessage() {}
+func (*PurgeUserDataReq) ProtoMessage() {}
 
-func (x *GetLeaderBoardReply_Board) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[211]
+func (x *PurgeUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[212]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11857,66 +11958,43 @@ func (x *GetLeaderBoardReply_Board) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaderBoardReply_Board.ProtoReflect.Descriptor instead.
-func (*GetLeaderBoardReply_Board) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *GetLeaderBoardReply_Board) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use PurgeUserDataReq.ProtoReflect.Descriptor instead.
+func (*PurgeUserDataReq) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *GetLeaderBoardReply_Board) GetUuid() string {
+func (x *PurgeUserDataReq) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetLeaderBoardReply_Board) GetMode() string {
-	if x != nil {
-		return x.Mode
-	}
-	return ""
-}
-
-type GetCollectionsContentReviewReply_Review struct {
+type GetUserDataReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
-	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
-	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Label      string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	Result     int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
-	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetCollectionsContentReviewReply_Review) Reset() {
-	*x = GetCollectionsContentReviewReply_Review{}
+func (x *GetUserDataReq) Reset() {
+	*x = GetUserDataReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[212]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[213]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCollectionsContentReviewReply_Review) String() string {
+func (x *GetUserDataReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsContentReviewReply_Review) ProtoMessage() {}
+func (*GetUserDataReq) ProtoMessage() {}
 
-func (x *GetCollectionsContentReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[212]
+func (x *GetUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[213]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11927,106 +12005,90 @@ func (x *GetCollectionsContentReviewReply_Review) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsContentReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetCollectionsContentReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use GetUserDataReq.ProtoReflect.Descriptor instead.
+func (*GetUserDataReq) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetId() int32 {
+func (x *GetUserDataReq) GetUuid() string {
 	if x != nil {
-		return x.Id
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetCreationId() int32 {
-	if x != nil {
-		return x.CreationId
-	}
-	return 0
+type GetUserDataReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*GetUserDataReply_File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *GetUserDataReply) Reset() {
+	*x = GetUserDataReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetKind() string {
-	if x != nil {
-		return x.Kind
-	}
-	return ""
+func (x *GetUserDataReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetUuid() string {
-	if x != nil {
-		return x.Uuid
+func (*GetUserDataReply) ProtoMessage() {}
+
+func (x *GetUserDataReply) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetCreateAt() string {
-	if x != nil {
-		return x.CreateAt
-	}
-	return ""
-}
-
-func (x *GetCollectionsContentReviewReply_Review) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
-}
-
-func (x *GetCollectionsContentReviewReply_Review) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *GetCollectionsContentReviewReply_Review) GetResult() int32 {
-	if x != nil {
-		return x.Result
-	}
-	return 0
+// Deprecated: Use GetUserDataReply.ProtoReflect.Descriptor instead.
+func (*GetUserDataReply) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *GetCollectionsContentReviewReply_Review) GetSection() string {
+func (x *GetUserDataReply) GetFiles() []*GetUserDataReply_File {
 	if x != nil {
-		return x.Section
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-type GetCollectionsListReply_Collections struct {
+type GetUserTagsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetCollectionsListReply_Collections) Reset() {
-	*x = GetCollectionsListReply_Collections{}
+func (x *GetUserTagsReq) Reset() {
+	*x = GetUserTagsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[213]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[215]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCollectionsListReply_Collections) String() string {
+func (x *GetUserTagsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsListReply_Collections) ProtoMessage() {}
+func (*GetUserTagsReq) ProtoMessage() {}
 
-func (x *GetCollectionsListReply_Collections) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[213]
+func (x *GetUserTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[215]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12037,50 +12099,43 @@ func (x *GetCollectionsListReply_Collections) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsListReply_Collections.ProtoReflect.Descriptor instead.
-func (*GetCollectionsListReply_Collections) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use GetUserTagsReq.ProtoReflect.Descriptor instead.
+func (*GetUserTagsReq) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *GetCollectionsListReply_Collections) GetId() int32 {
+func (x *GetUserTagsReq) GetUuid() string {
 	if x != nil {
-		return x.Id
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-type GetUserTimeLineListReply_TimeLine struct {
+type GetUserTagsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid       string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreationId int32  `protobuf:"varint,3,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
-	Mode       int32  `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	Agree      int32  `protobuf:"varint,5,opt,name=agree,proto3" json:"agree,omitempty"`
-	Collect    int32  `protobuf:"varint,6,opt,name=collect,proto3" json:"collect,omitempty"`
-	View       int32  `protobuf:"varint,7,opt,name=view,proto3" json:"view,omitempty"`
-	Comment    int32  `protobuf:"varint,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	Tags map[string]int32 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) Reset() {
-	*x = GetUserTimeLineListReply_TimeLine{}
+func (x *GetUserTagsReply) Reset() {
+	*x = GetUserTagsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[214]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[216]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) String() string {
+func (x *GetUserTagsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTimeLineListReply_TimeLine) ProtoMessage() {}
+func (*GetUserTagsReply) ProtoMessage() {}
 
-func (x *GetUserTimeLineListReply_TimeLine) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[214]
+func (x *GetUserTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[216]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12091,93 +12146,98 @@ func (x *GetUserTimeLineListReply_TimeLine) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTimeLineListReply_TimeLine.ProtoReflect.Descriptor instead.
-func (*GetUserTimeLineListReply_TimeLine) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use GetUserTagsReply.ProtoReflect.Descriptor instead.
+func (*GetUserTagsReply) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetId() int32 {
+func (x *GetUserTagsReply) GetTags() map[string]int32 {
 	if x != nil {
-		return x.Id
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
+type GetTagAuthorsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Size int32    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetCreationId() int32 {
-	if x != nil {
-		return x.CreationId
+func (x *GetTagAuthorsReq) Reset() {
+	*x = GetTagAuthorsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetMode() int32 {
-	if x != nil {
-		return x.Mode
-	}
-	return 0
+func (x *GetTagAuthorsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetAgree() int32 {
-	if x != nil {
-		return x.Agree
+func (*GetTagAuthorsReq) ProtoMessage() {}
+
+func (x *GetTagAuthorsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetCollect() int32 {
-	if x != nil {
-		return x.Collect
-	}
-	return 0
+// Deprecated: Use GetTagAuthorsReq.ProtoReflect.Descriptor instead.
+func (*GetTagAuthorsReq) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetView() int32 {
+func (x *GetTagAuthorsReq) GetTags() []string {
 	if x != nil {
-		return x.View
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *GetUserTimeLineListReply_TimeLine) GetComment() int32 {
+func (x *GetTagAuthorsReq) GetSize() int32 {
 	if x != nil {
-		return x.Comment
+		return x.Size
 	}
 	return 0
 }
 
-type GetArticleListReply_Article struct {
+type GetTagAuthorsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Authors map[string]int32 `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetArticleListReply_Article) Reset() {
-	*x = GetArticleListReply_Article{}
+func (x *GetTagAuthorsReply) Reset() {
+	*x = GetTagAuthorsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[215]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[218]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleListReply_Article) String() string {
+func (x *GetTagAuthorsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleListReply_Article) ProtoMessage() {}
+func (*GetTagAuthorsReply) ProtoMessage() {}
 
-func (x *GetArticleListReply_Article) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[215]
+func (x *GetTagAuthorsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[218]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12188,51 +12248,44 @@ func (x *GetArticleListReply_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleListReply_Article.ProtoReflect.Descriptor instead.
-func (*GetArticleListReply_Article) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{41, 0}
-}
-
-func (x *GetArticleListReply_Article) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetTagAuthorsReply.ProtoReflect.Descriptor instead.
+func (*GetTagAuthorsReply) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *GetArticleListReply_Article) GetUuid() string {
+func (x *GetTagAuthorsReply) GetAuthors() map[string]int32 {
 	if x != nil {
-		return x.Uuid
+		return x.Authors
 	}
-	return ""
+	return nil
 }
 
-type GetArticleListHotReply_Article struct {
+type GetAuthorActivityReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Days  int32    `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
 }
 
-func (x *GetArticleListHotReply_Article) Reset() {
-	*x = GetArticleListHotReply_Article{}
+func (x *GetAuthorActivityReq) Reset() {
+	*x = GetAuthorActivityReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[216]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[219]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleListHotReply_Article) String() string {
+func (x *GetAuthorActivityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleListHotReply_Article) ProtoMessage() {}
+func (*GetAuthorActivityReq) ProtoMessage() {}
 
-func (x *GetArticleListHotReply_Article) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[216]
+func (x *GetAuthorActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[219]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12243,54 +12296,50 @@ func (x *GetArticleListHotReply_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleListHotReply_Article.ProtoReflect.Descriptor instead.
-func (*GetArticleListHotReply_Article) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{43, 0}
+// Deprecated: Use GetAuthorActivityReq.ProtoReflect.Descriptor instead.
+func (*GetAuthorActivityReq) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *GetArticleListHotReply_Article) GetId() int32 {
+func (x *GetAuthorActivityReq) GetUuids() []string {
 	if x != nil {
-		return x.Id
+		return x.Uuids
 	}
-	return 0
+	return nil
 }
 
-func (x *GetArticleListHotReply_Article) GetUuid() string {
+func (x *GetAuthorActivityReq) GetDays() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-type GetArticleListStatisticReply_Count struct {
+type GetAuthorActivityReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Agree   int32 `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
-	Collect int32 `protobuf:"varint,3,opt,name=collect,proto3" json:"collect,omitempty"`
-	View    int32 `protobuf:"varint,4,opt,name=view,proto3" json:"view,omitempty"`
-	Comment int32 `protobuf:"varint,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Activity map[string]int32 `protobuf:"bytes,1,rep,name=activity,proto3" json:"activity,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetArticleListStatisticReply_Count) Reset() {
-	*x = GetArticleListStatisticReply_Count{}
+func (x *GetAuthorActivityReply) Reset() {
+	*x = GetAuthorActivityReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[219]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[220]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleListStatisticReply_Count) String() string {
+func (x *GetAuthorActivityReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleListStatisticReply_Count) ProtoMessage() {}
+func (*GetAuthorActivityReply) ProtoMessage() {}
 
-func (x *GetArticleListStatisticReply_Count) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[219]
+func (x *GetAuthorActivityReply) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[220]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12301,77 +12350,45 @@ func (x *GetArticleListStatisticReply_Count) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleListStatisticReply_Count.ProtoReflect.Descriptor instead.
-func (*GetArticleListStatisticReply_Count) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{57, 0}
-}
-
-func (x *GetArticleListStatisticReply_Count) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *GetArticleListStatisticReply_Count) GetAgree() int32 {
-	if x != nil {
-		return x.Agree
-	}
-	return 0
-}
-
-func (x *GetArticleListStatisticReply_Count) GetCollect() int32 {
-	if x != nil {
-		return x.Collect
-	}
-	return 0
-}
-
-func (x *GetArticleListStatisticReply_Count) GetView() int32 {
-	if x != nil {
-		return x.View
-	}
-	return 0
+// Deprecated: Use GetAuthorActivityReply.ProtoReflect.Descriptor instead.
+func (*GetAuthorActivityReply) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *GetArticleListStatisticReply_Count) GetComment() int32 {
+func (x *GetAuthorActivityReply) GetActivity() map[string]int32 {
 	if x != nil {
-		return x.Comment
+		return x.Activity
 	}
-	return 0
+	return nil
 }
 
-type GetArticleSearchReply_List struct {
+type GetLeaderBoardReply_Board struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Tags   string `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
-	Text   string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
-	Cover  string `protobuf:"bytes,5,opt,name=cover,proto3" json:"cover,omitempty"`
-	Update string `protobuf:"bytes,6,opt,name=update,proto3" json:"update,omitempty"`
-	Uuid   string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (x *GetArticleSearchReply_List) Reset() {
-	*x = GetArticleSearchReply_List{}
+func (x *GetLeaderBoardReply_Board) Reset() {
+	*x = GetLeaderBoardReply_Board{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[220]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[221]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleSearchReply_List) String() string {
+func (x *GetLeaderBoardReply_Board) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleSearchReply_List) ProtoMessage() {}
+func (*GetLeaderBoardReply_Board) ProtoMessage() {}
 
-func (x *GetArticleSearchReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[220]
+func (x *GetLeaderBoardReply_Board) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[221]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12382,97 +12399,66 @@ func (x *GetArticleSearchReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleSearchReply_List.ProtoReflect.Descriptor instead.
-func (*GetArticleSearchReply_List) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{61, 0}
+// Deprecated: Use GetLeaderBoardReply_Board.ProtoReflect.Descriptor instead.
+func (*GetLeaderBoardReply_Board) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *GetArticleSearchReply_List) GetId() int32 {
+func (x *GetLeaderBoardReply_Board) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetArticleSearchReply_List) GetTitle() string {
+func (x *GetLeaderBoardReply_Board) GetUuid() string {
 	if x != nil {
-		return x.Title
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetArticleSearchReply_List) GetTags() string {
-	if x != nil {
-		return x.Tags
-	}
-	return ""
-}
-
-func (x *GetArticleSearchReply_List) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *GetArticleSearchReply_List) GetCover() string {
-	if x != nil {
-		return x.Cover
-	}
-	return ""
-}
-
-func (x *GetArticleSearchReply_List) GetUpdate() string {
-	if x != nil {
-		return x.Update
-	}
-	return ""
-}
-
-func (x *GetArticleSearchReply_List) GetUuid() string {
+func (x *GetLeaderBoardReply_Board) GetMode() string {
 	if x != nil {
-		return x.Uuid
+		return x.Mode
 	}
 	return ""
 }
 
-type GetArticleImageReviewReply_Review struct {
+type GetCollectionsContentReviewReply_Review struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
-	Kind       string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	Uid        string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
 	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Url        string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
-	Label      string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
-	Result     int32  `protobuf:"varint,10,opt,name=result,proto3" json:"result,omitempty"`
-	Score      int32  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
-	Category   string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
-	SubLabel   string `protobuf:"bytes,13,opt,name=subLabel,proto3" json:"subLabel,omitempty"`
+	Label      string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	Result     int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
+	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
 }
 
-func (x *GetArticleImageReviewReply_Review) Reset() {
-	*x = GetArticleImageReviewReply_Review{}
+func (x *GetCollectionsContentReviewReply_Review) Reset() {
+	*x = GetCollectionsContentReviewReply_Review{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[221]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[222]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleImageReviewReply_Review) String() string {
+func (x *GetCollectionsContentReviewReply_Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleImageReviewReply_Review) ProtoMessage() {}
+func (*GetCollectionsContentReviewReply_Review) ProtoMessage() {}
 
-func (x *GetArticleImageReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[221]
+func (x *GetCollectionsContentReviewReply_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[222]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12483,136 +12469,106 @@ func (x *GetArticleImageReviewReply_Review) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleImageReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetArticleImageReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{63, 0}
+// Deprecated: Use GetCollectionsContentReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetCollectionsContentReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *GetArticleImageReviewReply_Review) GetId() int32 {
+func (x *GetCollectionsContentReviewReply_Review) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetArticleImageReviewReply_Review) GetCreationId() int32 {
+func (x *GetCollectionsContentReviewReply_Review) GetCreationId() int32 {
 	if x != nil {
 		return x.CreationId
 	}
 	return 0
 }
 
-func (x *GetArticleImageReviewReply_Review) GetKind() string {
+func (x *GetCollectionsContentReviewReply_Review) GetTitle() string {
 	if x != nil {
-		return x.Kind
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetArticleImageReviewReply_Review) GetUid() string {
+func (x *GetCollectionsContentReviewReply_Review) GetKind() string {
 	if x != nil {
-		return x.Uid
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *GetArticleImageReviewReply_Review) GetUuid() string {
+func (x *GetCollectionsContentReviewReply_Review) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetArticleImageReviewReply_Review) GetCreateAt() string {
+func (x *GetCollectionsContentReviewReply_Review) GetCreateAt() string {
 	if x != nil {
 		return x.CreateAt
 	}
 	return ""
 }
 
-func (x *GetArticleImageReviewReply_Review) GetJobId() string {
+func (x *GetCollectionsContentReviewReply_Review) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-func (x *GetArticleImageReviewReply_Review) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *GetArticleImageReviewReply_Review) GetLabel() string {
+func (x *GetCollectionsContentReviewReply_Review) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *GetArticleImageReviewReply_Review) GetResult() int32 {
+func (x *GetCollectionsContentReviewReply_Review) GetResult() int32 {
 	if x != nil {
 		return x.Result
 	}
 	return 0
 }
 
-func (x *GetArticleImageReviewReply_Review) GetScore() int32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *GetArticleImageReviewReply_Review) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *GetArticleImageReviewReply_Review) GetSubLabel() string {
+func (x *GetCollectionsContentReviewReply_Review) GetSection() string {
 	if x != nil {
-		return x.SubLabel
+		return x.Section
 	}
 	return ""
 }
 
-type GetArticleContentReviewReply_Review struct {
+type GetCollectionsListReply_Collections struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
-	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
-	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Label      string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	Result     int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
-	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetArticleContentReviewReply_Review) Reset() {
-	*x = GetArticleContentReviewReply_Review{}
+func (x *GetCollectionsListReply_Collections) Reset() {
+	*x = GetCollectionsListReply_Collections{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[222]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[223]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleContentReviewReply_Review) String() string {
+func (x *GetCollectionsListReply_Collections) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleContentReviewReply_Review) ProtoMessage() {}
+func (*GetCollectionsListReply_Collections) ProtoMessage() {}
 
-func (x *GetArticleContentReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[222]
+func (x *GetCollectionsListReply_Collections) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[223]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12623,154 +12579,147 @@ func (x *GetArticleContentReviewReply_Review) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleContentReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetArticleContentReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{65, 0}
+// Deprecated: Use GetCollectionsListReply_Collections.ProtoReflect.Descriptor instead.
+func (*GetCollectionsListReply_Collections) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *GetArticleContentReviewReply_Review) GetId() int32 {
+func (x *GetCollectionsListReply_Collections) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetCreationId() int32 {
-	if x != nil {
-		return x.CreationId
+type GetUserTimeLineListReply_TimeLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid       string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreationId int32  `protobuf:"varint,3,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
+	Mode       int32  `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Agree      int32  `protobuf:"varint,5,opt,name=agree,proto3" json:"agree,omitempty"`
+	Collect    int32  `protobuf:"varint,6,opt,name=collect,proto3" json:"collect,omitempty"`
+	View       int32  `protobuf:"varint,7,opt,name=view,proto3" json:"view,omitempty"`
+	Comment    int32  `protobuf:"varint,8,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *GetUserTimeLineListReply_TimeLine) Reset() {
+	*x = GetUserTimeLineListReply_TimeLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[224]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *GetUserTimeLineListReply_TimeLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimeLineListReply_TimeLine) ProtoMessage() {}
+
+func (x *GetUserTimeLineListReply_TimeLine) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[224]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetArticleContentReviewReply_Review) GetKind() string {
+// Deprecated: Use GetUserTimeLineListReply_TimeLine.ProtoReflect.Descriptor instead.
+func (*GetUserTimeLineListReply_TimeLine) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *GetUserTimeLineListReply_TimeLine) GetId() int32 {
 	if x != nil {
-		return x.Kind
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetUuid() string {
+func (x *GetUserTimeLineListReply_TimeLine) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetArticleContentReviewReply_Review) GetCreateAt() string {
+func (x *GetUserTimeLineListReply_TimeLine) GetCreationId() int32 {
 	if x != nil {
-		return x.CreateAt
+		return x.CreationId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetJobId() string {
+func (x *GetUserTimeLineListReply_TimeLine) GetMode() int32 {
 	if x != nil {
-		return x.JobId
+		return x.Mode
 	}
-	return ""
+	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetLabel() string {
+func (x *GetUserTimeLineListReply_TimeLine) GetAgree() int32 {
 	if x != nil {
-		return x.Label
+		return x.Agree
 	}
-	return ""
+	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetResult() int32 {
+func (x *GetUserTimeLineListReply_TimeLine) GetCollect() int32 {
 	if x != nil {
-		return x.Result
+		return x.Collect
 	}
 	return 0
 }
 
-func (x *GetArticleContentReviewReply_Review) GetSection() string {
+func (x *GetUserTimeLineListReply_TimeLine) GetView() int32 {
 	if x != nil {
-		return x.Section
+		return x.View
 	}
-	return ""
+	return 0
 }
 
-type GetArticleDraftListReply_Draft struct {
+func (x *GetUserTimeLineListReply_TimeLine) GetComment() int32 {
+	if x != nil {
+		return x.Comment
+	}
+	return 0
+}
+
+type GetArticleListReply_Article struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetArticleDraftListReply_Draft) Reset() {
-	*x = GetArticleDraftListReply_Draft{}
+func (x *GetArticleListReply_Article) Reset() {
+	*x = GetArticleListReply_Article{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[223]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[225]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArticleDraftListReply_Draft) String() string {
+func (x *GetArticleListReply_Article) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleDraftListReply_Draft) ProtoMessage() {}
+func (*GetArticleListReply_Article) ProtoMessage() {}
 
-func (x *GetArticleDraftListReply_Draft) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[223]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetArticleDraftListReply_Draft.ProtoReflect.Descriptor instead.
-func (*GetArticleDraftListReply_Draft) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{78, 0}
-}
-
-func (x *GetArticleDraftListReply_Draft) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type GetTalkListReply_Talk struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-}
-
-func (x *GetTalkListReply_Talk) Reset() {
-	*x = GetTalkListReply_Talk{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[224]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetTalkListReply_Talk) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetTalkListReply_Talk) ProtoMessage() {}
-
-func (x *GetTalkListReply_Talk) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[224]
+func (x *GetArticleListReply_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[225]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12781,26 +12730,26 @@ func (x *GetTalkListReply_Talk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTalkListReply_Talk.ProtoReflect.Descriptor instead.
-func (*GetTalkListReply_Talk) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{93, 0}
+// Deprecated: Use GetArticleListReply_Article.ProtoReflect.Descriptor instead.
+func (*GetArticleListReply_Article) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *GetTalkListReply_Talk) GetId() int32 {
+func (x *GetArticleListReply_Article) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTalkListReply_Talk) GetUuid() string {
+func (x *GetArticleListReply_Article) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type GetTalkListHotReply_Talk struct {
+type GetArticleListHotReply_Article struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -12809,23 +12758,23 @@ type GetTalkListHotReply_Talk struct {
 	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetTalkListHotReply_Talk) Reset() {
-	*x = GetTalkListHotReply_Talk{}
+func (x *GetArticleListHotReply_Article) Reset() {
+	*x = GetArticleListHotReply_Article{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[225]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[226]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTalkListHotReply_Talk) String() string {
+func (x *GetArticleListHotReply_Article) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTalkListHotReply_Talk) ProtoMessage() {}
+func (*GetArticleListHotReply_Article) ProtoMessage() {}
 
-func (x *GetTalkListHotReply_Talk) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[225]
+func (x *GetArticleListHotReply_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[226]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12836,26 +12785,26 @@ func (x *GetTalkListHotReply_Talk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTalkListHotReply_Talk.ProtoReflect.Descriptor instead.
-func (*GetTalkListHotReply_Talk) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{98, 0}
+// Deprecated: Use GetArticleListHotReply_Article.ProtoReflect.Descriptor instead.
+func (*GetArticleListHotReply_Article) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (x *GetTalkListHotReply_Talk) GetId() int32 {
+func (x *GetArticleListHotReply_Article) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTalkListHotReply_Talk) GetUuid() string {
+func (x *GetArticleListHotReply_Article) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type GetTalkListStatisticReply_Count struct {
+type GetArticleListStatisticReply_Count struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -12867,23 +12816,23 @@ type GetTalkListStatisticReply_Count struct {
 	Comment int32 `protobuf:"varint,5,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *GetTalkListStatisticReply_Count) Reset() {
-	*x = GetTalkListStatisticReply_Count{}
+func (x *GetArticleListStatisticReply_Count) Reset() {
+	*x = GetArticleListStatisticReply_Count{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[226]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[229]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTalkListStatisticReply_Count) String() string {
+func (x *GetArticleListStatisticReply_Count) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTalkListStatisticReply_Count) ProtoMessage() {}
+func (*GetArticleListStatisticReply_Count) ProtoMessage() {}
 
-func (x *GetTalkListStatisticReply_Count) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[226]
+func (x *GetArticleListStatisticReply_Count) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[229]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12894,47 +12843,47 @@ func (x *GetTalkListStatisticReply_Count) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTalkListStatisticReply_Count.ProtoReflect.Descriptor instead.
-func (*GetTalkListStatisticReply_Count) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{102, 0}
+// Deprecated: Use GetArticleListStatisticReply_Count.ProtoReflect.Descriptor instead.
+func (*GetArticleListStatisticReply_Count) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{57, 0}
 }
 
-func (x *GetTalkListStatisticReply_Count) GetId() int32 {
+func (x *GetArticleListStatisticReply_Count) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTalkListStatisticReply_Count) GetAgree() int32 {
+func (x *GetArticleListStatisticReply_Count) GetAgree() int32 {
 	if x != nil {
 		return x.Agree
 	}
 	return 0
 }
 
-func (x *GetTalkListStatisticReply_Count) GetCollect() int32 {
+func (x *GetArticleListStatisticReply_Count) GetCollect() int32 {
 	if x != nil {
 		return x.Collect
 	}
 	return 0
 }
 
-func (x *GetTalkListStatisticReply_Count) GetView() int32 {
+func (x *GetArticleListStatisticReply_Count) GetView() int32 {
 	if x != nil {
 		return x.View
 	}
 	return 0
 }
 
-func (x *GetTalkListStatisticReply_Count) GetComment() int32 {
+func (x *GetArticleListStatisticReply_Count) GetComment() int32 {
 	if x != nil {
 		return x.Comment
 	}
 	return 0
 }
 
-type GetTalkSearchReply_List struct {
+type GetArticleSearchReply_List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -12948,23 +12897,23 @@ type GetTalkSearchReply_List struct {
 	Uuid   string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetTalkSearchReply_List) Reset() {
-	*x = GetTalkSearchReply_List{}
+func (x *GetArticleSearchReply_List) Reset() {
+	*x = GetArticleSearchReply_List{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[227]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[230]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTalkSearchReply_List) String() string {
+func (x *GetArticleSearchReply_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTalkSearchReply_List) ProtoMessage() {}
+func (*GetArticleSearchReply_List) ProtoMessage() {}
 
-func (x *GetTalkSearchReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[227]
+func (x *GetArticleSearchReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[230]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12975,61 +12924,61 @@ func (x *GetTalkSearchReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTalkSearchReply_List.ProtoReflect.Descriptor instead.
-func (*GetTalkSearchReply_List) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{108, 0}
+// Deprecated: Use GetArticleSearchReply_List.ProtoReflect.Descriptor instead.
+func (*GetArticleSearchReply_List) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{61, 0}
 }
 
-func (x *GetTalkSearchReply_List) GetId() int32 {
+func (x *GetArticleSearchReply_List) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTalkSearchReply_List) GetTitle() string {
+func (x *GetArticleSearchReply_List) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *GetTalkSearchReply_List) GetTags() string {
+func (x *GetArticleSearchReply_List) GetTags() string {
 	if x != nil {
 		return x.Tags
 	}
 	return ""
 }
 
-func (x *GetTalkSearchReply_List) GetText() string {
+func (x *GetArticleSearchReply_List) GetText() string {
 	if x != nil {
 		return x.Text
 	}
 	return ""
 }
 
-func (x *GetTalkSearchReply_List) GetCover() string {
+func (x *GetArticleSearchReply_List) GetCover() string {
 	if x != nil {
 		return x.Cover
 	}
 	return ""
 }
 
-func (x *GetTalkSearchReply_List) GetUpdate() string {
+func (x *GetArticleSearchReply_List) GetUpdate() string {
 	if x != nil {
 		return x.Update
 	}
 	return ""
 }
 
-func (x *GetTalkSearchReply_List) GetUuid() string {
+func (x *GetArticleSearchReply_List) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type GetTalkImageReviewReply_Review struct {
+type GetArticleImageReviewReply_Review struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -13049,23 +12998,23 @@ type GetTalkImageReviewReply_Review struct {
 	SubLabel   string `protobuf:"bytes,13,opt,name=subLabel,proto3" json:"subLabel,omitempty"`
 }
 
-func (x *GetTalkImageReviewReply_Review) Reset() {
-	*x = GetTalkImageReviewReply_Review{}
+func (x *GetArticleImageReviewReply_Review) Reset() {
+	*x = GetArticleImageReviewReply_Review{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[230]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[231]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTalkImageReviewReply_Review) String() string {
+func (x *GetArticleImageReviewReply_Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTalkImageReviewReply_Review) ProtoMessage() {}
+func (*GetArticleImageReviewReply_Review) ProtoMessage() {}
 
-func (x *GetTalkImageReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[230]
+func (x *GetArticleImageReviewReply_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[231]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13076,103 +13025,103 @@ func (x *GetTalkImageReviewReply_Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTalkImageReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetTalkImageReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{114, 0}
+// Deprecated: Use GetArticleImageReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetArticleImageReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{63, 0}
 }
 
-func (x *GetTalkImageReviewReply_Review) GetId() int32 {
+func (x *GetArticleImageReviewReply_Review) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTalkImageReviewReply_Review) GetCreationId() int32 {
+func (x *GetArticleImageReviewReply_Review) GetCreationId() int32 {
 	if x != nil {
 		return x.CreationId
 	}
 	return 0
 }
 
-func (x *GetTalkImageReviewReply_Review) GetKind() string {
+func (x *GetArticleImageReviewReply_Review) GetKind() string {
 	if x != nil {
 		return x.Kind
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetUid() string {
+func (x *GetArticleImageReviewReply_Review) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetUuid() string {
+func (x *GetArticleImageReviewReply_Review) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetCreateAt() string {
+func (x *GetArticleImageReviewReply_Review) GetCreateAt() string {
 	if x != nil {
 		return x.CreateAt
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetJobId() string {
+func (x *GetArticleImageReviewReply_Review) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetUrl() string {
+func (x *GetArticleImageReviewReply_Review) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetLabel() string {
+func (x *GetArticleImageReviewReply_Review) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetResult() int32 {
+func (x *GetArticleImageReviewReply_Review) GetResult() int32 {
 	if x != nil {
 		return x.Result
 	}
 	return 0
 }
 
-func (x *GetTalkImageReviewReply_Review) GetScore() int32 {
+func (x *GetArticleImageReviewReply_Review) GetScore() int32 {
 	if x != nil {
 		return x.Score
 	}
 	return 0
 }
 
-func (x *GetTalkImageReviewReply_Review) GetCategory() string {
+func (x *GetArticleImageReviewReply_Review) GetCategory() string {
 	if x != nil {
 		return x.Category
 	}
 	return ""
 }
 
-func (x *GetTalkImageReviewReply_Review) GetSubLabel() string {
+func (x *GetArticleImageReviewReply_Review) GetSubLabel() string {
 	if x != nil {
 		return x.SubLabel
 	}
 	return ""
 }
 
-type GetTalkContentReviewReply_Review struct {
+type GetArticleContentReviewReply_Review struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -13189,23 +13138,23 @@ type GetTalkContentReviewReply_Review struct {
 	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
 }
 
-func (x *GetTalkContentReviewReply_Review) Reset() {
-	*x = GetTalkContentReviewReply_Review{}
+func (x *GetArticleContentReviewReply_Review) Reset() {
+	*x = GetArticleContentReviewReply_Review{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[231]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[232]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTalkContentReviewReply_Review) String() string {
+func (x *GetArticleContentReviewReply_Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTalkContentReviewReply_Review) ProtoMessage() {}
+func (*GetArticleContentReviewReply_Review) ProtoMessage() {}
 
-func (x *GetTalkContentReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[231]
+func (x *GetArticleContentReviewReply_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[232]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13216,112 +13165,109 @@ func (x *GetTalkContentReviewReply_Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTalkContentReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetTalkContentReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{116, 0}
+// Deprecated: Use GetArticleContentReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetArticleContentReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{65, 0}
 }
 
-func (x *GetTalkContentReviewReply_Review) GetId() int32 {
+func (x *GetArticleContentReviewReply_Review) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetTalkContentReviewReply_Review) GetCreationId() int32 {
+func (x *GetArticleContentReviewReply_Review) GetCreationId() int32 {
 	if x != nil {
 		return x.CreationId
 	}
 	return 0
 }
 
-func (x *GetTalkContentReviewReply_Review) GetTitle() string {
+func (x *GetArticleContentReviewReply_Review) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *GetTalkContentReviewReply_Review) GetKind() string {
+func (x *GetArticleContentReviewReply_Review) GetKind() string {
 	if x != nil {
 		return x.Kind
 	}
 	return ""
 }
 
-func (x *GetTalkContentReviewReply_Review) GetUuid() string {
+func (x *GetArticleContentReviewReply_Review) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetTalkContentReviewReply_Review) GetCreateAt() string {
+func (x *GetArticleContentReviewReply_Review) GetCreateAt() string {
 	if x != nil {
 		return x.CreateAt
 	}
 	return ""
 }
 
-func (x *GetTalkContentReviewReply_Review) GetJobId() string {
+func (x *GetArticleContentReviewReply_Review) GetJobId() string {
 	if x != nil {
 		return x.JobId
 	}
 	return ""
 }
 
-func (x *GetTalkContentReviewReply_Review) GetLabel() string {
+func (x *GetArticleContentReviewReply_Review) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *GetTalkContentReviewReply_Review) GetResult() int32 {
+func (x *GetArticleContentReviewReply_Review) GetResult() int32 {
 	if x != nil {
 		return x.Result
 	}
 	return 0
 }
 
-func (x *GetTalkContentReviewReply_Review) GetSection() string {
+func (x *GetArticleContentReviewReply_Review) GetSection() string {
 	if x != nil {
 		return x.Section
 	}
 	return ""
 }
 
-type GetColumnSearchReply_List struct {
+type AddCreationViewDbAndCacheReq_View struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tags      string `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
-	Introduce string `protobuf:"bytes,4,opt,name=introduce,proto3" json:"introduce,omitempty"`
-	Cover     string `protobuf:"bytes,5,opt,name=cover,proto3" json:"cover,omitempty"`
-	Update    string `protobuf:"bytes,6,opt,name=update,proto3" json:"update,omitempty"`
-	Uuid      string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid  string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Count int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Raw   int32  `protobuf:"varint,4,opt,name=raw,proto3" json:"raw,omitempty"`
 }
 
-func (x *GetColumnSearchReply_List) Reset() {
-	*x = GetColumnSearchReply_List{}
+func (x *AddCreationViewDbAndCacheReq_View) Reset() {
+	*x = AddCreationViewDbAndCacheReq_View{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[232]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[233]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetColumnSearchReply_List) String() string {
+func (x *AddCreationViewDbAndCacheReq_View) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColumnSearchReply_List) ProtoMessage() {}
+func (*AddCreationViewDbAndCacheReq_View) ProtoMessage() {}
 
-func (x *GetColumnSearchReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[232]
+func (x *AddCreationViewDbAndCacheReq_View) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[233]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13332,97 +13278,112 @@ func (x *GetColumnSearchReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColumnSearchReply_List.ProtoReflect.Descriptor instead.
-func (*GetColumnSearchReply_List) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{142, 0}
+// Deprecated: Use AddCreationViewDbAndCacheReq_View.ProtoReflect.Descriptor instead.
+func (*AddCreationViewDbAndCacheReq_View) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{72, 0}
 }
 
-func (x *GetColumnSearchReply_List) GetId() int32 {
+func (x *AddCreationViewDbAndCacheReq_View) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetColumnSearchReply_List) GetName() string {
+func (x *AddCreationViewDbAndCacheReq_View) GetUuid() string {
 	if x != nil {
-		return x.Name
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetColumnSearchReply_List) GetTags() string {
+func (x *AddCreationViewDbAndCacheReq_View) GetCount() int32 {
 	if x != nil {
-		return x.Tags
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *GetColumnSearchReply_List) GetIntroduce() string {
+func (x *AddCreationViewDbAndCacheReq_View) GetRaw() int32 {
 	if x != nil {
-		return x.Introduce
+		return x.Raw
 	}
-	return ""
+	return 0
 }
 
-func (x *GetColumnSearchReply_List) GetCover() string {
-	if x != nil {
-		return x.Cover
+type GetArticleDraftListReply_Draft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetArticleDraftListReply_Draft) Reset() {
+	*x = GetArticleDraftListReply_Draft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[234]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetColumnSearchReply_List) GetUpdate() string {
-	if x != nil {
-		return x.Update
+func (x *GetArticleDraftListReply_Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleDraftListReply_Draft) ProtoMessage() {}
+
+func (x *GetArticleDraftListReply_Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[234]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetColumnSearchReply_List) GetUuid() string {
+// Deprecated: Use GetArticleDraftListReply_Draft.ProtoReflect.Descriptor instead.
+func (*GetArticleDraftListReply_Draft) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *GetArticleDraftListReply_Draft) GetId() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type GetColumnImageReviewReply_Review struct {
+type GetTalkListReply_Talk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
-	Kind       string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	Uid        string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
-	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Url        string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
-	Label      string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
-	Result     int32  `protobuf:"varint,10,opt,name=result,proto3" json:"result,omitempty"`
-	Score      int32  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
-	Category   string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
-	SubLabel   string `protobuf:"bytes,13,opt,name=subLabel,proto3" json:"subLabel,omitempty"`
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetColumnImageReviewReply_Review) Reset() {
-	*x = GetColumnImageReviewReply_Review{}
+func (x *GetTalkListReply_Talk) Reset() {
+	*x = GetTalkListReply_Talk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[233]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[235]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetColumnImageReviewReply_Review) String() string {
+func (x *GetTalkListReply_Talk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColumnImageReviewReply_Review) ProtoMessage() {}
+func (*GetTalkListReply_Talk) ProtoMessage() {}
 
-func (x *GetColumnImageReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[233]
+func (x *GetTalkListReply_Talk) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[235]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13433,136 +13394,109 @@ func (x *GetColumnImageReviewReply_Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColumnImageReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetColumnImageReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{144, 0}
+// Deprecated: Use GetTalkListReply_Talk.ProtoReflect.Descriptor instead.
+func (*GetTalkListReply_Talk) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{94, 0}
 }
 
-func (x *GetColumnImageReviewReply_Review) GetId() int32 {
+func (x *GetTalkListReply_Talk) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetColumnImageReviewReply_Review) GetCreationId() int32 {
-	if x != nil {
-		return x.CreationId
-	}
-	return 0
-}
-
-func (x *GetColumnImageReviewReply_Review) GetKind() string {
+func (x *GetTalkListReply_Talk) GetUuid() string {
 	if x != nil {
-		return x.Kind
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetColumnImageReviewReply_Review) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
-}
+type GetTalkListHotReply_Talk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetColumnImageReviewReply_Review) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetColumnImageReviewReply_Review) GetCreateAt() string {
-	if x != nil {
-		return x.CreateAt
+func (x *GetTalkListHotReply_Talk) Reset() {
+	*x = GetTalkListHotReply_Talk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[236]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetColumnImageReviewReply_Review) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
+func (x *GetTalkListHotReply_Talk) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetColumnImageReviewReply_Review) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
+func (*GetTalkListHotReply_Talk) ProtoMessage() {}
 
-func (x *GetColumnImageReviewReply_Review) GetLabel() string {
-	if x != nil {
-		return x.Label
+func (x *GetTalkListHotReply_Talk) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[236]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetColumnImageReviewReply_Review) GetResult() int32 {
-	if x != nil {
-		return x.Result
-	}
-	return 0
+// Deprecated: Use GetTalkListHotReply_Talk.ProtoReflect.Descriptor instead.
+func (*GetTalkListHotReply_Talk) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{99, 0}
 }
 
-func (x *GetColumnImageReviewReply_Review) GetScore() int32 {
+func (x *GetTalkListHotReply_Talk) GetId() int32 {
 	if x != nil {
-		return x.Score
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetColumnImageReviewReply_Review) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *GetColumnImageReviewReply_Review) GetSubLabel() string {
+func (x *GetTalkListHotReply_Talk) GetUuid() string {
 	if x != nil {
-		return x.SubLabel
+		return x.Uuid
 	}
 	return ""
 }
 
-type GetColumnContentReviewReply_Review struct {
+type GetTalkListStatisticReply_Count struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
-	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
-	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Label      string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	Result     int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
-	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
+	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Agree   int32 `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
+	Collect int32 `protobuf:"varint,3,opt,name=collect,proto3" json:"collect,omitempty"`
+	View    int32 `protobuf:"varint,4,opt,name=view,proto3" json:"view,omitempty"`
+	Comment int32 `protobuf:"varint,5,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *GetColumnContentReviewReply_Review) Reset() {
-	*x = GetColumnContentReviewReply_Review{}
+func (x *GetTalkListStatisticReply_Count) Reset() {
+	*x = GetTalkListStatisticReply_Count{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[234]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[237]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetColumnContentReviewReply_Review) String() string {
+func (x *GetTalkListStatisticReply_Count) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColumnContentReviewReply_Review) ProtoMessage() {}
+func (*GetTalkListStatisticReply_Count) ProtoMessage() {}
 
-func (x *GetColumnContentReviewReply_Review) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[234]
+func (x *GetTalkListStatisticReply_Count) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[237]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13573,107 +13507,77 @@ func (x *GetColumnContentReviewReply_Review) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColumnContentReviewReply_Review.ProtoReflect.Descriptor instead.
-func (*GetColumnContentReviewReply_Review) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{146, 0}
+// Deprecated: Use GetTalkListStatisticReply_Count.ProtoReflect.Descriptor instead.
+func (*GetTalkListStatisticReply_Count) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{103, 0}
 }
 
-func (x *GetColumnContentReviewReply_Review) GetId() int32 {
+func (x *GetTalkListStatisticReply_Count) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetColumnContentReviewReply_Review) GetCreationId() int32 {
+func (x *GetTalkListStatisticReply_Count) GetAgree() int32 {
 	if x != nil {
-		return x.CreationId
+		return x.Agree
 	}
 	return 0
 }
 
-func (x *GetColumnContentReviewReply_Review) GetTitle() string {
+func (x *GetTalkListStatisticReply_Count) GetCollect() int32 {
 	if x != nil {
-		return x.Title
+		return x.Collect
 	}
-	return ""
+	return 0
 }
 
-func (x *GetColumnContentReviewReply_Review) GetKind() string {
+func (x *GetTalkListStatisticReply_Count) GetView() int32 {
 	if x != nil {
-		return x.Kind
+		return x.View
 	}
-	return ""
+	return 0
 }
 
-func (x *GetColumnContentReviewReply_Review) GetUuid() string {
+func (x *GetTalkListStatisticReply_Count) GetComment() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.Comment
 	}
-	return ""
+	return 0
 }
 
-func (x *GetColumnContentReviewReply_Review) GetCreateAt() string {
-	if x != nil {
-		return x.CreateAt
-	}
-	return ""
-}
+type GetTalkSearchReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetColumnContentReviewReply_Review) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
-}
-
-func (x *GetColumnContentReviewReply_Review) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *GetColumnContentReviewReply_Review) GetResult() int32 {
-	if x != nil {
-		return x.Result
-	}
-	return 0
-}
-
-func (x *GetColumnContentReviewReply_Review) GetSection() string {
-	if x != nil {
-		return x.Section
-	}
-	return ""
-}
-
-type GetColumnListReply_Column struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Tags   string `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Text   string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Cover  string `protobuf:"bytes,5,opt,name=cover,proto3" json:"cover,omitempty"`
+	Update string `protobuf:"bytes,6,opt,name=update,proto3" json:"update,omitempty"`
+	Uuid   string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetColumnListReply_Column) Reset() {
-	*x = GetColumnListReply_Column{}
+func (x *GetTalkSearchReply_List) Reset() {
+	*x = GetTalkSearchReply_List{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[235]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[238]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetColumnListReply_Column) String() string {
+func (x *GetTalkSearchReply_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColumnListReply_Column) ProtoMessage() {}
+func (*GetTalkSearchReply_List) ProtoMessage() {}
 
-func (x *GetColumnListReply_Column) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[235]
+func (x *GetTalkSearchReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[238]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13684,51 +13588,97 @@ func (x *GetColumnListReply_Column) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColumnListReply_Column.ProtoReflect.Descriptor instead.
-func (*GetColumnListReply_Column) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{158, 0}
+// Deprecated: Use GetTalkSearchReply_List.ProtoReflect.Descriptor instead.
+func (*GetTalkSearchReply_List) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{109, 0}
 }
 
-func (x *GetColumnListReply_Column) GetId() int32 {
+func (x *GetTalkSearchReply_List) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetColumnListReply_Column) GetUuid() string {
+func (x *GetTalkSearchReply_List) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetTalkSearchReply_List) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+func (x *GetTalkSearchReply_List) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *GetTalkSearchReply_List) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+func (x *GetTalkSearchReply_List) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
+}
+
+func (x *GetTalkSearchReply_List) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type GetColumnListHotReply_Column struct {
+type GetTalkImageReviewReply_Review struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
+	Kind       string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Uid        string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Url        string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	Label      string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+	Result     int32  `protobuf:"varint,10,opt,name=result,proto3" json:"result,omitempty"`
+	Score      int32  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
+	Category   string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	SubLabel   string `protobuf:"bytes,13,opt,name=subLabel,proto3" json:"subLabel,omitempty"`
 }
 
-func (x *GetColumnListHotReply_Column) Reset() {
-	*x = GetColumnListHotReply_Column{}
+func (x *GetTalkImageReviewReply_Review) Reset() {
+	*x = GetTalkImageReviewReply_Review{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[236]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[241]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetColumnListHotReply_Column) String() string {
+func (x *GetTalkImageReviewReply_Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColumnListHotReply_Column) ProtoMessage() {}
+func (*GetTalkImageReviewReply_Review) ProtoMessage() {}
 
-func (x *GetColumnListHotReply_Column) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[236]
+func (x *GetTalkImageReviewReply_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[241]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13739,122 +13689,136 @@ func (x *GetColumnListHotReply_Column) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColumnListHotReply_Column.ProtoReflect.Descriptor instead.
-func (*GetColumnListHotReply_Column) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{160, 0}
+// Deprecated: Use GetTalkImageReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetTalkImageReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{115, 0}
 }
 
-func (x *GetColumnListHotReply_Column) GetId() int32 {
+func (x *GetTalkImageReviewReply_Review) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetColumnListHotReply_Column) GetUuid() string {
+func (x *GetTalkImageReviewReply_Review) GetCreationId() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.CreationId
 	}
-	return ""
+	return 0
 }
 
-type GetColumnListStatisticReply_Count struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetTalkImageReviewReply_Review) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
 
-	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Agree   int32 `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
-	Collect int32 `protobuf:"varint,3,opt,name=collect,proto3" json:"collect,omitempty"`
-	View    int32 `protobuf:"varint,4,opt,name=view,proto3" json:"view,omitempty"`
+func (x *GetTalkImageReviewReply_Review) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
 }
 
-func (x *GetColumnListStatisticReply_Count) Reset() {
-	*x = GetColumnListStatisticReply_Count{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[237]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTalkImageReviewReply_Review) GetUuid() string {
+	if x != nil {
+		return x.Uuid
 	}
+	return ""
 }
 
-func (x *GetColumnListStatisticReply_Count) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTalkImageReviewReply_Review) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
 }
 
-func (*GetColumnListStatisticReply_Count) ProtoMessage() {}
+func (x *GetTalkImageReviewReply_Review) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
 
-func (x *GetColumnListStatisticReply_Count) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[237]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetTalkImageReviewReply_Review) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetColumnListStatisticReply_Count.ProtoReflect.Descriptor instead.
-func (*GetColumnListStatisticReply_Count) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{162, 0}
+func (x *GetTalkImageReviewReply_Review) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *GetColumnListStatisticReply_Count) GetId() int32 {
+func (x *GetTalkImageReviewReply_Review) GetResult() int32 {
 	if x != nil {
-		return x.Id
+		return x.Result
 	}
 	return 0
 }
 
-func (x *GetColumnListStatisticReply_Count) GetAgree() int32 {
+func (x *GetTalkImageReviewReply_Review) GetScore() int32 {
 	if x != nil {
-		return x.Agree
+		return x.Score
 	}
 	return 0
 }
 
-func (x *GetColumnListStatisticReply_Count) GetCollect() int32 {
+func (x *GetTalkImageReviewReply_Review) GetCategory() string {
 	if x != nil {
-		return x.Collect
+		return x.Category
 	}
-	return 0
+	return ""
 }
 
-func (x *GetColumnListStatisticReply_Count) GetView() int32 {
+func (x *GetTalkImageReviewReply_Review) GetSubLabel() string {
 	if x != nil {
-		return x.View
+		return x.SubLabel
 	}
-	return 0
+	return ""
 }
 
-type GetSubscribeListReply_Subscribe struct {
+type GetTalkContentReviewReply_Review struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
+	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Label      string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	Result     int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
+	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
 }
 
-func (x *GetSubscribeListReply_Subscribe) Reset() {
-	*x = GetSubscribeListReply_Subscribe{}
+func (x *GetTalkContentReviewReply_Review) Reset() {
+	*x = GetTalkContentReviewReply_Review{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[238]
+		mi := &file_creation_service_v1_creation_proto_msgTypes[242]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubscribeListReply_Subscribe) String() string {
+func (x *GetTalkContentReviewReply_Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscribeListReply_Subscribe) ProtoMessage() {}
+func (*GetTalkContentReviewReply_Review) ProtoMessage() {}
 
-func (x *GetSubscribeListReply_Subscribe) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[238]
+func (x *GetTalkContentReviewReply_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[242]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13865,90 +13829,97 @@ func (x *GetSubscribeListReply_Subscribe) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscribeListReply_Subscribe.ProtoReflect.Descriptor instead.
-func (*GetSubscribeListReply_Subscribe) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{172, 0}
+// Deprecated: Use GetTalkContentReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetTalkContentReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{117, 0}
 }
 
-func (x *GetSubscribeListReply_Subscribe) GetId() int32 {
+func (x *GetTalkContentReviewReply_Review) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetSubscribeListReply_Subscribe) GetUuid() string {
+func (x *GetTalkContentReviewReply_Review) GetCreationId() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.CreationId
 	}
-	return ""
+	return 0
 }
 
-type GetColumnSubscribesReply_Subscribes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id             int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubscribeJudge int32 `protobuf:"varint,2,opt,name=subscribe_judge,json=subscribeJudge,proto3" json:"subscribe_judge,omitempty"`
+func (x *GetTalkContentReviewReply_Review) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *GetColumnSubscribesReply_Subscribes) Reset() {
-	*x = GetColumnSubscribesReply_Subscribes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_creation_service_v1_creation_proto_msgTypes[239]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTalkContentReviewReply_Review) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
+	return ""
 }
 
-func (x *GetColumnSubscribesReply_Subscribes) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTalkContentReviewReply_Review) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
 }
 
-func (*GetColumnSubscribesReply_Subscribes) ProtoMessage() {}
+func (x *GetTalkContentReviewReply_Review) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
 
-func (x *GetColumnSubscribesReply_Subscribes) ProtoReflect() protoreflect.Message {
-	mi := &file_creation_service_v1_creation_proto_msgTypes[239]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetTalkContentReviewReply_Review) GetJobId() string {
+	if x != nil {
+		return x.JobId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetColumnSubscribesReply_Subscribes.ProtoReflect.Descriptor instead.
-func (*GetColumnSubscribesReply_Subscribes) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{176, 0}
+func (x *GetTalkContentReviewReply_Review) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *GetColumnSubscribesReply_Subscribes) GetId() int32 {
+func (x *GetTalkContentReviewReply_Review) GetResult() int32 {
 	if x != nil {
-		return x.Id
+		return x.Result
 	}
 	return 0
 }
 
-func (x *GetColumnSubscribesReply_Subscribes) GetSubscribeJudge() int32 {
+func (x *GetTalkContentReviewReply_Review) GetSection() string {
 	if x != nil {
-		return x.SubscribeJudge
+		return x.Section
 	}
-	return 0
+	return ""
 }
 
-type GetNewsReply_News struct {
+type GetColumnSearchReply_List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tags      string `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Introduce string `protobuf:"bytes,4,opt,name=introduce,proto3" json:"introduce,omitempty"`
+	Cover     string `protobuf:"bytes,5,opt,name=cover,proto3" json:"cover,omitempty"`
+	Update    string `protobuf:"bytes,6,opt,name=update,proto3" json:"update,omitempty"`
+	Uuid      string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *GetNewsReply_News) Reset() {
-	*x = GetNewsReply_News{}
+func (x *GetColumnSearchReply_List) Reset() {
+	*x = GetColumnSearchReply_List{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creation_service_v1_creation_proto_msgTypes[243]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -13956,13 +13927,13 @@ func (x *GetNewsReply_News) Reset() {
 	}
 }
 
-func (x *GetNewsReply_News) String() string {
+func (x *GetColumnSearchReply_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewsReply_News) ProtoMessage() {}
+func (*GetColumnSearchReply_List) ProtoMessage() {}
 
-func (x *GetNewsReply_News) ProtoReflect() protoreflect.Message {
+func (x *GetColumnSearchReply_List) ProtoReflect() protoreflect.Message {
 	mi := &file_creation_service_v1_creation_proto_msgTypes[243]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -13974,35 +13945,82 @@ func (x *GetNewsReply_News) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewsReply_News.ProtoReflect.Descriptor instead.
-func (*GetNewsReply_News) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{206, 0}
+// Deprecated: Use GetColumnSearchReply_List.ProtoReflect.Descriptor instead.
+func (*GetColumnSearchReply_List) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{143, 0}
 }
 
-func (x *GetNewsReply_News) GetId() string {
+func (x *GetColumnSearchReply_List) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
+	return 0
+}
+
+func (x *GetColumnSearchReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
 	return ""
 }
 
-type GetNewsSearchReply_List struct {
+func (x *GetColumnSearchReply_List) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+func (x *GetColumnSearchReply_List) GetIntroduce() string {
+	if x != nil {
+		return x.Introduce
+	}
+	return ""
+}
+
+func (x *GetColumnSearchReply_List) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+func (x *GetColumnSearchReply_List) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
+}
+
+func (x *GetColumnSearchReply_List) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetColumnImageReviewReply_Review struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Tags    string `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
-	Author  string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	Update  string `protobuf:"bytes,6,opt,name=update,proto3" json:"update,omitempty"`
-	Url     string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
-	Content string `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
-	Cover   string `protobuf:"bytes,9,opt,name=cover,proto3" json:"cover,omitempty"`
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
+	Kind       string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Uid        string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Url        string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	Label      string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+	Result     int32  `protobuf:"varint,10,opt,name=result,proto3" json:"result,omitempty"`
+	Score      int32  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
+	Category   string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	SubLabel   string `protobuf:"bytes,13,opt,name=subLabel,proto3" json:"subLabel,omitempty"`
 }
 
-func (x *GetNewsSearchReply_List) Reset() {
-	*x = GetNewsSearchReply_List{}
+func (x *GetColumnImageReviewReply_Review) Reset() {
+	*x = GetColumnImageReviewReply_Review{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_creation_service_v1_creation_proto_msgTypes[244]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -14010,13 +14028,13 @@ func (x *GetNewsSearchReply_List) Reset() {
 	}
 }
 
-func (x *GetNewsSearchReply_List) String() string {
+func (x *GetColumnImageReviewReply_Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewsSearchReply_List) ProtoMessage() {}
+func (*GetColumnImageReviewReply_Review) ProtoMessage() {}
 
-func (x *GetNewsSearchReply_List) ProtoReflect() protoreflect.Message {
+func (x *GetColumnImageReviewReply_Review) ProtoReflect() protoreflect.Message {
 	mi := &file_creation_service_v1_creation_proto_msgTypes[244]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -14028,93 +14046,743 @@ func (x *GetNewsSearchReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewsSearchReply_List.ProtoReflect.Descriptor instead.
-func (*GetNewsSearchReply_List) Descriptor() ([]byte, []int) {
-	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{208, 0}
+// Deprecated: Use GetColumnImageReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetColumnImageReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{145, 0}
 }
 
-func (x *GetNewsSearchReply_List) GetId() string {
+func (x *GetColumnImageReviewReply_Review) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
+	return 0
+}
+
+func (x *GetColumnImageReviewReply_Review) GetCreationId() int32 {
+	if x != nil {
+		return x.CreationId
+	}
+	return 0
+}
+
+func (x *GetColumnImageReviewReply_Review) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetTitle() string {
+func (x *GetColumnImageReviewReply_Review) GetUid() string {
 	if x != nil {
-		return x.Title
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetTags() string {
+func (x *GetColumnImageReviewReply_Review) GetUuid() string {
 	if x != nil {
-		return x.Tags
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetAuthor() string {
+func (x *GetColumnImageReviewReply_Review) GetCreateAt() string {
 	if x != nil {
-		return x.Author
+		return x.CreateAt
 	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetUpdate() string {
+func (x *GetColumnImageReviewReply_Review) GetJobId() string {
 	if x != nil {
-		return x.Update
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetUrl() string {
+func (x *GetColumnImageReviewReply_Review) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetContent() string {
+func (x *GetColumnImageReviewReply_Review) GetLabel() string {
 	if x != nil {
-		return x.Content
+		return x.Label
 	}
 	return ""
 }
 
-func (x *GetNewsSearchReply_List) GetCover() string {
+func (x *GetColumnImageReviewReply_Review) GetResult() int32 {
 	if x != nil {
-		return x.Cover
+		return x.Result
+	}
+	return 0
+}
+
+func (x *GetColumnImageReviewReply_Review) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetColumnImageReviewReply_Review) GetCategory() string {
+	if x != nil {
+		return x.Category
 	}
 	return ""
 }
 
-var File_creation_service_v1_creation_proto protoreflect.FileDescriptor
+func (x *GetColumnImageReviewReply_Review) GetSubLabel() string {
+	if x != nil {
+		return x.SubLabel
+	}
+	return ""
+}
 
-var file_creation_service_v1_creation_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
-	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x3f, 0x0a, 0x05, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
-	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
-	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c,
+type GetColumnContentReviewReply_Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreationId int32  `protobuf:"varint,2,opt,name=creation_id,json=creationId,proto3" json:"creation_id,omitempty"`
+	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Kind       string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Uuid       string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreateAt   string `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	JobId      string `protobuf:"bytes,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Label      string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	Result     int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
+	Section    string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
+}
+
+func (x *GetColumnContentReviewReply_Review) Reset() {
+	*x = GetColumnContentReviewReply_Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[245]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnContentReviewReply_Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnContentReviewReply_Review) ProtoMessage() {}
+
+func (x *GetColumnContentReviewReply_Review) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[245]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnContentReviewReply_Review.ProtoReflect.Descriptor instead.
+func (*GetColumnContentReviewReply_Review) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{147, 0}
+}
+
+func (x *GetColumnContentReviewReply_Review) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetColumnContentReviewReply_Review) GetCreationId() int32 {
+	if x != nil {
+		return x.CreationId
+	}
+	return 0
+}
+
+func (x *GetColumnContentReviewReply_Review) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetColumnContentReviewReply_Review) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetColumnContentReviewReply_Review) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetColumnContentReviewReply_Review) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+func (x *GetColumnContentReviewReply_Review) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetColumnContentReviewReply_Review) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GetColumnContentReviewReply_Review) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *GetColumnContentReviewReply_Review) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+type GetColumnListReply_Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetColumnListReply_Column) Reset() {
+	*x = GetColumnListReply_Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[246]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnListReply_Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnListReply_Column) ProtoMessage() {}
+
+func (x *GetColumnListReply_Column) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[246]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnListReply_Column.ProtoReflect.Descriptor instead.
+func (*GetColumnListReply_Column) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{159, 0}
+}
+
+func (x *GetColumnListReply_Column) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetColumnListReply_Column) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetColumnListHotReply_Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetColumnListHotReply_Column) Reset() {
+	*x = GetColumnListHotReply_Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[247]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnListHotReply_Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnListHotReply_Column) ProtoMessage() {}
+
+func (x *GetColumnListHotReply_Column) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[247]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnListHotReply_Column.ProtoReflect.Descriptor instead.
+func (*GetColumnListHotReply_Column) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{161, 0}
+}
+
+func (x *GetColumnListHotReply_Column) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetColumnListHotReply_Column) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetColumnListStatisticReply_Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Agree   int32 `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
+	Collect int32 `protobuf:"varint,3,opt,name=collect,proto3" json:"collect,omitempty"`
+	View    int32 `protobuf:"varint,4,opt,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *GetColumnListStatisticReply_Count) Reset() {
+	*x = GetColumnListStatisticReply_Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[248]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnListStatisticReply_Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnListStatisticReply_Count) ProtoMessage() {}
+
+func (x *GetColumnListStatisticReply_Count) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[248]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnListStatisticReply_Count.ProtoReflect.Descriptor instead.
+func (*GetColumnListStatisticReply_Count) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{163, 0}
+}
+
+func (x *GetColumnListStatisticReply_Count) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetColumnListStatisticReply_Count) GetAgree() int32 {
+	if x != nil {
+		return x.Agree
+	}
+	return 0
+}
+
+func (x *GetColumnListStatisticReply_Count) GetCollect() int32 {
+	if x != nil {
+		return x.Collect
+	}
+	return 0
+}
+
+func (x *GetColumnListStatisticReply_Count) GetView() int32 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+type GetSubscribeListReply_Subscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetSubscribeListReply_Subscribe) Reset() {
+	*x = GetSubscribeListReply_Subscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[249]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscribeListReply_Subscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribeListReply_Subscribe) ProtoMessage() {}
+
+func (x *GetSubscribeListReply_Subscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[249]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribeListReply_Subscribe.ProtoReflect.Descriptor instead.
+func (*GetSubscribeListReply_Subscribe) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{173, 0}
+}
+
+func (x *GetSubscribeListReply_Subscribe) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSubscribeListReply_Subscribe) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetColumnSubscribesReply_Subscribes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscribeJudge int32 `protobuf:"varint,2,opt,name=subscribe_judge,json=subscribeJudge,proto3" json:"subscribe_judge,omitempty"`
+}
+
+func (x *GetColumnSubscribesReply_Subscribes) Reset() {
+	*x = GetColumnSubscribesReply_Subscribes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[250]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnSubscribesReply_Subscribes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnSubscribesReply_Subscribes) ProtoMessage() {}
+
+func (x *GetColumnSubscribesReply_Subscribes) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[250]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnSubscribesReply_Subscribes.ProtoReflect.Descriptor instead.
+func (*GetColumnSubscribesReply_Subscribes) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{177, 0}
+}
+
+func (x *GetColumnSubscribesReply_Subscribes) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetColumnSubscribesReply_Subscribes) GetSubscribeJudge() int32 {
+	if x != nil {
+		return x.SubscribeJudge
+	}
+	return 0
+}
+
+type GetNewsReply_News struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetNewsReply_News) Reset() {
+	*x = GetNewsReply_News{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[254]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNewsReply_News) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsReply_News) ProtoMessage() {}
+
+func (x *GetNewsReply_News) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[254]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsReply_News.ProtoReflect.Descriptor instead.
+func (*GetNewsReply_News) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{207, 0}
+}
+
+func (x *GetNewsReply_News) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetNewsSearchReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Tags    string `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Author  string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Update  string `protobuf:"bytes,6,opt,name=update,proto3" json:"update,omitempty"`
+	Url     string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	Content string `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	Cover   string `protobuf:"bytes,9,opt,name=cover,proto3" json:"cover,omitempty"`
+}
+
+func (x *GetNewsSearchReply_List) Reset() {
+	*x = GetNewsSearchReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[255]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNewsSearchReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsSearchReply_List) ProtoMessage() {}
+
+func (x *GetNewsSearchReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[255]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsSearchReply_List.ProtoReflect.Descriptor instead.
+func (*GetNewsSearchReply_List) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{209, 0}
+}
+
+func (x *GetNewsSearchReply_List) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetNewsSearchReply_List) GetCover() string {
+	if x != nil {
+		return x.Cover
+	}
+	return ""
+}
+
+type GetUserDataReply_File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GetUserDataReply_File) Reset() {
+	*x = GetUserDataReply_File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_creation_service_v1_creation_proto_msgTypes[256]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDataReply_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDataReply_File) ProtoMessage() {}
+
+func (x *GetUserDataReply_File) ProtoReflect() protoreflect.Message {
+	mi := &file_creation_service_v1_creation_proto_msgTypes[256]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDataReply_File.ProtoReflect.Descriptor instead.
+func (*GetUserDataReply_File) Descriptor() ([]byte, []int) {
+	return file_creation_service_v1_creation_proto_rawDescGZIP(), []int{214, 0}
+}
+
+func (x *GetUserDataReply_File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserDataReply_File) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_creation_service_v1_creation_proto protoreflect.FileDescriptor
+
+var file_creation_service_v1_creation_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x3f, 0x0a, 0x05, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
+	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
+	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c,
 	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72,
 	0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
@@ -14455,684 +15123,106 @@ var file_creation_service_v1_creation_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x75, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x32, 0x11,
 	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x47,
+	0x24, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x47,
 	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
 	0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46,
-	0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
-	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x75, 0x0a,
-	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
-	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x67, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x18, 0x64, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x18, 0x0a, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x96, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
-	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9c, 0x03,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x1a, 0xb5, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x5e, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
-	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xde, 0x02, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x48, 0x0a,
-	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xf3, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0x62, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41,
-	0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x1a, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x1e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41,
-	0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x41, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65,
-	0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x5e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75,
-	0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a,
-	0x13, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4d, 0x61, 0x72,
-	0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
-	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x1a, 0x17, 0x0a, 0x05, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x67, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x6b, 0x0a, 0x12, 0x53,
-	0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
-	0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x50, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
-	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
-	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
-	0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a,
-	0x11, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0xb2, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64,
-	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35,
-	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
-	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x55, 0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x21, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x58,
-	0x0a, 0x18, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
-	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
-	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x1a, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x36, 0x0a, 0x04, 0x74, 0x61, 0x6c, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x61,
-	0x6c, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x6c, 0x6b, 0x1a, 0x2a, 0x0a, 0x04, 0x54, 0x61, 0x6c, 0x6b,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12,
-	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
-	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x22, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x61, 0x6c, 0x6b,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x04, 0x74,
-	0x61, 0x6c, 0x6b, 0x1a, 0x2a, 0x0a, 0x04, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03,
-	0x69, 0x64, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x42, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x75, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x44,
-	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f,
-	0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x1b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x18, 0x0a, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xfd, 0x01, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x1a, 0x96, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
-	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
-	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xa2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c,
-	0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x96, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a,
-	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x1a, 0xb5, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x5b, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xd8, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xf3, 0x01, 0x0a,
-	0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64,
-	0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15,
-	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
-	0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01,
-	0x52, 0x02, 0x69, 0x70, 0x22, 0x68, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b,
-	0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x61,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x5f, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x61, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x4d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x57, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44,
-	0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x45, 0x64,
-	0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x41, 0x0a,
-	0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0x4e, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0x3e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x44,
-	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0x55, 0x0a, 0x15, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x54, 0x61, 0x6c, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x19, 0x53,
-	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x1b, 0x53,
-	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41,
-	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69,
-	0x64, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b,
-	0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
-	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
-	0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x5e, 0x0a,
-	0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x8b, 0x01,
-	0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x77,
+	0x56, 0x69, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x61, 0x77, 0x56,
+	0x69, 0x65, 0x77, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15,
+	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65,
+	0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
 	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
-	0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x45,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44,
-	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x2e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0xdc, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x45, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x75, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x06, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x0a, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x22, 0x89, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x04, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x9e, 0x01, 0x0a, 0x04,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
-	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
-	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9a, 0x03, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x46,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x0a, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x1a, 0x96, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9c, 0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
 	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
 	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xb5,
 	0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
@@ -15154,293 +15244,526 @@ var file_creation_service_v1_creation_proto_rawDesc = []byte{
 	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75,
 	0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75,
-	0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x5d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xdc, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x47, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xf3,
-	0x01, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
-	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x63, 0x0a, 0x0f,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x59, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x12,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0x58, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
-	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x11, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
+	0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x5e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xde, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x48, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x1a, 0xf3, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
 	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x33, 0x0a,
-	0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x22, 0x6d, 0x0a, 0x19, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x62, 0x0a,
+	0x0e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61,
 	0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
 	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x3e, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x1a, 0x2c, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48,
-	0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48,
-	0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x06,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0x2c, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x64, 0x22, 0x5a, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x54, 0x0a,
+	0x1a, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x41,
+	0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xb6, 0x01, 0x0a,
+	0x1c, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x65, 0x77,
+	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x1a, 0x52, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x72, 0x61, 0x77, 0x22, 0x5e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x45,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03,
-	0x69, 0x64, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x5b, 0x0a, 0x05, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x58, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12,
+	0x22, 0x53, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
+	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x76, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x1a, 0x17, 0x0a, 0x05,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x6b,
+	0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x50, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2f, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa,
-	0x42, 0x18, 0x72, 0x16, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x22, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
 	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
 	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5f, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x55, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
+	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x7d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22,
+	0xb2, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
+	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x55, 0x75, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
+	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x21, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x58, 0x0a,
+	0x18, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x1a, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x04, 0x74, 0x61, 0x6c, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x61, 0x6c,
+	0x6b, 0x52, 0x04, 0x74, 0x61, 0x6c, 0x6b, 0x1a, 0x2a, 0x0a, 0x04, 0x54, 0x61, 0x6c, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2c,
 	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
 	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x22, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x61, 0x6c, 0x6b, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x04, 0x74, 0x61,
+	0x6c, 0x6b, 0x1a, 0x2a, 0x0a, 0x04, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x56,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
 	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x09,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x42, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x09, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x1a, 0x2f, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x58, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x75, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54,
+	0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x18, 0x0a, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x38, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x1a, 0x96, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0x96, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a, 0x06,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x1a, 0xb5, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xd8, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xf3, 0x01, 0x0a, 0x06,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
 	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x5f, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x22, 0x45, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a,
+	0x0b, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
+	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52,
+	0x02, 0x69, 0x70, 0x22, 0x68, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x45,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
 	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
-	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9a, 0x01,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41,
-	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x61, 0x67, 0x72,
-	0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41,
-	0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65,
-	0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x61, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0x5f, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x22, 0x4d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0x57, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x62,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x17, 0x45, 0x64, 0x69,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x1b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41,
+	0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
+	0x7a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x3e, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x15, 0x54,
+	0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x86, 0x01,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c,
+	0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xb2,
-	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x55,
-	0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22,
-	0x61, 0x0a, 0x0d, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x61, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x4f, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65,
+	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c,
+	0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a,
+	0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65,
 	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
 	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x4b, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
-	0x67, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x88,
-	0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x1b, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64,
 	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
 	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
@@ -15448,1117 +15771,1586 @@ var file_creation_service_v1_creation_proto_rawDesc = []byte{
 	0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
 	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64,
 	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x18, 0x0a, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x89, 0x02, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x9e, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x9a, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xb5, 0x02, 0x0a, 0x06, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x5d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0xdc, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x47, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xf3, 0x01, 0x0a, 0x06, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
 	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
-	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
-	0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a,
-	0x1d, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x66, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35,
-	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x20, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x10, 0x53, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
+	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x63, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2c,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
 	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x1a,
-	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41,
-	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73,
-	0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0x62, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x1f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x62, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15,
+	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x18, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
+	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
+	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x22, 0x6d, 0x0a,
+	0x19, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x73, 0x41, 0x6e,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2c,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
+	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0x2c, 0x0a, 0x06, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41,
+	0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x1a, 0x2c, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
+	0x2d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xc0,
+	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x5b, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x58, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x32,
+	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
+	0x7d, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x71, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x58,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
+	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
+	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x94, 0x01,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x1a, 0x2f, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x50, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x73, 0x1a, 0x45, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
 	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x65, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a,
-	0x22, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
+	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
+	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x49,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a,
+	0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x45, 0x64, 0x69, 0x74,
 	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x77, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x6e, 0x65, 0x77,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x04, 0x6e, 0x65, 0x77, 0x73, 0x1a, 0x16, 0x0a,
-	0x04, 0x4e, 0x65, 0x77, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x38, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x1a, 0xb2, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
 	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22,
-	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x64, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x61, 0x0a, 0x0d, 0x45, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
+	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4f, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x43,
+	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
+	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x19,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x67, 0x72, 0x65, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
+	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x55, 0x75, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
+	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
+	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69,
+	0x64, 0x22, 0x60, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
 	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x32, 0xbb, 0x79, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2b, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e,
+	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x8d, 0x01, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
+	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x63, 0x0a, 0x20, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x22, 0x40, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
+	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x62, 0x0a, 0x1e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x76,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62,
+	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x45, 0x0a,
+	0x1f, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x22, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62, 0x41,
+	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x20,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x22, 0x5a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x32, 0x0a, 0x04, 0x6e, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x04,
+	0x6e, 0x65, 0x77, 0x73, 0x1a, 0x16, 0x0a, 0x04, 0x4e, 0x65, 0x77, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x99, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0xb2, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a,
+	0x15, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32,
+	0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30,
+	0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x64,
+	0x75, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d,
+	0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x34, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3e,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x88,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x67, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x07,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x67, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xfa, 0x42, 0x1a, 0x92, 0x01, 0x17, 0x22,
+	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xf0, 0x7e, 0x0a, 0x08, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x27,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x1a, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
 	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c,
-	0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x54, 0x61,
+	0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x72,
 	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x56, 0x69, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x6a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1f,
+	0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
 	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x6e,
-	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
 	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x62,
+	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67,
+	0x75, 0x6c, 0x61, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x25, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x54, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x64, 0x69, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x62, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a,
-	0x1b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x28, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x16, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x15, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x26,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75,
 	0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x75, 0x0a, 0x25, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44,
-	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x64, 0x69, 0x74, 0x12, 0x23,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x64, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a,
-	0x0f, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x45,
-	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
-	0x73, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
-	0x6f, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x12, 0x5d, 0x0a, 0x17, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x50, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x6d, 0x0a, 0x1f, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x71,
+	0x0a, 0x21, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x5a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a,
-	0x15, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72,
-	0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x17, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75,
-	0x6c, 0x61, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1f, 0x41, 0x64, 0x64, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44,
-	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x21, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x5d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x48, 0x6f, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x12, 0x21, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x57, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72,
 	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6a,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
 	0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x74, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x27,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20,
 	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x23, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a,
+	0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x20, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
-	0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x25,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x45,
-	0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2d, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x17, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x27,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x68, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x62,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x00, 0x12, 0x64, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77,
+	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x65,
+	0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x53, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0f, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74,
+	0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65,
 	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x17, 0x45,
-	0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41,
-	0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65,
-	0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x60, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72,
-	0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x63,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x1c, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72,
 	0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
 	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x22, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x41, 0x72, 0x74,
-	0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x20, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x00, 0x12, 0x56, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1b, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69,
-	0x63, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72, 0x61,
-	0x66, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x72,
-	0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a,
-	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77,
-	0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12,
-	0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72,
-	0x65, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x66, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x12, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x41, 0x67, 0x72, 0x65,
-	0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x6a, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x12, 0x2e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2e, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62,
+	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x25,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x61,
-	0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69,
-	0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x12,
+	0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61,
+	0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
+	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1e,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x26,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74,
 	0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
-	0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x22,
+	0x63, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x22,
 	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54,
-	0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x61,
-	0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61,
-	0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c,
-	0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x24, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14,
 	0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1c, 0x41,
-	0x64, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x54, 0x61,
-	0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44,
-	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x18, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54,
-	0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x45, 0x64, 0x69, 0x74,
-	0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x12, 0x54, 0x61, 0x6c, 0x6b,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x26,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75,
-	0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x5a, 0x0a, 0x14, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
-	0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x3e, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x18, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x54,
-	0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x1a,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x6c, 0x6b, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x2a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x62, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x5e, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x28, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x12,
-	0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12,
-	0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
 	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x60, 0x0a, 0x12, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72,
-	0x65, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x16, 0x53, 0x65,
-	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44,
-	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c,
-	0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x28,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
-	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b,
-	0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x12, 0x6e, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x60, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61,
-	0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74,
-	0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x24, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a,
-	0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x14, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75,
-	0x6c, 0x61, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49,
+	0x12, 0x57, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65, 0x6e,
+	0x64, 0x54, 0x61, 0x6c, 0x6b, 0x45, 0x64, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x6c, 0x6b,
+	0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x56, 0x0a, 0x12, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72,
+	0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x54, 0x61, 0x6c,
+	0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49,
 	0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12,
-	0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72,
-	0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x61, 0x6c, 0x6b, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x45, 0x64, 0x69,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x44, 0x62, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x6c, 0x6b, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x56, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x73,
+	0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x54, 0x61, 0x6c, 0x6b,
+	0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62,
+	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69,
+	0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x15, 0x41, 0x64, 0x64,
+	0x54, 0x61, 0x6c, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x65, 0x77,
+	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x54, 0x61, 0x6c, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x22, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c,
+	0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12,
+	0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x21,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54,
+	0x61, 0x6c, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
 	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x70, 0x0a, 0x20, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x12, 0x1a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66,
-	0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x62,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2c,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x25, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54,
-	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65,
-	0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x16, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26,
-	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69,
-	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x26,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x16,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x49, 0x72, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x30, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x20, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12,
+	0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x44, 0x62, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x12, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a,
+	0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x16, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x73, 0x41, 0x6e, 0x64, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f,
+	0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
 	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x56,
-	0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56,
-	0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x12, 0x20,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x13, 0x47,
 	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12,
-	0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41,
-	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14, 0x47,
+	0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
 	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x26, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x45,
-	0x64, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x45, 0x64, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a,
-	0x0a, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2a,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
 	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e,
-	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a,
-	0x14, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41,
-	0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67,
-	0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x28, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1e,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43,
 	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72,
-	0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x20, 0x2e,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41,
-	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a,
-	0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a,
-	0x0d, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1d,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x12, 0x21, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x41, 0x67, 0x72, 0x65, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2a,
 	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62,
-	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41,
+	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x1d, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x62, 0x41,
+	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x44,
+	0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41,
+	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x69, 0x65, 0x77, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x1b, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43,
 	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41,
-	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2e, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x66, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75,
+	0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2e,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62,
+	0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x6c, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x2f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x12, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1f, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2f, 0x2e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x44, 0x62, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x64, 0x75, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0d, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d,
+	0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x77, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x77, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x73, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x41,
-	0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x58, 0x0a, 0x15, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
-	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x1c,
-	0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x74, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x1c, 0x5a, 0x1a, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -16573,7 +17365,7 @@ func file_creation_service_v1_creation_proto_rawDescGZIP() []byte {
 	return file_creation_service_v1_creation_proto_rawDescData
 }
 
-var file_creation_service_v1_creation_proto_msgTypes = make([]protoimpl.MessageInfo, 245)
+var file_creation_service_v1_creation_proto_msgTypes = make([]protoimpl.MessageInfo, 260)
 var file_creation_service_v1_creation_proto_goTypes = []interface{}{
 	(*GetLeaderBoardReply)(nil),                     // 0: creation.v1.GetLeaderBoardReply
 	(*GetLastCollectionsDraftReq)(nil),              // 1: creation.v1.GetLastCollectionsDraftReq
@@ -16647,555 +17439,591 @@ var file_creation_service_v1_creation_proto_goTypes = []interface{}{
 	(*EditArticleCosAndSearchReq)(nil),              // 69: creation.v1.EditArticleCosAndSearchReq
 	(*DeleteArticleCacheAndSearchReq)(nil),          // 70: creation.v1.DeleteArticleCacheAndSearchReq
 	(*SetArticleViewDbAndCacheReq)(nil),             // 71: creation.v1.SetArticleViewDbAndCacheReq
-	(*SetArticleAgreeDbAndCacheReq)(nil),            // 72: creation.v1.SetArticleAgreeDbAndCacheReq
-	(*SetArticleCollectDbAndCacheReq)(nil),          // 73: creation.v1.SetArticleCollectDbAndCacheReq
-	(*CreateArticleDraftReq)(nil),                   // 74: creation.v1.CreateArticleDraftReq
-	(*CreateArticleDraftReply)(nil),                 // 75: creation.v1.CreateArticleDraftReply
-	(*ArticleDraftMarkReq)(nil),                     // 76: creation.v1.ArticleDraftMarkReq
-	(*GetArticleDraftListReq)(nil),                  // 77: creation.v1.GetArticleDraftListReq
-	(*GetArticleDraftListReply)(nil),                // 78: creation.v1.GetArticleDraftListReply
-	(*SendArticleReq)(nil),                          // 79: creation.v1.SendArticleReq
-	(*SendArticleEditReq)(nil),                      // 80: creation.v1.SendArticleEditReq
-	(*DeleteArticleReq)(nil),                        // 81: creation.v1.DeleteArticleReq
-	(*DeleteArticleDraftReq)(nil),                   // 82: creation.v1.DeleteArticleDraftReq
-	(*SetArticleAgreeReq)(nil),                      // 83: creation.v1.SetArticleAgreeReq
-	(*SetArticleViewReq)(nil),                       // 84: creation.v1.SetArticleViewReq
-	(*SetArticleCollectReq)(nil),                    // 85: creation.v1.SetArticleCollectReq
-	(*CancelArticleAgreeReq)(nil),                   // 86: creation.v1.CancelArticleAgreeReq
-	(*CancelArticleAgreeDbAndCacheReq)(nil),         // 87: creation.v1.CancelArticleAgreeDbAndCacheReq
-	(*CancelArticleCollectReq)(nil),                 // 88: creation.v1.CancelArticleCollectReq
-	(*CancelArticleCollectDbAndCacheReq)(nil),       // 89: creation.v1.CancelArticleCollectDbAndCacheReq
-	(*ArticleStatisticJudgeReq)(nil),                // 90: creation.v1.ArticleStatisticJudgeReq
-	(*ArticleStatisticJudgeReply)(nil),              // 91: creation.v1.ArticleStatisticJudgeReply
-	(*GetTalkListReq)(nil),                          // 92: creation.v1.GetTalkListReq
-	(*GetTalkListReply)(nil),                        // 93: creation.v1.GetTalkListReply
-	(*GetTalkCountReq)(nil),                         // 94: creation.v1.GetTalkCountReq
-	(*GetTalkCountVisitorReq)(nil),                  // 95: creation.v1.GetTalkCountVisitorReq
-	(*GetTalkCountReply)(nil),                       // 96: creation.v1.GetTalkCountReply
-	(*GetTalkListHotReq)(nil),                       // 97: creation.v1.GetTalkListHotReq
-	(*GetTalkListHotReply)(nil),                     // 98: creation.v1.GetTalkListHotReply
-	(*GetUserTalkListReq)(nil),                      // 99: creation.v1.GetUserTalkListReq
-	(*GetUserTalkListVisitorReq)(nil),               // 100: creation.v1.GetUserTalkListVisitorReq
-	(*GetTalkListStatisticReq)(nil),                 // 101: creation.v1.GetTalkListStatisticReq
-	(*GetTalkListStatisticReply)(nil),               // 102: creation.v1.GetTalkListStatisticReply
-	(*GetTalkStatisticReq)(nil),                     // 103: creation.v1.GetTalkStatisticReq
-	(*GetTalkStatisticReply)(nil),                   // 104: creation.v1.GetTalkStatisticReply
-	(*GetLastTalkDraftReq)(nil),                     // 105: creation.v1.GetLastTalkDraftReq
-	(*GetLastTalkDraftReply)(nil),                   // 106: creation.v1.GetLastTalkDraftReply
-	(*GetTalkSearchReq)(nil),                        // 107: creation.v1.GetTalkSearchReq
-	(*GetTalkSearchReply)(nil),                      // 108: creation.v1.GetTalkSearchReply
-	(*GetUserTalkAgreeReq)(nil),                     // 109: creation.v1.GetUserTalkAgreeReq
-	(*GetUserTalkCollectReq)(nil),                   // 110: creation.v1.GetUserTalkCollectReq
-	(*GetUserTalkAgreeReply)(nil),                   // 111: creation.v1.GetUserTalkAgreeReply
-	(*GetUserTalkCollectReply)(nil),                 // 112: creation.v1.GetUserTalkCollectReply
-	(*GetTalkImageReviewReq)(nil),                   // 113: creation.v1.GetTalkImageReviewReq
-	(*GetTalkImageReviewReply)(nil),                 // 114: creation.v1.GetTalkImageReviewReply
-	(*GetTalkContentReviewReq)(nil),                 // 115: creation.v1.GetTalkContentReviewReq
-	(*GetTalkContentReviewReply)(nil),               // 116: creation.v1.GetTalkContentReviewReply
-	(*CreateTalkDraftReq)(nil),                      // 117: creation.v1.CreateTalkDraftReq
-	(*CreateTalkDraftReply)(nil),                    // 118: creation.v1.CreateTalkDraftReply
-	(*SendTalkReq)(nil),                             // 119: creation.v1.SendTalkReq
-	(*SendTalkEditReq)(nil),                         // 120: creation.v1.SendTalkEditReq
-	(*CreateTalkReq)(nil),                           // 121: creation.v1.CreateTalkReq
-	(*EditTalkReq)(nil),                             // 122: creation.v1.EditTalkReq
-	(*DeleteTalkReq)(nil),                           // 123: creation.v1.DeleteTalkReq
-	(*CreateTalkDbCacheAndSearchReq)(nil),           // 124: creation.v1.CreateTalkDbCacheAndSearchReq
-	(*EditTalkCosAndSearchReq)(nil),                 // 125: creation.v1.EditTalkCosAndSearchReq
-	(*DeleteTalkCacheAndSearchReq)(nil),             // 126: creation.v1.DeleteTalkCacheAndSearchReq
-	(*SetTalkViewReq)(nil),                          // 127: creation.v1.SetTalkViewReq
-	(*SetTalkViewDbAndCacheReq)(nil),                // 128: creation.v1.SetTalkViewDbAndCacheReq
-	(*TalkStatisticJudgeReq)(nil),                   // 129: creation.v1.TalkStatisticJudgeReq
-	(*TalkStatisticJudgeReply)(nil),                 // 130: creation.v1.TalkStatisticJudgeReply
-	(*SetTalkAgreeReq)(nil),                         // 131: creation.v1.SetTalkAgreeReq
-	(*SetTalkAgreeDbAndCacheReq)(nil),               // 132: creation.v1.SetTalkAgreeDbAndCacheReq
-	(*SetTalkCollectReq)(nil),                       // 133: creation.v1.SetTalkCollectReq
-	(*SetTalkCollectDbAndCacheReq)(nil),             // 134: creation.v1.SetTalkCollectDbAndCacheReq
-	(*CancelTalkAgreeReq)(nil),                      // 135: creation.v1.CancelTalkAgreeReq
-	(*CancelTalkAgreeDbAndCacheReq)(nil),            // 136: creation.v1.CancelTalkAgreeDbAndCacheReq
-	(*CancelTalkCollectReq)(nil),                    // 137: creation.v1.CancelTalkCollectReq
-	(*CancelTalkCollectDbAndCacheReq)(nil),          // 138: creation.v1.CancelTalkCollectDbAndCacheReq
-	(*GetLastColumnDraftReq)(nil),                   // 139: creation.v1.GetLastColumnDraftReq
-	(*GetLastColumnDraftReply)(nil),                 // 140: creation.v1.GetLastColumnDraftReply
-	(*GetColumnSearchReq)(nil),                      // 141: creation.v1.GetColumnSearchReq
-	(*GetColumnSearchReply)(nil),                    // 142: creation.v1.GetColumnSearchReply
-	(*GetColumnImageReviewReq)(nil),                 // 143: creation.v1.GetColumnImageReviewReq
-	(*GetColumnImageReviewReply)(nil),               // 144: creation.v1.GetColumnImageReviewReply
-	(*GetColumnContentReviewReq)(nil),               // 145: creation.v1.GetColumnContentReviewReq
-	(*GetColumnContentReviewReply)(nil),             // 146: creation.v1.GetColumnContentReviewReply
-	(*CreateColumnDraftReq)(nil),                    // 147: creation.v1.CreateColumnDraftReq
-	(*CreateColumnDraftReply)(nil),                  // 148: creation.v1.CreateColumnDraftReply
-	(*SendColumnReq)(nil),                           // 149: creation.v1.SendColumnReq
-	(*CreateColumnReq)(nil),                         // 150: creation.v1.CreateColumnReq
-	(*CreateColumnDbCacheAndSearchReq)(nil),         // 151: creation.v1.CreateColumnDbCacheAndSearchReq
-	(*SubscribeColumnReq)(nil),                      // 152: creation.v1.SubscribeColumnReq
-	(*CancelSubscribeColumnReq)(nil),                // 153: creation.v1.CancelSubscribeColumnReq
-	(*SubscribeJudgeReq)(nil),                       // 154: creation.v1.SubscribeJudgeReq
-	(*SubscribeJudgeReply)(nil),                     // 155: creation.v1.SubscribeJudgeReply
-	(*EditColumnCosAndSearchReq)(nil),               // 156: creation.v1.EditColumnCosAndSearchReq
-	(*GetColumnListReq)(nil),                        // 157: creation.v1.GetColumnListReq
-	(*GetColumnListReply)(nil),                      // 158: creation.v1.GetColumnListReply
-	(*GetColumnListHotReq)(nil),                     // 159: creation.v1.GetColumnListHotReq
-	(*GetColumnListHotReply)(nil),                   // 160: creation.v1.GetColumnListHotReply
-	(*GetColumnListStatisticReq)(nil),               // 161: creation.v1.GetColumnListStatisticReq
-	(*GetColumnListStatisticReply)(nil),             // 162: creation.v1.GetColumnListStatisticReply
-	(*GetColumnStatisticReq)(nil),                   // 163: creation.v1.GetColumnStatisticReq
-	(*GetColumnStatisticReply)(nil),                 // 164: creation.v1.GetColumnStatisticReply
-	(*GetUserColumnListReq)(nil),                    // 165: creation.v1.GetUserColumnListReq
-	(*GetUserColumnListVisitorReq)(nil),             // 166: creation.v1.GetUserColumnListVisitorReq
-	(*GetColumnArticleListReq)(nil),                 // 167: creation.v1.GetColumnArticleListReq
-	(*GetColumnCountReq)(nil),                       // 168: creation.v1.GetColumnCountReq
-	(*GetColumnCountVisitorReq)(nil),                // 169: creation.v1.GetColumnCountVisitorReq
-	(*GetColumnCountReply)(nil),                     // 170: creation.v1.GetColumnCountReply
-	(*GetSubscribeListReq)(nil),                     // 171: creation.v1.GetSubscribeListReq
-	(*GetSubscribeListReply)(nil),                   // 172: creation.v1.GetSubscribeListReply
-	(*GetSubscribeListCountReq)(nil),                // 173: creation.v1.GetSubscribeListCountReq
-	(*GetSubscribeListCountReply)(nil),              // 174: creation.v1.GetSubscribeListCountReply
-	(*GetColumnSubscribesReq)(nil),                  // 175: creation.v1.GetColumnSubscribesReq
-	(*GetColumnSubscribesReply)(nil),                // 176: creation.v1.GetColumnSubscribesReply
-	(*GetUserColumnAgreeReq)(nil),                   // 177: creation.v1.GetUserColumnAgreeReq
-	(*GetUserColumnCollectReq)(nil),                 // 178: creation.v1.GetUserColumnCollectReq
-	(*GetUserColumnAgreeReply)(nil),                 // 179: creation.v1.GetUserColumnAgreeReply
-	(*GetUserColumnCollectReply)(nil),               // 180: creation.v1.GetUserColumnCollectReply
-	(*GetUserSubscribeColumnReq)(nil),               // 181: creation.v1.GetUserSubscribeColumnReq
-	(*GetUserSubscribeColumnReply)(nil),             // 182: creation.v1.GetUserSubscribeColumnReply
-	(*SendColumnEditReq)(nil),                       // 183: creation.v1.SendColumnEditReq
-	(*EditColumnReq)(nil),                           // 184: creation.v1.EditColumnReq
-	(*DeleteColumnReq)(nil),                         // 185: creation.v1.DeleteColumnReq
-	(*DeleteColumnCacheAndSearchReq)(nil),           // 186: creation.v1.DeleteColumnCacheAndSearchReq
-	(*ColumnStatisticJudgeReq)(nil),                 // 187: creation.v1.ColumnStatisticJudgeReq
-	(*ColumnStatisticJudgeReply)(nil),               // 188: creation.v1.ColumnStatisticJudgeReply
-	(*SetColumnAgreeReq)(nil),                       // 189: creation.v1.SetColumnAgreeReq
-	(*SetColumnAgreeDbAndCacheReq)(nil),             // 190: creation.v1.SetColumnAgreeDbAndCacheReq
-	(*CancelColumnAgreeReq)(nil),                    // 191: creation.v1.CancelColumnAgreeReq
-	(*CancelColumnAgreeDbAndCacheReq)(nil),          // 192: creation.v1.CancelColumnAgreeDbAndCacheReq
-	(*SetColumnCollectReq)(nil),                     // 193: creation.v1.SetColumnCollectReq
-	(*SetColumnCollectDbAndCacheReq)(nil),           // 194: creation.v1.SetColumnCollectDbAndCacheReq
-	(*CancelColumnCollectReq)(nil),                  // 195: creation.v1.CancelColumnCollectReq
-	(*CancelColumnCollectDbAndCacheReq)(nil),        // 196: creation.v1.CancelColumnCollectDbAndCacheReq
-	(*SetColumnViewReq)(nil),                        // 197: creation.v1.SetColumnViewReq
-	(*SetColumnViewDbAndCacheReq)(nil),              // 198: creation.v1.SetColumnViewDbAndCacheReq
-	(*AddColumnIncludesReq)(nil),                    // 199: creation.v1.AddColumnIncludesReq
-	(*AddColumnIncludesDbAndCacheReq)(nil),          // 200: creation.v1.AddColumnIncludesDbAndCacheReq
-	(*DeleteColumnIncludesReq)(nil),                 // 201: creation.v1.DeleteColumnIncludesReq
-	(*DeleteColumnIncludesDbAndCacheReq)(nil),       // 202: creation.v1.DeleteColumnIncludesDbAndCacheReq
-	(*SetColumnSubscribeDbAndCacheReq)(nil),         // 203: creation.v1.SetColumnSubscribeDbAndCacheReq
-	(*CancelColumnSubscribeDbAndCacheReq)(nil),      // 204: creation.v1.CancelColumnSubscribeDbAndCacheReq
-	(*GetNewsReq)(nil),                              // 205: creation.v1.GetNewsReq
-	(*GetNewsReply)(nil),                            // 206: creation.v1.GetNewsReply
-	(*GetNewsSearchReq)(nil),                        // 207: creation.v1.GetNewsSearchReq
-	(*GetNewsSearchReply)(nil),                      // 208: creation.v1.GetNewsSearchReply
-	(*AddCreationCommentReq)(nil),                   // 209: creation.v1.AddCreationCommentReq
-	(*ReduceCreationCommentReq)(nil),                // 210: creation.v1.ReduceCreationCommentReq
-	(*GetLeaderBoardReply_Board)(nil),               // 211: creation.v1.GetLeaderBoardReply.Board
-	(*GetCollectionsContentReviewReply_Review)(nil), // 212: creation.v1.GetCollectionsContentReviewReply.Review
-	(*GetCollectionsListReply_Collections)(nil),     // 213: creation.v1.GetCollectionsListReply.Collections
-	(*GetUserTimeLineListReply_TimeLine)(nil),       // 214: creation.v1.GetUserTimeLineListReply.TimeLine
-	(*GetArticleListReply_Article)(nil),             // 215: creation.v1.GetArticleListReply.Article
-	(*GetArticleListHotReply_Article)(nil),          // 216: creation.v1.GetArticleListHotReply.Article
-	nil,                                             // 217: creation.v1.GetUserArticleAgreeReply.AgreeEntry
-	nil,                                             // 218: creation.v1.GetUserArticleCollectReply.CollectEntry
-	(*GetArticleListStatisticReply_Count)(nil),      // 219: creation.v1.GetArticleListStatisticReply.Count
-	(*GetArticleSearchReply_List)(nil),              // 220: creation.v1.GetArticleSearchReply.List
-	(*GetArticleImageReviewReply_Review)(nil),       // 221: creation.v1.GetArticleImageReviewReply.Review
-	(*GetArticleContentReviewReply_Review)(nil),     // 222: creation.v1.GetArticleContentReviewReply.Review
-	(*GetArticleDraftListReply_Draft)(nil),          // 223: creation.v1.GetArticleDraftListReply.Draft
-	(*GetTalkListReply_Talk)(nil),                   // 224: creation.v1.GetTalkListReply.Talk
-	(*GetTalkListHotReply_Talk)(nil),                // 225: creation.v1.GetTalkListHotReply.Talk
-	(*GetTalkListStatisticReply_Count)(nil),         // 226: creation.v1.GetTalkListStatisticReply.Count
-	(*GetTalkSearchReply_List)(nil),                 // 227: creation.v1.GetTalkSearchReply.List
-	nil,                                             // 228: creation.v1.GetUserTalkAgreeReply.AgreeEntry
-	nil,                                             // 229: creation.v1.GetUserTalkCollectReply.CollectEntry
-	(*GetTalkImageReviewReply_Review)(nil),          // 230: creation.v1.GetTalkImageReviewReply.Review
-	(*GetTalkContentReviewReply_Review)(nil),        // 231: creation.v1.GetTalkContentReviewReply.Review
-	(*GetColumnSearchReply_List)(nil),               // 232: creation.v1.GetColumnSearchReply.List
-	(*GetColumnImageReviewReply_Review)(nil),        // 233: creation.v1.GetColumnImageReviewReply.Review
-	(*GetColumnContentReviewReply_Review)(nil),      // 234: creation.v1.GetColumnContentReviewReply.Review
-	(*GetColumnListReply_Column)(nil),               // 235: creation.v1.GetColumnListReply.Column
-	(*GetColumnListHotReply_Column)(nil),            // 236: creation.v1.GetColumnListHotReply.Column
-	(*GetColumnListStatisticReply_Count)(nil),       // 237: creation.v1.GetColumnListStatisticReply.Count
-	(*GetSubscribeListReply_Subscribe)(nil),         // 238: creation.v1.GetSubscribeListReply.Subscribe
-	(*GetColumnSubscribesReply_Subscribes)(nil),     // 239: creation.v1.GetColumnSubscribesReply.Subscribes
-	nil,                             // 240: creation.v1.GetUserColumnAgreeReply.AgreeEntry
-	nil,                             // 241: creation.v1.GetUserColumnCollectReply.CollectEntry
-	nil,                             // 242: creation.v1.GetUserSubscribeColumnReply.SubscribeEntry
-	(*GetNewsReply_News)(nil),       // 243: creation.v1.GetNewsReply.News
-	(*GetNewsSearchReply_List)(nil), // 244: creation.v1.GetNewsSearchReply.List
-	(*emptypb.Empty)(nil),           // 245: google.protobuf.Empty
+	(*AddCreationViewDbAndCacheReq)(nil),            // 72: creation.v1.AddCreationViewDbAndCacheReq
+	(*SetArticleAgreeDbAndCacheReq)(nil),            // 73: creation.v1.SetArticleAgreeDbAndCacheReq
+	(*SetArticleCollectDbAndCacheReq)(nil),          // 74: creation.v1.SetArticleCollectDbAndCacheReq
+	(*CreateArticleDraftReq)(nil),                   // 75: creation.v1.CreateArticleDraftReq
+	(*CreateArticleDraftReply)(nil),                 // 76: creation.v1.CreateArticleDraftReply
+	(*ArticleDraftMarkReq)(nil),                     // 77: creation.v1.ArticleDraftMarkReq
+	(*GetArticleDraftListReq)(nil),                  // 78: creation.v1.GetArticleDraftListReq
+	(*GetArticleDraftListReply)(nil),                // 79: creation.v1.GetArticleDraftListReply
+	(*SendArticleReq)(nil),                          // 80: creation.v1.SendArticleReq
+	(*SendArticleEditReq)(nil),                      // 81: creation.v1.SendArticleEditReq
+	(*DeleteArticleReq)(nil),                        // 82: creation.v1.DeleteArticleReq
+	(*DeleteArticleDraftReq)(nil),                   // 83: creation.v1.DeleteArticleDraftReq
+	(*SetArticleAgreeReq)(nil),                      // 84: creation.v1.SetArticleAgreeReq
+	(*SetArticleViewReq)(nil),                       // 85: creation.v1.SetArticleViewReq
+	(*SetArticleCollectReq)(nil),                    // 86: creation.v1.SetArticleCollectReq
+	(*CancelArticleAgreeReq)(nil),                   // 87: creation.v1.CancelArticleAgreeReq
+	(*CancelArticleAgreeDbAndCacheReq)(nil),         // 88: creation.v1.CancelArticleAgreeDbAndCacheReq
+	(*CancelArticleCollectReq)(nil),                 // 89: creation.v1.CancelArticleCollectReq
+	(*CancelArticleCollectDbAndCacheReq)(nil),       // 90: creation.v1.CancelArticleCollectDbAndCacheReq
+	(*ArticleStatisticJudgeReq)(nil),                // 91: creation.v1.ArticleStatisticJudgeReq
+	(*ArticleStatisticJudgeReply)(nil),              // 92: creation.v1.ArticleStatisticJudgeReply
+	(*GetTalkListReq)(nil),                          // 93: creation.v1.GetTalkListReq
+	(*GetTalkListReply)(nil),                        // 94: creation.v1.GetTalkListReply
+	(*GetTalkCountReq)(nil),                         // 95: creation.v1.GetTalkCountReq
+	(*GetTalkCountVisitorReq)(nil),                  // 96: creation.v1.GetTalkCountVisitorReq
+	(*GetTalkCountReply)(nil),                       // 97: creation.v1.GetTalkCountReply
+	(*GetTalkListHotReq)(nil),                       // 98: creation.v1.GetTalkListHotReq
+	(*GetTalkListHotReply)(nil),                     // 99: creation.v1.GetTalkListHotReply
+	(*GetUserTalkListReq)(nil),                      // 100: creation.v1.GetUserTalkListReq
+	(*GetUserTalkListVisitorReq)(nil),               // 101: creation.v1.GetUserTalkListVisitorReq
+	(*GetTalkListStatisticReq)(nil),                 // 102: creation.v1.GetTalkListStatisticReq
+	(*GetTalkListStatisticReply)(nil),               // 103: creation.v1.GetTalkListStatisticReply
+	(*GetTalkStatisticReq)(nil),                     // 104: creation.v1.GetTalkStatisticReq
+	(*GetTalkStatisticReply)(nil),                   // 105: creation.v1.GetTalkStatisticReply
+	(*GetLastTalkDraftReq)(nil),                     // 106: creation.v1.GetLastTalkDraftReq
+	(*GetLastTalkDraftReply)(nil),                   // 107: creation.v1.GetLastTalkDraftReply
+	(*GetTalkSearchReq)(nil),                        // 108: creation.v1.GetTalkSearchReq
+	(*GetTalkSearchReply)(nil),                      // 109: creation.v1.GetTalkSearchReply
+	(*GetUserTalkAgreeReq)(nil),                     // 110: creation.v1.GetUserTalkAgreeReq
+	(*GetUserTalkCollectReq)(nil),                   // 111: creation.v1.GetUserTalkCollectReq
+	(*GetUserTalkAgreeReply)(nil),                   // 112: creation.v1.GetUserTalkAgreeReply
+	(*GetUserTalkCollectReply)(nil),                 // 113: creation.v1.GetUserTalkCollectReply
+	(*GetTalkImageReviewReq)(nil),                   // 114: creation.v1.GetTalkImageReviewReq
+	(*GetTalkImageReviewReply)(nil),                 // 115: creation.v1.GetTalkImageReviewReply
+	(*GetTalkContentReviewReq)(nil),                 // 116: creation.v1.GetTalkContentReviewReq
+	(*GetTalkContentReviewReply)(nil),               // 117: creation.v1.GetTalkContentReviewReply
+	(*CreateTalkDraftReq)(nil),                      // 118: creation.v1.CreateTalkDraftReq
+	(*CreateTalkDraftReply)(nil),                    // 119: creation.v1.CreateTalkDraftReply
+	(*SendTalkReq)(nil),                             // 120: creation.v1.SendTalkReq
+	(*SendTalkEditReq)(nil),                         // 121: creation.v1.SendTalkEditReq
+	(*CreateTalkReq)(nil),                           // 122: creation.v1.CreateTalkReq
+	(*EditTalkReq)(nil),                             // 123: creation.v1.EditTalkReq
+	(*DeleteTalkReq)(nil),                           // 124: creation.v1.DeleteTalkReq
+	(*CreateTalkDbCacheAndSearchReq)(nil),           // 125: creation.v1.CreateTalkDbCacheAndSearchReq
+	(*EditTalkCosAndSearchReq)(nil),                 // 126: creation.v1.EditTalkCosAndSearchReq
+	(*DeleteTalkCacheAndSearchReq)(nil),             // 127: creation.v1.DeleteTalkCacheAndSearchReq
+	(*SetTalkViewReq)(nil),                          // 128: creation.v1.SetTalkViewReq
+	(*SetTalkViewDbAndCacheReq)(nil),                // 129: creation.v1.SetTalkViewDbAndCacheReq
+	(*TalkStatisticJudgeReq)(nil),                   // 130: creation.v1.TalkStatisticJudgeReq
+	(*TalkStatisticJudgeReply)(nil),                 // 131: creation.v1.TalkStatisticJudgeReply
+	(*SetTalkAgreeReq)(nil),                         // 132: creation.v1.SetTalkAgreeReq
+	(*SetTalkAgreeDbAndCacheReq)(nil),               // 133: creation.v1.SetTalkAgreeDbAndCacheReq
+	(*SetTalkCollectReq)(nil),                       // 134: creation.v1.SetTalkCollectReq
+	(*SetTalkCollectDbAndCacheReq)(nil),             // 135: creation.v1.SetTalkCollectDbAndCacheReq
+	(*CancelTalkAgreeReq)(nil),                      // 136: creation.v1.CancelTalkAgreeReq
+	(*CancelTalkAgreeDbAndCacheReq)(nil),            // 137: creation.v1.CancelTalkAgreeDbAndCacheReq
+	(*CancelTalkCollectReq)(nil),                    // 138: creation.v1.CancelTalkCollectReq
+	(*CancelTalkCollectDbAndCacheReq)(nil),          // 139: creation.v1.CancelTalkCollectDbAndCacheReq
+	(*GetLastColumnDraftReq)(nil),                   // 140: creation.v1.GetLastColumnDraftReq
+	(*GetLastColumnDraftReply)(nil),                 // 141: creation.v1.GetLastColumnDraftReply
+	(*GetColumnSearchReq)(nil),                      // 142: creation.v1.GetColumnSearchReq
+	(*GetColumnSearchReply)(nil),                    // 143: creation.v1.GetColumnSearchReply
+	(*GetColumnImageReviewReq)(nil),                 // 144: creation.v1.GetColumnImageReviewReq
+	(*GetColumnImageReviewReply)(nil),               // 145: creation.v1.GetColumnImageReviewReply
+	(*GetColumnContentReviewReq)(nil),               // 146: creation.v1.GetColumnContentReviewReq
+	(*GetColumnContentReviewReply)(nil),             // 147: creation.v1.GetColumnContentReviewReply
+	(*CreateColumnDraftReq)(nil),                    // 148: creation.v1.CreateColumnDraftReq
+	(*CreateColumnDraftReply)(nil),                  // 149: creation.v1.CreateColumnDraftReply
+	(*SendColumnReq)(nil),                           // 150: creation.v1.SendColumnReq
+	(*CreateColumnReq)(nil),                         // 151: creation.v1.CreateColumnReq
+	(*CreateColumnDbCacheAndSearchReq)(nil),         // 152: creation.v1.CreateColumnDbCacheAndSearchReq
+	(*SubscribeColumnReq)(nil),                      // 153: creation.v1.SubscribeColumnReq
+	(*CancelSubscribeColumnReq)(nil),                // 154: creation.v1.CancelSubscribeColumnReq
+	(*SubscribeJudgeReq)(nil),                       // 155: creation.v1.SubscribeJudgeReq
+	(*SubscribeJudgeReply)(nil),                     // 156: creation.v1.SubscribeJudgeReply
+	(*EditColumnCosAndSearchReq)(nil),               // 157: creation.v1.EditColumnCosAndSearchReq
+	(*GetColumnListReq)(nil),                        // 158: creation.v1.GetColumnListReq
+	(*GetColumnListReply)(nil),                      // 159: creation.v1.GetColumnListReply
+	(*GetColumnListHotReq)(nil),                     // 160: creation.v1.GetColumnListHotReq
+	(*GetColumnListHotReply)(nil),                   // 161: creation.v1.GetColumnListHotReply
+	(*GetColumnListStatisticReq)(nil),               // 162: creation.v1.GetColumnListStatisticReq
+	(*GetColumnListStatisticReply)(nil),             // 163: creation.v1.GetColumnListStatisticReply
+	(*GetColumnStatisticReq)(nil),                   // 164: creation.v1.GetColumnStatisticReq
+	(*GetColumnStatisticReply)(nil),                 // 165: creation.v1.GetColumnStatisticReply
+	(*GetUserColumnListReq)(nil),                    // 166: creation.v1.GetUserColumnListReq
+	(*GetUserColumnListVisitorReq)(nil),             // 167: creation.v1.GetUserColumnListVisitorReq
+	(*GetColumnArticleListReq)(nil),                 // 168: creation.v1.GetColumnArticleListReq
+	(*GetColumnCountReq)(nil),                       // 169: creation.v1.GetColumnCountReq
+	(*GetColumnCountVisitorReq)(nil),                // 170: creation.v1.GetColumnCountVisitorReq
+	(*GetColumnCountReply)(nil),                     // 171: creation.v1.GetColumnCountReply
+	(*GetSubscribeListReq)(nil),                     // 172: creation.v1.GetSubscribeListReq
+	(*GetSubscribeListReply)(nil),                   // 173: creation.v1.GetSubscribeListReply
+	(*GetSubscribeListCountReq)(nil),                // 174: creation.v1.GetSubscribeListCountReq
+	(*GetSubscribeListCountReply)(nil),              // 175: creation.v1.GetSubscribeListCountReply
+	(*GetColumnSubscribesReq)(nil),                  // 176: creation.v1.GetColumnSubscribesReq
+	(*GetColumnSubscribesReply)(nil),                // 177: creation.v1.GetColumnSubscribesReply
+	(*GetUserColumnAgreeReq)(nil),                   // 178: creation.v1.GetUserColumnAgreeReq
+	(*GetUserColumnCollectReq)(nil),                 // 179: creation.v1.GetUserColumnCollectReq
+	(*GetUserColumnAgreeReply)(nil),                 // 180: creation.v1.GetUserColumnAgreeReply
+	(*GetUserColumnCollectReply)(nil),               // 181: creation.v1.GetUserColumnCollectReply
+	(*GetUserSubscribeColumnReq)(nil),               // 182: creation.v1.GetUserSubscribeColumnReq
+	(*GetUserSubscribeColumnReply)(nil),             // 183: creation.v1.GetUserSubscribeColumnReply
+	(*SendColumnEditReq)(nil),                       // 184: creation.v1.SendColumnEditReq
+	(*EditColumnReq)(nil),                           // 185: creation.v1.EditColumnReq
+	(*DeleteColumnReq)(nil),                         // 186: creation.v1.DeleteColumnReq
+	(*DeleteColumnCacheAndSearchReq)(nil),           // 187: creation.v1.DeleteColumnCacheAndSearchReq
+	(*ColumnStatisticJudgeReq)(nil),                 // 188: creation.v1.ColumnStatisticJudgeReq
+	(*ColumnStatisticJudgeReply)(nil),               // 189: creation.v1.ColumnStatisticJudgeReply
+	(*SetColumnAgreeReq)(nil),                       // 190: creation.v1.SetColumnAgreeReq
+	(*SetColumnAgreeDbAndCacheReq)(nil),             // 191: creation.v1.SetColumnAgreeDbAndCacheReq
+	(*CancelColumnAgreeReq)(nil),                    // 192: creation.v1.CancelColumnAgreeReq
+	(*CancelColumnAgreeDbAndCacheReq)(nil),          // 193: creation.v1.CancelColumnAgreeDbAndCacheReq
+	(*SetColumnCollectReq)(nil),                     // 194: creation.v1.SetColumnCollectReq
+	(*SetColumnCollectDbAndCacheReq)(nil),           // 195: creation.v1.SetColumnCollectDbAndCacheReq
+	(*CancelColumnCollectReq)(nil),                  // 196: creation.v1.CancelColumnCollectReq
+	(*CancelColumnCollectDbAndCacheReq)(nil),        // 197: creation.v1.CancelColumnCollectDbAndCacheReq
+	(*SetColumnViewReq)(nil),                        // 198: creation.v1.SetColumnViewReq
+	(*SetColumnViewDbAndCacheReq)(nil),              // 199: creation.v1.SetColumnViewDbAndCacheReq
+	(*AddColumnIncludesReq)(nil),                    // 200: creation.v1.AddColumnIncludesReq
+	(*AddColumnIncludesDbAndCacheReq)(nil),          // 201: creation.v1.AddColumnIncludesDbAndCacheReq
+	(*DeleteColumnIncludesReq)(nil),                 // 202: creation.v1.DeleteColumnIncludesReq
+	(*DeleteColumnIncludesDbAndCacheReq)(nil),       // 203: creation.v1.DeleteColumnIncludesDbAndCacheReq
+	(*SetColumnSubscribeDbAndCacheReq)(nil),         // 204: creation.v1.SetColumnSubscribeDbAndCacheReq
+	(*CancelColumnSubscribeDbAndCacheReq)(nil),      // 205: creation.v1.CancelColumnSubscribeDbAndCacheReq
+	(*GetNewsReq)(nil),                              // 206: creation.v1.GetNewsReq
+	(*GetNewsReply)(nil),                            // 207: creation.v1.GetNewsReply
+	(*GetNewsSearchReq)(nil),                        // 208: creation.v1.GetNewsSearchReq
+	(*GetNewsSearchReply)(nil),                      // 209: creation.v1.GetNewsSearchReply
+	(*AddCreationCommentReq)(nil),                   // 210: creation.v1.AddCreationCommentReq
+	(*ReduceCreationCommentReq)(nil),                // 211: creation.v1.ReduceCreationCommentReq
+	(*PurgeUserDataReq)(nil),                        // 212: creation.v1.PurgeUserDataReq
+	(*GetUserDataReq)(nil),                          // 213: creation.v1.GetUserDataReq
+	(*GetUserDataReply)(nil),                        // 214: creation.v1.GetUserDataReply
+	(*GetUserTagsReq)(nil),                          // 215: creation.v1.GetUserTagsReq
+	(*GetUserTagsReply)(nil),                        // 216: creation.v1.GetUserTagsReply
+	(*GetTagAuthorsReq)(nil),                        // 217: creation.v1.GetTagAuthorsReq
+	(*GetTagAuthorsReply)(nil),                      // 218: creation.v1.GetTagAuthorsReply
+	(*GetAuthorActivityReq)(nil),                    // 219: creation.v1.GetAuthorActivityReq
+	(*GetAuthorActivityReply)(nil),                  // 220: creation.v1.GetAuthorActivityReply
+	(*GetLeaderBoardReply_Board)(nil),               // 221: creation.v1.GetLeaderBoardReply.Board
+	(*GetCollectionsContentReviewReply_Review)(nil), // 222: creation.v1.GetCollectionsContentReviewReply.Review
+	(*GetCollectionsListReply_Collections)(nil),     // 223: creation.v1.GetCollectionsListReply.Collections
+	(*GetUserTimeLineListReply_TimeLine)(nil),       // 224: creation.v1.GetUserTimeLineListReply.TimeLine
+	(*GetArticleListReply_Article)(nil),             // 225: creation.v1.GetArticleListReply.Article
+	(*GetArticleListHotReply_Article)(nil),          // 226: creation.v1.GetArticleListHotReply.Article
+	nil,                                             // 227: creation.v1.GetUserArticleAgreeReply.AgreeEntry
+	nil,                                             // 228: creation.v1.GetUserArticleCollectReply.CollectEntry
+	(*GetArticleListStatisticReply_Count)(nil),      // 229: creation.v1.GetArticleListStatisticReply.Count
+	(*GetArticleSearchReply_List)(nil),              // 230: creation.v1.GetArticleSearchReply.List
+	(*GetArticleImageReviewReply_Review)(nil),       // 231: creation.v1.GetArticleImageReviewReply.Review
+	(*GetArticleContentReviewReply_Review)(nil),     // 232: creation.v1.GetArticleContentReviewReply.Review
+	(*AddCreationViewDbAndCacheReq_View)(nil),       // 233: creation.v1.AddCreationViewDbAndCacheReq.View
+	(*GetArticleDraftListReply_Draft)(nil),          // 234: creation.v1.GetArticleDraftListReply.Draft
+	(*GetTalkListReply_Talk)(nil),                   // 235: creation.v1.GetTalkListReply.Talk
+	(*GetTalkListHotReply_Talk)(nil),                // 236: creation.v1.GetTalkListHotReply.Talk
+	(*GetTalkListStatisticReply_Count)(nil),         // 237: creation.v1.GetTalkListStatisticReply.Count
+	(*GetTalkSearchReply_List)(nil),                 // 238: creation.v1.GetTalkSearchReply.List
+	nil,                                             // 239: creation.v1.GetUserTalkAgreeReply.AgreeEntry
+	nil,                                             // 240: creation.v1.GetUserTalkCollectReply.CollectEntry
+	(*GetTalkImageReviewReply_Review)(nil),          // 241: creation.v1.GetTalkImageReviewReply.Review
+	(*GetTalkContentReviewReply_Review)(nil),        // 242: creation.v1.GetTalkContentReviewReply.Review
+	(*GetColumnSearchReply_List)(nil),               // 243: creation.v1.GetColumnSearchReply.List
+	(*GetColumnImageReviewReply_Review)(nil),        // 244: creation.v1.GetColumnImageReviewReply.Review
+	(*GetColumnContentReviewReply_Review)(nil),      // 245: creation.v1.GetColumnContentReviewReply.Review
+	(*GetColumnListReply_Column)(nil),               // 246: creation.v1.GetColumnListReply.Column
+	(*GetColumnListHotReply_Column)(nil),            // 247: creation.v1.GetColumnListHotReply.Column
+	(*GetColumnListStatisticReply_Count)(nil),       // 248: creation.v1.GetColumnListStatisticReply.Count
+	(*GetSubscribeListReply_Subscribe)(nil),         // 249: creation.v1.GetSubscribeListReply.Subscribe
+	(*GetColumnSubscribesReply_Subscribes)(nil),     // 250: creation.v1.GetColumnSubscribesReply.Subscribes
+	nil,                             // 251: creation.v1.GetUserColumnAgreeReply.AgreeEntry
+	nil,                             // 252: creation.v1.GetUserColumnCollectReply.CollectEntry
+	nil,                             // 253: creation.v1.GetUserSubscribeColumnReply.SubscribeEntry
+	(*GetNewsReply_News)(nil),       // 254: creation.v1.GetNewsReply.News
+	(*GetNewsSearchReply_List)(nil), // 255: creation.v1.GetNewsSearchReply.List
+	(*GetUserDataReply_File)(nil),   // 256: creation.v1.GetUserDataReply.File
+	nil,                             // 257: creation.v1.GetUserTagsReply.TagsEntry
+	nil,                             // 258: creation.v1.GetTagAuthorsReply.AuthorsEntry
+	nil,                             // 259: creation.v1.GetAuthorActivityReply.ActivityEntry
+	(*emptypb.Empty)(nil),           // 260: google.protobuf.Empty
 }
 var file_creation_service_v1_creation_proto_depIdxs = []int32{
-	211, // 0: creation.v1.GetLeaderBoardReply.board:type_name -> creation.v1.GetLeaderBoardReply.Board
-	212, // 1: creation.v1.GetCollectionsContentReviewReply.review:type_name -> creation.v1.GetCollectionsContentReviewReply.Review
-	213, // 2: creation.v1.GetCollectionsListReply.collections:type_name -> creation.v1.GetCollectionsListReply.Collections
-	214, // 3: creation.v1.GetUserTimeLineListReply.timeline:type_name -> creation.v1.GetUserTimeLineListReply.TimeLine
-	215, // 4: creation.v1.GetArticleListReply.article:type_name -> creation.v1.GetArticleListReply.Article
-	216, // 5: creation.v1.GetArticleListHotReply.article:type_name -> creation.v1.GetArticleListHotReply.Article
-	217, // 6: creation.v1.GetUserArticleAgreeReply.agree:type_name -> creation.v1.GetUserArticleAgreeReply.AgreeEntry
-	218, // 7: creation.v1.GetUserArticleCollectReply.collect:type_name -> creation.v1.GetUserArticleCollectReply.CollectEntry
-	219, // 8: creation.v1.GetArticleListStatisticReply.count:type_name -> creation.v1.GetArticleListStatisticReply.Count
-	220, // 9: creation.v1.GetArticleSearchReply.list:type_name -> creation.v1.GetArticleSearchReply.List
-	221, // 10: creation.v1.GetArticleImageReviewReply.review:type_name -> creation.v1.GetArticleImageReviewReply.Review
-	222, // 11: creation.v1.GetArticleContentReviewReply.review:type_name -> creation.v1.GetArticleContentReviewReply.Review
-	223, // 12: creation.v1.GetArticleDraftListReply.draft:type_name -> creation.v1.GetArticleDraftListReply.Draft
-	224, // 13: creation.v1.GetTalkListReply.talk:type_name -> creation.v1.GetTalkListReply.Talk
-	225, // 14: creation.v1.GetTalkListHotReply.talk:type_name -> creation.v1.GetTalkListHotReply.Talk
-	226, // 15: creation.v1.GetTalkListStatisticReply.count:type_name -> creation.v1.GetTalkListStatisticReply.Count
-	227, // 16: creation.v1.GetTalkSearchReply.list:type_name -> creation.v1.GetTalkSearchReply.List
-	228, // 17: creation.v1.GetUserTalkAgreeReply.agree:type_name -> creation.v1.GetUserTalkAgreeReply.AgreeEntry
-	229, // 18: creation.v1.GetUserTalkCollectReply.collect:type_name -> creation.v1.GetUserTalkCollectReply.CollectEntry
-	230, // 19: creation.v1.GetTalkImageReviewReply.review:type_name -> creation.v1.GetTalkImageReviewReply.Review
-	231, // 20: creation.v1.GetTalkContentReviewReply.review:type_name -> creation.v1.GetTalkContentReviewReply.Review
-	232, // 21: creation.v1.GetColumnSearchReply.list:type_name -> creation.v1.GetColumnSearchReply.List
-	233, // 22: creation.v1.GetColumnImageReviewReply.review:type_name -> creation.v1.GetColumnImageReviewReply.Review
-	234, // 23: creation.v1.GetColumnContentReviewReply.review:type_name -> creation.v1.GetColumnContentReviewReply.Review
-	235, // 24: creation.v1.GetColumnListReply.column:type_name -> creation.v1.GetColumnListReply.Column
-	236, // 25: creation.v1.GetColumnListHotReply.column:type_name -> creation.v1.GetColumnListHotReply.Column
-	237, // 26: creation.v1.GetColumnListStatisticReply.count:type_name -> creation.v1.GetColumnListStatisticReply.Count
-	238, // 27: creation.v1.GetSubscribeListReply.subscribe:type_name -> creation.v1.GetSubscribeListReply.Subscribe
-	239, // 28: creation.v1.GetColumnSubscribesReply.subscribes:type_name -> creation.v1.GetColumnSubscribesReply.Subscribes
-	240, // 29: creation.v1.GetUserColumnAgreeReply.agree:type_name -> creation.v1.GetUserColumnAgreeReply.AgreeEntry
-	241, // 30: creation.v1.GetUserColumnCollectReply.collect:type_name -> creation.v1.GetUserColumnCollectReply.CollectEntry
-	242, // 31: creation.v1.GetUserSubscribeColumnReply.subscribe:type_name -> creation.v1.GetUserSubscribeColumnReply.SubscribeEntry
-	243, // 32: creation.v1.GetNewsReply.news:type_name -> creation.v1.GetNewsReply.News
-	244, // 33: creation.v1.GetNewsSearchReply.list:type_name -> creation.v1.GetNewsSearchReply.List
-	245, // 34: creation.v1.Creation.GetLeaderBoard:input_type -> google.protobuf.Empty
-	1,   // 35: creation.v1.Creation.GetLastCollectionsDraft:input_type -> creation.v1.GetLastCollectionsDraftReq
-	3,   // 36: creation.v1.Creation.GetCollectionsContentReview:input_type -> creation.v1.GetCollectionsContentReviewReq
-	5,   // 37: creation.v1.Creation.GetCollectArticleList:input_type -> creation.v1.GetCollectArticleListReq
-	6,   // 38: creation.v1.Creation.GetCollectArticleCount:input_type -> creation.v1.GetCollectArticleCountReq
-	8,   // 39: creation.v1.Creation.GetCollectTalkList:input_type -> creation.v1.GetCollectTalkListReq
-	9,   // 40: creation.v1.Creation.GetCollectTalkCount:input_type -> creation.v1.GetCollectTalkCountReq
-	11,  // 41: creation.v1.Creation.GetCollectColumnList:input_type -> creation.v1.GetCollectColumnListReq
-	12,  // 42: creation.v1.Creation.GetCollectColumnCount:input_type -> creation.v1.GetCollectColumnCountReq
-	14,  // 43: creation.v1.Creation.GetCollections:input_type -> creation.v1.GetCollectionsReq
-	16,  // 44: creation.v1.Creation.GetCollectionListInfo:input_type -> creation.v1.GetCollectionListInfoReq
-	17,  // 45: creation.v1.Creation.GetCollectionsList:input_type -> creation.v1.GetCollectionsListReq
-	19,  // 46: creation.v1.Creation.GetCollectionsListAll:input_type -> creation.v1.GetCollectionsListAllReq
-	20,  // 47: creation.v1.Creation.GetCollectionsCount:input_type -> creation.v1.GetCollectionsCountReq
-	17,  // 48: creation.v1.Creation.GetCollectionsListByVisitor:input_type -> creation.v1.GetCollectionsListReq
-	20,  // 49: creation.v1.Creation.GetCollectionsVisitorCount:input_type -> creation.v1.GetCollectionsCountReq
-	22,  // 50: creation.v1.Creation.GetCreationUser:input_type -> creation.v1.GetCreationUserReq
-	22,  // 51: creation.v1.Creation.GetCreationUserVisitor:input_type -> creation.v1.GetCreationUserReq
-	24,  // 52: creation.v1.Creation.GetUserTimeLineList:input_type -> creation.v1.GetUserTimeLineListReq
-	26,  // 53: creation.v1.Creation.SendCollections:input_type -> creation.v1.SendCollectionsReq
-	27,  // 54: creation.v1.Creation.CreateCollectionsDraft:input_type -> creation.v1.CreateCollectionsDraftReq
-	29,  // 55: creation.v1.Creation.CreateCollections:input_type -> creation.v1.CreateCollectionsReq
-	30,  // 56: creation.v1.Creation.CreateCollectionsDbAndCache:input_type -> creation.v1.CreateCollectionsDbAndCacheReq
-	37,  // 57: creation.v1.Creation.CollectionsContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
-	39,  // 58: creation.v1.Creation.AddCollectionsContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
-	31,  // 59: creation.v1.Creation.SendCollectionsEdit:input_type -> creation.v1.SendCollectionsEditReq
-	32,  // 60: creation.v1.Creation.EditCollections:input_type -> creation.v1.EditCollectionsReq
-	33,  // 61: creation.v1.Creation.EditCollectionsCos:input_type -> creation.v1.EditCollectionsCosReq
-	34,  // 62: creation.v1.Creation.DeleteCollections:input_type -> creation.v1.DeleteCollectionsReq
-	35,  // 63: creation.v1.Creation.DeleteCollectionsCache:input_type -> creation.v1.DeleteCollectionsCacheReq
-	36,  // 64: creation.v1.Creation.ArticleImageIrregular:input_type -> creation.v1.CreationImageIrregularReq
-	37,  // 65: creation.v1.Creation.ArticleContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
-	38,  // 66: creation.v1.Creation.AddArticleImageReviewDbAndCache:input_type -> creation.v1.AddCreationImageReviewDbAndCacheReq
-	39,  // 67: creation.v1.Creation.AddArticleContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
-	40,  // 68: creation.v1.Creation.GetArticleList:input_type -> creation.v1.GetArticleListReq
-	42,  // 69: creation.v1.Creation.GetArticleListHot:input_type -> creation.v1.GetArticleListHotReq
-	167, // 70: creation.v1.Creation.GetColumnArticleList:input_type -> creation.v1.GetColumnArticleListReq
-	44,  // 71: creation.v1.Creation.GetArticleCount:input_type -> creation.v1.GetArticleCountReq
-	45,  // 72: creation.v1.Creation.GetArticleCountVisitor:input_type -> creation.v1.GetArticleCountVisitorReq
-	47,  // 73: creation.v1.Creation.GetUserArticleList:input_type -> creation.v1.GetUserArticleListReq
-	48,  // 74: creation.v1.Creation.GetUserArticleListVisitor:input_type -> creation.v1.GetUserArticleListVisitorReq
-	49,  // 75: creation.v1.Creation.GetUserArticleListAll:input_type -> creation.v1.GetUserArticleListAllReq
-	50,  // 76: creation.v1.Creation.GetArticleStatistic:input_type -> creation.v1.GetArticleStatisticReq
-	52,  // 77: creation.v1.Creation.GetUserArticleAgree:input_type -> creation.v1.GetUserArticleAgreeReq
-	54,  // 78: creation.v1.Creation.GetUserArticleCollect:input_type -> creation.v1.GetUserArticleCollectReq
-	56,  // 79: creation.v1.Creation.GetArticleListStatistic:input_type -> creation.v1.GetArticleListStatisticReq
-	58,  // 80: creation.v1.Creation.GetLastArticleDraft:input_type -> creation.v1.GetLastArticleDraftReq
-	60,  // 81: creation.v1.Creation.GetArticleSearch:input_type -> creation.v1.GetArticleSearchReq
-	62,  // 82: creation.v1.Creation.GetArticleImageReview:input_type -> creation.v1.GetArticleImageReviewReq
-	64,  // 83: creation.v1.Creation.GetArticleContentReview:input_type -> creation.v1.GetArticleContentReviewReq
-	66,  // 84: creation.v1.Creation.CreateArticle:input_type -> creation.v1.CreateArticleReq
-	67,  // 85: creation.v1.Creation.EditArticle:input_type -> creation.v1.EditArticleReq
-	68,  // 86: creation.v1.Creation.CreateArticleDbCacheAndSearch:input_type -> creation.v1.CreateArticleDbCacheAndSearchReq
-	69,  // 87: creation.v1.Creation.EditArticleCosAndSearch:input_type -> creation.v1.EditArticleCosAndSearchReq
-	70,  // 88: creation.v1.Creation.DeleteArticleCacheAndSearch:input_type -> creation.v1.DeleteArticleCacheAndSearchReq
-	71,  // 89: creation.v1.Creation.SetArticleViewDbAndCache:input_type -> creation.v1.SetArticleViewDbAndCacheReq
-	72,  // 90: creation.v1.Creation.SetArticleAgreeDbAndCache:input_type -> creation.v1.SetArticleAgreeDbAndCacheReq
-	73,  // 91: creation.v1.Creation.SetArticleCollectDbAndCache:input_type -> creation.v1.SetArticleCollectDbAndCacheReq
-	74,  // 92: creation.v1.Creation.CreateArticleDraft:input_type -> creation.v1.CreateArticleDraftReq
-	76,  // 93: creation.v1.Creation.ArticleDraftMark:input_type -> creation.v1.ArticleDraftMarkReq
-	77,  // 94: creation.v1.Creation.GetArticleDraftList:input_type -> creation.v1.GetArticleDraftListReq
-	79,  // 95: creation.v1.Creation.SendArticle:input_type -> creation.v1.SendArticleReq
-	80,  // 96: creation.v1.Creation.SendArticleEdit:input_type -> creation.v1.SendArticleEditReq
-	81,  // 97: creation.v1.Creation.DeleteArticle:input_type -> creation.v1.DeleteArticleReq
-	82,  // 98: creation.v1.Creation.DeleteArticleDraft:input_type -> creation.v1.DeleteArticleDraftReq
-	83,  // 99: creation.v1.Creation.SetArticleAgree:input_type -> creation.v1.SetArticleAgreeReq
-	84,  // 100: creation.v1.Creation.SetArticleView:input_type -> creation.v1.SetArticleViewReq
-	85,  // 101: creation.v1.Creation.SetArticleCollect:input_type -> creation.v1.SetArticleCollectReq
-	86,  // 102: creation.v1.Creation.CancelArticleAgree:input_type -> creation.v1.CancelArticleAgreeReq
-	87,  // 103: creation.v1.Creation.CancelArticleAgreeDbAndCache:input_type -> creation.v1.CancelArticleAgreeDbAndCacheReq
-	88,  // 104: creation.v1.Creation.CancelArticleCollect:input_type -> creation.v1.CancelArticleCollectReq
-	89,  // 105: creation.v1.Creation.CancelArticleCollectDbAndCache:input_type -> creation.v1.CancelArticleCollectDbAndCacheReq
-	90,  // 106: creation.v1.Creation.ArticleStatisticJudge:input_type -> creation.v1.ArticleStatisticJudgeReq
-	92,  // 107: creation.v1.Creation.GetTalkList:input_type -> creation.v1.GetTalkListReq
-	97,  // 108: creation.v1.Creation.GetTalkListHot:input_type -> creation.v1.GetTalkListHotReq
-	99,  // 109: creation.v1.Creation.GetUserTalkList:input_type -> creation.v1.GetUserTalkListReq
-	100, // 110: creation.v1.Creation.GetUserTalkListVisitor:input_type -> creation.v1.GetUserTalkListVisitorReq
-	94,  // 111: creation.v1.Creation.GetTalkCount:input_type -> creation.v1.GetTalkCountReq
-	95,  // 112: creation.v1.Creation.GetTalkCountVisitor:input_type -> creation.v1.GetTalkCountVisitorReq
-	101, // 113: creation.v1.Creation.GetTalkListStatistic:input_type -> creation.v1.GetTalkListStatisticReq
-	103, // 114: creation.v1.Creation.GetTalkStatistic:input_type -> creation.v1.GetTalkStatisticReq
-	105, // 115: creation.v1.Creation.GetLastTalkDraft:input_type -> creation.v1.GetLastTalkDraftReq
-	107, // 116: creation.v1.Creation.GetTalkSearch:input_type -> creation.v1.GetTalkSearchReq
-	109, // 117: creation.v1.Creation.GetUserTalkAgree:input_type -> creation.v1.GetUserTalkAgreeReq
-	110, // 118: creation.v1.Creation.GetUserTalkCollect:input_type -> creation.v1.GetUserTalkCollectReq
-	113, // 119: creation.v1.Creation.GetTalkImageReview:input_type -> creation.v1.GetTalkImageReviewReq
-	115, // 120: creation.v1.Creation.GetTalkContentReview:input_type -> creation.v1.GetTalkContentReviewReq
-	38,  // 121: creation.v1.Creation.AddTalkImageReviewDbAndCache:input_type -> creation.v1.AddCreationImageReviewDbAndCacheReq
-	39,  // 122: creation.v1.Creation.AddTalkContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
-	117, // 123: creation.v1.Creation.CreateTalkDraft:input_type -> creation.v1.CreateTalkDraftReq
-	119, // 124: creation.v1.Creation.SendTalk:input_type -> creation.v1.SendTalkReq
-	120, // 125: creation.v1.Creation.SendTalkEdit:input_type -> creation.v1.SendTalkEditReq
-	36,  // 126: creation.v1.Creation.TalkImageIrregular:input_type -> creation.v1.CreationImageIrregularReq
-	37,  // 127: creation.v1.Creation.TalkContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
-	121, // 128: creation.v1.Creation.CreateTalk:input_type -> creation.v1.CreateTalkReq
-	122, // 129: creation.v1.Creation.EditTalk:input_type -> creation.v1.EditTalkReq
-	123, // 130: creation.v1.Creation.DeleteTalk:input_type -> creation.v1.DeleteTalkReq
-	124, // 131: creation.v1.Creation.CreateTalkDbCacheAndSearch:input_type -> creation.v1.CreateTalkDbCacheAndSearchReq
-	125, // 132: creation.v1.Creation.EditTalkCosAndSearch:input_type -> creation.v1.EditTalkCosAndSearchReq
-	126, // 133: creation.v1.Creation.DeleteTalkCacheAndSearch:input_type -> creation.v1.DeleteTalkCacheAndSearchReq
-	127, // 134: creation.v1.Creation.SetTalkView:input_type -> creation.v1.SetTalkViewReq
-	128, // 135: creation.v1.Creation.SetTalkViewDbAndCache:input_type -> creation.v1.SetTalkViewDbAndCacheReq
-	129, // 136: creation.v1.Creation.TalkStatisticJudge:input_type -> creation.v1.TalkStatisticJudgeReq
-	131, // 137: creation.v1.Creation.SetTalkAgree:input_type -> creation.v1.SetTalkAgreeReq
-	132, // 138: creation.v1.Creation.SetTalkAgreeDbAndCache:input_type -> creation.v1.SetTalkAgreeDbAndCacheReq
-	133, // 139: creation.v1.Creation.SetTalkCollect:input_type -> creation.v1.SetTalkCollectReq
-	134, // 140: creation.v1.Creation.SetTalkCollectDbAndCache:input_type -> creation.v1.SetTalkCollectDbAndCacheReq
-	135, // 141: creation.v1.Creation.CancelTalkAgree:input_type -> creation.v1.CancelTalkAgreeReq
-	136, // 142: creation.v1.Creation.CancelTalkAgreeDbAndCache:input_type -> creation.v1.CancelTalkAgreeDbAndCacheReq
-	137, // 143: creation.v1.Creation.CancelTalkCollect:input_type -> creation.v1.CancelTalkCollectReq
-	138, // 144: creation.v1.Creation.CancelTalkCollectDbAndCache:input_type -> creation.v1.CancelTalkCollectDbAndCacheReq
-	139, // 145: creation.v1.Creation.GetLastColumnDraft:input_type -> creation.v1.GetLastColumnDraftReq
-	141, // 146: creation.v1.Creation.GetColumnSearch:input_type -> creation.v1.GetColumnSearchReq
-	143, // 147: creation.v1.Creation.GetColumnImageReview:input_type -> creation.v1.GetColumnImageReviewReq
-	145, // 148: creation.v1.Creation.GetColumnContentReview:input_type -> creation.v1.GetColumnContentReviewReq
-	36,  // 149: creation.v1.Creation.ColumnImageIrregular:input_type -> creation.v1.CreationImageIrregularReq
-	37,  // 150: creation.v1.Creation.ColumnContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
-	38,  // 151: creation.v1.Creation.AddColumnImageReviewDbAndCache:input_type -> creation.v1.AddCreationImageReviewDbAndCacheReq
-	39,  // 152: creation.v1.Creation.AddColumnContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
-	147, // 153: creation.v1.Creation.CreateColumnDraft:input_type -> creation.v1.CreateColumnDraftReq
-	149, // 154: creation.v1.Creation.SendColumn:input_type -> creation.v1.SendColumnReq
-	150, // 155: creation.v1.Creation.CreateColumn:input_type -> creation.v1.CreateColumnReq
-	151, // 156: creation.v1.Creation.CreateColumnDbCacheAndSearch:input_type -> creation.v1.CreateColumnDbCacheAndSearchReq
-	152, // 157: creation.v1.Creation.SubscribeColumn:input_type -> creation.v1.SubscribeColumnReq
-	153, // 158: creation.v1.Creation.CancelSubscribeColumn:input_type -> creation.v1.CancelSubscribeColumnReq
-	154, // 159: creation.v1.Creation.SubscribeJudge:input_type -> creation.v1.SubscribeJudgeReq
-	156, // 160: creation.v1.Creation.EditColumnCosAndSearch:input_type -> creation.v1.EditColumnCosAndSearchReq
-	157, // 161: creation.v1.Creation.GetColumnList:input_type -> creation.v1.GetColumnListReq
-	159, // 162: creation.v1.Creation.GetColumnListHot:input_type -> creation.v1.GetColumnListHotReq
-	161, // 163: creation.v1.Creation.GetColumnListStatistic:input_type -> creation.v1.GetColumnListStatisticReq
-	163, // 164: creation.v1.Creation.GetColumnStatistic:input_type -> creation.v1.GetColumnStatisticReq
-	165, // 165: creation.v1.Creation.GetUserColumnList:input_type -> creation.v1.GetUserColumnListReq
-	166, // 166: creation.v1.Creation.GetUserColumnListVisitor:input_type -> creation.v1.GetUserColumnListVisitorReq
-	168, // 167: creation.v1.Creation.GetColumnCount:input_type -> creation.v1.GetColumnCountReq
-	169, // 168: creation.v1.Creation.GetColumnCountVisitor:input_type -> creation.v1.GetColumnCountVisitorReq
-	171, // 169: creation.v1.Creation.GetSubscribeList:input_type -> creation.v1.GetSubscribeListReq
-	173, // 170: creation.v1.Creation.GetSubscribeListCount:input_type -> creation.v1.GetSubscribeListCountReq
-	175, // 171: creation.v1.Creation.GetColumnSubscribes:input_type -> creation.v1.GetColumnSubscribesReq
-	177, // 172: creation.v1.Creation.GetUserColumnAgree:input_type -> creation.v1.GetUserColumnAgreeReq
-	178, // 173: creation.v1.Creation.GetUserColumnCollect:input_type -> creation.v1.GetUserColumnCollectReq
-	181, // 174: creation.v1.Creation.GetUserSubscribeColumn:input_type -> creation.v1.GetUserSubscribeColumnReq
-	183, // 175: creation.v1.Creation.SendColumnEdit:input_type -> creation.v1.SendColumnEditReq
-	184, // 176: creation.v1.Creation.EditColumn:input_type -> creation.v1.EditColumnReq
-	185, // 177: creation.v1.Creation.DeleteColumn:input_type -> creation.v1.DeleteColumnReq
-	186, // 178: creation.v1.Creation.DeleteColumnCacheAndSearch:input_type -> creation.v1.DeleteColumnCacheAndSearchReq
-	187, // 179: creation.v1.Creation.ColumnStatisticJudge:input_type -> creation.v1.ColumnStatisticJudgeReq
-	189, // 180: creation.v1.Creation.SetColumnAgree:input_type -> creation.v1.SetColumnAgreeReq
-	190, // 181: creation.v1.Creation.SetColumnAgreeDbAndCache:input_type -> creation.v1.SetColumnAgreeDbAndCacheReq
-	191, // 182: creation.v1.Creation.CancelColumnAgree:input_type -> creation.v1.CancelColumnAgreeReq
-	192, // 183: creation.v1.Creation.CancelColumnAgreeDbAndCache:input_type -> creation.v1.CancelColumnAgreeDbAndCacheReq
-	193, // 184: creation.v1.Creation.SetColumnCollect:input_type -> creation.v1.SetColumnCollectReq
-	194, // 185: creation.v1.Creation.SetColumnCollectDbAndCache:input_type -> creation.v1.SetColumnCollectDbAndCacheReq
-	195, // 186: creation.v1.Creation.CancelColumnCollect:input_type -> creation.v1.CancelColumnCollectReq
-	196, // 187: creation.v1.Creation.CancelColumnCollectDbAndCache:input_type -> creation.v1.CancelColumnCollectDbAndCacheReq
-	197, // 188: creation.v1.Creation.SetColumnView:input_type -> creation.v1.SetColumnViewReq
-	198, // 189: creation.v1.Creation.SetColumnViewDbAndCache:input_type -> creation.v1.SetColumnViewDbAndCacheReq
-	199, // 190: creation.v1.Creation.AddColumnIncludes:input_type -> creation.v1.AddColumnIncludesReq
-	200, // 191: creation.v1.Creation.AddColumnIncludesDbAndCache:input_type -> creation.v1.AddColumnIncludesDbAndCacheReq
-	201, // 192: creation.v1.Creation.DeleteColumnIncludes:input_type -> creation.v1.DeleteColumnIncludesReq
-	202, // 193: creation.v1.Creation.DeleteColumnIncludesDbAndCache:input_type -> creation.v1.DeleteColumnIncludesDbAndCacheReq
-	203, // 194: creation.v1.Creation.SetColumnSubscribeDbAndCache:input_type -> creation.v1.SetColumnSubscribeDbAndCacheReq
-	204, // 195: creation.v1.Creation.CancelColumnSubscribeDbAndCache:input_type -> creation.v1.CancelColumnSubscribeDbAndCacheReq
-	205, // 196: creation.v1.Creation.GetNews:input_type -> creation.v1.GetNewsReq
-	207, // 197: creation.v1.Creation.GetNewsSearch:input_type -> creation.v1.GetNewsSearchReq
-	209, // 198: creation.v1.Creation.AddCreationComment:input_type -> creation.v1.AddCreationCommentReq
-	210, // 199: creation.v1.Creation.ReduceCreationComment:input_type -> creation.v1.ReduceCreationCommentReq
-	245, // 200: creation.v1.Creation.GetHealth:input_type -> google.protobuf.Empty
-	0,   // 201: creation.v1.Creation.GetLeaderBoard:output_type -> creation.v1.GetLeaderBoardReply
-	2,   // 202: creation.v1.Creation.GetLastCollectionsDraft:output_type -> creation.v1.GetLastCollectionsDraftReply
-	4,   // 203: creation.v1.Creation.GetCollectionsContentReview:output_type -> creation.v1.GetCollectionsContentReviewReply
-	41,  // 204: creation.v1.Creation.GetCollectArticleList:output_type -> creation.v1.GetArticleListReply
-	7,   // 205: creation.v1.Creation.GetCollectArticleCount:output_type -> creation.v1.GetCollectArticleCountReply
-	93,  // 206: creation.v1.Creation.GetCollectTalkList:output_type -> creation.v1.GetTalkListReply
-	10,  // 207: creation.v1.Creation.GetCollectTalkCount:output_type -> creation.v1.GetCollectTalkCountReply
-	158, // 208: creation.v1.Creation.GetCollectColumnList:output_type -> creation.v1.GetColumnListReply
-	13,  // 209: creation.v1.Creation.GetCollectColumnCount:output_type -> creation.v1.GetCollectColumnCountReply
-	15,  // 210: creation.v1.Creation.GetCollections:output_type -> creation.v1.GetCollectionsReply
-	18,  // 211: creation.v1.Creation.GetCollectionListInfo:output_type -> creation.v1.GetCollectionsListReply
-	18,  // 212: creation.v1.Creation.GetCollectionsList:output_type -> creation.v1.GetCollectionsListReply
-	18,  // 213: creation.v1.Creation.GetCollectionsListAll:output_type -> creation.v1.GetCollectionsListReply
-	21,  // 214: creation.v1.Creation.GetCollectionsCount:output_type -> creation.v1.GetCollectionsCountReply
-	18,  // 215: creation.v1.Creation.GetCollectionsListByVisitor:output_type -> creation.v1.GetCollectionsListReply
-	21,  // 216: creation.v1.Creation.GetCollectionsVisitorCount:output_type -> creation.v1.GetCollectionsCountReply
-	23,  // 217: creation.v1.Creation.GetCreationUser:output_type -> creation.v1.GetCreationUserReply
-	23,  // 218: creation.v1.Creation.GetCreationUserVisitor:output_type -> creation.v1.GetCreationUserReply
-	25,  // 219: creation.v1.Creation.GetUserTimeLineList:output_type -> creation.v1.GetUserTimeLineListReply
-	245, // 220: creation.v1.Creation.SendCollections:output_type -> google.protobuf.Empty
-	28,  // 221: creation.v1.Creation.CreateCollectionsDraft:output_type -> creation.v1.CreateCollectionsDraftReply
-	245, // 222: creation.v1.Creation.CreateCollections:output_type -> google.protobuf.Empty
-	245, // 223: creation.v1.Creation.CreateCollectionsDbAndCache:output_type -> google.protobuf.Empty
-	245, // 224: creation.v1.Creation.CollectionsContentIrregular:output_type -> google.protobuf.Empty
-	245, // 225: creation.v1.Creation.AddCollectionsContentReviewDbAndCache:output_type -> google.protobuf.Empty
-	245, // 226: creation.v1.Creation.SendCollectionsEdit:output_type -> google.protobuf.Empty
-	245, // 227: creation.v1.Creation.EditCollections:output_type -> google.protobuf.Empty
-	245, // 228: creation.v1.Creation.EditCollectionsCos:output_type -> google.protobuf.Empty
-	245, // 229: creation.v1.Creation.DeleteCollections:output_type -> google.protobuf.Empty
-	245, // 230: creation.v1.Creation.DeleteCollectionsCache:output_type -> google.protobuf.Empty
-	245, // 231: creation.v1.Creation.ArticleImageIrregular:output_type -> google.protobuf.Empty
-	245, // 232: creation.v1.Creation.ArticleContentIrregular:output_type -> google.protobuf.Empty
-	245, // 233: creation.v1.Creation.AddArticleImageReviewDbAndCache:output_type -> google.protobuf.Empty
-	245, // 234: creation.v1.Creation.AddArticleContentReviewDbAndCache:output_type -> google.protobuf.Empty
-	41,  // 235: creation.v1.Creation.GetArticleList:output_type -> creation.v1.GetArticleListReply
-	43,  // 236: creation.v1.Creation.GetArticleListHot:output_type -> creation.v1.GetArticleListHotReply
-	41,  // 237: creation.v1.Creation.GetColumnArticleList:output_type -> creation.v1.GetArticleListReply
-	46,  // 238: creation.v1.Creation.GetArticleCount:output_type -> creation.v1.GetArticleCountReply
-	46,  // 239: creation.v1.Creation.GetArticleCountVisitor:output_type -> creation.v1.GetArticleCountReply
-	41,  // 240: creation.v1.Creation.GetUserArticleList:output_type -> creation.v1.GetArticleListReply
-	41,  // 241: creation.v1.Creation.GetUserArticleListVisitor:output_type -> creation.v1.GetArticleListReply
-	41,  // 242: creation.v1.Creation.GetUserArticleListAll:output_type -> creation.v1.GetArticleListReply
-	51,  // 243: creation.v1.Creation.GetArticleStatistic:output_type -> creation.v1.GetArticleStatisticReply
-	53,  // 244: creation.v1.Creation.GetUserArticleAgree:output_type -> creation.v1.GetUserArticleAgreeReply
-	55,  // 245: creation.v1.Creation.GetUserArticleCollect:output_type -> creation.v1.GetUserArticleCollectReply
-	57,  // 246: creation.v1.Creation.GetArticleListStatistic:output_type -> creation.v1.GetArticleListStatisticReply
-	59,  // 247: creation.v1.Creation.GetLastArticleDraft:output_type -> creation.v1.GetLastArticleDraftReply
-	61,  // 248: creation.v1.Creation.GetArticleSearch:output_type -> creation.v1.GetArticleSearchReply
-	63,  // 249: creation.v1.Creation.GetArticleImageReview:output_type -> creation.v1.GetArticleImageReviewReply
-	65,  // 250: creation.v1.Creation.GetArticleContentReview:output_type -> creation.v1.GetArticleContentReviewReply
-	245, // 251: creation.v1.Creation.CreateArticle:output_type -> google.protobuf.Empty
-	245, // 252: creation.v1.Creation.EditArticle:output_type -> google.protobuf.Empty
-	245, // 253: creation.v1.Creation.CreateArticleDbCacheAndSearch:output_type -> google.protobuf.Empty
-	245, // 254: creation.v1.Creation.EditArticleCosAndSearch:output_type -> google.protobuf.Empty
-	245, // 255: creation.v1.Creation.DeleteArticleCacheAndSearch:output_type -> google.protobuf.Empty
-	245, // 256: creation.v1.Creation.SetArticleViewDbAndCache:output_type -> google.protobuf.Empty
-	245, // 257: creation.v1.Creation.SetArticleAgreeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 258: creation.v1.Creation.SetArticleCollectDbAndCache:output_type -> google.protobuf.Empty
-	75,  // 259: creation.v1.Creation.CreateArticleDraft:output_type -> creation.v1.CreateArticleDraftReply
-	245, // 260: creation.v1.Creation.ArticleDraftMark:output_type -> google.protobuf.Empty
-	78,  // 261: creation.v1.Creation.GetArticleDraftList:output_type -> creation.v1.GetArticleDraftListReply
-	245, // 262: creation.v1.Creation.SendArticle:output_type -> google.protobuf.Empty
-	245, // 263: creation.v1.Creation.SendArticleEdit:output_type -> google.protobuf.Empty
-	245, // 264: creation.v1.Creation.DeleteArticle:output_type -> google.protobuf.Empty
-	245, // 265: creation.v1.Creation.DeleteArticleDraft:output_type -> google.protobuf.Empty
-	245, // 266: creation.v1.Creation.SetArticleAgree:output_type -> google.protobuf.Empty
-	245, // 267: creation.v1.Creation.SetArticleView:output_type -> google.protobuf.Empty
-	245, // 268: creation.v1.Creation.SetArticleCollect:output_type -> google.protobuf.Empty
-	245, // 269: creation.v1.Creation.CancelArticleAgree:output_type -> google.protobuf.Empty
-	245, // 270: creation.v1.Creation.CancelArticleAgreeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 271: creation.v1.Creation.CancelArticleCollect:output_type -> google.protobuf.Empty
-	245, // 272: creation.v1.Creation.CancelArticleCollectDbAndCache:output_type -> google.protobuf.Empty
-	91,  // 273: creation.v1.Creation.ArticleStatisticJudge:output_type -> creation.v1.ArticleStatisticJudgeReply
-	93,  // 274: creation.v1.Creation.GetTalkList:output_type -> creation.v1.GetTalkListReply
-	98,  // 275: creation.v1.Creation.GetTalkListHot:output_type -> creation.v1.GetTalkListHotReply
-	93,  // 276: creation.v1.Creation.GetUserTalkList:output_type -> creation.v1.GetTalkListReply
-	93,  // 277: creation.v1.Creation.GetUserTalkListVisitor:output_type -> creation.v1.GetTalkListReply
-	96,  // 278: creation.v1.Creation.GetTalkCount:output_type -> creation.v1.GetTalkCountReply
-	96,  // 279: creation.v1.Creation.GetTalkCountVisitor:output_type -> creation.v1.GetTalkCountReply
-	102, // 280: creation.v1.Creation.GetTalkListStatistic:output_type -> creation.v1.GetTalkListStatisticReply
-	104, // 281: creation.v1.Creation.GetTalkStatistic:output_type -> creation.v1.GetTalkStatisticReply
-	106, // 282: creation.v1.Creation.GetLastTalkDraft:output_type -> creation.v1.GetLastTalkDraftReply
-	108, // 283: creation.v1.Creation.GetTalkSearch:output_type -> creation.v1.GetTalkSearchReply
-	111, // 284: creation.v1.Creation.GetUserTalkAgree:output_type -> creation.v1.GetUserTalkAgreeReply
-	112, // 285: creation.v1.Creation.GetUserTalkCollect:output_type -> creation.v1.GetUserTalkCollectReply
-	114, // 286: creation.v1.Creation.GetTalkImageReview:output_type -> creation.v1.GetTalkImageReviewReply
-	116, // 287: creation.v1.Creation.GetTalkContentReview:output_type -> creation.v1.GetTalkContentReviewReply
-	245, // 288: creation.v1.Creation.AddTalkImageReviewDbAndCache:output_type -> google.protobuf.Empty
-	245, // 289: creation.v1.Creation.AddTalkContentReviewDbAndCache:output_type -> google.protobuf.Empty
-	118, // 290: creation.v1.Creation.CreateTalkDraft:output_type -> creation.v1.CreateTalkDraftReply
-	245, // 291: creation.v1.Creation.SendTalk:output_type -> google.protobuf.Empty
-	245, // 292: creation.v1.Creation.SendTalkEdit:output_type -> google.protobuf.Empty
-	245, // 293: creation.v1.Creation.TalkImageIrregular:output_type -> google.protobuf.Empty
-	245, // 294: creation.v1.Creation.TalkContentIrregular:output_type -> google.protobuf.Empty
-	245, // 295: creation.v1.Creation.CreateTalk:output_type -> google.protobuf.Empty
-	245, // 296: creation.v1.Creation.EditTalk:output_type -> google.protobuf.Empty
-	245, // 297: creation.v1.Creation.DeleteTalk:output_type -> google.protobuf.Empty
-	245, // 298: creation.v1.Creation.CreateTalkDbCacheAndSearch:output_type -> google.protobuf.Empty
-	245, // 299: creation.v1.Creation.EditTalkCosAndSearch:output_type -> google.protobuf.Empty
-	245, // 300: creation.v1.Creation.DeleteTalkCacheAndSearch:output_type -> google.protobuf.Empty
-	245, // 301: creation.v1.Creation.SetTalkView:output_type -> google.protobuf.Empty
-	245, // 302: creation.v1.Creation.SetTalkViewDbAndCache:output_type -> google.protobuf.Empty
-	130, // 303: creation.v1.Creation.TalkStatisticJudge:output_type -> creation.v1.TalkStatisticJudgeReply
-	245, // 304: creation.v1.Creation.SetTalkAgree:output_type -> google.protobuf.Empty
-	245, // 305: creation.v1.Creation.SetTalkAgreeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 306: creation.v1.Creation.SetTalkCollect:output_type -> google.protobuf.Empty
-	245, // 307: creation.v1.Creation.SetTalkCollectDbAndCache:output_type -> google.protobuf.Empty
-	245, // 308: creation.v1.Creation.CancelTalkAgree:output_type -> google.protobuf.Empty
-	245, // 309: creation.v1.Creation.CancelTalkAgreeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 310: creation.v1.Creation.CancelTalkCollect:output_type -> google.protobuf.Empty
-	245, // 311: creation.v1.Creation.CancelTalkCollectDbAndCache:output_type -> google.protobuf.Empty
-	140, // 312: creation.v1.Creation.GetLastColumnDraft:output_type -> creation.v1.GetLastColumnDraftReply
-	142, // 313: creation.v1.Creation.GetColumnSearch:output_type -> creation.v1.GetColumnSearchReply
-	144, // 314: creation.v1.Creation.GetColumnImageReview:output_type -> creation.v1.GetColumnImageReviewReply
-	146, // 315: creation.v1.Creation.GetColumnContentReview:output_type -> creation.v1.GetColumnContentReviewReply
-	245, // 316: creation.v1.Creation.ColumnImageIrregular:output_type -> google.protobuf.Empty
-	245, // 317: creation.v1.Creation.ColumnContentIrregular:output_type -> google.protobuf.Empty
-	245, // 318: creation.v1.Creation.AddColumnImageReviewDbAndCache:output_type -> google.protobuf.Empty
-	245, // 319: creation.v1.Creation.AddColumnContentReviewDbAndCache:output_type -> google.protobuf.Empty
-	148, // 320: creation.v1.Creation.CreateColumnDraft:output_type -> creation.v1.CreateColumnDraftReply
-	245, // 321: creation.v1.Creation.SendColumn:output_type -> google.protobuf.Empty
-	245, // 322: creation.v1.Creation.CreateColumn:output_type -> google.protobuf.Empty
-	245, // 323: creation.v1.Creation.CreateColumnDbCacheAndSearch:output_type -> google.protobuf.Empty
-	245, // 324: creation.v1.Creation.SubscribeColumn:output_type -> google.protobuf.Empty
-	245, // 325: creation.v1.Creation.CancelSubscribeColumn:output_type -> google.protobuf.Empty
-	155, // 326: creation.v1.Creation.SubscribeJudge:output_type -> creation.v1.SubscribeJudgeReply
-	245, // 327: creation.v1.Creation.EditColumnCosAndSearch:output_type -> google.protobuf.Empty
-	158, // 328: creation.v1.Creation.GetColumnList:output_type -> creation.v1.GetColumnListReply
-	160, // 329: creation.v1.Creation.GetColumnListHot:output_type -> creation.v1.GetColumnListHotReply
-	162, // 330: creation.v1.Creation.GetColumnListStatistic:output_type -> creation.v1.GetColumnListStatisticReply
-	164, // 331: creation.v1.Creation.GetColumnStatistic:output_type -> creation.v1.GetColumnStatisticReply
-	158, // 332: creation.v1.Creation.GetUserColumnList:output_type -> creation.v1.GetColumnListReply
-	158, // 333: creation.v1.Creation.GetUserColumnListVisitor:output_type -> creation.v1.GetColumnListReply
-	170, // 334: creation.v1.Creation.GetColumnCount:output_type -> creation.v1.GetColumnCountReply
-	170, // 335: creation.v1.Creation.GetColumnCountVisitor:output_type -> creation.v1.GetColumnCountReply
-	172, // 336: creation.v1.Creation.GetSubscribeList:output_type -> creation.v1.GetSubscribeListReply
-	174, // 337: creation.v1.Creation.GetSubscribeListCount:output_type -> creation.v1.GetSubscribeListCountReply
-	176, // 338: creation.v1.Creation.GetColumnSubscribes:output_type -> creation.v1.GetColumnSubscribesReply
-	179, // 339: creation.v1.Creation.GetUserColumnAgree:output_type -> creation.v1.GetUserColumnAgreeReply
-	180, // 340: creation.v1.Creation.GetUserColumnCollect:output_type -> creation.v1.GetUserColumnCollectReply
-	182, // 341: creation.v1.Creation.GetUserSubscribeColumn:output_type -> creation.v1.GetUserSubscribeColumnReply
-	245, // 342: creation.v1.Creation.SendColumnEdit:output_type -> google.protobuf.Empty
-	245, // 343: creation.v1.Creation.EditColumn:output_type -> google.protobuf.Empty
-	245, // 344: creation.v1.Creation.DeleteColumn:output_type -> google.protobuf.Empty
-	245, // 345: creation.v1.Creation.DeleteColumnCacheAndSearch:output_type -> google.protobuf.Empty
-	188, // 346: creation.v1.Creation.ColumnStatisticJudge:output_type -> creation.v1.ColumnStatisticJudgeReply
-	245, // 347: creation.v1.Creation.SetColumnAgree:output_type -> google.protobuf.Empty
-	245, // 348: creation.v1.Creation.SetColumnAgreeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 349: creation.v1.Creation.CancelColumnAgree:output_type -> google.protobuf.Empty
-	245, // 350: creation.v1.Creation.CancelColumnAgreeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 351: creation.v1.Creation.SetColumnCollect:output_type -> google.protobuf.Empty
-	245, // 352: creation.v1.Creation.SetColumnCollectDbAndCache:output_type -> google.protobuf.Empty
-	245, // 353: creation.v1.Creation.CancelColumnCollect:output_type -> google.protobuf.Empty
-	245, // 354: creation.v1.Creation.CancelColumnCollectDbAndCache:output_type -> google.protobuf.Empty
-	245, // 355: creation.v1.Creation.SetColumnView:output_type -> google.protobuf.Empty
-	245, // 356: creation.v1.Creation.SetColumnViewDbAndCache:output_type -> google.protobuf.Empty
-	245, // 357: creation.v1.Creation.AddColumnIncludes:output_type -> google.protobuf.Empty
-	245, // 358: creation.v1.Creation.AddColumnIncludesDbAndCache:output_type -> google.protobuf.Empty
-	245, // 359: creation.v1.Creation.DeleteColumnIncludes:output_type -> google.protobuf.Empty
-	245, // 360: creation.v1.Creation.DeleteColumnIncludesDbAndCache:output_type -> google.protobuf.Empty
-	245, // 361: creation.v1.Creation.SetColumnSubscribeDbAndCache:output_type -> google.protobuf.Empty
-	245, // 362: creation.v1.Creation.CancelColumnSubscribeDbAndCache:output_type -> google.protobuf.Empty
-	206, // 363: creation.v1.Creation.GetNews:output_type -> creation.v1.GetNewsReply
-	208, // 364: creation.v1.Creation.GetNewsSearch:output_type -> creation.v1.GetNewsSearchReply
-	245, // 365: creation.v1.Creation.AddCreationComment:output_type -> google.protobuf.Empty
-	245, // 366: creation.v1.Creation.ReduceCreationComment:output_type -> google.protobuf.Empty
-	245, // 367: creation.v1.Creation.GetHealth:output_type -> google.protobuf.Empty
-	201, // [201:368] is the sub-list for method output_type
-	34,  // [34:201] is the sub-list for method input_type
-	34,  // [34:34] is the sub-list for extension type_name
-	34,  // [34:34] is the sub-list for extension extendee
-	0,   // [0:34] is the sub-list for field type_name
+	221, // 0: creation.v1.GetLeaderBoardReply.board:type_name -> creation.v1.GetLeaderBoardReply.Board
+	222, // 1: creation.v1.GetCollectionsContentReviewReply.review:type_name -> creation.v1.GetCollectionsContentReviewReply.Review
+	223, // 2: creation.v1.GetCollectionsListReply.collections:type_name -> creation.v1.GetCollectionsListReply.Collections
+	224, // 3: creation.v1.GetUserTimeLineListReply.timeline:type_name -> creation.v1.GetUserTimeLineListReply.TimeLine
+	225, // 4: creation.v1.GetArticleListReply.article:type_name -> creation.v1.GetArticleListReply.Article
+	226, // 5: creation.v1.GetArticleListHotReply.article:type_name -> creation.v1.GetArticleListHotReply.Article
+	227, // 6: creation.v1.GetUserArticleAgreeReply.agree:type_name -> creation.v1.GetUserArticleAgreeReply.AgreeEntry
+	228, // 7: creation.v1.GetUserArticleCollectReply.collect:type_name -> creation.v1.GetUserArticleCollectReply.CollectEntry
+	229, // 8: creation.v1.GetArticleListStatisticReply.count:type_name -> creation.v1.GetArticleListStatisticReply.Count
+	230, // 9: creation.v1.GetArticleSearchReply.list:type_name -> creation.v1.GetArticleSearchReply.List
+	231, // 10: creation.v1.GetArticleImageReviewReply.review:type_name -> creation.v1.GetArticleImageReviewReply.Review
+	232, // 11: creation.v1.GetArticleContentReviewReply.review:type_name -> creation.v1.GetArticleContentReviewReply.Review
+	233, // 12: creation.v1.AddCreationViewDbAndCacheReq.view:type_name -> creation.v1.AddCreationViewDbAndCacheReq.View
+	234, // 13: creation.v1.GetArticleDraftListReply.draft:type_name -> creation.v1.GetArticleDraftListReply.Draft
+	235, // 14: creation.v1.GetTalkListReply.talk:type_name -> creation.v1.GetTalkListReply.Talk
+	236, // 15: creation.v1.GetTalkListHotReply.talk:type_name -> creation.v1.GetTalkListHotReply.Talk
+	237, // 16: creation.v1.GetTalkListStatisticReply.count:type_name -> creation.v1.GetTalkListStatisticReply.Count
+	238, // 17: creation.v1.GetTalkSearchReply.list:type_name -> creation.v1.GetTalkSearchReply.List
+	239, // 18: creation.v1.GetUserTalkAgreeReply.agree:type_name -> creation.v1.GetUserTalkAgreeReply.AgreeEntry
+	240, // 19: creation.v1.GetUserTalkCollectReply.collect:type_name -> creation.v1.GetUserTalkCollectReply.CollectEntry
+	241, // 20: creation.v1.GetTalkImageReviewReply.review:type_name -> creation.v1.GetTalkImageReviewReply.Review
+	242, // 21: creation.v1.GetTalkContentReviewReply.review:type_name -> creation.v1.GetTalkContentReviewReply.Review
+	243, // 22: creation.v1.GetColumnSearchReply.list:type_name -> creation.v1.GetColumnSearchReply.List
+	244, // 23: creation.v1.GetColumnImageReviewReply.review:type_name -> creation.v1.GetColumnImageReviewReply.Review
+	245, // 24: creation.v1.GetColumnContentReviewReply.review:type_name -> creation.v1.GetColumnContentReviewReply.Review
+	246, // 25: creation.v1.GetColumnListReply.column:type_name -> creation.v1.GetColumnListReply.Column
+	247, // 26: creation.v1.GetColumnListHotReply.column:type_name -> creation.v1.GetColumnListHotReply.Column
+	248, // 27: creation.v1.GetColumnListStatisticReply.count:type_name -> creation.v1.GetColumnListStatisticReply.Count
+	249, // 28: creation.v1.GetSubscribeListReply.subscribe:type_name -> creation.v1.GetSubscribeListReply.Subscribe
+	250, // 29: creation.v1.GetColumnSubscribesReply.subscribes:type_name -> creation.v1.GetColumnSubscribesReply.Subscribes
+	251, // 30: creation.v1.GetUserColumnAgreeReply.agree:type_name -> creation.v1.GetUserColumnAgreeReply.AgreeEntry
+	252, // 31: creation.v1.GetUserColumnCollectReply.collect:type_name -> creation.v1.GetUserColumnCollectReply.CollectEntry
+	253, // 32: creation.v1.GetUserSubscribeColumnReply.subscribe:type_name -> creation.v1.GetUserSubscribeColumnReply.SubscribeEntry
+	254, // 33: creation.v1.GetNewsReply.news:type_name -> creation.v1.GetNewsReply.News
+	255, // 34: creation.v1.GetNewsSearchReply.list:type_name -> creation.v1.GetNewsSearchReply.List
+	256, // 35: creation.v1.GetUserDataReply.files:type_name -> creation.v1.GetUserDataReply.File
+	257, // 36: creation.v1.GetUserTagsReply.tags:type_name -> creation.v1.GetUserTagsReply.TagsEntry
+	258, // 37: creation.v1.GetTagAuthorsReply.authors:type_name -> creation.v1.GetTagAuthorsReply.AuthorsEntry
+	259, // 38: creation.v1.GetAuthorActivityReply.activity:type_name -> creation.v1.GetAuthorActivityReply.ActivityEntry
+	260, // 39: creation.v1.Creation.GetLeaderBoard:input_type -> google.protobuf.Empty
+	1,   // 40: creation.v1.Creation.GetLastCollectionsDraft:input_type -> creation.v1.GetLastCollectionsDraftReq
+	3,   // 41: creation.v1.Creation.GetCollectionsContentReview:input_type -> creation.v1.GetCollectionsContentReviewReq
+	5,   // 42: creation.v1.Creation.GetCollectArticleList:input_type -> creation.v1.GetCollectArticleListReq
+	6,   // 43: creation.v1.Creation.GetCollectArticleCount:input_type -> creation.v1.GetCollectArticleCountReq
+	8,   // 44: creation.v1.Creation.GetCollectTalkList:input_type -> creation.v1.GetCollectTalkListReq
+	9,   // 45: creation.v1.Creation.GetCollectTalkCount:input_type -> creation.v1.GetCollectTalkCountReq
+	11,  // 46: creation.v1.Creation.GetCollectColumnList:input_type -> creation.v1.GetCollectColumnListReq
+	12,  // 47: creation.v1.Creation.GetCollectColumnCount:input_type -> creation.v1.GetCollectColumnCountReq
+	14,  // 48: creation.v1.Creation.GetCollections:input_type -> creation.v1.GetCollectionsReq
+	16,  // 49: creation.v1.Creation.GetCollectionListInfo:input_type -> creation.v1.GetCollectionListInfoReq
+	17,  // 50: creation.v1.Creation.GetCollectionsList:input_type -> creation.v1.GetCollectionsListReq
+	19,  // 51: creation.v1.Creation.GetCollectionsListAll:input_type -> creation.v1.GetCollectionsListAllReq
+	20,  // 52: creation.v1.Creation.GetCollectionsCount:input_type -> creation.v1.GetCollectionsCountReq
+	17,  // 53: creation.v1.Creation.GetCollectionsListByVisitor:input_type -> creation.v1.GetCollectionsListReq
+	20,  // 54: creation.v1.Creation.GetCollectionsVisitorCount:input_type -> creation.v1.GetCollectionsCountReq
+	22,  // 55: creation.v1.Creation.GetCreationUser:input_type -> creation.v1.GetCreationUserReq
+	22,  // 56: creation.v1.Creation.GetCreationUserVisitor:input_type -> creation.v1.GetCreationUserReq
+	24,  // 57: creation.v1.Creation.GetUserTimeLineList:input_type -> creation.v1.GetUserTimeLineListReq
+	26,  // 58: creation.v1.Creation.SendCollections:input_type -> creation.v1.SendCollectionsReq
+	27,  // 59: creation.v1.Creation.CreateCollectionsDraft:input_type -> creation.v1.CreateCollectionsDraftReq
+	29,  // 60: creation.v1.Creation.CreateCollections:input_type -> creation.v1.CreateCollectionsReq
+	30,  // 61: creation.v1.Creation.CreateCollectionsDbAndCache:input_type -> creation.v1.CreateCollectionsDbAndCacheReq
+	37,  // 62: creation.v1.Creation.CollectionsContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
+	39,  // 63: creation.v1.Creation.AddCollectionsContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
+	31,  // 64: creation.v1.Creation.SendCollectionsEdit:input_type -> creation.v1.SendCollectionsEditReq
+	32,  // 65: creation.v1.Creation.EditCollections:input_type -> creation.v1.EditCollectionsReq
+	33,  // 66: creation.v1.Creation.EditCollectionsCos:input_type -> creation.v1.EditCollectionsCosReq
+	34,  // 67: creation.v1.Creation.DeleteCollections:input_type -> creation.v1.DeleteCollectionsReq
+	35,  // 68: creation.v1.Creation.DeleteCollectionsCache:input_type -> creation.v1.DeleteCollectionsCacheReq
+	36,  // 69: creation.v1.Creation.ArticleImageIrregular:input_type -> creation.v1.CreationImageIrregularReq
+	37,  // 70: creation.v1.Creation.ArticleContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
+	38,  // 71: creation.v1.Creation.AddArticleImageReviewDbAndCache:input_type -> creation.v1.AddCreationImageReviewDbAndCacheReq
+	39,  // 72: creation.v1.Creation.AddArticleContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
+	40,  // 73: creation.v1.Creation.GetArticleList:input_type -> creation.v1.GetArticleListReq
+	42,  // 74: creation.v1.Creation.GetArticleListHot:input_type -> creation.v1.GetArticleListHotReq
+	168, // 75: creation.v1.Creation.GetColumnArticleList:input_type -> creation.v1.GetColumnArticleListReq
+	44,  // 76: creation.v1.Creation.GetArticleCount:input_type -> creation.v1.GetArticleCountReq
+	45,  // 77: creation.v1.Creation.GetArticleCountVisitor:input_type -> creation.v1.GetArticleCountVisitorReq
+	47,  // 78: creation.v1.Creation.GetUserArticleList:input_type -> creation.v1.GetUserArticleListReq
+	48,  // 79: creation.v1.Creation.GetUserArticleListVisitor:input_type -> creation.v1.GetUserArticleListVisitorReq
+	49,  // 80: creation.v1.Creation.GetUserArticleListAll:input_type -> creation.v1.GetUserArticleListAllReq
+	50,  // 81: creation.v1.Creation.GetArticleStatistic:input_type -> creation.v1.GetArticleStatisticReq
+	52,  // 82: creation.v1.Creation.GetUserArticleAgree:input_type -> creation.v1.GetUserArticleAgreeReq
+	54,  // 83: creation.v1.Creation.GetUserArticleCollect:input_type -> creation.v1.GetUserArticleCollectReq
+	56,  // 84: creation.v1.Creation.GetArticleListStatistic:input_type -> creation.v1.GetArticleListStatisticReq
+	58,  // 85: creation.v1.Creation.GetLastArticleDraft:input_type -> creation.v1.GetLastArticleDraftReq
+	60,  // 86: creation.v1.Creation.GetArticleSearch:input_type -> creation.v1.GetArticleSearchReq
+	62,  // 87: creation.v1.Creation.GetArticleImageReview:input_type -> creation.v1.GetArticleImageReviewReq
+	64,  // 88: creation.v1.Creation.GetArticleContentReview:input_type -> creation.v1.GetArticleContentReviewReq
+	66,  // 89: creation.v1.Creation.CreateArticle:input_type -> creation.v1.CreateArticleReq
+	67,  // 90: creation.v1.Creation.EditArticle:input_type -> creation.v1.EditArticleReq
+	68,  // 91: creation.v1.Creation.CreateArticleDbCacheAndSearch:input_type -> creation.v1.CreateArticleDbCacheAndSearchReq
+	69,  // 92: creation.v1.Creation.EditArticleCosAndSearch:input_type -> creation.v1.EditArticleCosAndSearchReq
+	70,  // 93: creation.v1.Creation.DeleteArticleCacheAndSearch:input_type -> creation.v1.DeleteArticleCacheAndSearchReq
+	71,  // 94: creation.v1.Creation.SetArticleViewDbAndCache:input_type -> creation.v1.SetArticleViewDbAndCacheReq
+	72,  // 95: creation.v1.Creation.AddArticleViewDbAndCache:input_type -> creation.v1.AddCreationViewDbAndCacheReq
+	73,  // 96: creation.v1.Creation.SetArticleAgreeDbAndCache:input_type -> creation.v1.SetArticleAgreeDbAndCacheReq
+	74,  // 97: creation.v1.Creation.SetArticleCollectDbAndCache:input_type -> creation.v1.SetArticleCollectDbAndCacheReq
+	75,  // 98: creation.v1.Creation.CreateArticleDraft:input_type -> creation.v1.CreateArticleDraftReq
+	77,  // 99: creation.v1.Creation.ArticleDraftMark:input_type -> creation.v1.ArticleDraftMarkReq
+	78,  // 100: creation.v1.Creation.GetArticleDraftList:input_type -> creation.v1.GetArticleDraftListReq
+	80,  // 101: creation.v1.Creation.SendArticle:input_type -> creation.v1.SendArticleReq
+	81,  // 102: creation.v1.Creation.SendArticleEdit:input_type -> creation.v1.SendArticleEditReq
+	82,  // 103: creation.v1.Creation.DeleteArticle:input_type -> creation.v1.DeleteArticleReq
+	83,  // 104: creation.v1.Creation.DeleteArticleDraft:input_type -> creation.v1.DeleteArticleDraftReq
+	84,  // 105: creation.v1.Creation.SetArticleAgree:input_type -> creation.v1.SetArticleAgreeReq
+	85,  // 106: creation.v1.Creation.SetArticleView:input_type -> creation.v1.SetArticleViewReq
+	86,  // 107: creation.v1.Creation.SetArticleCollect:input_type -> creation.v1.SetArticleCollectReq
+	87,  // 108: creation.v1.Creation.CancelArticleAgree:input_type -> creation.v1.CancelArticleAgreeReq
+	88,  // 109: creation.v1.Creation.CancelArticleAgreeDbAndCache:input_type -> creation.v1.CancelArticleAgreeDbAndCacheReq
+	89,  // 110: creation.v1.Creation.CancelArticleCollect:input_type -> creation.v1.CancelArticleCollectReq
+	90,  // 111: creation.v1.Creation.CancelArticleCollectDbAndCache:input_type -> creation.v1.CancelArticleCollectDbAndCacheReq
+	91,  // 112: creation.v1.Creation.ArticleStatisticJudge:input_type -> creation.v1.ArticleStatisticJudgeReq
+	93,  // 113: creation.v1.Creation.GetTalkList:input_type -> creation.v1.GetTalkListReq
+	98,  // 114: creation.v1.Creation.GetTalkListHot:input_type -> creation.v1.GetTalkListHotReq
+	100, // 115: creation.v1.Creation.GetUserTalkList:input_type -> creation.v1.GetUserTalkListReq
+	101, // 116: creation.v1.Creation.GetUserTalkListVisitor:input_type -> creation.v1.GetUserTalkListVisitorReq
+	95,  // 117: creation.v1.Creation.GetTalkCount:input_type -> creation.v1.GetTalkCountReq
+	96,  // 118: creation.v1.Creation.GetTalkCountVisitor:input_type -> creation.v1.GetTalkCountVisitorReq
+	102, // 119: creation.v1.Creation.GetTalkListStatistic:input_type -> creation.v1.GetTalkListStatisticReq
+	104, // 120: creation.v1.Creation.GetTalkStatistic:input_type -> creation.v1.GetTalkStatisticReq
+	106, // 121: creation.v1.Creation.GetLastTalkDraft:input_type -> creation.v1.GetLastTalkDraftReq
+	108, // 122: creation.v1.Creation.GetTalkSearch:input_type -> creation.v1.GetTalkSearchReq
+	110, // 123: creation.v1.Creation.GetUserTalkAgree:input_type -> creation.v1.GetUserTalkAgreeReq
+	111, // 124: creation.v1.Creation.GetUserTalkCollect:input_type -> creation.v1.GetUserTalkCollectReq
+	114, // 125: creation.v1.Creation.GetTalkImageReview:input_type -> creation.v1.GetTalkImageReviewReq
+	116, // 126: creation.v1.Creation.GetTalkContentReview:input_type -> creation.v1.GetTalkContentReviewReq
+	38,  // 127: creation.v1.Creation.AddTalkImageReviewDbAndCache:input_type -> creation.v1.AddCreationImageReviewDbAndCacheReq
+	39,  // 128: creation.v1.Creation.AddTalkContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
+	118, // 129: creation.v1.Creation.CreateTalkDraft:input_type -> creation.v1.CreateTalkDraftReq
+	120, // 130: creation.v1.Creation.SendTalk:input_type -> creation.v1.SendTalkReq
+	121, // 131: creation.v1.Creation.SendTalkEdit:input_type -> creation.v1.SendTalkEditReq
+	36,  // 132: creation.v1.Creation.TalkImageIrregular:input_type -> creation.v1.CreationImageIrregularReq
+	37,  // 133: creation.v1.Creation.TalkContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
+	122, // 134: creation.v1.Creation.CreateTalk:input_type -> creation.v1.CreateTalkReq
+	123, // 135: creation.v1.Creation.EditTalk:input_type -> creation.v1.EditTalkReq
+	124, // 136: creation.v1.Creation.DeleteTalk:input_type -> creation.v1.DeleteTalkReq
+	125, // 137: creation.v1.Creation.CreateTalkDbCacheAndSearch:input_type -> creation.v1.CreateTalkDbCacheAndSearchReq
+	126, // 138: creation.v1.Creation.EditTalkCosAndSearch:input_type -> creation.v1.EditTalkCosAndSearchReq
+	127, // 139: creation.v1.Creation.DeleteTalkCacheAndSearch:input_type -> creation.v1.DeleteTalkCacheAndSearchReq
+	128, // 140: creation.v1.Creation.SetTalkView:input_type -> creation.v1.SetTalkViewReq
+	129, // 141: creation.v1.Creation.SetTalkViewDbAndCache:input_type -> creation.v1.SetTalkViewDbAndCacheReq
+	72,  // 142: creation.v1.Creation.AddTalkViewDbAndCache:input_type -> creation.v1.AddCreationViewDbAndCacheReq
+	130, // 143: creation.v1.Creation.TalkStatisticJudge:input_type -> creation.v1.TalkStatisticJudgeReq
+	132, // 144: creation.v1.Creation.SetTalkAgree:input_type -> creation.v1.SetTalkAgreeReq
+	133, // 145: creation.v1.Creation.SetTalkAgreeDbAndCache:input_type -> creation.v1.SetTalkAgreeDbAndCacheReq
+	134, // 146: creation.v1.Creation.SetTalkCollect:input_type -> creation.v1.SetTalkCollectReq
+	135, // 147: creation.v1.Creation.SetTalkCollectDbAndCache:input_type -> creation.v1.SetTalkCollectDbAndCacheReq
+	136, // 148: creation.v1.Creation.CancelTalkAgree:input_type -> creation.v1.CancelTalkAgreeReq
+	137, // 149: creation.v1.Creation.CancelTalkAgreeDbAndCache:input_type -> creation.v1.CancelTalkAgreeDbAndCacheReq
+	138, // 150: creation.v1.Creation.CancelTalkCollect:input_type -> creation.v1.CancelTalkCollectReq
+	139, // 151: creation.v1.Creation.CancelTalkCollectDbAndCache:input_type -> creation.v1.CancelTalkCollectDbAndCacheReq
+	140, // 152: creation.v1.Creation.GetLastColumnDraft:input_type -> creation.v1.GetLastColumnDraftReq
+	142, // 153: creation.v1.Creation.GetColumnSearch:input_type -> creation.v1.GetColumnSearchReq
+	144, // 154: creation.v1.Creation.GetColumnImageReview:input_type -> creation.v1.GetColumnImageReviewReq
+	146, // 155: creation.v1.Creation.GetColumnContentReview:input_type -> creation.v1.GetColumnContentReviewReq
+	36,  // 156: creation.v1.Creation.ColumnImageIrregular:input_type -> creation.v1.CreationImageIrregularReq
+	37,  // 157: creation.v1.Creation.ColumnContentIrregular:input_type -> creation.v1.CreationContentIrregularReq
+	38,  // 158: creation.v1.Creation.AddColumnImageReviewDbAndCache:input_type -> creation.v1.AddCreationImageReviewDbAndCacheReq
+	39,  // 159: creation.v1.Creation.AddColumnContentReviewDbAndCache:input_type -> creation.v1.AddCreationContentReviewDbAndCacheReq
+	148, // 160: creation.v1.Creation.CreateColumnDraft:input_type -> creation.v1.CreateColumnDraftReq
+	150, // 161: creation.v1.Creation.SendColumn:input_type -> creation.v1.SendColumnReq
+	151, // 162: creation.v1.Creation.CreateColumn:input_type -> creation.v1.CreateColumnReq
+	152, // 163: creation.v1.Creation.CreateColumnDbCacheAndSearch:input_type -> creation.v1.CreateColumnDbCacheAndSearchReq
+	153, // 164: creation.v1.Creation.SubscribeColumn:input_type -> creation.v1.SubscribeColumnReq
+	154, // 165: creation.v1.Creation.CancelSubscribeColumn:input_type -> creation.v1.CancelSubscribeColumnReq
+	155, // 166: creation.v1.Creation.SubscribeJudge:input_type -> creation.v1.SubscribeJudgeReq
+	157, // 167: creation.v1.Creation.EditColumnCosAndSearch:input_type -> creation.v1.EditColumnCosAndSearchReq
+	158, // 168: creation.v1.Creation.GetColumnList:input_type -> creation.v1.GetColumnListReq
+	160, // 169: creation.v1.Creation.GetColumnListHot:input_type -> creation.v1.GetColumnListHotReq
+	162, // 170: creation.v1.Creation.GetColumnListStatistic:input_type -> creation.v1.GetColumnListStatisticReq
+	164, // 171: creation.v1.Creation.GetColumnStatistic:input_type -> creation.v1.GetColumnStatisticReq
+	166, // 172: creation.v1.Creation.GetUserColumnList:input_type -> creation.v1.GetUserColumnListReq
+	167, // 173: creation.v1.Creation.GetUserColumnListVisitor:input_type -> creation.v1.GetUserColumnListVisitorReq
+	169, // 174: creation.v1.Creation.GetColumnCount:input_type -> creation.v1.GetColumnCountReq
+	170, // 175: creation.v1.Creation.GetColumnCountVisitor:input_type -> creation.v1.GetColumnCountVisitorReq
+	172, // 176: creation.v1.Creation.GetSubscribeList:input_type -> creation.v1.GetSubscribeListReq
+	174, // 177: creation.v1.Creation.GetSubscribeListCount:input_type -> creation.v1.GetSubscribeListCountReq
+	176, // 178: creation.v1.Creation.GetColumnSubscribes:input_type -> creation.v1.GetColumnSubscribesReq
+	178, // 179: creation.v1.Creation.GetUserColumnAgree:input_type -> creation.v1.GetUserColumnAgreeReq
+	179, // 180: creation.v1.Creation.GetUserColumnCollect:input_type -> creation.v1.GetUserColumnCollectReq
+	182, // 181: creation.v1.Creation.GetUserSubscribeColumn:input_type -> creation.v1.GetUserSubscribeColumnReq
+	184, // 182: creation.v1.Creation.SendColumnEdit:input_type -> creation.v1.SendColumnEditReq
+	185, // 183: creation.v1.Creation.EditColumn:input_type -> creation.v1.EditColumnReq
+	186, // 184: creation.v1.Creation.DeleteColumn:input_type -> creation.v1.DeleteColumnReq
+	187, // 185: creation.v1.Creation.DeleteColumnCacheAndSearch:input_type -> creation.v1.DeleteColumnCacheAndSearchReq
+	188, // 186: creation.v1.Creation.ColumnStatisticJudge:input_type -> creation.v1.ColumnStatisticJudgeReq
+	190, // 187: creation.v1.Creation.SetColumnAgree:input_type -> creation.v1.SetColumnAgreeReq
+	191, // 188: creation.v1.Creation.SetColumnAgreeDbAndCache:input_type -> creation.v1.SetColumnAgreeDbAndCacheReq
+	192, // 189: creation.v1.Creation.CancelColumnAgree:input_type -> creation.v1.CancelColumnAgreeReq
+	193, // 190: creation.v1.Creation.CancelColumnAgreeDbAndCache:input_type -> creation.v1.CancelColumnAgreeDbAndCacheReq
+	194, // 191: creation.v1.Creation.SetColumnCollect:input_type -> creation.v1.SetColumnCollectReq
+	195, // 192: creation.v1.Creation.SetColumnCollectDbAndCache:input_type -> creation.v1.SetColumnCollectDbAndCacheReq
+	196, // 193: creation.v1.Creation.CancelColumnCollect:input_type -> creation.v1.CancelColumnCollectReq
+	197, // 194: creation.v1.Creation.CancelColumnCollectDbAndCache:input_type -> creation.v1.CancelColumnCollectDbAndCacheReq
+	198, // 195: creation.v1.Creation.SetColumnView:input_type -> creation.v1.SetColumnViewReq
+	199, // 196: creation.v1.Creation.SetColumnViewDbAndCache:input_type -> creation.v1.SetColumnViewDbAndCacheReq
+	72,  // 197: creation.v1.Creation.AddColumnViewDbAndCache:input_type -> creation.v1.AddCreationViewDbAndCacheReq
+	200, // 198: creation.v1.Creation.AddColumnIncludes:input_type -> creation.v1.AddColumnIncludesReq
+	201, // 199: creation.v1.Creation.AddColumnIncludesDbAndCache:input_type -> creation.v1.AddColumnIncludesDbAndCacheReq
+	202, // 200: creation.v1.Creation.DeleteColumnIncludes:input_type -> creation.v1.DeleteColumnIncludesReq
+	203, // 201: creation.v1.Creation.DeleteColumnIncludesDbAndCache:input_type -> creation.v1.DeleteColumnIncludesDbAndCacheReq
+	204, // 202: creation.v1.Creation.SetColumnSubscribeDbAndCache:input_type -> creation.v1.SetColumnSubscribeDbAndCacheReq
+	205, // 203: creation.v1.Creation.CancelColumnSubscribeDbAndCache:input_type -> creation.v1.CancelColumnSubscribeDbAndCacheReq
+	206, // 204: creation.v1.Creation.GetNews:input_type -> creation.v1.GetNewsReq
+	208, // 205: creation.v1.Creation.GetNewsSearch:input_type -> creation.v1.GetNewsSearchReq
+	210, // 206: creation.v1.Creation.AddCreationComment:input_type -> creation.v1.AddCreationCommentReq
+	211, // 207: creation.v1.Creation.ReduceCreationComment:input_type -> creation.v1.ReduceCreationCommentReq
+	213, // 208: creation.v1.Creation.GetUserData:input_type -> creation.v1.GetUserDataReq
+	212, // 209: creation.v1.Creation.PurgeUserData:input_type -> creation.v1.PurgeUserDataReq
+	215, // 210: creation.v1.Creation.GetUserTags:input_type -> creation.v1.GetUserTagsReq
+	217, // 211: creation.v1.Creation.GetTagAuthors:input_type -> creation.v1.GetTagAuthorsReq
+	219, // 212: creation.v1.Creation.GetAuthorActivity:input_type -> creation.v1.GetAuthorActivityReq
+	260, // 213: creation.v1.Creation.GetHealth:input_type -> google.protobuf.Empty
+	0,   // 214: creation.v1.Creation.GetLeaderBoard:output_type -> creation.v1.GetLeaderBoardReply
+	2,   // 215: creation.v1.Creation.GetLastCollectionsDraft:output_type -> creation.v1.GetLastCollectionsDraftReply
+	4,   // 216: creation.v1.Creation.GetCollectionsContentReview:output_type -> creation.v1.GetCollectionsContentReviewReply
+	41,  // 217: creation.v1.Creation.GetCollectArticleList:output_type -> creation.v1.GetArticleListReply
+	7,   // 218: creation.v1.Creation.GetCollectArticleCount:output_type -> creation.v1.GetCollectArticleCountReply
+	94,  // 219: creation.v1.Creation.GetCollectTalkList:output_type -> creation.v1.GetTalkListReply
+	10,  // 220: creation.v1.Creation.GetCollectTalkCount:output_type -> creation.v1.GetCollectTalkCountReply
+	159, // 221: creation.v1.Creation.GetCollectColumnList:output_type -> creation.v1.GetColumnListReply
+	13,  // 222: creation.v1.Creation.GetCollectColumnCount:output_type -> creation.v1.GetCollectColumnCountReply
+	15,  // 223: creation.v1.Creation.GetCollections:output_type -> creation.v1.GetCollectionsReply
+	18,  // 224: creation.v1.Creation.GetCollectionListInfo:output_type -> creation.v1.GetCollectionsListReply
+	18,  // 225: creation.v1.Creation.GetCollectionsList:output_type -> creation.v1.GetCollectionsListReply
+	18,  // 226: creation.v1.Creation.GetCollectionsListAll:output_type -> creation.v1.GetCollectionsListReply
+	21,  // 227: creation.v1.Creation.GetCollectionsCount:output_type -> creation.v1.GetCollectionsCountReply
+	18,  // 228: creation.v1.Creation.GetCollectionsListByVisitor:output_type -> creation.v1.GetCollectionsListReply
+	21,  // 229: creation.v1.Creation.GetCollectionsVisitorCount:output_type -> creation.v1.GetCollectionsCountReply
+	23,  // 230: creation.v1.Creation.GetCreationUser:output_type -> creation.v1.GetCreationUserReply
+	23,  // 231: creation.v1.Creation.GetCreationUserVisitor:output_type -> creation.v1.GetCreationUserReply
+	25,  // 232: creation.v1.Creation.GetUserTimeLineList:output_type -> creation.v1.GetUserTimeLineListReply
+	260, // 233: creation.v1.Creation.SendCollections:output_type -> google.protobuf.Empty
+	28,  // 234: creation.v1.Creation.CreateCollectionsDraft:output_type -> creation.v1.CreateCollectionsDraftReply
+	260, // 235: creation.v1.Creation.CreateCollections:output_type -> google.protobuf.Empty
+	260, // 236: creation.v1.Creation.CreateCollectionsDbAndCache:output_type -> google.protobuf.Empty
+	260, // 237: creation.v1.Creation.CollectionsContentIrregular:output_type -> google.protobuf.Empty
+	260, // 238: creation.v1.Creation.AddCollectionsContentReviewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 239: creation.v1.Creation.SendCollectionsEdit:output_type -> google.protobuf.Empty
+	260, // 240: creation.v1.Creation.EditCollections:output_type -> google.protobuf.Empty
+	260, // 241: creation.v1.Creation.EditCollectionsCos:output_type -> google.protobuf.Empty
+	260, // 242: creation.v1.Creation.DeleteCollections:output_type -> google.protobuf.Empty
+	260, // 243: creation.v1.Creation.DeleteCollectionsCache:output_type -> google.protobuf.Empty
+	260, // 244: creation.v1.Creation.ArticleImageIrregular:output_type -> google.protobuf.Empty
+	260, // 245: creation.v1.Creation.ArticleContentIrregular:output_type -> google.protobuf.Empty
+	260, // 246: creation.v1.Creation.AddArticleImageReviewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 247: creation.v1.Creation.AddArticleContentReviewDbAndCache:output_type -> google.protobuf.Empty
+	41,  // 248: creation.v1.Creation.GetArticleList:output_type -> creation.v1.GetArticleListReply
+	43,  // 249: creation.v1.Creation.GetArticleListHot:output_type -> creation.v1.GetArticleListHotReply
+	41,  // 250: creation.v1.Creation.GetColumnArticleList:output_type -> creation.v1.GetArticleListReply
+	46,  // 251: creation.v1.Creation.GetArticleCount:output_type -> creation.v1.GetArticleCountReply
+	46,  // 252: creation.v1.Creation.GetArticleCountVisitor:output_type -> creation.v1.GetArticleCountReply
+	41,  // 253: creation.v1.Creation.GetUserArticleList:output_type -> creation.v1.GetArticleListReply
+	41,  // 254: creation.v1.Creation.GetUserArticleListVisitor:output_type -> creation.v1.GetArticleListReply
+	41,  // 255: creation.v1.Creation.GetUserArticleListAll:output_type -> creation.v1.GetArticleListReply
+	51,  // 256: creation.v1.Creation.GetArticleStatistic:output_type -> creation.v1.GetArticleStatisticReply
+	53,  // 257: creation.v1.Creation.GetUserArticleAgree:output_type -> creation.v1.GetUserArticleAgreeReply
+	55,  // 258: creation.v1.Creation.GetUserArticleCollect:output_type -> creation.v1.GetUserArticleCollectReply
+	57,  // 259: creation.v1.Creation.GetArticleListStatistic:output_type -> creation.v1.GetArticleListStatisticReply
+	59,  // 260: creation.v1.Creation.GetLastArticleDraft:output_type -> creation.v1.GetLastArticleDraftReply
+	61,  // 261: creation.v1.Creation.GetArticleSearch:output_type -> creation.v1.GetArticleSearchReply
+	63,  // 262: creation.v1.Creation.GetArticleImageReview:output_type -> creation.v1.GetArticleImageReviewReply
+	65,  // 263: creation.v1.Creation.GetArticleContentReview:output_type -> creation.v1.GetArticleContentReviewReply
+	260, // 264: creation.v1.Creation.CreateArticle:output_type -> google.protobuf.Empty
+	260, // 265: creation.v1.Creation.EditArticle:output_type -> google.protobuf.Empty
+	260, // 266: creation.v1.Creation.CreateArticleDbCacheAndSearch:output_type -> google.protobuf.Empty
+	260, // 267: creation.v1.Creation.EditArticleCosAndSearch:output_type -> google.protobuf.Empty
+	260, // 268: creation.v1.Creation.DeleteArticleCacheAndSearch:output_type -> google.protobuf.Empty
+	260, // 269: creation.v1.Creation.SetArticleViewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 270: creation.v1.Creation.AddArticleViewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 271: creation.v1.Creation.SetArticleAgreeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 272: creation.v1.Creation.SetArticleCollectDbAndCache:output_type -> google.protobuf.Empty
+	76,  // 273: creation.v1.Creation.CreateArticleDraft:output_type -> creation.v1.CreateArticleDraftReply
+	260, // 274: creation.v1.Creation.ArticleDraftMark:output_type -> google.protobuf.Empty
+	79,  // 275: creation.v1.Creation.GetArticleDraftList:output_type -> creation.v1.GetArticleDraftListReply
+	260, // 276: creation.v1.Creation.SendArticle:output_type -> google.protobuf.Empty
+	260, // 277: creation.v1.Creation.SendArticleEdit:output_type -> google.protobuf.Empty
+	260, // 278: creation.v1.Creation.DeleteArticle:output_type -> google.protobuf.Empty
+	260, // 279: creation.v1.Creation.DeleteArticleDraft:output_type -> google.protobuf.Empty
+	260, // 280: creation.v1.Creation.SetArticleAgree:output_type -> google.protobuf.Empty
+	260, // 281: creation.v1.Creation.SetArticleView:output_type -> google.protobuf.Empty
+	260, // 282: creation.v1.Creation.SetArticleCollect:output_type -> google.protobuf.Empty
+	260, // 283: creation.v1.Creation.CancelArticleAgree:output_type -> google.protobuf.Empty
+	260, // 284: creation.v1.Creation.CancelArticleAgreeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 285: creation.v1.Creation.CancelArticleCollect:output_type -> google.protobuf.Empty
+	260, // 286: creation.v1.Creation.CancelArticleCollectDbAndCache:output_type -> google.protobuf.Empty
+	92,  // 287: creation.v1.Creation.ArticleStatisticJudge:output_type -> creation.v1.ArticleStatisticJudgeReply
+	94,  // 288: creation.v1.Creation.GetTalkList:output_type -> creation.v1.GetTalkListReply
+	99,  // 289: creation.v1.Creation.GetTalkListHot:output_type -> creation.v1.GetTalkListHotReply
+	94,  // 290: creation.v1.Creation.GetUserTalkList:output_type -> creation.v1.GetTalkListReply
+	94,  // 291: creation.v1.Creation.GetUserTalkListVisitor:output_type -> creation.v1.GetTalkListReply
+	97,  // 292: creation.v1.Creation.GetTalkCount:output_type -> creation.v1.GetTalkCountReply
+	97,  // 293: creation.v1.Creation.GetTalkCountVisitor:output_type -> creation.v1.GetTalkCountReply
+	103, // 294: creation.v1.Creation.GetTalkListStatistic:output_type -> creation.v1.GetTalkListStatisticReply
+	105, // 295: creation.v1.Creation.GetTalkStatistic:output_type -> creation.v1.GetTalkStatisticReply
+	107, // 296: creation.v1.Creation.GetLastTalkDraft:output_type -> creation.v1.GetLastTalkDraftReply
+	109, // 297: creation.v1.Creation.GetTalkSearch:output_type -> creation.v1.GetTalkSearchReply
+	112, // 298: creation.v1.Creation.GetUserTalkAgree:output_type -> creation.v1.GetUserTalkAgreeReply
+	113, // 299: creation.v1.Creation.GetUserTalkCollect:output_type -> creation.v1.GetUserTalkCollectReply
+	115, // 300: creation.v1.Creation.GetTalkImageReview:output_type -> creation.v1.GetTalkImageReviewReply
+	117, // 301: creation.v1.Creation.GetTalkContentReview:output_type -> creation.v1.GetTalkContentReviewReply
+	260, // 302: creation.v1.Creation.AddTalkImageReviewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 303: creation.v1.Creation.AddTalkContentReviewDbAndCache:output_type -> google.protobuf.Empty
+	119, // 304: creation.v1.Creation.CreateTalkDraft:output_type -> creation.v1.CreateTalkDraftReply
+	260, // 305: creation.v1.Creation.SendTalk:output_type -> google.protobuf.Empty
+	260, // 306: creation.v1.Creation.SendTalkEdit:output_type -> google.protobuf.Empty
+	260, // 307: creation.v1.Creation.TalkImageIrregular:output_type -> google.protobuf.Empty
+	260, // 308: creation.v1.Creation.TalkContentIrregular:output_type -> google.protobuf.Empty
+	260, // 309: creation.v1.Creation.CreateTalk:output_type -> google.protobuf.Empty
+	260, // 310: creation.v1.Creation.EditTalk:output_type -> google.protobuf.Empty
+	260, // 311: creation.v1.Creation.DeleteTalk:output_type -> google.protobuf.Empty
+	260, // 312: creation.v1.Creation.CreateTalkDbCacheAndSearch:output_type -> google.protobuf.Empty
+	260, // 313: creation.v1.Creation.EditTalkCosAndSearch:output_type -> google.protobuf.Empty
+	260, // 314: creation.v1.Creation.DeleteTalkCacheAndSearch:output_type -> google.protobuf.Empty
+	260, // 315: creation.v1.Creation.SetTalkView:output_type -> google.protobuf.Empty
+	260, // 316: creation.v1.Creation.SetTalkViewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 317: creation.v1.Creation.AddTalkViewDbAndCache:output_type -> google.protobuf.Empty
+	131, // 318: creation.v1.Creation.TalkStatisticJudge:output_type -> creation.v1.TalkStatisticJudgeReply
+	260, // 319: creation.v1.Creation.SetTalkAgree:output_type -> google.protobuf.Empty
+	260, // 320: creation.v1.Creation.SetTalkAgreeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 321: creation.v1.Creation.SetTalkCollect:output_type -> google.protobuf.Empty
+	260, // 322: creation.v1.Creation.SetTalkCollectDbAndCache:output_type -> google.protobuf.Empty
+	260, // 323: creation.v1.Creation.CancelTalkAgree:output_type -> google.protobuf.Empty
+	260, // 324: creation.v1.Creation.CancelTalkAgreeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 325: creation.v1.Creation.CancelTalkCollect:output_type -> google.protobuf.Empty
+	260, // 326: creation.v1.Creation.CancelTalkCollectDbAndCache:output_type -> google.protobuf.Empty
+	141, // 327: creation.v1.Creation.GetLastColumnDraft:output_type -> creation.v1.GetLastColumnDraftReply
+	143, // 328: creation.v1.Creation.GetColumnSearch:output_type -> creation.v1.GetColumnSearchReply
+	145, // 329: creation.v1.Creation.GetColumnImageReview:output_type -> creation.v1.GetColumnImageReviewReply
+	147, // 330: creation.v1.Creation.GetColumnContentReview:output_type -> creation.v1.GetColumnContentReviewReply
+	260, // 331: creation.v1.Creation.ColumnImageIrregular:output_type -> google.protobuf.Empty
+	260, // 332: creation.v1.Creation.ColumnContentIrregular:output_type -> google.protobuf.Empty
+	260, // 333: creation.v1.Creation.AddColumnImageReviewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 334: creation.v1.Creation.AddColumnContentReviewDbAndCache:output_type -> google.protobuf.Empty
+	149, // 335: creation.v1.Creation.CreateColumnDraft:output_type -> creation.v1.CreateColumnDraftReply
+	260, // 336: creation.v1.Creation.SendColumn:output_type -> google.protobuf.Empty
+	260, // 337: creation.v1.Creation.CreateColumn:output_type -> google.protobuf.Empty
+	260, // 338: creation.v1.Creation.CreateColumnDbCacheAndSearch:output_type -> google.protobuf.Empty
+	260, // 339: creation.v1.Creation.SubscribeColumn:output_type -> google.protobuf.Empty
+	260, // 340: creation.v1.Creation.CancelSubscribeColumn:output_type -> google.protobuf.Empty
+	156, // 341: creation.v1.Creation.SubscribeJudge:output_type -> creation.v1.SubscribeJudgeReply
+	260, // 342: creation.v1.Creation.EditColumnCosAndSearch:output_type -> google.protobuf.Empty
+	159, // 343: creation.v1.Creation.GetColumnList:output_type -> creation.v1.GetColumnListReply
+	161, // 344: creation.v1.Creation.GetColumnListHot:output_type -> creation.v1.GetColumnListHotReply
+	163, // 345: creation.v1.Creation.GetColumnListStatistic:output_type -> creation.v1.GetColumnListStatisticReply
+	165, // 346: creation.v1.Creation.GetColumnStatistic:output_type -> creation.v1.GetColumnStatisticReply
+	159, // 347: creation.v1.Creation.GetUserColumnList:output_type -> creation.v1.GetColumnListReply
+	159, // 348: creation.v1.Creation.GetUserColumnListVisitor:output_type -> creation.v1.GetColumnListReply
+	171, // 349: creation.v1.Creation.GetColumnCount:output_type -> creation.v1.GetColumnCountReply
+	171, // 350: creation.v1.Creation.GetColumnCountVisitor:output_type -> creation.v1.GetColumnCountReply
+	173, // 351: creation.v1.Creation.GetSubscribeList:output_type -> creation.v1.GetSubscribeListReply
+	175, // 352: creation.v1.Creation.GetSubscribeListCount:output_type -> creation.v1.GetSubscribeListCountReply
+	177, // 353: creation.v1.Creation.GetColumnSubscribes:output_type -> creation.v1.GetColumnSubscribesReply
+	180, // 354: creation.v1.Creation.GetUserColumnAgree:output_type -> creation.v1.GetUserColumnAgreeReply
+	181, // 355: creation.v1.Creation.GetUserColumnCollect:output_type -> creation.v1.GetUserColumnCollectReply
+	183, // 356: creation.v1.Creation.GetUserSubscribeColumn:output_type -> creation.v1.GetUserSubscribeColumnReply
+	260, // 357: creation.v1.Creation.SendColumnEdit:output_type -> google.protobuf.Empty
+	260, // 358: creation.v1.Creation.EditColumn:output_type -> google.protobuf.Empty
+	260, // 359: creation.v1.Creation.DeleteColumn:output_type -> google.protobuf.Empty
+	260, // 360: creation.v1.Creation.DeleteColumnCacheAndSearch:output_type -> google.protobuf.Empty
+	189, // 361: creation.v1.Creation.ColumnStatisticJudge:output_type -> creation.v1.ColumnStatisticJudgeReply
+	260, // 362: creation.v1.Creation.SetColumnAgree:output_type -> google.protobuf.Empty
+	260, // 363: creation.v1.Creation.SetColumnAgreeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 364: creation.v1.Creation.CancelColumnAgree:output_type -> google.protobuf.Empty
+	260, // 365: creation.v1.Creation.CancelColumnAgreeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 366: creation.v1.Creation.SetColumnCollect:output_type -> google.protobuf.Empty
+	260, // 367: creation.v1.Creation.SetColumnCollectDbAndCache:output_type -> google.protobuf.Empty
+	260, // 368: creation.v1.Creation.CancelColumnCollect:output_type -> google.protobuf.Empty
+	260, // 369: creation.v1.Creation.CancelColumnCollectDbAndCache:output_type -> google.protobuf.Empty
+	260, // 370: creation.v1.Creation.SetColumnView:output_type -> google.protobuf.Empty
+	260, // 371: creation.v1.Creation.SetColumnViewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 372: creation.v1.Creation.AddColumnViewDbAndCache:output_type -> google.protobuf.Empty
+	260, // 373: creation.v1.Creation.AddColumnIncludes:output_type -> google.protobuf.Empty
+	260, // 374: creation.v1.Creation.AddColumnIncludesDbAndCache:output_type -> google.protobuf.Empty
+	260, // 375: creation.v1.Creation.DeleteColumnIncludes:output_type -> google.protobuf.Empty
+	260, // 376: creation.v1.Creation.DeleteColumnIncludesDbAndCache:output_type -> google.protobuf.Empty
+	260, // 377: creation.v1.Creation.SetColumnSubscribeDbAndCache:output_type -> google.protobuf.Empty
+	260, // 378: creation.v1.Creation.CancelColumnSubscribeDbAndCache:output_type -> google.protobuf.Empty
+	207, // 379: creation.v1.Creation.GetNews:output_type -> creation.v1.GetNewsReply
+	209, // 380: creation.v1.Creation.GetNewsSearch:output_type -> creation.v1.GetNewsSearchReply
+	260, // 381: creation.v1.Creation.AddCreationComment:output_type -> google.protobuf.Empty
+	260, // 382: creation.v1.Creation.ReduceCreationComment:output_type -> google.protobuf.Empty
+	214, // 383: creation.v1.Creation.GetUserData:output_type -> creation.v1.GetUserDataReply
+	260, // 384: creation.v1.Creation.PurgeUserData:output_type -> google.protobuf.Empty
+	216, // 385: creation.v1.Creation.GetUserTags:output_type -> creation.v1.GetUserTagsReply
+	218, // 386: creation.v1.Creation.GetTagAuthors:output_type -> creation.v1.GetTagAuthorsReply
+	220, // 387: creation.v1.Creation.GetAuthorActivity:output_type -> creation.v1.GetAuthorActivityReply
+	260, // 388: creation.v1.Creation.GetHealth:output_type -> google.protobuf.Empty
+	214, // [214:389] is the sub-list for method output_type
+	39,  // [39:214] is the sub-list for method input_type
+	39,  // [39:39] is the sub-list for extension type_name
+	39,  // [39:39] is the sub-list for extension extendee
+	0,   // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_creation_service_v1_creation_proto_init() }
@@ -18069,7 +18897,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetArticleAgreeDbAndCacheReq); i {
+			switch v := v.(*AddCreationViewDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18081,7 +18909,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetArticleCollectDbAndCacheReq); i {
+			switch v := v.(*SetArticleAgreeDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18093,7 +18921,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateArticleDraftReq); i {
+			switch v := v.(*SetArticleCollectDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18105,7 +18933,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateArticleDraftReply); i {
+			switch v := v.(*CreateArticleDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18117,7 +18945,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleDraftMarkReq); i {
+			switch v := v.(*CreateArticleDraftReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18129,7 +18957,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArticleDraftListReq); i {
+			switch v := v.(*ArticleDraftMarkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18141,7 +18969,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArticleDraftListReply); i {
+			switch v := v.(*GetArticleDraftListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18153,7 +18981,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendArticleReq); i {
+			switch v := v.(*GetArticleDraftListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18165,7 +18993,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendArticleEditReq); i {
+			switch v := v.(*SendArticleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18177,7 +19005,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteArticleReq); i {
+			switch v := v.(*SendArticleEditReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18189,7 +19017,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteArticleDraftReq); i {
+			switch v := v.(*DeleteArticleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18201,7 +19029,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetArticleAgreeReq); i {
+			switch v := v.(*DeleteArticleDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18213,7 +19041,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetArticleViewReq); i {
+			switch v := v.(*SetArticleAgreeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18225,7 +19053,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetArticleCollectReq); i {
+			switch v := v.(*SetArticleViewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18237,7 +19065,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelArticleAgreeReq); i {
+			switch v := v.(*SetArticleCollectReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18249,7 +19077,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelArticleAgreeDbAndCacheReq); i {
+			switch v := v.(*CancelArticleAgreeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18261,7 +19089,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelArticleCollectReq); i {
+			switch v := v.(*CancelArticleAgreeDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18273,7 +19101,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelArticleCollectDbAndCacheReq); i {
+			switch v := v.(*CancelArticleCollectReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18285,7 +19113,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleStatisticJudgeReq); i {
+			switch v := v.(*CancelArticleCollectDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18297,7 +19125,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArticleStatisticJudgeReply); i {
+			switch v := v.(*ArticleStatisticJudgeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18309,7 +19137,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkListReq); i {
+			switch v := v.(*ArticleStatisticJudgeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18321,7 +19149,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkListReply); i {
+			switch v := v.(*GetTalkListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18333,7 +19161,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkCountReq); i {
+			switch v := v.(*GetTalkListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18345,7 +19173,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkCountVisitorReq); i {
+			switch v := v.(*GetTalkCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18357,7 +19185,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkCountReply); i {
+			switch v := v.(*GetTalkCountVisitorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18369,7 +19197,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkListHotReq); i {
+			switch v := v.(*GetTalkCountReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18381,7 +19209,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkListHotReply); i {
+			switch v := v.(*GetTalkListHotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18393,7 +19221,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTalkListReq); i {
+			switch v := v.(*GetTalkListHotReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18405,7 +19233,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTalkListVisitorReq); i {
+			switch v := v.(*GetUserTalkListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18417,7 +19245,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkListStatisticReq); i {
+			switch v := v.(*GetUserTalkListVisitorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18429,7 +19257,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkListStatisticReply); i {
+			switch v := v.(*GetTalkListStatisticReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18441,7 +19269,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkStatisticReq); i {
+			switch v := v.(*GetTalkListStatisticReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18453,7 +19281,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkStatisticReply); i {
+			switch v := v.(*GetTalkStatisticReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18465,7 +19293,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastTalkDraftReq); i {
+			switch v := v.(*GetTalkStatisticReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18477,7 +19305,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastTalkDraftReply); i {
+			switch v := v.(*GetLastTalkDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18489,7 +19317,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkSearchReq); i {
+			switch v := v.(*GetLastTalkDraftReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18501,7 +19329,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkSearchReply); i {
+			switch v := v.(*GetTalkSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18513,7 +19341,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTalkAgreeReq); i {
+			switch v := v.(*GetTalkSearchReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18525,7 +19353,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTalkCollectReq); i {
+			switch v := v.(*GetUserTalkAgreeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18537,7 +19365,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTalkAgreeReply); i {
+			switch v := v.(*GetUserTalkCollectReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18549,7 +19377,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTalkCollectReply); i {
+			switch v := v.(*GetUserTalkAgreeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18561,7 +19389,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkImageReviewReq); i {
+			switch v := v.(*GetUserTalkCollectReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18573,7 +19401,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkImageReviewReply); i {
+			switch v := v.(*GetTalkImageReviewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18585,7 +19413,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkContentReviewReq); i {
+			switch v := v.(*GetTalkImageReviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18597,7 +19425,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTalkContentReviewReply); i {
+			switch v := v.(*GetTalkContentReviewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18609,7 +19437,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTalkDraftReq); i {
+			switch v := v.(*GetTalkContentReviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18621,7 +19449,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTalkDraftReply); i {
+			switch v := v.(*CreateTalkDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18633,7 +19461,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTalkReq); i {
+			switch v := v.(*CreateTalkDraftReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18645,7 +19473,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTalkEditReq); i {
+			switch v := v.(*SendTalkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18657,7 +19485,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTalkReq); i {
+			switch v := v.(*SendTalkEditReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18669,7 +19497,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditTalkReq); i {
+			switch v := v.(*CreateTalkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18681,7 +19509,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTalkReq); i {
+			switch v := v.(*EditTalkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18693,7 +19521,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTalkDbCacheAndSearchReq); i {
+			switch v := v.(*DeleteTalkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18705,7 +19533,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditTalkCosAndSearchReq); i {
+			switch v := v.(*CreateTalkDbCacheAndSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18717,7 +19545,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTalkCacheAndSearchReq); i {
+			switch v := v.(*EditTalkCosAndSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18729,7 +19557,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTalkViewReq); i {
+			switch v := v.(*DeleteTalkCacheAndSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18741,7 +19569,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTalkViewDbAndCacheReq); i {
+			switch v := v.(*SetTalkViewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18753,7 +19581,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TalkStatisticJudgeReq); i {
+			switch v := v.(*SetTalkViewDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18765,7 +19593,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TalkStatisticJudgeReply); i {
+			switch v := v.(*TalkStatisticJudgeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18777,7 +19605,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTalkAgreeReq); i {
+			switch v := v.(*TalkStatisticJudgeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18789,7 +19617,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTalkAgreeDbAndCacheReq); i {
+			switch v := v.(*SetTalkAgreeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18801,7 +19629,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTalkCollectReq); i {
+			switch v := v.(*SetTalkAgreeDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18813,7 +19641,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTalkCollectDbAndCacheReq); i {
+			switch v := v.(*SetTalkCollectReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18825,7 +19653,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelTalkAgreeReq); i {
+			switch v := v.(*SetTalkCollectDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18837,7 +19665,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelTalkAgreeDbAndCacheReq); i {
+			switch v := v.(*CancelTalkAgreeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18849,7 +19677,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelTalkCollectReq); i {
+			switch v := v.(*CancelTalkAgreeDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18861,7 +19689,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelTalkCollectDbAndCacheReq); i {
+			switch v := v.(*CancelTalkCollectReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18873,7 +19701,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastColumnDraftReq); i {
+			switch v := v.(*CancelTalkCollectDbAndCacheReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18885,7 +19713,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastColumnDraftReply); i {
+			switch v := v.(*GetLastColumnDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18897,7 +19725,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnSearchReq); i {
+			switch v := v.(*GetLastColumnDraftReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18909,7 +19737,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnSearchReply); i {
+			switch v := v.(*GetColumnSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18921,7 +19749,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnImageReviewReq); i {
+			switch v := v.(*GetColumnSearchReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18933,7 +19761,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnImageReviewReply); i {
+			switch v := v.(*GetColumnImageReviewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18945,7 +19773,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnContentReviewReq); i {
+			switch v := v.(*GetColumnImageReviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18957,7 +19785,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnContentReviewReply); i {
+			switch v := v.(*GetColumnContentReviewReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18969,7 +19797,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateColumnDraftReq); i {
+			switch v := v.(*GetColumnContentReviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18981,7 +19809,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateColumnDraftReply); i {
+			switch v := v.(*CreateColumnDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -18993,7 +19821,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendColumnReq); i {
+			switch v := v.(*CreateColumnDraftReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19005,7 +19833,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateColumnReq); i {
+			switch v := v.(*SendColumnReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19017,7 +19845,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateColumnDbCacheAndSearchReq); i {
+			switch v := v.(*CreateColumnReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19029,7 +19857,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeColumnReq); i {
+			switch v := v.(*CreateColumnDbCacheAndSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19041,7 +19869,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSubscribeColumnReq); i {
+			switch v := v.(*SubscribeColumnReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19053,7 +19881,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeJudgeReq); i {
+			switch v := v.(*CancelSubscribeColumnReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19065,7 +19893,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeJudgeReply); i {
+			switch v := v.(*SubscribeJudgeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19077,7 +19905,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditColumnCosAndSearchReq); i {
+			switch v := v.(*SubscribeJudgeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19089,7 +19917,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnListReq); i {
+			switch v := v.(*EditColumnCosAndSearchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19101,7 +19929,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnListReply); i {
+			switch v := v.(*GetColumnListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19113,7 +19941,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnListHotReq); i {
+			switch v := v.(*GetColumnListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19125,7 +19953,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnListHotReply); i {
+			switch v := v.(*GetColumnListHotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19137,7 +19965,7 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColumnListStatisticReq); i {
+			switch v := v.(*GetColumnListHotReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -19149,6 +19977,18 @@ func file_creation_service_v1_creation_proto_init() {
 			}
 		}
 		file_creation_service_v1_creation_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetColumnListStatisticReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnListStatisticReply); i {
 			case 0:
 				return &v.state
@@ -19160,7 +20000,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnStatisticReq); i {
 			case 0:
 				return &v.state
@@ -19172,7 +20012,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnStatisticReply); i {
 			case 0:
 				return &v.state
@@ -19184,7 +20024,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserColumnListReq); i {
 			case 0:
 				return &v.state
@@ -19196,7 +20036,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserColumnListVisitorReq); i {
 			case 0:
 				return &v.state
@@ -19208,7 +20048,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnArticleListReq); i {
 			case 0:
 				return &v.state
@@ -19220,7 +20060,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnCountReq); i {
 			case 0:
 				return &v.state
@@ -19232,7 +20072,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnCountVisitorReq); i {
 			case 0:
 				return &v.state
@@ -19244,7 +20084,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnCountReply); i {
 			case 0:
 				return &v.state
@@ -19256,7 +20096,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubscribeListReq); i {
 			case 0:
 				return &v.state
@@ -19268,7 +20108,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubscribeListReply); i {
 			case 0:
 				return &v.state
@@ -19280,7 +20120,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubscribeListCountReq); i {
 			case 0:
 				return &v.state
@@ -19292,7 +20132,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubscribeListCountReply); i {
 			case 0:
 				return &v.state
@@ -19304,7 +20144,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnSubscribesReq); i {
 			case 0:
 				return &v.state
@@ -19316,7 +20156,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnSubscribesReply); i {
 			case 0:
 				return &v.state
@@ -19328,7 +20168,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserColumnAgreeReq); i {
 			case 0:
 				return &v.state
@@ -19340,7 +20180,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserColumnCollectReq); i {
 			case 0:
 				return &v.state
@@ -19352,7 +20192,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserColumnAgreeReply); i {
 			case 0:
 				return &v.state
@@ -19364,7 +20204,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserColumnCollectReply); i {
 			case 0:
 				return &v.state
@@ -19376,7 +20216,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserSubscribeColumnReq); i {
 			case 0:
 				return &v.state
@@ -19388,7 +20228,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserSubscribeColumnReply); i {
 			case 0:
 				return &v.state
@@ -19400,7 +20240,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendColumnEditReq); i {
 			case 0:
 				return &v.state
@@ -19412,7 +20252,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EditColumnReq); i {
 			case 0:
 				return &v.state
@@ -19424,7 +20264,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteColumnReq); i {
 			case 0:
 				return &v.state
@@ -19436,7 +20276,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteColumnCacheAndSearchReq); i {
 			case 0:
 				return &v.state
@@ -19448,7 +20288,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ColumnStatisticJudgeReq); i {
 			case 0:
 				return &v.state
@@ -19460,7 +20300,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ColumnStatisticJudgeReply); i {
 			case 0:
 				return &v.state
@@ -19472,7 +20312,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnAgreeReq); i {
 			case 0:
 				return &v.state
@@ -19484,7 +20324,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnAgreeDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19496,7 +20336,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelColumnAgreeReq); i {
 			case 0:
 				return &v.state
@@ -19508,7 +20348,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelColumnAgreeDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19520,7 +20360,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnCollectReq); i {
 			case 0:
 				return &v.state
@@ -19532,7 +20372,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnCollectDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19544,7 +20384,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelColumnCollectReq); i {
 			case 0:
 				return &v.state
@@ -19556,7 +20396,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelColumnCollectDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19568,7 +20408,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnViewReq); i {
 			case 0:
 				return &v.state
@@ -19580,7 +20420,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnViewDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19592,7 +20432,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddColumnIncludesReq); i {
 			case 0:
 				return &v.state
@@ -19604,7 +20444,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddColumnIncludesDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19616,7 +20456,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteColumnIncludesReq); i {
 			case 0:
 				return &v.state
@@ -19628,7 +20468,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteColumnIncludesDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19640,7 +20480,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetColumnSubscribeDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19652,7 +20492,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelColumnSubscribeDbAndCacheReq); i {
 			case 0:
 				return &v.state
@@ -19664,7 +20504,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNewsReq); i {
 			case 0:
 				return &v.state
@@ -19676,7 +20516,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNewsReply); i {
 			case 0:
 				return &v.state
@@ -19688,7 +20528,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNewsSearchReq); i {
 			case 0:
 				return &v.state
@@ -19700,7 +20540,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNewsSearchReply); i {
 			case 0:
 				return &v.state
@@ -19712,7 +20552,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddCreationCommentReq); i {
 			case 0:
 				return &v.state
@@ -19724,7 +20564,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReduceCreationCommentReq); i {
 			case 0:
 				return &v.state
@@ -19736,7 +20576,115 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeUserDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserTagsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserTagsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[217].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagAuthorsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[218].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagAuthorsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorActivityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorActivityReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLeaderBoardReply_Board); i {
 			case 0:
 				return &v.state
@@ -19748,7 +20696,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCollectionsContentReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19760,7 +20708,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[223].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCollectionsListReply_Collections); i {
 			case 0:
 				return &v.state
@@ -19772,7 +20720,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[224].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserTimeLineListReply_TimeLine); i {
 			case 0:
 				return &v.state
@@ -19784,7 +20732,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[225].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleListReply_Article); i {
 			case 0:
 				return &v.state
@@ -19796,7 +20744,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[226].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleListHotReply_Article); i {
 			case 0:
 				return &v.state
@@ -19808,7 +20756,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[229].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleListStatisticReply_Count); i {
 			case 0:
 				return &v.state
@@ -19820,7 +20768,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[230].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleSearchReply_List); i {
 			case 0:
 				return &v.state
@@ -19832,7 +20780,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[231].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleImageReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19844,7 +20792,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[232].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleContentReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19856,7 +20804,19 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[223].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[233].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCreationViewDbAndCacheReq_View); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_creation_service_v1_creation_proto_msgTypes[234].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetArticleDraftListReply_Draft); i {
 			case 0:
 				return &v.state
@@ -19868,7 +20828,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[224].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[235].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTalkListReply_Talk); i {
 			case 0:
 				return &v.state
@@ -19880,7 +20840,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[225].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[236].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTalkListHotReply_Talk); i {
 			case 0:
 				return &v.state
@@ -19892,7 +20852,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[226].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[237].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTalkListStatisticReply_Count); i {
 			case 0:
 				return &v.state
@@ -19904,7 +20864,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[227].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[238].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTalkSearchReply_List); i {
 			case 0:
 				return &v.state
@@ -19916,7 +20876,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[230].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[241].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTalkImageReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19928,7 +20888,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[231].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[242].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTalkContentReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19940,7 +20900,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[232].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[243].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnSearchReply_List); i {
 			case 0:
 				return &v.state
@@ -19952,7 +20912,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[233].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[244].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnImageReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19964,7 +20924,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[234].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[245].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnContentReviewReply_Review); i {
 			case 0:
 				return &v.state
@@ -19976,7 +20936,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[235].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[246].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnListReply_Column); i {
 			case 0:
 				return &v.state
@@ -19988,7 +20948,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[236].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[247].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnListHotReply_Column); i {
 			case 0:
 				return &v.state
@@ -20000,7 +20960,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[237].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[248].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnListStatisticReply_Count); i {
 			case 0:
 				return &v.state
@@ -20012,7 +20972,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[238].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[249].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubscribeListReply_Subscribe); i {
 			case 0:
 				return &v.state
@@ -20024,7 +20984,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[239].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[250].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetColumnSubscribesReply_Subscribes); i {
 			case 0:
 				return &v.state
@@ -20036,7 +20996,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[243].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[254].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNewsReply_News); i {
 			case 0:
 				return &v.state
@@ -20048,7 +21008,7 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
-		file_creation_service_v1_creation_proto_msgTypes[244].Exporter = func(v interface{}, i int) interface{} {
+		file_creation_service_v1_creation_proto_msgTypes[255].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNewsSearchReply_List); i {
 			case 0:
 				return &v.state
@@ -20060,6 +21020,18 @@ func file_creation_service_v1_creation_proto_init() {
 				return nil
 			}
 		}
+		file_creation_service_v1_creation_proto_msgTypes[256].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataReply_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -20067,7 +21039,7 @@ func file_creation_service_v1_creation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_creation_service_v1_creation_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   245,
+			NumMessages:   260,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
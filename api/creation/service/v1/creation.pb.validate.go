@@ -6199,6 +6199,8 @@ func (m *GetArticleStatisticReply) validate(all bool) error {
 
 	// no validation rules for Comment
 
+	// no validation rules for RawView
+
 	if len(errors) > 0 {
 		return GetArticleStatisticReplyMultiError(errors)
 	}
@@ -8615,6 +8617,143 @@ var _ interface {
 	ErrorName() string
 } = SetArticleViewDbAndCacheReqValidationError{}
 
+// Validate checks the field values on AddCreationViewDbAndCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCreationViewDbAndCacheReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCreationViewDbAndCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCreationViewDbAndCacheReqMultiError, or nil if none found.
+func (m *AddCreationViewDbAndCacheReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCreationViewDbAndCacheReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetView() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddCreationViewDbAndCacheReqValidationError{
+						field:  fmt.Sprintf("View[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddCreationViewDbAndCacheReqValidationError{
+						field:  fmt.Sprintf("View[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddCreationViewDbAndCacheReqValidationError{
+					field:  fmt.Sprintf("View[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddCreationViewDbAndCacheReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCreationViewDbAndCacheReqMultiError is an error wrapping multiple
+// validation errors returned by AddCreationViewDbAndCacheReq.ValidateAll() if
+// the designated constraints aren't met.
+type AddCreationViewDbAndCacheReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCreationViewDbAndCacheReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCreationViewDbAndCacheReqMultiError) AllErrors() []error { return m }
+
+// AddCreationViewDbAndCacheReqValidationError is the validation error returned
+// by AddCreationViewDbAndCacheReq.Validate if the designated constraints
+// aren't met.
+type AddCreationViewDbAndCacheReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCreationViewDbAndCacheReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCreationViewDbAndCacheReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCreationViewDbAndCacheReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCreationViewDbAndCacheReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCreationViewDbAndCacheReqValidationError) ErrorName() string {
+	return "AddCreationViewDbAndCacheReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCreationViewDbAndCacheReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCreationViewDbAndCacheReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCreationViewDbAndCacheReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCreationViewDbAndCacheReqValidationError{}
+
 // Validate checks the field values on SetArticleAgreeDbAndCacheReq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -10073,6 +10212,10 @@ func (m *SetArticleViewReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for UserUuid
+
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
 		return SetArticleViewReqMultiError(errors)
 	}
@@ -15107,6 +15250,10 @@ func (m *SetTalkViewReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for UserUuid
+
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
 		return SetTalkViewReqMultiError(errors)
 	}
@@ -23277,6 +23424,10 @@ func (m *SetColumnViewReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for UserUuid
+
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
 		return SetColumnViewReqMultiError(errors)
 	}
@@ -24853,48 +25004,53 @@ var _ interface {
 
 var _ReduceCreationCommentReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetLeaderBoardReply_Board with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLeaderBoardReply_Board) Validate() error {
+// Validate checks the field values on PurgeUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserDataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLeaderBoardReply_Board with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PurgeUserDataReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLeaderBoardReply_BoardMultiError, or nil if none found.
-func (m *GetLeaderBoardReply_Board) ValidateAll() error {
+// PurgeUserDataReqMultiError, or nil if none found.
+func (m *PurgeUserDataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLeaderBoardReply_Board) validate(all bool) error {
+func (m *PurgeUserDataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Uuid
-
-	// no validation rules for Mode
+	if !_PurgeUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := PurgeUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetLeaderBoardReply_BoardMultiError(errors)
+		return PurgeUserDataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLeaderBoardReply_BoardMultiError is an error wrapping multiple validation
-// errors returned by GetLeaderBoardReply_Board.ValidateAll() if the
-// designated constraints aren't met.
-type GetLeaderBoardReply_BoardMultiError []error
+// PurgeUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by PurgeUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeUserDataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLeaderBoardReply_BoardMultiError) Error() string {
+func (m PurgeUserDataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -24903,11 +25059,11 @@ func (m GetLeaderBoardReply_BoardMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLeaderBoardReply_BoardMultiError) AllErrors() []error { return m }
+func (m PurgeUserDataReqMultiError) AllErrors() []error { return m }
 
-// GetLeaderBoardReply_BoardValidationError is the validation error returned by
-// GetLeaderBoardReply_Board.Validate if the designated constraints aren't met.
-type GetLeaderBoardReply_BoardValidationError struct {
+// PurgeUserDataReqValidationError is the validation error returned by
+// PurgeUserDataReq.Validate if the designated constraints aren't met.
+type PurgeUserDataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -24915,24 +25071,22 @@ type GetLeaderBoardReply_BoardValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLeaderBoardReply_BoardValidationError) Field() string { return e.field }
+func (e PurgeUserDataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLeaderBoardReply_BoardValidationError) Reason() string { return e.reason }
+func (e PurgeUserDataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLeaderBoardReply_BoardValidationError) Cause() error { return e.cause }
+func (e PurgeUserDataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLeaderBoardReply_BoardValidationError) Key() bool { return e.key }
+func (e PurgeUserDataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLeaderBoardReply_BoardValidationError) ErrorName() string {
-	return "GetLeaderBoardReply_BoardValidationError"
-}
+func (e PurgeUserDataReqValidationError) ErrorName() string { return "PurgeUserDataReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetLeaderBoardReply_BoardValidationError) Error() string {
+func (e PurgeUserDataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -24944,14 +25098,14 @@ func (e GetLeaderBoardReply_BoardValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLeaderBoardReply_Board.%s: %s%s",
+		"invalid %sPurgeUserDataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLeaderBoardReply_BoardValidationError{}
+var _ error = PurgeUserDataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -24959,67 +25113,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLeaderBoardReply_BoardValidationError{}
+} = PurgeUserDataReqValidationError{}
 
-// Validate checks the field values on GetCollectionsContentReviewReply_Review
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetCollectionsContentReviewReply_Review) Validate() error {
+var _PurgeUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetCollectionsContentReviewReply_Review with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetCollectionsContentReviewReply_ReviewMultiError, or nil if none found.
-func (m *GetCollectionsContentReviewReply_Review) ValidateAll() error {
+// ValidateAll checks the field values on GetUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserDataReqMultiError,
+// or nil if none found.
+func (m *GetUserDataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCollectionsContentReviewReply_Review) validate(all bool) error {
+func (m *GetUserDataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for CreationId
-
-	// no validation rules for Title
-
-	// no validation rules for Kind
-
-	// no validation rules for Uuid
-
-	// no validation rules for CreateAt
-
-	// no validation rules for JobId
-
-	// no validation rules for Label
-
-	// no validation rules for Result
-
-	// no validation rules for Section
+	if !_GetUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetCollectionsContentReviewReply_ReviewMultiError(errors)
+		return GetUserDataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCollectionsContentReviewReply_ReviewMultiError is an error wrapping
-// multiple validation errors returned by
-// GetCollectionsContentReviewReply_Review.ValidateAll() if the designated
-// constraints aren't met.
-type GetCollectionsContentReviewReply_ReviewMultiError []error
+// GetUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCollectionsContentReviewReply_ReviewMultiError) Error() string {
+func (m GetUserDataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -25028,12 +25172,11 @@ func (m GetCollectionsContentReviewReply_ReviewMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCollectionsContentReviewReply_ReviewMultiError) AllErrors() []error { return m }
+func (m GetUserDataReqMultiError) AllErrors() []error { return m }
 
-// GetCollectionsContentReviewReply_ReviewValidationError is the validation
-// error returned by GetCollectionsContentReviewReply_Review.Validate if the
-// designated constraints aren't met.
-type GetCollectionsContentReviewReply_ReviewValidationError struct {
+// GetUserDataReqValidationError is the validation error returned by
+// GetUserDataReq.Validate if the designated constraints aren't met.
+type GetUserDataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -25041,24 +25184,22 @@ type GetCollectionsContentReviewReply_ReviewValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCollectionsContentReviewReply_ReviewValidationError) Field() string { return e.field }
+func (e GetUserDataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCollectionsContentReviewReply_ReviewValidationError) Reason() string { return e.reason }
+func (e GetUserDataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCollectionsContentReviewReply_ReviewValidationError) Cause() error { return e.cause }
+func (e GetUserDataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCollectionsContentReviewReply_ReviewValidationError) Key() bool { return e.key }
+func (e GetUserDataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCollectionsContentReviewReply_ReviewValidationError) ErrorName() string {
-	return "GetCollectionsContentReviewReply_ReviewValidationError"
-}
+func (e GetUserDataReqValidationError) ErrorName() string { return "GetUserDataReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCollectionsContentReviewReply_ReviewValidationError) Error() string {
+func (e GetUserDataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -25070,14 +25211,14 @@ func (e GetCollectionsContentReviewReply_ReviewValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCollectionsContentReviewReply_Review.%s: %s%s",
+		"invalid %sGetUserDataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCollectionsContentReviewReply_ReviewValidationError{}
+var _ error = GetUserDataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -25085,41 +25226,1058 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCollectionsContentReviewReply_ReviewValidationError{}
+} = GetUserDataReqValidationError{}
 
-// Validate checks the field values on GetCollectionsListReply_Collections with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetCollectionsListReply_Collections) Validate() error {
+var _GetUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCollectionsListReply_Collections
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetCollectionsListReply_CollectionsMultiError, or nil if none found.
-func (m *GetCollectionsListReply_Collections) ValidateAll() error {
+// ValidateAll checks the field values on GetUserDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReplyMultiError, or nil if none found.
+func (m *GetUserDataReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCollectionsListReply_Collections) validate(all bool) error {
+func (m *GetUserDataReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if len(errors) > 0 {
-		return GetCollectionsListReply_CollectionsMultiError(errors)
-	}
-
-	return nil
-}
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
 
-// GetCollectionsListReply_CollectionsMultiError is an error wrapping multiple
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserDataReplyValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserDataReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReplyMultiError) AllErrors() []error { return m }
+
+// GetUserDataReplyValidationError is the validation error returned by
+// GetUserDataReply.Validate if the designated constraints aren't met.
+type GetUserDataReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReplyValidationError) ErrorName() string { return "GetUserDataReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReplyValidationError{}
+
+// Validate checks the field values on GetUserTagsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserTagsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserTagsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserTagsReqMultiError,
+// or nil if none found.
+func (m *GetUserTagsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserTagsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserTagsReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserTagsReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserTagsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserTagsReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserTagsReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserTagsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserTagsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserTagsReqMultiError) AllErrors() []error { return m }
+
+// GetUserTagsReqValidationError is the validation error returned by
+// GetUserTagsReq.Validate if the designated constraints aren't met.
+type GetUserTagsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserTagsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserTagsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserTagsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserTagsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserTagsReqValidationError) ErrorName() string { return "GetUserTagsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserTagsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserTagsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserTagsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserTagsReqValidationError{}
+
+var _GetUserTagsReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserTagsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserTagsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserTagsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserTagsReplyMultiError, or nil if none found.
+func (m *GetUserTagsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserTagsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tags
+
+	if len(errors) > 0 {
+		return GetUserTagsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserTagsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserTagsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserTagsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserTagsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserTagsReplyMultiError) AllErrors() []error { return m }
+
+// GetUserTagsReplyValidationError is the validation error returned by
+// GetUserTagsReply.Validate if the designated constraints aren't met.
+type GetUserTagsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserTagsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserTagsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserTagsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserTagsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserTagsReplyValidationError) ErrorName() string { return "GetUserTagsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserTagsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserTagsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserTagsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserTagsReplyValidationError{}
+
+// Validate checks the field values on GetTagAuthorsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTagAuthorsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagAuthorsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagAuthorsReqMultiError, or nil if none found.
+func (m *GetTagAuthorsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagAuthorsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return GetTagAuthorsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagAuthorsReqMultiError is an error wrapping multiple validation errors
+// returned by GetTagAuthorsReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetTagAuthorsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagAuthorsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagAuthorsReqMultiError) AllErrors() []error { return m }
+
+// GetTagAuthorsReqValidationError is the validation error returned by
+// GetTagAuthorsReq.Validate if the designated constraints aren't met.
+type GetTagAuthorsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagAuthorsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagAuthorsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagAuthorsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagAuthorsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagAuthorsReqValidationError) ErrorName() string { return "GetTagAuthorsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTagAuthorsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagAuthorsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagAuthorsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagAuthorsReqValidationError{}
+
+// Validate checks the field values on GetTagAuthorsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagAuthorsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagAuthorsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagAuthorsReplyMultiError, or nil if none found.
+func (m *GetTagAuthorsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagAuthorsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Authors
+
+	if len(errors) > 0 {
+		return GetTagAuthorsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagAuthorsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTagAuthorsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTagAuthorsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagAuthorsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagAuthorsReplyMultiError) AllErrors() []error { return m }
+
+// GetTagAuthorsReplyValidationError is the validation error returned by
+// GetTagAuthorsReply.Validate if the designated constraints aren't met.
+type GetTagAuthorsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagAuthorsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagAuthorsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagAuthorsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagAuthorsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagAuthorsReplyValidationError) ErrorName() string {
+	return "GetTagAuthorsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagAuthorsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagAuthorsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagAuthorsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagAuthorsReplyValidationError{}
+
+// Validate checks the field values on GetAuthorActivityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorActivityReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorActivityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorActivityReqMultiError, or nil if none found.
+func (m *GetAuthorActivityReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorActivityReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUuids() {
+		_, _ = idx, item
+
+		if !_GetAuthorActivityReq_Uuids_Pattern.MatchString(item) {
+			err := GetAuthorActivityReqValidationError{
+				field:  fmt.Sprintf("Uuids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Days
+
+	if len(errors) > 0 {
+		return GetAuthorActivityReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorActivityReqMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorActivityReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorActivityReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorActivityReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorActivityReqMultiError) AllErrors() []error { return m }
+
+// GetAuthorActivityReqValidationError is the validation error returned by
+// GetAuthorActivityReq.Validate if the designated constraints aren't met.
+type GetAuthorActivityReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorActivityReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorActivityReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorActivityReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorActivityReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorActivityReqValidationError) ErrorName() string {
+	return "GetAuthorActivityReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorActivityReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorActivityReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorActivityReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorActivityReqValidationError{}
+
+var _GetAuthorActivityReq_Uuids_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetAuthorActivityReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorActivityReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorActivityReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorActivityReplyMultiError, or nil if none found.
+func (m *GetAuthorActivityReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorActivityReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Activity
+
+	if len(errors) > 0 {
+		return GetAuthorActivityReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorActivityReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorActivityReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorActivityReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorActivityReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorActivityReplyMultiError) AllErrors() []error { return m }
+
+// GetAuthorActivityReplyValidationError is the validation error returned by
+// GetAuthorActivityReply.Validate if the designated constraints aren't met.
+type GetAuthorActivityReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorActivityReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorActivityReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorActivityReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorActivityReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorActivityReplyValidationError) ErrorName() string {
+	return "GetAuthorActivityReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorActivityReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorActivityReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorActivityReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorActivityReplyValidationError{}
+
+// Validate checks the field values on GetLeaderBoardReply_Board with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLeaderBoardReply_Board) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeaderBoardReply_Board with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLeaderBoardReply_BoardMultiError, or nil if none found.
+func (m *GetLeaderBoardReply_Board) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeaderBoardReply_Board) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return GetLeaderBoardReply_BoardMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeaderBoardReply_BoardMultiError is an error wrapping multiple validation
+// errors returned by GetLeaderBoardReply_Board.ValidateAll() if the
+// designated constraints aren't met.
+type GetLeaderBoardReply_BoardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeaderBoardReply_BoardMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeaderBoardReply_BoardMultiError) AllErrors() []error { return m }
+
+// GetLeaderBoardReply_BoardValidationError is the validation error returned by
+// GetLeaderBoardReply_Board.Validate if the designated constraints aren't met.
+type GetLeaderBoardReply_BoardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeaderBoardReply_BoardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeaderBoardReply_BoardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeaderBoardReply_BoardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeaderBoardReply_BoardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeaderBoardReply_BoardValidationError) ErrorName() string {
+	return "GetLeaderBoardReply_BoardValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeaderBoardReply_BoardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeaderBoardReply_Board.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeaderBoardReply_BoardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeaderBoardReply_BoardValidationError{}
+
+// Validate checks the field values on GetCollectionsContentReviewReply_Review
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetCollectionsContentReviewReply_Review) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetCollectionsContentReviewReply_Review with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetCollectionsContentReviewReply_ReviewMultiError, or nil if none found.
+func (m *GetCollectionsContentReviewReply_Review) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionsContentReviewReply_Review) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CreationId
+
+	// no validation rules for Title
+
+	// no validation rules for Kind
+
+	// no validation rules for Uuid
+
+	// no validation rules for CreateAt
+
+	// no validation rules for JobId
+
+	// no validation rules for Label
+
+	// no validation rules for Result
+
+	// no validation rules for Section
+
+	if len(errors) > 0 {
+		return GetCollectionsContentReviewReply_ReviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionsContentReviewReply_ReviewMultiError is an error wrapping
+// multiple validation errors returned by
+// GetCollectionsContentReviewReply_Review.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionsContentReviewReply_ReviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionsContentReviewReply_ReviewMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionsContentReviewReply_ReviewMultiError) AllErrors() []error { return m }
+
+// GetCollectionsContentReviewReply_ReviewValidationError is the validation
+// error returned by GetCollectionsContentReviewReply_Review.Validate if the
+// designated constraints aren't met.
+type GetCollectionsContentReviewReply_ReviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionsContentReviewReply_ReviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionsContentReviewReply_ReviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionsContentReviewReply_ReviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionsContentReviewReply_ReviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionsContentReviewReply_ReviewValidationError) ErrorName() string {
+	return "GetCollectionsContentReviewReply_ReviewValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionsContentReviewReply_ReviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionsContentReviewReply_Review.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionsContentReviewReply_ReviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionsContentReviewReply_ReviewValidationError{}
+
+// Validate checks the field values on GetCollectionsListReply_Collections with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetCollectionsListReply_Collections) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionsListReply_Collections
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetCollectionsListReply_CollectionsMultiError, or nil if none found.
+func (m *GetCollectionsListReply_Collections) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionsListReply_Collections) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCollectionsListReply_CollectionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionsListReply_CollectionsMultiError is an error wrapping multiple
 // validation errors returned by
 // GetCollectionsListReply_Collections.ValidateAll() if the designated
 // constraints aren't met.
@@ -26016,6 +27174,119 @@ var _ interface {
 	ErrorName() string
 } = GetArticleContentReviewReply_ReviewValidationError{}
 
+// Validate checks the field values on AddCreationViewDbAndCacheReq_View with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddCreationViewDbAndCacheReq_View) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCreationViewDbAndCacheReq_View
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddCreationViewDbAndCacheReq_ViewMultiError, or nil if none found.
+func (m *AddCreationViewDbAndCacheReq_View) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCreationViewDbAndCacheReq_View) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid
+
+	// no validation rules for Count
+
+	// no validation rules for Raw
+
+	if len(errors) > 0 {
+		return AddCreationViewDbAndCacheReq_ViewMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCreationViewDbAndCacheReq_ViewMultiError is an error wrapping multiple
+// validation errors returned by
+// AddCreationViewDbAndCacheReq_View.ValidateAll() if the designated
+// constraints aren't met.
+type AddCreationViewDbAndCacheReq_ViewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCreationViewDbAndCacheReq_ViewMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCreationViewDbAndCacheReq_ViewMultiError) AllErrors() []error { return m }
+
+// AddCreationViewDbAndCacheReq_ViewValidationError is the validation error
+// returned by AddCreationViewDbAndCacheReq_View.Validate if the designated
+// constraints aren't met.
+type AddCreationViewDbAndCacheReq_ViewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCreationViewDbAndCacheReq_ViewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCreationViewDbAndCacheReq_ViewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCreationViewDbAndCacheReq_ViewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCreationViewDbAndCacheReq_ViewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCreationViewDbAndCacheReq_ViewValidationError) ErrorName() string {
+	return "AddCreationViewDbAndCacheReq_ViewValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCreationViewDbAndCacheReq_ViewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCreationViewDbAndCacheReq_View.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCreationViewDbAndCacheReq_ViewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCreationViewDbAndCacheReq_ViewValidationError{}
+
 // Validate checks the field values on GetArticleDraftListReply_Draft with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -27951,3 +29222,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetNewsSearchReply_ListValidationError{}
+
+// Validate checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReply_FileMultiError, or nil if none found.
+func (m *GetUserDataReply_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReply_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetUserDataReply_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReply_FileMultiError is an error wrapping multiple validation
+// errors returned by GetUserDataReply_File.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserDataReply_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReply_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReply_FileMultiError) AllErrors() []error { return m }
+
+// GetUserDataReply_FileValidationError is the validation error returned by
+// GetUserDataReply_File.Validate if the designated constraints aren't met.
+type GetUserDataReply_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReply_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReply_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReply_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReply_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReply_FileValidationError) ErrorName() string {
+	return "GetUserDataReply_FileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReply_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReply_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReply_FileValidationError{}
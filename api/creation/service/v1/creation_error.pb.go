@@ -93,6 +93,9 @@ const (
 	CreationErrorReason_NOT_EMPTY                        CreationErrorReason = 66
 	CreationErrorReason_ADD_COMMENT_FAILED               CreationErrorReason = 67
 	CreationErrorReason_REDUCE_COMMENT_FAILED            CreationErrorReason = 68
+	CreationErrorReason_GET_USER_DATA_FAILED             CreationErrorReason = 69
+	CreationErrorReason_PURGE_USER_DATA_FAILED           CreationErrorReason = 70
+	CreationErrorReason_GET_RECOMMEND_DATA_FAILED        CreationErrorReason = 71
 )
 
 // Enum value maps for CreationErrorReason.
@@ -167,6 +170,9 @@ var (
 		66: "NOT_EMPTY",
 		67: "ADD_COMMENT_FAILED",
 		68: "REDUCE_COMMENT_FAILED",
+		69: "GET_USER_DATA_FAILED",
+		70: "PURGE_USER_DATA_FAILED",
+		71: "GET_RECOMMEND_DATA_FAILED",
 	}
 	CreationErrorReason_value = map[string]int32{
 		"UNKNOWN_ERROR":                    0,
@@ -238,6 +244,9 @@ var (
 		"NOT_EMPTY":                        66,
 		"ADD_COMMENT_FAILED":               67,
 		"REDUCE_COMMENT_FAILED":            68,
+		"GET_USER_DATA_FAILED":             69,
+		"PURGE_USER_DATA_FAILED":           70,
+		"GET_RECOMMEND_DATA_FAILED":        71,
 	}
 )
 
@@ -275,7 +284,7 @@ var file_creation_service_v1_creation_error_proto_rawDesc = []byte{
 	0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x8b, 0x0f, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xe0, 0x0f, 0x0a,
 	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
 	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
 	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x43, 0x4f, 0x52,
@@ -396,10 +405,15 @@ var file_creation_service_v1_creation_error_proto_rawDesc = []byte{
 	0x42, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
 	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x43, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x44,
 	0x55, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x44, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1f, 0x5a, 0x1d, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x45, 0x44, 0x10, 0x44, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x45, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x55, 0x52, 0x47, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x46, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x45,
+	0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x47, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42,
+	0x1f, 0x5a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
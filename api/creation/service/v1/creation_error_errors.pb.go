@@ -838,3 +838,39 @@ func IsReduceCommentFailed(err error) bool {
 func ErrorReduceCommentFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, CreationErrorReason_REDUCE_COMMENT_FAILED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsGetUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CreationErrorReason_GET_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, CreationErrorReason_GET_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPurgeUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CreationErrorReason_PURGE_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorPurgeUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, CreationErrorReason_PURGE_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetRecommendDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CreationErrorReason_GET_RECOMMEND_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetRecommendDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, CreationErrorReason_GET_RECOMMEND_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
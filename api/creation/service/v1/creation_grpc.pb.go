@@ -79,6 +79,7 @@ type CreationClient interface {
 	EditArticleCosAndSearch(ctx context.Context, in *EditArticleCosAndSearchReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteArticleCacheAndSearch(ctx context.Context, in *DeleteArticleCacheAndSearchReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetArticleViewDbAndCache(ctx context.Context, in *SetArticleViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddArticleViewDbAndCache(ctx context.Context, in *AddCreationViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetArticleAgreeDbAndCache(ctx context.Context, in *SetArticleAgreeDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetArticleCollectDbAndCache(ctx context.Context, in *SetArticleCollectDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateArticleDraft(ctx context.Context, in *CreateArticleDraftReq, opts ...grpc.CallOption) (*CreateArticleDraftReply, error)
@@ -125,6 +126,7 @@ type CreationClient interface {
 	DeleteTalkCacheAndSearch(ctx context.Context, in *DeleteTalkCacheAndSearchReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetTalkView(ctx context.Context, in *SetTalkViewReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetTalkViewDbAndCache(ctx context.Context, in *SetTalkViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddTalkViewDbAndCache(ctx context.Context, in *AddCreationViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	TalkStatisticJudge(ctx context.Context, in *TalkStatisticJudgeReq, opts ...grpc.CallOption) (*TalkStatisticJudgeReply, error)
 	SetTalkAgree(ctx context.Context, in *SetTalkAgreeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetTalkAgreeDbAndCache(ctx context.Context, in *SetTalkAgreeDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -179,6 +181,7 @@ type CreationClient interface {
 	CancelColumnCollectDbAndCache(ctx context.Context, in *CancelColumnCollectDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetColumnView(ctx context.Context, in *SetColumnViewReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetColumnViewDbAndCache(ctx context.Context, in *SetColumnViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddColumnViewDbAndCache(ctx context.Context, in *AddCreationViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddColumnIncludes(ctx context.Context, in *AddColumnIncludesReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddColumnIncludesDbAndCache(ctx context.Context, in *AddColumnIncludesDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteColumnIncludes(ctx context.Context, in *DeleteColumnIncludesReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -189,6 +192,11 @@ type CreationClient interface {
 	GetNewsSearch(ctx context.Context, in *GetNewsSearchReq, opts ...grpc.CallOption) (*GetNewsSearchReply, error)
 	AddCreationComment(ctx context.Context, in *AddCreationCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ReduceCreationComment(ctx context.Context, in *ReduceCreationCommentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error)
+	PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserTags(ctx context.Context, in *GetUserTagsReq, opts ...grpc.CallOption) (*GetUserTagsReply, error)
+	GetTagAuthors(ctx context.Context, in *GetTagAuthorsReq, opts ...grpc.CallOption) (*GetTagAuthorsReply, error)
+	GetAuthorActivity(ctx context.Context, in *GetAuthorActivityReq, opts ...grpc.CallOption) (*GetAuthorActivityReply, error)
 	GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -704,6 +712,15 @@ func (c *creationClient) SetArticleViewDbAndCache(ctx context.Context, in *SetAr
 	return out, nil
 }
 
+func (c *creationClient) AddArticleViewDbAndCache(ctx context.Context, in *AddCreationViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/AddArticleViewDbAndCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creationClient) SetArticleAgreeDbAndCache(ctx context.Context, in *SetArticleAgreeDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/creation.v1.Creation/SetArticleAgreeDbAndCache", in, out, opts...)
@@ -1118,6 +1135,15 @@ func (c *creationClient) SetTalkViewDbAndCache(ctx context.Context, in *SetTalkV
 	return out, nil
 }
 
+func (c *creationClient) AddTalkViewDbAndCache(ctx context.Context, in *AddCreationViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/AddTalkViewDbAndCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creationClient) TalkStatisticJudge(ctx context.Context, in *TalkStatisticJudgeReq, opts ...grpc.CallOption) (*TalkStatisticJudgeReply, error) {
 	out := new(TalkStatisticJudgeReply)
 	err := c.cc.Invoke(ctx, "/creation.v1.Creation/TalkStatisticJudge", in, out, opts...)
@@ -1604,6 +1630,15 @@ func (c *creationClient) SetColumnViewDbAndCache(ctx context.Context, in *SetCol
 	return out, nil
 }
 
+func (c *creationClient) AddColumnViewDbAndCache(ctx context.Context, in *AddCreationViewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/AddColumnViewDbAndCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creationClient) AddColumnIncludes(ctx context.Context, in *AddColumnIncludesReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/creation.v1.Creation/AddColumnIncludes", in, out, opts...)
@@ -1694,6 +1729,51 @@ func (c *creationClient) ReduceCreationComment(ctx context.Context, in *ReduceCr
 	return out, nil
 }
 
+func (c *creationClient) GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error) {
+	out := new(GetUserDataReply)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/GetUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creationClient) PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/PurgeUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creationClient) GetUserTags(ctx context.Context, in *GetUserTagsReq, opts ...grpc.CallOption) (*GetUserTagsReply, error) {
+	out := new(GetUserTagsReply)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/GetUserTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creationClient) GetTagAuthors(ctx context.Context, in *GetTagAuthorsReq, opts ...grpc.CallOption) (*GetTagAuthorsReply, error) {
+	out := new(GetTagAuthorsReply)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/GetTagAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creationClient) GetAuthorActivity(ctx context.Context, in *GetAuthorActivityReq, opts ...grpc.CallOption) (*GetAuthorActivityReply, error) {
+	out := new(GetAuthorActivityReply)
+	err := c.cc.Invoke(ctx, "/creation.v1.Creation/GetAuthorActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *creationClient) GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/creation.v1.Creation/GetHealth", in, out, opts...)
@@ -1763,6 +1843,7 @@ type CreationServer interface {
 	EditArticleCosAndSearch(context.Context, *EditArticleCosAndSearchReq) (*emptypb.Empty, error)
 	DeleteArticleCacheAndSearch(context.Context, *DeleteArticleCacheAndSearchReq) (*emptypb.Empty, error)
 	SetArticleViewDbAndCache(context.Context, *SetArticleViewDbAndCacheReq) (*emptypb.Empty, error)
+	AddArticleViewDbAndCache(context.Context, *AddCreationViewDbAndCacheReq) (*emptypb.Empty, error)
 	SetArticleAgreeDbAndCache(context.Context, *SetArticleAgreeDbAndCacheReq) (*emptypb.Empty, error)
 	SetArticleCollectDbAndCache(context.Context, *SetArticleCollectDbAndCacheReq) (*emptypb.Empty, error)
 	CreateArticleDraft(context.Context, *CreateArticleDraftReq) (*CreateArticleDraftReply, error)
@@ -1809,6 +1890,7 @@ type CreationServer interface {
 	DeleteTalkCacheAndSearch(context.Context, *DeleteTalkCacheAndSearchReq) (*emptypb.Empty, error)
 	SetTalkView(context.Context, *SetTalkViewReq) (*emptypb.Empty, error)
 	SetTalkViewDbAndCache(context.Context, *SetTalkViewDbAndCacheReq) (*emptypb.Empty, error)
+	AddTalkViewDbAndCache(context.Context, *AddCreationViewDbAndCacheReq) (*emptypb.Empty, error)
 	TalkStatisticJudge(context.Context, *TalkStatisticJudgeReq) (*TalkStatisticJudgeReply, error)
 	SetTalkAgree(context.Context, *SetTalkAgreeReq) (*emptypb.Empty, error)
 	SetTalkAgreeDbAndCache(context.Context, *SetTalkAgreeDbAndCacheReq) (*emptypb.Empty, error)
@@ -1863,6 +1945,7 @@ type CreationServer interface {
 	CancelColumnCollectDbAndCache(context.Context, *CancelColumnCollectDbAndCacheReq) (*emptypb.Empty, error)
 	SetColumnView(context.Context, *SetColumnViewReq) (*emptypb.Empty, error)
 	SetColumnViewDbAndCache(context.Context, *SetColumnViewDbAndCacheReq) (*emptypb.Empty, error)
+	AddColumnViewDbAndCache(context.Context, *AddCreationViewDbAndCacheReq) (*emptypb.Empty, error)
 	AddColumnIncludes(context.Context, *AddColumnIncludesReq) (*emptypb.Empty, error)
 	AddColumnIncludesDbAndCache(context.Context, *AddColumnIncludesDbAndCacheReq) (*emptypb.Empty, error)
 	DeleteColumnIncludes(context.Context, *DeleteColumnIncludesReq) (*emptypb.Empty, error)
@@ -1873,6 +1956,11 @@ type CreationServer interface {
 	GetNewsSearch(context.Context, *GetNewsSearchReq) (*GetNewsSearchReply, error)
 	AddCreationComment(context.Context, *AddCreationCommentReq) (*emptypb.Empty, error)
 	ReduceCreationComment(context.Context, *ReduceCreationCommentReq) (*emptypb.Empty, error)
+	GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error)
+	PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error)
+	GetUserTags(context.Context, *GetUserTagsReq) (*GetUserTagsReply, error)
+	GetTagAuthors(context.Context, *GetTagAuthorsReq) (*GetTagAuthorsReply, error)
+	GetAuthorActivity(context.Context, *GetAuthorActivityReq) (*GetAuthorActivityReply, error)
 	GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCreationServer()
 }
@@ -2049,6 +2137,9 @@ func (UnimplementedCreationServer) DeleteArticleCacheAndSearch(context.Context,
 func (UnimplementedCreationServer) SetArticleViewDbAndCache(context.Context, *SetArticleViewDbAndCacheReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetArticleViewDbAndCache not implemented")
 }
+func (UnimplementedCreationServer) AddArticleViewDbAndCache(context.Context, *AddCreationViewDbAndCacheReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddArticleViewDbAndCache not implemented")
+}
 func (UnimplementedCreationServer) SetArticleAgreeDbAndCache(context.Context, *SetArticleAgreeDbAndCacheReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetArticleAgreeDbAndCache not implemented")
 }
@@ -2187,6 +2278,9 @@ func (UnimplementedCreationServer) SetTalkView(context.Context, *SetTalkViewReq)
 func (UnimplementedCreationServer) SetTalkViewDbAndCache(context.Context, *SetTalkViewDbAndCacheReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetTalkViewDbAndCache not implemented")
 }
+func (UnimplementedCreationServer) AddTalkViewDbAndCache(context.Context, *AddCreationViewDbAndCacheReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTalkViewDbAndCache not implemented")
+}
 func (UnimplementedCreationServer) TalkStatisticJudge(context.Context, *TalkStatisticJudgeReq) (*TalkStatisticJudgeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TalkStatisticJudge not implemented")
 }
@@ -2349,6 +2443,9 @@ func (UnimplementedCreationServer) SetColumnView(context.Context, *SetColumnView
 func (UnimplementedCreationServer) SetColumnViewDbAndCache(context.Context, *SetColumnViewDbAndCacheReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetColumnViewDbAndCache not implemented")
 }
+func (UnimplementedCreationServer) AddColumnViewDbAndCache(context.Context, *AddCreationViewDbAndCacheReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddColumnViewDbAndCache not implemented")
+}
 func (UnimplementedCreationServer) AddColumnIncludes(context.Context, *AddColumnIncludesReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddColumnIncludes not implemented")
 }
@@ -2379,6 +2476,21 @@ func (UnimplementedCreationServer) AddCreationComment(context.Context, *AddCreat
 func (UnimplementedCreationServer) ReduceCreationComment(context.Context, *ReduceCreationCommentReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReduceCreationComment not implemented")
 }
+func (UnimplementedCreationServer) GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
+}
+func (UnimplementedCreationServer) PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserData not implemented")
+}
+func (UnimplementedCreationServer) GetUserTags(context.Context, *GetUserTagsReq) (*GetUserTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTags not implemented")
+}
+func (UnimplementedCreationServer) GetTagAuthors(context.Context, *GetTagAuthorsReq) (*GetTagAuthorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagAuthors not implemented")
+}
+func (UnimplementedCreationServer) GetAuthorActivity(context.Context, *GetAuthorActivityReq) (*GetAuthorActivityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorActivity not implemented")
+}
 func (UnimplementedCreationServer) GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
 }
@@ -3403,6 +3515,24 @@ func _Creation_SetArticleViewDbAndCache_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Creation_AddArticleViewDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCreationViewDbAndCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).AddArticleViewDbAndCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/AddArticleViewDbAndCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).AddArticleViewDbAndCache(ctx, req.(*AddCreationViewDbAndCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Creation_SetArticleAgreeDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetArticleAgreeDbAndCacheReq)
 	if err := dec(in); err != nil {
@@ -4231,6 +4361,24 @@ func _Creation_SetTalkViewDbAndCache_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Creation_AddTalkViewDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCreationViewDbAndCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).AddTalkViewDbAndCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/AddTalkViewDbAndCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).AddTalkViewDbAndCache(ctx, req.(*AddCreationViewDbAndCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Creation_TalkStatisticJudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TalkStatisticJudgeReq)
 	if err := dec(in); err != nil {
@@ -5203,6 +5351,24 @@ func _Creation_SetColumnViewDbAndCache_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Creation_AddColumnViewDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCreationViewDbAndCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).AddColumnViewDbAndCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/AddColumnViewDbAndCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).AddColumnViewDbAndCache(ctx, req.(*AddCreationViewDbAndCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Creation_AddColumnIncludes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddColumnIncludesReq)
 	if err := dec(in); err != nil {
@@ -5383,6 +5549,96 @@ func _Creation_ReduceCreationComment_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Creation_GetUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).GetUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/GetUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).GetUserData(ctx, req.(*GetUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Creation_PurgeUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).PurgeUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/PurgeUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).PurgeUserData(ctx, req.(*PurgeUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Creation_GetUserTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).GetUserTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/GetUserTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).GetUserTags(ctx, req.(*GetUserTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Creation_GetTagAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagAuthorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).GetTagAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/GetTagAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).GetTagAuthors(ctx, req.(*GetTagAuthorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Creation_GetAuthorActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreationServer).GetAuthorActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/creation.v1.Creation/GetAuthorActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreationServer).GetAuthorActivity(ctx, req.(*GetAuthorActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Creation_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -5632,6 +5888,10 @@ var Creation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetArticleViewDbAndCache",
 			Handler:    _Creation_SetArticleViewDbAndCache_Handler,
 		},
+		{
+			MethodName: "AddArticleViewDbAndCache",
+			Handler:    _Creation_AddArticleViewDbAndCache_Handler,
+		},
 		{
 			MethodName: "SetArticleAgreeDbAndCache",
 			Handler:    _Creation_SetArticleAgreeDbAndCache_Handler,
@@ -5816,6 +6076,10 @@ var Creation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetTalkViewDbAndCache",
 			Handler:    _Creation_SetTalkViewDbAndCache_Handler,
 		},
+		{
+			MethodName: "AddTalkViewDbAndCache",
+			Handler:    _Creation_AddTalkViewDbAndCache_Handler,
+		},
 		{
 			MethodName: "TalkStatisticJudge",
 			Handler:    _Creation_TalkStatisticJudge_Handler,
@@ -6032,6 +6296,10 @@ var Creation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetColumnViewDbAndCache",
 			Handler:    _Creation_SetColumnViewDbAndCache_Handler,
 		},
+		{
+			MethodName: "AddColumnViewDbAndCache",
+			Handler:    _Creation_AddColumnViewDbAndCache_Handler,
+		},
 		{
 			MethodName: "AddColumnIncludes",
 			Handler:    _Creation_AddColumnIncludes_Handler,
@@ -6072,6 +6340,26 @@ var Creation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReduceCreationComment",
 			Handler:    _Creation_ReduceCreationComment_Handler,
 		},
+		{
+			MethodName: "GetUserData",
+			Handler:    _Creation_GetUserData_Handler,
+		},
+		{
+			MethodName: "PurgeUserData",
+			Handler:    _Creation_PurgeUserData_Handler,
+		},
+		{
+			MethodName: "GetUserTags",
+			Handler:    _Creation_GetUserTags_Handler,
+		},
+		{
+			MethodName: "GetTagAuthors",
+			Handler:    _Creation_GetTagAuthors_Handler,
+		},
+		{
+			MethodName: "GetAuthorActivity",
+			Handler:    _Creation_GetAuthorActivity_Handler,
+		},
 		{
 			MethodName: "GetHealth",
 			Handler:    _Creation_GetHealth_Handler,
@@ -244,6 +244,7 @@ type GetMessageNotificationReply struct {
 	Comment    int32            `protobuf:"varint,2,opt,name=comment,proto3" json:"comment,omitempty"`
 	SubComment int32            `protobuf:"varint,3,opt,name=subComment,proto3" json:"subComment,omitempty"`
 	System     int32            `protobuf:"varint,4,opt,name=system,proto3" json:"system,omitempty"`
+	Mention    int32            `protobuf:"varint,5,opt,name=mention,proto3" json:"mention,omitempty"`
 }
 
 func (x *GetMessageNotificationReply) Reset() {
@@ -306,6 +307,13 @@ func (x *GetMessageNotificationReply) GetSystem() int32 {
 	return 0
 }
 
+func (x *GetMessageNotificationReply) GetMention() int32 {
+	if x != nil {
+		return x.Mention
+	}
+	return 0
+}
+
 type GetMailBoxLastTimeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -455,17 +463,17 @@ func (x *GetMessageSystemNotificationReply) GetList() []*GetMessageSystemNotific
 	return nil
 }
 
-type SetMailBoxLastTimeReq struct {
+type GetMessageMentionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Time int32  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Page int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *SetMailBoxLastTimeReq) Reset() {
-	*x = SetMailBoxLastTimeReq{}
+func (x *GetMessageMentionReq) Reset() {
+	*x = GetMessageMentionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -473,13 +481,13 @@ func (x *SetMailBoxLastTimeReq) Reset() {
 	}
 }
 
-func (x *SetMailBoxLastTimeReq) String() string {
+func (x *GetMessageMentionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMailBoxLastTimeReq) ProtoMessage() {}
+func (*GetMessageMentionReq) ProtoMessage() {}
 
-func (x *SetMailBoxLastTimeReq) ProtoReflect() protoreflect.Message {
+func (x *GetMessageMentionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,35 +499,35 @@ func (x *SetMailBoxLastTimeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMailBoxLastTimeReq.ProtoReflect.Descriptor instead.
-func (*SetMailBoxLastTimeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMessageMentionReq.ProtoReflect.Descriptor instead.
+func (*GetMessageMentionReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SetMailBoxLastTimeReq) GetUuid() string {
+func (x *GetMessageMentionReq) GetPage() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *SetMailBoxLastTimeReq) GetTime() int32 {
+func (x *GetMessageMentionReq) GetUuid() string {
 	if x != nil {
-		return x.Time
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-type RemoveMailBoxCommentCountReq struct {
+type GetMessageMentionReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	List []*GetMessageMentionReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *RemoveMailBoxCommentCountReq) Reset() {
-	*x = RemoveMailBoxCommentCountReq{}
+func (x *GetMessageMentionReply) Reset() {
+	*x = GetMessageMentionReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,13 +535,13 @@ func (x *RemoveMailBoxCommentCountReq) Reset() {
 	}
 }
 
-func (x *RemoveMailBoxCommentCountReq) String() string {
+func (x *GetMessageMentionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMailBoxCommentCountReq) ProtoMessage() {}
+func (*GetMessageMentionReply) ProtoMessage() {}
 
-func (x *RemoveMailBoxCommentCountReq) ProtoReflect() protoreflect.Message {
+func (x *GetMessageMentionReply) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,28 +553,29 @@ func (x *RemoveMailBoxCommentCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMailBoxCommentCountReq.ProtoReflect.Descriptor instead.
-func (*RemoveMailBoxCommentCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMessageMentionReply.ProtoReflect.Descriptor instead.
+func (*GetMessageMentionReply) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RemoveMailBoxCommentCountReq) GetUuid() string {
+func (x *GetMessageMentionReply) GetList() []*GetMessageMentionReply_List {
 	if x != nil {
-		return x.Uuid
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type RemoveMailBoxSubCommentCountReq struct {
+type SetMailBoxLastTimeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Time int32  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *RemoveMailBoxSubCommentCountReq) Reset() {
-	*x = RemoveMailBoxSubCommentCountReq{}
+func (x *SetMailBoxLastTimeReq) Reset() {
+	*x = SetMailBoxLastTimeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,13 +583,13 @@ func (x *RemoveMailBoxSubCommentCountReq) Reset() {
 	}
 }
 
-func (x *RemoveMailBoxSubCommentCountReq) String() string {
+func (x *SetMailBoxLastTimeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMailBoxSubCommentCountReq) ProtoMessage() {}
+func (*SetMailBoxLastTimeReq) ProtoMessage() {}
 
-func (x *RemoveMailBoxSubCommentCountReq) ProtoReflect() protoreflect.Message {
+func (x *SetMailBoxLastTimeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,19 +601,26 @@ func (x *RemoveMailBoxSubCommentCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMailBoxSubCommentCountReq.ProtoReflect.Descriptor instead.
-func (*RemoveMailBoxSubCommentCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMailBoxLastTimeReq.ProtoReflect.Descriptor instead.
+func (*SetMailBoxLastTimeReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RemoveMailBoxSubCommentCountReq) GetUuid() string {
+func (x *SetMailBoxLastTimeReq) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type RemoveMailBoxSystemNotificationCountReq struct {
+func (x *SetMailBoxLastTimeReq) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type RemoveMailBoxCommentCountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -612,8 +628,8 @@ type RemoveMailBoxSystemNotificationCountReq struct {
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *RemoveMailBoxSystemNotificationCountReq) Reset() {
-	*x = RemoveMailBoxSystemNotificationCountReq{}
+func (x *RemoveMailBoxCommentCountReq) Reset() {
+	*x = RemoveMailBoxCommentCountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,13 +637,13 @@ func (x *RemoveMailBoxSystemNotificationCountReq) Reset() {
 	}
 }
 
-func (x *RemoveMailBoxSystemNotificationCountReq) String() string {
+func (x *RemoveMailBoxCommentCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMailBoxSystemNotificationCountReq) ProtoMessage() {}
+func (*RemoveMailBoxCommentCountReq) ProtoMessage() {}
 
-func (x *RemoveMailBoxSystemNotificationCountReq) ProtoReflect() protoreflect.Message {
+func (x *RemoveMailBoxCommentCountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,30 +655,28 @@ func (x *RemoveMailBoxSystemNotificationCountReq) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMailBoxSystemNotificationCountReq.ProtoReflect.Descriptor instead.
-func (*RemoveMailBoxSystemNotificationCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveMailBoxCommentCountReq.ProtoReflect.Descriptor instead.
+func (*RemoveMailBoxCommentCountReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveMailBoxSystemNotificationCountReq) GetUuid() string {
+func (x *RemoveMailBoxCommentCountReq) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type SectionPornInfoStruct struct {
+type RemoveMailBoxSubCommentCountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
-	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
-	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *SectionPornInfoStruct) Reset() {
-	*x = SectionPornInfoStruct{}
+func (x *RemoveMailBoxSubCommentCountReq) Reset() {
+	*x = RemoveMailBoxSubCommentCountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,13 +684,13 @@ func (x *SectionPornInfoStruct) Reset() {
 	}
 }
 
-func (x *SectionPornInfoStruct) String() string {
+func (x *RemoveMailBoxSubCommentCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionPornInfoStruct) ProtoMessage() {}
+func (*RemoveMailBoxSubCommentCountReq) ProtoMessage() {}
 
-func (x *SectionPornInfoStruct) ProtoReflect() protoreflect.Message {
+func (x *RemoveMailBoxSubCommentCountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,44 +702,28 @@ func (x *SectionPornInfoStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionPornInfoStruct.ProtoReflect.Descriptor instead.
-func (*SectionPornInfoStruct) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveMailBoxSubCommentCountReq.ProtoReflect.Descriptor instead.
+func (*RemoveMailBoxSubCommentCountReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SectionPornInfoStruct) GetHitFlag() int32 {
-	if x != nil {
-		return x.HitFlag
-	}
-	return 0
-}
-
-func (x *SectionPornInfoStruct) GetScore() int32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *SectionPornInfoStruct) GetKeywords() string {
+func (x *RemoveMailBoxSubCommentCountReq) GetUuid() string {
 	if x != nil {
-		return x.Keywords
+		return x.Uuid
 	}
 	return ""
 }
 
-type SectionAdsInfoStruct struct {
+type RemoveMailBoxSystemNotificationCountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
-	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
-	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *SectionAdsInfoStruct) Reset() {
-	*x = SectionAdsInfoStruct{}
+func (x *RemoveMailBoxSystemNotificationCountReq) Reset() {
+	*x = RemoveMailBoxSystemNotificationCountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -733,13 +731,13 @@ func (x *SectionAdsInfoStruct) Reset() {
 	}
 }
 
-func (x *SectionAdsInfoStruct) String() string {
+func (x *RemoveMailBoxSystemNotificationCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionAdsInfoStruct) ProtoMessage() {}
+func (*RemoveMailBoxSystemNotificationCountReq) ProtoMessage() {}
 
-func (x *SectionAdsInfoStruct) ProtoReflect() protoreflect.Message {
+func (x *RemoveMailBoxSystemNotificationCountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,44 +749,28 @@ func (x *SectionAdsInfoStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionAdsInfoStruct.ProtoReflect.Descriptor instead.
-func (*SectionAdsInfoStruct) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveMailBoxSystemNotificationCountReq.ProtoReflect.Descriptor instead.
+func (*RemoveMailBoxSystemNotificationCountReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SectionAdsInfoStruct) GetHitFlag() int32 {
-	if x != nil {
-		return x.HitFlag
-	}
-	return 0
-}
-
-func (x *SectionAdsInfoStruct) GetScore() int32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *SectionAdsInfoStruct) GetKeywords() string {
+func (x *RemoveMailBoxSystemNotificationCountReq) GetUuid() string {
 	if x != nil {
-		return x.Keywords
+		return x.Uuid
 	}
 	return ""
 }
 
-type SectionIllegalInfoStruct struct {
+type RemoveMailBoxMentionCountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
-	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
-	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *SectionIllegalInfoStruct) Reset() {
-	*x = SectionIllegalInfoStruct{}
+func (x *RemoveMailBoxMentionCountReq) Reset() {
+	*x = RemoveMailBoxMentionCountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,13 +778,13 @@ func (x *SectionIllegalInfoStruct) Reset() {
 	}
 }
 
-func (x *SectionIllegalInfoStruct) String() string {
+func (x *RemoveMailBoxMentionCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionIllegalInfoStruct) ProtoMessage() {}
+func (*RemoveMailBoxMentionCountReq) ProtoMessage() {}
 
-func (x *SectionIllegalInfoStruct) ProtoReflect() protoreflect.Message {
+func (x *RemoveMailBoxMentionCountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,44 +796,30 @@ func (x *SectionIllegalInfoStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionIllegalInfoStruct.ProtoReflect.Descriptor instead.
-func (*SectionIllegalInfoStruct) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveMailBoxMentionCountReq.ProtoReflect.Descriptor instead.
+func (*RemoveMailBoxMentionCountReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SectionIllegalInfoStruct) GetHitFlag() int32 {
-	if x != nil {
-		return x.HitFlag
-	}
-	return 0
-}
-
-func (x *SectionIllegalInfoStruct) GetScore() int32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *SectionIllegalInfoStruct) GetKeywords() string {
+func (x *RemoveMailBoxMentionCountReq) GetUuid() string {
 	if x != nil {
-		return x.Keywords
+		return x.Uuid
 	}
 	return ""
 }
 
-type SectionAbuseInfoStruct struct {
+type GetContentFlagListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
-	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
-	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+	Page   int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Kind   string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *SectionAbuseInfoStruct) Reset() {
-	*x = SectionAbuseInfoStruct{}
+func (x *GetContentFlagListReq) Reset() {
+	*x = GetContentFlagListReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,13 +827,13 @@ func (x *SectionAbuseInfoStruct) Reset() {
 	}
 }
 
-func (x *SectionAbuseInfoStruct) String() string {
+func (x *GetContentFlagListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionAbuseInfoStruct) ProtoMessage() {}
+func (*GetContentFlagListReq) ProtoMessage() {}
 
-func (x *SectionAbuseInfoStruct) ProtoReflect() protoreflect.Message {
+func (x *GetContentFlagListReq) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -877,47 +845,42 @@ func (x *SectionAbuseInfoStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionAbuseInfoStruct.ProtoReflect.Descriptor instead.
-func (*SectionAbuseInfoStruct) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContentFlagListReq.ProtoReflect.Descriptor instead.
+func (*GetContentFlagListReq) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SectionAbuseInfoStruct) GetHitFlag() int32 {
+func (x *GetContentFlagListReq) GetPage() int32 {
 	if x != nil {
-		return x.HitFlag
+		return x.Page
 	}
 	return 0
 }
 
-func (x *SectionAbuseInfoStruct) GetScore() int32 {
+func (x *GetContentFlagListReq) GetKind() string {
 	if x != nil {
-		return x.Score
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *SectionAbuseInfoStruct) GetKeywords() string {
+func (x *GetContentFlagListReq) GetStatus() int32 {
 	if x != nil {
-		return x.Keywords
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-type SectionStruct struct {
+type GetContentFlagListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label       string                    `protobuf:"bytes,1,opt,name=Label,proto3" json:"Label,omitempty"`
-	Result      int32                     `protobuf:"varint,2,opt,name=Result,proto3" json:"Result,omitempty"`
-	PornInfo    *SectionPornInfoStruct    `protobuf:"bytes,3,opt,name=PornInfo,proto3" json:"PornInfo,omitempty"`
-	AdsInfo     *SectionAdsInfoStruct     `protobuf:"bytes,4,opt,name=AdsInfo,proto3" json:"AdsInfo,omitempty"`
-	IllegalInfo *SectionIllegalInfoStruct `protobuf:"bytes,5,opt,name=IllegalInfo,proto3" json:"IllegalInfo,omitempty"`
-	AbuseInfo   *SectionAbuseInfoStruct   `protobuf:"bytes,6,opt,name=AbuseInfo,proto3" json:"AbuseInfo,omitempty"`
+	List []*GetContentFlagListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *SectionStruct) Reset() {
-	*x = SectionStruct{}
+func (x *GetContentFlagListReply) Reset() {
+	*x = GetContentFlagListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_service_v1_message_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -925,13 +888,13 @@ func (x *SectionStruct) Reset() {
 	}
 }
 
-func (x *SectionStruct) String() string {
+func (x *GetContentFlagListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SectionStruct) ProtoMessage() {}
+func (*GetContentFlagListReply) ProtoMessage() {}
 
-func (x *SectionStruct) ProtoReflect() protoreflect.Message {
+func (x *GetContentFlagListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_message_service_v1_message_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,90 +906,1048 @@ func (x *SectionStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SectionStruct.ProtoReflect.Descriptor instead.
-func (*SectionStruct) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContentFlagListReply.ProtoReflect.Descriptor instead.
+func (*GetContentFlagListReply) Descriptor() ([]byte, []int) {
 	return file_message_service_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SectionStruct) GetLabel() string {
+func (x *GetContentFlagListReply) GetList() []*GetContentFlagListReply_List {
 	if x != nil {
-		return x.Label
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *SectionStruct) GetResult() int32 {
-	if x != nil {
-		return x.Result
-	}
-	return 0
+type PublishContentFlagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SectionStruct) GetPornInfo() *SectionPornInfoStruct {
-	if x != nil {
-		return x.PornInfo
+func (x *PublishContentFlagReq) Reset() {
+	*x = PublishContentFlagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SectionStruct) GetAdsInfo() *SectionAdsInfoStruct {
-	if x != nil {
-		return x.AdsInfo
-	}
-	return nil
+func (x *PublishContentFlagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SectionStruct) GetIllegalInfo() *SectionIllegalInfoStruct {
-	if x != nil {
+func (*PublishContentFlagReq) ProtoMessage() {}
+
+func (x *PublishContentFlagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishContentFlagReq.ProtoReflect.Descriptor instead.
+func (*PublishContentFlagReq) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PublishContentFlagReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveContentFlagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveContentFlagReq) Reset() {
+	*x = RemoveContentFlagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContentFlagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContentFlagReq) ProtoMessage() {}
+
+func (x *RemoveContentFlagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContentFlagReq.ProtoReflect.Descriptor instead.
+func (*RemoveContentFlagReq) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveContentFlagReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AppealReviewReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid   string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	JobId  string `protobuf:"bytes,2,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AppealReviewReq) Reset() {
+	*x = AppealReviewReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppealReviewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealReviewReq) ProtoMessage() {}
+
+func (x *AppealReviewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealReviewReq.ProtoReflect.Descriptor instead.
+func (*AppealReviewReq) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AppealReviewReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AppealReviewReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AppealReviewReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetAppealListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Uuid   string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Status int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetAppealListReq) Reset() {
+	*x = GetAppealListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppealListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppealListReq) ProtoMessage() {}
+
+func (x *GetAppealListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppealListReq.ProtoReflect.Descriptor instead.
+func (*GetAppealListReq) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAppealListReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAppealListReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetAppealListReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetAppealListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*GetAppealListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *GetAppealListReply) Reset() {
+	*x = GetAppealListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppealListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppealListReply) ProtoMessage() {}
+
+func (x *GetAppealListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppealListReply.ProtoReflect.Descriptor instead.
+func (*GetAppealListReply) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAppealListReply) GetList() []*GetAppealListReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AcceptAppealReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AcceptAppealReq) Reset() {
+	*x = AcceptAppealReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptAppealReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAppealReq) ProtoMessage() {}
+
+func (x *AcceptAppealReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAppealReq.ProtoReflect.Descriptor instead.
+func (*AcceptAppealReq) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcceptAppealReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectAppealReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejectAppealReq) Reset() {
+	*x = RejectAppealReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectAppealReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectAppealReq) ProtoMessage() {}
+
+func (x *RejectAppealReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectAppealReq.ProtoReflect.Descriptor instead.
+func (*RejectAppealReq) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RejectAppealReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SectionPornInfoStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
+	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
+	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+}
+
+func (x *SectionPornInfoStruct) Reset() {
+	*x = SectionPornInfoStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionPornInfoStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionPornInfoStruct) ProtoMessage() {}
+
+func (x *SectionPornInfoStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionPornInfoStruct.ProtoReflect.Descriptor instead.
+func (*SectionPornInfoStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SectionPornInfoStruct) GetHitFlag() int32 {
+	if x != nil {
+		return x.HitFlag
+	}
+	return 0
+}
+
+func (x *SectionPornInfoStruct) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SectionPornInfoStruct) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+type SectionAdsInfoStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
+	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
+	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+}
+
+func (x *SectionAdsInfoStruct) Reset() {
+	*x = SectionAdsInfoStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionAdsInfoStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionAdsInfoStruct) ProtoMessage() {}
+
+func (x *SectionAdsInfoStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionAdsInfoStruct.ProtoReflect.Descriptor instead.
+func (*SectionAdsInfoStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SectionAdsInfoStruct) GetHitFlag() int32 {
+	if x != nil {
+		return x.HitFlag
+	}
+	return 0
+}
+
+func (x *SectionAdsInfoStruct) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SectionAdsInfoStruct) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+type SectionIllegalInfoStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
+	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
+	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+}
+
+func (x *SectionIllegalInfoStruct) Reset() {
+	*x = SectionIllegalInfoStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionIllegalInfoStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionIllegalInfoStruct) ProtoMessage() {}
+
+func (x *SectionIllegalInfoStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionIllegalInfoStruct.ProtoReflect.Descriptor instead.
+func (*SectionIllegalInfoStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SectionIllegalInfoStruct) GetHitFlag() int32 {
+	if x != nil {
+		return x.HitFlag
+	}
+	return 0
+}
+
+func (x *SectionIllegalInfoStruct) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SectionIllegalInfoStruct) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+type SectionAbuseInfoStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HitFlag  int32  `protobuf:"varint,1,opt,name=HitFlag,proto3" json:"HitFlag,omitempty"`
+	Score    int32  `protobuf:"varint,2,opt,name=Score,proto3" json:"Score,omitempty"`
+	Keywords string `protobuf:"bytes,3,opt,name=Keywords,proto3" json:"Keywords,omitempty"`
+}
+
+func (x *SectionAbuseInfoStruct) Reset() {
+	*x = SectionAbuseInfoStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionAbuseInfoStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionAbuseInfoStruct) ProtoMessage() {}
+
+func (x *SectionAbuseInfoStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionAbuseInfoStruct.ProtoReflect.Descriptor instead.
+func (*SectionAbuseInfoStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SectionAbuseInfoStruct) GetHitFlag() int32 {
+	if x != nil {
+		return x.HitFlag
+	}
+	return 0
+}
+
+func (x *SectionAbuseInfoStruct) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SectionAbuseInfoStruct) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+type SectionStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label       string                    `protobuf:"bytes,1,opt,name=Label,proto3" json:"Label,omitempty"`
+	Result      int32                     `protobuf:"varint,2,opt,name=Result,proto3" json:"Result,omitempty"`
+	PornInfo    *SectionPornInfoStruct    `protobuf:"bytes,3,opt,name=PornInfo,proto3" json:"PornInfo,omitempty"`
+	AdsInfo     *SectionAdsInfoStruct     `protobuf:"bytes,4,opt,name=AdsInfo,proto3" json:"AdsInfo,omitempty"`
+	IllegalInfo *SectionIllegalInfoStruct `protobuf:"bytes,5,opt,name=IllegalInfo,proto3" json:"IllegalInfo,omitempty"`
+	AbuseInfo   *SectionAbuseInfoStruct   `protobuf:"bytes,6,opt,name=AbuseInfo,proto3" json:"AbuseInfo,omitempty"`
+}
+
+func (x *SectionStruct) Reset() {
+	*x = SectionStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionStruct) ProtoMessage() {}
+
+func (x *SectionStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionStruct.ProtoReflect.Descriptor instead.
+func (*SectionStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SectionStruct) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SectionStruct) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *SectionStruct) GetPornInfo() *SectionPornInfoStruct {
+	if x != nil {
+		return x.PornInfo
+	}
+	return nil
+}
+
+func (x *SectionStruct) GetAdsInfo() *SectionAdsInfoStruct {
+	if x != nil {
+		return x.AdsInfo
+	}
+	return nil
+}
+
+func (x *SectionStruct) GetIllegalInfo() *SectionIllegalInfoStruct {
+	if x != nil {
 		return x.IllegalInfo
 	}
 	return nil
 }
 
-func (x *SectionStruct) GetAbuseInfo() *SectionAbuseInfoStruct {
+func (x *SectionStruct) GetAbuseInfo() *SectionAbuseInfoStruct {
+	if x != nil {
+		return x.AbuseInfo
+	}
+	return nil
+}
+
+type JobsDetailStruct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         string            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Message      string            `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	JobId        string            `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	DataId       string            `protobuf:"bytes,4,opt,name=DataId,proto3" json:"DataId,omitempty"`
+	State        string            `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
+	CreationTime string            `protobuf:"bytes,6,opt,name=CreationTime,proto3" json:"CreationTime,omitempty"`
+	Object       string            `protobuf:"bytes,7,opt,name=Object,proto3" json:"Object,omitempty"`
+	Label        string            `protobuf:"bytes,8,opt,name=Label,proto3" json:"Label,omitempty"`
+	Result       int32             `protobuf:"varint,9,opt,name=Result,proto3" json:"Result,omitempty"`
+	BucketId     string            `protobuf:"bytes,10,opt,name=BucketId,proto3" json:"BucketId,omitempty"`
+	Region       string            `protobuf:"bytes,11,opt,name=Region,proto3" json:"Region,omitempty"`
+	CosHeaders   map[string]string `protobuf:"bytes,12,rep,name=CosHeaders,proto3" json:"CosHeaders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Section      []*SectionStruct  `protobuf:"bytes,13,rep,name=Section,proto3" json:"Section,omitempty"`
+}
+
+func (x *JobsDetailStruct) Reset() {
+	*x = JobsDetailStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobsDetailStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobsDetailStruct) ProtoMessage() {}
+
+func (x *JobsDetailStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobsDetailStruct.ProtoReflect.Descriptor instead.
+func (*JobsDetailStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JobsDetailStruct) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetDataId() string {
+	if x != nil {
+		return x.DataId
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetCreationTime() string {
+	if x != nil {
+		return x.CreationTime
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *JobsDetailStruct) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *JobsDetailStruct) GetCosHeaders() map[string]string {
+	if x != nil {
+		return x.CosHeaders
+	}
+	return nil
+}
+
+func (x *JobsDetailStruct) GetSection() []*SectionStruct {
 	if x != nil {
-		return x.AbuseInfo
+		return x.Section
 	}
 	return nil
 }
 
-type JobsDetailStruct struct {
+type ImageReviewReq_JobsDetailStruct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code         string            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Message      string            `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
-	JobId        string            `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
-	DataId       string            `protobuf:"bytes,4,opt,name=DataId,proto3" json:"DataId,omitempty"`
-	State        string            `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
-	CreationTime string            `protobuf:"bytes,6,opt,name=CreationTime,proto3" json:"CreationTime,omitempty"`
-	Object       string            `protobuf:"bytes,7,opt,name=Object,proto3" json:"Object,omitempty"`
-	Label        string            `protobuf:"bytes,8,opt,name=Label,proto3" json:"Label,omitempty"`
-	Result       int32             `protobuf:"varint,9,opt,name=Result,proto3" json:"Result,omitempty"`
-	BucketId     string            `protobuf:"bytes,10,opt,name=BucketId,proto3" json:"BucketId,omitempty"`
-	Region       string            `protobuf:"bytes,11,opt,name=Region,proto3" json:"Region,omitempty"`
-	CosHeaders   map[string]string `protobuf:"bytes,12,rep,name=CosHeaders,proto3" json:"CosHeaders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Section      []*SectionStruct  `protobuf:"bytes,13,rep,name=Section,proto3" json:"Section,omitempty"`
+	Code       string            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Message    string            `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	JobId      string            `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
+	State      string            `protobuf:"bytes,4,opt,name=State,proto3" json:"State,omitempty"`
+	Object     string            `protobuf:"bytes,5,opt,name=Object,proto3" json:"Object,omitempty"`
+	Url        string            `protobuf:"bytes,6,opt,name=Url,proto3" json:"Url,omitempty"`
+	Label      string            `protobuf:"bytes,7,opt,name=Label,proto3" json:"Label,omitempty"`
+	Result     int32             `protobuf:"varint,8,opt,name=Result,proto3" json:"Result,omitempty"`
+	Score      int32             `protobuf:"varint,9,opt,name=Score,proto3" json:"Score,omitempty"`
+	Category   string            `protobuf:"bytes,10,opt,name=Category,proto3" json:"Category,omitempty"`
+	SubLabel   string            `protobuf:"bytes,11,opt,name=SubLabel,proto3" json:"SubLabel,omitempty"`
+	BucketId   string            `protobuf:"bytes,12,opt,name=BucketId,proto3" json:"BucketId,omitempty"`
+	Region     string            `protobuf:"bytes,13,opt,name=Region,proto3" json:"Region,omitempty"`
+	CosHeaders map[string]string `protobuf:"bytes,14,rep,name=CosHeaders,proto3" json:"CosHeaders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) Reset() {
+	*x = ImageReviewReq_JobsDetailStruct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageReviewReq_JobsDetailStruct) ProtoMessage() {}
+
+func (x *ImageReviewReq_JobsDetailStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageReviewReq_JobsDetailStruct.ProtoReflect.Descriptor instead.
+func (*ImageReviewReq_JobsDetailStruct) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetSubLabel() string {
+	if x != nil {
+		return x.SubLabel
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ImageReviewReq_JobsDetailStruct) GetCosHeaders() map[string]string {
+	if x != nil {
+		return x.CosHeaders
+	}
+	return nil
+}
+
+type GetMessageSystemNotificationReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentId        int32  `protobuf:"varint,2,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	CreatedAt        string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	NotificationType string `protobuf:"bytes,4,opt,name=notificationType,proto3" json:"notificationType,omitempty"`
+	Title            string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Uid              string `protobuf:"bytes,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uuid             string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Label            string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	Result           int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
+	Section          string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
+	Text             string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+	Comment          string `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *JobsDetailStruct) Reset() {
-	*x = JobsDetailStruct{}
+func (x *GetMessageSystemNotificationReply_List) Reset() {
+	*x = GetMessageSystemNotificationReply_List{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_v1_message_proto_msgTypes[17]
+		mi := &file_message_service_v1_message_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobsDetailStruct) String() string {
+func (x *GetMessageSystemNotificationReply_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobsDetailStruct) ProtoMessage() {}
+func (*GetMessageSystemNotificationReply_List) ProtoMessage() {}
 
-func (x *JobsDetailStruct) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_v1_message_proto_msgTypes[17]
+func (x *GetMessageSystemNotificationReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,140 +1958,129 @@ func (x *JobsDetailStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobsDetailStruct.ProtoReflect.Descriptor instead.
-func (*JobsDetailStruct) Descriptor() ([]byte, []int) {
-	return file_message_service_v1_message_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetMessageSystemNotificationReply_List.ProtoReflect.Descriptor instead.
+func (*GetMessageSystemNotificationReply_List) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *JobsDetailStruct) GetCode() string {
+func (x *GetMessageSystemNotificationReply_List) GetId() int32 {
 	if x != nil {
-		return x.Code
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *JobsDetailStruct) GetMessage() string {
+func (x *GetMessageSystemNotificationReply_List) GetContentId() int32 {
 	if x != nil {
-		return x.Message
+		return x.ContentId
 	}
-	return ""
+	return 0
 }
 
-func (x *JobsDetailStruct) GetJobId() string {
+func (x *GetMessageSystemNotificationReply_List) GetCreatedAt() string {
 	if x != nil {
-		return x.JobId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetDataId() string {
+func (x *GetMessageSystemNotificationReply_List) GetNotificationType() string {
 	if x != nil {
-		return x.DataId
+		return x.NotificationType
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetState() string {
+func (x *GetMessageSystemNotificationReply_List) GetTitle() string {
 	if x != nil {
-		return x.State
+		return x.Title
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetCreationTime() string {
+func (x *GetMessageSystemNotificationReply_List) GetUid() string {
 	if x != nil {
-		return x.CreationTime
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetObject() string {
+func (x *GetMessageSystemNotificationReply_List) GetUuid() string {
 	if x != nil {
-		return x.Object
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetLabel() string {
+func (x *GetMessageSystemNotificationReply_List) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetResult() int32 {
+func (x *GetMessageSystemNotificationReply_List) GetResult() int32 {
 	if x != nil {
 		return x.Result
 	}
 	return 0
 }
 
-func (x *JobsDetailStruct) GetBucketId() string {
+func (x *GetMessageSystemNotificationReply_List) GetSection() string {
 	if x != nil {
-		return x.BucketId
+		return x.Section
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetRegion() string {
+func (x *GetMessageSystemNotificationReply_List) GetText() string {
 	if x != nil {
-		return x.Region
+		return x.Text
 	}
 	return ""
 }
 
-func (x *JobsDetailStruct) GetCosHeaders() map[string]string {
-	if x != nil {
-		return x.CosHeaders
-	}
-	return nil
-}
-
-func (x *JobsDetailStruct) GetSection() []*SectionStruct {
+func (x *GetMessageSystemNotificationReply_List) GetComment() string {
 	if x != nil {
-		return x.Section
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-type ImageReviewReq_JobsDetailStruct struct {
+type GetMessageMentionReply_List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code       string            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Message    string            `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
-	JobId      string            `protobuf:"bytes,3,opt,name=JobId,proto3" json:"JobId,omitempty"`
-	State      string            `protobuf:"bytes,4,opt,name=State,proto3" json:"State,omitempty"`
-	Object     string            `protobuf:"bytes,5,opt,name=Object,proto3" json:"Object,omitempty"`
-	Url        string            `protobuf:"bytes,6,opt,name=Url,proto3" json:"Url,omitempty"`
-	Label      string            `protobuf:"bytes,7,opt,name=Label,proto3" json:"Label,omitempty"`
-	Result     int32             `protobuf:"varint,8,opt,name=Result,proto3" json:"Result,omitempty"`
-	Score      int32             `protobuf:"varint,9,opt,name=Score,proto3" json:"Score,omitempty"`
-	Category   string            `protobuf:"bytes,10,opt,name=Category,proto3" json:"Category,omitempty"`
-	SubLabel   string            `protobuf:"bytes,11,opt,name=SubLabel,proto3" json:"SubLabel,omitempty"`
-	BucketId   string            `protobuf:"bytes,12,opt,name=BucketId,proto3" json:"BucketId,omitempty"`
-	Region     string            `protobuf:"bytes,13,opt,name=Region,proto3" json:"Region,omitempty"`
-	CosHeaders map[string]string `protobuf:"bytes,14,rep,name=CosHeaders,proto3" json:"CosHeaders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id           int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentId    int32  `protobuf:"varint,2,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	CreatedAt    string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Kind         string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	CreationId   int32  `protobuf:"varint,5,opt,name=creationId,proto3" json:"creationId,omitempty"`
+	CreationType int32  `protobuf:"varint,6,opt,name=creationType,proto3" json:"creationType,omitempty"`
+	RootId       int32  `protobuf:"varint,7,opt,name=rootId,proto3" json:"rootId,omitempty"`
+	Uid          string `protobuf:"bytes,8,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uuid         string `protobuf:"bytes,9,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Text         string `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) Reset() {
-	*x = ImageReviewReq_JobsDetailStruct{}
+func (x *GetMessageMentionReply_List) Reset() {
+	*x = GetMessageMentionReply_List{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_v1_message_proto_msgTypes[18]
+		mi := &file_message_service_v1_message_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) String() string {
+func (x *GetMessageMentionReply_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImageReviewReq_JobsDetailStruct) ProtoMessage() {}
+func (*GetMessageMentionReply_List) ProtoMessage() {}
 
-func (x *ImageReviewReq_JobsDetailStruct) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_v1_message_proto_msgTypes[18]
+func (x *GetMessageMentionReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,145 +2091,244 @@ func (x *ImageReviewReq_JobsDetailStruct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImageReviewReq_JobsDetailStruct.ProtoReflect.Descriptor instead.
-func (*ImageReviewReq_JobsDetailStruct) Descriptor() ([]byte, []int) {
-	return file_message_service_v1_message_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use GetMessageMentionReply_List.ProtoReflect.Descriptor instead.
+func (*GetMessageMentionReply_List) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetCode() string {
+func (x *GetMessageMentionReply_List) GetId() int32 {
 	if x != nil {
-		return x.Code
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMessageMentionReply_List) GetContentId() int32 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *GetMessageMentionReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetMessage() string {
+func (x *GetMessageMentionReply_List) GetKind() string {
 	if x != nil {
-		return x.Message
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetJobId() string {
+func (x *GetMessageMentionReply_List) GetCreationId() int32 {
 	if x != nil {
-		return x.JobId
+		return x.CreationId
+	}
+	return 0
+}
+
+func (x *GetMessageMentionReply_List) GetCreationType() int32 {
+	if x != nil {
+		return x.CreationType
+	}
+	return 0
+}
+
+func (x *GetMessageMentionReply_List) GetRootId() int32 {
+	if x != nil {
+		return x.RootId
+	}
+	return 0
+}
+
+func (x *GetMessageMentionReply_List) GetUid() string {
+	if x != nil {
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetState() string {
+func (x *GetMessageMentionReply_List) GetUuid() string {
 	if x != nil {
-		return x.State
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetObject() string {
+func (x *GetMessageMentionReply_List) GetText() string {
 	if x != nil {
-		return x.Object
+		return x.Text
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetUrl() string {
+type GetContentFlagListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Mode      string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	ContentId int32  `protobuf:"varint,4,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	Uuid      string `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Text      string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	JobId     string `protobuf:"bytes,7,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Label     string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	Section   string `protobuf:"bytes,9,opt,name=section,proto3" json:"section,omitempty"`
+	Status    int32  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt int64  `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *GetContentFlagListReply_List) Reset() {
+	*x = GetContentFlagListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_service_v1_message_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContentFlagListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentFlagListReply_List) ProtoMessage() {}
+
+func (x *GetContentFlagListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentFlagListReply_List.ProtoReflect.Descriptor instead.
+func (*GetContentFlagListReply_List) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *GetContentFlagListReply_List) GetId() int32 {
 	if x != nil {
-		return x.Url
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetContentFlagListReply_List) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetLabel() string {
+func (x *GetContentFlagListReply_List) GetMode() string {
 	if x != nil {
-		return x.Label
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetResult() int32 {
+func (x *GetContentFlagListReply_List) GetContentId() int32 {
 	if x != nil {
-		return x.Result
+		return x.ContentId
 	}
 	return 0
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetScore() int32 {
+func (x *GetContentFlagListReply_List) GetUuid() string {
 	if x != nil {
-		return x.Score
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetCategory() string {
+func (x *GetContentFlagListReply_List) GetText() string {
 	if x != nil {
-		return x.Category
+		return x.Text
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetSubLabel() string {
+func (x *GetContentFlagListReply_List) GetJobId() string {
 	if x != nil {
-		return x.SubLabel
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetBucketId() string {
+func (x *GetContentFlagListReply_List) GetLabel() string {
 	if x != nil {
-		return x.BucketId
+		return x.Label
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetRegion() string {
+func (x *GetContentFlagListReply_List) GetSection() string {
 	if x != nil {
-		return x.Region
+		return x.Section
 	}
 	return ""
 }
 
-func (x *ImageReviewReq_JobsDetailStruct) GetCosHeaders() map[string]string {
+func (x *GetContentFlagListReply_List) GetStatus() int32 {
 	if x != nil {
-		return x.CosHeaders
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-type GetMessageSystemNotificationReply_List struct {
+func (x *GetContentFlagListReply_List) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetAppealListReply_List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ContentId        int32  `protobuf:"varint,2,opt,name=contentId,proto3" json:"contentId,omitempty"`
-	CreatedAt        string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	NotificationType string `protobuf:"bytes,4,opt,name=notificationType,proto3" json:"notificationType,omitempty"`
-	Title            string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Uid              string `protobuf:"bytes,6,opt,name=uid,proto3" json:"uid,omitempty"`
-	Uuid             string `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Label            string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	Result           int32  `protobuf:"varint,9,opt,name=result,proto3" json:"result,omitempty"`
-	Section          string `protobuf:"bytes,10,opt,name=section,proto3" json:"section,omitempty"`
-	Text             string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
-	Comment          string `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment,omitempty"`
-}
-
-func (x *GetMessageSystemNotificationReply_List) Reset() {
-	*x = GetMessageSystemNotificationReply_List{}
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId     string `protobuf:"bytes,2,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Uuid      string `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status    int32  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Kind      string `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	Mode      string `protobuf:"bytes,8,opt,name=mode,proto3" json:"mode,omitempty"`
+	ContentId int32  `protobuf:"varint,9,opt,name=contentId,proto3" json:"contentId,omitempty"`
+	Text      string `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
+	Label     string `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+	Section   string `protobuf:"bytes,12,opt,name=section,proto3" json:"section,omitempty"`
+}
+
+func (x *GetAppealListReply_List) Reset() {
+	*x = GetAppealListReply_List{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_service_v1_message_proto_msgTypes[21]
+		mi := &file_message_service_v1_message_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMessageSystemNotificationReply_List) String() string {
+func (x *GetAppealListReply_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageSystemNotificationReply_List) ProtoMessage() {}
+func (*GetAppealListReply_List) ProtoMessage() {}
 
-func (x *GetMessageSystemNotificationReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_message_service_v1_message_proto_msgTypes[21]
+func (x *GetAppealListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_message_service_v1_message_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1330,91 +2339,91 @@ func (x *GetMessageSystemNotificationReply_List) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageSystemNotificationReply_List.ProtoReflect.Descriptor instead.
-func (*GetMessageSystemNotificationReply_List) Descriptor() ([]byte, []int) {
-	return file_message_service_v1_message_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use GetAppealListReply_List.ProtoReflect.Descriptor instead.
+func (*GetAppealListReply_List) Descriptor() ([]byte, []int) {
+	return file_message_service_v1_message_proto_rawDescGZIP(), []int{21, 0}
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetId() int32 {
+func (x *GetAppealListReply_List) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetContentId() int32 {
+func (x *GetAppealListReply_List) GetJobId() string {
 	if x != nil {
-		return x.ContentId
+		return x.JobId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetCreatedAt() string {
+func (x *GetAppealListReply_List) GetUuid() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetNotificationType() string {
+func (x *GetAppealListReply_List) GetReason() string {
 	if x != nil {
-		return x.NotificationType
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetTitle() string {
+func (x *GetAppealListReply_List) GetStatus() int32 {
 	if x != nil {
-		return x.Title
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetUid() string {
+func (x *GetAppealListReply_List) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Uid
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetUuid() string {
+func (x *GetAppealListReply_List) GetKind() string {
 	if x != nil {
-		return x.Uuid
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetLabel() string {
+func (x *GetAppealListReply_List) GetMode() string {
 	if x != nil {
-		return x.Label
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetResult() int32 {
+func (x *GetAppealListReply_List) GetContentId() int32 {
 	if x != nil {
-		return x.Result
+		return x.ContentId
 	}
 	return 0
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetSection() string {
+func (x *GetAppealListReply_List) GetText() string {
 	if x != nil {
-		return x.Section
+		return x.Text
 	}
 	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetText() string {
+func (x *GetAppealListReply_List) GetLabel() string {
 	if x != nil {
-		return x.Text
+		return x.Label
 	}
 	return ""
 }
 
-func (x *GetMessageSystemNotificationReply_List) GetComment() string {
+func (x *GetAppealListReply_List) GetSection() string {
 	if x != nil {
-		return x.Comment
+		return x.Section
 	}
 	return ""
 }
@@ -1430,7 +2439,7 @@ var file_message_service_v1_message_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
 	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xf4, 0x05, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x74, 0x6f, 0x22, 0xee, 0x05, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
 	0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x4b, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74,
 	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69,
@@ -1438,7 +2447,7 @@ var file_message_service_v1_message_proto_rawDesc = []byte{
 	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61,
 	0x69, 0x6c, 0x12, 0x26, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52,
-	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xec, 0x04, 0x0a, 0x10, 0x4a,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xe6, 0x04, 0x0a, 0x10, 0x4a,
 	0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12,
 	0x1c, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
 	0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a,
@@ -1466,366 +2475,525 @@ var file_message_service_v1_message_proto_rawDesc = []byte{
 	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12,
 	0x20, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x12, 0x77, 0x0a, 0x0a, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x6e, 0x12, 0x71, 0x0a, 0x0a, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
 	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
 	0x71, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x72, 0x75,
 	0x63, 0x74, 0x2e, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x1a, 0xfa, 0x42, 0x0a, 0x9a, 0x01, 0x07, 0x2a, 0x05, 0x72, 0x03, 0x18, 0xe8,
-	0x07, 0xfa, 0x42, 0x0a, 0x9a, 0x01, 0x07, 0x22, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0a,
-	0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f,
-	0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x75, 0x0a, 0x0d, 0x54, 0x65, 0x78,
-	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x0a, 0x4a, 0x6f,
-	0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x73,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x4a, 0x6f,
-	0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x63, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15,
-	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
-	0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c,
-	0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
-	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xff, 0x01, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x51, 0x0a, 0x08,
-	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
-	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x1a, 0x3b, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2d,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x9e, 0x03, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x1a, 0xb0, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f,
-	0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72,
-	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x4c,
-	0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42,
-	0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x1f,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53, 0x75, 0x62,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa,
-	0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
-	0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x57, 0x0a,
-	0x27, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x72, 0x79, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x9a, 0x01, 0x0e, 0x22, 0x05, 0x72, 0x03, 0x18, 0xe8,
+	0x07, 0x2a, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0a, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x75, 0x0a, 0x0d, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x12, 0x26, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x22,
+	0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
 	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
-	0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x4b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x6c, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a,
-	0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x18, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6c,
-	0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
-	0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x4b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x16, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x62, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
-	0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x4b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07,
-	0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x3d, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a,
-	0x0a, 0x07, 0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x51, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3b, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78,
+	0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x63, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x9e, 0x03, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x1a, 0xb0, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x22, 0xd4, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0xfc, 0x01, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4d,
+	0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69,
+	0x6c, 0x42, 0x6f, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x22, 0x4f, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42,
+	0x6f, 0x78, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x22, 0x57, 0x0a, 0x27, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c,
+	0x42, 0x6f, 0x78, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15,
+	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x1c, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x30, 0x7d, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0xfa, 0x42, 0x3b, 0x72, 0x39, 0x32, 0x37, 0x5e, 0x28,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x7c, 0x74, 0x61, 0x6c, 0x6b, 0x7c, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x7c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7c,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x7c, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x29, 0x3f, 0x24, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x80, 0x02,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x27, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xfa, 0x42, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xf4, 0x03,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x2f, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
+	0xfa, 0x42, 0x18, 0x72, 0x16, 0x32, 0x14, 0x5e, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x30, 0x7d, 0x29, 0x3f, 0x24, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe8, 0x02, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x37, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x98, 0x02, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6d, 0x0a, 0x15, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52,
+	0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x6c, 0x0a, 0x14, 0x53, 0x65, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x07, 0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x46, 0x0a, 0x0b, 0x49, 0x6c,
-	0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6c, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x49, 0x6c, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x62, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x75, 0x73, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x09, 0x41, 0x62, 0x75, 0x73, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe4, 0x04, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8,
-	0x07, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
-	0xe8, 0x07, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x4a,
-	0x6f, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x44,
-	0x61, 0x74, 0x61, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1e, 0x0a,
-	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x06, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x0a,
-	0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8,
-	0x07, 0x52, 0x08, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x52,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x68, 0x0a,
-	0x0a, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
-	0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
-	0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x1a, 0xfa, 0x42, 0x0a, 0x9a, 0x01, 0x07, 0x2a, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0xfa, 0x42,
-	0x0a, 0x9a, 0x01, 0x07, 0x22, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0a, 0x43, 0x6f, 0x73,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xfa, 0x14, 0x0a, 0x07,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x68, 0x0a, 0x0c, 0x41, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01,
-	0x2a, 0x12, 0x66, 0x0a, 0x0b, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x74,
-	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x2f,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x11,
-	0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x74,
-	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a,
-	0x12, 0x75, 0x0a, 0x12, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x25, 0x22, 0x20, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x10, 0x54, 0x61, 0x6c, 0x6b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2f, 0x74, 0x61, 0x6c, 0x6b, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0e, 0x54, 0x61, 0x6c,
-	0x6b, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2f, 0x74, 0x61, 0x6c, 0x6b, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x0f, 0x54, 0x61, 0x6c, 0x6b, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2f, 0x74, 0x61, 0x6c, 0x6b, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x74, 0x78, 0x2f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x2f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x70,
-	0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f,
-	0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a,
-	0x12, 0x73, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x4b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x18, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6c, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52,
+	0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x16, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x48, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52,
+	0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x0d, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x50, 0x6f, 0x72, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x07, 0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x41, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x46,
+	0x0a, 0x0b, 0x49, 0x6c, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6c, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x49, 0x6c, 0x6c, 0x65, 0x67,
+	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x62, 0x75, 0x73, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62,
+	0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x09, 0x41,
+	0x62, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xde, 0x04, 0x0a, 0x10, 0x4a, 0x6f, 0x62,
+	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x1c, 0x0a,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1e, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x06, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x44, 0x61, 0x74, 0x61, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8,
+	0x07, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x1e, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x62, 0x0a, 0x0a, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x9a, 0x01, 0x0e, 0x22, 0x05, 0x72, 0x03, 0x18,
+	0xe8, 0x07, 0x2a, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0a, 0x43, 0x6f, 0x73, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f,
+	0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xe2, 0x1a, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x68, 0x0a, 0x0c, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x52,
 	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
 	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x24, 0x22, 0x1f, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x22, 0x19, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12,
+	0x66, 0x0a, 0x0b, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x74, 0x78, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a,
+	0x01, 0x2a, 0x12, 0x76, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x11, 0x41, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x74, 0x78, 0x2f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x2f,
+	0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x75,
+	0x0a, 0x12, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x22, 0x20, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x6c, 0x65, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x10, 0x54, 0x61, 0x6c, 0x6b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2f, 0x74, 0x61, 0x6c, 0x6b, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0e, 0x54, 0x61, 0x6c, 0x6b, 0x45,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2f, 0x74, 0x61, 0x6c, 0x6b, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x0f, 0x54, 0x61, 0x6c, 0x6b, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2f, 0x74, 0x61, 0x6c, 0x6b, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x2f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x10,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
 	0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
 	0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x74, 0x78,
-	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19,
+	0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x74, 0x78,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x2f,
+	0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x73,
+	0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22,
+	0x1f, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74,
 	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x74, 0x78, 0x2f, 0x6d,
+	0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x74, 0x78, 0x2f, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01,
-	0x2a, 0x12, 0x76, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x16, 0x53, 0x75, 0x62,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24,
-	0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x63,
+	0x6e, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12,
+	0x76, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x22, 0x21, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x63,
 	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76,
-	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x6a, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x23, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24, 0x2f, 0x74,
+	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x3a, 0x01, 0x2a, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x65, 0x74, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x6a, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69,
 	0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69,
 	0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x19, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f,
-	0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x1c, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53, 0x75, 0x62, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61,
-	0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x75, 0x0a, 0x24, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42,
-	0x6f, 0x78, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69,
-	0x6c, 0x42, 0x6f, 0x78, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x23, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c,
+	0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4c,
+	0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61,
+	0x69, 0x6c, 0x42, 0x6f, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78,
+	0x53, 0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x24,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69,
+	0x6c, 0x42, 0x6f, 0x78, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x78, 0x4d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x20, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x1b,
+	0x5a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1840,7 +3008,7 @@ func file_message_service_v1_message_proto_rawDescGZIP() []byte {
 	return file_message_service_v1_message_proto_rawDescData
 }
 
-var file_message_service_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_message_service_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_message_service_v1_message_proto_goTypes = []interface{}{
 	(*ImageReviewReq)(nil),                          // 0: message.v1.ImageReviewReq
 	(*TextReviewReq)(nil),                           // 1: message.v1.TextReviewReq
@@ -1850,88 +3018,124 @@ var file_message_service_v1_message_proto_goTypes = []interface{}{
 	(*GetMailBoxLastTimeReply)(nil),                 // 5: message.v1.GetMailBoxLastTimeReply
 	(*GetMessageSystemNotificationReq)(nil),         // 6: message.v1.GetMessageSystemNotificationReq
 	(*GetMessageSystemNotificationReply)(nil),       // 7: message.v1.GetMessageSystemNotificationReply
-	(*SetMailBoxLastTimeReq)(nil),                   // 8: message.v1.SetMailBoxLastTimeReq
-	(*RemoveMailBoxCommentCountReq)(nil),            // 9: message.v1.RemoveMailBoxCommentCountReq
-	(*RemoveMailBoxSubCommentCountReq)(nil),         // 10: message.v1.RemoveMailBoxSubCommentCountReq
-	(*RemoveMailBoxSystemNotificationCountReq)(nil), // 11: message.v1.RemoveMailBoxSystemNotificationCountReq
-	(*SectionPornInfoStruct)(nil),                   // 12: message.v1.SectionPornInfoStruct
-	(*SectionAdsInfoStruct)(nil),                    // 13: message.v1.SectionAdsInfoStruct
-	(*SectionIllegalInfoStruct)(nil),                // 14: message.v1.SectionIllegalInfoStruct
-	(*SectionAbuseInfoStruct)(nil),                  // 15: message.v1.SectionAbuseInfoStruct
-	(*SectionStruct)(nil),                           // 16: message.v1.SectionStruct
-	(*JobsDetailStruct)(nil),                        // 17: message.v1.JobsDetailStruct
-	(*ImageReviewReq_JobsDetailStruct)(nil),         // 18: message.v1.ImageReviewReq.JobsDetailStruct
-	nil,                                             // 19: message.v1.ImageReviewReq.JobsDetailStruct.CosHeadersEntry
-	nil,                                             // 20: message.v1.GetMessageNotificationReply.TimelineEntry
-	(*GetMessageSystemNotificationReply_List)(nil),  // 21: message.v1.GetMessageSystemNotificationReply.List
-	nil,                   // 22: message.v1.JobsDetailStruct.CosHeadersEntry
-	(*emptypb.Empty)(nil), // 23: google.protobuf.Empty
+	(*GetMessageMentionReq)(nil),                    // 8: message.v1.GetMessageMentionReq
+	(*GetMessageMentionReply)(nil),                  // 9: message.v1.GetMessageMentionReply
+	(*SetMailBoxLastTimeReq)(nil),                   // 10: message.v1.SetMailBoxLastTimeReq
+	(*RemoveMailBoxCommentCountReq)(nil),            // 11: message.v1.RemoveMailBoxCommentCountReq
+	(*RemoveMailBoxSubCommentCountReq)(nil),         // 12: message.v1.RemoveMailBoxSubCommentCountReq
+	(*RemoveMailBoxSystemNotificationCountReq)(nil), // 13: message.v1.RemoveMailBoxSystemNotificationCountReq
+	(*RemoveMailBoxMentionCountReq)(nil),            // 14: message.v1.RemoveMailBoxMentionCountReq
+	(*GetContentFlagListReq)(nil),                   // 15: message.v1.GetContentFlagListReq
+	(*GetContentFlagListReply)(nil),                 // 16: message.v1.GetContentFlagListReply
+	(*PublishContentFlagReq)(nil),                   // 17: message.v1.PublishContentFlagReq
+	(*RemoveContentFlagReq)(nil),                    // 18: message.v1.RemoveContentFlagReq
+	(*AppealReviewReq)(nil),                         // 19: message.v1.AppealReviewReq
+	(*GetAppealListReq)(nil),                        // 20: message.v1.GetAppealListReq
+	(*GetAppealListReply)(nil),                      // 21: message.v1.GetAppealListReply
+	(*AcceptAppealReq)(nil),                         // 22: message.v1.AcceptAppealReq
+	(*RejectAppealReq)(nil),                         // 23: message.v1.RejectAppealReq
+	(*SectionPornInfoStruct)(nil),                   // 24: message.v1.SectionPornInfoStruct
+	(*SectionAdsInfoStruct)(nil),                    // 25: message.v1.SectionAdsInfoStruct
+	(*SectionIllegalInfoStruct)(nil),                // 26: message.v1.SectionIllegalInfoStruct
+	(*SectionAbuseInfoStruct)(nil),                  // 27: message.v1.SectionAbuseInfoStruct
+	(*SectionStruct)(nil),                           // 28: message.v1.SectionStruct
+	(*JobsDetailStruct)(nil),                        // 29: message.v1.JobsDetailStruct
+	(*ImageReviewReq_JobsDetailStruct)(nil),         // 30: message.v1.ImageReviewReq.JobsDetailStruct
+	nil,                                             // 31: message.v1.ImageReviewReq.JobsDetailStruct.CosHeadersEntry
+	nil,                                             // 32: message.v1.GetMessageNotificationReply.TimelineEntry
+	(*GetMessageSystemNotificationReply_List)(nil),  // 33: message.v1.GetMessageSystemNotificationReply.List
+	(*GetMessageMentionReply_List)(nil),             // 34: message.v1.GetMessageMentionReply.List
+	(*GetContentFlagListReply_List)(nil),            // 35: message.v1.GetContentFlagListReply.List
+	(*GetAppealListReply_List)(nil),                 // 36: message.v1.GetAppealListReply.List
+	nil,                                             // 37: message.v1.JobsDetailStruct.CosHeadersEntry
+	(*emptypb.Empty)(nil),                           // 38: google.protobuf.Empty
 }
 var file_message_service_v1_message_proto_depIdxs = []int32{
-	18, // 0: message.v1.ImageReviewReq.JobsDetail:type_name -> message.v1.ImageReviewReq.JobsDetailStruct
-	17, // 1: message.v1.TextReviewReq.JobsDetail:type_name -> message.v1.JobsDetailStruct
-	20, // 2: message.v1.GetMessageNotificationReply.timeline:type_name -> message.v1.GetMessageNotificationReply.TimelineEntry
-	21, // 3: message.v1.GetMessageSystemNotificationReply.list:type_name -> message.v1.GetMessageSystemNotificationReply.List
-	12, // 4: message.v1.SectionStruct.PornInfo:type_name -> message.v1.SectionPornInfoStruct
-	13, // 5: message.v1.SectionStruct.AdsInfo:type_name -> message.v1.SectionAdsInfoStruct
-	14, // 6: message.v1.SectionStruct.IllegalInfo:type_name -> message.v1.SectionIllegalInfoStruct
-	15, // 7: message.v1.SectionStruct.AbuseInfo:type_name -> message.v1.SectionAbuseInfoStruct
-	22, // 8: message.v1.JobsDetailStruct.CosHeaders:type_name -> message.v1.JobsDetailStruct.CosHeadersEntry
-	16, // 9: message.v1.JobsDetailStruct.Section:type_name -> message.v1.SectionStruct
-	19, // 10: message.v1.ImageReviewReq.JobsDetailStruct.CosHeaders:type_name -> message.v1.ImageReviewReq.JobsDetailStruct.CosHeadersEntry
-	0,  // 11: message.v1.Message.AvatarReview:input_type -> message.v1.ImageReviewReq
-	0,  // 12: message.v1.Message.CoverReview:input_type -> message.v1.ImageReviewReq
-	1,  // 13: message.v1.Message.ProfileReview:input_type -> message.v1.TextReviewReq
-	1,  // 14: message.v1.Message.ArticleCreateReview:input_type -> message.v1.TextReviewReq
-	1,  // 15: message.v1.Message.ArticleEditReview:input_type -> message.v1.TextReviewReq
-	0,  // 16: message.v1.Message.ArticleImageReview:input_type -> message.v1.ImageReviewReq
-	1,  // 17: message.v1.Message.TalkCreateReview:input_type -> message.v1.TextReviewReq
-	1,  // 18: message.v1.Message.TalkEditReview:input_type -> message.v1.TextReviewReq
-	0,  // 19: message.v1.Message.TalkImageReview:input_type -> message.v1.ImageReviewReq
-	1,  // 20: message.v1.Message.ColumnCreateReview:input_type -> message.v1.TextReviewReq
-	1,  // 21: message.v1.Message.ColumnEditReview:input_type -> message.v1.TextReviewReq
-	0,  // 22: message.v1.Message.ColumnImageReview:input_type -> message.v1.ImageReviewReq
-	1,  // 23: message.v1.Message.CollectionsCreateReview:input_type -> message.v1.TextReviewReq
-	1,  // 24: message.v1.Message.CollectionsEditReview:input_type -> message.v1.TextReviewReq
-	1,  // 25: message.v1.Message.CommentCreateReview:input_type -> message.v1.TextReviewReq
-	1,  // 26: message.v1.Message.SubCommentCreateReview:input_type -> message.v1.TextReviewReq
-	23, // 27: message.v1.Message.GetHealth:input_type -> google.protobuf.Empty
-	2,  // 28: message.v1.Message.GetMessageNotification:input_type -> message.v1.GetMessageNotificationReq
-	3,  // 29: message.v1.Message.GetMailBoxLastTime:input_type -> message.v1.GetMailBoxLastTimeReq
-	6,  // 30: message.v1.Message.GetMessageSystemNotification:input_type -> message.v1.GetMessageSystemNotificationReq
-	8,  // 31: message.v1.Message.SetMailBoxLastTime:input_type -> message.v1.SetMailBoxLastTimeReq
-	9,  // 32: message.v1.Message.RemoveMailBoxCommentCount:input_type -> message.v1.RemoveMailBoxCommentCountReq
-	10, // 33: message.v1.Message.RemoveMailBoxSubCommentCount:input_type -> message.v1.RemoveMailBoxSubCommentCountReq
-	11, // 34: message.v1.Message.RemoveMailBoxSystemNotificationCount:input_type -> message.v1.RemoveMailBoxSystemNotificationCountReq
-	23, // 35: message.v1.Message.AvatarReview:output_type -> google.protobuf.Empty
-	23, // 36: message.v1.Message.CoverReview:output_type -> google.protobuf.Empty
-	23, // 37: message.v1.Message.ProfileReview:output_type -> google.protobuf.Empty
-	23, // 38: message.v1.Message.ArticleCreateReview:output_type -> google.protobuf.Empty
-	23, // 39: message.v1.Message.ArticleEditReview:output_type -> google.protobuf.Empty
-	23, // 40: message.v1.Message.ArticleImageReview:output_type -> google.protobuf.Empty
-	23, // 41: message.v1.Message.TalkCreateReview:output_type -> google.protobuf.Empty
-	23, // 42: message.v1.Message.TalkEditReview:output_type -> google.protobuf.Empty
-	23, // 43: message.v1.Message.TalkImageReview:output_type -> google.protobuf.Empty
-	23, // 44: message.v1.Message.ColumnCreateReview:output_type -> google.protobuf.Empty
-	23, // 45: message.v1.Message.ColumnEditReview:output_type -> google.protobuf.Empty
-	23, // 46: message.v1.Message.ColumnImageReview:output_type -> google.protobuf.Empty
-	23, // 47: message.v1.Message.CollectionsCreateReview:output_type -> google.protobuf.Empty
-	23, // 48: message.v1.Message.CollectionsEditReview:output_type -> google.protobuf.Empty
-	23, // 49: message.v1.Message.CommentCreateReview:output_type -> google.protobuf.Empty
-	23, // 50: message.v1.Message.SubCommentCreateReview:output_type -> google.protobuf.Empty
-	23, // 51: message.v1.Message.GetHealth:output_type -> google.protobuf.Empty
-	4,  // 52: message.v1.Message.GetMessageNotification:output_type -> message.v1.GetMessageNotificationReply
-	5,  // 53: message.v1.Message.GetMailBoxLastTime:output_type -> message.v1.GetMailBoxLastTimeReply
-	7,  // 54: message.v1.Message.GetMessageSystemNotification:output_type -> message.v1.GetMessageSystemNotificationReply
-	23, // 55: message.v1.Message.SetMailBoxLastTime:output_type -> google.protobuf.Empty
-	23, // 56: message.v1.Message.RemoveMailBoxCommentCount:output_type -> google.protobuf.Empty
-	23, // 57: message.v1.Message.RemoveMailBoxSubCommentCount:output_type -> google.protobuf.Empty
-	23, // 58: message.v1.Message.RemoveMailBoxSystemNotificationCount:output_type -> google.protobuf.Empty
-	35, // [35:59] is the sub-list for method output_type
-	11, // [11:35] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	30, // 0: message.v1.ImageReviewReq.JobsDetail:type_name -> message.v1.ImageReviewReq.JobsDetailStruct
+	29, // 1: message.v1.TextReviewReq.JobsDetail:type_name -> message.v1.JobsDetailStruct
+	32, // 2: message.v1.GetMessageNotificationReply.timeline:type_name -> message.v1.GetMessageNotificationReply.TimelineEntry
+	33, // 3: message.v1.GetMessageSystemNotificationReply.list:type_name -> message.v1.GetMessageSystemNotificationReply.List
+	34, // 4: message.v1.GetMessageMentionReply.list:type_name -> message.v1.GetMessageMentionReply.List
+	35, // 5: message.v1.GetContentFlagListReply.list:type_name -> message.v1.GetContentFlagListReply.List
+	36, // 6: message.v1.GetAppealListReply.list:type_name -> message.v1.GetAppealListReply.List
+	24, // 7: message.v1.SectionStruct.PornInfo:type_name -> message.v1.SectionPornInfoStruct
+	25, // 8: message.v1.SectionStruct.AdsInfo:type_name -> message.v1.SectionAdsInfoStruct
+	26, // 9: message.v1.SectionStruct.IllegalInfo:type_name -> message.v1.SectionIllegalInfoStruct
+	27, // 10: message.v1.SectionStruct.AbuseInfo:type_name -> message.v1.SectionAbuseInfoStruct
+	37, // 11: message.v1.JobsDetailStruct.CosHeaders:type_name -> message.v1.JobsDetailStruct.CosHeadersEntry
+	28, // 12: message.v1.JobsDetailStruct.Section:type_name -> message.v1.SectionStruct
+	31, // 13: message.v1.ImageReviewReq.JobsDetailStruct.CosHeaders:type_name -> message.v1.ImageReviewReq.JobsDetailStruct.CosHeadersEntry
+	0,  // 14: message.v1.Message.AvatarReview:input_type -> message.v1.ImageReviewReq
+	0,  // 15: message.v1.Message.CoverReview:input_type -> message.v1.ImageReviewReq
+	1,  // 16: message.v1.Message.ProfileReview:input_type -> message.v1.TextReviewReq
+	1,  // 17: message.v1.Message.ArticleCreateReview:input_type -> message.v1.TextReviewReq
+	1,  // 18: message.v1.Message.ArticleEditReview:input_type -> message.v1.TextReviewReq
+	0,  // 19: message.v1.Message.ArticleImageReview:input_type -> message.v1.ImageReviewReq
+	1,  // 20: message.v1.Message.TalkCreateReview:input_type -> message.v1.TextReviewReq
+	1,  // 21: message.v1.Message.TalkEditReview:input_type -> message.v1.TextReviewReq
+	0,  // 22: message.v1.Message.TalkImageReview:input_type -> message.v1.ImageReviewReq
+	1,  // 23: message.v1.Message.ColumnCreateReview:input_type -> message.v1.TextReviewReq
+	1,  // 24: message.v1.Message.ColumnEditReview:input_type -> message.v1.TextReviewReq
+	0,  // 25: message.v1.Message.ColumnImageReview:input_type -> message.v1.ImageReviewReq
+	1,  // 26: message.v1.Message.CollectionsCreateReview:input_type -> message.v1.TextReviewReq
+	1,  // 27: message.v1.Message.CollectionsEditReview:input_type -> message.v1.TextReviewReq
+	1,  // 28: message.v1.Message.CommentCreateReview:input_type -> message.v1.TextReviewReq
+	1,  // 29: message.v1.Message.SubCommentCreateReview:input_type -> message.v1.TextReviewReq
+	38, // 30: message.v1.Message.GetHealth:input_type -> google.protobuf.Empty
+	2,  // 31: message.v1.Message.GetMessageNotification:input_type -> message.v1.GetMessageNotificationReq
+	3,  // 32: message.v1.Message.GetMailBoxLastTime:input_type -> message.v1.GetMailBoxLastTimeReq
+	6,  // 33: message.v1.Message.GetMessageSystemNotification:input_type -> message.v1.GetMessageSystemNotificationReq
+	8,  // 34: message.v1.Message.GetMessageMention:input_type -> message.v1.GetMessageMentionReq
+	10, // 35: message.v1.Message.SetMailBoxLastTime:input_type -> message.v1.SetMailBoxLastTimeReq
+	11, // 36: message.v1.Message.RemoveMailBoxCommentCount:input_type -> message.v1.RemoveMailBoxCommentCountReq
+	12, // 37: message.v1.Message.RemoveMailBoxSubCommentCount:input_type -> message.v1.RemoveMailBoxSubCommentCountReq
+	13, // 38: message.v1.Message.RemoveMailBoxSystemNotificationCount:input_type -> message.v1.RemoveMailBoxSystemNotificationCountReq
+	14, // 39: message.v1.Message.RemoveMailBoxMentionCount:input_type -> message.v1.RemoveMailBoxMentionCountReq
+	15, // 40: message.v1.Message.GetContentFlagList:input_type -> message.v1.GetContentFlagListReq
+	17, // 41: message.v1.Message.PublishContentFlag:input_type -> message.v1.PublishContentFlagReq
+	18, // 42: message.v1.Message.RemoveContentFlag:input_type -> message.v1.RemoveContentFlagReq
+	19, // 43: message.v1.Message.AppealReview:input_type -> message.v1.AppealReviewReq
+	20, // 44: message.v1.Message.GetAppealList:input_type -> message.v1.GetAppealListReq
+	22, // 45: message.v1.Message.AcceptAppeal:input_type -> message.v1.AcceptAppealReq
+	23, // 46: message.v1.Message.RejectAppeal:input_type -> message.v1.RejectAppealReq
+	38, // 47: message.v1.Message.AvatarReview:output_type -> google.protobuf.Empty
+	38, // 48: message.v1.Message.CoverReview:output_type -> google.protobuf.Empty
+	38, // 49: message.v1.Message.ProfileReview:output_type -> google.protobuf.Empty
+	38, // 50: message.v1.Message.ArticleCreateReview:output_type -> google.protobuf.Empty
+	38, // 51: message.v1.Message.ArticleEditReview:output_type -> google.protobuf.Empty
+	38, // 52: message.v1.Message.ArticleImageReview:output_type -> google.protobuf.Empty
+	38, // 53: message.v1.Message.TalkCreateReview:output_type -> google.protobuf.Empty
+	38, // 54: message.v1.Message.TalkEditReview:output_type -> google.protobuf.Empty
+	38, // 55: message.v1.Message.TalkImageReview:output_type -> google.protobuf.Empty
+	38, // 56: message.v1.Message.ColumnCreateReview:output_type -> google.protobuf.Empty
+	38, // 57: message.v1.Message.ColumnEditReview:output_type -> google.protobuf.Empty
+	38, // 58: message.v1.Message.ColumnImageReview:output_type -> google.protobuf.Empty
+	38, // 59: message.v1.Message.CollectionsCreateReview:output_type -> google.protobuf.Empty
+	38, // 60: message.v1.Message.CollectionsEditReview:output_type -> google.protobuf.Empty
+	38, // 61: message.v1.Message.CommentCreateReview:output_type -> google.protobuf.Empty
+	38, // 62: message.v1.Message.SubCommentCreateReview:output_type -> google.protobuf.Empty
+	38, // 63: message.v1.Message.GetHealth:output_type -> google.protobuf.Empty
+	4,  // 64: message.v1.Message.GetMessageNotification:output_type -> message.v1.GetMessageNotificationReply
+	5,  // 65: message.v1.Message.GetMailBoxLastTime:output_type -> message.v1.GetMailBoxLastTimeReply
+	7,  // 66: message.v1.Message.GetMessageSystemNotification:output_type -> message.v1.GetMessageSystemNotificationReply
+	9,  // 67: message.v1.Message.GetMessageMention:output_type -> message.v1.GetMessageMentionReply
+	38, // 68: message.v1.Message.SetMailBoxLastTime:output_type -> google.protobuf.Empty
+	38, // 69: message.v1.Message.RemoveMailBoxCommentCount:output_type -> google.protobuf.Empty
+	38, // 70: message.v1.Message.RemoveMailBoxSubCommentCount:output_type -> google.protobuf.Empty
+	38, // 71: message.v1.Message.RemoveMailBoxSystemNotificationCount:output_type -> google.protobuf.Empty
+	38, // 72: message.v1.Message.RemoveMailBoxMentionCount:output_type -> google.protobuf.Empty
+	16, // 73: message.v1.Message.GetContentFlagList:output_type -> message.v1.GetContentFlagListReply
+	38, // 74: message.v1.Message.PublishContentFlag:output_type -> google.protobuf.Empty
+	38, // 75: message.v1.Message.RemoveContentFlag:output_type -> google.protobuf.Empty
+	38, // 76: message.v1.Message.AppealReview:output_type -> google.protobuf.Empty
+	21, // 77: message.v1.Message.GetAppealList:output_type -> message.v1.GetAppealListReply
+	38, // 78: message.v1.Message.AcceptAppeal:output_type -> google.protobuf.Empty
+	38, // 79: message.v1.Message.RejectAppeal:output_type -> google.protobuf.Empty
+	47, // [47:80] is the sub-list for method output_type
+	14, // [14:47] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_message_service_v1_message_proto_init() }
@@ -2037,7 +3241,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMailBoxLastTimeReq); i {
+			switch v := v.(*GetMessageMentionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2049,7 +3253,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMailBoxCommentCountReq); i {
+			switch v := v.(*GetMessageMentionReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2061,7 +3265,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMailBoxSubCommentCountReq); i {
+			switch v := v.(*SetMailBoxLastTimeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2073,7 +3277,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveMailBoxSystemNotificationCountReq); i {
+			switch v := v.(*RemoveMailBoxCommentCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2085,7 +3289,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SectionPornInfoStruct); i {
+			switch v := v.(*RemoveMailBoxSubCommentCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2097,7 +3301,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SectionAdsInfoStruct); i {
+			switch v := v.(*RemoveMailBoxSystemNotificationCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2109,7 +3313,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SectionIllegalInfoStruct); i {
+			switch v := v.(*RemoveMailBoxMentionCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2121,7 +3325,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SectionAbuseInfoStruct); i {
+			switch v := v.(*GetContentFlagListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2133,7 +3337,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SectionStruct); i {
+			switch v := v.(*GetContentFlagListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2145,7 +3349,7 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobsDetailStruct); i {
+			switch v := v.(*PublishContentFlagReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2157,7 +3361,31 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageReviewReq_JobsDetailStruct); i {
+			switch v := v.(*RemoveContentFlagReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppealReviewReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppealListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2169,6 +3397,126 @@ func file_message_service_v1_message_proto_init() {
 			}
 		}
 		file_message_service_v1_message_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppealListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptAppealReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectAppealReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionPornInfoStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionAdsInfoStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionIllegalInfoStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionAbuseInfoStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobsDetailStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageReviewReq_JobsDetailStruct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMessageSystemNotificationReply_List); i {
 			case 0:
 				return &v.state
@@ -2180,6 +3528,42 @@ func file_message_service_v1_message_proto_init() {
 				return nil
 			}
 		}
+		file_message_service_v1_message_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageMentionReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContentFlagListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_service_v1_message_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppealListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2187,7 +3571,7 @@ func file_message_service_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_message_service_v1_message_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -575,6 +575,8 @@ func (m *GetMessageNotificationReply) validate(all bool) error {
 
 	// no validation rules for System
 
+	// no validation rules for Mention
+
 	if len(errors) > 0 {
 		return GetMessageNotificationReplyMultiError(errors)
 	}
@@ -1017,6 +1019,259 @@ var _ interface {
 	ErrorName() string
 } = GetMessageSystemNotificationReplyValidationError{}
 
+// Validate checks the field values on GetMessageMentionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageMentionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageMentionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageMentionReqMultiError, or nil if none found.
+func (m *GetMessageMentionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageMentionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetMessageMentionReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetMessageMentionReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMessageMentionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageMentionReqMultiError is an error wrapping multiple validation
+// errors returned by GetMessageMentionReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageMentionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageMentionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageMentionReqMultiError) AllErrors() []error { return m }
+
+// GetMessageMentionReqValidationError is the validation error returned by
+// GetMessageMentionReq.Validate if the designated constraints aren't met.
+type GetMessageMentionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageMentionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageMentionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageMentionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageMentionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageMentionReqValidationError) ErrorName() string {
+	return "GetMessageMentionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageMentionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageMentionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageMentionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageMentionReqValidationError{}
+
+var _GetMessageMentionReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetMessageMentionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageMentionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageMentionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageMentionReplyMultiError, or nil if none found.
+func (m *GetMessageMentionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageMentionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMessageMentionReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMessageMentionReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMessageMentionReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMessageMentionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageMentionReplyMultiError is an error wrapping multiple validation
+// errors returned by GetMessageMentionReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageMentionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageMentionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageMentionReplyMultiError) AllErrors() []error { return m }
+
+// GetMessageMentionReplyValidationError is the validation error returned by
+// GetMessageMentionReply.Validate if the designated constraints aren't met.
+type GetMessageMentionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageMentionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageMentionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageMentionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageMentionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageMentionReplyValidationError) ErrorName() string {
+	return "GetMessageMentionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageMentionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageMentionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageMentionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageMentionReplyValidationError{}
+
 // Validate checks the field values on SetMailBoxLastTimeReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1485,36 +1740,32 @@ var _ interface {
 
 var _RemoveMailBoxSystemNotificationCountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on SectionPornInfoStruct with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveMailBoxMentionCountReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SectionPornInfoStruct) Validate() error {
+func (m *RemoveMailBoxMentionCountReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SectionPornInfoStruct with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RemoveMailBoxMentionCountReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SectionPornInfoStructMultiError, or nil if none found.
-func (m *SectionPornInfoStruct) ValidateAll() error {
+// RemoveMailBoxMentionCountReqMultiError, or nil if none found.
+func (m *RemoveMailBoxMentionCountReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SectionPornInfoStruct) validate(all bool) error {
+func (m *RemoveMailBoxMentionCountReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HitFlag
-
-	// no validation rules for Score
-
-	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
-		err := SectionPornInfoStructValidationError{
-			field:  "Keywords",
-			reason: "value length must be at most 1000 runes",
+	if !_RemoveMailBoxMentionCountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := RemoveMailBoxMentionCountReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
 		if !all {
 			return err
@@ -1523,19 +1774,19 @@ func (m *SectionPornInfoStruct) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SectionPornInfoStructMultiError(errors)
+		return RemoveMailBoxMentionCountReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SectionPornInfoStructMultiError is an error wrapping multiple validation
-// errors returned by SectionPornInfoStruct.ValidateAll() if the designated
-// constraints aren't met.
-type SectionPornInfoStructMultiError []error
+// RemoveMailBoxMentionCountReqMultiError is an error wrapping multiple
+// validation errors returned by RemoveMailBoxMentionCountReq.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveMailBoxMentionCountReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SectionPornInfoStructMultiError) Error() string {
+func (m RemoveMailBoxMentionCountReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1544,11 +1795,12 @@ func (m SectionPornInfoStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SectionPornInfoStructMultiError) AllErrors() []error { return m }
+func (m RemoveMailBoxMentionCountReqMultiError) AllErrors() []error { return m }
 
-// SectionPornInfoStructValidationError is the validation error returned by
-// SectionPornInfoStruct.Validate if the designated constraints aren't met.
-type SectionPornInfoStructValidationError struct {
+// RemoveMailBoxMentionCountReqValidationError is the validation error returned
+// by RemoveMailBoxMentionCountReq.Validate if the designated constraints
+// aren't met.
+type RemoveMailBoxMentionCountReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1556,24 +1808,24 @@ type SectionPornInfoStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e SectionPornInfoStructValidationError) Field() string { return e.field }
+func (e RemoveMailBoxMentionCountReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SectionPornInfoStructValidationError) Reason() string { return e.reason }
+func (e RemoveMailBoxMentionCountReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SectionPornInfoStructValidationError) Cause() error { return e.cause }
+func (e RemoveMailBoxMentionCountReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SectionPornInfoStructValidationError) Key() bool { return e.key }
+func (e RemoveMailBoxMentionCountReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SectionPornInfoStructValidationError) ErrorName() string {
-	return "SectionPornInfoStructValidationError"
+func (e RemoveMailBoxMentionCountReqValidationError) ErrorName() string {
+	return "RemoveMailBoxMentionCountReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SectionPornInfoStructValidationError) Error() string {
+func (e RemoveMailBoxMentionCountReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1585,14 +1837,14 @@ func (e SectionPornInfoStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSectionPornInfoStruct.%s: %s%s",
+		"invalid %sRemoveMailBoxMentionCountReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SectionPornInfoStructValidationError{}
+var _ error = RemoveMailBoxMentionCountReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1600,38 +1852,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SectionPornInfoStructValidationError{}
+} = RemoveMailBoxMentionCountReqValidationError{}
 
-// Validate checks the field values on SectionAdsInfoStruct with the rules
+var _RemoveMailBoxMentionCountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetContentFlagListReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SectionAdsInfoStruct) Validate() error {
+func (m *GetContentFlagListReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SectionAdsInfoStruct with the rules
+// ValidateAll checks the field values on GetContentFlagListReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SectionAdsInfoStructMultiError, or nil if none found.
-func (m *SectionAdsInfoStruct) ValidateAll() error {
+// GetContentFlagListReqMultiError, or nil if none found.
+func (m *GetContentFlagListReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SectionAdsInfoStruct) validate(all bool) error {
+func (m *GetContentFlagListReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HitFlag
-
-	// no validation rules for Score
+	// no validation rules for Page
 
-	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
-		err := SectionAdsInfoStructValidationError{
-			field:  "Keywords",
-			reason: "value length must be at most 1000 runes",
+	if !_GetContentFlagListReq_Kind_Pattern.MatchString(m.GetKind()) {
+		err := GetContentFlagListReqValidationError{
+			field:  "Kind",
+			reason: "value does not match regex pattern \"^(article|talk|column|collections|comment|subComment)?$\"",
 		}
 		if !all {
 			return err
@@ -1639,20 +1891,22 @@ func (m *SectionAdsInfoStruct) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return SectionAdsInfoStructMultiError(errors)
+		return GetContentFlagListReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SectionAdsInfoStructMultiError is an error wrapping multiple validation
-// errors returned by SectionAdsInfoStruct.ValidateAll() if the designated
+// GetContentFlagListReqMultiError is an error wrapping multiple validation
+// errors returned by GetContentFlagListReq.ValidateAll() if the designated
 // constraints aren't met.
-type SectionAdsInfoStructMultiError []error
+type GetContentFlagListReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SectionAdsInfoStructMultiError) Error() string {
+func (m GetContentFlagListReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1661,11 +1915,11 @@ func (m SectionAdsInfoStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SectionAdsInfoStructMultiError) AllErrors() []error { return m }
+func (m GetContentFlagListReqMultiError) AllErrors() []error { return m }
 
-// SectionAdsInfoStructValidationError is the validation error returned by
-// SectionAdsInfoStruct.Validate if the designated constraints aren't met.
-type SectionAdsInfoStructValidationError struct {
+// GetContentFlagListReqValidationError is the validation error returned by
+// GetContentFlagListReq.Validate if the designated constraints aren't met.
+type GetContentFlagListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1673,24 +1927,24 @@ type SectionAdsInfoStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e SectionAdsInfoStructValidationError) Field() string { return e.field }
+func (e GetContentFlagListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SectionAdsInfoStructValidationError) Reason() string { return e.reason }
+func (e GetContentFlagListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SectionAdsInfoStructValidationError) Cause() error { return e.cause }
+func (e GetContentFlagListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SectionAdsInfoStructValidationError) Key() bool { return e.key }
+func (e GetContentFlagListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SectionAdsInfoStructValidationError) ErrorName() string {
-	return "SectionAdsInfoStructValidationError"
+func (e GetContentFlagListReqValidationError) ErrorName() string {
+	return "GetContentFlagListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SectionAdsInfoStructValidationError) Error() string {
+func (e GetContentFlagListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1702,14 +1956,14 @@ func (e SectionAdsInfoStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSectionAdsInfoStruct.%s: %s%s",
+		"invalid %sGetContentFlagListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SectionAdsInfoStructValidationError{}
+var _ error = GetContentFlagListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1717,59 +1971,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SectionAdsInfoStructValidationError{}
+} = GetContentFlagListReqValidationError{}
 
-// Validate checks the field values on SectionIllegalInfoStruct with the rules
+var _GetContentFlagListReq_Kind_Pattern = regexp.MustCompile("^(article|talk|column|collections|comment|subComment)?$")
+
+// Validate checks the field values on GetContentFlagListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SectionIllegalInfoStruct) Validate() error {
+func (m *GetContentFlagListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SectionIllegalInfoStruct with the
+// ValidateAll checks the field values on GetContentFlagListReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SectionIllegalInfoStructMultiError, or nil if none found.
-func (m *SectionIllegalInfoStruct) ValidateAll() error {
+// GetContentFlagListReplyMultiError, or nil if none found.
+func (m *GetContentFlagListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SectionIllegalInfoStruct) validate(all bool) error {
+func (m *GetContentFlagListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HitFlag
-
-	// no validation rules for Score
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
-		err := SectionIllegalInfoStructValidationError{
-			field:  "Keywords",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetContentFlagListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetContentFlagListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetContentFlagListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return SectionIllegalInfoStructMultiError(errors)
+		return GetContentFlagListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SectionIllegalInfoStructMultiError is an error wrapping multiple validation
-// errors returned by SectionIllegalInfoStruct.ValidateAll() if the designated
+// GetContentFlagListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetContentFlagListReply.ValidateAll() if the designated
 // constraints aren't met.
-type SectionIllegalInfoStructMultiError []error
+type GetContentFlagListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SectionIllegalInfoStructMultiError) Error() string {
+func (m GetContentFlagListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1778,11 +2053,11 @@ func (m SectionIllegalInfoStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SectionIllegalInfoStructMultiError) AllErrors() []error { return m }
+func (m GetContentFlagListReplyMultiError) AllErrors() []error { return m }
 
-// SectionIllegalInfoStructValidationError is the validation error returned by
-// SectionIllegalInfoStruct.Validate if the designated constraints aren't met.
-type SectionIllegalInfoStructValidationError struct {
+// GetContentFlagListReplyValidationError is the validation error returned by
+// GetContentFlagListReply.Validate if the designated constraints aren't met.
+type GetContentFlagListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1790,24 +2065,24 @@ type SectionIllegalInfoStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e SectionIllegalInfoStructValidationError) Field() string { return e.field }
+func (e GetContentFlagListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SectionIllegalInfoStructValidationError) Reason() string { return e.reason }
+func (e GetContentFlagListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SectionIllegalInfoStructValidationError) Cause() error { return e.cause }
+func (e GetContentFlagListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SectionIllegalInfoStructValidationError) Key() bool { return e.key }
+func (e GetContentFlagListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SectionIllegalInfoStructValidationError) ErrorName() string {
-	return "SectionIllegalInfoStructValidationError"
+func (e GetContentFlagListReplyValidationError) ErrorName() string {
+	return "GetContentFlagListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SectionIllegalInfoStructValidationError) Error() string {
+func (e GetContentFlagListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1819,14 +2094,14 @@ func (e SectionIllegalInfoStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSectionIllegalInfoStruct.%s: %s%s",
+		"invalid %sGetContentFlagListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SectionIllegalInfoStructValidationError{}
+var _ error = GetContentFlagListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1834,59 +2109,150 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SectionIllegalInfoStructValidationError{}
+} = GetContentFlagListReplyValidationError{}
 
-// Validate checks the field values on SectionAbuseInfoStruct with the rules
+// Validate checks the field values on PublishContentFlagReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SectionAbuseInfoStruct) Validate() error {
+func (m *PublishContentFlagReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SectionAbuseInfoStruct with the rules
+// ValidateAll checks the field values on PublishContentFlagReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SectionAbuseInfoStructMultiError, or nil if none found.
-func (m *SectionAbuseInfoStruct) ValidateAll() error {
+// PublishContentFlagReqMultiError, or nil if none found.
+func (m *PublishContentFlagReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SectionAbuseInfoStruct) validate(all bool) error {
+func (m *PublishContentFlagReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HitFlag
+	// no validation rules for Id
 
-	// no validation rules for Score
+	if len(errors) > 0 {
+		return PublishContentFlagReqMultiError(errors)
+	}
 
-	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
-		err := SectionAbuseInfoStructValidationError{
-			field:  "Keywords",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// PublishContentFlagReqMultiError is an error wrapping multiple validation
+// errors returned by PublishContentFlagReq.ValidateAll() if the designated
+// constraints aren't met.
+type PublishContentFlagReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishContentFlagReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishContentFlagReqMultiError) AllErrors() []error { return m }
+
+// PublishContentFlagReqValidationError is the validation error returned by
+// PublishContentFlagReq.Validate if the designated constraints aren't met.
+type PublishContentFlagReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishContentFlagReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishContentFlagReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishContentFlagReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishContentFlagReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishContentFlagReqValidationError) ErrorName() string {
+	return "PublishContentFlagReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishContentFlagReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishContentFlagReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishContentFlagReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishContentFlagReqValidationError{}
+
+// Validate checks the field values on RemoveContentFlagReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveContentFlagReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveContentFlagReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveContentFlagReqMultiError, or nil if none found.
+func (m *RemoveContentFlagReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveContentFlagReq) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return SectionAbuseInfoStructMultiError(errors)
+		return RemoveContentFlagReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SectionAbuseInfoStructMultiError is an error wrapping multiple validation
-// errors returned by SectionAbuseInfoStruct.ValidateAll() if the designated
+// RemoveContentFlagReqMultiError is an error wrapping multiple validation
+// errors returned by RemoveContentFlagReq.ValidateAll() if the designated
 // constraints aren't met.
-type SectionAbuseInfoStructMultiError []error
+type RemoveContentFlagReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SectionAbuseInfoStructMultiError) Error() string {
+func (m RemoveContentFlagReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1895,11 +2261,11 @@ func (m SectionAbuseInfoStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SectionAbuseInfoStructMultiError) AllErrors() []error { return m }
+func (m RemoveContentFlagReqMultiError) AllErrors() []error { return m }
 
-// SectionAbuseInfoStructValidationError is the validation error returned by
-// SectionAbuseInfoStruct.Validate if the designated constraints aren't met.
-type SectionAbuseInfoStructValidationError struct {
+// RemoveContentFlagReqValidationError is the validation error returned by
+// RemoveContentFlagReq.Validate if the designated constraints aren't met.
+type RemoveContentFlagReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1907,24 +2273,24 @@ type SectionAbuseInfoStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e SectionAbuseInfoStructValidationError) Field() string { return e.field }
+func (e RemoveContentFlagReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SectionAbuseInfoStructValidationError) Reason() string { return e.reason }
+func (e RemoveContentFlagReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SectionAbuseInfoStructValidationError) Cause() error { return e.cause }
+func (e RemoveContentFlagReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SectionAbuseInfoStructValidationError) Key() bool { return e.key }
+func (e RemoveContentFlagReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SectionAbuseInfoStructValidationError) ErrorName() string {
-	return "SectionAbuseInfoStructValidationError"
+func (e RemoveContentFlagReqValidationError) ErrorName() string {
+	return "RemoveContentFlagReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SectionAbuseInfoStructValidationError) Error() string {
+func (e RemoveContentFlagReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1936,14 +2302,14 @@ func (e SectionAbuseInfoStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSectionAbuseInfoStruct.%s: %s%s",
+		"invalid %sRemoveContentFlagReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SectionAbuseInfoStructValidationError{}
+var _ error = RemoveContentFlagReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1951,34 +2317,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SectionAbuseInfoStructValidationError{}
+} = RemoveContentFlagReqValidationError{}
 
-// Validate checks the field values on SectionStruct with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SectionStruct) Validate() error {
+// Validate checks the field values on AppealReviewReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppealReviewReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SectionStruct with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SectionStructMultiError, or
-// nil if none found.
-func (m *SectionStruct) ValidateAll() error {
+// ValidateAll checks the field values on AppealReviewReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealReviewReqMultiError, or nil if none found.
+func (m *AppealReviewReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SectionStruct) validate(all bool) error {
+func (m *AppealReviewReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetLabel()) > 1000 {
-		err := SectionStructValidationError{
-			field:  "Label",
-			reason: "value length must be at most 1000 runes",
+	if !_AppealReviewReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := AppealReviewReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
 		if !all {
 			return err
@@ -1986,138 +2352,1873 @@ func (m *SectionStruct) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Result
+	if l := utf8.RuneCountInString(m.GetJobId()); l < 1 || l > 100 {
+		err := AppealReviewReqValidationError{
+			field:  "JobId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetPornInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "PornInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "PornInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := AppealReviewReqValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetPornInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SectionStructValidationError{
-				field:  "PornInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetAdsInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "AdsInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "AdsInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAdsInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SectionStructValidationError{
-				field:  "AdsInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return AppealReviewReqMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetIllegalInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "IllegalInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "IllegalInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	return nil
+}
+
+// AppealReviewReqMultiError is an error wrapping multiple validation errors
+// returned by AppealReviewReq.ValidateAll() if the designated constraints
+// aren't met.
+type AppealReviewReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealReviewReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealReviewReqMultiError) AllErrors() []error { return m }
+
+// AppealReviewReqValidationError is the validation error returned by
+// AppealReviewReq.Validate if the designated constraints aren't met.
+type AppealReviewReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealReviewReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealReviewReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealReviewReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealReviewReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealReviewReqValidationError) ErrorName() string { return "AppealReviewReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppealReviewReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealReviewReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealReviewReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealReviewReqValidationError{}
+
+var _AppealReviewReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetAppealListReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealListReqMultiError, or nil if none found.
+func (m *GetAppealListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetAppealListReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetAppealListReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9]{20})?$\"",
 		}
-	} else if v, ok := interface{}(m.GetIllegalInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SectionStructValidationError{
-				field:  "IllegalInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetAbuseInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "AbuseInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return GetAppealListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealListReqMultiError is an error wrapping multiple validation errors
+// returned by GetAppealListReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppealListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealListReqMultiError) AllErrors() []error { return m }
+
+// GetAppealListReqValidationError is the validation error returned by
+// GetAppealListReq.Validate if the designated constraints aren't met.
+type GetAppealListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealListReqValidationError) ErrorName() string { return "GetAppealListReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAppealListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealListReqValidationError{}
+
+var _GetAppealListReq_Uuid_Pattern = regexp.MustCompile("^([a-zA-Z0-9]{20})?$")
+
+// Validate checks the field values on GetAppealListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealListReplyMultiError, or nil if none found.
+func (m *GetAppealListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SectionStructValidationError{
-					field:  "AbuseInfo",
+				return GetAppealListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAbuseInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SectionStructValidationError{
-				field:  "AbuseInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAppealListReplyMultiError(errors)
 	}
 
+	return nil
+}
+
+// GetAppealListReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAppealListReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppealListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealListReplyMultiError) AllErrors() []error { return m }
+
+// GetAppealListReplyValidationError is the validation error returned by
+// GetAppealListReply.Validate if the designated constraints aren't met.
+type GetAppealListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealListReplyValidationError) ErrorName() string {
+	return "GetAppealListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealListReplyValidationError{}
+
+// Validate checks the field values on AcceptAppealReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AcceptAppealReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptAppealReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptAppealReqMultiError, or nil if none found.
+func (m *AcceptAppealReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptAppealReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AcceptAppealReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptAppealReqMultiError is an error wrapping multiple validation errors
+// returned by AcceptAppealReq.ValidateAll() if the designated constraints
+// aren't met.
+type AcceptAppealReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptAppealReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptAppealReqMultiError) AllErrors() []error { return m }
+
+// AcceptAppealReqValidationError is the validation error returned by
+// AcceptAppealReq.Validate if the designated constraints aren't met.
+type AcceptAppealReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptAppealReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptAppealReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptAppealReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptAppealReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptAppealReqValidationError) ErrorName() string { return "AcceptAppealReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AcceptAppealReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptAppealReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptAppealReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptAppealReqValidationError{}
+
+// Validate checks the field values on RejectAppealReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RejectAppealReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectAppealReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectAppealReqMultiError, or nil if none found.
+func (m *RejectAppealReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectAppealReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RejectAppealReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectAppealReqMultiError is an error wrapping multiple validation errors
+// returned by RejectAppealReq.ValidateAll() if the designated constraints
+// aren't met.
+type RejectAppealReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectAppealReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectAppealReqMultiError) AllErrors() []error { return m }
+
+// RejectAppealReqValidationError is the validation error returned by
+// RejectAppealReq.Validate if the designated constraints aren't met.
+type RejectAppealReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectAppealReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectAppealReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectAppealReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectAppealReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectAppealReqValidationError) ErrorName() string { return "RejectAppealReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RejectAppealReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectAppealReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectAppealReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectAppealReqValidationError{}
+
+// Validate checks the field values on SectionPornInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SectionPornInfoStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SectionPornInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SectionPornInfoStructMultiError, or nil if none found.
+func (m *SectionPornInfoStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SectionPornInfoStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HitFlag
+
+	// no validation rules for Score
+
+	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
+		err := SectionPornInfoStructValidationError{
+			field:  "Keywords",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SectionPornInfoStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectionPornInfoStructMultiError is an error wrapping multiple validation
+// errors returned by SectionPornInfoStruct.ValidateAll() if the designated
+// constraints aren't met.
+type SectionPornInfoStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectionPornInfoStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectionPornInfoStructMultiError) AllErrors() []error { return m }
+
+// SectionPornInfoStructValidationError is the validation error returned by
+// SectionPornInfoStruct.Validate if the designated constraints aren't met.
+type SectionPornInfoStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectionPornInfoStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectionPornInfoStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectionPornInfoStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectionPornInfoStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectionPornInfoStructValidationError) ErrorName() string {
+	return "SectionPornInfoStructValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SectionPornInfoStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSectionPornInfoStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectionPornInfoStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectionPornInfoStructValidationError{}
+
+// Validate checks the field values on SectionAdsInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SectionAdsInfoStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SectionAdsInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SectionAdsInfoStructMultiError, or nil if none found.
+func (m *SectionAdsInfoStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SectionAdsInfoStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HitFlag
+
+	// no validation rules for Score
+
+	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
+		err := SectionAdsInfoStructValidationError{
+			field:  "Keywords",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SectionAdsInfoStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectionAdsInfoStructMultiError is an error wrapping multiple validation
+// errors returned by SectionAdsInfoStruct.ValidateAll() if the designated
+// constraints aren't met.
+type SectionAdsInfoStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectionAdsInfoStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectionAdsInfoStructMultiError) AllErrors() []error { return m }
+
+// SectionAdsInfoStructValidationError is the validation error returned by
+// SectionAdsInfoStruct.Validate if the designated constraints aren't met.
+type SectionAdsInfoStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectionAdsInfoStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectionAdsInfoStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectionAdsInfoStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectionAdsInfoStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectionAdsInfoStructValidationError) ErrorName() string {
+	return "SectionAdsInfoStructValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SectionAdsInfoStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSectionAdsInfoStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectionAdsInfoStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectionAdsInfoStructValidationError{}
+
+// Validate checks the field values on SectionIllegalInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SectionIllegalInfoStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SectionIllegalInfoStruct with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SectionIllegalInfoStructMultiError, or nil if none found.
+func (m *SectionIllegalInfoStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SectionIllegalInfoStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HitFlag
+
+	// no validation rules for Score
+
+	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
+		err := SectionIllegalInfoStructValidationError{
+			field:  "Keywords",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SectionIllegalInfoStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectionIllegalInfoStructMultiError is an error wrapping multiple validation
+// errors returned by SectionIllegalInfoStruct.ValidateAll() if the designated
+// constraints aren't met.
+type SectionIllegalInfoStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectionIllegalInfoStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectionIllegalInfoStructMultiError) AllErrors() []error { return m }
+
+// SectionIllegalInfoStructValidationError is the validation error returned by
+// SectionIllegalInfoStruct.Validate if the designated constraints aren't met.
+type SectionIllegalInfoStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectionIllegalInfoStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectionIllegalInfoStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectionIllegalInfoStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectionIllegalInfoStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectionIllegalInfoStructValidationError) ErrorName() string {
+	return "SectionIllegalInfoStructValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SectionIllegalInfoStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSectionIllegalInfoStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectionIllegalInfoStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectionIllegalInfoStructValidationError{}
+
+// Validate checks the field values on SectionAbuseInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SectionAbuseInfoStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SectionAbuseInfoStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SectionAbuseInfoStructMultiError, or nil if none found.
+func (m *SectionAbuseInfoStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SectionAbuseInfoStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HitFlag
+
+	// no validation rules for Score
+
+	if utf8.RuneCountInString(m.GetKeywords()) > 1000 {
+		err := SectionAbuseInfoStructValidationError{
+			field:  "Keywords",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SectionAbuseInfoStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectionAbuseInfoStructMultiError is an error wrapping multiple validation
+// errors returned by SectionAbuseInfoStruct.ValidateAll() if the designated
+// constraints aren't met.
+type SectionAbuseInfoStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectionAbuseInfoStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectionAbuseInfoStructMultiError) AllErrors() []error { return m }
+
+// SectionAbuseInfoStructValidationError is the validation error returned by
+// SectionAbuseInfoStruct.Validate if the designated constraints aren't met.
+type SectionAbuseInfoStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectionAbuseInfoStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectionAbuseInfoStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectionAbuseInfoStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectionAbuseInfoStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectionAbuseInfoStructValidationError) ErrorName() string {
+	return "SectionAbuseInfoStructValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SectionAbuseInfoStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSectionAbuseInfoStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectionAbuseInfoStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectionAbuseInfoStructValidationError{}
+
+// Validate checks the field values on SectionStruct with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SectionStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SectionStruct with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SectionStructMultiError, or
+// nil if none found.
+func (m *SectionStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SectionStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLabel()) > 1000 {
+		err := SectionStructValidationError{
+			field:  "Label",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetPornInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "PornInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "PornInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPornInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SectionStructValidationError{
+				field:  "PornInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdsInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "AdsInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "AdsInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdsInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SectionStructValidationError{
+				field:  "AdsInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIllegalInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "IllegalInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "IllegalInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIllegalInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SectionStructValidationError{
+				field:  "IllegalInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAbuseInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "AbuseInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SectionStructValidationError{
+					field:  "AbuseInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAbuseInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SectionStructValidationError{
+				field:  "AbuseInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SectionStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectionStructMultiError is an error wrapping multiple validation errors
+// returned by SectionStruct.ValidateAll() if the designated constraints
+// aren't met.
+type SectionStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectionStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectionStructMultiError) AllErrors() []error { return m }
+
+// SectionStructValidationError is the validation error returned by
+// SectionStruct.Validate if the designated constraints aren't met.
+type SectionStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectionStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectionStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectionStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectionStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectionStructValidationError) ErrorName() string { return "SectionStructValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SectionStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSectionStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectionStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectionStructValidationError{}
+
+// Validate checks the field values on JobsDetailStruct with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobsDetailStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobsDetailStruct with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobsDetailStructMultiError, or nil if none found.
+func (m *JobsDetailStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobsDetailStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "Code",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMessage()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "Message",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetJobId()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "JobId",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDataId()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "DataId",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetState()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "State",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCreationTime()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "CreationTime",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "Object",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabel()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "Label",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Result
+
+	if utf8.RuneCountInString(m.GetBucketId()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "BucketId",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRegion()) > 1000 {
+		err := JobsDetailStructValidationError{
+			field:  "Region",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCosHeaders()))
+		i := 0
+		for key := range m.GetCosHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCosHeaders()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) > 1000 {
+				err := JobsDetailStructValidationError{
+					field:  fmt.Sprintf("CosHeaders[%v]", key),
+					reason: "value length must be at most 1000 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 1000 {
+				err := JobsDetailStructValidationError{
+					field:  fmt.Sprintf("CosHeaders[%v]", key),
+					reason: "value length must be at most 1000 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetSection() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobsDetailStructValidationError{
+						field:  fmt.Sprintf("Section[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobsDetailStructValidationError{
+						field:  fmt.Sprintf("Section[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobsDetailStructValidationError{
+					field:  fmt.Sprintf("Section[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobsDetailStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobsDetailStructMultiError is an error wrapping multiple validation errors
+// returned by JobsDetailStruct.ValidateAll() if the designated constraints
+// aren't met.
+type JobsDetailStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobsDetailStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobsDetailStructMultiError) AllErrors() []error { return m }
+
+// JobsDetailStructValidationError is the validation error returned by
+// JobsDetailStruct.Validate if the designated constraints aren't met.
+type JobsDetailStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobsDetailStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobsDetailStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobsDetailStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobsDetailStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobsDetailStructValidationError) ErrorName() string { return "JobsDetailStructValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobsDetailStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobsDetailStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobsDetailStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobsDetailStructValidationError{}
+
+// Validate checks the field values on ImageReviewReq_JobsDetailStruct with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageReviewReq_JobsDetailStruct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageReviewReq_JobsDetailStruct with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ImageReviewReq_JobsDetailStructMultiError, or nil if none found.
+func (m *ImageReviewReq_JobsDetailStruct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageReviewReq_JobsDetailStruct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Code",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMessage()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Message",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetJobId()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "JobId",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetState()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "State",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Object",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Url",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabel()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Label",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Result
+
+	// no validation rules for Score
+
+	if utf8.RuneCountInString(m.GetCategory()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Category",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubLabel()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "SubLabel",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBucketId()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "BucketId",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRegion()) > 1000 {
+		err := ImageReviewReq_JobsDetailStructValidationError{
+			field:  "Region",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetCosHeaders()))
+		i := 0
+		for key := range m.GetCosHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCosHeaders()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) > 1000 {
+				err := ImageReviewReq_JobsDetailStructValidationError{
+					field:  fmt.Sprintf("CosHeaders[%v]", key),
+					reason: "value length must be at most 1000 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 1000 {
+				err := ImageReviewReq_JobsDetailStructValidationError{
+					field:  fmt.Sprintf("CosHeaders[%v]", key),
+					reason: "value length must be at most 1000 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImageReviewReq_JobsDetailStructMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageReviewReq_JobsDetailStructMultiError is an error wrapping multiple
+// validation errors returned by ImageReviewReq_JobsDetailStruct.ValidateAll()
+// if the designated constraints aren't met.
+type ImageReviewReq_JobsDetailStructMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageReviewReq_JobsDetailStructMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageReviewReq_JobsDetailStructMultiError) AllErrors() []error { return m }
+
+// ImageReviewReq_JobsDetailStructValidationError is the validation error
+// returned by ImageReviewReq_JobsDetailStruct.Validate if the designated
+// constraints aren't met.
+type ImageReviewReq_JobsDetailStructValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageReviewReq_JobsDetailStructValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageReviewReq_JobsDetailStructValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageReviewReq_JobsDetailStructValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageReviewReq_JobsDetailStructValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageReviewReq_JobsDetailStructValidationError) ErrorName() string {
+	return "ImageReviewReq_JobsDetailStructValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageReviewReq_JobsDetailStructValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageReviewReq_JobsDetailStruct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageReviewReq_JobsDetailStructValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageReviewReq_JobsDetailStructValidationError{}
+
+// Validate checks the field values on GetMessageSystemNotificationReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetMessageSystemNotificationReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetMessageSystemNotificationReply_List with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetMessageSystemNotificationReply_ListMultiError, or nil if none found.
+func (m *GetMessageSystemNotificationReply_List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageSystemNotificationReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ContentId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for NotificationType
+
+	// no validation rules for Title
+
+	// no validation rules for Uid
+
+	// no validation rules for Uuid
+
+	// no validation rules for Label
+
+	// no validation rules for Result
+
+	// no validation rules for Section
+
+	// no validation rules for Text
+
+	// no validation rules for Comment
+
 	if len(errors) > 0 {
-		return SectionStructMultiError(errors)
+		return GetMessageSystemNotificationReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// SectionStructMultiError is an error wrapping multiple validation errors
-// returned by SectionStruct.ValidateAll() if the designated constraints
-// aren't met.
-type SectionStructMultiError []error
+// GetMessageSystemNotificationReply_ListMultiError is an error wrapping
+// multiple validation errors returned by
+// GetMessageSystemNotificationReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageSystemNotificationReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SectionStructMultiError) Error() string {
+func (m GetMessageSystemNotificationReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2126,11 +4227,12 @@ func (m SectionStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SectionStructMultiError) AllErrors() []error { return m }
+func (m GetMessageSystemNotificationReply_ListMultiError) AllErrors() []error { return m }
 
-// SectionStructValidationError is the validation error returned by
-// SectionStruct.Validate if the designated constraints aren't met.
-type SectionStructValidationError struct {
+// GetMessageSystemNotificationReply_ListValidationError is the validation
+// error returned by GetMessageSystemNotificationReply_List.Validate if the
+// designated constraints aren't met.
+type GetMessageSystemNotificationReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2138,22 +4240,24 @@ type SectionStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e SectionStructValidationError) Field() string { return e.field }
+func (e GetMessageSystemNotificationReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SectionStructValidationError) Reason() string { return e.reason }
+func (e GetMessageSystemNotificationReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SectionStructValidationError) Cause() error { return e.cause }
+func (e GetMessageSystemNotificationReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SectionStructValidationError) Key() bool { return e.key }
+func (e GetMessageSystemNotificationReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SectionStructValidationError) ErrorName() string { return "SectionStructValidationError" }
+func (e GetMessageSystemNotificationReply_ListValidationError) ErrorName() string {
+	return "GetMessageSystemNotificationReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SectionStructValidationError) Error() string {
+func (e GetMessageSystemNotificationReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2165,14 +4269,14 @@ func (e SectionStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSectionStruct.%s: %s%s",
+		"invalid %sGetMessageSystemNotificationReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SectionStructValidationError{}
+var _ error = GetMessageSystemNotificationReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -2180,227 +4284,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SectionStructValidationError{}
+} = GetMessageSystemNotificationReply_ListValidationError{}
 
-// Validate checks the field values on JobsDetailStruct with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobsDetailStruct) Validate() error {
+// Validate checks the field values on GetMessageMentionReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageMentionReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobsDetailStruct with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetMessageMentionReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobsDetailStructMultiError, or nil if none found.
-func (m *JobsDetailStruct) ValidateAll() error {
+// GetMessageMentionReply_ListMultiError, or nil if none found.
+func (m *GetMessageMentionReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobsDetailStruct) validate(all bool) error {
+func (m *GetMessageMentionReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCode()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "Code",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetMessage()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "Message",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetJobId()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "JobId",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDataId()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "DataId",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetState()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "State",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetCreationTime()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "CreationTime",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetObject()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "Object",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetLabel()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "Label",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Result
+	// no validation rules for Id
 
-	if utf8.RuneCountInString(m.GetBucketId()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "BucketId",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ContentId
 
-	if utf8.RuneCountInString(m.GetRegion()) > 1000 {
-		err := JobsDetailStructValidationError{
-			field:  "Region",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for CreatedAt
 
-	{
-		sorted_keys := make([]string, len(m.GetCosHeaders()))
-		i := 0
-		for key := range m.GetCosHeaders() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetCosHeaders()[key]
-			_ = val
+	// no validation rules for Kind
 
-			if utf8.RuneCountInString(key) > 1000 {
-				err := JobsDetailStructValidationError{
-					field:  fmt.Sprintf("CosHeaders[%v]", key),
-					reason: "value length must be at most 1000 runes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
+	// no validation rules for CreationId
 
-			if utf8.RuneCountInString(val) > 1000 {
-				err := JobsDetailStructValidationError{
-					field:  fmt.Sprintf("CosHeaders[%v]", key),
-					reason: "value length must be at most 1000 runes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
+	// no validation rules for CreationType
 
-		}
-	}
+	// no validation rules for RootId
 
-	for idx, item := range m.GetSection() {
-		_, _ = idx, item
+	// no validation rules for Uid
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobsDetailStructValidationError{
-						field:  fmt.Sprintf("Section[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobsDetailStructValidationError{
-						field:  fmt.Sprintf("Section[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobsDetailStructValidationError{
-					field:  fmt.Sprintf("Section[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Uuid
 
-	}
+	// no validation rules for Text
 
 	if len(errors) > 0 {
-		return JobsDetailStructMultiError(errors)
+		return GetMessageMentionReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobsDetailStructMultiError is an error wrapping multiple validation errors
-// returned by JobsDetailStruct.ValidateAll() if the designated constraints
-// aren't met.
-type JobsDetailStructMultiError []error
+// GetMessageMentionReply_ListMultiError is an error wrapping multiple
+// validation errors returned by GetMessageMentionReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessageMentionReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobsDetailStructMultiError) Error() string {
+func (m GetMessageMentionReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2409,11 +4350,12 @@ func (m JobsDetailStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobsDetailStructMultiError) AllErrors() []error { return m }
+func (m GetMessageMentionReply_ListMultiError) AllErrors() []error { return m }
 
-// JobsDetailStructValidationError is the validation error returned by
-// JobsDetailStruct.Validate if the designated constraints aren't met.
-type JobsDetailStructValidationError struct {
+// GetMessageMentionReply_ListValidationError is the validation error returned
+// by GetMessageMentionReply_List.Validate if the designated constraints
+// aren't met.
+type GetMessageMentionReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2421,22 +4363,24 @@ type JobsDetailStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobsDetailStructValidationError) Field() string { return e.field }
+func (e GetMessageMentionReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobsDetailStructValidationError) Reason() string { return e.reason }
+func (e GetMessageMentionReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobsDetailStructValidationError) Cause() error { return e.cause }
+func (e GetMessageMentionReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobsDetailStructValidationError) Key() bool { return e.key }
+func (e GetMessageMentionReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobsDetailStructValidationError) ErrorName() string { return "JobsDetailStructValidationError" }
+func (e GetMessageMentionReply_ListValidationError) ErrorName() string {
+	return "GetMessageMentionReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobsDetailStructValidationError) Error() string {
+func (e GetMessageMentionReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2448,14 +4392,14 @@ func (e JobsDetailStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobsDetailStruct.%s: %s%s",
+		"invalid %sGetMessageMentionReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobsDetailStructValidationError{}
+var _ error = GetMessageMentionReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -2463,206 +4407,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobsDetailStructValidationError{}
+} = GetMessageMentionReply_ListValidationError{}
 
-// Validate checks the field values on ImageReviewReq_JobsDetailStruct with the
+// Validate checks the field values on GetContentFlagListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ImageReviewReq_JobsDetailStruct) Validate() error {
+func (m *GetContentFlagListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ImageReviewReq_JobsDetailStruct with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ImageReviewReq_JobsDetailStructMultiError, or nil if none found.
-func (m *ImageReviewReq_JobsDetailStruct) ValidateAll() error {
+// ValidateAll checks the field values on GetContentFlagListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetContentFlagListReply_ListMultiError, or nil if none found.
+func (m *GetContentFlagListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ImageReviewReq_JobsDetailStruct) validate(all bool) error {
+func (m *GetContentFlagListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCode()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Code",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetMessage()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Message",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetJobId()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "JobId",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetState()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "State",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetObject()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Object",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetUrl()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Url",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetLabel()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Label",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Id
 
-	// no validation rules for Result
+	// no validation rules for Kind
 
-	// no validation rules for Score
+	// no validation rules for Mode
 
-	if utf8.RuneCountInString(m.GetCategory()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Category",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ContentId
 
-	if utf8.RuneCountInString(m.GetSubLabel()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "SubLabel",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Uuid
 
-	if utf8.RuneCountInString(m.GetBucketId()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "BucketId",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Text
 
-	if utf8.RuneCountInString(m.GetRegion()) > 1000 {
-		err := ImageReviewReq_JobsDetailStructValidationError{
-			field:  "Region",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for JobId
 
-	{
-		sorted_keys := make([]string, len(m.GetCosHeaders()))
-		i := 0
-		for key := range m.GetCosHeaders() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetCosHeaders()[key]
-			_ = val
+	// no validation rules for Label
 
-			if utf8.RuneCountInString(key) > 1000 {
-				err := ImageReviewReq_JobsDetailStructValidationError{
-					field:  fmt.Sprintf("CosHeaders[%v]", key),
-					reason: "value length must be at most 1000 runes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
+	// no validation rules for Section
 
-			if utf8.RuneCountInString(val) > 1000 {
-				err := ImageReviewReq_JobsDetailStructValidationError{
-					field:  fmt.Sprintf("CosHeaders[%v]", key),
-					reason: "value length must be at most 1000 runes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
+	// no validation rules for Status
 
-		}
-	}
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return ImageReviewReq_JobsDetailStructMultiError(errors)
+		return GetContentFlagListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// ImageReviewReq_JobsDetailStructMultiError is an error wrapping multiple
-// validation errors returned by ImageReviewReq_JobsDetailStruct.ValidateAll()
-// if the designated constraints aren't met.
-type ImageReviewReq_JobsDetailStructMultiError []error
+// GetContentFlagListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by GetContentFlagListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type GetContentFlagListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ImageReviewReq_JobsDetailStructMultiError) Error() string {
+func (m GetContentFlagListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2671,12 +4475,12 @@ func (m ImageReviewReq_JobsDetailStructMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ImageReviewReq_JobsDetailStructMultiError) AllErrors() []error { return m }
+func (m GetContentFlagListReply_ListMultiError) AllErrors() []error { return m }
 
-// ImageReviewReq_JobsDetailStructValidationError is the validation error
-// returned by ImageReviewReq_JobsDetailStruct.Validate if the designated
-// constraints aren't met.
-type ImageReviewReq_JobsDetailStructValidationError struct {
+// GetContentFlagListReply_ListValidationError is the validation error returned
+// by GetContentFlagListReply_List.Validate if the designated constraints
+// aren't met.
+type GetContentFlagListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2684,24 +4488,24 @@ type ImageReviewReq_JobsDetailStructValidationError struct {
 }
 
 // Field function returns field value.
-func (e ImageReviewReq_JobsDetailStructValidationError) Field() string { return e.field }
+func (e GetContentFlagListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ImageReviewReq_JobsDetailStructValidationError) Reason() string { return e.reason }
+func (e GetContentFlagListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ImageReviewReq_JobsDetailStructValidationError) Cause() error { return e.cause }
+func (e GetContentFlagListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ImageReviewReq_JobsDetailStructValidationError) Key() bool { return e.key }
+func (e GetContentFlagListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ImageReviewReq_JobsDetailStructValidationError) ErrorName() string {
-	return "ImageReviewReq_JobsDetailStructValidationError"
+func (e GetContentFlagListReply_ListValidationError) ErrorName() string {
+	return "GetContentFlagListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ImageReviewReq_JobsDetailStructValidationError) Error() string {
+func (e GetContentFlagListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2713,14 +4517,14 @@ func (e ImageReviewReq_JobsDetailStructValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sImageReviewReq_JobsDetailStruct.%s: %s%s",
+		"invalid %sGetContentFlagListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ImageReviewReq_JobsDetailStructValidationError{}
+var _ error = GetContentFlagListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -2728,26 +4532,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ImageReviewReq_JobsDetailStructValidationError{}
+} = GetContentFlagListReply_ListValidationError{}
 
-// Validate checks the field values on GetMessageSystemNotificationReply_List
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetMessageSystemNotificationReply_List) Validate() error {
+// Validate checks the field values on GetAppealListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetMessageSystemNotificationReply_List with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetMessageSystemNotificationReply_ListMultiError, or nil if none found.
-func (m *GetMessageSystemNotificationReply_List) ValidateAll() error {
+// ValidateAll checks the field values on GetAppealListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealListReply_ListMultiError, or nil if none found.
+func (m *GetAppealListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMessageSystemNotificationReply_List) validate(all bool) error {
+func (m *GetAppealListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2756,43 +4558,42 @@ func (m *GetMessageSystemNotificationReply_List) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for ContentId
-
-	// no validation rules for CreatedAt
+	// no validation rules for JobId
 
-	// no validation rules for NotificationType
+	// no validation rules for Uuid
 
-	// no validation rules for Title
+	// no validation rules for Reason
 
-	// no validation rules for Uid
+	// no validation rules for Status
 
-	// no validation rules for Uuid
+	// no validation rules for CreatedAt
 
-	// no validation rules for Label
+	// no validation rules for Kind
 
-	// no validation rules for Result
+	// no validation rules for Mode
 
-	// no validation rules for Section
+	// no validation rules for ContentId
 
 	// no validation rules for Text
 
-	// no validation rules for Comment
+	// no validation rules for Label
+
+	// no validation rules for Section
 
 	if len(errors) > 0 {
-		return GetMessageSystemNotificationReply_ListMultiError(errors)
+		return GetAppealListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMessageSystemNotificationReply_ListMultiError is an error wrapping
-// multiple validation errors returned by
-// GetMessageSystemNotificationReply_List.ValidateAll() if the designated
+// GetAppealListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by GetAppealListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type GetMessageSystemNotificationReply_ListMultiError []error
+type GetAppealListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMessageSystemNotificationReply_ListMultiError) Error() string {
+func (m GetAppealListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2801,12 +4602,11 @@ func (m GetMessageSystemNotificationReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMessageSystemNotificationReply_ListMultiError) AllErrors() []error { return m }
+func (m GetAppealListReply_ListMultiError) AllErrors() []error { return m }
 
-// GetMessageSystemNotificationReply_ListValidationError is the validation
-// error returned by GetMessageSystemNotificationReply_List.Validate if the
-// designated constraints aren't met.
-type GetMessageSystemNotificationReply_ListValidationError struct {
+// GetAppealListReply_ListValidationError is the validation error returned by
+// GetAppealListReply_List.Validate if the designated constraints aren't met.
+type GetAppealListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2814,24 +4614,24 @@ type GetMessageSystemNotificationReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMessageSystemNotificationReply_ListValidationError) Field() string { return e.field }
+func (e GetAppealListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMessageSystemNotificationReply_ListValidationError) Reason() string { return e.reason }
+func (e GetAppealListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMessageSystemNotificationReply_ListValidationError) Cause() error { return e.cause }
+func (e GetAppealListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMessageSystemNotificationReply_ListValidationError) Key() bool { return e.key }
+func (e GetAppealListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMessageSystemNotificationReply_ListValidationError) ErrorName() string {
-	return "GetMessageSystemNotificationReply_ListValidationError"
+func (e GetAppealListReply_ListValidationError) ErrorName() string {
+	return "GetAppealListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMessageSystemNotificationReply_ListValidationError) Error() string {
+func (e GetAppealListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2843,14 +4643,14 @@ func (e GetMessageSystemNotificationReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMessageSystemNotificationReply_List.%s: %s%s",
+		"invalid %sGetAppealListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMessageSystemNotificationReply_ListValidationError{}
+var _ error = GetAppealListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -2858,4 +4658,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMessageSystemNotificationReply_ListValidationError{}
+} = GetAppealListReply_ListValidationError{}
@@ -31,18 +31,44 @@ const (
 	MessageErrorReason_SET_MAILBOX_LAST_TIME_FAILED              MessageErrorReason = 4
 	MessageErrorReason_REMOVE_MAILBOX_COMMENT_FAILED             MessageErrorReason = 5
 	MessageErrorReason_REMOVE_MAILBOX_SYSTEM_NOTIFICATION_FAILED MessageErrorReason = 6
+	MessageErrorReason_REMOVE_MAILBOX_MENTION_FAILED             MessageErrorReason = 7
+	MessageErrorReason_CONTENT_FLAG_NOT_FOUND                    MessageErrorReason = 8
+	MessageErrorReason_CONTENT_FLAG_RESOLVED                     MessageErrorReason = 9
+	MessageErrorReason_GET_CONTENT_FLAG_FAILED                   MessageErrorReason = 10
+	MessageErrorReason_PUBLISH_CONTENT_FLAG_FAILED               MessageErrorReason = 11
+	MessageErrorReason_REMOVE_CONTENT_FLAG_FAILED                MessageErrorReason = 12
+	MessageErrorReason_APPEAL_NOT_FOUND                          MessageErrorReason = 13
+	MessageErrorReason_APPEAL_RESOLVED                           MessageErrorReason = 14
+	MessageErrorReason_APPEAL_EXIST                              MessageErrorReason = 15
+	MessageErrorReason_APPEAL_REVIEW_FAILED                      MessageErrorReason = 16
+	MessageErrorReason_GET_APPEAL_FAILED                         MessageErrorReason = 17
+	MessageErrorReason_ACCEPT_APPEAL_FAILED                      MessageErrorReason = 18
+	MessageErrorReason_REJECT_APPEAL_FAILED                      MessageErrorReason = 19
 )
 
 // Enum value maps for MessageErrorReason.
 var (
 	MessageErrorReason_name = map[int32]string{
-		0: "UNKNOWN_ERROR",
-		1: "ACCESS_USER_MEDAL_FAILED",
-		2: "GET_MAILBOX_LAST_TIME_FAILED",
-		3: "GET_MESSAGE_NOTIFICATION_FAILED",
-		4: "SET_MAILBOX_LAST_TIME_FAILED",
-		5: "REMOVE_MAILBOX_COMMENT_FAILED",
-		6: "REMOVE_MAILBOX_SYSTEM_NOTIFICATION_FAILED",
+		0:  "UNKNOWN_ERROR",
+		1:  "ACCESS_USER_MEDAL_FAILED",
+		2:  "GET_MAILBOX_LAST_TIME_FAILED",
+		3:  "GET_MESSAGE_NOTIFICATION_FAILED",
+		4:  "SET_MAILBOX_LAST_TIME_FAILED",
+		5:  "REMOVE_MAILBOX_COMMENT_FAILED",
+		6:  "REMOVE_MAILBOX_SYSTEM_NOTIFICATION_FAILED",
+		7:  "REMOVE_MAILBOX_MENTION_FAILED",
+		8:  "CONTENT_FLAG_NOT_FOUND",
+		9:  "CONTENT_FLAG_RESOLVED",
+		10: "GET_CONTENT_FLAG_FAILED",
+		11: "PUBLISH_CONTENT_FLAG_FAILED",
+		12: "REMOVE_CONTENT_FLAG_FAILED",
+		13: "APPEAL_NOT_FOUND",
+		14: "APPEAL_RESOLVED",
+		15: "APPEAL_EXIST",
+		16: "APPEAL_REVIEW_FAILED",
+		17: "GET_APPEAL_FAILED",
+		18: "ACCEPT_APPEAL_FAILED",
+		19: "REJECT_APPEAL_FAILED",
 	}
 	MessageErrorReason_value = map[string]int32{
 		"UNKNOWN_ERROR":                             0,
@@ -52,6 +78,19 @@ var (
 		"SET_MAILBOX_LAST_TIME_FAILED":              4,
 		"REMOVE_MAILBOX_COMMENT_FAILED":             5,
 		"REMOVE_MAILBOX_SYSTEM_NOTIFICATION_FAILED": 6,
+		"REMOVE_MAILBOX_MENTION_FAILED":             7,
+		"CONTENT_FLAG_NOT_FOUND":                    8,
+		"CONTENT_FLAG_RESOLVED":                     9,
+		"GET_CONTENT_FLAG_FAILED":                   10,
+		"PUBLISH_CONTENT_FLAG_FAILED":               11,
+		"REMOVE_CONTENT_FLAG_FAILED":                12,
+		"APPEAL_NOT_FOUND":                          13,
+		"APPEAL_RESOLVED":                           14,
+		"APPEAL_EXIST":                              15,
+		"APPEAL_REVIEW_FAILED":                      16,
+		"GET_APPEAL_FAILED":                         17,
+		"ACCEPT_APPEAL_FAILED":                      18,
+		"REJECT_APPEAL_FAILED":                      19,
 	}
 )
 
@@ -89,7 +128,7 @@ var file_message_service_v1_message_error_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x86, 0x02, 0x0a, 0x12, 0x4d, 0x65,
+	0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xfe, 0x04, 0x0a, 0x12, 0x4d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
 	0x52, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x55, 0x53,
@@ -105,10 +144,34 @@ var file_message_service_v1_message_error_proto_rawDesc = []byte{
 	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x2d, 0x0a,
 	0x29, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4c, 0x42, 0x4f, 0x58, 0x5f,
 	0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x1a, 0x04, 0xa0, 0x45,
-	0xf4, 0x03, 0x42, 0x1e, 0x5a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4c, 0x42, 0x4f, 0x58, 0x5f, 0x4d,
+	0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12,
+	0x20, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0x94,
+	0x03, 0x12, 0x1f, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4c, 0x41,
+	0x47, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45,
+	0x99, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0a, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45,
+	0x4e, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0b,
+	0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45,
+	0x4e, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0c,
+	0x12, 0x1a, 0x0a, 0x10, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0d, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x19, 0x0a, 0x0f,
+	0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10,
+	0x0e, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x41, 0x50, 0x50, 0x45, 0x41,
+	0x4c, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x0f, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x45, 0x54,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x11,
+	0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41,
+	0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x13, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1e, 0x5a, 0x1c, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -94,3 +94,159 @@ func IsRemoveMailboxSystemNotificationFailed(err error) bool {
 func ErrorRemoveMailboxSystemNotificationFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, MessageErrorReason_REMOVE_MAILBOX_SYSTEM_NOTIFICATION_FAILED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsRemoveMailboxMentionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_REMOVE_MAILBOX_MENTION_FAILED.String() && e.Code == 500
+}
+
+func ErrorRemoveMailboxMentionFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_REMOVE_MAILBOX_MENTION_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsContentFlagNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_CONTENT_FLAG_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorContentFlagNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, MessageErrorReason_CONTENT_FLAG_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsContentFlagResolved(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_CONTENT_FLAG_RESOLVED.String() && e.Code == 409
+}
+
+func ErrorContentFlagResolved(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, MessageErrorReason_CONTENT_FLAG_RESOLVED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetContentFlagFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_GET_CONTENT_FLAG_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetContentFlagFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_GET_CONTENT_FLAG_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPublishContentFlagFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_PUBLISH_CONTENT_FLAG_FAILED.String() && e.Code == 500
+}
+
+func ErrorPublishContentFlagFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_PUBLISH_CONTENT_FLAG_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRemoveContentFlagFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_REMOVE_CONTENT_FLAG_FAILED.String() && e.Code == 500
+}
+
+func ErrorRemoveContentFlagFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_REMOVE_CONTENT_FLAG_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAppealNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_APPEAL_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorAppealNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, MessageErrorReason_APPEAL_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAppealResolved(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_APPEAL_RESOLVED.String() && e.Code == 409
+}
+
+func ErrorAppealResolved(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, MessageErrorReason_APPEAL_RESOLVED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAppealExist(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_APPEAL_EXIST.String() && e.Code == 409
+}
+
+func ErrorAppealExist(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, MessageErrorReason_APPEAL_EXIST.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAppealReviewFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_APPEAL_REVIEW_FAILED.String() && e.Code == 500
+}
+
+func ErrorAppealReviewFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_APPEAL_REVIEW_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetAppealFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_GET_APPEAL_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetAppealFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_GET_APPEAL_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAcceptAppealFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_ACCEPT_APPEAL_FAILED.String() && e.Code == 500
+}
+
+func ErrorAcceptAppealFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_ACCEPT_APPEAL_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRejectAppealFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == MessageErrorReason_REJECT_APPEAL_FAILED.String() && e.Code == 500
+}
+
+func ErrorRejectAppealFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, MessageErrorReason_REJECT_APPEAL_FAILED.String(), fmt.Sprintf(format, args...))
+}
@@ -43,10 +43,19 @@ type MessageClient interface {
 	GetMessageNotification(ctx context.Context, in *GetMessageNotificationReq, opts ...grpc.CallOption) (*GetMessageNotificationReply, error)
 	GetMailBoxLastTime(ctx context.Context, in *GetMailBoxLastTimeReq, opts ...grpc.CallOption) (*GetMailBoxLastTimeReply, error)
 	GetMessageSystemNotification(ctx context.Context, in *GetMessageSystemNotificationReq, opts ...grpc.CallOption) (*GetMessageSystemNotificationReply, error)
+	GetMessageMention(ctx context.Context, in *GetMessageMentionReq, opts ...grpc.CallOption) (*GetMessageMentionReply, error)
 	SetMailBoxLastTime(ctx context.Context, in *SetMailBoxLastTimeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMailBoxCommentCount(ctx context.Context, in *RemoveMailBoxCommentCountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMailBoxSubCommentCount(ctx context.Context, in *RemoveMailBoxSubCommentCountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMailBoxSystemNotificationCount(ctx context.Context, in *RemoveMailBoxSystemNotificationCountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveMailBoxMentionCount(ctx context.Context, in *RemoveMailBoxMentionCountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetContentFlagList(ctx context.Context, in *GetContentFlagListReq, opts ...grpc.CallOption) (*GetContentFlagListReply, error)
+	PublishContentFlag(ctx context.Context, in *PublishContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveContentFlag(ctx context.Context, in *RemoveContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AppealReview(ctx context.Context, in *AppealReviewReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAppealList(ctx context.Context, in *GetAppealListReq, opts ...grpc.CallOption) (*GetAppealListReply, error)
+	AcceptAppeal(ctx context.Context, in *AcceptAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectAppeal(ctx context.Context, in *RejectAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type messageClient struct {
@@ -237,6 +246,15 @@ func (c *messageClient) GetMessageSystemNotification(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *messageClient) GetMessageMention(ctx context.Context, in *GetMessageMentionReq, opts ...grpc.CallOption) (*GetMessageMentionReply, error) {
+	out := new(GetMessageMentionReply)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/GetMessageMention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messageClient) SetMailBoxLastTime(ctx context.Context, in *SetMailBoxLastTimeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/message.v1.Message/SetMailBoxLastTime", in, out, opts...)
@@ -273,6 +291,78 @@ func (c *messageClient) RemoveMailBoxSystemNotificationCount(ctx context.Context
 	return out, nil
 }
 
+func (c *messageClient) RemoveMailBoxMentionCount(ctx context.Context, in *RemoveMailBoxMentionCountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/RemoveMailBoxMentionCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetContentFlagList(ctx context.Context, in *GetContentFlagListReq, opts ...grpc.CallOption) (*GetContentFlagListReply, error) {
+	out := new(GetContentFlagListReply)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/GetContentFlagList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) PublishContentFlag(ctx context.Context, in *PublishContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/PublishContentFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) RemoveContentFlag(ctx context.Context, in *RemoveContentFlagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/RemoveContentFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) AppealReview(ctx context.Context, in *AppealReviewReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/AppealReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) GetAppealList(ctx context.Context, in *GetAppealListReq, opts ...grpc.CallOption) (*GetAppealListReply, error) {
+	out := new(GetAppealListReply)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/GetAppealList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) AcceptAppeal(ctx context.Context, in *AcceptAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/AcceptAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) RejectAppeal(ctx context.Context, in *RejectAppealReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/message.v1.Message/RejectAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServer is the server API for Message service.
 // All implementations must embed UnimplementedMessageServer
 // for forward compatibility
@@ -297,10 +387,19 @@ type MessageServer interface {
 	GetMessageNotification(context.Context, *GetMessageNotificationReq) (*GetMessageNotificationReply, error)
 	GetMailBoxLastTime(context.Context, *GetMailBoxLastTimeReq) (*GetMailBoxLastTimeReply, error)
 	GetMessageSystemNotification(context.Context, *GetMessageSystemNotificationReq) (*GetMessageSystemNotificationReply, error)
+	GetMessageMention(context.Context, *GetMessageMentionReq) (*GetMessageMentionReply, error)
 	SetMailBoxLastTime(context.Context, *SetMailBoxLastTimeReq) (*emptypb.Empty, error)
 	RemoveMailBoxCommentCount(context.Context, *RemoveMailBoxCommentCountReq) (*emptypb.Empty, error)
 	RemoveMailBoxSubCommentCount(context.Context, *RemoveMailBoxSubCommentCountReq) (*emptypb.Empty, error)
 	RemoveMailBoxSystemNotificationCount(context.Context, *RemoveMailBoxSystemNotificationCountReq) (*emptypb.Empty, error)
+	RemoveMailBoxMentionCount(context.Context, *RemoveMailBoxMentionCountReq) (*emptypb.Empty, error)
+	GetContentFlagList(context.Context, *GetContentFlagListReq) (*GetContentFlagListReply, error)
+	PublishContentFlag(context.Context, *PublishContentFlagReq) (*emptypb.Empty, error)
+	RemoveContentFlag(context.Context, *RemoveContentFlagReq) (*emptypb.Empty, error)
+	AppealReview(context.Context, *AppealReviewReq) (*emptypb.Empty, error)
+	GetAppealList(context.Context, *GetAppealListReq) (*GetAppealListReply, error)
+	AcceptAppeal(context.Context, *AcceptAppealReq) (*emptypb.Empty, error)
+	RejectAppeal(context.Context, *RejectAppealReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMessageServer()
 }
 
@@ -368,6 +467,9 @@ func (UnimplementedMessageServer) GetMailBoxLastTime(context.Context, *GetMailBo
 func (UnimplementedMessageServer) GetMessageSystemNotification(context.Context, *GetMessageSystemNotificationReq) (*GetMessageSystemNotificationReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessageSystemNotification not implemented")
 }
+func (UnimplementedMessageServer) GetMessageMention(context.Context, *GetMessageMentionReq) (*GetMessageMentionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageMention not implemented")
+}
 func (UnimplementedMessageServer) SetMailBoxLastTime(context.Context, *SetMailBoxLastTimeReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMailBoxLastTime not implemented")
 }
@@ -380,6 +482,30 @@ func (UnimplementedMessageServer) RemoveMailBoxSubCommentCount(context.Context,
 func (UnimplementedMessageServer) RemoveMailBoxSystemNotificationCount(context.Context, *RemoveMailBoxSystemNotificationCountReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMailBoxSystemNotificationCount not implemented")
 }
+func (UnimplementedMessageServer) RemoveMailBoxMentionCount(context.Context, *RemoveMailBoxMentionCountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMailBoxMentionCount not implemented")
+}
+func (UnimplementedMessageServer) GetContentFlagList(context.Context, *GetContentFlagListReq) (*GetContentFlagListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentFlagList not implemented")
+}
+func (UnimplementedMessageServer) PublishContentFlag(context.Context, *PublishContentFlagReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishContentFlag not implemented")
+}
+func (UnimplementedMessageServer) RemoveContentFlag(context.Context, *RemoveContentFlagReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContentFlag not implemented")
+}
+func (UnimplementedMessageServer) AppealReview(context.Context, *AppealReviewReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+}
+func (UnimplementedMessageServer) GetAppealList(context.Context, *GetAppealListReq) (*GetAppealListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppealList not implemented")
+}
+func (UnimplementedMessageServer) AcceptAppeal(context.Context, *AcceptAppealReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAppeal not implemented")
+}
+func (UnimplementedMessageServer) RejectAppeal(context.Context, *RejectAppealReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAppeal not implemented")
+}
 func (UnimplementedMessageServer) mustEmbedUnimplementedMessageServer() {}
 
 // UnsafeMessageServer may be embedded to opt out of forward compatibility for this service.
@@ -753,6 +879,24 @@ func _Message_GetMessageSystemNotification_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Message_GetMessageMention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageMentionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetMessageMention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/GetMessageMention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetMessageMention(ctx, req.(*GetMessageMentionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Message_SetMailBoxLastTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetMailBoxLastTimeReq)
 	if err := dec(in); err != nil {
@@ -825,6 +969,150 @@ func _Message_RemoveMailBoxSystemNotificationCount_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Message_RemoveMailBoxMentionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMailBoxMentionCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).RemoveMailBoxMentionCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/RemoveMailBoxMentionCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).RemoveMailBoxMentionCount(ctx, req.(*RemoveMailBoxMentionCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetContentFlagList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentFlagListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetContentFlagList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/GetContentFlagList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetContentFlagList(ctx, req.(*GetContentFlagListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_PublishContentFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishContentFlagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).PublishContentFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/PublishContentFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).PublishContentFlag(ctx, req.(*PublishContentFlagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_RemoveContentFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContentFlagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).RemoveContentFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/RemoveContentFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).RemoveContentFlag(ctx, req.(*RemoveContentFlagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).AppealReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/AppealReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).AppealReview(ctx, req.(*AppealReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_GetAppealList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).GetAppealList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/GetAppealList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).GetAppealList(ctx, req.(*GetAppealListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_AcceptAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).AcceptAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/AcceptAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).AcceptAppeal(ctx, req.(*AcceptAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_RejectAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAppealReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).RejectAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/message.v1.Message/RejectAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).RejectAppeal(ctx, req.(*RejectAppealReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Message_ServiceDesc is the grpc.ServiceDesc for Message service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -912,6 +1200,10 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessageSystemNotification",
 			Handler:    _Message_GetMessageSystemNotification_Handler,
 		},
+		{
+			MethodName: "GetMessageMention",
+			Handler:    _Message_GetMessageMention_Handler,
+		},
 		{
 			MethodName: "SetMailBoxLastTime",
 			Handler:    _Message_SetMailBoxLastTime_Handler,
@@ -928,6 +1220,38 @@ var Message_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMailBoxSystemNotificationCount",
 			Handler:    _Message_RemoveMailBoxSystemNotificationCount_Handler,
 		},
+		{
+			MethodName: "RemoveMailBoxMentionCount",
+			Handler:    _Message_RemoveMailBoxMentionCount_Handler,
+		},
+		{
+			MethodName: "GetContentFlagList",
+			Handler:    _Message_GetContentFlagList_Handler,
+		},
+		{
+			MethodName: "PublishContentFlag",
+			Handler:    _Message_PublishContentFlag_Handler,
+		},
+		{
+			MethodName: "RemoveContentFlag",
+			Handler:    _Message_RemoveContentFlag_Handler,
+		},
+		{
+			MethodName: "AppealReview",
+			Handler:    _Message_AppealReview_Handler,
+		},
+		{
+			MethodName: "GetAppealList",
+			Handler:    _Message_GetAppealList_Handler,
+		},
+		{
+			MethodName: "AcceptAppeal",
+			Handler:    _Message_AcceptAppeal_Handler,
+		},
+		{
+			MethodName: "RejectAppeal",
+			Handler:    _Message_RejectAppeal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message/service/v1/message.proto",
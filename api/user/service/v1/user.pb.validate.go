@@ -79,10 +79,10 @@ func (m *UserRegisterReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 20 {
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
 		err := UserRegisterReqValidationError{
 			field:  "Password",
-			reason: "value length must be between 1 and 20 runes, inclusive",
+			reason: "value length must be between 1 and 128 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -813,10 +813,10 @@ func (m *LoginByPasswordReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 20 {
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
 		err := LoginByPasswordReqValidationError{
 			field:  "Password",
-			reason: "value length must be between 1 and 20 runes, inclusive",
+			reason: "value length must be between 1 and 128 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -835,6 +835,10 @@ func (m *LoginByPasswordReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
 		return LoginByPasswordReqMultiError(errors)
 	}
@@ -966,6 +970,10 @@ func (m *LoginByCodeReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
 		return LoginByCodeReqMultiError(errors)
 	}
@@ -1072,6 +1080,10 @@ func (m *LoginReply) validate(all bool) error {
 
 	// no validation rules for Token
 
+	// no validation rules for RefreshToken
+
+	// no validation rules for Challenge
+
 	if len(errors) > 0 {
 		return LoginReplyMultiError(errors)
 	}
@@ -1149,32 +1161,32 @@ var _ interface {
 	ErrorName() string
 } = LoginReplyValidationError{}
 
-// Validate checks the field values on LoginByWeChatReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *LoginByWeChatReq) Validate() error {
+// Validate checks the field values on GetOauthUrlReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetOauthUrlReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginByWeChatReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LoginByWeChatReqMultiError, or nil if none found.
-func (m *LoginByWeChatReq) ValidateAll() error {
+// ValidateAll checks the field values on GetOauthUrlReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetOauthUrlReqMultiError,
+// or nil if none found.
+func (m *GetOauthUrlReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginByWeChatReq) validate(all bool) error {
+func (m *GetOauthUrlReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := LoginByWeChatReqValidationError{
-			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 1 || l > 20 {
+		err := GetOauthUrlReqValidationError{
+			field:  "Provider",
+			reason: "value length must be between 1 and 20 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1182,130 +1194,45 @@ func (m *LoginByWeChatReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return LoginByWeChatReqMultiError(errors)
-	}
-
-	return nil
-}
-
-// LoginByWeChatReqMultiError is an error wrapping multiple validation errors
-// returned by LoginByWeChatReq.ValidateAll() if the designated constraints
-// aren't met.
-type LoginByWeChatReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m LoginByWeChatReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m LoginByWeChatReqMultiError) AllErrors() []error { return m }
-
-// LoginByWeChatReqValidationError is the validation error returned by
-// LoginByWeChatReq.Validate if the designated constraints aren't met.
-type LoginByWeChatReqValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e LoginByWeChatReqValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e LoginByWeChatReqValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e LoginByWeChatReqValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e LoginByWeChatReqValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e LoginByWeChatReqValidationError) ErrorName() string { return "LoginByWeChatReqValidationError" }
-
-// Error satisfies the builtin error interface
-func (e LoginByWeChatReqValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sLoginByWeChatReq.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = LoginByWeChatReqValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = LoginByWeChatReqValidationError{}
-
-// Validate checks the field values on LoginByQQReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LoginByQQReq) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on LoginByQQReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LoginByQQReqMultiError, or
-// nil if none found.
-func (m *LoginByQQReq) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *LoginByQQReq) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
+	if m.GetRedirectUri() != "" {
 
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := LoginByQQReqValidationError{
-			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
+		if uri, err := url.Parse(m.GetRedirectUri()); err != nil {
+			err = GetOauthUrlReqValidationError{
+				field:  "RedirectUri",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := GetOauthUrlReqValidationError{
+				field:  "RedirectUri",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return LoginByQQReqMultiError(errors)
+		return GetOauthUrlReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginByQQReqMultiError is an error wrapping multiple validation errors
-// returned by LoginByQQReq.ValidateAll() if the designated constraints aren't met.
-type LoginByQQReqMultiError []error
+// GetOauthUrlReqMultiError is an error wrapping multiple validation errors
+// returned by GetOauthUrlReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetOauthUrlReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginByQQReqMultiError) Error() string {
+func (m GetOauthUrlReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1314,11 +1241,11 @@ func (m LoginByQQReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginByQQReqMultiError) AllErrors() []error { return m }
+func (m GetOauthUrlReqMultiError) AllErrors() []error { return m }
 
-// LoginByQQReqValidationError is the validation error returned by
-// LoginByQQReq.Validate if the designated constraints aren't met.
-type LoginByQQReqValidationError struct {
+// GetOauthUrlReqValidationError is the validation error returned by
+// GetOauthUrlReq.Validate if the designated constraints aren't met.
+type GetOauthUrlReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1326,22 +1253,22 @@ type LoginByQQReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginByQQReqValidationError) Field() string { return e.field }
+func (e GetOauthUrlReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginByQQReqValidationError) Reason() string { return e.reason }
+func (e GetOauthUrlReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginByQQReqValidationError) Cause() error { return e.cause }
+func (e GetOauthUrlReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginByQQReqValidationError) Key() bool { return e.key }
+func (e GetOauthUrlReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginByQQReqValidationError) ErrorName() string { return "LoginByQQReqValidationError" }
+func (e GetOauthUrlReqValidationError) ErrorName() string { return "GetOauthUrlReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LoginByQQReqValidationError) Error() string {
+func (e GetOauthUrlReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1353,14 +1280,14 @@ func (e LoginByQQReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginByQQReq.%s: %s%s",
+		"invalid %sGetOauthUrlReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginByQQReqValidationError{}
+var _ error = GetOauthUrlReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1368,55 +1295,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginByQQReqValidationError{}
+} = GetOauthUrlReqValidationError{}
 
-// Validate checks the field values on LoginByGithubReq with the rules defined
+// Validate checks the field values on GetOauthUrlReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *LoginByGithubReq) Validate() error {
+func (m *GetOauthUrlReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginByGithubReq with the rules
+// ValidateAll checks the field values on GetOauthUrlReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LoginByGithubReqMultiError, or nil if none found.
-func (m *LoginByGithubReq) ValidateAll() error {
+// GetOauthUrlReplyMultiError, or nil if none found.
+func (m *GetOauthUrlReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginByGithubReq) validate(all bool) error {
+func (m *GetOauthUrlReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := LoginByGithubReqValidationError{
-			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Url
+
+	// no validation rules for State
 
 	if len(errors) > 0 {
-		return LoginByGithubReqMultiError(errors)
+		return GetOauthUrlReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginByGithubReqMultiError is an error wrapping multiple validation errors
-// returned by LoginByGithubReq.ValidateAll() if the designated constraints
+// GetOauthUrlReplyMultiError is an error wrapping multiple validation errors
+// returned by GetOauthUrlReply.ValidateAll() if the designated constraints
 // aren't met.
-type LoginByGithubReqMultiError []error
+type GetOauthUrlReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginByGithubReqMultiError) Error() string {
+func (m GetOauthUrlReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1425,11 +1345,11 @@ func (m LoginByGithubReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginByGithubReqMultiError) AllErrors() []error { return m }
+func (m GetOauthUrlReplyMultiError) AllErrors() []error { return m }
 
-// LoginByGithubReqValidationError is the validation error returned by
-// LoginByGithubReq.Validate if the designated constraints aren't met.
-type LoginByGithubReqValidationError struct {
+// GetOauthUrlReplyValidationError is the validation error returned by
+// GetOauthUrlReply.Validate if the designated constraints aren't met.
+type GetOauthUrlReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1437,22 +1357,22 @@ type LoginByGithubReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginByGithubReqValidationError) Field() string { return e.field }
+func (e GetOauthUrlReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginByGithubReqValidationError) Reason() string { return e.reason }
+func (e GetOauthUrlReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginByGithubReqValidationError) Cause() error { return e.cause }
+func (e GetOauthUrlReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginByGithubReqValidationError) Key() bool { return e.key }
+func (e GetOauthUrlReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginByGithubReqValidationError) ErrorName() string { return "LoginByGithubReqValidationError" }
+func (e GetOauthUrlReplyValidationError) ErrorName() string { return "GetOauthUrlReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LoginByGithubReqValidationError) Error() string {
+func (e GetOauthUrlReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1464,14 +1384,14 @@ func (e LoginByGithubReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginByGithubReq.%s: %s%s",
+		"invalid %sGetOauthUrlReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginByGithubReqValidationError{}
+var _ error = GetOauthUrlReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1479,33 +1399,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginByGithubReqValidationError{}
+} = GetOauthUrlReplyValidationError{}
 
-// Validate checks the field values on LoginByGiteeReq with the rules defined
+// Validate checks the field values on LoginByOauthReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *LoginByGiteeReq) Validate() error {
+func (m *LoginByOauthReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginByGiteeReq with the rules
+// ValidateAll checks the field values on LoginByOauthReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LoginByGiteeReqMultiError, or nil if none found.
-func (m *LoginByGiteeReq) ValidateAll() error {
+// LoginByOauthReqMultiError, or nil if none found.
+func (m *LoginByOauthReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginByGiteeReq) validate(all bool) error {
+func (m *LoginByOauthReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := LoginByGiteeReqValidationError{
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 1 || l > 20 {
+		err := LoginByOauthReqValidationError{
+			field:  "Provider",
+			reason: "value length must be between 1 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 500 {
+		err := LoginByOauthReqValidationError{
 			field:  "Code",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetState()); l < 1 || l > 100 {
+		err := LoginByOauthReqValidationError{
+			field:  "State",
 			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
 		if !all {
@@ -1514,20 +1456,24 @@ func (m *LoginByGiteeReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
-		return LoginByGiteeReqMultiError(errors)
+		return LoginByOauthReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginByGiteeReqMultiError is an error wrapping multiple validation errors
-// returned by LoginByGiteeReq.ValidateAll() if the designated constraints
+// LoginByOauthReqMultiError is an error wrapping multiple validation errors
+// returned by LoginByOauthReq.ValidateAll() if the designated constraints
 // aren't met.
-type LoginByGiteeReqMultiError []error
+type LoginByOauthReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginByGiteeReqMultiError) Error() string {
+func (m LoginByOauthReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1536,11 +1482,11 @@ func (m LoginByGiteeReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginByGiteeReqMultiError) AllErrors() []error { return m }
+func (m LoginByOauthReqMultiError) AllErrors() []error { return m }
 
-// LoginByGiteeReqValidationError is the validation error returned by
-// LoginByGiteeReq.Validate if the designated constraints aren't met.
-type LoginByGiteeReqValidationError struct {
+// LoginByOauthReqValidationError is the validation error returned by
+// LoginByOauthReq.Validate if the designated constraints aren't met.
+type LoginByOauthReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1548,22 +1494,22 @@ type LoginByGiteeReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginByGiteeReqValidationError) Field() string { return e.field }
+func (e LoginByOauthReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginByGiteeReqValidationError) Reason() string { return e.reason }
+func (e LoginByOauthReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginByGiteeReqValidationError) Cause() error { return e.cause }
+func (e LoginByOauthReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginByGiteeReqValidationError) Key() bool { return e.key }
+func (e LoginByOauthReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginByGiteeReqValidationError) ErrorName() string { return "LoginByGiteeReqValidationError" }
+func (e LoginByOauthReqValidationError) ErrorName() string { return "LoginByOauthReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LoginByGiteeReqValidationError) Error() string {
+func (e LoginByOauthReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1575,14 +1521,14 @@ func (e LoginByGiteeReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginByGiteeReq.%s: %s%s",
+		"invalid %sLoginByOauthReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginByGiteeReqValidationError{}
+var _ error = LoginByOauthReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1590,7 +1536,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginByGiteeReqValidationError{}
+} = LoginByOauthReqValidationError{}
 
 // Validate checks the field values on LoginPasswordResetReq with the rules
 // defined in the proto definition for this message. If any rules are
@@ -1647,10 +1593,10 @@ func (m *LoginPasswordResetReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 20 {
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
 		err := LoginPasswordResetReqValidationError{
 			field:  "Password",
-			reason: "value length must be between 1 and 20 runes, inclusive",
+			reason: "value length must be between 1 and 128 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1758,43 +1704,32 @@ var _LoginPasswordResetReq_Mode_InLookup = map[string]struct{}{
 	"email": {},
 }
 
-// Validate checks the field values on SendPhoneCodeReq with the rules defined
+// Validate checks the field values on RefreshTokenReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *SendPhoneCodeReq) Validate() error {
+func (m *RefreshTokenReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendPhoneCodeReq with the rules
+// ValidateAll checks the field values on RefreshTokenReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SendPhoneCodeReqMultiError, or nil if none found.
-func (m *SendPhoneCodeReq) ValidateAll() error {
+// RefreshTokenReqMultiError, or nil if none found.
+func (m *RefreshTokenReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendPhoneCodeReq) validate(all bool) error {
+func (m *RefreshTokenReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 50 {
-		err := SendPhoneCodeReqValidationError{
-			field:  "Phone",
-			reason: "value length must be between 1 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_SendPhoneCodeReq_Phone_Pattern.MatchString(m.GetPhone()) {
-		err := SendPhoneCodeReqValidationError{
-			field:  "Phone",
-			reason: "value does not match regex pattern \"^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\\\d{8}$\"",
+	if l := utf8.RuneCountInString(m.GetRefreshToken()); l < 1 || l > 100 {
+		err := RefreshTokenReqValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1802,31 +1737,22 @@ func (m *SendPhoneCodeReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _SendPhoneCodeReq_Template_InLookup[m.GetTemplate()]; !ok {
-		err := SendPhoneCodeReqValidationError{
-			field:  "Template",
-			reason: "value must be in list [1 2 3 4]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Ip
 
 	if len(errors) > 0 {
-		return SendPhoneCodeReqMultiError(errors)
+		return RefreshTokenReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendPhoneCodeReqMultiError is an error wrapping multiple validation errors
-// returned by SendPhoneCodeReq.ValidateAll() if the designated constraints
+// RefreshTokenReqMultiError is an error wrapping multiple validation errors
+// returned by RefreshTokenReq.ValidateAll() if the designated constraints
 // aren't met.
-type SendPhoneCodeReqMultiError []error
+type RefreshTokenReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendPhoneCodeReqMultiError) Error() string {
+func (m RefreshTokenReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1835,11 +1761,11 @@ func (m SendPhoneCodeReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendPhoneCodeReqMultiError) AllErrors() []error { return m }
+func (m RefreshTokenReqMultiError) AllErrors() []error { return m }
 
-// SendPhoneCodeReqValidationError is the validation error returned by
-// SendPhoneCodeReq.Validate if the designated constraints aren't met.
-type SendPhoneCodeReqValidationError struct {
+// RefreshTokenReqValidationError is the validation error returned by
+// RefreshTokenReq.Validate if the designated constraints aren't met.
+type RefreshTokenReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1847,22 +1773,22 @@ type SendPhoneCodeReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendPhoneCodeReqValidationError) Field() string { return e.field }
+func (e RefreshTokenReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendPhoneCodeReqValidationError) Reason() string { return e.reason }
+func (e RefreshTokenReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendPhoneCodeReqValidationError) Cause() error { return e.cause }
+func (e RefreshTokenReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendPhoneCodeReqValidationError) Key() bool { return e.key }
+func (e RefreshTokenReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendPhoneCodeReqValidationError) ErrorName() string { return "SendPhoneCodeReqValidationError" }
+func (e RefreshTokenReqValidationError) ErrorName() string { return "RefreshTokenReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SendPhoneCodeReqValidationError) Error() string {
+func (e RefreshTokenReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1874,14 +1800,14 @@ func (e SendPhoneCodeReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendPhoneCodeReq.%s: %s%s",
+		"invalid %sRefreshTokenReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendPhoneCodeReqValidationError{}
+var _ error = RefreshTokenReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1889,54 +1815,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendPhoneCodeReqValidationError{}
-
-var _SendPhoneCodeReq_Phone_Pattern = regexp.MustCompile("^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\d{8}$")
+} = RefreshTokenReqValidationError{}
 
-var _SendPhoneCodeReq_Template_InLookup = map[string]struct{}{
-	"1": {},
-	"2": {},
-	"3": {},
-	"4": {},
-}
-
-// Validate checks the field values on SendEmailCodeReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SendEmailCodeReq) Validate() error {
+// Validate checks the field values on LogoutReq with the rules defined in the
+// proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LogoutReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendEmailCodeReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LogoutReq with the rules defined in
+// the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SendEmailCodeReqMultiError, or nil if none found.
-func (m *SendEmailCodeReq) ValidateAll() error {
+// LogoutReqMultiError, or nil if none found.
+func (m *LogoutReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendEmailCodeReq) validate(all bool) error {
+func (m *LogoutReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetEmail()) > 50 {
-		err := SendEmailCodeReqValidationError{
-			field:  "Email",
-			reason: "value length must be at most 50 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_SendEmailCodeReq_Email_Pattern.MatchString(m.GetEmail()) {
-		err := SendEmailCodeReqValidationError{
-			field:  "Email",
-			reason: "value does not match regex pattern \"\\\\w+([-+.]\\\\w+)*@\\\\w+([-.]\\\\w+)*\\\\.\\\\w+([-.]\\\\w+)*\"",
+	if !_LogoutReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := LogoutReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
 		if !all {
 			return err
@@ -1944,10 +1850,10 @@ func (m *SendEmailCodeReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _SendEmailCodeReq_Template_InLookup[m.GetTemplate()]; !ok {
-		err := SendEmailCodeReqValidationError{
-			field:  "Template",
-			reason: "value must be in list [1 2 3 4 5]",
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 50 {
+		err := LogoutReqValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1956,19 +1862,18 @@ func (m *SendEmailCodeReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SendEmailCodeReqMultiError(errors)
+		return LogoutReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendEmailCodeReqMultiError is an error wrapping multiple validation errors
-// returned by SendEmailCodeReq.ValidateAll() if the designated constraints
-// aren't met.
-type SendEmailCodeReqMultiError []error
+// LogoutReqMultiError is an error wrapping multiple validation errors returned
+// by LogoutReq.ValidateAll() if the designated constraints aren't met.
+type LogoutReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendEmailCodeReqMultiError) Error() string {
+func (m LogoutReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1977,11 +1882,11 @@ func (m SendEmailCodeReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendEmailCodeReqMultiError) AllErrors() []error { return m }
+func (m LogoutReqMultiError) AllErrors() []error { return m }
 
-// SendEmailCodeReqValidationError is the validation error returned by
-// SendEmailCodeReq.Validate if the designated constraints aren't met.
-type SendEmailCodeReqValidationError struct {
+// LogoutReqValidationError is the validation error returned by
+// LogoutReq.Validate if the designated constraints aren't met.
+type LogoutReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1989,22 +1894,22 @@ type SendEmailCodeReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendEmailCodeReqValidationError) Field() string { return e.field }
+func (e LogoutReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendEmailCodeReqValidationError) Reason() string { return e.reason }
+func (e LogoutReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendEmailCodeReqValidationError) Cause() error { return e.cause }
+func (e LogoutReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendEmailCodeReqValidationError) Key() bool { return e.key }
+func (e LogoutReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendEmailCodeReqValidationError) ErrorName() string { return "SendEmailCodeReqValidationError" }
+func (e LogoutReqValidationError) ErrorName() string { return "LogoutReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SendEmailCodeReqValidationError) Error() string {
+func (e LogoutReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2016,14 +1921,14 @@ func (e SendEmailCodeReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendEmailCodeReq.%s: %s%s",
+		"invalid %sLogoutReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendEmailCodeReqValidationError{}
+var _ error = LogoutReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2031,42 +1936,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendEmailCodeReqValidationError{}
-
-var _SendEmailCodeReq_Email_Pattern = regexp.MustCompile("\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*")
+} = LogoutReqValidationError{}
 
-var _SendEmailCodeReq_Template_InLookup = map[string]struct{}{
-	"1": {},
-	"2": {},
-	"3": {},
-	"4": {},
-	"5": {},
-}
+var _LogoutReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetCosSessionKeyReq with the rules
+// Validate checks the field values on LogoutAllDevicesReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCosSessionKeyReq) Validate() error {
+func (m *LogoutAllDevicesReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCosSessionKeyReq with the rules
+// ValidateAll checks the field values on LogoutAllDevicesReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCosSessionKeyReqMultiError, or nil if none found.
-func (m *GetCosSessionKeyReq) ValidateAll() error {
+// LogoutAllDevicesReqMultiError, or nil if none found.
+func (m *LogoutAllDevicesReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCosSessionKeyReq) validate(all bool) error {
+func (m *LogoutAllDevicesReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetCosSessionKeyReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetCosSessionKeyReqValidationError{
+	if !_LogoutAllDevicesReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := LogoutAllDevicesReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -2077,19 +1974,19 @@ func (m *GetCosSessionKeyReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCosSessionKeyReqMultiError(errors)
+		return LogoutAllDevicesReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCosSessionKeyReqMultiError is an error wrapping multiple validation
-// errors returned by GetCosSessionKeyReq.ValidateAll() if the designated
+// LogoutAllDevicesReqMultiError is an error wrapping multiple validation
+// errors returned by LogoutAllDevicesReq.ValidateAll() if the designated
 // constraints aren't met.
-type GetCosSessionKeyReqMultiError []error
+type LogoutAllDevicesReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCosSessionKeyReqMultiError) Error() string {
+func (m LogoutAllDevicesReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2098,11 +1995,11 @@ func (m GetCosSessionKeyReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCosSessionKeyReqMultiError) AllErrors() []error { return m }
+func (m LogoutAllDevicesReqMultiError) AllErrors() []error { return m }
 
-// GetCosSessionKeyReqValidationError is the validation error returned by
-// GetCosSessionKeyReq.Validate if the designated constraints aren't met.
-type GetCosSessionKeyReqValidationError struct {
+// LogoutAllDevicesReqValidationError is the validation error returned by
+// LogoutAllDevicesReq.Validate if the designated constraints aren't met.
+type LogoutAllDevicesReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2110,24 +2007,24 @@ type GetCosSessionKeyReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCosSessionKeyReqValidationError) Field() string { return e.field }
+func (e LogoutAllDevicesReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCosSessionKeyReqValidationError) Reason() string { return e.reason }
+func (e LogoutAllDevicesReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCosSessionKeyReqValidationError) Cause() error { return e.cause }
+func (e LogoutAllDevicesReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCosSessionKeyReqValidationError) Key() bool { return e.key }
+func (e LogoutAllDevicesReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCosSessionKeyReqValidationError) ErrorName() string {
-	return "GetCosSessionKeyReqValidationError"
+func (e LogoutAllDevicesReqValidationError) ErrorName() string {
+	return "LogoutAllDevicesReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCosSessionKeyReqValidationError) Error() string {
+func (e LogoutAllDevicesReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2139,14 +2036,14 @@ func (e GetCosSessionKeyReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCosSessionKeyReq.%s: %s%s",
+		"invalid %sLogoutAllDevicesReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCosSessionKeyReqValidationError{}
+var _ error = LogoutAllDevicesReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2154,56 +2051,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCosSessionKeyReqValidationError{}
+} = LogoutAllDevicesReqValidationError{}
 
-var _GetCosSessionKeyReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _LogoutAllDevicesReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetCosSessionKeyReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCosSessionKeyReply) Validate() error {
+// Validate checks the field values on ListSessionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCosSessionKeyReply with the rules
+// ValidateAll checks the field values on ListSessionsReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCosSessionKeyReplyMultiError, or nil if none found.
-func (m *GetCosSessionKeyReply) ValidateAll() error {
+// ListSessionsReqMultiError, or nil if none found.
+func (m *ListSessionsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCosSessionKeyReply) validate(all bool) error {
+func (m *ListSessionsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TmpSecretId
-
-	// no validation rules for TmpSecretKey
-
-	// no validation rules for SessionToken
-
-	// no validation rules for StartTime
-
-	// no validation rules for ExpiredTime
+	if !_ListSessionsReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ListSessionsReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetCosSessionKeyReplyMultiError(errors)
+		return ListSessionsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCosSessionKeyReplyMultiError is an error wrapping multiple validation
-// errors returned by GetCosSessionKeyReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetCosSessionKeyReplyMultiError []error
+// ListSessionsReqMultiError is an error wrapping multiple validation errors
+// returned by ListSessionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListSessionsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCosSessionKeyReplyMultiError) Error() string {
+func (m ListSessionsReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2212,11 +2110,11 @@ func (m GetCosSessionKeyReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCosSessionKeyReplyMultiError) AllErrors() []error { return m }
+func (m ListSessionsReqMultiError) AllErrors() []error { return m }
 
-// GetCosSessionKeyReplyValidationError is the validation error returned by
-// GetCosSessionKeyReply.Validate if the designated constraints aren't met.
-type GetCosSessionKeyReplyValidationError struct {
+// ListSessionsReqValidationError is the validation error returned by
+// ListSessionsReq.Validate if the designated constraints aren't met.
+type ListSessionsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2224,24 +2122,22 @@ type GetCosSessionKeyReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCosSessionKeyReplyValidationError) Field() string { return e.field }
+func (e ListSessionsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCosSessionKeyReplyValidationError) Reason() string { return e.reason }
+func (e ListSessionsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCosSessionKeyReplyValidationError) Cause() error { return e.cause }
+func (e ListSessionsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCosSessionKeyReplyValidationError) Key() bool { return e.key }
+func (e ListSessionsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCosSessionKeyReplyValidationError) ErrorName() string {
-	return "GetCosSessionKeyReplyValidationError"
-}
+func (e ListSessionsReqValidationError) ErrorName() string { return "ListSessionsReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCosSessionKeyReplyValidationError) Error() string {
+func (e ListSessionsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2253,14 +2149,14 @@ func (e GetCosSessionKeyReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCosSessionKeyReply.%s: %s%s",
+		"invalid %sListSessionsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCosSessionKeyReplyValidationError{}
+var _ error = ListSessionsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2268,88 +2164,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCosSessionKeyReplyValidationError{}
+} = ListSessionsReqValidationError{}
 
-// Validate checks the field values on SetUserPhoneReq with the rules defined
+var _ListSessionsReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on ListSessionsReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *SetUserPhoneReq) Validate() error {
+func (m *ListSessionsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserPhoneReq with the rules
+// ValidateAll checks the field values on ListSessionsReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetUserPhoneReqMultiError, or nil if none found.
-func (m *SetUserPhoneReq) ValidateAll() error {
+// ListSessionsReplyMultiError, or nil if none found.
+func (m *ListSessionsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserPhoneReq) validate(all bool) error {
+func (m *ListSessionsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserPhoneReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserPhoneReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 50 {
-		err := SetUserPhoneReqValidationError{
-			field:  "Phone",
-			reason: "value length must be between 1 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetSession() {
+		_, _ = idx, item
 
-	if !_SetUserPhoneReq_Phone_Pattern.MatchString(m.GetPhone()) {
-		err := SetUserPhoneReqValidationError{
-			field:  "Phone",
-			reason: "value does not match regex pattern \"^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\\\d{8}$\"",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsReplyValidationError{
+						field:  fmt.Sprintf("Session[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsReplyValidationError{
+						field:  fmt.Sprintf("Session[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsReplyValidationError{
+					field:  fmt.Sprintf("Session[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if !_SetUserPhoneReq_Code_Pattern.MatchString(m.GetCode()) {
-		err := SetUserPhoneReqValidationError{
-			field:  "Code",
-			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SetUserPhoneReqMultiError(errors)
+		return ListSessionsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserPhoneReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserPhoneReq.ValidateAll() if the designated constraints
+// ListSessionsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListSessionsReply.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserPhoneReqMultiError []error
+type ListSessionsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserPhoneReqMultiError) Error() string {
+func (m ListSessionsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2358,11 +2246,11 @@ func (m SetUserPhoneReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserPhoneReqMultiError) AllErrors() []error { return m }
+func (m ListSessionsReplyMultiError) AllErrors() []error { return m }
 
-// SetUserPhoneReqValidationError is the validation error returned by
-// SetUserPhoneReq.Validate if the designated constraints aren't met.
-type SetUserPhoneReqValidationError struct {
+// ListSessionsReplyValidationError is the validation error returned by
+// ListSessionsReply.Validate if the designated constraints aren't met.
+type ListSessionsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2370,22 +2258,24 @@ type SetUserPhoneReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserPhoneReqValidationError) Field() string { return e.field }
+func (e ListSessionsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserPhoneReqValidationError) Reason() string { return e.reason }
+func (e ListSessionsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserPhoneReqValidationError) Cause() error { return e.cause }
+func (e ListSessionsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserPhoneReqValidationError) Key() bool { return e.key }
+func (e ListSessionsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserPhoneReqValidationError) ErrorName() string { return "SetUserPhoneReqValidationError" }
+func (e ListSessionsReplyValidationError) ErrorName() string {
+	return "ListSessionsReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SetUserPhoneReqValidationError) Error() string {
+func (e ListSessionsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2397,14 +2287,14 @@ func (e SetUserPhoneReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserPhoneReq.%s: %s%s",
+		"invalid %sListSessionsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserPhoneReqValidationError{}
+var _ error = ListSessionsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2412,62 +2302,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserPhoneReqValidationError{}
-
-var _SetUserPhoneReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
-
-var _SetUserPhoneReq_Phone_Pattern = regexp.MustCompile("^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\d{8}$")
-
-var _SetUserPhoneReq_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+} = ListSessionsReplyValidationError{}
 
-// Validate checks the field values on SetUserEmailReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SetUserEmailReq) Validate() error {
+// Validate checks the field values on LoginByTotpReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginByTotpReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserEmailReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetUserEmailReqMultiError, or nil if none found.
-func (m *SetUserEmailReq) ValidateAll() error {
+// ValidateAll checks the field values on LoginByTotpReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginByTotpReqMultiError,
+// or nil if none found.
+func (m *LoginByTotpReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserEmailReq) validate(all bool) error {
+func (m *LoginByTotpReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserEmailReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserEmailReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetEmail()) > 50 {
-		err := SetUserEmailReqValidationError{
-			field:  "Email",
-			reason: "value length must be at most 50 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_SetUserEmailReq_Email_Pattern.MatchString(m.GetEmail()) {
-		err := SetUserEmailReqValidationError{
-			field:  "Email",
-			reason: "value does not match regex pattern \"\\\\w+([-+.]\\\\w+)*@\\\\w+([-.]\\\\w+)*\\\\.\\\\w+([-.]\\\\w+)*\"",
+	if l := utf8.RuneCountInString(m.GetChallenge()); l < 1 || l > 100 {
+		err := LoginByTotpReqValidationError{
+			field:  "Challenge",
+			reason: "value length must be between 1 and 100 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -2475,10 +2337,10 @@ func (m *SetUserEmailReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_SetUserEmailReq_Code_Pattern.MatchString(m.GetCode()) {
-		err := SetUserEmailReqValidationError{
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 20 {
+		err := LoginByTotpReqValidationError{
 			field:  "Code",
-			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+			reason: "value length must be between 1 and 20 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -2486,20 +2348,22 @@ func (m *SetUserEmailReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Ip
+
 	if len(errors) > 0 {
-		return SetUserEmailReqMultiError(errors)
+		return LoginByTotpReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserEmailReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserEmailReq.ValidateAll() if the designated constraints
+// LoginByTotpReqMultiError is an error wrapping multiple validation errors
+// returned by LoginByTotpReq.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserEmailReqMultiError []error
+type LoginByTotpReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserEmailReqMultiError) Error() string {
+func (m LoginByTotpReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2508,11 +2372,11 @@ func (m SetUserEmailReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserEmailReqMultiError) AllErrors() []error { return m }
+func (m LoginByTotpReqMultiError) AllErrors() []error { return m }
 
-// SetUserEmailReqValidationError is the validation error returned by
-// SetUserEmailReq.Validate if the designated constraints aren't met.
-type SetUserEmailReqValidationError struct {
+// LoginByTotpReqValidationError is the validation error returned by
+// LoginByTotpReq.Validate if the designated constraints aren't met.
+type LoginByTotpReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2520,22 +2384,22 @@ type SetUserEmailReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserEmailReqValidationError) Field() string { return e.field }
+func (e LoginByTotpReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserEmailReqValidationError) Reason() string { return e.reason }
+func (e LoginByTotpReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserEmailReqValidationError) Cause() error { return e.cause }
+func (e LoginByTotpReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserEmailReqValidationError) Key() bool { return e.key }
+func (e LoginByTotpReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserEmailReqValidationError) ErrorName() string { return "SetUserEmailReqValidationError" }
+func (e LoginByTotpReqValidationError) ErrorName() string { return "LoginByTotpReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserEmailReqValidationError) Error() string {
+func (e LoginByTotpReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2547,14 +2411,14 @@ func (e SetUserEmailReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserEmailReq.%s: %s%s",
+		"invalid %sLoginByTotpReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserEmailReqValidationError{}
+var _ error = LoginByTotpReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2562,38 +2426,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserEmailReqValidationError{}
+} = LoginByTotpReqValidationError{}
 
-var _SetUserEmailReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+// Validate checks the field values on EnrollTotpReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnrollTotpReq) Validate() error {
+	return m.validate(false)
+}
 
-var _SetUserEmailReq_Email_Pattern = regexp.MustCompile("\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*")
+// ValidateAll checks the field values on EnrollTotpReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollTotpReqMultiError, or
+// nil if none found.
+func (m *EnrollTotpReq) ValidateAll() error {
+	return m.validate(true)
+}
 
-var _SetUserEmailReq_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
-
-// Validate checks the field values on SetUserPasswordReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetUserPasswordReq) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on SetUserPasswordReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetUserPasswordReqMultiError, or nil if none found.
-func (m *SetUserPasswordReq) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *SetUserPasswordReq) validate(all bool) error {
+func (m *EnrollTotpReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserPasswordReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserPasswordReqValidationError{
+	if !_EnrollTotpReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := EnrollTotpReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -2603,31 +2461,20 @@ func (m *SetUserPasswordReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 20 {
-		err := SetUserPasswordReqValidationError{
-			field:  "Password",
-			reason: "value length must be between 1 and 20 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return SetUserPasswordReqMultiError(errors)
+		return EnrollTotpReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserPasswordReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserPasswordReq.ValidateAll() if the designated constraints
+// EnrollTotpReqMultiError is an error wrapping multiple validation errors
+// returned by EnrollTotpReq.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserPasswordReqMultiError []error
+type EnrollTotpReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserPasswordReqMultiError) Error() string {
+func (m EnrollTotpReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2636,11 +2483,11 @@ func (m SetUserPasswordReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserPasswordReqMultiError) AllErrors() []error { return m }
+func (m EnrollTotpReqMultiError) AllErrors() []error { return m }
 
-// SetUserPasswordReqValidationError is the validation error returned by
-// SetUserPasswordReq.Validate if the designated constraints aren't met.
-type SetUserPasswordReqValidationError struct {
+// EnrollTotpReqValidationError is the validation error returned by
+// EnrollTotpReq.Validate if the designated constraints aren't met.
+type EnrollTotpReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2648,24 +2495,22 @@ type SetUserPasswordReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserPasswordReqValidationError) Field() string { return e.field }
+func (e EnrollTotpReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserPasswordReqValidationError) Reason() string { return e.reason }
+func (e EnrollTotpReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserPasswordReqValidationError) Cause() error { return e.cause }
+func (e EnrollTotpReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserPasswordReqValidationError) Key() bool { return e.key }
+func (e EnrollTotpReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserPasswordReqValidationError) ErrorName() string {
-	return "SetUserPasswordReqValidationError"
-}
+func (e EnrollTotpReqValidationError) ErrorName() string { return "EnrollTotpReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserPasswordReqValidationError) Error() string {
+func (e EnrollTotpReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2677,14 +2522,14 @@ func (e SetUserPasswordReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserPasswordReq.%s: %s%s",
+		"invalid %sEnrollTotpReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserPasswordReqValidationError{}
+var _ error = EnrollTotpReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2692,93 +2537,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserPasswordReqValidationError{}
+} = EnrollTotpReqValidationError{}
 
-var _SetUserPasswordReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _EnrollTotpReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on SetUserWechatReq with the rules defined
+// Validate checks the field values on EnrollTotpReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *SetUserWechatReq) Validate() error {
+func (m *EnrollTotpReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserWechatReq with the rules
+// ValidateAll checks the field values on EnrollTotpReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetUserWechatReqMultiError, or nil if none found.
-func (m *SetUserWechatReq) ValidateAll() error {
+// EnrollTotpReplyMultiError, or nil if none found.
+func (m *EnrollTotpReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserWechatReq) validate(all bool) error {
+func (m *EnrollTotpReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserWechatReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserWechatReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := SetUserWechatReqValidationError{
-			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetRedirectUrl() != "" {
-
-		if uri, err := url.Parse(m.GetRedirectUrl()); err != nil {
-			err = SetUserWechatReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be a valid URI",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := SetUserWechatReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be absolute",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Secret
 
-	}
+	// no validation rules for Uri
 
 	if len(errors) > 0 {
-		return SetUserWechatReqMultiError(errors)
+		return EnrollTotpReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserWechatReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserWechatReq.ValidateAll() if the designated constraints
+// EnrollTotpReplyMultiError is an error wrapping multiple validation errors
+// returned by EnrollTotpReply.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserWechatReqMultiError []error
+type EnrollTotpReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserWechatReqMultiError) Error() string {
+func (m EnrollTotpReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2787,11 +2589,11 @@ func (m SetUserWechatReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserWechatReqMultiError) AllErrors() []error { return m }
+func (m EnrollTotpReplyMultiError) AllErrors() []error { return m }
 
-// SetUserWechatReqValidationError is the validation error returned by
-// SetUserWechatReq.Validate if the designated constraints aren't met.
-type SetUserWechatReqValidationError struct {
+// EnrollTotpReplyValidationError is the validation error returned by
+// EnrollTotpReply.Validate if the designated constraints aren't met.
+type EnrollTotpReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2799,22 +2601,22 @@ type SetUserWechatReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserWechatReqValidationError) Field() string { return e.field }
+func (e EnrollTotpReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserWechatReqValidationError) Reason() string { return e.reason }
+func (e EnrollTotpReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserWechatReqValidationError) Cause() error { return e.cause }
+func (e EnrollTotpReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserWechatReqValidationError) Key() bool { return e.key }
+func (e EnrollTotpReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserWechatReqValidationError) ErrorName() string { return "SetUserWechatReqValidationError" }
+func (e EnrollTotpReplyValidationError) ErrorName() string { return "EnrollTotpReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserWechatReqValidationError) Error() string {
+func (e EnrollTotpReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2826,14 +2628,14 @@ func (e SetUserWechatReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserWechatReq.%s: %s%s",
+		"invalid %sEnrollTotpReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserWechatReqValidationError{}
+var _ error = EnrollTotpReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2841,34 +2643,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserWechatReqValidationError{}
-
-var _SetUserWechatReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = EnrollTotpReplyValidationError{}
 
-// Validate checks the field values on SetUserQQReq with the rules defined in
+// Validate checks the field values on ConfirmTotpReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SetUserQQReq) Validate() error {
+func (m *ConfirmTotpReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserQQReq with the rules defined
+// ValidateAll checks the field values on ConfirmTotpReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SetUserQQReqMultiError, or
-// nil if none found.
-func (m *SetUserQQReq) ValidateAll() error {
+// result is a list of violation errors wrapped in ConfirmTotpReqMultiError,
+// or nil if none found.
+func (m *ConfirmTotpReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserQQReq) validate(all bool) error {
+func (m *ConfirmTotpReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserQQReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserQQReqValidationError{
+	if !_ConfirmTotpReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ConfirmTotpReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -2878,10 +2678,10 @@ func (m *SetUserQQReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := SetUserQQReqValidationError{
+	if !_ConfirmTotpReq_Code_Pattern.MatchString(m.GetCode()) {
+		err := ConfirmTotpReqValidationError{
 			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
 		}
 		if !all {
 			return err
@@ -2889,44 +2689,20 @@ func (m *SetUserQQReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetRedirectUrl() != "" {
-
-		if uri, err := url.Parse(m.GetRedirectUrl()); err != nil {
-			err = SetUserQQReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be a valid URI",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := SetUserQQReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be absolute",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return SetUserQQReqMultiError(errors)
+		return ConfirmTotpReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserQQReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserQQReq.ValidateAll() if the designated constraints aren't met.
-type SetUserQQReqMultiError []error
+// ConfirmTotpReqMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTotpReq.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTotpReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserQQReqMultiError) Error() string {
+func (m ConfirmTotpReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2935,11 +2711,11 @@ func (m SetUserQQReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserQQReqMultiError) AllErrors() []error { return m }
+func (m ConfirmTotpReqMultiError) AllErrors() []error { return m }
 
-// SetUserQQReqValidationError is the validation error returned by
-// SetUserQQReq.Validate if the designated constraints aren't met.
-type SetUserQQReqValidationError struct {
+// ConfirmTotpReqValidationError is the validation error returned by
+// ConfirmTotpReq.Validate if the designated constraints aren't met.
+type ConfirmTotpReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2947,22 +2723,22 @@ type SetUserQQReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserQQReqValidationError) Field() string { return e.field }
+func (e ConfirmTotpReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserQQReqValidationError) Reason() string { return e.reason }
+func (e ConfirmTotpReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserQQReqValidationError) Cause() error { return e.cause }
+func (e ConfirmTotpReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserQQReqValidationError) Key() bool { return e.key }
+func (e ConfirmTotpReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserQQReqValidationError) ErrorName() string { return "SetUserQQReqValidationError" }
+func (e ConfirmTotpReqValidationError) ErrorName() string { return "ConfirmTotpReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserQQReqValidationError) Error() string {
+func (e ConfirmTotpReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2974,14 +2750,14 @@ func (e SetUserQQReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserQQReq.%s: %s%s",
+		"invalid %sConfirmTotpReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserQQReqValidationError{}
+var _ error = ConfirmTotpReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2989,93 +2765,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserQQReqValidationError{}
+} = ConfirmTotpReqValidationError{}
 
-var _SetUserQQReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _ConfirmTotpReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on SetUserGiteeReq with the rules defined
+var _ConfirmTotpReq_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on ConfirmTotpReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *SetUserGiteeReq) Validate() error {
+func (m *ConfirmTotpReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserGiteeReq with the rules
+// ValidateAll checks the field values on ConfirmTotpReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetUserGiteeReqMultiError, or nil if none found.
-func (m *SetUserGiteeReq) ValidateAll() error {
+// ConfirmTotpReplyMultiError, or nil if none found.
+func (m *ConfirmTotpReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserGiteeReq) validate(all bool) error {
+func (m *ConfirmTotpReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserGiteeReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserGiteeReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := SetUserGiteeReqValidationError{
-			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetRedirectUrl() != "" {
-
-		if uri, err := url.Parse(m.GetRedirectUrl()); err != nil {
-			err = SetUserGiteeReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be a valid URI",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := SetUserGiteeReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be absolute",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return SetUserGiteeReqMultiError(errors)
+		return ConfirmTotpReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserGiteeReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserGiteeReq.ValidateAll() if the designated constraints
+// ConfirmTotpReplyMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTotpReply.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserGiteeReqMultiError []error
+type ConfirmTotpReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserGiteeReqMultiError) Error() string {
+func (m ConfirmTotpReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3084,11 +2815,11 @@ func (m SetUserGiteeReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserGiteeReqMultiError) AllErrors() []error { return m }
+func (m ConfirmTotpReplyMultiError) AllErrors() []error { return m }
 
-// SetUserGiteeReqValidationError is the validation error returned by
-// SetUserGiteeReq.Validate if the designated constraints aren't met.
-type SetUserGiteeReqValidationError struct {
+// ConfirmTotpReplyValidationError is the validation error returned by
+// ConfirmTotpReply.Validate if the designated constraints aren't met.
+type ConfirmTotpReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3096,22 +2827,22 @@ type SetUserGiteeReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserGiteeReqValidationError) Field() string { return e.field }
+func (e ConfirmTotpReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserGiteeReqValidationError) Reason() string { return e.reason }
+func (e ConfirmTotpReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserGiteeReqValidationError) Cause() error { return e.cause }
+func (e ConfirmTotpReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserGiteeReqValidationError) Key() bool { return e.key }
+func (e ConfirmTotpReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserGiteeReqValidationError) ErrorName() string { return "SetUserGiteeReqValidationError" }
+func (e ConfirmTotpReplyValidationError) ErrorName() string { return "ConfirmTotpReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserGiteeReqValidationError) Error() string {
+func (e ConfirmTotpReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3123,14 +2854,14 @@ func (e SetUserGiteeReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserGiteeReq.%s: %s%s",
+		"invalid %sConfirmTotpReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserGiteeReqValidationError{}
+var _ error = ConfirmTotpReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3138,34 +2869,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserGiteeReqValidationError{}
+} = ConfirmTotpReplyValidationError{}
 
-var _SetUserGiteeReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
-
-// Validate checks the field values on SetUserGithubReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SetUserGithubReq) Validate() error {
+// Validate checks the field values on DisableTotpReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DisableTotpReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserGithubReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetUserGithubReqMultiError, or nil if none found.
-func (m *SetUserGithubReq) ValidateAll() error {
+// ValidateAll checks the field values on DisableTotpReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DisableTotpReqMultiError,
+// or nil if none found.
+func (m *DisableTotpReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserGithubReq) validate(all bool) error {
+func (m *DisableTotpReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserGithubReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserGithubReqValidationError{
+	if !_DisableTotpReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := DisableTotpReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -3175,10 +2904,10 @@ func (m *SetUserGithubReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-		err := SetUserGithubReqValidationError{
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 20 {
+		err := DisableTotpReqValidationError{
 			field:  "Code",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+			reason: "value length must be between 1 and 20 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -3186,45 +2915,20 @@ func (m *SetUserGithubReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetRedirectUrl() != "" {
-
-		if uri, err := url.Parse(m.GetRedirectUrl()); err != nil {
-			err = SetUserGithubReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be a valid URI",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := SetUserGithubReqValidationError{
-				field:  "RedirectUrl",
-				reason: "value must be absolute",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return SetUserGithubReqMultiError(errors)
+		return DisableTotpReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserGithubReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserGithubReq.ValidateAll() if the designated constraints
+// DisableTotpReqMultiError is an error wrapping multiple validation errors
+// returned by DisableTotpReq.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserGithubReqMultiError []error
+type DisableTotpReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserGithubReqMultiError) Error() string {
+func (m DisableTotpReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3233,11 +2937,11 @@ func (m SetUserGithubReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserGithubReqMultiError) AllErrors() []error { return m }
+func (m DisableTotpReqMultiError) AllErrors() []error { return m }
 
-// SetUserGithubReqValidationError is the validation error returned by
-// SetUserGithubReq.Validate if the designated constraints aren't met.
-type SetUserGithubReqValidationError struct {
+// DisableTotpReqValidationError is the validation error returned by
+// DisableTotpReq.Validate if the designated constraints aren't met.
+type DisableTotpReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3245,22 +2949,22 @@ type SetUserGithubReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserGithubReqValidationError) Field() string { return e.field }
+func (e DisableTotpReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserGithubReqValidationError) Reason() string { return e.reason }
+func (e DisableTotpReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserGithubReqValidationError) Cause() error { return e.cause }
+func (e DisableTotpReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserGithubReqValidationError) Key() bool { return e.key }
+func (e DisableTotpReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserGithubReqValidationError) ErrorName() string { return "SetUserGithubReqValidationError" }
+func (e DisableTotpReqValidationError) ErrorName() string { return "DisableTotpReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserGithubReqValidationError) Error() string {
+func (e DisableTotpReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3272,14 +2976,14 @@ func (e SetUserGithubReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserGithubReq.%s: %s%s",
+		"invalid %sDisableTotpReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserGithubReqValidationError{}
+var _ error = DisableTotpReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3287,36 +2991,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserGithubReqValidationError{}
+} = DisableTotpReqValidationError{}
 
-var _SetUserGithubReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _DisableTotpReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on SetUserFollowReq with the rules defined
+// Validate checks the field values on SendPhoneCodeReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *SetUserFollowReq) Validate() error {
+func (m *SendPhoneCodeReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetUserFollowReq with the rules
+// ValidateAll checks the field values on SendPhoneCodeReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetUserFollowReqMultiError, or nil if none found.
-func (m *SetUserFollowReq) ValidateAll() error {
+// SendPhoneCodeReqMultiError, or nil if none found.
+func (m *SendPhoneCodeReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetUserFollowReq) validate(all bool) error {
+func (m *SendPhoneCodeReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetUserFollowReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetUserFollowReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+	if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 50 {
+		err := SendPhoneCodeReqValidationError{
+			field:  "Phone",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -3324,10 +3028,32 @@ func (m *SetUserFollowReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_SetUserFollowReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
-		err := SetUserFollowReqValidationError{
-			field:  "UserUuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+	if !_SendPhoneCodeReq_Phone_Pattern.MatchString(m.GetPhone()) {
+		err := SendPhoneCodeReqValidationError{
+			field:  "Phone",
+			reason: "value does not match regex pattern \"^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\\\d{8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SendPhoneCodeReq_Template_InLookup[m.GetTemplate()]; !ok {
+		err := SendPhoneCodeReqValidationError{
+			field:  "Template",
+			reason: "value must be in list [1 2 3 4]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCaptcha()) > 200 {
+		err := SendPhoneCodeReqValidationError{
+			field:  "Captcha",
+			reason: "value length must be at most 200 runes",
 		}
 		if !all {
 			return err
@@ -3336,19 +3062,19 @@ func (m *SetUserFollowReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SetUserFollowReqMultiError(errors)
+		return SendPhoneCodeReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetUserFollowReqMultiError is an error wrapping multiple validation errors
-// returned by SetUserFollowReq.ValidateAll() if the designated constraints
+// SendPhoneCodeReqMultiError is an error wrapping multiple validation errors
+// returned by SendPhoneCodeReq.ValidateAll() if the designated constraints
 // aren't met.
-type SetUserFollowReqMultiError []error
+type SendPhoneCodeReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetUserFollowReqMultiError) Error() string {
+func (m SendPhoneCodeReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3357,11 +3083,11 @@ func (m SetUserFollowReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetUserFollowReqMultiError) AllErrors() []error { return m }
+func (m SendPhoneCodeReqMultiError) AllErrors() []error { return m }
 
-// SetUserFollowReqValidationError is the validation error returned by
-// SetUserFollowReq.Validate if the designated constraints aren't met.
-type SetUserFollowReqValidationError struct {
+// SendPhoneCodeReqValidationError is the validation error returned by
+// SendPhoneCodeReq.Validate if the designated constraints aren't met.
+type SendPhoneCodeReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3369,22 +3095,22 @@ type SetUserFollowReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetUserFollowReqValidationError) Field() string { return e.field }
+func (e SendPhoneCodeReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetUserFollowReqValidationError) Reason() string { return e.reason }
+func (e SendPhoneCodeReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetUserFollowReqValidationError) Cause() error { return e.cause }
+func (e SendPhoneCodeReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetUserFollowReqValidationError) Key() bool { return e.key }
+func (e SendPhoneCodeReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetUserFollowReqValidationError) ErrorName() string { return "SetUserFollowReqValidationError" }
+func (e SendPhoneCodeReqValidationError) ErrorName() string { return "SendPhoneCodeReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetUserFollowReqValidationError) Error() string {
+func (e SendPhoneCodeReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3396,14 +3122,14 @@ func (e SetUserFollowReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetUserFollowReq.%s: %s%s",
+		"invalid %sSendPhoneCodeReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetUserFollowReqValidationError{}
+var _ error = SendPhoneCodeReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3411,38 +3137,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetUserFollowReqValidationError{}
+} = SendPhoneCodeReqValidationError{}
 
-var _SetUserFollowReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SendPhoneCodeReq_Phone_Pattern = regexp.MustCompile("^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\d{8}$")
 
-var _SetUserFollowReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SendPhoneCodeReq_Template_InLookup = map[string]struct{}{
+	"1": {},
+	"2": {},
+	"3": {},
+	"4": {},
+}
 
-// Validate checks the field values on SetFollowDbAndCacheReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetFollowDbAndCacheReq) Validate() error {
+// Validate checks the field values on SendEmailCodeReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendEmailCodeReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetFollowDbAndCacheReq with the rules
+// ValidateAll checks the field values on SendEmailCodeReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetFollowDbAndCacheReqMultiError, or nil if none found.
-func (m *SetFollowDbAndCacheReq) ValidateAll() error {
+// SendEmailCodeReqMultiError, or nil if none found.
+func (m *SendEmailCodeReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetFollowDbAndCacheReq) validate(all bool) error {
+func (m *SendEmailCodeReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetFollowDbAndCacheReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetFollowDbAndCacheReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+	if utf8.RuneCountInString(m.GetEmail()) > 50 {
+		err := SendEmailCodeReqValidationError{
+			field:  "Email",
+			reason: "value length must be at most 50 runes",
 		}
 		if !all {
 			return err
@@ -3450,10 +3181,32 @@ func (m *SetFollowDbAndCacheReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_SetFollowDbAndCacheReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
-		err := SetFollowDbAndCacheReqValidationError{
-			field:  "UserUuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+	if !_SendEmailCodeReq_Email_Pattern.MatchString(m.GetEmail()) {
+		err := SendEmailCodeReqValidationError{
+			field:  "Email",
+			reason: "value does not match regex pattern \"\\\\w+([-+.]\\\\w+)*@\\\\w+([-.]\\\\w+)*\\\\.\\\\w+([-.]\\\\w+)*\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SendEmailCodeReq_Template_InLookup[m.GetTemplate()]; !ok {
+		err := SendEmailCodeReqValidationError{
+			field:  "Template",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCaptcha()) > 200 {
+		err := SendEmailCodeReqValidationError{
+			field:  "Captcha",
+			reason: "value length must be at most 200 runes",
 		}
 		if !all {
 			return err
@@ -3462,19 +3215,19 @@ func (m *SetFollowDbAndCacheReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SetFollowDbAndCacheReqMultiError(errors)
+		return SendEmailCodeReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetFollowDbAndCacheReqMultiError is an error wrapping multiple validation
-// errors returned by SetFollowDbAndCacheReq.ValidateAll() if the designated
-// constraints aren't met.
-type SetFollowDbAndCacheReqMultiError []error
+// SendEmailCodeReqMultiError is an error wrapping multiple validation errors
+// returned by SendEmailCodeReq.ValidateAll() if the designated constraints
+// aren't met.
+type SendEmailCodeReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetFollowDbAndCacheReqMultiError) Error() string {
+func (m SendEmailCodeReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3483,11 +3236,11 @@ func (m SetFollowDbAndCacheReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetFollowDbAndCacheReqMultiError) AllErrors() []error { return m }
+func (m SendEmailCodeReqMultiError) AllErrors() []error { return m }
 
-// SetFollowDbAndCacheReqValidationError is the validation error returned by
-// SetFollowDbAndCacheReq.Validate if the designated constraints aren't met.
-type SetFollowDbAndCacheReqValidationError struct {
+// SendEmailCodeReqValidationError is the validation error returned by
+// SendEmailCodeReq.Validate if the designated constraints aren't met.
+type SendEmailCodeReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3495,24 +3248,22 @@ type SetFollowDbAndCacheReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetFollowDbAndCacheReqValidationError) Field() string { return e.field }
+func (e SendEmailCodeReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetFollowDbAndCacheReqValidationError) Reason() string { return e.reason }
+func (e SendEmailCodeReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetFollowDbAndCacheReqValidationError) Cause() error { return e.cause }
+func (e SendEmailCodeReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetFollowDbAndCacheReqValidationError) Key() bool { return e.key }
+func (e SendEmailCodeReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetFollowDbAndCacheReqValidationError) ErrorName() string {
-	return "SetFollowDbAndCacheReqValidationError"
-}
+func (e SendEmailCodeReqValidationError) ErrorName() string { return "SendEmailCodeReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetFollowDbAndCacheReqValidationError) Error() string {
+func (e SendEmailCodeReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3524,14 +3275,14 @@ func (e SetFollowDbAndCacheReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetFollowDbAndCacheReq.%s: %s%s",
+		"invalid %sSendEmailCodeReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetFollowDbAndCacheReqValidationError{}
+var _ error = SendEmailCodeReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3539,36 +3290,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetFollowDbAndCacheReqValidationError{}
+} = SendEmailCodeReqValidationError{}
 
-var _SetFollowDbAndCacheReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SendEmailCodeReq_Email_Pattern = regexp.MustCompile("\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*")
 
-var _SetFollowDbAndCacheReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SendEmailCodeReq_Template_InLookup = map[string]struct{}{
+	"1": {},
+	"2": {},
+	"3": {},
+	"4": {},
+	"5": {},
+}
 
-// Validate checks the field values on CancelUserFollowReq with the rules
+// Validate checks the field values on GetCosSessionKeyReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelUserFollowReq) Validate() error {
+func (m *GetCosSessionKeyReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelUserFollowReq with the rules
+// ValidateAll checks the field values on GetCosSessionKeyReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelUserFollowReqMultiError, or nil if none found.
-func (m *CancelUserFollowReq) ValidateAll() error {
+// GetCosSessionKeyReqMultiError, or nil if none found.
+func (m *GetCosSessionKeyReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelUserFollowReq) validate(all bool) error {
+func (m *GetCosSessionKeyReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CancelUserFollowReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := CancelUserFollowReqValidationError{
+	if !_GetCosSessionKeyReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetCosSessionKeyReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -3578,31 +3335,20 @@ func (m *CancelUserFollowReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CancelUserFollowReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
-		err := CancelUserFollowReqValidationError{
-			field:  "UserUuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return CancelUserFollowReqMultiError(errors)
+		return GetCosSessionKeyReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelUserFollowReqMultiError is an error wrapping multiple validation
-// errors returned by CancelUserFollowReq.ValidateAll() if the designated
+// GetCosSessionKeyReqMultiError is an error wrapping multiple validation
+// errors returned by GetCosSessionKeyReq.ValidateAll() if the designated
 // constraints aren't met.
-type CancelUserFollowReqMultiError []error
+type GetCosSessionKeyReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelUserFollowReqMultiError) Error() string {
+func (m GetCosSessionKeyReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3611,11 +3357,11 @@ func (m CancelUserFollowReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelUserFollowReqMultiError) AllErrors() []error { return m }
+func (m GetCosSessionKeyReqMultiError) AllErrors() []error { return m }
 
-// CancelUserFollowReqValidationError is the validation error returned by
-// CancelUserFollowReq.Validate if the designated constraints aren't met.
-type CancelUserFollowReqValidationError struct {
+// GetCosSessionKeyReqValidationError is the validation error returned by
+// GetCosSessionKeyReq.Validate if the designated constraints aren't met.
+type GetCosSessionKeyReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3623,24 +3369,24 @@ type CancelUserFollowReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelUserFollowReqValidationError) Field() string { return e.field }
+func (e GetCosSessionKeyReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelUserFollowReqValidationError) Reason() string { return e.reason }
+func (e GetCosSessionKeyReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelUserFollowReqValidationError) Cause() error { return e.cause }
+func (e GetCosSessionKeyReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelUserFollowReqValidationError) Key() bool { return e.key }
+func (e GetCosSessionKeyReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelUserFollowReqValidationError) ErrorName() string {
-	return "CancelUserFollowReqValidationError"
+func (e GetCosSessionKeyReqValidationError) ErrorName() string {
+	return "GetCosSessionKeyReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelUserFollowReqValidationError) Error() string {
+func (e GetCosSessionKeyReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3652,14 +3398,14 @@ func (e CancelUserFollowReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelUserFollowReq.%s: %s%s",
+		"invalid %sGetCosSessionKeyReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelUserFollowReqValidationError{}
+var _ error = GetCosSessionKeyReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3667,70 +3413,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelUserFollowReqValidationError{}
-
-var _CancelUserFollowReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = GetCosSessionKeyReqValidationError{}
 
-var _CancelUserFollowReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _GetCosSessionKeyReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on CancelFollowDbAndCacheReq with the rules
+// Validate checks the field values on GetCosSessionKeyReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelFollowDbAndCacheReq) Validate() error {
+func (m *GetCosSessionKeyReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelFollowDbAndCacheReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCosSessionKeyReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelFollowDbAndCacheReqMultiError, or nil if none found.
-func (m *CancelFollowDbAndCacheReq) ValidateAll() error {
+// GetCosSessionKeyReplyMultiError, or nil if none found.
+func (m *GetCosSessionKeyReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelFollowDbAndCacheReq) validate(all bool) error {
+func (m *GetCosSessionKeyReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CancelFollowDbAndCacheReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := CancelFollowDbAndCacheReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for TmpSecretId
 
-	if !_CancelFollowDbAndCacheReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
-		err := CancelFollowDbAndCacheReqValidationError{
-			field:  "UserUuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for TmpSecretKey
+
+	// no validation rules for SessionToken
+
+	// no validation rules for StartTime
+
+	// no validation rules for ExpiredTime
 
 	if len(errors) > 0 {
-		return CancelFollowDbAndCacheReqMultiError(errors)
+		return GetCosSessionKeyReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelFollowDbAndCacheReqMultiError is an error wrapping multiple validation
-// errors returned by CancelFollowDbAndCacheReq.ValidateAll() if the
-// designated constraints aren't met.
-type CancelFollowDbAndCacheReqMultiError []error
+// GetCosSessionKeyReplyMultiError is an error wrapping multiple validation
+// errors returned by GetCosSessionKeyReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetCosSessionKeyReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelFollowDbAndCacheReqMultiError) Error() string {
+func (m GetCosSessionKeyReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3739,11 +3471,11 @@ func (m CancelFollowDbAndCacheReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelFollowDbAndCacheReqMultiError) AllErrors() []error { return m }
+func (m GetCosSessionKeyReplyMultiError) AllErrors() []error { return m }
 
-// CancelFollowDbAndCacheReqValidationError is the validation error returned by
-// CancelFollowDbAndCacheReq.Validate if the designated constraints aren't met.
-type CancelFollowDbAndCacheReqValidationError struct {
+// GetCosSessionKeyReplyValidationError is the validation error returned by
+// GetCosSessionKeyReply.Validate if the designated constraints aren't met.
+type GetCosSessionKeyReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3751,24 +3483,24 @@ type CancelFollowDbAndCacheReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelFollowDbAndCacheReqValidationError) Field() string { return e.field }
+func (e GetCosSessionKeyReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelFollowDbAndCacheReqValidationError) Reason() string { return e.reason }
+func (e GetCosSessionKeyReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelFollowDbAndCacheReqValidationError) Cause() error { return e.cause }
+func (e GetCosSessionKeyReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelFollowDbAndCacheReqValidationError) Key() bool { return e.key }
+func (e GetCosSessionKeyReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelFollowDbAndCacheReqValidationError) ErrorName() string {
-	return "CancelFollowDbAndCacheReqValidationError"
+func (e GetCosSessionKeyReplyValidationError) ErrorName() string {
+	return "GetCosSessionKeyReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelFollowDbAndCacheReqValidationError) Error() string {
+func (e GetCosSessionKeyReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3780,14 +3512,14 @@ func (e CancelFollowDbAndCacheReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelFollowDbAndCacheReq.%s: %s%s",
+		"invalid %sGetCosSessionKeyReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelFollowDbAndCacheReqValidationError{}
+var _ error = GetCosSessionKeyReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3795,36 +3527,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelFollowDbAndCacheReqValidationError{}
-
-var _CancelFollowDbAndCacheReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
-
-var _CancelFollowDbAndCacheReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = GetCosSessionKeyReplyValidationError{}
 
-// Validate checks the field values on ChangeUserPasswordReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeUserPasswordReq) Validate() error {
+// Validate checks the field values on SetUserPhoneReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetUserPhoneReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeUserPasswordReq with the rules
+// ValidateAll checks the field values on SetUserPhoneReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChangeUserPasswordReqMultiError, or nil if none found.
-func (m *ChangeUserPasswordReq) ValidateAll() error {
+// SetUserPhoneReqMultiError, or nil if none found.
+func (m *SetUserPhoneReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeUserPasswordReq) validate(all bool) error {
+func (m *SetUserPhoneReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ChangeUserPasswordReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := ChangeUserPasswordReqValidationError{
+	if !_SetUserPhoneReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserPhoneReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -3834,10 +3562,10 @@ func (m *ChangeUserPasswordReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetOldpassword()); l < 1 || l > 20 {
-		err := ChangeUserPasswordReqValidationError{
-			field:  "Oldpassword",
-			reason: "value length must be between 1 and 20 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 50 {
+		err := SetUserPhoneReqValidationError{
+			field:  "Phone",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -3845,10 +3573,21 @@ func (m *ChangeUserPasswordReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 20 {
-		err := ChangeUserPasswordReqValidationError{
-			field:  "Password",
-			reason: "value length must be between 1 and 20 runes, inclusive",
+	if !_SetUserPhoneReq_Phone_Pattern.MatchString(m.GetPhone()) {
+		err := SetUserPhoneReqValidationError{
+			field:  "Phone",
+			reason: "value does not match regex pattern \"^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\\\d{8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SetUserPhoneReq_Code_Pattern.MatchString(m.GetCode()) {
+		err := SetUserPhoneReqValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
 		}
 		if !all {
 			return err
@@ -3857,19 +3596,19 @@ func (m *ChangeUserPasswordReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChangeUserPasswordReqMultiError(errors)
+		return SetUserPhoneReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeUserPasswordReqMultiError is an error wrapping multiple validation
-// errors returned by ChangeUserPasswordReq.ValidateAll() if the designated
-// constraints aren't met.
-type ChangeUserPasswordReqMultiError []error
+// SetUserPhoneReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserPhoneReq.ValidateAll() if the designated constraints
+// aren't met.
+type SetUserPhoneReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeUserPasswordReqMultiError) Error() string {
+func (m SetUserPhoneReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3878,11 +3617,11 @@ func (m ChangeUserPasswordReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeUserPasswordReqMultiError) AllErrors() []error { return m }
+func (m SetUserPhoneReqMultiError) AllErrors() []error { return m }
 
-// ChangeUserPasswordReqValidationError is the validation error returned by
-// ChangeUserPasswordReq.Validate if the designated constraints aren't met.
-type ChangeUserPasswordReqValidationError struct {
+// SetUserPhoneReqValidationError is the validation error returned by
+// SetUserPhoneReq.Validate if the designated constraints aren't met.
+type SetUserPhoneReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3890,24 +3629,22 @@ type ChangeUserPasswordReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeUserPasswordReqValidationError) Field() string { return e.field }
+func (e SetUserPhoneReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeUserPasswordReqValidationError) Reason() string { return e.reason }
+func (e SetUserPhoneReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeUserPasswordReqValidationError) Cause() error { return e.cause }
+func (e SetUserPhoneReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeUserPasswordReqValidationError) Key() bool { return e.key }
+func (e SetUserPhoneReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeUserPasswordReqValidationError) ErrorName() string {
-	return "ChangeUserPasswordReqValidationError"
-}
+func (e SetUserPhoneReqValidationError) ErrorName() string { return "SetUserPhoneReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ChangeUserPasswordReqValidationError) Error() string {
+func (e SetUserPhoneReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3919,14 +3656,14 @@ func (e ChangeUserPasswordReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeUserPasswordReq.%s: %s%s",
+		"invalid %sSetUserPhoneReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ChangeUserPasswordReqValidationError{}
+var _ error = SetUserPhoneReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3934,34 +3671,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeUserPasswordReqValidationError{}
+} = SetUserPhoneReqValidationError{}
 
-var _ChangeUserPasswordReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SetUserPhoneReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on UnbindUserAccountReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnbindUserAccountReq) Validate() error {
+var _SetUserPhoneReq_Phone_Pattern = regexp.MustCompile("^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\d{8}$")
+
+var _SetUserPhoneReq_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on SetUserEmailReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetUserEmailReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnbindUserAccountReq with the rules
+// ValidateAll checks the field values on SetUserEmailReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnbindUserAccountReqMultiError, or nil if none found.
-func (m *UnbindUserAccountReq) ValidateAll() error {
+// SetUserEmailReqMultiError, or nil if none found.
+func (m *SetUserEmailReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnbindUserAccountReq) validate(all bool) error {
+func (m *SetUserEmailReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_UnbindUserAccountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := UnbindUserAccountReqValidationError{
+	if !_SetUserEmailReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserEmailReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -3971,206 +3712,89 @@ func (m *UnbindUserAccountReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPhone() != "" {
-
-		if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 50 {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Phone",
-				reason: "value length must be between 1 and 50 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetEmail()) > 50 {
+		err := SetUserEmailReqValidationError{
+			field:  "Email",
+			reason: "value length must be at most 50 runes",
 		}
-
-		if !_UnbindUserAccountReq_Phone_Pattern.MatchString(m.GetPhone()) {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Phone",
-				reason: "value does not match regex pattern \"^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\\\d{8}$\"",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
-	if m.GetEmail() != "" {
-
-		if utf8.RuneCountInString(m.GetEmail()) > 50 {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Email",
-				reason: "value length must be at most 50 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if !_SetUserEmailReq_Email_Pattern.MatchString(m.GetEmail()) {
+		err := SetUserEmailReqValidationError{
+			field:  "Email",
+			reason: "value does not match regex pattern \"\\\\w+([-+.]\\\\w+)*@\\\\w+([-.]\\\\w+)*\\\\.\\\\w+([-.]\\\\w+)*\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if !_UnbindUserAccountReq_Email_Pattern.MatchString(m.GetEmail()) {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Email",
-				reason: "value does not match regex pattern \"\\\\w+([-+.]\\\\w+)*@\\\\w+([-.]\\\\w+)*\\\\.\\\\w+([-.]\\\\w+)*\"",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if !_SetUserEmailReq_Code_Pattern.MatchString(m.GetCode()) {
+		err := SetUserEmailReqValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if len(errors) > 0 {
+		return SetUserEmailReqMultiError(errors)
 	}
 
-	if m.GetAccount() != "" {
+	return nil
+}
 
-		if l := utf8.RuneCountInString(m.GetAccount()); l < 1 || l > 50 {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Account",
-				reason: "value length must be between 1 and 50 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// SetUserEmailReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserEmailReq.ValidateAll() if the designated constraints
+// aren't met.
+type SetUserEmailReqMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUserEmailReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.GetPassword() != "" {
+// AllErrors returns a list of validation violation errors.
+func (m SetUserEmailReqMultiError) AllErrors() []error { return m }
 
-		if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 20 {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Password",
-				reason: "value length must be between 1 and 20 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// SetUserEmailReqValidationError is the validation error returned by
+// SetUserEmailReq.Validate if the designated constraints aren't met.
+type SetUserEmailReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	}
+// Field function returns field value.
+func (e SetUserEmailReqValidationError) Field() string { return e.field }
 
-	if m.GetCode() != "" {
+// Reason function returns reason value.
+func (e SetUserEmailReqValidationError) Reason() string { return e.reason }
 
-		if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 100 {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Code",
-				reason: "value length must be between 1 and 100 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Cause function returns cause value.
+func (e SetUserEmailReqValidationError) Cause() error { return e.cause }
 
-	}
-
-	if _, ok := _UnbindUserAccountReq_Choose_InLookup[m.GetChoose()]; !ok {
-		err := UnbindUserAccountReqValidationError{
-			field:  "Choose",
-			reason: "value must be in list [phone email password wechat qq gitee github]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMode() != "" {
-
-		if _, ok := _UnbindUserAccountReq_Mode_InLookup[m.GetMode()]; !ok {
-			err := UnbindUserAccountReqValidationError{
-				field:  "Mode",
-				reason: "value must be in list [phone email]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetRedirectUri() != "" {
-
-		if uri, err := url.Parse(m.GetRedirectUri()); err != nil {
-			err = UnbindUserAccountReqValidationError{
-				field:  "RedirectUri",
-				reason: "value must be a valid URI",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := UnbindUserAccountReqValidationError{
-				field:  "RedirectUri",
-				reason: "value must be absolute",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return UnbindUserAccountReqMultiError(errors)
-	}
-
-	return nil
-}
-
-// UnbindUserAccountReqMultiError is an error wrapping multiple validation
-// errors returned by UnbindUserAccountReq.ValidateAll() if the designated
-// constraints aren't met.
-type UnbindUserAccountReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UnbindUserAccountReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m UnbindUserAccountReqMultiError) AllErrors() []error { return m }
-
-// UnbindUserAccountReqValidationError is the validation error returned by
-// UnbindUserAccountReq.Validate if the designated constraints aren't met.
-type UnbindUserAccountReqValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e UnbindUserAccountReqValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e UnbindUserAccountReqValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e UnbindUserAccountReqValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e UnbindUserAccountReqValidationError) Key() bool { return e.key }
+// Key function returns key value.
+func (e SetUserEmailReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnbindUserAccountReqValidationError) ErrorName() string {
-	return "UnbindUserAccountReqValidationError"
-}
+func (e SetUserEmailReqValidationError) ErrorName() string { return "SetUserEmailReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UnbindUserAccountReqValidationError) Error() string {
+func (e SetUserEmailReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4182,14 +3806,14 @@ func (e UnbindUserAccountReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnbindUserAccountReq.%s: %s%s",
+		"invalid %sSetUserEmailReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnbindUserAccountReqValidationError{}
+var _ error = SetUserEmailReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4197,53 +3821,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnbindUserAccountReqValidationError{}
-
-var _UnbindUserAccountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
-
-var _UnbindUserAccountReq_Phone_Pattern = regexp.MustCompile("^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\d{8}$")
+} = SetUserEmailReqValidationError{}
 
-var _UnbindUserAccountReq_Email_Pattern = regexp.MustCompile("\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*")
+var _SetUserEmailReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-var _UnbindUserAccountReq_Choose_InLookup = map[string]struct{}{
-	"phone":    {},
-	"email":    {},
-	"password": {},
-	"wechat":   {},
-	"qq":       {},
-	"gitee":    {},
-	"github":   {},
-}
+var _SetUserEmailReq_Email_Pattern = regexp.MustCompile("\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*")
 
-var _UnbindUserAccountReq_Mode_InLookup = map[string]struct{}{
-	"phone": {},
-	"email": {},
-}
+var _SetUserEmailReq_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
 
-// Validate checks the field values on GetAccountReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetAccountReq) Validate() error {
+// Validate checks the field values on SetUserPasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUserPasswordReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAccountReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetAccountReqMultiError, or
-// nil if none found.
-func (m *GetAccountReq) ValidateAll() error {
+// ValidateAll checks the field values on SetUserPasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetUserPasswordReqMultiError, or nil if none found.
+func (m *SetUserPasswordReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAccountReq) validate(all bool) error {
+func (m *SetUserPasswordReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetAccountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetAccountReqValidationError{
+	if !_SetUserPasswordReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserPasswordReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -4253,20 +3862,31 @@ func (m *GetAccountReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
+		err := SetUserPasswordReqValidationError{
+			field:  "Password",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetAccountReqMultiError(errors)
+		return SetUserPasswordReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAccountReqMultiError is an error wrapping multiple validation errors
-// returned by GetAccountReq.ValidateAll() if the designated constraints
+// SetUserPasswordReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserPasswordReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetAccountReqMultiError []error
+type SetUserPasswordReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAccountReqMultiError) Error() string {
+func (m SetUserPasswordReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4275,11 +3895,11 @@ func (m GetAccountReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAccountReqMultiError) AllErrors() []error { return m }
+func (m SetUserPasswordReqMultiError) AllErrors() []error { return m }
 
-// GetAccountReqValidationError is the validation error returned by
-// GetAccountReq.Validate if the designated constraints aren't met.
-type GetAccountReqValidationError struct {
+// SetUserPasswordReqValidationError is the validation error returned by
+// SetUserPasswordReq.Validate if the designated constraints aren't met.
+type SetUserPasswordReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4287,22 +3907,24 @@ type GetAccountReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAccountReqValidationError) Field() string { return e.field }
+func (e SetUserPasswordReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAccountReqValidationError) Reason() string { return e.reason }
+func (e SetUserPasswordReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAccountReqValidationError) Cause() error { return e.cause }
+func (e SetUserPasswordReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAccountReqValidationError) Key() bool { return e.key }
+func (e SetUserPasswordReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAccountReqValidationError) ErrorName() string { return "GetAccountReqValidationError" }
+func (e SetUserPasswordReqValidationError) ErrorName() string {
+	return "SetUserPasswordReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetAccountReqValidationError) Error() string {
+func (e SetUserPasswordReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4314,14 +3936,14 @@ func (e GetAccountReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAccountReq.%s: %s%s",
+		"invalid %sSetUserPasswordReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAccountReqValidationError{}
+var _ error = SetUserPasswordReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4329,60 +3951,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAccountReqValidationError{}
+} = SetUserPasswordReqValidationError{}
 
-var _GetAccountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SetUserPasswordReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetAccountReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetAccountReply) Validate() error {
+// Validate checks the field values on SetUserIdentityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUserIdentityReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAccountReply with the rules
+// ValidateAll checks the field values on SetUserIdentityReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAccountReplyMultiError, or nil if none found.
-func (m *GetAccountReply) ValidateAll() error {
+// SetUserIdentityReqMultiError, or nil if none found.
+func (m *SetUserIdentityReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAccountReply) validate(all bool) error {
+func (m *SetUserIdentityReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Phone
-
-	// no validation rules for Email
-
-	// no validation rules for Qq
-
-	// no validation rules for Wechat
+	if !_SetUserIdentityReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserIdentityReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Gitee
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 1 || l > 20 {
+		err := SetUserIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value length must be between 1 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Github
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 500 {
+		err := SetUserIdentityReqValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Password
+	if l := utf8.RuneCountInString(m.GetState()); l < 1 || l > 100 {
+		err := SetUserIdentityReqValidationError{
+			field:  "State",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetAccountReplyMultiError(errors)
+		return SetUserIdentityReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAccountReplyMultiError is an error wrapping multiple validation errors
-// returned by GetAccountReply.ValidateAll() if the designated constraints
+// SetUserIdentityReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserIdentityReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetAccountReplyMultiError []error
+type SetUserIdentityReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAccountReplyMultiError) Error() string {
+func (m SetUserIdentityReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4391,11 +4043,11 @@ func (m GetAccountReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAccountReplyMultiError) AllErrors() []error { return m }
+func (m SetUserIdentityReqMultiError) AllErrors() []error { return m }
 
-// GetAccountReplyValidationError is the validation error returned by
-// GetAccountReply.Validate if the designated constraints aren't met.
-type GetAccountReplyValidationError struct {
+// SetUserIdentityReqValidationError is the validation error returned by
+// SetUserIdentityReq.Validate if the designated constraints aren't met.
+type SetUserIdentityReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4403,22 +4055,24 @@ type GetAccountReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAccountReplyValidationError) Field() string { return e.field }
+func (e SetUserIdentityReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAccountReplyValidationError) Reason() string { return e.reason }
+func (e SetUserIdentityReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAccountReplyValidationError) Cause() error { return e.cause }
+func (e SetUserIdentityReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAccountReplyValidationError) Key() bool { return e.key }
+func (e SetUserIdentityReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAccountReplyValidationError) ErrorName() string { return "GetAccountReplyValidationError" }
+func (e SetUserIdentityReqValidationError) ErrorName() string {
+	return "SetUserIdentityReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetAccountReplyValidationError) Error() string {
+func (e SetUserIdentityReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4430,14 +4084,14 @@ func (e GetAccountReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAccountReply.%s: %s%s",
+		"invalid %sSetUserIdentityReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAccountReplyValidationError{}
+var _ error = SetUserIdentityReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4445,32 +4099,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAccountReplyValidationError{}
+} = SetUserIdentityReqValidationError{}
 
-// Validate checks the field values on GetProfileReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetProfileReq) Validate() error {
+var _SetUserIdentityReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on SetUserFollowReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetUserFollowReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetProfileReqMultiError, or
-// nil if none found.
-func (m *GetProfileReq) ValidateAll() error {
+// ValidateAll checks the field values on SetUserFollowReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetUserFollowReqMultiError, or nil if none found.
+func (m *SetUserFollowReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileReq) validate(all bool) error {
+func (m *SetUserFollowReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetProfileReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetProfileReqValidationError{
+	if !_SetUserFollowReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserFollowReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -4480,20 +4136,31 @@ func (m *GetProfileReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if !_SetUserFollowReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := SetUserFollowReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetProfileReqMultiError(errors)
+		return SetUserFollowReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileReqMultiError is an error wrapping multiple validation errors
-// returned by GetProfileReq.ValidateAll() if the designated constraints
+// SetUserFollowReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserFollowReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetProfileReqMultiError []error
+type SetUserFollowReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileReqMultiError) Error() string {
+func (m SetUserFollowReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4502,11 +4169,11 @@ func (m GetProfileReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileReqMultiError) AllErrors() []error { return m }
+func (m SetUserFollowReqMultiError) AllErrors() []error { return m }
 
-// GetProfileReqValidationError is the validation error returned by
-// GetProfileReq.Validate if the designated constraints aren't met.
-type GetProfileReqValidationError struct {
+// SetUserFollowReqValidationError is the validation error returned by
+// SetUserFollowReq.Validate if the designated constraints aren't met.
+type SetUserFollowReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4514,22 +4181,22 @@ type GetProfileReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileReqValidationError) Field() string { return e.field }
+func (e SetUserFollowReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileReqValidationError) Reason() string { return e.reason }
+func (e SetUserFollowReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileReqValidationError) Cause() error { return e.cause }
+func (e SetUserFollowReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileReqValidationError) Key() bool { return e.key }
+func (e SetUserFollowReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileReqValidationError) ErrorName() string { return "GetProfileReqValidationError" }
+func (e SetUserFollowReqValidationError) ErrorName() string { return "SetUserFollowReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetProfileReqValidationError) Error() string {
+func (e SetUserFollowReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4541,14 +4208,14 @@ func (e GetProfileReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileReq.%s: %s%s",
+		"invalid %sSetUserFollowReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileReqValidationError{}
+var _ error = SetUserFollowReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4556,68 +4223,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileReqValidationError{}
+} = SetUserFollowReqValidationError{}
 
-var _GetProfileReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SetUserFollowReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetProfileReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetProfileReply) Validate() error {
+var _SetUserFollowReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on SetFollowDbAndCacheReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFollowDbAndCacheReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileReply with the rules
+// ValidateAll checks the field values on SetFollowDbAndCacheReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProfileReplyMultiError, or nil if none found.
-func (m *GetProfileReply) ValidateAll() error {
+// SetFollowDbAndCacheReqMultiError, or nil if none found.
+func (m *SetFollowDbAndCacheReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileReply) validate(all bool) error {
+func (m *SetFollowDbAndCacheReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Uuid
-
-	// no validation rules for Username
-
-	// no validation rules for Avatar
-
-	// no validation rules for School
-
-	// no validation rules for Company
-
-	// no validation rules for Job
-
-	// no validation rules for Homepage
-
-	// no validation rules for Introduce
-
-	// no validation rules for Created
-
-	// no validation rules for Github
+	if !_SetFollowDbAndCacheReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetFollowDbAndCacheReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Gitee
+	if !_SetFollowDbAndCacheReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := SetFollowDbAndCacheReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetProfileReplyMultiError(errors)
+		return SetFollowDbAndCacheReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileReplyMultiError is an error wrapping multiple validation errors
-// returned by GetProfileReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetProfileReplyMultiError []error
+// SetFollowDbAndCacheReqMultiError is an error wrapping multiple validation
+// errors returned by SetFollowDbAndCacheReq.ValidateAll() if the designated
+// constraints aren't met.
+type SetFollowDbAndCacheReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileReplyMultiError) Error() string {
+func (m SetFollowDbAndCacheReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4626,11 +4295,11 @@ func (m GetProfileReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileReplyMultiError) AllErrors() []error { return m }
+func (m SetFollowDbAndCacheReqMultiError) AllErrors() []error { return m }
 
-// GetProfileReplyValidationError is the validation error returned by
-// GetProfileReply.Validate if the designated constraints aren't met.
-type GetProfileReplyValidationError struct {
+// SetFollowDbAndCacheReqValidationError is the validation error returned by
+// SetFollowDbAndCacheReq.Validate if the designated constraints aren't met.
+type SetFollowDbAndCacheReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4638,22 +4307,24 @@ type GetProfileReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileReplyValidationError) Field() string { return e.field }
+func (e SetFollowDbAndCacheReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileReplyValidationError) Reason() string { return e.reason }
+func (e SetFollowDbAndCacheReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileReplyValidationError) Cause() error { return e.cause }
+func (e SetFollowDbAndCacheReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileReplyValidationError) Key() bool { return e.key }
+func (e SetFollowDbAndCacheReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileReplyValidationError) ErrorName() string { return "GetProfileReplyValidationError" }
+func (e SetFollowDbAndCacheReqValidationError) ErrorName() string {
+	return "SetFollowDbAndCacheReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetProfileReplyValidationError) Error() string {
+func (e SetFollowDbAndCacheReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4665,14 +4336,14 @@ func (e GetProfileReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileReply.%s: %s%s",
+		"invalid %sSetFollowDbAndCacheReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileReplyValidationError{}
+var _ error = SetFollowDbAndCacheReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4680,44 +4351,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileReplyValidationError{}
+} = SetFollowDbAndCacheReqValidationError{}
 
-// Validate checks the field values on GetProfileListReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetProfileListReq) Validate() error {
+var _SetFollowDbAndCacheReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _SetFollowDbAndCacheReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on CancelUserFollowReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelUserFollowReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileListReq with the rules
+// ValidateAll checks the field values on CancelUserFollowReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProfileListReqMultiError, or nil if none found.
-func (m *GetProfileListReq) ValidateAll() error {
+// CancelUserFollowReqMultiError, or nil if none found.
+func (m *CancelUserFollowReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileListReq) validate(all bool) error {
+func (m *CancelUserFollowReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_CancelUserFollowReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := CancelUserFollowReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CancelUserFollowReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := CancelUserFollowReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetProfileListReqMultiError(errors)
+		return CancelUserFollowReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileListReqMultiError is an error wrapping multiple validation errors
-// returned by GetProfileListReq.ValidateAll() if the designated constraints
-// aren't met.
-type GetProfileListReqMultiError []error
+// CancelUserFollowReqMultiError is an error wrapping multiple validation
+// errors returned by CancelUserFollowReq.ValidateAll() if the designated
+// constraints aren't met.
+type CancelUserFollowReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileListReqMultiError) Error() string {
+func (m CancelUserFollowReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4726,11 +4423,11 @@ func (m GetProfileListReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileListReqMultiError) AllErrors() []error { return m }
+func (m CancelUserFollowReqMultiError) AllErrors() []error { return m }
 
-// GetProfileListReqValidationError is the validation error returned by
-// GetProfileListReq.Validate if the designated constraints aren't met.
-type GetProfileListReqValidationError struct {
+// CancelUserFollowReqValidationError is the validation error returned by
+// CancelUserFollowReq.Validate if the designated constraints aren't met.
+type CancelUserFollowReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4738,24 +4435,24 @@ type GetProfileListReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileListReqValidationError) Field() string { return e.field }
+func (e CancelUserFollowReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileListReqValidationError) Reason() string { return e.reason }
+func (e CancelUserFollowReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileListReqValidationError) Cause() error { return e.cause }
+func (e CancelUserFollowReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileListReqValidationError) Key() bool { return e.key }
+func (e CancelUserFollowReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileListReqValidationError) ErrorName() string {
-	return "GetProfileListReqValidationError"
+func (e CancelUserFollowReqValidationError) ErrorName() string {
+	return "CancelUserFollowReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProfileListReqValidationError) Error() string {
+func (e CancelUserFollowReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4767,14 +4464,14 @@ func (e GetProfileListReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileListReq.%s: %s%s",
+		"invalid %sCancelUserFollowReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileListReqValidationError{}
+var _ error = CancelUserFollowReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4782,78 +4479,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileListReqValidationError{}
+} = CancelUserFollowReqValidationError{}
 
-// Validate checks the field values on GetProfileListReply with the rules
+var _CancelUserFollowReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _CancelUserFollowReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on CancelFollowDbAndCacheReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProfileListReply) Validate() error {
+func (m *CancelFollowDbAndCacheReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelFollowDbAndCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProfileListReplyMultiError, or nil if none found.
-func (m *GetProfileListReply) ValidateAll() error {
+// CancelFollowDbAndCacheReqMultiError, or nil if none found.
+func (m *CancelFollowDbAndCacheReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileListReply) validate(all bool) error {
+func (m *CancelFollowDbAndCacheReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProfile() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProfileListReplyValidationError{
-						field:  fmt.Sprintf("Profile[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProfileListReplyValidationError{
-						field:  fmt.Sprintf("Profile[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetProfileListReplyValidationError{
-					field:  fmt.Sprintf("Profile[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_CancelFollowDbAndCacheReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := CancelFollowDbAndCacheReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if !_CancelFollowDbAndCacheReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := CancelFollowDbAndCacheReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetProfileListReplyMultiError(errors)
+		return CancelFollowDbAndCacheReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileListReplyMultiError is an error wrapping multiple validation
-// errors returned by GetProfileListReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetProfileListReplyMultiError []error
+// CancelFollowDbAndCacheReqMultiError is an error wrapping multiple validation
+// errors returned by CancelFollowDbAndCacheReq.ValidateAll() if the
+// designated constraints aren't met.
+type CancelFollowDbAndCacheReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileListReplyMultiError) Error() string {
+func (m CancelFollowDbAndCacheReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4862,11 +4551,11 @@ func (m GetProfileListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileListReplyMultiError) AllErrors() []error { return m }
+func (m CancelFollowDbAndCacheReqMultiError) AllErrors() []error { return m }
 
-// GetProfileListReplyValidationError is the validation error returned by
-// GetProfileListReply.Validate if the designated constraints aren't met.
-type GetProfileListReplyValidationError struct {
+// CancelFollowDbAndCacheReqValidationError is the validation error returned by
+// CancelFollowDbAndCacheReq.Validate if the designated constraints aren't met.
+type CancelFollowDbAndCacheReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4874,24 +4563,24 @@ type GetProfileListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileListReplyValidationError) Field() string { return e.field }
+func (e CancelFollowDbAndCacheReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileListReplyValidationError) Reason() string { return e.reason }
+func (e CancelFollowDbAndCacheReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileListReplyValidationError) Cause() error { return e.cause }
+func (e CancelFollowDbAndCacheReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileListReplyValidationError) Key() bool { return e.key }
+func (e CancelFollowDbAndCacheReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileListReplyValidationError) ErrorName() string {
-	return "GetProfileListReplyValidationError"
+func (e CancelFollowDbAndCacheReqValidationError) ErrorName() string {
+	return "CancelFollowDbAndCacheReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProfileListReplyValidationError) Error() string {
+func (e CancelFollowDbAndCacheReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4903,14 +4592,14 @@ func (e GetProfileListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileListReply.%s: %s%s",
+		"invalid %sCancelFollowDbAndCacheReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileListReplyValidationError{}
+var _ error = CancelFollowDbAndCacheReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4918,32 +4607,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileListReplyValidationError{}
+} = CancelFollowDbAndCacheReqValidationError{}
 
-// Validate checks the field values on GetProfileUpdateReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProfileUpdateReq) Validate() error {
+var _CancelFollowDbAndCacheReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _CancelFollowDbAndCacheReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on BlockUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockUserReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileUpdateReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProfileUpdateReqMultiError, or nil if none found.
-func (m *GetProfileUpdateReq) ValidateAll() error {
+// ValidateAll checks the field values on BlockUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockUserReqMultiError, or
+// nil if none found.
+func (m *BlockUserReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileUpdateReq) validate(all bool) error {
+func (m *BlockUserReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetProfileUpdateReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetProfileUpdateReqValidationError{
+	if !_BlockUserReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := BlockUserReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -4953,20 +4646,30 @@ func (m *GetProfileUpdateReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if !_BlockUserReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := BlockUserReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetProfileUpdateReqMultiError(errors)
+		return BlockUserReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileUpdateReqMultiError is an error wrapping multiple validation
-// errors returned by GetProfileUpdateReq.ValidateAll() if the designated
-// constraints aren't met.
-type GetProfileUpdateReqMultiError []error
+// BlockUserReqMultiError is an error wrapping multiple validation errors
+// returned by BlockUserReq.ValidateAll() if the designated constraints aren't met.
+type BlockUserReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileUpdateReqMultiError) Error() string {
+func (m BlockUserReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4975,11 +4678,11 @@ func (m GetProfileUpdateReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileUpdateReqMultiError) AllErrors() []error { return m }
+func (m BlockUserReqMultiError) AllErrors() []error { return m }
 
-// GetProfileUpdateReqValidationError is the validation error returned by
-// GetProfileUpdateReq.Validate if the designated constraints aren't met.
-type GetProfileUpdateReqValidationError struct {
+// BlockUserReqValidationError is the validation error returned by
+// BlockUserReq.Validate if the designated constraints aren't met.
+type BlockUserReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4987,24 +4690,22 @@ type GetProfileUpdateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileUpdateReqValidationError) Field() string { return e.field }
+func (e BlockUserReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileUpdateReqValidationError) Reason() string { return e.reason }
+func (e BlockUserReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileUpdateReqValidationError) Cause() error { return e.cause }
+func (e BlockUserReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileUpdateReqValidationError) Key() bool { return e.key }
+func (e BlockUserReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileUpdateReqValidationError) ErrorName() string {
-	return "GetProfileUpdateReqValidationError"
-}
+func (e BlockUserReqValidationError) ErrorName() string { return "BlockUserReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetProfileUpdateReqValidationError) Error() string {
+func (e BlockUserReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5016,14 +4717,14 @@ func (e GetProfileUpdateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileUpdateReq.%s: %s%s",
+		"invalid %sBlockUserReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileUpdateReqValidationError{}
+var _ error = BlockUserReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5031,66 +4732,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileUpdateReqValidationError{}
+} = BlockUserReqValidationError{}
 
-var _GetProfileUpdateReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _BlockUserReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetProfileUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProfileUpdateReply) Validate() error {
+var _BlockUserReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on UnblockUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProfileUpdateReplyMultiError, or nil if none found.
-func (m *GetProfileUpdateReply) ValidateAll() error {
+// ValidateAll checks the field values on UnblockUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnblockUserReqMultiError,
+// or nil if none found.
+func (m *UnblockUserReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileUpdateReply) validate(all bool) error {
+func (m *UnblockUserReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Username
-
-	// no validation rules for Avatar
-
-	// no validation rules for School
-
-	// no validation rules for Company
-
-	// no validation rules for Job
-
-	// no validation rules for Homepage
-
-	// no validation rules for Introduce
-
-	// no validation rules for Github
-
-	// no validation rules for Gitee
+	if !_UnblockUserReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := UnblockUserReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Status
+	if !_UnblockUserReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := UnblockUserReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetProfileUpdateReplyMultiError(errors)
+		return UnblockUserReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by GetProfileUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetProfileUpdateReplyMultiError []error
+// UnblockUserReqMultiError is an error wrapping multiple validation errors
+// returned by UnblockUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockUserReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileUpdateReplyMultiError) Error() string {
+func (m UnblockUserReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5099,11 +4804,11 @@ func (m GetProfileUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileUpdateReplyMultiError) AllErrors() []error { return m }
+func (m UnblockUserReqMultiError) AllErrors() []error { return m }
 
-// GetProfileUpdateReplyValidationError is the validation error returned by
-// GetProfileUpdateReply.Validate if the designated constraints aren't met.
-type GetProfileUpdateReplyValidationError struct {
+// UnblockUserReqValidationError is the validation error returned by
+// UnblockUserReq.Validate if the designated constraints aren't met.
+type UnblockUserReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5111,24 +4816,22 @@ type GetProfileUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileUpdateReplyValidationError) Field() string { return e.field }
+func (e UnblockUserReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileUpdateReplyValidationError) Reason() string { return e.reason }
+func (e UnblockUserReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileUpdateReplyValidationError) Cause() error { return e.cause }
+func (e UnblockUserReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileUpdateReplyValidationError) Key() bool { return e.key }
+func (e UnblockUserReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileUpdateReplyValidationError) ErrorName() string {
-	return "GetProfileUpdateReplyValidationError"
-}
+func (e UnblockUserReqValidationError) ErrorName() string { return "UnblockUserReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetProfileUpdateReplyValidationError) Error() string {
+func (e UnblockUserReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5140,14 +4843,14 @@ func (e GetProfileUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileUpdateReply.%s: %s%s",
+		"invalid %sUnblockUserReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileUpdateReplyValidationError{}
+var _ error = UnblockUserReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5155,32 +4858,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileUpdateReplyValidationError{}
+} = UnblockUserReqValidationError{}
 
-// Validate checks the field values on GetUserFollowReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserFollowReq) Validate() error {
+var _UnblockUserReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _UnblockUserReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on MuteUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteUserReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserFollowReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUserFollowReqMultiError, or nil if none found.
-func (m *GetUserFollowReq) ValidateAll() error {
+// ValidateAll checks the field values on MuteUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteUserReqMultiError, or
+// nil if none found.
+func (m *MuteUserReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserFollowReq) validate(all bool) error {
+func (m *MuteUserReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetUserFollowReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetUserFollowReqValidationError{
+	if !_MuteUserReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := MuteUserReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -5190,8 +4897,8 @@ func (m *GetUserFollowReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_GetUserFollowReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
-		err := GetUserFollowReqValidationError{
+	if !_MuteUserReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := MuteUserReqValidationError{
 			field:  "UserUuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -5202,19 +4909,18 @@ func (m *GetUserFollowReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserFollowReqMultiError(errors)
+		return MuteUserReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserFollowReqMultiError is an error wrapping multiple validation errors
-// returned by GetUserFollowReq.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserFollowReqMultiError []error
+// MuteUserReqMultiError is an error wrapping multiple validation errors
+// returned by MuteUserReq.ValidateAll() if the designated constraints aren't met.
+type MuteUserReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserFollowReqMultiError) Error() string {
+func (m MuteUserReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5223,11 +4929,11 @@ func (m GetUserFollowReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserFollowReqMultiError) AllErrors() []error { return m }
+func (m MuteUserReqMultiError) AllErrors() []error { return m }
 
-// GetUserFollowReqValidationError is the validation error returned by
-// GetUserFollowReq.Validate if the designated constraints aren't met.
-type GetUserFollowReqValidationError struct {
+// MuteUserReqValidationError is the validation error returned by
+// MuteUserReq.Validate if the designated constraints aren't met.
+type MuteUserReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5235,22 +4941,22 @@ type GetUserFollowReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserFollowReqValidationError) Field() string { return e.field }
+func (e MuteUserReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserFollowReqValidationError) Reason() string { return e.reason }
+func (e MuteUserReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserFollowReqValidationError) Cause() error { return e.cause }
+func (e MuteUserReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserFollowReqValidationError) Key() bool { return e.key }
+func (e MuteUserReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserFollowReqValidationError) ErrorName() string { return "GetUserFollowReqValidationError" }
+func (e MuteUserReqValidationError) ErrorName() string { return "MuteUserReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUserFollowReqValidationError) Error() string {
+func (e MuteUserReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5262,14 +4968,14 @@ func (e GetUserFollowReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserFollowReq.%s: %s%s",
+		"invalid %sMuteUserReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserFollowReqValidationError{}
+var _ error = MuteUserReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5277,50 +4983,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserFollowReqValidationError{}
+} = MuteUserReqValidationError{}
 
-var _GetUserFollowReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _MuteUserReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-var _GetUserFollowReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _MuteUserReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetUserFollowReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserFollowReply) Validate() error {
+// Validate checks the field values on UnmuteUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnmuteUserReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserFollowReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUserFollowReplyMultiError, or nil if none found.
-func (m *GetUserFollowReply) ValidateAll() error {
+// ValidateAll checks the field values on UnmuteUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnmuteUserReqMultiError, or
+// nil if none found.
+func (m *UnmuteUserReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserFollowReply) validate(all bool) error {
+func (m *UnmuteUserReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Follow
+	if !_UnmuteUserReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := UnmuteUserReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UnmuteUserReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := UnmuteUserReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetUserFollowReplyMultiError(errors)
+		return UnmuteUserReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserFollowReplyMultiError is an error wrapping multiple validation errors
-// returned by GetUserFollowReply.ValidateAll() if the designated constraints
+// UnmuteUserReqMultiError is an error wrapping multiple validation errors
+// returned by UnmuteUserReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetUserFollowReplyMultiError []error
+type UnmuteUserReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserFollowReplyMultiError) Error() string {
+func (m UnmuteUserReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5329,11 +5055,11 @@ func (m GetUserFollowReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserFollowReplyMultiError) AllErrors() []error { return m }
+func (m UnmuteUserReqMultiError) AllErrors() []error { return m }
 
-// GetUserFollowReplyValidationError is the validation error returned by
-// GetUserFollowReply.Validate if the designated constraints aren't met.
-type GetUserFollowReplyValidationError struct {
+// UnmuteUserReqValidationError is the validation error returned by
+// UnmuteUserReq.Validate if the designated constraints aren't met.
+type UnmuteUserReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5341,24 +5067,22 @@ type GetUserFollowReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserFollowReplyValidationError) Field() string { return e.field }
+func (e UnmuteUserReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserFollowReplyValidationError) Reason() string { return e.reason }
+func (e UnmuteUserReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserFollowReplyValidationError) Cause() error { return e.cause }
+func (e UnmuteUserReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserFollowReplyValidationError) Key() bool { return e.key }
+func (e UnmuteUserReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserFollowReplyValidationError) ErrorName() string {
-	return "GetUserFollowReplyValidationError"
-}
+func (e UnmuteUserReqValidationError) ErrorName() string { return "UnmuteUserReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUserFollowReplyValidationError) Error() string {
+func (e UnmuteUserReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5370,14 +5094,14 @@ func (e GetUserFollowReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserFollowReply.%s: %s%s",
+		"invalid %sUnmuteUserReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserFollowReplyValidationError{}
+var _ error = UnmuteUserReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5385,34 +5109,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserFollowReplyValidationError{}
+} = UnmuteUserReqValidationError{}
 
-// Validate checks the field values on GetFollowListReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowListReq) Validate() error {
+var _UnmuteUserReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _UnmuteUserReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on SetProfilePrivateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProfilePrivateReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowListReq with the rules
+// ValidateAll checks the field values on SetProfilePrivateReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFollowListReqMultiError, or nil if none found.
-func (m *GetFollowListReq) ValidateAll() error {
+// SetProfilePrivateReqMultiError, or nil if none found.
+func (m *SetProfilePrivateReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowListReq) validate(all bool) error {
+func (m *SetProfilePrivateReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	if !_GetFollowListReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetFollowListReqValidationError{
+	if !_SetProfilePrivateReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetProfilePrivateReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -5422,20 +5148,22 @@ func (m *GetFollowListReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Private
+
 	if len(errors) > 0 {
-		return GetFollowListReqMultiError(errors)
+		return SetProfilePrivateReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowListReqMultiError is an error wrapping multiple validation errors
-// returned by GetFollowListReq.ValidateAll() if the designated constraints
-// aren't met.
-type GetFollowListReqMultiError []error
+// SetProfilePrivateReqMultiError is an error wrapping multiple validation
+// errors returned by SetProfilePrivateReq.ValidateAll() if the designated
+// constraints aren't met.
+type SetProfilePrivateReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowListReqMultiError) Error() string {
+func (m SetProfilePrivateReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5444,11 +5172,11 @@ func (m GetFollowListReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowListReqMultiError) AllErrors() []error { return m }
+func (m SetProfilePrivateReqMultiError) AllErrors() []error { return m }
 
-// GetFollowListReqValidationError is the validation error returned by
-// GetFollowListReq.Validate if the designated constraints aren't met.
-type GetFollowListReqValidationError struct {
+// SetProfilePrivateReqValidationError is the validation error returned by
+// SetProfilePrivateReq.Validate if the designated constraints aren't met.
+type SetProfilePrivateReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5456,22 +5184,24 @@ type GetFollowListReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowListReqValidationError) Field() string { return e.field }
+func (e SetProfilePrivateReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowListReqValidationError) Reason() string { return e.reason }
+func (e SetProfilePrivateReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowListReqValidationError) Cause() error { return e.cause }
+func (e SetProfilePrivateReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowListReqValidationError) Key() bool { return e.key }
+func (e SetProfilePrivateReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowListReqValidationError) ErrorName() string { return "GetFollowListReqValidationError" }
+func (e SetProfilePrivateReqValidationError) ErrorName() string {
+	return "SetProfilePrivateReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetFollowListReqValidationError) Error() string {
+func (e SetProfilePrivateReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5483,14 +5213,14 @@ func (e GetFollowListReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowListReq.%s: %s%s",
+		"invalid %sSetProfilePrivateReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowListReqValidationError{}
+var _ error = SetProfilePrivateReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5498,80 +5228,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowListReqValidationError{}
+} = SetProfilePrivateReqValidationError{}
 
-var _GetFollowListReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SetProfilePrivateReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetFollowListReply with the rules
+// Validate checks the field values on ApproveFollowRequestReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowListReply) Validate() error {
+func (m *ApproveFollowRequestReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ApproveFollowRequestReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFollowListReplyMultiError, or nil if none found.
-func (m *GetFollowListReply) ValidateAll() error {
+// ApproveFollowRequestReqMultiError, or nil if none found.
+func (m *ApproveFollowRequestReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowListReply) validate(all bool) error {
+func (m *ApproveFollowRequestReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFollow() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetFollowListReplyValidationError{
-						field:  fmt.Sprintf("Follow[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetFollowListReplyValidationError{
-						field:  fmt.Sprintf("Follow[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetFollowListReplyValidationError{
-					field:  fmt.Sprintf("Follow[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_ApproveFollowRequestReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ApproveFollowRequestReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if !_ApproveFollowRequestReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := ApproveFollowRequestReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetFollowListReplyMultiError(errors)
+		return ApproveFollowRequestReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowListReplyMultiError is an error wrapping multiple validation errors
-// returned by GetFollowListReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetFollowListReplyMultiError []error
+// ApproveFollowRequestReqMultiError is an error wrapping multiple validation
+// errors returned by ApproveFollowRequestReq.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveFollowRequestReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowListReplyMultiError) Error() string {
+func (m ApproveFollowRequestReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5580,11 +5298,11 @@ func (m GetFollowListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowListReplyMultiError) AllErrors() []error { return m }
+func (m ApproveFollowRequestReqMultiError) AllErrors() []error { return m }
 
-// GetFollowListReplyValidationError is the validation error returned by
-// GetFollowListReply.Validate if the designated constraints aren't met.
-type GetFollowListReplyValidationError struct {
+// ApproveFollowRequestReqValidationError is the validation error returned by
+// ApproveFollowRequestReq.Validate if the designated constraints aren't met.
+type ApproveFollowRequestReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5592,24 +5310,24 @@ type GetFollowListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowListReplyValidationError) Field() string { return e.field }
+func (e ApproveFollowRequestReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowListReplyValidationError) Reason() string { return e.reason }
+func (e ApproveFollowRequestReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowListReplyValidationError) Cause() error { return e.cause }
+func (e ApproveFollowRequestReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowListReplyValidationError) Key() bool { return e.key }
+func (e ApproveFollowRequestReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowListReplyValidationError) ErrorName() string {
-	return "GetFollowListReplyValidationError"
+func (e ApproveFollowRequestReqValidationError) ErrorName() string {
+	return "ApproveFollowRequestReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFollowListReplyValidationError) Error() string {
+func (e ApproveFollowRequestReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5621,14 +5339,14 @@ func (e GetFollowListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowListReply.%s: %s%s",
+		"invalid %sApproveFollowRequestReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowListReplyValidationError{}
+var _ error = ApproveFollowRequestReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5636,32 +5354,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowListReplyValidationError{}
+} = ApproveFollowRequestReqValidationError{}
 
-// Validate checks the field values on GetFollowListCountReq with the rules
+var _ApproveFollowRequestReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _ApproveFollowRequestReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on RejectFollowRequestReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowListCountReq) Validate() error {
+func (m *RejectFollowRequestReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowListCountReq with the rules
+// ValidateAll checks the field values on RejectFollowRequestReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFollowListCountReqMultiError, or nil if none found.
-func (m *GetFollowListCountReq) ValidateAll() error {
+// RejectFollowRequestReqMultiError, or nil if none found.
+func (m *RejectFollowRequestReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowListCountReq) validate(all bool) error {
+func (m *RejectFollowRequestReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetFollowListCountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetFollowListCountReqValidationError{
+	if !_RejectFollowRequestReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := RejectFollowRequestReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -5671,20 +5393,31 @@ func (m *GetFollowListCountReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if !_RejectFollowRequestReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := RejectFollowRequestReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetFollowListCountReqMultiError(errors)
+		return RejectFollowRequestReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowListCountReqMultiError is an error wrapping multiple validation
-// errors returned by GetFollowListCountReq.ValidateAll() if the designated
+// RejectFollowRequestReqMultiError is an error wrapping multiple validation
+// errors returned by RejectFollowRequestReq.ValidateAll() if the designated
 // constraints aren't met.
-type GetFollowListCountReqMultiError []error
+type RejectFollowRequestReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowListCountReqMultiError) Error() string {
+func (m RejectFollowRequestReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5693,11 +5426,11 @@ func (m GetFollowListCountReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowListCountReqMultiError) AllErrors() []error { return m }
+func (m RejectFollowRequestReqMultiError) AllErrors() []error { return m }
 
-// GetFollowListCountReqValidationError is the validation error returned by
-// GetFollowListCountReq.Validate if the designated constraints aren't met.
-type GetFollowListCountReqValidationError struct {
+// RejectFollowRequestReqValidationError is the validation error returned by
+// RejectFollowRequestReq.Validate if the designated constraints aren't met.
+type RejectFollowRequestReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5705,24 +5438,24 @@ type GetFollowListCountReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowListCountReqValidationError) Field() string { return e.field }
+func (e RejectFollowRequestReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowListCountReqValidationError) Reason() string { return e.reason }
+func (e RejectFollowRequestReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowListCountReqValidationError) Cause() error { return e.cause }
+func (e RejectFollowRequestReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowListCountReqValidationError) Key() bool { return e.key }
+func (e RejectFollowRequestReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowListCountReqValidationError) ErrorName() string {
-	return "GetFollowListCountReqValidationError"
+func (e RejectFollowRequestReqValidationError) ErrorName() string {
+	return "RejectFollowRequestReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFollowListCountReqValidationError) Error() string {
+func (e RejectFollowRequestReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5734,14 +5467,14 @@ func (e GetFollowListCountReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowListCountReq.%s: %s%s",
+		"invalid %sRejectFollowRequestReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowListCountReqValidationError{}
+var _ error = RejectFollowRequestReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5749,48 +5482,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowListCountReqValidationError{}
+} = RejectFollowRequestReqValidationError{}
 
-var _GetFollowListCountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _RejectFollowRequestReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetFollowListCountReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowListCountReply) Validate() error {
+var _RejectFollowRequestReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on SetUserHandleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetUserHandleReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowListCountReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetUserHandleReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFollowListCountReplyMultiError, or nil if none found.
-func (m *GetFollowListCountReply) ValidateAll() error {
+// SetUserHandleReqMultiError, or nil if none found.
+func (m *SetUserHandleReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowListCountReply) validate(all bool) error {
+func (m *SetUserHandleReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
+	if !_SetUserHandleReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserHandleReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SetUserHandleReq_Handle_Pattern.MatchString(m.GetHandle()) {
+		err := SetUserHandleReqValidationError{
+			field:  "Handle",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_]{3,20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetFollowListCountReplyMultiError(errors)
+		return SetUserHandleReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowListCountReplyMultiError is an error wrapping multiple validation
-// errors returned by GetFollowListCountReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetFollowListCountReplyMultiError []error
+// SetUserHandleReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserHandleReq.ValidateAll() if the designated constraints
+// aren't met.
+type SetUserHandleReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowListCountReplyMultiError) Error() string {
+func (m SetUserHandleReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5799,11 +5554,11 @@ func (m GetFollowListCountReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowListCountReplyMultiError) AllErrors() []error { return m }
+func (m SetUserHandleReqMultiError) AllErrors() []error { return m }
 
-// GetFollowListCountReplyValidationError is the validation error returned by
-// GetFollowListCountReply.Validate if the designated constraints aren't met.
-type GetFollowListCountReplyValidationError struct {
+// SetUserHandleReqValidationError is the validation error returned by
+// SetUserHandleReq.Validate if the designated constraints aren't met.
+type SetUserHandleReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5811,24 +5566,22 @@ type GetFollowListCountReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowListCountReplyValidationError) Field() string { return e.field }
+func (e SetUserHandleReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowListCountReplyValidationError) Reason() string { return e.reason }
+func (e SetUserHandleReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowListCountReplyValidationError) Cause() error { return e.cause }
+func (e SetUserHandleReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowListCountReplyValidationError) Key() bool { return e.key }
+func (e SetUserHandleReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowListCountReplyValidationError) ErrorName() string {
-	return "GetFollowListCountReplyValidationError"
-}
+func (e SetUserHandleReqValidationError) ErrorName() string { return "SetUserHandleReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFollowListCountReplyValidationError) Error() string {
+func (e SetUserHandleReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5840,14 +5593,14 @@ func (e GetFollowListCountReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowListCountReply.%s: %s%s",
+		"invalid %sSetUserHandleReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowListCountReplyValidationError{}
+var _ error = SetUserHandleReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5855,34 +5608,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowListCountReplyValidationError{}
+} = SetUserHandleReqValidationError{}
 
-// Validate checks the field values on GetFollowedListReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowedListReq) Validate() error {
+var _SetUserHandleReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _SetUserHandleReq_Handle_Pattern = regexp.MustCompile("^[a-zA-Z0-9_]{3,20}$")
+
+// Validate checks the field values on SetUserRoleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetUserRoleReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowedListReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFollowedListReqMultiError, or nil if none found.
-func (m *GetFollowedListReq) ValidateAll() error {
+// ValidateAll checks the field values on SetUserRoleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetUserRoleReqMultiError,
+// or nil if none found.
+func (m *SetUserRoleReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowedListReq) validate(all bool) error {
+func (m *SetUserRoleReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	if !_GetFollowedListReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetFollowedListReqValidationError{
+	if !_SetUserRoleReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetUserRoleReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -5892,20 +5647,31 @@ func (m *GetFollowedListReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if !_SetUserRoleReq_Role_Pattern.MatchString(m.GetRole()) {
+		err := SetUserRoleReqValidationError{
+			field:  "Role",
+			reason: "value does not match regex pattern \"^(user|moderator|admin)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetFollowedListReqMultiError(errors)
+		return SetUserRoleReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowedListReqMultiError is an error wrapping multiple validation errors
-// returned by GetFollowedListReq.ValidateAll() if the designated constraints
+// SetUserRoleReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserRoleReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetFollowedListReqMultiError []error
+type SetUserRoleReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowedListReqMultiError) Error() string {
+func (m SetUserRoleReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5914,11 +5680,11 @@ func (m GetFollowedListReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowedListReqMultiError) AllErrors() []error { return m }
+func (m SetUserRoleReqMultiError) AllErrors() []error { return m }
 
-// GetFollowedListReqValidationError is the validation error returned by
-// GetFollowedListReq.Validate if the designated constraints aren't met.
-type GetFollowedListReqValidationError struct {
+// SetUserRoleReqValidationError is the validation error returned by
+// SetUserRoleReq.Validate if the designated constraints aren't met.
+type SetUserRoleReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5926,24 +5692,22 @@ type GetFollowedListReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowedListReqValidationError) Field() string { return e.field }
+func (e SetUserRoleReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowedListReqValidationError) Reason() string { return e.reason }
+func (e SetUserRoleReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowedListReqValidationError) Cause() error { return e.cause }
+func (e SetUserRoleReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowedListReqValidationError) Key() bool { return e.key }
+func (e SetUserRoleReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowedListReqValidationError) ErrorName() string {
-	return "GetFollowedListReqValidationError"
-}
+func (e SetUserRoleReqValidationError) ErrorName() string { return "SetUserRoleReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFollowedListReqValidationError) Error() string {
+func (e SetUserRoleReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5955,14 +5719,14 @@ func (e GetFollowedListReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowedListReq.%s: %s%s",
+		"invalid %sSetUserRoleReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowedListReqValidationError{}
+var _ error = SetUserRoleReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5970,80 +5734,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowedListReqValidationError{}
+} = SetUserRoleReqValidationError{}
 
-var _GetFollowedListReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _SetUserRoleReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetFollowedListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowedListReply) Validate() error {
+var _SetUserRoleReq_Role_Pattern = regexp.MustCompile("^(user|moderator|admin)$")
+
+// Validate checks the field values on SuspendUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SuspendUserReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowedListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFollowedListReplyMultiError, or nil if none found.
-func (m *GetFollowedListReply) ValidateAll() error {
+// ValidateAll checks the field values on SuspendUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuspendUserReqMultiError,
+// or nil if none found.
+func (m *SuspendUserReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowedListReply) validate(all bool) error {
+func (m *SuspendUserReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFollow() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetFollowedListReplyValidationError{
-						field:  fmt.Sprintf("Follow[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetFollowedListReplyValidationError{
-						field:  fmt.Sprintf("Follow[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetFollowedListReplyValidationError{
-					field:  fmt.Sprintf("Follow[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_SuspendUserReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SuspendUserReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	// no validation rules for Until
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := SuspendUserReqValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetFollowedListReplyMultiError(errors)
+		return SuspendUserReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowedListReplyMultiError is an error wrapping multiple validation
-// errors returned by GetFollowedListReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetFollowedListReplyMultiError []error
+// SuspendUserReqMultiError is an error wrapping multiple validation errors
+// returned by SuspendUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type SuspendUserReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowedListReplyMultiError) Error() string {
+func (m SuspendUserReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6052,11 +5808,11 @@ func (m GetFollowedListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowedListReplyMultiError) AllErrors() []error { return m }
+func (m SuspendUserReqMultiError) AllErrors() []error { return m }
 
-// GetFollowedListReplyValidationError is the validation error returned by
-// GetFollowedListReply.Validate if the designated constraints aren't met.
-type GetFollowedListReplyValidationError struct {
+// SuspendUserReqValidationError is the validation error returned by
+// SuspendUserReq.Validate if the designated constraints aren't met.
+type SuspendUserReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6064,24 +5820,22 @@ type GetFollowedListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowedListReplyValidationError) Field() string { return e.field }
+func (e SuspendUserReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowedListReplyValidationError) Reason() string { return e.reason }
+func (e SuspendUserReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowedListReplyValidationError) Cause() error { return e.cause }
+func (e SuspendUserReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowedListReplyValidationError) Key() bool { return e.key }
+func (e SuspendUserReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowedListReplyValidationError) ErrorName() string {
-	return "GetFollowedListReplyValidationError"
-}
+func (e SuspendUserReqValidationError) ErrorName() string { return "SuspendUserReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFollowedListReplyValidationError) Error() string {
+func (e SuspendUserReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6093,14 +5847,14 @@ func (e GetFollowedListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowedListReply.%s: %s%s",
+		"invalid %sSuspendUserReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowedListReplyValidationError{}
+var _ error = SuspendUserReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6108,32 +5862,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowedListReplyValidationError{}
+} = SuspendUserReqValidationError{}
 
-// Validate checks the field values on GetFollowedListCountReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowedListCountReq) Validate() error {
+var _SuspendUserReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on UnsuspendUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnsuspendUserReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowedListCountReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnsuspendUserReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFollowedListCountReqMultiError, or nil if none found.
-func (m *GetFollowedListCountReq) ValidateAll() error {
+// UnsuspendUserReqMultiError, or nil if none found.
+func (m *UnsuspendUserReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowedListCountReq) validate(all bool) error {
+func (m *UnsuspendUserReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetFollowedListCountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetFollowedListCountReqValidationError{
+	if !_UnsuspendUserReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := UnsuspendUserReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -6144,19 +5900,6553 @@ func (m *GetFollowedListCountReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetFollowedListCountReqMultiError(errors)
+		return UnsuspendUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsuspendUserReqMultiError is an error wrapping multiple validation errors
+// returned by UnsuspendUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type UnsuspendUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsuspendUserReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsuspendUserReqMultiError) AllErrors() []error { return m }
+
+// UnsuspendUserReqValidationError is the validation error returned by
+// UnsuspendUserReq.Validate if the designated constraints aren't met.
+type UnsuspendUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsuspendUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsuspendUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsuspendUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsuspendUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsuspendUserReqValidationError) ErrorName() string { return "UnsuspendUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnsuspendUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsuspendUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsuspendUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsuspendUserReqValidationError{}
+
+var _UnsuspendUserReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on AddAuditLogReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddAuditLogReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAuditLogReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddAuditLogReqMultiError,
+// or nil if none found.
+func (m *AddAuditLogReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAuditLogReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_AddAuditLogReq_Operator_Pattern.MatchString(m.GetOperator()) {
+		err := AddAuditLogReqValidationError{
+			field:  "Operator",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) > 20 {
+		err := AddAuditLogReqValidationError{
+			field:  "Role",
+			reason: "value length must be at most 20 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAction()); l < 1 || l > 50 {
+		err := AddAuditLogReqValidationError{
+			field:  "Action",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) > 20 {
+		err := AddAuditLogReqValidationError{
+			field:  "Kind",
+			reason: "value length must be at most 20 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTarget()) > 100 {
+		err := AddAuditLogReqValidationError{
+			field:  "Target",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := AddAuditLogReqValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddAuditLogReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAuditLogReqMultiError is an error wrapping multiple validation errors
+// returned by AddAuditLogReq.ValidateAll() if the designated constraints
+// aren't met.
+type AddAuditLogReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAuditLogReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAuditLogReqMultiError) AllErrors() []error { return m }
+
+// AddAuditLogReqValidationError is the validation error returned by
+// AddAuditLogReq.Validate if the designated constraints aren't met.
+type AddAuditLogReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAuditLogReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAuditLogReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAuditLogReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAuditLogReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAuditLogReqValidationError) ErrorName() string { return "AddAuditLogReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddAuditLogReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAuditLogReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAuditLogReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAuditLogReqValidationError{}
+
+var _AddAuditLogReq_Operator_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on AddAuditLogReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddAuditLogReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAuditLogReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAuditLogReplyMultiError, or nil if none found.
+func (m *AddAuditLogReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAuditLogReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AddAuditLogReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAuditLogReplyMultiError is an error wrapping multiple validation errors
+// returned by AddAuditLogReply.ValidateAll() if the designated constraints
+// aren't met.
+type AddAuditLogReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAuditLogReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAuditLogReplyMultiError) AllErrors() []error { return m }
+
+// AddAuditLogReplyValidationError is the validation error returned by
+// AddAuditLogReply.Validate if the designated constraints aren't met.
+type AddAuditLogReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAuditLogReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAuditLogReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAuditLogReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAuditLogReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAuditLogReplyValidationError) ErrorName() string { return "AddAuditLogReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddAuditLogReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAuditLogReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAuditLogReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAuditLogReplyValidationError{}
+
+// Validate checks the field values on CompleteAuditLogReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteAuditLogReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteAuditLogReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteAuditLogReqMultiError, or nil if none found.
+func (m *CompleteAuditLogReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteAuditLogReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return CompleteAuditLogReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteAuditLogReqMultiError is an error wrapping multiple validation
+// errors returned by CompleteAuditLogReq.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteAuditLogReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteAuditLogReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteAuditLogReqMultiError) AllErrors() []error { return m }
+
+// CompleteAuditLogReqValidationError is the validation error returned by
+// CompleteAuditLogReq.Validate if the designated constraints aren't met.
+type CompleteAuditLogReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteAuditLogReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteAuditLogReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteAuditLogReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteAuditLogReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteAuditLogReqValidationError) ErrorName() string {
+	return "CompleteAuditLogReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteAuditLogReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteAuditLogReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteAuditLogReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteAuditLogReqValidationError{}
+
+// Validate checks the field values on ChangeUserPasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeUserPasswordReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeUserPasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeUserPasswordReqMultiError, or nil if none found.
+func (m *ChangeUserPasswordReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeUserPasswordReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ChangeUserPasswordReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ChangeUserPasswordReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetOldpassword()); l < 1 || l > 128 {
+		err := ChangeUserPasswordReqValidationError{
+			field:  "Oldpassword",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
+		err := ChangeUserPasswordReqValidationError{
+			field:  "Password",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCode() != "" {
+
+		if utf8.RuneCountInString(m.GetCode()) > 20 {
+			err := ChangeUserPasswordReqValidationError{
+				field:  "Code",
+				reason: "value length must be at most 20 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ChangeUserPasswordReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeUserPasswordReqMultiError is an error wrapping multiple validation
+// errors returned by ChangeUserPasswordReq.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeUserPasswordReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeUserPasswordReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeUserPasswordReqMultiError) AllErrors() []error { return m }
+
+// ChangeUserPasswordReqValidationError is the validation error returned by
+// ChangeUserPasswordReq.Validate if the designated constraints aren't met.
+type ChangeUserPasswordReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeUserPasswordReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeUserPasswordReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeUserPasswordReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeUserPasswordReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeUserPasswordReqValidationError) ErrorName() string {
+	return "ChangeUserPasswordReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeUserPasswordReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeUserPasswordReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeUserPasswordReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeUserPasswordReqValidationError{}
+
+var _ChangeUserPasswordReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on UnbindUserAccountReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnbindUserAccountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnbindUserAccountReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnbindUserAccountReqMultiError, or nil if none found.
+func (m *UnbindUserAccountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnbindUserAccountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_UnbindUserAccountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := UnbindUserAccountReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPhone() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 50 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Phone",
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UnbindUserAccountReq_Phone_Pattern.MatchString(m.GetPhone()) {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Phone",
+				reason: "value does not match regex pattern \"^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\\\d{8}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEmail() != "" {
+
+		if utf8.RuneCountInString(m.GetEmail()) > 50 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Email",
+				reason: "value length must be at most 50 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UnbindUserAccountReq_Email_Pattern.MatchString(m.GetEmail()) {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Email",
+				reason: "value does not match regex pattern \"\\\\w+([-+.]\\\\w+)*@\\\\w+([-.]\\\\w+)*\\\\.\\\\w+([-.]\\\\w+)*\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetAccount() != "" {
+
+		if l := utf8.RuneCountInString(m.GetAccount()); l < 1 || l > 50 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Account",
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPassword() != "" {
+
+		if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Password",
+				reason: "value length must be between 1 and 128 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCode() != "" {
+
+		if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 500 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Code",
+				reason: "value length must be between 1 and 500 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetChoose()); l < 1 || l > 20 {
+		err := UnbindUserAccountReqValidationError{
+			field:  "Choose",
+			reason: "value length must be between 1 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMode() != "" {
+
+		if _, ok := _UnbindUserAccountReq_Mode_InLookup[m.GetMode()]; !ok {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Mode",
+				reason: "value must be in list [phone email]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetState() != "" {
+
+		if utf8.RuneCountInString(m.GetState()) > 100 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "State",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetProvider() != "" {
+
+		if utf8.RuneCountInString(m.GetProvider()) > 20 {
+			err := UnbindUserAccountReqValidationError{
+				field:  "Provider",
+				reason: "value length must be at most 20 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UnbindUserAccountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnbindUserAccountReqMultiError is an error wrapping multiple validation
+// errors returned by UnbindUserAccountReq.ValidateAll() if the designated
+// constraints aren't met.
+type UnbindUserAccountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnbindUserAccountReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnbindUserAccountReqMultiError) AllErrors() []error { return m }
+
+// UnbindUserAccountReqValidationError is the validation error returned by
+// UnbindUserAccountReq.Validate if the designated constraints aren't met.
+type UnbindUserAccountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnbindUserAccountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnbindUserAccountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnbindUserAccountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnbindUserAccountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnbindUserAccountReqValidationError) ErrorName() string {
+	return "UnbindUserAccountReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnbindUserAccountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnbindUserAccountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnbindUserAccountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnbindUserAccountReqValidationError{}
+
+var _UnbindUserAccountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _UnbindUserAccountReq_Phone_Pattern = regexp.MustCompile("^((13[0-9])|(14[5,7])|(15[0-3,5-9])|(17[0,3,5-8])|(18[0-9])|166|198|199|(147))\\d{8}$")
+
+var _UnbindUserAccountReq_Email_Pattern = regexp.MustCompile("\\w+([-+.]\\w+)*@\\w+([-.]\\w+)*\\.\\w+([-.]\\w+)*")
+
+var _UnbindUserAccountReq_Mode_InLookup = map[string]struct{}{
+	"phone": {},
+	"email": {},
+}
+
+// Validate checks the field values on GetAccountReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetAccountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetAccountReqMultiError, or
+// nil if none found.
+func (m *GetAccountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetAccountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetAccountReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAccountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountReqMultiError is an error wrapping multiple validation errors
+// returned by GetAccountReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetAccountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountReqMultiError) AllErrors() []error { return m }
+
+// GetAccountReqValidationError is the validation error returned by
+// GetAccountReq.Validate if the designated constraints aren't met.
+type GetAccountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountReqValidationError) ErrorName() string { return "GetAccountReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAccountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountReqValidationError{}
+
+var _GetAccountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetAccountReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountReplyMultiError, or nil if none found.
+func (m *GetAccountReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Phone
+
+	// no validation rules for Email
+
+	// no validation rules for Password
+
+	// no validation rules for Totp
+
+	if len(errors) > 0 {
+		return GetAccountReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAccountReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAccountReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountReplyMultiError) AllErrors() []error { return m }
+
+// GetAccountReplyValidationError is the validation error returned by
+// GetAccountReply.Validate if the designated constraints aren't met.
+type GetAccountReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountReplyValidationError) ErrorName() string { return "GetAccountReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAccountReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountReplyValidationError{}
+
+// Validate checks the field values on GetProfileReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetProfileReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetProfileReqMultiError, or
+// nil if none found.
+func (m *GetProfileReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetProfileReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetProfileReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProfileReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileReqMultiError is an error wrapping multiple validation errors
+// returned by GetProfileReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileReqMultiError) AllErrors() []error { return m }
+
+// GetProfileReqValidationError is the validation error returned by
+// GetProfileReq.Validate if the designated constraints aren't met.
+type GetProfileReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileReqValidationError) ErrorName() string { return "GetProfileReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetProfileReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileReqValidationError{}
+
+var _GetProfileReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetProfileReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileReplyMultiError, or nil if none found.
+func (m *GetProfileReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	// no validation rules for Username
+
+	// no validation rules for Avatar
+
+	// no validation rules for School
+
+	// no validation rules for Company
+
+	// no validation rules for Job
+
+	// no validation rules for Homepage
+
+	// no validation rules for Introduce
+
+	// no validation rules for Created
+
+	// no validation rules for Github
+
+	// no validation rules for Gitee
+
+	// no validation rules for Private
+
+	if len(errors) > 0 {
+		return GetProfileReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileReplyMultiError is an error wrapping multiple validation errors
+// returned by GetProfileReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileReplyMultiError) AllErrors() []error { return m }
+
+// GetProfileReplyValidationError is the validation error returned by
+// GetProfileReply.Validate if the designated constraints aren't met.
+type GetProfileReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileReplyValidationError) ErrorName() string { return "GetProfileReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetProfileReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileReplyValidationError{}
+
+// Validate checks the field values on GetProfileListReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileListReqMultiError, or nil if none found.
+func (m *GetProfileListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetProfileListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileListReqMultiError is an error wrapping multiple validation errors
+// returned by GetProfileListReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileListReqMultiError) AllErrors() []error { return m }
+
+// GetProfileListReqValidationError is the validation error returned by
+// GetProfileListReq.Validate if the designated constraints aren't met.
+type GetProfileListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileListReqValidationError) ErrorName() string {
+	return "GetProfileListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileListReqValidationError{}
+
+// Validate checks the field values on GetProfileListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileListReplyMultiError, or nil if none found.
+func (m *GetProfileListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProfile() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProfileListReplyValidationError{
+						field:  fmt.Sprintf("Profile[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProfileListReplyValidationError{
+						field:  fmt.Sprintf("Profile[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProfileListReplyValidationError{
+					field:  fmt.Sprintf("Profile[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetProfileListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetProfileListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetProfileListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileListReplyMultiError) AllErrors() []error { return m }
+
+// GetProfileListReplyValidationError is the validation error returned by
+// GetProfileListReply.Validate if the designated constraints aren't met.
+type GetProfileListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileListReplyValidationError) ErrorName() string {
+	return "GetProfileListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileListReplyValidationError{}
+
+// Validate checks the field values on GetProfileUpdateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileUpdateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileUpdateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileUpdateReqMultiError, or nil if none found.
+func (m *GetProfileUpdateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileUpdateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetProfileUpdateReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetProfileUpdateReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProfileUpdateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileUpdateReqMultiError is an error wrapping multiple validation
+// errors returned by GetProfileUpdateReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetProfileUpdateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileUpdateReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileUpdateReqMultiError) AllErrors() []error { return m }
+
+// GetProfileUpdateReqValidationError is the validation error returned by
+// GetProfileUpdateReq.Validate if the designated constraints aren't met.
+type GetProfileUpdateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileUpdateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileUpdateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileUpdateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileUpdateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileUpdateReqValidationError) ErrorName() string {
+	return "GetProfileUpdateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileUpdateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileUpdateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileUpdateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileUpdateReqValidationError{}
+
+var _GetProfileUpdateReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetProfileUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileUpdateReplyMultiError, or nil if none found.
+func (m *GetProfileUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Avatar
+
+	// no validation rules for School
+
+	// no validation rules for Company
+
+	// no validation rules for Job
+
+	// no validation rules for Homepage
+
+	// no validation rules for Introduce
+
+	// no validation rules for Github
+
+	// no validation rules for Gitee
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return GetProfileUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by GetProfileUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetProfileUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileUpdateReplyMultiError) AllErrors() []error { return m }
+
+// GetProfileUpdateReplyValidationError is the validation error returned by
+// GetProfileUpdateReply.Validate if the designated constraints aren't met.
+type GetProfileUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileUpdateReplyValidationError) ErrorName() string {
+	return "GetProfileUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileUpdateReplyValidationError{}
+
+// Validate checks the field values on GetUserFollowReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserFollowReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserFollowReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserFollowReqMultiError, or nil if none found.
+func (m *GetUserFollowReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserFollowReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserFollowReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserFollowReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetUserFollowReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := GetUserFollowReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserFollowReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserFollowReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserFollowReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserFollowReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserFollowReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserFollowReqMultiError) AllErrors() []error { return m }
+
+// GetUserFollowReqValidationError is the validation error returned by
+// GetUserFollowReq.Validate if the designated constraints aren't met.
+type GetUserFollowReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserFollowReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserFollowReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserFollowReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserFollowReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserFollowReqValidationError) ErrorName() string { return "GetUserFollowReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserFollowReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserFollowReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserFollowReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserFollowReqValidationError{}
+
+var _GetUserFollowReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _GetUserFollowReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserFollowReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserFollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserFollowReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserFollowReplyMultiError, or nil if none found.
+func (m *GetUserFollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserFollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Follow
+
+	// no validation rules for Requested
+
+	if len(errors) > 0 {
+		return GetUserFollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserFollowReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserFollowReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserFollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserFollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserFollowReplyMultiError) AllErrors() []error { return m }
+
+// GetUserFollowReplyValidationError is the validation error returned by
+// GetUserFollowReply.Validate if the designated constraints aren't met.
+type GetUserFollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserFollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserFollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserFollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserFollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserFollowReplyValidationError) ErrorName() string {
+	return "GetUserFollowReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserFollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserFollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserFollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserFollowReplyValidationError{}
+
+// Validate checks the field values on GetFollowListReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowListReqMultiError, or nil if none found.
+func (m *GetFollowListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetFollowListReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetFollowListReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFollowListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowListReqMultiError is an error wrapping multiple validation errors
+// returned by GetFollowListReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetFollowListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowListReqMultiError) AllErrors() []error { return m }
+
+// GetFollowListReqValidationError is the validation error returned by
+// GetFollowListReq.Validate if the designated constraints aren't met.
+type GetFollowListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowListReqValidationError) ErrorName() string { return "GetFollowListReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFollowListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowListReqValidationError{}
+
+var _GetFollowListReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetFollowListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowListReplyMultiError, or nil if none found.
+func (m *GetFollowListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollow() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFollowListReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFollowListReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFollowListReplyValidationError{
+					field:  fmt.Sprintf("Follow[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFollowListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowListReplyMultiError is an error wrapping multiple validation errors
+// returned by GetFollowListReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetFollowListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowListReplyMultiError) AllErrors() []error { return m }
+
+// GetFollowListReplyValidationError is the validation error returned by
+// GetFollowListReply.Validate if the designated constraints aren't met.
+type GetFollowListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowListReplyValidationError) ErrorName() string {
+	return "GetFollowListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowListReplyValidationError{}
+
+// Validate checks the field values on GetFollowListCountReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowListCountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowListCountReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowListCountReqMultiError, or nil if none found.
+func (m *GetFollowListCountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowListCountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetFollowListCountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetFollowListCountReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFollowListCountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowListCountReqMultiError is an error wrapping multiple validation
+// errors returned by GetFollowListCountReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowListCountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowListCountReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowListCountReqMultiError) AllErrors() []error { return m }
+
+// GetFollowListCountReqValidationError is the validation error returned by
+// GetFollowListCountReq.Validate if the designated constraints aren't met.
+type GetFollowListCountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowListCountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowListCountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowListCountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowListCountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowListCountReqValidationError) ErrorName() string {
+	return "GetFollowListCountReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowListCountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowListCountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowListCountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowListCountReqValidationError{}
+
+var _GetFollowListCountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetFollowListCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowListCountReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowListCountReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowListCountReplyMultiError, or nil if none found.
+func (m *GetFollowListCountReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowListCountReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetFollowListCountReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowListCountReplyMultiError is an error wrapping multiple validation
+// errors returned by GetFollowListCountReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowListCountReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowListCountReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowListCountReplyMultiError) AllErrors() []error { return m }
+
+// GetFollowListCountReplyValidationError is the validation error returned by
+// GetFollowListCountReply.Validate if the designated constraints aren't met.
+type GetFollowListCountReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowListCountReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowListCountReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowListCountReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowListCountReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowListCountReplyValidationError) ErrorName() string {
+	return "GetFollowListCountReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowListCountReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowListCountReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowListCountReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowListCountReplyValidationError{}
+
+// Validate checks the field values on GetFollowedListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowedListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowedListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowedListReqMultiError, or nil if none found.
+func (m *GetFollowedListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowedListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetFollowedListReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetFollowedListReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFollowedListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowedListReqMultiError is an error wrapping multiple validation errors
+// returned by GetFollowedListReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetFollowedListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowedListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowedListReqMultiError) AllErrors() []error { return m }
+
+// GetFollowedListReqValidationError is the validation error returned by
+// GetFollowedListReq.Validate if the designated constraints aren't met.
+type GetFollowedListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowedListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowedListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowedListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowedListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowedListReqValidationError) ErrorName() string {
+	return "GetFollowedListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowedListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowedListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowedListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowedListReqValidationError{}
+
+var _GetFollowedListReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetFollowedListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowedListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowedListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowedListReplyMultiError, or nil if none found.
+func (m *GetFollowedListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowedListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollow() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFollowedListReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFollowedListReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFollowedListReplyValidationError{
+					field:  fmt.Sprintf("Follow[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFollowedListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowedListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetFollowedListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowedListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowedListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowedListReplyMultiError) AllErrors() []error { return m }
+
+// GetFollowedListReplyValidationError is the validation error returned by
+// GetFollowedListReply.Validate if the designated constraints aren't met.
+type GetFollowedListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowedListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowedListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowedListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowedListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowedListReplyValidationError) ErrorName() string {
+	return "GetFollowedListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowedListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowedListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowedListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowedListReplyValidationError{}
+
+// Validate checks the field values on GetFollowedListCountReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowedListCountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowedListCountReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowedListCountReqMultiError, or nil if none found.
+func (m *GetFollowedListCountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowedListCountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetFollowedListCountReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetFollowedListCountReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFollowedListCountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowedListCountReqMultiError is an error wrapping multiple validation
+// errors returned by GetFollowedListCountReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowedListCountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowedListCountReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowedListCountReqMultiError) AllErrors() []error { return m }
+
+// GetFollowedListCountReqValidationError is the validation error returned by
+// GetFollowedListCountReq.Validate if the designated constraints aren't met.
+type GetFollowedListCountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowedListCountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowedListCountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowedListCountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowedListCountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowedListCountReqValidationError) ErrorName() string {
+	return "GetFollowedListCountReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowedListCountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowedListCountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowedListCountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowedListCountReqValidationError{}
+
+var _GetFollowedListCountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetFollowedListCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowedListCountReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowedListCountReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowedListCountReplyMultiError, or nil if none found.
+func (m *GetFollowedListCountReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowedListCountReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetFollowedListCountReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowedListCountReplyMultiError is an error wrapping multiple validation
+// errors returned by GetFollowedListCountReply.ValidateAll() if the
+// designated constraints aren't met.
+type GetFollowedListCountReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowedListCountReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowedListCountReplyMultiError) AllErrors() []error { return m }
+
+// GetFollowedListCountReplyValidationError is the validation error returned by
+// GetFollowedListCountReply.Validate if the designated constraints aren't met.
+type GetFollowedListCountReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowedListCountReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowedListCountReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowedListCountReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowedListCountReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowedListCountReplyValidationError) ErrorName() string {
+	return "GetFollowedListCountReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowedListCountReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowedListCountReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowedListCountReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowedListCountReplyValidationError{}
+
+// Validate checks the field values on GetUserFollowsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserFollowsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserFollowsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserFollowsReqMultiError, or nil if none found.
+func (m *GetUserFollowsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserFollowsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserFollowsReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserFollowsReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserFollowsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserFollowsReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserFollowsReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserFollowsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserFollowsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserFollowsReqMultiError) AllErrors() []error { return m }
+
+// GetUserFollowsReqValidationError is the validation error returned by
+// GetUserFollowsReq.Validate if the designated constraints aren't met.
+type GetUserFollowsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserFollowsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserFollowsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserFollowsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserFollowsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserFollowsReqValidationError) ErrorName() string {
+	return "GetUserFollowsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserFollowsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserFollowsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserFollowsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserFollowsReqValidationError{}
+
+var _GetUserFollowsReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserFollowsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserFollowsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserFollowsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserFollowsReplyMultiError, or nil if none found.
+func (m *GetUserFollowsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserFollowsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Follows
+
+	if len(errors) > 0 {
+		return GetUserFollowsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserFollowsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserFollowsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserFollowsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserFollowsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserFollowsReplyMultiError) AllErrors() []error { return m }
+
+// GetUserFollowsReplyValidationError is the validation error returned by
+// GetUserFollowsReply.Validate if the designated constraints aren't met.
+type GetUserFollowsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserFollowsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserFollowsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserFollowsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserFollowsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserFollowsReplyValidationError) ErrorName() string {
+	return "GetUserFollowsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserFollowsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserFollowsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserFollowsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserFollowsReplyValidationError{}
+
+// Validate checks the field values on GetUserBlockReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBlockReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserBlockReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBlockReqMultiError, or nil if none found.
+func (m *GetUserBlockReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserBlockReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserBlockReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserBlockReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetUserBlockReq_UserUuid_Pattern.MatchString(m.GetUserUuid()) {
+		err := GetUserBlockReqValidationError{
+			field:  "UserUuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserBlockReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserBlockReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserBlockReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserBlockReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserBlockReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserBlockReqMultiError) AllErrors() []error { return m }
+
+// GetUserBlockReqValidationError is the validation error returned by
+// GetUserBlockReq.Validate if the designated constraints aren't met.
+type GetUserBlockReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserBlockReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserBlockReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserBlockReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserBlockReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserBlockReqValidationError) ErrorName() string { return "GetUserBlockReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserBlockReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserBlockReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserBlockReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserBlockReqValidationError{}
+
+var _GetUserBlockReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+var _GetUserBlockReq_UserUuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserBlockReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBlockReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserBlockReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBlockReplyMultiError, or nil if none found.
+func (m *GetUserBlockReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserBlockReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Blocked
+
+	// no validation rules for Muted
+
+	if len(errors) > 0 {
+		return GetUserBlockReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserBlockReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserBlockReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserBlockReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserBlockReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserBlockReplyMultiError) AllErrors() []error { return m }
+
+// GetUserBlockReplyValidationError is the validation error returned by
+// GetUserBlockReply.Validate if the designated constraints aren't met.
+type GetUserBlockReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserBlockReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserBlockReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserBlockReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserBlockReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserBlockReplyValidationError) ErrorName() string {
+	return "GetUserBlockReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserBlockReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserBlockReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserBlockReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserBlockReplyValidationError{}
+
+// Validate checks the field values on GetUserBlocksReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBlocksReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserBlocksReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBlocksReqMultiError, or nil if none found.
+func (m *GetUserBlocksReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserBlocksReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserBlocksReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserBlocksReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserBlocksReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserBlocksReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserBlocksReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserBlocksReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserBlocksReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserBlocksReqMultiError) AllErrors() []error { return m }
+
+// GetUserBlocksReqValidationError is the validation error returned by
+// GetUserBlocksReq.Validate if the designated constraints aren't met.
+type GetUserBlocksReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserBlocksReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserBlocksReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserBlocksReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserBlocksReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserBlocksReqValidationError) ErrorName() string { return "GetUserBlocksReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserBlocksReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserBlocksReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserBlocksReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserBlocksReqValidationError{}
+
+var _GetUserBlocksReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserBlocksReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBlocksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserBlocksReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBlocksReplyMultiError, or nil if none found.
+func (m *GetUserBlocksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserBlocksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Blocked
+
+	// no validation rules for Muted
+
+	if len(errors) > 0 {
+		return GetUserBlocksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserBlocksReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserBlocksReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserBlocksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserBlocksReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserBlocksReplyMultiError) AllErrors() []error { return m }
+
+// GetUserBlocksReplyValidationError is the validation error returned by
+// GetUserBlocksReply.Validate if the designated constraints aren't met.
+type GetUserBlocksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserBlocksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserBlocksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserBlocksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserBlocksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserBlocksReplyValidationError) ErrorName() string {
+	return "GetUserBlocksReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserBlocksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserBlocksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserBlocksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserBlocksReplyValidationError{}
+
+// Validate checks the field values on GetFollowRecommendationsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowRecommendationsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowRecommendationsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowRecommendationsReqMultiError, or nil if none found.
+func (m *GetFollowRecommendationsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowRecommendationsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetFollowRecommendationsReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetFollowRecommendationsReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFollowRecommendationsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowRecommendationsReqMultiError is an error wrapping multiple
+// validation errors returned by GetFollowRecommendationsReq.ValidateAll() if
+// the designated constraints aren't met.
+type GetFollowRecommendationsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowRecommendationsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowRecommendationsReqMultiError) AllErrors() []error { return m }
+
+// GetFollowRecommendationsReqValidationError is the validation error returned
+// by GetFollowRecommendationsReq.Validate if the designated constraints
+// aren't met.
+type GetFollowRecommendationsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowRecommendationsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowRecommendationsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowRecommendationsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowRecommendationsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowRecommendationsReqValidationError) ErrorName() string {
+	return "GetFollowRecommendationsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowRecommendationsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowRecommendationsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowRecommendationsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowRecommendationsReqValidationError{}
+
+var _GetFollowRecommendationsReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetFollowRecommendationsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowRecommendationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowRecommendationsReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetFollowRecommendationsReplyMultiError, or nil if none found.
+func (m *GetFollowRecommendationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowRecommendationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollow() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFollowRecommendationsReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFollowRecommendationsReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFollowRecommendationsReplyValidationError{
+					field:  fmt.Sprintf("Follow[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFollowRecommendationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowRecommendationsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetFollowRecommendationsReply.ValidateAll()
+// if the designated constraints aren't met.
+type GetFollowRecommendationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowRecommendationsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowRecommendationsReplyMultiError) AllErrors() []error { return m }
+
+// GetFollowRecommendationsReplyValidationError is the validation error
+// returned by GetFollowRecommendationsReply.Validate if the designated
+// constraints aren't met.
+type GetFollowRecommendationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowRecommendationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowRecommendationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowRecommendationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowRecommendationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowRecommendationsReplyValidationError) ErrorName() string {
+	return "GetFollowRecommendationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowRecommendationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowRecommendationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowRecommendationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowRecommendationsReplyValidationError{}
+
+// Validate checks the field values on GetFollowRequestsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowRequestsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowRequestsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowRequestsReqMultiError, or nil if none found.
+func (m *GetFollowRequestsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowRequestsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetFollowRequestsReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetFollowRequestsReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFollowRequestsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowRequestsReqMultiError is an error wrapping multiple validation
+// errors returned by GetFollowRequestsReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowRequestsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowRequestsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowRequestsReqMultiError) AllErrors() []error { return m }
+
+// GetFollowRequestsReqValidationError is the validation error returned by
+// GetFollowRequestsReq.Validate if the designated constraints aren't met.
+type GetFollowRequestsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowRequestsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowRequestsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowRequestsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowRequestsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowRequestsReqValidationError) ErrorName() string {
+	return "GetFollowRequestsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowRequestsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowRequestsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowRequestsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowRequestsReqValidationError{}
+
+var _GetFollowRequestsReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetFollowRequestsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFollowRequestsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFollowRequestsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFollowRequestsReplyMultiError, or nil if none found.
+func (m *GetFollowRequestsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFollowRequestsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollow() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFollowRequestsReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFollowRequestsReplyValidationError{
+						field:  fmt.Sprintf("Follow[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFollowRequestsReplyValidationError{
+					field:  fmt.Sprintf("Follow[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFollowRequestsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFollowRequestsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetFollowRequestsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowRequestsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFollowRequestsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFollowRequestsReplyMultiError) AllErrors() []error { return m }
+
+// GetFollowRequestsReplyValidationError is the validation error returned by
+// GetFollowRequestsReply.Validate if the designated constraints aren't met.
+type GetFollowRequestsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFollowRequestsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFollowRequestsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFollowRequestsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFollowRequestsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFollowRequestsReplyValidationError) ErrorName() string {
+	return "GetFollowRequestsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFollowRequestsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFollowRequestsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFollowRequestsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFollowRequestsReplyValidationError{}
+
+// Validate checks the field values on GetProfileVisibleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileVisibleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileVisibleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileVisibleReqMultiError, or nil if none found.
+func (m *GetProfileVisibleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileVisibleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetProfileVisibleReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetProfileVisibleReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserUuid()) > 20 {
+		err := GetProfileVisibleReqValidationError{
+			field:  "UserUuid",
+			reason: "value length must be at most 20 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProfileVisibleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileVisibleReqMultiError is an error wrapping multiple validation
+// errors returned by GetProfileVisibleReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetProfileVisibleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileVisibleReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileVisibleReqMultiError) AllErrors() []error { return m }
+
+// GetProfileVisibleReqValidationError is the validation error returned by
+// GetProfileVisibleReq.Validate if the designated constraints aren't met.
+type GetProfileVisibleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileVisibleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileVisibleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileVisibleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileVisibleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileVisibleReqValidationError) ErrorName() string {
+	return "GetProfileVisibleReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileVisibleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileVisibleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileVisibleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileVisibleReqValidationError{}
+
+var _GetProfileVisibleReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetProfileVisibleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileVisibleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileVisibleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileVisibleReplyMultiError, or nil if none found.
+func (m *GetProfileVisibleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileVisibleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Visible
+
+	if len(errors) > 0 {
+		return GetProfileVisibleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileVisibleReplyMultiError is an error wrapping multiple validation
+// errors returned by GetProfileVisibleReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetProfileVisibleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileVisibleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileVisibleReplyMultiError) AllErrors() []error { return m }
+
+// GetProfileVisibleReplyValidationError is the validation error returned by
+// GetProfileVisibleReply.Validate if the designated constraints aren't met.
+type GetProfileVisibleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileVisibleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileVisibleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileVisibleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileVisibleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileVisibleReplyValidationError) ErrorName() string {
+	return "GetProfileVisibleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileVisibleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileVisibleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileVisibleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileVisibleReplyValidationError{}
+
+// Validate checks the field values on GetUserHandleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserHandleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserHandleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserHandleReqMultiError, or nil if none found.
+func (m *GetUserHandleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserHandleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserHandleReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserHandleReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserHandleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserHandleReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserHandleReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserHandleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserHandleReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserHandleReqMultiError) AllErrors() []error { return m }
+
+// GetUserHandleReqValidationError is the validation error returned by
+// GetUserHandleReq.Validate if the designated constraints aren't met.
+type GetUserHandleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserHandleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserHandleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserHandleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserHandleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserHandleReqValidationError) ErrorName() string { return "GetUserHandleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserHandleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserHandleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserHandleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserHandleReqValidationError{}
+
+var _GetUserHandleReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserHandleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserHandleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserHandleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserHandleReplyMultiError, or nil if none found.
+func (m *GetUserHandleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserHandleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Handle
+
+	// no validation rules for Changeable
+
+	if len(errors) > 0 {
+		return GetUserHandleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserHandleReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserHandleReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserHandleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserHandleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserHandleReplyMultiError) AllErrors() []error { return m }
+
+// GetUserHandleReplyValidationError is the validation error returned by
+// GetUserHandleReply.Validate if the designated constraints aren't met.
+type GetUserHandleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserHandleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserHandleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserHandleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserHandleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserHandleReplyValidationError) ErrorName() string {
+	return "GetUserHandleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserHandleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserHandleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserHandleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserHandleReplyValidationError{}
+
+// Validate checks the field values on GetUserByHandleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByHandleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByHandleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByHandleReqMultiError, or nil if none found.
+func (m *GetUserByHandleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByHandleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserByHandleReq_Handle_Pattern.MatchString(m.GetHandle()) {
+		err := GetUserByHandleReqValidationError{
+			field:  "Handle",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_]{3,20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserByHandleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByHandleReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserByHandleReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserByHandleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByHandleReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByHandleReqMultiError) AllErrors() []error { return m }
+
+// GetUserByHandleReqValidationError is the validation error returned by
+// GetUserByHandleReq.Validate if the designated constraints aren't met.
+type GetUserByHandleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByHandleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByHandleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByHandleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByHandleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByHandleReqValidationError) ErrorName() string {
+	return "GetUserByHandleReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByHandleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByHandleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByHandleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByHandleReqValidationError{}
+
+var _GetUserByHandleReq_Handle_Pattern = regexp.MustCompile("^[a-zA-Z0-9_]{3,20}$")
+
+// Validate checks the field values on GetUserByHandleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByHandleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByHandleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByHandleReplyMultiError, or nil if none found.
+func (m *GetUserByHandleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByHandleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuid
+
+	if len(errors) > 0 {
+		return GetUserByHandleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByHandleReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserByHandleReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserByHandleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByHandleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByHandleReplyMultiError) AllErrors() []error { return m }
+
+// GetUserByHandleReplyValidationError is the validation error returned by
+// GetUserByHandleReply.Validate if the designated constraints aren't met.
+type GetUserByHandleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByHandleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByHandleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByHandleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByHandleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByHandleReplyValidationError) ErrorName() string {
+	return "GetUserByHandleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByHandleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByHandleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByHandleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByHandleReplyValidationError{}
+
+// Validate checks the field values on GetHandleUuidsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetHandleUuidsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHandleUuidsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHandleUuidsReqMultiError, or nil if none found.
+func (m *GetHandleUuidsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHandleUuidsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHandles() {
+		_, _ = idx, item
+
+		if !_GetHandleUuidsReq_Handles_Pattern.MatchString(item) {
+			err := GetHandleUuidsReqValidationError{
+				field:  fmt.Sprintf("Handles[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_]{3,20}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetHandleUuidsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHandleUuidsReqMultiError is an error wrapping multiple validation errors
+// returned by GetHandleUuidsReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetHandleUuidsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHandleUuidsReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHandleUuidsReqMultiError) AllErrors() []error { return m }
+
+// GetHandleUuidsReqValidationError is the validation error returned by
+// GetHandleUuidsReq.Validate if the designated constraints aren't met.
+type GetHandleUuidsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHandleUuidsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHandleUuidsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHandleUuidsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHandleUuidsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHandleUuidsReqValidationError) ErrorName() string {
+	return "GetHandleUuidsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHandleUuidsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHandleUuidsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHandleUuidsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHandleUuidsReqValidationError{}
+
+var _GetHandleUuidsReq_Handles_Pattern = regexp.MustCompile("^[a-zA-Z0-9_]{3,20}$")
+
+// Validate checks the field values on GetHandleUuidsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetHandleUuidsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHandleUuidsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHandleUuidsReplyMultiError, or nil if none found.
+func (m *GetHandleUuidsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHandleUuidsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uuids
+
+	if len(errors) > 0 {
+		return GetHandleUuidsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHandleUuidsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetHandleUuidsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetHandleUuidsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHandleUuidsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHandleUuidsReplyMultiError) AllErrors() []error { return m }
+
+// GetHandleUuidsReplyValidationError is the validation error returned by
+// GetHandleUuidsReply.Validate if the designated constraints aren't met.
+type GetHandleUuidsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHandleUuidsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHandleUuidsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHandleUuidsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHandleUuidsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHandleUuidsReplyValidationError) ErrorName() string {
+	return "GetHandleUuidsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHandleUuidsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHandleUuidsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHandleUuidsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHandleUuidsReplyValidationError{}
+
+// Validate checks the field values on GetUserRoleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRoleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRoleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRoleReqMultiError,
+// or nil if none found.
+func (m *GetUserRoleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRoleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetUserRoleReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserRoleReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRoleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRoleReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserRoleReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRoleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRoleReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRoleReqMultiError) AllErrors() []error { return m }
+
+// GetUserRoleReqValidationError is the validation error returned by
+// GetUserRoleReq.Validate if the designated constraints aren't met.
+type GetUserRoleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRoleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRoleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRoleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRoleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRoleReqValidationError) ErrorName() string { return "GetUserRoleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRoleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRoleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRoleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRoleReqValidationError{}
+
+var _GetUserRoleReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserRoleReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserRoleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRoleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserRoleReplyMultiError, or nil if none found.
+func (m *GetUserRoleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRoleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return GetUserRoleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRoleReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserRoleReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRoleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRoleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRoleReplyMultiError) AllErrors() []error { return m }
+
+// GetUserRoleReplyValidationError is the validation error returned by
+// GetUserRoleReply.Validate if the designated constraints aren't met.
+type GetUserRoleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRoleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRoleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRoleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRoleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRoleReplyValidationError) ErrorName() string { return "GetUserRoleReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRoleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRoleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRoleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRoleReplyValidationError{}
+
+// Validate checks the field values on GetAuditLogListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuditLogListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuditLogListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuditLogListReqMultiError, or nil if none found.
+func (m *GetAuditLogListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuditLogListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetAuditLogListReq_Operator_Pattern.MatchString(m.GetOperator()) {
+		err := GetAuditLogListReqValidationError{
+			field:  "Operator",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9]{20})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAuditLogListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuditLogListReqMultiError is an error wrapping multiple validation errors
+// returned by GetAuditLogListReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuditLogListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuditLogListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuditLogListReqMultiError) AllErrors() []error { return m }
+
+// GetAuditLogListReqValidationError is the validation error returned by
+// GetAuditLogListReq.Validate if the designated constraints aren't met.
+type GetAuditLogListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuditLogListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuditLogListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuditLogListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuditLogListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuditLogListReqValidationError) ErrorName() string {
+	return "GetAuditLogListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuditLogListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuditLogListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuditLogListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuditLogListReqValidationError{}
+
+var _GetAuditLogListReq_Operator_Pattern = regexp.MustCompile("^([a-zA-Z0-9]{20})?$")
+
+// Validate checks the field values on GetAuditLogListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuditLogListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuditLogListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuditLogListReplyMultiError, or nil if none found.
+func (m *GetAuditLogListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuditLogListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAuditLogListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAuditLogListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAuditLogListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAuditLogListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuditLogListReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAuditLogListReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuditLogListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuditLogListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuditLogListReplyMultiError) AllErrors() []error { return m }
+
+// GetAuditLogListReplyValidationError is the validation error returned by
+// GetAuditLogListReply.Validate if the designated constraints aren't met.
+type GetAuditLogListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuditLogListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuditLogListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuditLogListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuditLogListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuditLogListReplyValidationError) ErrorName() string {
+	return "GetAuditLogListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuditLogListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuditLogListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuditLogListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuditLogListReplyValidationError{}
+
+// Validate checks the field values on GetUserSearchReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserSearchReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserSearchReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserSearchReqMultiError, or nil if none found.
+func (m *GetUserSearchReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserSearchReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if utf8.RuneCountInString(m.GetSearch()) > 100 {
+		err := GetUserSearchReqValidationError{
+			field:  "Search",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserSearchReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserSearchReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserSearchReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserSearchReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserSearchReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserSearchReqMultiError) AllErrors() []error { return m }
+
+// GetUserSearchReqValidationError is the validation error returned by
+// GetUserSearchReq.Validate if the designated constraints aren't met.
+type GetUserSearchReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserSearchReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserSearchReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserSearchReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserSearchReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserSearchReqValidationError) ErrorName() string { return "GetUserSearchReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserSearchReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserSearchReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserSearchReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserSearchReqValidationError{}
+
+// Validate checks the field values on GetUserSearchReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserSearchReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserSearchReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserSearchReplyMultiError, or nil if none found.
+func (m *GetUserSearchReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserSearchReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserSearchReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserSearchReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserSearchReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return GetUserSearchReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserSearchReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserSearchReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserSearchReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserSearchReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserSearchReplyMultiError) AllErrors() []error { return m }
+
+// GetUserSearchReplyValidationError is the validation error returned by
+// GetUserSearchReply.Validate if the designated constraints aren't met.
+type GetUserSearchReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserSearchReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserSearchReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserSearchReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserSearchReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserSearchReplyValidationError) ErrorName() string {
+	return "GetUserSearchReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserSearchReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserSearchReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserSearchReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserSearchReplyValidationError{}
+
+// Validate checks the field values on GetAvatarReviewReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAvatarReviewReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAvatarReviewReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAvatarReviewReqMultiError, or nil if none found.
+func (m *GetAvatarReviewReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAvatarReviewReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetAvatarReviewReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetAvatarReviewReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAvatarReviewReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAvatarReviewReqMultiError is an error wrapping multiple validation errors
+// returned by GetAvatarReviewReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetAvatarReviewReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAvatarReviewReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAvatarReviewReqMultiError) AllErrors() []error { return m }
+
+// GetAvatarReviewReqValidationError is the validation error returned by
+// GetAvatarReviewReq.Validate if the designated constraints aren't met.
+type GetAvatarReviewReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAvatarReviewReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAvatarReviewReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAvatarReviewReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAvatarReviewReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAvatarReviewReqValidationError) ErrorName() string {
+	return "GetAvatarReviewReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAvatarReviewReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAvatarReviewReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAvatarReviewReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAvatarReviewReqValidationError{}
+
+var _GetAvatarReviewReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetAvatarReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAvatarReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAvatarReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAvatarReviewReplyMultiError, or nil if none found.
+func (m *GetAvatarReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAvatarReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReview() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAvatarReviewReplyValidationError{
+						field:  fmt.Sprintf("Review[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAvatarReviewReplyValidationError{
+						field:  fmt.Sprintf("Review[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAvatarReviewReplyValidationError{
+					field:  fmt.Sprintf("Review[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAvatarReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAvatarReviewReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAvatarReviewReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAvatarReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAvatarReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAvatarReviewReplyMultiError) AllErrors() []error { return m }
+
+// GetAvatarReviewReplyValidationError is the validation error returned by
+// GetAvatarReviewReply.Validate if the designated constraints aren't met.
+type GetAvatarReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAvatarReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAvatarReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAvatarReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAvatarReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAvatarReviewReplyValidationError) ErrorName() string {
+	return "GetAvatarReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAvatarReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAvatarReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAvatarReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAvatarReviewReplyValidationError{}
+
+// Validate checks the field values on GetCoverReviewReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCoverReviewReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCoverReviewReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCoverReviewReqMultiError, or nil if none found.
+func (m *GetCoverReviewReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCoverReviewReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if !_GetCoverReviewReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetCoverReviewReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCoverReviewReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCoverReviewReqMultiError is an error wrapping multiple validation errors
+// returned by GetCoverReviewReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetCoverReviewReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCoverReviewReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCoverReviewReqMultiError) AllErrors() []error { return m }
+
+// GetCoverReviewReqValidationError is the validation error returned by
+// GetCoverReviewReq.Validate if the designated constraints aren't met.
+type GetCoverReviewReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCoverReviewReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCoverReviewReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCoverReviewReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCoverReviewReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCoverReviewReqValidationError) ErrorName() string {
+	return "GetCoverReviewReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCoverReviewReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCoverReviewReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCoverReviewReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCoverReviewReqValidationError{}
+
+var _GetCoverReviewReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetCoverReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCoverReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCoverReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCoverReviewReplyMultiError, or nil if none found.
+func (m *GetCoverReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCoverReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReview() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCoverReviewReplyValidationError{
+						field:  fmt.Sprintf("Review[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCoverReviewReplyValidationError{
+						field:  fmt.Sprintf("Review[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCoverReviewReplyValidationError{
+					field:  fmt.Sprintf("Review[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCoverReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCoverReviewReplyMultiError is an error wrapping multiple validation
+// errors returned by GetCoverReviewReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetCoverReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCoverReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCoverReviewReplyMultiError) AllErrors() []error { return m }
+
+// GetCoverReviewReplyValidationError is the validation error returned by
+// GetCoverReviewReply.Validate if the designated constraints aren't met.
+type GetCoverReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCoverReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCoverReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCoverReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCoverReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCoverReviewReplyValidationError) ErrorName() string {
+	return "GetCoverReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCoverReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCoverReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCoverReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCoverReviewReplyValidationError{}
+
+// Validate checks the field values on SetProfileUpdateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProfileUpdateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProfileUpdateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProfileUpdateReqMultiError, or nil if none found.
+func (m *SetProfileUpdateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProfileUpdateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SetProfileUpdateReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 1 || l > 20 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Username",
+			reason: "value length must be between 1 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSchool()); l < 0 || l > 50 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "School",
+			reason: "value length must be between 0 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCompany()); l < 0 || l > 50 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Company",
+			reason: "value length must be between 0 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetJob()); l < 0 || l > 50 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Job",
+			reason: "value length must be between 0 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetHomepage()); l < 0 || l > 100 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Homepage",
+			reason: "value length must be between 0 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetGithub()); l < 0 || l > 100 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Github",
+			reason: "value length must be between 0 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetGitee()); l < 0 || l > 100 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Gitee",
+			reason: "value length must be between 0 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIntroduce()); l < 0 || l > 100 {
+		err := SetProfileUpdateReqValidationError{
+			field:  "Introduce",
+			reason: "value length must be between 0 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetProfileUpdateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProfileUpdateReqMultiError is an error wrapping multiple validation
+// errors returned by SetProfileUpdateReq.ValidateAll() if the designated
+// constraints aren't met.
+type SetProfileUpdateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProfileUpdateReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProfileUpdateReqMultiError) AllErrors() []error { return m }
+
+// SetProfileUpdateReqValidationError is the validation error returned by
+// SetProfileUpdateReq.Validate if the designated constraints aren't met.
+type SetProfileUpdateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProfileUpdateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProfileUpdateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProfileUpdateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProfileUpdateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProfileUpdateReqValidationError) ErrorName() string {
+	return "SetProfileUpdateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProfileUpdateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProfileUpdateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProfileUpdateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProfileUpdateReqValidationError{}
+
+var _SetProfileUpdateReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on ProfileReviewPassReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileReviewPassReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileReviewPassReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileReviewPassReqMultiError, or nil if none found.
+func (m *ProfileReviewPassReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileReviewPassReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ProfileReviewPassReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ProfileReviewPassReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUpdate()) > 20 {
+		err := ProfileReviewPassReqValidationError{
+			field:  "Update",
+			reason: "value length must be at most 20 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProfileReviewPassReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowedListCountReqMultiError is an error wrapping multiple validation
-// errors returned by GetFollowedListCountReq.ValidateAll() if the designated
+// ProfileReviewPassReqMultiError is an error wrapping multiple validation
+// errors returned by ProfileReviewPassReq.ValidateAll() if the designated
 // constraints aren't met.
-type GetFollowedListCountReqMultiError []error
+type ProfileReviewPassReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowedListCountReqMultiError) Error() string {
+func (m ProfileReviewPassReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6165,11 +12455,11 @@ func (m GetFollowedListCountReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowedListCountReqMultiError) AllErrors() []error { return m }
+func (m ProfileReviewPassReqMultiError) AllErrors() []error { return m }
 
-// GetFollowedListCountReqValidationError is the validation error returned by
-// GetFollowedListCountReq.Validate if the designated constraints aren't met.
-type GetFollowedListCountReqValidationError struct {
+// ProfileReviewPassReqValidationError is the validation error returned by
+// ProfileReviewPassReq.Validate if the designated constraints aren't met.
+type ProfileReviewPassReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6177,24 +12467,24 @@ type GetFollowedListCountReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowedListCountReqValidationError) Field() string { return e.field }
+func (e ProfileReviewPassReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowedListCountReqValidationError) Reason() string { return e.reason }
+func (e ProfileReviewPassReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowedListCountReqValidationError) Cause() error { return e.cause }
+func (e ProfileReviewPassReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowedListCountReqValidationError) Key() bool { return e.key }
+func (e ProfileReviewPassReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowedListCountReqValidationError) ErrorName() string {
-	return "GetFollowedListCountReqValidationError"
+func (e ProfileReviewPassReqValidationError) ErrorName() string {
+	return "ProfileReviewPassReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFollowedListCountReqValidationError) Error() string {
+func (e ProfileReviewPassReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6206,14 +12496,14 @@ func (e GetFollowedListCountReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowedListCountReq.%s: %s%s",
+		"invalid %sProfileReviewPassReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowedListCountReqValidationError{}
+var _ error = ProfileReviewPassReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6221,48 +12511,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowedListCountReqValidationError{}
+} = ProfileReviewPassReqValidationError{}
 
-var _GetFollowedListCountReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _ProfileReviewPassReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetFollowedListCountReply with the rules
+// Validate checks the field values on ProfileReviewNotPassReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowedListCountReply) Validate() error {
+func (m *ProfileReviewNotPassReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowedListCountReply with the
+// ValidateAll checks the field values on ProfileReviewNotPassReq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFollowedListCountReplyMultiError, or nil if none found.
-func (m *GetFollowedListCountReply) ValidateAll() error {
+// ProfileReviewNotPassReqMultiError, or nil if none found.
+func (m *ProfileReviewNotPassReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowedListCountReply) validate(all bool) error {
+func (m *ProfileReviewNotPassReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
+	if !_ProfileReviewNotPassReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ProfileReviewNotPassReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetFollowedListCountReplyMultiError(errors)
+		return ProfileReviewNotPassReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowedListCountReplyMultiError is an error wrapping multiple validation
-// errors returned by GetFollowedListCountReply.ValidateAll() if the
-// designated constraints aren't met.
-type GetFollowedListCountReplyMultiError []error
+// ProfileReviewNotPassReqMultiError is an error wrapping multiple validation
+// errors returned by ProfileReviewNotPassReq.ValidateAll() if the designated
+// constraints aren't met.
+type ProfileReviewNotPassReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowedListCountReplyMultiError) Error() string {
+func (m ProfileReviewNotPassReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6271,11 +12570,11 @@ func (m GetFollowedListCountReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowedListCountReplyMultiError) AllErrors() []error { return m }
+func (m ProfileReviewNotPassReqMultiError) AllErrors() []error { return m }
 
-// GetFollowedListCountReplyValidationError is the validation error returned by
-// GetFollowedListCountReply.Validate if the designated constraints aren't met.
-type GetFollowedListCountReplyValidationError struct {
+// ProfileReviewNotPassReqValidationError is the validation error returned by
+// ProfileReviewNotPassReq.Validate if the designated constraints aren't met.
+type ProfileReviewNotPassReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6283,24 +12582,24 @@ type GetFollowedListCountReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowedListCountReplyValidationError) Field() string { return e.field }
+func (e ProfileReviewNotPassReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowedListCountReplyValidationError) Reason() string { return e.reason }
+func (e ProfileReviewNotPassReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowedListCountReplyValidationError) Cause() error { return e.cause }
+func (e ProfileReviewNotPassReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowedListCountReplyValidationError) Key() bool { return e.key }
+func (e ProfileReviewNotPassReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowedListCountReplyValidationError) ErrorName() string {
-	return "GetFollowedListCountReplyValidationError"
+func (e ProfileReviewNotPassReqValidationError) ErrorName() string {
+	return "ProfileReviewNotPassReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFollowedListCountReplyValidationError) Error() string {
+func (e ProfileReviewNotPassReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6312,14 +12611,14 @@ func (e GetFollowedListCountReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowedListCountReply.%s: %s%s",
+		"invalid %sProfileReviewNotPassReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowedListCountReplyValidationError{}
+var _ error = ProfileReviewNotPassReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6327,32 +12626,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowedListCountReplyValidationError{}
+} = ProfileReviewNotPassReqValidationError{}
 
-// Validate checks the field values on GetUserFollowsReq with the rules defined
+var _ProfileReviewNotPassReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on ExportUserDataReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetUserFollowsReq) Validate() error {
+func (m *ExportUserDataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserFollowsReq with the rules
+// ValidateAll checks the field values on ExportUserDataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserFollowsReqMultiError, or nil if none found.
-func (m *GetUserFollowsReq) ValidateAll() error {
+// ExportUserDataReqMultiError, or nil if none found.
+func (m *ExportUserDataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserFollowsReq) validate(all bool) error {
+func (m *ExportUserDataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetUserFollowsReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetUserFollowsReqValidationError{
+	if !_ExportUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := ExportUserDataReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -6363,19 +12664,19 @@ func (m *GetUserFollowsReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserFollowsReqMultiError(errors)
+		return ExportUserDataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserFollowsReqMultiError is an error wrapping multiple validation errors
-// returned by GetUserFollowsReq.ValidateAll() if the designated constraints
+// ExportUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by ExportUserDataReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetUserFollowsReqMultiError []error
+type ExportUserDataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserFollowsReqMultiError) Error() string {
+func (m ExportUserDataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6384,11 +12685,11 @@ func (m GetUserFollowsReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserFollowsReqMultiError) AllErrors() []error { return m }
+func (m ExportUserDataReqMultiError) AllErrors() []error { return m }
 
-// GetUserFollowsReqValidationError is the validation error returned by
-// GetUserFollowsReq.Validate if the designated constraints aren't met.
-type GetUserFollowsReqValidationError struct {
+// ExportUserDataReqValidationError is the validation error returned by
+// ExportUserDataReq.Validate if the designated constraints aren't met.
+type ExportUserDataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6396,24 +12697,24 @@ type GetUserFollowsReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserFollowsReqValidationError) Field() string { return e.field }
+func (e ExportUserDataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserFollowsReqValidationError) Reason() string { return e.reason }
+func (e ExportUserDataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserFollowsReqValidationError) Cause() error { return e.cause }
+func (e ExportUserDataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserFollowsReqValidationError) Key() bool { return e.key }
+func (e ExportUserDataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserFollowsReqValidationError) ErrorName() string {
-	return "GetUserFollowsReqValidationError"
+func (e ExportUserDataReqValidationError) ErrorName() string {
+	return "ExportUserDataReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserFollowsReqValidationError) Error() string {
+func (e ExportUserDataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6425,14 +12726,14 @@ func (e GetUserFollowsReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserFollowsReq.%s: %s%s",
+		"invalid %sExportUserDataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserFollowsReqValidationError{}
+var _ error = ExportUserDataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6440,48 +12741,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserFollowsReqValidationError{}
+} = ExportUserDataReqValidationError{}
 
-var _GetUserFollowsReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _ExportUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetUserFollowsReply with the rules
+// Validate checks the field values on ExportUserDataReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserFollowsReply) Validate() error {
+func (m *ExportUserDataReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserFollowsReply with the rules
+// ValidateAll checks the field values on ExportUserDataReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserFollowsReplyMultiError, or nil if none found.
-func (m *GetUserFollowsReply) ValidateAll() error {
+// ExportUserDataReplyMultiError, or nil if none found.
+func (m *ExportUserDataReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserFollowsReply) validate(all bool) error {
+func (m *ExportUserDataReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Follows
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetUserFollowsReplyMultiError(errors)
+		return ExportUserDataReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserFollowsReplyMultiError is an error wrapping multiple validation
-// errors returned by GetUserFollowsReply.ValidateAll() if the designated
+// ExportUserDataReplyMultiError is an error wrapping multiple validation
+// errors returned by ExportUserDataReply.ValidateAll() if the designated
 // constraints aren't met.
-type GetUserFollowsReplyMultiError []error
+type ExportUserDataReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserFollowsReplyMultiError) Error() string {
+func (m ExportUserDataReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6490,11 +12791,11 @@ func (m GetUserFollowsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserFollowsReplyMultiError) AllErrors() []error { return m }
+func (m ExportUserDataReplyMultiError) AllErrors() []error { return m }
 
-// GetUserFollowsReplyValidationError is the validation error returned by
-// GetUserFollowsReply.Validate if the designated constraints aren't met.
-type GetUserFollowsReplyValidationError struct {
+// ExportUserDataReplyValidationError is the validation error returned by
+// ExportUserDataReply.Validate if the designated constraints aren't met.
+type ExportUserDataReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6502,24 +12803,24 @@ type GetUserFollowsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserFollowsReplyValidationError) Field() string { return e.field }
+func (e ExportUserDataReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserFollowsReplyValidationError) Reason() string { return e.reason }
+func (e ExportUserDataReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserFollowsReplyValidationError) Cause() error { return e.cause }
+func (e ExportUserDataReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserFollowsReplyValidationError) Key() bool { return e.key }
+func (e ExportUserDataReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserFollowsReplyValidationError) ErrorName() string {
-	return "GetUserFollowsReplyValidationError"
+func (e ExportUserDataReplyValidationError) ErrorName() string {
+	return "ExportUserDataReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserFollowsReplyValidationError) Error() string {
+func (e ExportUserDataReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6531,14 +12832,14 @@ func (e GetUserFollowsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserFollowsReply.%s: %s%s",
+		"invalid %sExportUserDataReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserFollowsReplyValidationError{}
+var _ error = ExportUserDataReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6546,36 +12847,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserFollowsReplyValidationError{}
+} = ExportUserDataReplyValidationError{}
 
-// Validate checks the field values on GetUserSearchReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserSearchReq) Validate() error {
+// Validate checks the field values on GetUserDataExportReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataExportReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserSearchReq with the rules
+// ValidateAll checks the field values on GetUserDataExportReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserSearchReqMultiError, or nil if none found.
-func (m *GetUserSearchReq) ValidateAll() error {
+// GetUserDataExportReqMultiError, or nil if none found.
+func (m *GetUserDataExportReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserSearchReq) validate(all bool) error {
+func (m *GetUserDataExportReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	if utf8.RuneCountInString(m.GetSearch()) > 100 {
-		err := GetUserSearchReqValidationError{
-			field:  "Search",
-			reason: "value length must be at most 100 runes",
+	if !_GetUserDataExportReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserDataExportReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
 		if !all {
 			return err
@@ -6584,19 +12883,19 @@ func (m *GetUserSearchReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserSearchReqMultiError(errors)
+		return GetUserDataExportReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserSearchReqMultiError is an error wrapping multiple validation errors
-// returned by GetUserSearchReq.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserSearchReqMultiError []error
+// GetUserDataExportReqMultiError is an error wrapping multiple validation
+// errors returned by GetUserDataExportReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserDataExportReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserSearchReqMultiError) Error() string {
+func (m GetUserDataExportReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6605,11 +12904,11 @@ func (m GetUserSearchReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserSearchReqMultiError) AllErrors() []error { return m }
+func (m GetUserDataExportReqMultiError) AllErrors() []error { return m }
 
-// GetUserSearchReqValidationError is the validation error returned by
-// GetUserSearchReq.Validate if the designated constraints aren't met.
-type GetUserSearchReqValidationError struct {
+// GetUserDataExportReqValidationError is the validation error returned by
+// GetUserDataExportReq.Validate if the designated constraints aren't met.
+type GetUserDataExportReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6617,22 +12916,24 @@ type GetUserSearchReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserSearchReqValidationError) Field() string { return e.field }
+func (e GetUserDataExportReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserSearchReqValidationError) Reason() string { return e.reason }
+func (e GetUserDataExportReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserSearchReqValidationError) Cause() error { return e.cause }
+func (e GetUserDataExportReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserSearchReqValidationError) Key() bool { return e.key }
+func (e GetUserDataExportReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserSearchReqValidationError) ErrorName() string { return "GetUserSearchReqValidationError" }
+func (e GetUserDataExportReqValidationError) ErrorName() string {
+	return "GetUserDataExportReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserSearchReqValidationError) Error() string {
+func (e GetUserDataExportReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6644,95 +12945,67 @@ func (e GetUserSearchReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserSearchReq.%s: %s%s",
+		"invalid %sGetUserDataExportReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserSearchReqValidationError{}
+var _ error = GetUserDataExportReqValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
 	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetUserSearchReqValidationError{}
-
-// Validate checks the field values on GetUserSearchReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserSearchReply) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetUserSearchReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUserSearchReplyMultiError, or nil if none found.
-func (m *GetUserSearchReply) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetUserSearchReply) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetList() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUserSearchReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUserSearchReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetUserSearchReplyValidationError{
-					field:  fmt.Sprintf("List[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	Cause() error
+	ErrorName() string
+} = GetUserDataExportReqValidationError{}
+
+var _GetUserDataExportReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataExportReply) Validate() error {
+	return m.validate(false)
+}
 
+// ValidateAll checks the field values on GetUserDataExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataExportReplyMultiError, or nil if none found.
+func (m *GetUserDataExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for Total
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Ready
+
+	// no validation rules for Url
 
 	if len(errors) > 0 {
-		return GetUserSearchReplyMultiError(errors)
+		return GetUserDataExportReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserSearchReplyMultiError is an error wrapping multiple validation errors
-// returned by GetUserSearchReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserSearchReplyMultiError []error
+// GetUserDataExportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserDataExportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserDataExportReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserSearchReplyMultiError) Error() string {
+func (m GetUserDataExportReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6741,11 +13014,11 @@ func (m GetUserSearchReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserSearchReplyMultiError) AllErrors() []error { return m }
+func (m GetUserDataExportReplyMultiError) AllErrors() []error { return m }
 
-// GetUserSearchReplyValidationError is the validation error returned by
-// GetUserSearchReply.Validate if the designated constraints aren't met.
-type GetUserSearchReplyValidationError struct {
+// GetUserDataExportReplyValidationError is the validation error returned by
+// GetUserDataExportReply.Validate if the designated constraints aren't met.
+type GetUserDataExportReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6753,24 +13026,24 @@ type GetUserSearchReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserSearchReplyValidationError) Field() string { return e.field }
+func (e GetUserDataExportReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserSearchReplyValidationError) Reason() string { return e.reason }
+func (e GetUserDataExportReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserSearchReplyValidationError) Cause() error { return e.cause }
+func (e GetUserDataExportReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserSearchReplyValidationError) Key() bool { return e.key }
+func (e GetUserDataExportReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserSearchReplyValidationError) ErrorName() string {
-	return "GetUserSearchReplyValidationError"
+func (e GetUserDataExportReplyValidationError) ErrorName() string {
+	return "GetUserDataExportReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserSearchReplyValidationError) Error() string {
+func (e GetUserDataExportReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6782,14 +13055,14 @@ func (e GetUserSearchReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserSearchReply.%s: %s%s",
+		"invalid %sGetUserDataExportReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserSearchReplyValidationError{}
+var _ error = GetUserDataExportReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6797,34 +13070,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserSearchReplyValidationError{}
+} = GetUserDataExportReplyValidationError{}
 
-// Validate checks the field values on GetAvatarReviewReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAvatarReviewReq) Validate() error {
+// Validate checks the field values on PurgeUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserDataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAvatarReviewReq with the rules
+// ValidateAll checks the field values on PurgeUserDataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAvatarReviewReqMultiError, or nil if none found.
-func (m *GetAvatarReviewReq) ValidateAll() error {
+// PurgeUserDataReqMultiError, or nil if none found.
+func (m *PurgeUserDataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAvatarReviewReq) validate(all bool) error {
+func (m *PurgeUserDataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	if !_GetAvatarReviewReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetAvatarReviewReqValidationError{
+	if !_PurgeUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := PurgeUserDataReqValidationError{
 			field:  "Uuid",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
@@ -6835,19 +13106,19 @@ func (m *GetAvatarReviewReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetAvatarReviewReqMultiError(errors)
+		return PurgeUserDataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAvatarReviewReqMultiError is an error wrapping multiple validation errors
-// returned by GetAvatarReviewReq.ValidateAll() if the designated constraints
+// PurgeUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by PurgeUserDataReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetAvatarReviewReqMultiError []error
+type PurgeUserDataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAvatarReviewReqMultiError) Error() string {
+func (m PurgeUserDataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6856,11 +13127,11 @@ func (m GetAvatarReviewReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAvatarReviewReqMultiError) AllErrors() []error { return m }
+func (m PurgeUserDataReqMultiError) AllErrors() []error { return m }
 
-// GetAvatarReviewReqValidationError is the validation error returned by
-// GetAvatarReviewReq.Validate if the designated constraints aren't met.
-type GetAvatarReviewReqValidationError struct {
+// PurgeUserDataReqValidationError is the validation error returned by
+// PurgeUserDataReq.Validate if the designated constraints aren't met.
+type PurgeUserDataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6868,24 +13139,22 @@ type GetAvatarReviewReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAvatarReviewReqValidationError) Field() string { return e.field }
+func (e PurgeUserDataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAvatarReviewReqValidationError) Reason() string { return e.reason }
+func (e PurgeUserDataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAvatarReviewReqValidationError) Cause() error { return e.cause }
+func (e PurgeUserDataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAvatarReviewReqValidationError) Key() bool { return e.key }
+func (e PurgeUserDataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAvatarReviewReqValidationError) ErrorName() string {
-	return "GetAvatarReviewReqValidationError"
-}
+func (e PurgeUserDataReqValidationError) ErrorName() string { return "PurgeUserDataReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAvatarReviewReqValidationError) Error() string {
+func (e PurgeUserDataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6897,14 +13166,14 @@ func (e GetAvatarReviewReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAvatarReviewReq.%s: %s%s",
+		"invalid %sPurgeUserDataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAvatarReviewReqValidationError{}
+var _ error = PurgeUserDataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6912,80 +13181,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAvatarReviewReqValidationError{}
+} = PurgeUserDataReqValidationError{}
 
-var _GetAvatarReviewReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+var _PurgeUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
 
-// Validate checks the field values on GetAvatarReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAvatarReviewReply) Validate() error {
+// Validate checks the field values on GetUserDataReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAvatarReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAvatarReviewReplyMultiError, or nil if none found.
-func (m *GetAvatarReviewReply) ValidateAll() error {
+// ValidateAll checks the field values on GetUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserDataReqMultiError,
+// or nil if none found.
+func (m *GetUserDataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAvatarReviewReply) validate(all bool) error {
+func (m *GetUserDataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReview() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAvatarReviewReplyValidationError{
-						field:  fmt.Sprintf("Review[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAvatarReviewReplyValidationError{
-						field:  fmt.Sprintf("Review[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAvatarReviewReplyValidationError{
-					field:  fmt.Sprintf("Review[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_GetUserDataReq_Uuid_Pattern.MatchString(m.GetUuid()) {
+		err := GetUserDataReqValidationError{
+			field:  "Uuid",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetAvatarReviewReplyMultiError(errors)
+		return GetUserDataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAvatarReviewReplyMultiError is an error wrapping multiple validation
-// errors returned by GetAvatarReviewReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetAvatarReviewReplyMultiError []error
+// GetUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserDataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAvatarReviewReplyMultiError) Error() string {
+func (m GetUserDataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6994,11 +13240,11 @@ func (m GetAvatarReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAvatarReviewReplyMultiError) AllErrors() []error { return m }
+func (m GetUserDataReqMultiError) AllErrors() []error { return m }
 
-// GetAvatarReviewReplyValidationError is the validation error returned by
-// GetAvatarReviewReply.Validate if the designated constraints aren't met.
-type GetAvatarReviewReplyValidationError struct {
+// GetUserDataReqValidationError is the validation error returned by
+// GetUserDataReq.Validate if the designated constraints aren't met.
+type GetUserDataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7006,24 +13252,22 @@ type GetAvatarReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAvatarReviewReplyValidationError) Field() string { return e.field }
+func (e GetUserDataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAvatarReviewReplyValidationError) Reason() string { return e.reason }
+func (e GetUserDataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAvatarReviewReplyValidationError) Cause() error { return e.cause }
+func (e GetUserDataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAvatarReviewReplyValidationError) Key() bool { return e.key }
+func (e GetUserDataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAvatarReviewReplyValidationError) ErrorName() string {
-	return "GetAvatarReviewReplyValidationError"
-}
+func (e GetUserDataReqValidationError) ErrorName() string { return "GetUserDataReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAvatarReviewReplyValidationError) Error() string {
+func (e GetUserDataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7035,14 +13279,14 @@ func (e GetAvatarReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAvatarReviewReply.%s: %s%s",
+		"invalid %sGetUserDataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAvatarReviewReplyValidationError{}
+var _ error = GetUserDataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -7050,57 +13294,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAvatarReviewReplyValidationError{}
+} = GetUserDataReqValidationError{}
 
-// Validate checks the field values on GetCoverReviewReq with the rules defined
+var _GetUserDataReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+
+// Validate checks the field values on GetUserDataReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetCoverReviewReq) Validate() error {
+func (m *GetUserDataReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCoverReviewReq with the rules
+// ValidateAll checks the field values on GetUserDataReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCoverReviewReqMultiError, or nil if none found.
-func (m *GetCoverReviewReq) ValidateAll() error {
+// GetUserDataReplyMultiError, or nil if none found.
+func (m *GetUserDataReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCoverReviewReq) validate(all bool) error {
+func (m *GetUserDataReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
 
-	if !_GetCoverReviewReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := GetCoverReviewReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserDataReplyValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserDataReplyValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return GetCoverReviewReqMultiError(errors)
+		return GetUserDataReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCoverReviewReqMultiError is an error wrapping multiple validation errors
-// returned by GetCoverReviewReq.ValidateAll() if the designated constraints
+// GetUserDataReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserDataReply.ValidateAll() if the designated constraints
 // aren't met.
-type GetCoverReviewReqMultiError []error
+type GetUserDataReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCoverReviewReqMultiError) Error() string {
+func (m GetUserDataReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7109,11 +13376,11 @@ func (m GetCoverReviewReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCoverReviewReqMultiError) AllErrors() []error { return m }
+func (m GetUserDataReplyMultiError) AllErrors() []error { return m }
 
-// GetCoverReviewReqValidationError is the validation error returned by
-// GetCoverReviewReq.Validate if the designated constraints aren't met.
-type GetCoverReviewReqValidationError struct {
+// GetUserDataReplyValidationError is the validation error returned by
+// GetUserDataReply.Validate if the designated constraints aren't met.
+type GetUserDataReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7121,24 +13388,22 @@ type GetCoverReviewReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCoverReviewReqValidationError) Field() string { return e.field }
+func (e GetUserDataReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCoverReviewReqValidationError) Reason() string { return e.reason }
+func (e GetUserDataReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCoverReviewReqValidationError) Cause() error { return e.cause }
+func (e GetUserDataReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCoverReviewReqValidationError) Key() bool { return e.key }
+func (e GetUserDataReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCoverReviewReqValidationError) ErrorName() string {
-	return "GetCoverReviewReqValidationError"
-}
+func (e GetUserDataReplyValidationError) ErrorName() string { return "GetUserDataReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCoverReviewReqValidationError) Error() string {
+func (e GetUserDataReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7150,14 +13415,14 @@ func (e GetCoverReviewReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCoverReviewReq.%s: %s%s",
+		"invalid %sGetUserDataReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCoverReviewReqValidationError{}
+var _ error = GetUserDataReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7165,80 +13430,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCoverReviewReqValidationError{}
-
-var _GetCoverReviewReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = GetUserDataReplyValidationError{}
 
-// Validate checks the field values on GetCoverReviewReply with the rules
+// Validate checks the field values on ListSessionsReply_Session with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCoverReviewReply) Validate() error {
+func (m *ListSessionsReply_Session) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCoverReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListSessionsReply_Session with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCoverReviewReplyMultiError, or nil if none found.
-func (m *GetCoverReviewReply) ValidateAll() error {
+// ListSessionsReply_SessionMultiError, or nil if none found.
+func (m *ListSessionsReply_Session) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCoverReviewReply) validate(all bool) error {
+func (m *ListSessionsReply_Session) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReview() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCoverReviewReplyValidationError{
-						field:  fmt.Sprintf("Review[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCoverReviewReplyValidationError{
-						field:  fmt.Sprintf("Review[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetCoverReviewReplyValidationError{
-					field:  fmt.Sprintf("Review[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Device
 
-	}
+	// no validation rules for Ip
+
+	// no validation rules for Created
+
+	// no validation rules for Seen
 
 	if len(errors) > 0 {
-		return GetCoverReviewReplyMultiError(errors)
+		return ListSessionsReply_SessionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCoverReviewReplyMultiError is an error wrapping multiple validation
-// errors returned by GetCoverReviewReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetCoverReviewReplyMultiError []error
+// ListSessionsReply_SessionMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsReply_Session.ValidateAll() if the
+// designated constraints aren't met.
+type ListSessionsReply_SessionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCoverReviewReplyMultiError) Error() string {
+func (m ListSessionsReply_SessionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7247,11 +13486,11 @@ func (m GetCoverReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCoverReviewReplyMultiError) AllErrors() []error { return m }
+func (m ListSessionsReply_SessionMultiError) AllErrors() []error { return m }
 
-// GetCoverReviewReplyValidationError is the validation error returned by
-// GetCoverReviewReply.Validate if the designated constraints aren't met.
-type GetCoverReviewReplyValidationError struct {
+// ListSessionsReply_SessionValidationError is the validation error returned by
+// ListSessionsReply_Session.Validate if the designated constraints aren't met.
+type ListSessionsReply_SessionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7259,24 +13498,24 @@ type GetCoverReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCoverReviewReplyValidationError) Field() string { return e.field }
+func (e ListSessionsReply_SessionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCoverReviewReplyValidationError) Reason() string { return e.reason }
+func (e ListSessionsReply_SessionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCoverReviewReplyValidationError) Cause() error { return e.cause }
+func (e ListSessionsReply_SessionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCoverReviewReplyValidationError) Key() bool { return e.key }
+func (e ListSessionsReply_SessionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCoverReviewReplyValidationError) ErrorName() string {
-	return "GetCoverReviewReplyValidationError"
+func (e ListSessionsReply_SessionValidationError) ErrorName() string {
+	return "ListSessionsReply_SessionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCoverReviewReplyValidationError) Error() string {
+func (e ListSessionsReply_SessionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7288,14 +13527,14 @@ func (e GetCoverReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCoverReviewReply.%s: %s%s",
+		"invalid %sListSessionsReply_Session.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCoverReviewReplyValidationError{}
+var _ error = ListSessionsReply_SessionValidationError{}
 
 var _ interface {
 	Field() string
@@ -7303,143 +13542,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCoverReviewReplyValidationError{}
+} = ListSessionsReply_SessionValidationError{}
 
-// Validate checks the field values on SetProfileUpdateReq with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetProfileListReply_Profile with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProfileUpdateReq) Validate() error {
+func (m *GetProfileListReply_Profile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProfileUpdateReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetProfileListReply_Profile with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetProfileUpdateReqMultiError, or nil if none found.
-func (m *SetProfileUpdateReq) ValidateAll() error {
+// GetProfileListReply_ProfileMultiError, or nil if none found.
+func (m *GetProfileListReply_Profile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProfileUpdateReq) validate(all bool) error {
+func (m *GetProfileListReply_Profile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SetProfileUpdateReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetUsername()); l < 1 || l > 20 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Username",
-			reason: "value length must be between 1 and 20 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetSchool()); l < 0 || l > 50 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "School",
-			reason: "value length must be between 0 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetCompany()); l < 0 || l > 50 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Company",
-			reason: "value length must be between 0 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetJob()); l < 0 || l > 50 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Job",
-			reason: "value length must be between 0 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetHomepage()); l < 0 || l > 100 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Homepage",
-			reason: "value length must be between 0 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetGithub()); l < 0 || l > 100 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Github",
-			reason: "value length must be between 0 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Uuid
 
-	if l := utf8.RuneCountInString(m.GetGitee()); l < 0 || l > 100 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Gitee",
-			reason: "value length must be between 0 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Username
 
-	if l := utf8.RuneCountInString(m.GetIntroduce()); l < 0 || l > 100 {
-		err := SetProfileUpdateReqValidationError{
-			field:  "Introduce",
-			reason: "value length must be between 0 and 100 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Introduce
 
 	if len(errors) > 0 {
-		return SetProfileUpdateReqMultiError(errors)
+		return GetProfileListReply_ProfileMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProfileUpdateReqMultiError is an error wrapping multiple validation
-// errors returned by SetProfileUpdateReq.ValidateAll() if the designated
-// constraints aren't met.
-type SetProfileUpdateReqMultiError []error
+// GetProfileListReply_ProfileMultiError is an error wrapping multiple
+// validation errors returned by GetProfileListReply_Profile.ValidateAll() if
+// the designated constraints aren't met.
+type GetProfileListReply_ProfileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProfileUpdateReqMultiError) Error() string {
+func (m GetProfileListReply_ProfileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7448,11 +13594,12 @@ func (m SetProfileUpdateReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProfileUpdateReqMultiError) AllErrors() []error { return m }
+func (m GetProfileListReply_ProfileMultiError) AllErrors() []error { return m }
 
-// SetProfileUpdateReqValidationError is the validation error returned by
-// SetProfileUpdateReq.Validate if the designated constraints aren't met.
-type SetProfileUpdateReqValidationError struct {
+// GetProfileListReply_ProfileValidationError is the validation error returned
+// by GetProfileListReply_Profile.Validate if the designated constraints
+// aren't met.
+type GetProfileListReply_ProfileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7460,24 +13607,24 @@ type SetProfileUpdateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProfileUpdateReqValidationError) Field() string { return e.field }
+func (e GetProfileListReply_ProfileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProfileUpdateReqValidationError) Reason() string { return e.reason }
+func (e GetProfileListReply_ProfileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProfileUpdateReqValidationError) Cause() error { return e.cause }
+func (e GetProfileListReply_ProfileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProfileUpdateReqValidationError) Key() bool { return e.key }
+func (e GetProfileListReply_ProfileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProfileUpdateReqValidationError) ErrorName() string {
-	return "SetProfileUpdateReqValidationError"
+func (e GetProfileListReply_ProfileValidationError) ErrorName() string {
+	return "GetProfileListReply_ProfileValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProfileUpdateReqValidationError) Error() string {
+func (e GetProfileListReply_ProfileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7489,14 +13636,14 @@ func (e SetProfileUpdateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProfileUpdateReq.%s: %s%s",
+		"invalid %sGetProfileListReply_Profile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProfileUpdateReqValidationError{}
+var _ error = GetProfileListReply_ProfileValidationError{}
 
 var _ interface {
 	Field() string
@@ -7504,68 +13651,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProfileUpdateReqValidationError{}
-
-var _SetProfileUpdateReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = GetProfileListReply_ProfileValidationError{}
 
-// Validate checks the field values on ProfileReviewPassReq with the rules
+// Validate checks the field values on GetFollowListReply_Follow with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProfileReviewPassReq) Validate() error {
+func (m *GetFollowListReply_Follow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProfileReviewPassReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetFollowListReply_Follow with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProfileReviewPassReqMultiError, or nil if none found.
-func (m *ProfileReviewPassReq) ValidateAll() error {
+// GetFollowListReply_FollowMultiError, or nil if none found.
+func (m *GetFollowListReply_Follow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProfileReviewPassReq) validate(all bool) error {
+func (m *GetFollowListReply_Follow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ProfileReviewPassReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := ProfileReviewPassReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetUpdate()) > 20 {
-		err := ProfileReviewPassReqValidationError{
-			field:  "Update",
-			reason: "value length must be at most 20 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Uuid
 
 	if len(errors) > 0 {
-		return ProfileReviewPassReqMultiError(errors)
+		return GetFollowListReply_FollowMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProfileReviewPassReqMultiError is an error wrapping multiple validation
-// errors returned by ProfileReviewPassReq.ValidateAll() if the designated
-// constraints aren't met.
-type ProfileReviewPassReqMultiError []error
+// GetFollowListReply_FollowMultiError is an error wrapping multiple validation
+// errors returned by GetFollowListReply_Follow.ValidateAll() if the
+// designated constraints aren't met.
+type GetFollowListReply_FollowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProfileReviewPassReqMultiError) Error() string {
+func (m GetFollowListReply_FollowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7574,11 +13699,11 @@ func (m ProfileReviewPassReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProfileReviewPassReqMultiError) AllErrors() []error { return m }
+func (m GetFollowListReply_FollowMultiError) AllErrors() []error { return m }
 
-// ProfileReviewPassReqValidationError is the validation error returned by
-// ProfileReviewPassReq.Validate if the designated constraints aren't met.
-type ProfileReviewPassReqValidationError struct {
+// GetFollowListReply_FollowValidationError is the validation error returned by
+// GetFollowListReply_Follow.Validate if the designated constraints aren't met.
+type GetFollowListReply_FollowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7586,24 +13711,24 @@ type ProfileReviewPassReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProfileReviewPassReqValidationError) Field() string { return e.field }
+func (e GetFollowListReply_FollowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProfileReviewPassReqValidationError) Reason() string { return e.reason }
+func (e GetFollowListReply_FollowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProfileReviewPassReqValidationError) Cause() error { return e.cause }
+func (e GetFollowListReply_FollowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProfileReviewPassReqValidationError) Key() bool { return e.key }
+func (e GetFollowListReply_FollowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProfileReviewPassReqValidationError) ErrorName() string {
-	return "ProfileReviewPassReqValidationError"
+func (e GetFollowListReply_FollowValidationError) ErrorName() string {
+	return "GetFollowListReply_FollowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProfileReviewPassReqValidationError) Error() string {
+func (e GetFollowListReply_FollowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7615,14 +13740,14 @@ func (e ProfileReviewPassReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProfileReviewPassReq.%s: %s%s",
+		"invalid %sGetFollowListReply_Follow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProfileReviewPassReqValidationError{}
+var _ error = GetFollowListReply_FollowValidationError{}
 
 var _ interface {
 	Field() string
@@ -7630,57 +13755,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProfileReviewPassReqValidationError{}
-
-var _ProfileReviewPassReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = GetFollowListReply_FollowValidationError{}
 
-// Validate checks the field values on ProfileReviewNotPassReq with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetFollowedListReply_Follow with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProfileReviewNotPassReq) Validate() error {
+func (m *GetFollowedListReply_Follow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProfileReviewNotPassReq with the
+// ValidateAll checks the field values on GetFollowedListReply_Follow with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProfileReviewNotPassReqMultiError, or nil if none found.
-func (m *ProfileReviewNotPassReq) ValidateAll() error {
+// GetFollowedListReply_FollowMultiError, or nil if none found.
+func (m *GetFollowedListReply_Follow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProfileReviewNotPassReq) validate(all bool) error {
+func (m *GetFollowedListReply_Follow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ProfileReviewNotPassReq_Uuid_Pattern.MatchString(m.GetUuid()) {
-		err := ProfileReviewNotPassReqValidationError{
-			field:  "Uuid",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{20}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Uuid
 
 	if len(errors) > 0 {
-		return ProfileReviewNotPassReqMultiError(errors)
+		return GetFollowedListReply_FollowMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProfileReviewNotPassReqMultiError is an error wrapping multiple validation
-// errors returned by ProfileReviewNotPassReq.ValidateAll() if the designated
-// constraints aren't met.
-type ProfileReviewNotPassReqMultiError []error
+// GetFollowedListReply_FollowMultiError is an error wrapping multiple
+// validation errors returned by GetFollowedListReply_Follow.ValidateAll() if
+// the designated constraints aren't met.
+type GetFollowedListReply_FollowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProfileReviewNotPassReqMultiError) Error() string {
+func (m GetFollowedListReply_FollowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7689,11 +13803,12 @@ func (m ProfileReviewNotPassReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProfileReviewNotPassReqMultiError) AllErrors() []error { return m }
+func (m GetFollowedListReply_FollowMultiError) AllErrors() []error { return m }
 
-// ProfileReviewNotPassReqValidationError is the validation error returned by
-// ProfileReviewNotPassReq.Validate if the designated constraints aren't met.
-type ProfileReviewNotPassReqValidationError struct {
+// GetFollowedListReply_FollowValidationError is the validation error returned
+// by GetFollowedListReply_Follow.Validate if the designated constraints
+// aren't met.
+type GetFollowedListReply_FollowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7701,24 +13816,24 @@ type ProfileReviewNotPassReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProfileReviewNotPassReqValidationError) Field() string { return e.field }
+func (e GetFollowedListReply_FollowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProfileReviewNotPassReqValidationError) Reason() string { return e.reason }
+func (e GetFollowedListReply_FollowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProfileReviewNotPassReqValidationError) Cause() error { return e.cause }
+func (e GetFollowedListReply_FollowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProfileReviewNotPassReqValidationError) Key() bool { return e.key }
+func (e GetFollowedListReply_FollowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProfileReviewNotPassReqValidationError) ErrorName() string {
-	return "ProfileReviewNotPassReqValidationError"
+func (e GetFollowedListReply_FollowValidationError) ErrorName() string {
+	return "GetFollowedListReply_FollowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProfileReviewNotPassReqValidationError) Error() string {
+func (e GetFollowedListReply_FollowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7730,14 +13845,14 @@ func (e ProfileReviewNotPassReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProfileReviewNotPassReq.%s: %s%s",
+		"invalid %sGetFollowedListReply_Follow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProfileReviewNotPassReqValidationError{}
+var _ error = GetFollowedListReply_FollowValidationError{}
 
 var _ interface {
 	Field() string
@@ -7745,26 +13860,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProfileReviewNotPassReqValidationError{}
-
-var _ProfileReviewNotPassReq_Uuid_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{20}$")
+} = GetFollowedListReply_FollowValidationError{}
 
-// Validate checks the field values on GetProfileListReply_Profile with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProfileListReply_Profile) Validate() error {
+// Validate checks the field values on GetFollowRecommendationsReply_Follow
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetFollowRecommendationsReply_Follow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProfileListReply_Profile with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProfileListReply_ProfileMultiError, or nil if none found.
-func (m *GetProfileListReply_Profile) ValidateAll() error {
+// ValidateAll checks the field values on GetFollowRecommendationsReply_Follow
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetFollowRecommendationsReply_FollowMultiError, or nil if none found.
+func (m *GetFollowRecommendationsReply_Follow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProfileListReply_Profile) validate(all bool) error {
+func (m *GetFollowRecommendationsReply_Follow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7773,24 +13887,21 @@ func (m *GetProfileListReply_Profile) validate(all bool) error {
 
 	// no validation rules for Uuid
 
-	// no validation rules for Username
-
-	// no validation rules for Introduce
-
 	if len(errors) > 0 {
-		return GetProfileListReply_ProfileMultiError(errors)
+		return GetFollowRecommendationsReply_FollowMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProfileListReply_ProfileMultiError is an error wrapping multiple
-// validation errors returned by GetProfileListReply_Profile.ValidateAll() if
-// the designated constraints aren't met.
-type GetProfileListReply_ProfileMultiError []error
+// GetFollowRecommendationsReply_FollowMultiError is an error wrapping multiple
+// validation errors returned by
+// GetFollowRecommendationsReply_Follow.ValidateAll() if the designated
+// constraints aren't met.
+type GetFollowRecommendationsReply_FollowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProfileListReply_ProfileMultiError) Error() string {
+func (m GetFollowRecommendationsReply_FollowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7799,12 +13910,12 @@ func (m GetProfileListReply_ProfileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProfileListReply_ProfileMultiError) AllErrors() []error { return m }
+func (m GetFollowRecommendationsReply_FollowMultiError) AllErrors() []error { return m }
 
-// GetProfileListReply_ProfileValidationError is the validation error returned
-// by GetProfileListReply_Profile.Validate if the designated constraints
-// aren't met.
-type GetProfileListReply_ProfileValidationError struct {
+// GetFollowRecommendationsReply_FollowValidationError is the validation error
+// returned by GetFollowRecommendationsReply_Follow.Validate if the designated
+// constraints aren't met.
+type GetFollowRecommendationsReply_FollowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7812,24 +13923,24 @@ type GetProfileListReply_ProfileValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProfileListReply_ProfileValidationError) Field() string { return e.field }
+func (e GetFollowRecommendationsReply_FollowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProfileListReply_ProfileValidationError) Reason() string { return e.reason }
+func (e GetFollowRecommendationsReply_FollowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProfileListReply_ProfileValidationError) Cause() error { return e.cause }
+func (e GetFollowRecommendationsReply_FollowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProfileListReply_ProfileValidationError) Key() bool { return e.key }
+func (e GetFollowRecommendationsReply_FollowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProfileListReply_ProfileValidationError) ErrorName() string {
-	return "GetProfileListReply_ProfileValidationError"
+func (e GetFollowRecommendationsReply_FollowValidationError) ErrorName() string {
+	return "GetFollowRecommendationsReply_FollowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProfileListReply_ProfileValidationError) Error() string {
+func (e GetFollowRecommendationsReply_FollowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7841,14 +13952,14 @@ func (e GetProfileListReply_ProfileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProfileListReply_Profile.%s: %s%s",
+		"invalid %sGetFollowRecommendationsReply_Follow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProfileListReply_ProfileValidationError{}
+var _ error = GetFollowRecommendationsReply_FollowValidationError{}
 
 var _ interface {
 	Field() string
@@ -7856,24 +13967,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProfileListReply_ProfileValidationError{}
+} = GetFollowRecommendationsReply_FollowValidationError{}
 
-// Validate checks the field values on GetFollowListReply_Follow with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetFollowRequestsReply_Follow with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowListReply_Follow) Validate() error {
+func (m *GetFollowRequestsReply_Follow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowListReply_Follow with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFollowListReply_FollowMultiError, or nil if none found.
-func (m *GetFollowListReply_Follow) ValidateAll() error {
+// ValidateAll checks the field values on GetFollowRequestsReply_Follow with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetFollowRequestsReply_FollowMultiError, or nil if none found.
+func (m *GetFollowRequestsReply_Follow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowListReply_Follow) validate(all bool) error {
+func (m *GetFollowRequestsReply_Follow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7883,19 +13994,19 @@ func (m *GetFollowListReply_Follow) validate(all bool) error {
 	// no validation rules for Uuid
 
 	if len(errors) > 0 {
-		return GetFollowListReply_FollowMultiError(errors)
+		return GetFollowRequestsReply_FollowMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowListReply_FollowMultiError is an error wrapping multiple validation
-// errors returned by GetFollowListReply_Follow.ValidateAll() if the
-// designated constraints aren't met.
-type GetFollowListReply_FollowMultiError []error
+// GetFollowRequestsReply_FollowMultiError is an error wrapping multiple
+// validation errors returned by GetFollowRequestsReply_Follow.ValidateAll()
+// if the designated constraints aren't met.
+type GetFollowRequestsReply_FollowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowListReply_FollowMultiError) Error() string {
+func (m GetFollowRequestsReply_FollowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7904,11 +14015,12 @@ func (m GetFollowListReply_FollowMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowListReply_FollowMultiError) AllErrors() []error { return m }
+func (m GetFollowRequestsReply_FollowMultiError) AllErrors() []error { return m }
 
-// GetFollowListReply_FollowValidationError is the validation error returned by
-// GetFollowListReply_Follow.Validate if the designated constraints aren't met.
-type GetFollowListReply_FollowValidationError struct {
+// GetFollowRequestsReply_FollowValidationError is the validation error
+// returned by GetFollowRequestsReply_Follow.Validate if the designated
+// constraints aren't met.
+type GetFollowRequestsReply_FollowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7916,24 +14028,24 @@ type GetFollowListReply_FollowValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowListReply_FollowValidationError) Field() string { return e.field }
+func (e GetFollowRequestsReply_FollowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowListReply_FollowValidationError) Reason() string { return e.reason }
+func (e GetFollowRequestsReply_FollowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowListReply_FollowValidationError) Cause() error { return e.cause }
+func (e GetFollowRequestsReply_FollowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowListReply_FollowValidationError) Key() bool { return e.key }
+func (e GetFollowRequestsReply_FollowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowListReply_FollowValidationError) ErrorName() string {
-	return "GetFollowListReply_FollowValidationError"
+func (e GetFollowRequestsReply_FollowValidationError) ErrorName() string {
+	return "GetFollowRequestsReply_FollowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFollowListReply_FollowValidationError) Error() string {
+func (e GetFollowRequestsReply_FollowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7945,14 +14057,14 @@ func (e GetFollowListReply_FollowValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowListReply_Follow.%s: %s%s",
+		"invalid %sGetFollowRequestsReply_Follow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowListReply_FollowValidationError{}
+var _ error = GetFollowRequestsReply_FollowValidationError{}
 
 var _ interface {
 	Field() string
@@ -7960,46 +14072,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowListReply_FollowValidationError{}
+} = GetFollowRequestsReply_FollowValidationError{}
 
-// Validate checks the field values on GetFollowedListReply_Follow with the
+// Validate checks the field values on GetAuditLogListReply_AuditLog with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFollowedListReply_Follow) Validate() error {
+func (m *GetAuditLogListReply_AuditLog) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFollowedListReply_Follow with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFollowedListReply_FollowMultiError, or nil if none found.
-func (m *GetFollowedListReply_Follow) ValidateAll() error {
+// ValidateAll checks the field values on GetAuditLogListReply_AuditLog with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAuditLogListReply_AuditLogMultiError, or nil if none found.
+func (m *GetAuditLogListReply_AuditLog) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFollowedListReply_Follow) validate(all bool) error {
+func (m *GetAuditLogListReply_AuditLog) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Uuid
+	// no validation rules for Id
+
+	// no validation rules for Operator
+
+	// no validation rules for Role
+
+	// no validation rules for Action
+
+	// no validation rules for Kind
+
+	// no validation rules for Target
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return GetFollowedListReply_FollowMultiError(errors)
+		return GetAuditLogListReply_AuditLogMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFollowedListReply_FollowMultiError is an error wrapping multiple
-// validation errors returned by GetFollowedListReply_Follow.ValidateAll() if
-// the designated constraints aren't met.
-type GetFollowedListReply_FollowMultiError []error
+// GetAuditLogListReply_AuditLogMultiError is an error wrapping multiple
+// validation errors returned by GetAuditLogListReply_AuditLog.ValidateAll()
+// if the designated constraints aren't met.
+type GetAuditLogListReply_AuditLogMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFollowedListReply_FollowMultiError) Error() string {
+func (m GetAuditLogListReply_AuditLogMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8008,12 +14136,12 @@ func (m GetFollowedListReply_FollowMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFollowedListReply_FollowMultiError) AllErrors() []error { return m }
+func (m GetAuditLogListReply_AuditLogMultiError) AllErrors() []error { return m }
 
-// GetFollowedListReply_FollowValidationError is the validation error returned
-// by GetFollowedListReply_Follow.Validate if the designated constraints
-// aren't met.
-type GetFollowedListReply_FollowValidationError struct {
+// GetAuditLogListReply_AuditLogValidationError is the validation error
+// returned by GetAuditLogListReply_AuditLog.Validate if the designated
+// constraints aren't met.
+type GetAuditLogListReply_AuditLogValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8021,24 +14149,24 @@ type GetFollowedListReply_FollowValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFollowedListReply_FollowValidationError) Field() string { return e.field }
+func (e GetAuditLogListReply_AuditLogValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFollowedListReply_FollowValidationError) Reason() string { return e.reason }
+func (e GetAuditLogListReply_AuditLogValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFollowedListReply_FollowValidationError) Cause() error { return e.cause }
+func (e GetAuditLogListReply_AuditLogValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFollowedListReply_FollowValidationError) Key() bool { return e.key }
+func (e GetAuditLogListReply_AuditLogValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFollowedListReply_FollowValidationError) ErrorName() string {
-	return "GetFollowedListReply_FollowValidationError"
+func (e GetAuditLogListReply_AuditLogValidationError) ErrorName() string {
+	return "GetAuditLogListReply_AuditLogValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFollowedListReply_FollowValidationError) Error() string {
+func (e GetAuditLogListReply_AuditLogValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8050,14 +14178,14 @@ func (e GetFollowedListReply_FollowValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFollowedListReply_Follow.%s: %s%s",
+		"invalid %sGetAuditLogListReply_AuditLog.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFollowedListReply_FollowValidationError{}
+var _ error = GetAuditLogListReply_AuditLogValidationError{}
 
 var _ interface {
 	Field() string
@@ -8065,7 +14193,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFollowedListReply_FollowValidationError{}
+} = GetAuditLogListReply_AuditLogValidationError{}
 
 // Validate checks the field values on GetUserSearchReply_List with the rules
 // defined in the proto definition for this message. If any rules are
@@ -8419,3 +14547,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetCoverReviewReply_ReviewValidationError{}
+
+// Validate checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserDataReply_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserDataReply_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserDataReply_FileMultiError, or nil if none found.
+func (m *GetUserDataReply_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserDataReply_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetUserDataReply_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserDataReply_FileMultiError is an error wrapping multiple validation
+// errors returned by GetUserDataReply_File.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserDataReply_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserDataReply_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserDataReply_FileMultiError) AllErrors() []error { return m }
+
+// GetUserDataReply_FileValidationError is the validation error returned by
+// GetUserDataReply_File.Validate if the designated constraints aren't met.
+type GetUserDataReply_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserDataReply_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserDataReply_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserDataReply_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserDataReply_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserDataReply_FileValidationError) ErrorName() string {
+	return "GetUserDataReply_FileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserDataReply_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserDataReply_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserDataReply_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserDataReply_FileValidationError{}
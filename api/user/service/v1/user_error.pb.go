@@ -24,49 +24,93 @@ const (
 type UserErrorReason int32
 
 const (
-	UserErrorReason_UNKNOWN_ERROR                UserErrorReason = 0
-	UserErrorReason_VERIFY_PASSWORD_FAILED       UserErrorReason = 1
-	UserErrorReason_VERIFY_CODE_FAILED           UserErrorReason = 2
-	UserErrorReason_USERNAME_CONFLICT            UserErrorReason = 3
-	UserErrorReason_PHONE_CONFLICT               UserErrorReason = 4
-	UserErrorReason_EMAIL_CONFLICT               UserErrorReason = 5
-	UserErrorReason_WECHAT_CONFLICT              UserErrorReason = 6
-	UserErrorReason_QQ_CONFLICT                  UserErrorReason = 7
-	UserErrorReason_GITEE_CONFLICT               UserErrorReason = 8
-	UserErrorReason_GITHUB_CONFLICT              UserErrorReason = 9
-	UserErrorReason_USER_NAME_CONFLICT           UserErrorReason = 10
-	UserErrorReason_GET_ACCOUNT_FAILED           UserErrorReason = 11
-	UserErrorReason_GET_PROFILE_FAILED           UserErrorReason = 12
-	UserErrorReason_GET_PROFILE_UPDATE_FAILED    UserErrorReason = 13
-	UserErrorReason_GET_ACHIEVEMENT_FAILED       UserErrorReason = 14
-	UserErrorReason_GET_USER_FOLLOW_FAILED       UserErrorReason = 15
-	UserErrorReason_GET_FOLLOW_LIST_FAILED       UserErrorReason = 16
-	UserErrorReason_GET_FOLLOW_LIST_COUNT_FAILED UserErrorReason = 17
-	UserErrorReason_GET_PROFILE_LIST_FAILED      UserErrorReason = 18
-	UserErrorReason_GET_USER_SEARCH_FAILED       UserErrorReason = 19
-	UserErrorReason_GET_PICTURE_REVIEW_FAILED    UserErrorReason = 20
-	UserErrorReason_SEND_CODE_FAILED             UserErrorReason = 21
-	UserErrorReason_SET_PHONE_FAILED             UserErrorReason = 22
-	UserErrorReason_SET_EMAIL_FAILED             UserErrorReason = 23
-	UserErrorReason_SET_PROFILE_FAILED           UserErrorReason = 24
-	UserErrorReason_SET_PROFILE_UPDATE_FAILED    UserErrorReason = 25
-	UserErrorReason_SET_USERNAME_FAILED          UserErrorReason = 26
-	UserErrorReason_SET_PASSWORD_FAILED          UserErrorReason = 27
-	UserErrorReason_SET_FOLLOW_FAILED            UserErrorReason = 28
-	UserErrorReason_SET_IMAGE_FAILED             UserErrorReason = 29
-	UserErrorReason_SET_IMAGE_IRREGULAR_FAILED   UserErrorReason = 30
-	UserErrorReason_SET_WECHAT_FAILED            UserErrorReason = 31
-	UserErrorReason_SET_QQ_FAILED                UserErrorReason = 32
-	UserErrorReason_SET_GITEE_FAILED             UserErrorReason = 33
-	UserErrorReason_SET_GITHUB_FAILED            UserErrorReason = 34
-	UserErrorReason_CANCEL_FOLLOW_FAILED         UserErrorReason = 35
-	UserErrorReason_LOGIN_FAILED                 UserErrorReason = 36
-	UserErrorReason_REGISTER_FAILED              UserErrorReason = 37
-	UserErrorReason_RESET_PASSWORD_FAILED        UserErrorReason = 38
-	UserErrorReason_PROFILE_REVIEW_MODIFY_FAILED UserErrorReason = 39
-	UserErrorReason_PROFILE_UPDATE_MODIFY_FAILED UserErrorReason = 40
-	UserErrorReason_UNBIND_ACCOUNT_FAILED        UserErrorReason = 41
-	UserErrorReason_UNIQUE_ACCOUNT               UserErrorReason = 42
+	UserErrorReason_UNKNOWN_ERROR                     UserErrorReason = 0
+	UserErrorReason_VERIFY_PASSWORD_FAILED            UserErrorReason = 1
+	UserErrorReason_VERIFY_CODE_FAILED                UserErrorReason = 2
+	UserErrorReason_USERNAME_CONFLICT                 UserErrorReason = 3
+	UserErrorReason_PHONE_CONFLICT                    UserErrorReason = 4
+	UserErrorReason_EMAIL_CONFLICT                    UserErrorReason = 5
+	UserErrorReason_WECHAT_CONFLICT                   UserErrorReason = 6
+	UserErrorReason_QQ_CONFLICT                       UserErrorReason = 7
+	UserErrorReason_GITEE_CONFLICT                    UserErrorReason = 8
+	UserErrorReason_GITHUB_CONFLICT                   UserErrorReason = 9
+	UserErrorReason_USER_NAME_CONFLICT                UserErrorReason = 10
+	UserErrorReason_GET_ACCOUNT_FAILED                UserErrorReason = 11
+	UserErrorReason_GET_PROFILE_FAILED                UserErrorReason = 12
+	UserErrorReason_GET_PROFILE_UPDATE_FAILED         UserErrorReason = 13
+	UserErrorReason_GET_ACHIEVEMENT_FAILED            UserErrorReason = 14
+	UserErrorReason_GET_USER_FOLLOW_FAILED            UserErrorReason = 15
+	UserErrorReason_GET_FOLLOW_LIST_FAILED            UserErrorReason = 16
+	UserErrorReason_GET_FOLLOW_LIST_COUNT_FAILED      UserErrorReason = 17
+	UserErrorReason_GET_PROFILE_LIST_FAILED           UserErrorReason = 18
+	UserErrorReason_GET_USER_SEARCH_FAILED            UserErrorReason = 19
+	UserErrorReason_GET_PICTURE_REVIEW_FAILED         UserErrorReason = 20
+	UserErrorReason_SEND_CODE_FAILED                  UserErrorReason = 21
+	UserErrorReason_SET_PHONE_FAILED                  UserErrorReason = 22
+	UserErrorReason_SET_EMAIL_FAILED                  UserErrorReason = 23
+	UserErrorReason_SET_PROFILE_FAILED                UserErrorReason = 24
+	UserErrorReason_SET_PROFILE_UPDATE_FAILED         UserErrorReason = 25
+	UserErrorReason_SET_USERNAME_FAILED               UserErrorReason = 26
+	UserErrorReason_SET_PASSWORD_FAILED               UserErrorReason = 27
+	UserErrorReason_SET_FOLLOW_FAILED                 UserErrorReason = 28
+	UserErrorReason_SET_IMAGE_FAILED                  UserErrorReason = 29
+	UserErrorReason_SET_IMAGE_IRREGULAR_FAILED        UserErrorReason = 30
+	UserErrorReason_SET_WECHAT_FAILED                 UserErrorReason = 31
+	UserErrorReason_SET_QQ_FAILED                     UserErrorReason = 32
+	UserErrorReason_SET_GITEE_FAILED                  UserErrorReason = 33
+	UserErrorReason_SET_GITHUB_FAILED                 UserErrorReason = 34
+	UserErrorReason_CANCEL_FOLLOW_FAILED              UserErrorReason = 35
+	UserErrorReason_LOGIN_FAILED                      UserErrorReason = 36
+	UserErrorReason_REGISTER_FAILED                   UserErrorReason = 37
+	UserErrorReason_RESET_PASSWORD_FAILED             UserErrorReason = 38
+	UserErrorReason_PROFILE_REVIEW_MODIFY_FAILED      UserErrorReason = 39
+	UserErrorReason_PROFILE_UPDATE_MODIFY_FAILED      UserErrorReason = 40
+	UserErrorReason_UNBIND_ACCOUNT_FAILED             UserErrorReason = 41
+	UserErrorReason_UNIQUE_ACCOUNT                    UserErrorReason = 42
+	UserErrorReason_REFRESH_TOKEN_INVALID             UserErrorReason = 43
+	UserErrorReason_LOGOUT_FAILED                     UserErrorReason = 44
+	UserErrorReason_GET_SESSIONS_FAILED               UserErrorReason = 45
+	UserErrorReason_ACCOUNT_LOCKED                    UserErrorReason = 46
+	UserErrorReason_SEND_CODE_TOO_FREQUENT            UserErrorReason = 47
+	UserErrorReason_SEND_CODE_LIMIT_EXCEEDED          UserErrorReason = 48
+	UserErrorReason_SEND_CODE_SUSPENDED               UserErrorReason = 49
+	UserErrorReason_CAPTCHA_INVALID                   UserErrorReason = 50
+	UserErrorReason_TOTP_INVALID                      UserErrorReason = 51
+	UserErrorReason_TOTP_CHALLENGE_INVALID            UserErrorReason = 52
+	UserErrorReason_TOTP_ALREADY_ENABLED              UserErrorReason = 53
+	UserErrorReason_TOTP_NOT_ENABLED                  UserErrorReason = 54
+	UserErrorReason_TOTP_FAILED                       UserErrorReason = 55
+	UserErrorReason_IDENTITY_CONFLICT                 UserErrorReason = 56
+	UserErrorReason_SET_IDENTITY_FAILED               UserErrorReason = 57
+	UserErrorReason_OAUTH_STATE_INVALID               UserErrorReason = 58
+	UserErrorReason_PROVIDER_NOT_FOUND                UserErrorReason = 59
+	UserErrorReason_DELETE_ACCOUNT_FAILED             UserErrorReason = 60
+	UserErrorReason_EXPORT_USER_DATA_FAILED           UserErrorReason = 61
+	UserErrorReason_EXPORT_TOO_FREQUENT               UserErrorReason = 62
+	UserErrorReason_GET_USER_DATA_FAILED              UserErrorReason = 63
+	UserErrorReason_PURGE_USER_DATA_FAILED            UserErrorReason = 64
+	UserErrorReason_PASSWORD_TOO_WEAK                 UserErrorReason = 65
+	UserErrorReason_USER_BLOCKED                      UserErrorReason = 66
+	UserErrorReason_BLOCK_USER_FAILED                 UserErrorReason = 67
+	UserErrorReason_GET_USER_BLOCK_FAILED             UserErrorReason = 68
+	UserErrorReason_GET_FOLLOW_RECOMMENDATIONS_FAILED UserErrorReason = 69
+	UserErrorReason_SET_PROFILE_PRIVATE_FAILED        UserErrorReason = 70
+	UserErrorReason_FOLLOW_REQUEST_NOT_FOUND          UserErrorReason = 71
+	UserErrorReason_FOLLOW_REQUEST_FAILED             UserErrorReason = 72
+	UserErrorReason_GET_FOLLOW_REQUESTS_FAILED        UserErrorReason = 73
+	UserErrorReason_GET_PROFILE_VISIBLE_FAILED        UserErrorReason = 74
+	UserErrorReason_HANDLE_TAKEN                      UserErrorReason = 75
+	UserErrorReason_HANDLE_RESERVED                   UserErrorReason = 76
+	UserErrorReason_HANDLE_COOLDOWN                   UserErrorReason = 77
+	UserErrorReason_HANDLE_NOT_FOUND                  UserErrorReason = 78
+	UserErrorReason_SET_HANDLE_FAILED                 UserErrorReason = 79
+	UserErrorReason_GET_HANDLE_FAILED                 UserErrorReason = 80
+	UserErrorReason_GET_USER_ROLE_FAILED              UserErrorReason = 81
+	UserErrorReason_SET_USER_ROLE_FAILED              UserErrorReason = 82
+	UserErrorReason_USER_SUSPENDED                    UserErrorReason = 83
+	UserErrorReason_SUSPEND_USER_FAILED               UserErrorReason = 84
+	UserErrorReason_ADD_AUDIT_LOG_FAILED              UserErrorReason = 85
+	UserErrorReason_GET_AUDIT_LOG_FAILED              UserErrorReason = 86
 )
 
 // Enum value maps for UserErrorReason.
@@ -115,51 +159,139 @@ var (
 		40: "PROFILE_UPDATE_MODIFY_FAILED",
 		41: "UNBIND_ACCOUNT_FAILED",
 		42: "UNIQUE_ACCOUNT",
+		43: "REFRESH_TOKEN_INVALID",
+		44: "LOGOUT_FAILED",
+		45: "GET_SESSIONS_FAILED",
+		46: "ACCOUNT_LOCKED",
+		47: "SEND_CODE_TOO_FREQUENT",
+		48: "SEND_CODE_LIMIT_EXCEEDED",
+		49: "SEND_CODE_SUSPENDED",
+		50: "CAPTCHA_INVALID",
+		51: "TOTP_INVALID",
+		52: "TOTP_CHALLENGE_INVALID",
+		53: "TOTP_ALREADY_ENABLED",
+		54: "TOTP_NOT_ENABLED",
+		55: "TOTP_FAILED",
+		56: "IDENTITY_CONFLICT",
+		57: "SET_IDENTITY_FAILED",
+		58: "OAUTH_STATE_INVALID",
+		59: "PROVIDER_NOT_FOUND",
+		60: "DELETE_ACCOUNT_FAILED",
+		61: "EXPORT_USER_DATA_FAILED",
+		62: "EXPORT_TOO_FREQUENT",
+		63: "GET_USER_DATA_FAILED",
+		64: "PURGE_USER_DATA_FAILED",
+		65: "PASSWORD_TOO_WEAK",
+		66: "USER_BLOCKED",
+		67: "BLOCK_USER_FAILED",
+		68: "GET_USER_BLOCK_FAILED",
+		69: "GET_FOLLOW_RECOMMENDATIONS_FAILED",
+		70: "SET_PROFILE_PRIVATE_FAILED",
+		71: "FOLLOW_REQUEST_NOT_FOUND",
+		72: "FOLLOW_REQUEST_FAILED",
+		73: "GET_FOLLOW_REQUESTS_FAILED",
+		74: "GET_PROFILE_VISIBLE_FAILED",
+		75: "HANDLE_TAKEN",
+		76: "HANDLE_RESERVED",
+		77: "HANDLE_COOLDOWN",
+		78: "HANDLE_NOT_FOUND",
+		79: "SET_HANDLE_FAILED",
+		80: "GET_HANDLE_FAILED",
+		81: "GET_USER_ROLE_FAILED",
+		82: "SET_USER_ROLE_FAILED",
+		83: "USER_SUSPENDED",
+		84: "SUSPEND_USER_FAILED",
+		85: "ADD_AUDIT_LOG_FAILED",
+		86: "GET_AUDIT_LOG_FAILED",
 	}
 	UserErrorReason_value = map[string]int32{
-		"UNKNOWN_ERROR":                0,
-		"VERIFY_PASSWORD_FAILED":       1,
-		"VERIFY_CODE_FAILED":           2,
-		"USERNAME_CONFLICT":            3,
-		"PHONE_CONFLICT":               4,
-		"EMAIL_CONFLICT":               5,
-		"WECHAT_CONFLICT":              6,
-		"QQ_CONFLICT":                  7,
-		"GITEE_CONFLICT":               8,
-		"GITHUB_CONFLICT":              9,
-		"USER_NAME_CONFLICT":           10,
-		"GET_ACCOUNT_FAILED":           11,
-		"GET_PROFILE_FAILED":           12,
-		"GET_PROFILE_UPDATE_FAILED":    13,
-		"GET_ACHIEVEMENT_FAILED":       14,
-		"GET_USER_FOLLOW_FAILED":       15,
-		"GET_FOLLOW_LIST_FAILED":       16,
-		"GET_FOLLOW_LIST_COUNT_FAILED": 17,
-		"GET_PROFILE_LIST_FAILED":      18,
-		"GET_USER_SEARCH_FAILED":       19,
-		"GET_PICTURE_REVIEW_FAILED":    20,
-		"SEND_CODE_FAILED":             21,
-		"SET_PHONE_FAILED":             22,
-		"SET_EMAIL_FAILED":             23,
-		"SET_PROFILE_FAILED":           24,
-		"SET_PROFILE_UPDATE_FAILED":    25,
-		"SET_USERNAME_FAILED":          26,
-		"SET_PASSWORD_FAILED":          27,
-		"SET_FOLLOW_FAILED":            28,
-		"SET_IMAGE_FAILED":             29,
-		"SET_IMAGE_IRREGULAR_FAILED":   30,
-		"SET_WECHAT_FAILED":            31,
-		"SET_QQ_FAILED":                32,
-		"SET_GITEE_FAILED":             33,
-		"SET_GITHUB_FAILED":            34,
-		"CANCEL_FOLLOW_FAILED":         35,
-		"LOGIN_FAILED":                 36,
-		"REGISTER_FAILED":              37,
-		"RESET_PASSWORD_FAILED":        38,
-		"PROFILE_REVIEW_MODIFY_FAILED": 39,
-		"PROFILE_UPDATE_MODIFY_FAILED": 40,
-		"UNBIND_ACCOUNT_FAILED":        41,
-		"UNIQUE_ACCOUNT":               42,
+		"UNKNOWN_ERROR":                     0,
+		"VERIFY_PASSWORD_FAILED":            1,
+		"VERIFY_CODE_FAILED":                2,
+		"USERNAME_CONFLICT":                 3,
+		"PHONE_CONFLICT":                    4,
+		"EMAIL_CONFLICT":                    5,
+		"WECHAT_CONFLICT":                   6,
+		"QQ_CONFLICT":                       7,
+		"GITEE_CONFLICT":                    8,
+		"GITHUB_CONFLICT":                   9,
+		"USER_NAME_CONFLICT":                10,
+		"GET_ACCOUNT_FAILED":                11,
+		"GET_PROFILE_FAILED":                12,
+		"GET_PROFILE_UPDATE_FAILED":         13,
+		"GET_ACHIEVEMENT_FAILED":            14,
+		"GET_USER_FOLLOW_FAILED":            15,
+		"GET_FOLLOW_LIST_FAILED":            16,
+		"GET_FOLLOW_LIST_COUNT_FAILED":      17,
+		"GET_PROFILE_LIST_FAILED":           18,
+		"GET_USER_SEARCH_FAILED":            19,
+		"GET_PICTURE_REVIEW_FAILED":         20,
+		"SEND_CODE_FAILED":                  21,
+		"SET_PHONE_FAILED":                  22,
+		"SET_EMAIL_FAILED":                  23,
+		"SET_PROFILE_FAILED":                24,
+		"SET_PROFILE_UPDATE_FAILED":         25,
+		"SET_USERNAME_FAILED":               26,
+		"SET_PASSWORD_FAILED":               27,
+		"SET_FOLLOW_FAILED":                 28,
+		"SET_IMAGE_FAILED":                  29,
+		"SET_IMAGE_IRREGULAR_FAILED":        30,
+		"SET_WECHAT_FAILED":                 31,
+		"SET_QQ_FAILED":                     32,
+		"SET_GITEE_FAILED":                  33,
+		"SET_GITHUB_FAILED":                 34,
+		"CANCEL_FOLLOW_FAILED":              35,
+		"LOGIN_FAILED":                      36,
+		"REGISTER_FAILED":                   37,
+		"RESET_PASSWORD_FAILED":             38,
+		"PROFILE_REVIEW_MODIFY_FAILED":      39,
+		"PROFILE_UPDATE_MODIFY_FAILED":      40,
+		"UNBIND_ACCOUNT_FAILED":             41,
+		"UNIQUE_ACCOUNT":                    42,
+		"REFRESH_TOKEN_INVALID":             43,
+		"LOGOUT_FAILED":                     44,
+		"GET_SESSIONS_FAILED":               45,
+		"ACCOUNT_LOCKED":                    46,
+		"SEND_CODE_TOO_FREQUENT":            47,
+		"SEND_CODE_LIMIT_EXCEEDED":          48,
+		"SEND_CODE_SUSPENDED":               49,
+		"CAPTCHA_INVALID":                   50,
+		"TOTP_INVALID":                      51,
+		"TOTP_CHALLENGE_INVALID":            52,
+		"TOTP_ALREADY_ENABLED":              53,
+		"TOTP_NOT_ENABLED":                  54,
+		"TOTP_FAILED":                       55,
+		"IDENTITY_CONFLICT":                 56,
+		"SET_IDENTITY_FAILED":               57,
+		"OAUTH_STATE_INVALID":               58,
+		"PROVIDER_NOT_FOUND":                59,
+		"DELETE_ACCOUNT_FAILED":             60,
+		"EXPORT_USER_DATA_FAILED":           61,
+		"EXPORT_TOO_FREQUENT":               62,
+		"GET_USER_DATA_FAILED":              63,
+		"PURGE_USER_DATA_FAILED":            64,
+		"PASSWORD_TOO_WEAK":                 65,
+		"USER_BLOCKED":                      66,
+		"BLOCK_USER_FAILED":                 67,
+		"GET_USER_BLOCK_FAILED":             68,
+		"GET_FOLLOW_RECOMMENDATIONS_FAILED": 69,
+		"SET_PROFILE_PRIVATE_FAILED":        70,
+		"FOLLOW_REQUEST_NOT_FOUND":          71,
+		"FOLLOW_REQUEST_FAILED":             72,
+		"GET_FOLLOW_REQUESTS_FAILED":        73,
+		"GET_PROFILE_VISIBLE_FAILED":        74,
+		"HANDLE_TAKEN":                      75,
+		"HANDLE_RESERVED":                   76,
+		"HANDLE_COOLDOWN":                   77,
+		"HANDLE_NOT_FOUND":                  78,
+		"SET_HANDLE_FAILED":                 79,
+		"GET_HANDLE_FAILED":                 80,
+		"GET_USER_ROLE_FAILED":              81,
+		"SET_USER_ROLE_FAILED":              82,
+		"USER_SUSPENDED":                    83,
+		"SUSPEND_USER_FAILED":               84,
+		"ADD_AUDIT_LOG_FAILED":              85,
+		"GET_AUDIT_LOG_FAILED":              86,
 	}
 )
 
@@ -197,7 +329,7 @@ var file_user_service_v1_user_error_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x07, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2a, 0x93, 0x09, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x2a, 0xe1, 0x12, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
 	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
 	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x16, 0x56, 0x45, 0x52, 0x49, 0x46,
 	0x59, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
@@ -270,9 +402,86 @@ var file_user_service_v1_user_error_proto_rawDesc = []byte{
 	0x28, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x42, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f,
 	0x55, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x29, 0x12, 0x12, 0x0a, 0x0e,
 	0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x2a,
-	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1b, 0x5a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62,
-	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x1f, 0x0a, 0x15, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x2b, 0x1a, 0x04, 0xa8, 0x45, 0x91,
+	0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x47, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x2c, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x2d, 0x12, 0x18, 0x0a,
+	0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x2e, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x20, 0x0a, 0x16, 0x53, 0x45, 0x4e, 0x44, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e,
+	0x54, 0x10, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x22, 0x0a, 0x18, 0x53, 0x45, 0x4e,
+	0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x30, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x1d, 0x0a,
+	0x13, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45,
+	0x4e, 0x44, 0x45, 0x44, 0x10, 0x31, 0x1a, 0x04, 0xa8, 0x45, 0xf7, 0x03, 0x12, 0x19, 0x0a, 0x0f,
+	0x43, 0x41, 0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x32, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x50, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x33, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12,
+	0x20, 0x0a, 0x16, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47,
+	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x34, 0x1a, 0x04, 0xa8, 0x45, 0x91,
+	0x03, 0x12, 0x1e, 0x0a, 0x14, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x35, 0x1a, 0x04, 0xa8, 0x45, 0x99,
+	0x03, 0x12, 0x1a, 0x0a, 0x10, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e,
+	0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x36, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x37, 0x12, 0x1b,
+	0x0a, 0x11, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x10, 0x38, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x45, 0x54, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x39, 0x12, 0x1d, 0x0a, 0x13, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x3a, 0x1a, 0x04, 0xa8,
+	0x45, 0x90, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x3b, 0x1a, 0x04, 0xa8, 0x45, 0x94,
+	0x03, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x3c, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x3d, 0x12, 0x1d, 0x0a, 0x13, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x54,
+	0x10, 0x3e, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x45, 0x54, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x3f, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x52, 0x47, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x40, 0x12, 0x1b,
+	0x0a, 0x11, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x57,
+	0x45, 0x41, 0x4b, 0x10, 0x41, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x42, 0x1a, 0x04, 0xa8,
+	0x45, 0x93, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x43, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x45,
+	0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x44, 0x12, 0x25, 0x0a, 0x21, 0x47, 0x45, 0x54, 0x5f, 0x46, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x45, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x46, 0x12, 0x22, 0x0a, 0x18,
+	0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x47, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x48, 0x12, 0x1e, 0x0a, 0x1a, 0x47,
+	0x45, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x49, 0x12, 0x1e, 0x0a, 0x1a, 0x47,
+	0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42,
+	0x4c, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x4a, 0x12, 0x16, 0x0a, 0x0c, 0x48,
+	0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x10, 0x4b, 0x1a, 0x04, 0xa8,
+	0x45, 0x99, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x4c, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19,
+	0x0a, 0x0f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4f, 0x4c, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x4d, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x48, 0x41, 0x4e,
+	0x44, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x4e, 0x1a,
+	0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x54, 0x5f, 0x48, 0x41, 0x4e,
+	0x44, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x4f, 0x12, 0x15, 0x0a, 0x11,
+	0x47, 0x45, 0x54, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x50, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x51, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x45, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x52, 0x12, 0x18, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x53, 0x1a, 0x04, 0xa8, 0x45, 0x93,
+	0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x54, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x44,
+	0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x55, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x45, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49,
+	0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x56, 0x1a, 0x04,
+	0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1b, 0x5a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x3b, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
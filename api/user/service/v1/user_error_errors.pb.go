@@ -526,3 +526,531 @@ func IsUniqueAccount(err error) bool {
 func ErrorUniqueAccount(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, UserErrorReason_UNIQUE_ACCOUNT.String(), fmt.Sprintf(format, args...))
 }
+
+func IsRefreshTokenInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_REFRESH_TOKEN_INVALID.String() && e.Code == 401
+}
+
+func ErrorRefreshTokenInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, UserErrorReason_REFRESH_TOKEN_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsLogoutFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_LOGOUT_FAILED.String() && e.Code == 500
+}
+
+func ErrorLogoutFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_LOGOUT_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetSessionsFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_SESSIONS_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetSessionsFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_SESSIONS_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAccountLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_ACCOUNT_LOCKED.String() && e.Code == 429
+}
+
+func ErrorAccountLocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, UserErrorReason_ACCOUNT_LOCKED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSendCodeTooFrequent(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SEND_CODE_TOO_FREQUENT.String() && e.Code == 429
+}
+
+func ErrorSendCodeTooFrequent(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, UserErrorReason_SEND_CODE_TOO_FREQUENT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSendCodeLimitExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SEND_CODE_LIMIT_EXCEEDED.String() && e.Code == 429
+}
+
+func ErrorSendCodeLimitExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, UserErrorReason_SEND_CODE_LIMIT_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSendCodeSuspended(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SEND_CODE_SUSPENDED.String() && e.Code == 503
+}
+
+func ErrorSendCodeSuspended(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, UserErrorReason_SEND_CODE_SUSPENDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCaptchaInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_CAPTCHA_INVALID.String() && e.Code == 400
+}
+
+func ErrorCaptchaInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, UserErrorReason_CAPTCHA_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTotpInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TOTP_INVALID.String() && e.Code == 401
+}
+
+func ErrorTotpInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, UserErrorReason_TOTP_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTotpChallengeInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TOTP_CHALLENGE_INVALID.String() && e.Code == 401
+}
+
+func ErrorTotpChallengeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, UserErrorReason_TOTP_CHALLENGE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTotpAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TOTP_ALREADY_ENABLED.String() && e.Code == 409
+}
+
+func ErrorTotpAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, UserErrorReason_TOTP_ALREADY_ENABLED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTotpNotEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TOTP_NOT_ENABLED.String() && e.Code == 400
+}
+
+func ErrorTotpNotEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, UserErrorReason_TOTP_NOT_ENABLED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTotpFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TOTP_FAILED.String() && e.Code == 500
+}
+
+func ErrorTotpFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_TOTP_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsIdentityConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_IDENTITY_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorIdentityConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, UserErrorReason_IDENTITY_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSetIdentityFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SET_IDENTITY_FAILED.String() && e.Code == 500
+}
+
+func ErrorSetIdentityFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_SET_IDENTITY_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsOauthStateInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_OAUTH_STATE_INVALID.String() && e.Code == 400
+}
+
+func ErrorOauthStateInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, UserErrorReason_OAUTH_STATE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsProviderNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_PROVIDER_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorProviderNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, UserErrorReason_PROVIDER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsDeleteAccountFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_DELETE_ACCOUNT_FAILED.String() && e.Code == 500
+}
+
+func ErrorDeleteAccountFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_DELETE_ACCOUNT_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsExportUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_EXPORT_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorExportUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_EXPORT_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsExportTooFrequent(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_EXPORT_TOO_FREQUENT.String() && e.Code == 429
+}
+
+func ErrorExportTooFrequent(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, UserErrorReason_EXPORT_TOO_FREQUENT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPurgeUserDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_PURGE_USER_DATA_FAILED.String() && e.Code == 500
+}
+
+func ErrorPurgeUserDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_PURGE_USER_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsPasswordTooWeak(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_PASSWORD_TOO_WEAK.String() && e.Code == 400
+}
+
+func ErrorPasswordTooWeak(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, UserErrorReason_PASSWORD_TOO_WEAK.String(), fmt.Sprintf(format, args...))
+}
+
+func IsUserBlocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_USER_BLOCKED.String() && e.Code == 403
+}
+
+func ErrorUserBlocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, UserErrorReason_USER_BLOCKED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsBlockUserFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_BLOCK_USER_FAILED.String() && e.Code == 500
+}
+
+func ErrorBlockUserFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_BLOCK_USER_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetUserBlockFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_USER_BLOCK_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetUserBlockFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_USER_BLOCK_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetFollowRecommendationsFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_FOLLOW_RECOMMENDATIONS_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetFollowRecommendationsFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_FOLLOW_RECOMMENDATIONS_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSetProfilePrivateFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SET_PROFILE_PRIVATE_FAILED.String() && e.Code == 500
+}
+
+func ErrorSetProfilePrivateFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_SET_PROFILE_PRIVATE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsFollowRequestNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_FOLLOW_REQUEST_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorFollowRequestNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, UserErrorReason_FOLLOW_REQUEST_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsFollowRequestFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_FOLLOW_REQUEST_FAILED.String() && e.Code == 500
+}
+
+func ErrorFollowRequestFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_FOLLOW_REQUEST_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetFollowRequestsFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_FOLLOW_REQUESTS_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetFollowRequestsFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_FOLLOW_REQUESTS_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetProfileVisibleFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_PROFILE_VISIBLE_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetProfileVisibleFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_PROFILE_VISIBLE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsHandleTaken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_HANDLE_TAKEN.String() && e.Code == 409
+}
+
+func ErrorHandleTaken(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, UserErrorReason_HANDLE_TAKEN.String(), fmt.Sprintf(format, args...))
+}
+
+func IsHandleReserved(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_HANDLE_RESERVED.String() && e.Code == 400
+}
+
+func ErrorHandleReserved(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, UserErrorReason_HANDLE_RESERVED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsHandleCooldown(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_HANDLE_COOLDOWN.String() && e.Code == 429
+}
+
+func ErrorHandleCooldown(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, UserErrorReason_HANDLE_COOLDOWN.String(), fmt.Sprintf(format, args...))
+}
+
+func IsHandleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_HANDLE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorHandleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, UserErrorReason_HANDLE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSetHandleFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SET_HANDLE_FAILED.String() && e.Code == 500
+}
+
+func ErrorSetHandleFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_SET_HANDLE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetHandleFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_HANDLE_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetHandleFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_HANDLE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetUserRoleFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_USER_ROLE_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetUserRoleFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_USER_ROLE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSetUserRoleFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SET_USER_ROLE_FAILED.String() && e.Code == 500
+}
+
+func ErrorSetUserRoleFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_SET_USER_ROLE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsUserSuspended(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_USER_SUSPENDED.String() && e.Code == 403
+}
+
+func ErrorUserSuspended(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, UserErrorReason_USER_SUSPENDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSuspendUserFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_SUSPEND_USER_FAILED.String() && e.Code == 500
+}
+
+func ErrorSuspendUserFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_SUSPEND_USER_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsAddAuditLogFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_ADD_AUDIT_LOG_FAILED.String() && e.Code == 500
+}
+
+func ErrorAddAuditLogFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_ADD_AUDIT_LOG_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsGetAuditLogFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_GET_AUDIT_LOG_FAILED.String() && e.Code == 500
+}
+
+func ErrorGetAuditLogFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, UserErrorReason_GET_AUDIT_LOG_FAILED.String(), fmt.Sprintf(format, args...))
+}
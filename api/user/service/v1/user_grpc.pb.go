@@ -30,25 +30,41 @@ type UserClient interface {
 	AddCoverReviewDbAndCache(ctx context.Context, in *AddCoverReviewDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	LoginByPassword(ctx context.Context, in *LoginByPasswordReq, opts ...grpc.CallOption) (*LoginReply, error)
 	LoginByCode(ctx context.Context, in *LoginByCodeReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByWeChat(ctx context.Context, in *LoginByWeChatReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByQQ(ctx context.Context, in *LoginByQQReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByGithub(ctx context.Context, in *LoginByGithubReq, opts ...grpc.CallOption) (*LoginReply, error)
-	LoginByGitee(ctx context.Context, in *LoginByGiteeReq, opts ...grpc.CallOption) (*LoginReply, error)
+	GetOauthUrl(ctx context.Context, in *GetOauthUrlReq, opts ...grpc.CallOption) (*GetOauthUrlReply, error)
+	LoginByOauth(ctx context.Context, in *LoginByOauthReq, opts ...grpc.CallOption) (*LoginReply, error)
 	LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*LoginReply, error)
+	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSessions(ctx context.Context, in *ListSessionsReq, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	LoginByTotp(ctx context.Context, in *LoginByTotpReq, opts ...grpc.CallOption) (*LoginReply, error)
+	EnrollTotp(ctx context.Context, in *EnrollTotpReq, opts ...grpc.CallOption) (*EnrollTotpReply, error)
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...grpc.CallOption) (*ConfirmTotpReply, error)
+	DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendPhoneCode(ctx context.Context, in *SendPhoneCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendEmailCode(ctx context.Context, in *SendEmailCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCosSessionKey(ctx context.Context, in *GetCosSessionKeyReq, opts ...grpc.CallOption) (*GetCosSessionKeyReply, error)
 	SetUserPhone(ctx context.Context, in *SetUserPhoneReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserEmail(ctx context.Context, in *SetUserEmailReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserPassword(ctx context.Context, in *SetUserPasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserWechat(ctx context.Context, in *SetUserWechatReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserQQ(ctx context.Context, in *SetUserQQReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserGitee(ctx context.Context, in *SetUserGiteeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetUserGithub(ctx context.Context, in *SetUserGithubReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserIdentity(ctx context.Context, in *SetUserIdentityReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserFollow(ctx context.Context, in *SetUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetFollowDbAndCache(ctx context.Context, in *SetFollowDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelUserFollow(ctx context.Context, in *CancelUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelFollowDbAndCache(ctx context.Context, in *CancelFollowDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MuteUser(ctx context.Context, in *MuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetProfilePrivate(ctx context.Context, in *SetProfilePrivateReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserHandle(ctx context.Context, in *SetUserHandleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogReply, error)
+	CompleteAuditLog(ctx context.Context, in *CompleteAuditLogReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetAccount(ctx context.Context, in *GetAccountReq, opts ...grpc.CallOption) (*GetAccountReply, error)
 	GetProfile(ctx context.Context, in *GetProfileReq, opts ...grpc.CallOption) (*GetProfileReply, error)
 	GetProfileList(ctx context.Context, in *GetProfileListReq, opts ...grpc.CallOption) (*GetProfileListReply, error)
@@ -59,6 +75,16 @@ type UserClient interface {
 	GetFollowedList(ctx context.Context, in *GetFollowedListReq, opts ...grpc.CallOption) (*GetFollowedListReply, error)
 	GetFollowedListCount(ctx context.Context, in *GetFollowedListCountReq, opts ...grpc.CallOption) (*GetFollowedListCountReply, error)
 	GetUserFollows(ctx context.Context, in *GetUserFollowsReq, opts ...grpc.CallOption) (*GetUserFollowsReply, error)
+	GetUserBlock(ctx context.Context, in *GetUserBlockReq, opts ...grpc.CallOption) (*GetUserBlockReply, error)
+	GetUserBlocks(ctx context.Context, in *GetUserBlocksReq, opts ...grpc.CallOption) (*GetUserBlocksReply, error)
+	GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsReq, opts ...grpc.CallOption) (*GetFollowRecommendationsReply, error)
+	GetFollowRequests(ctx context.Context, in *GetFollowRequestsReq, opts ...grpc.CallOption) (*GetFollowRequestsReply, error)
+	GetProfileVisible(ctx context.Context, in *GetProfileVisibleReq, opts ...grpc.CallOption) (*GetProfileVisibleReply, error)
+	GetUserHandle(ctx context.Context, in *GetUserHandleReq, opts ...grpc.CallOption) (*GetUserHandleReply, error)
+	GetUserByHandle(ctx context.Context, in *GetUserByHandleReq, opts ...grpc.CallOption) (*GetUserByHandleReply, error)
+	GetHandleUuids(ctx context.Context, in *GetHandleUuidsReq, opts ...grpc.CallOption) (*GetHandleUuidsReply, error)
+	GetUserRole(ctx context.Context, in *GetUserRoleReq, opts ...grpc.CallOption) (*GetUserRoleReply, error)
+	GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...grpc.CallOption) (*GetAuditLogListReply, error)
 	GetUserSearch(ctx context.Context, in *GetUserSearchReq, opts ...grpc.CallOption) (*GetUserSearchReply, error)
 	GetAvatarReview(ctx context.Context, in *GetAvatarReviewReq, opts ...grpc.CallOption) (*GetAvatarReviewReply, error)
 	GetCoverReview(ctx context.Context, in *GetCoverReviewReq, opts ...grpc.CallOption) (*GetCoverReviewReply, error)
@@ -68,10 +94,12 @@ type UserClient interface {
 	ChangeUserPassword(ctx context.Context, in *ChangeUserPasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnbindUserPhone(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnbindUserEmail(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserWechat(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserQQ(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserGitee(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UnbindUserGithub(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnbindUserIdentity(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAccount(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (*ExportUserDataReply, error)
+	GetUserDataExport(ctx context.Context, in *GetUserDataExportReq, opts ...grpc.CallOption) (*GetUserDataExportReply, error)
+	GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error)
+	PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -146,45 +174,99 @@ func (c *userClient) LoginByCode(ctx context.Context, in *LoginByCodeReq, opts .
 	return out, nil
 }
 
-func (c *userClient) LoginByWeChat(ctx context.Context, in *LoginByWeChatReq, opts ...grpc.CallOption) (*LoginReply, error) {
-	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/user.v1.User/LoginByWeChat", in, out, opts...)
+func (c *userClient) GetOauthUrl(ctx context.Context, in *GetOauthUrlReq, opts ...grpc.CallOption) (*GetOauthUrlReply, error) {
+	out := new(GetOauthUrlReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetOauthUrl", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) LoginByQQ(ctx context.Context, in *LoginByQQReq, opts ...grpc.CallOption) (*LoginReply, error) {
+func (c *userClient) LoginByOauth(ctx context.Context, in *LoginByOauthReq, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/user.v1.User/LoginByQQ", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/LoginByOauth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/LoginPasswordReset", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) LoginByGithub(ctx context.Context, in *LoginByGithubReq, opts ...grpc.CallOption) (*LoginReply, error) {
+func (c *userClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/user.v1.User/LoginByGithub", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) LogoutAllDevices(ctx context.Context, in *LogoutAllDevicesReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/LogoutAllDevices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) LoginByGitee(ctx context.Context, in *LoginByGiteeReq, opts ...grpc.CallOption) (*LoginReply, error) {
+func (c *userClient) ListSessions(ctx context.Context, in *ListSessionsReq, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) LoginByTotp(ctx context.Context, in *LoginByTotpReq, opts ...grpc.CallOption) (*LoginReply, error) {
 	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/user.v1.User/LoginByGitee", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/LoginByTotp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) LoginPasswordReset(ctx context.Context, in *LoginPasswordResetReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) EnrollTotp(ctx context.Context, in *EnrollTotpReq, opts ...grpc.CallOption) (*EnrollTotpReply, error) {
+	out := new(EnrollTotpReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/EnrollTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...grpc.CallOption) (*ConfirmTotpReply, error) {
+	out := new(ConfirmTotpReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/ConfirmTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/LoginPasswordReset", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/DisableTotp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -245,72 +327,162 @@ func (c *userClient) SetUserPassword(ctx context.Context, in *SetUserPasswordReq
 	return out, nil
 }
 
-func (c *userClient) SetUserWechat(ctx context.Context, in *SetUserWechatReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) SetUserIdentity(ctx context.Context, in *SetUserIdentityReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserWechat", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserIdentity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) SetUserQQ(ctx context.Context, in *SetUserQQReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) SetUserFollow(ctx context.Context, in *SetUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserQQ", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserFollow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) SetUserGitee(ctx context.Context, in *SetUserGiteeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) SetFollowDbAndCache(ctx context.Context, in *SetFollowDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserGitee", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SetFollowDbAndCache", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) SetUserGithub(ctx context.Context, in *SetUserGithubReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) CancelUserFollow(ctx context.Context, in *CancelUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserGithub", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/CancelUserFollow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) SetUserFollow(ctx context.Context, in *SetUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) CancelFollowDbAndCache(ctx context.Context, in *CancelFollowDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserFollow", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/CancelFollowDbAndCache", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) SetFollowDbAndCache(ctx context.Context, in *SetFollowDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/SetFollowDbAndCache", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/BlockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) CancelUserFollow(ctx context.Context, in *CancelUserFollowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/CancelUserFollow", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/UnblockUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) CancelFollowDbAndCache(ctx context.Context, in *CancelFollowDbAndCacheReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) MuteUser(ctx context.Context, in *MuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/CancelFollowDbAndCache", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/MuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnmuteUser(ctx context.Context, in *UnmuteUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/UnmuteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetProfilePrivate(ctx context.Context, in *SetProfilePrivateReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SetProfilePrivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/ApproveFollowRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/RejectFollowRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetUserHandle(ctx context.Context, in *SetUserHandleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/SuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/UnsuspendUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogReply, error) {
+	out := new(AddAuditLogReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/AddAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CompleteAuditLog(ctx context.Context, in *CompleteAuditLogReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/user.v1.User/CompleteAuditLog", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +579,96 @@ func (c *userClient) GetUserFollows(ctx context.Context, in *GetUserFollowsReq,
 	return out, nil
 }
 
+func (c *userClient) GetUserBlock(ctx context.Context, in *GetUserBlockReq, opts ...grpc.CallOption) (*GetUserBlockReply, error) {
+	out := new(GetUserBlockReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserBlocks(ctx context.Context, in *GetUserBlocksReq, opts ...grpc.CallOption) (*GetUserBlocksReply, error) {
+	out := new(GetUserBlocksReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsReq, opts ...grpc.CallOption) (*GetFollowRecommendationsReply, error) {
+	out := new(GetFollowRecommendationsReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetFollowRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetFollowRequests(ctx context.Context, in *GetFollowRequestsReq, opts ...grpc.CallOption) (*GetFollowRequestsReply, error) {
+	out := new(GetFollowRequestsReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetFollowRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetProfileVisible(ctx context.Context, in *GetProfileVisibleReq, opts ...grpc.CallOption) (*GetProfileVisibleReply, error) {
+	out := new(GetProfileVisibleReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetProfileVisible", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserHandle(ctx context.Context, in *GetUserHandleReq, opts ...grpc.CallOption) (*GetUserHandleReply, error) {
+	out := new(GetUserHandleReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserByHandle(ctx context.Context, in *GetUserByHandleReq, opts ...grpc.CallOption) (*GetUserByHandleReply, error) {
+	out := new(GetUserByHandleReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserByHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetHandleUuids(ctx context.Context, in *GetHandleUuidsReq, opts ...grpc.CallOption) (*GetHandleUuidsReply, error) {
+	out := new(GetHandleUuidsReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetHandleUuids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserRole(ctx context.Context, in *GetUserRoleReq, opts ...grpc.CallOption) (*GetUserRoleReply, error) {
+	out := new(GetUserRoleReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetAuditLogList(ctx context.Context, in *GetAuditLogListReq, opts ...grpc.CallOption) (*GetAuditLogListReply, error) {
+	out := new(GetAuditLogListReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetAuditLogList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetUserSearch(ctx context.Context, in *GetUserSearchReq, opts ...grpc.CallOption) (*GetUserSearchReply, error) {
 	out := new(GetUserSearchReply)
 	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserSearch", in, out, opts...)
@@ -488,36 +750,54 @@ func (c *userClient) UnbindUserEmail(ctx context.Context, in *UnbindUserAccountR
 	return out, nil
 }
 
-func (c *userClient) UnbindUserWechat(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) UnbindUserIdentity(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/UnbindUserWechat", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/UnbindUserIdentity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) UnbindUserQQ(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) DeleteAccount(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/UnbindUserQQ", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/DeleteAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) UnbindUserGitee(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/UnbindUserGitee", in, out, opts...)
+func (c *userClient) ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (*ExportUserDataReply, error) {
+	out := new(ExportUserDataReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserDataExport(ctx context.Context, in *GetUserDataExportReq, opts ...grpc.CallOption) (*GetUserDataExportReply, error) {
+	out := new(GetUserDataExportReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserData(ctx context.Context, in *GetUserDataReq, opts ...grpc.CallOption) (*GetUserDataReply, error) {
+	out := new(GetUserDataReply)
+	err := c.cc.Invoke(ctx, "/user.v1.User/GetUserData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userClient) UnbindUserGithub(ctx context.Context, in *UnbindUserAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *userClient) PurgeUserData(ctx context.Context, in *PurgeUserDataReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/user.v1.User/UnbindUserGithub", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/user.v1.User/PurgeUserData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -544,25 +824,41 @@ type UserServer interface {
 	AddCoverReviewDbAndCache(context.Context, *AddCoverReviewDbAndCacheReq) (*emptypb.Empty, error)
 	LoginByPassword(context.Context, *LoginByPasswordReq) (*LoginReply, error)
 	LoginByCode(context.Context, *LoginByCodeReq) (*LoginReply, error)
-	LoginByWeChat(context.Context, *LoginByWeChatReq) (*LoginReply, error)
-	LoginByQQ(context.Context, *LoginByQQReq) (*LoginReply, error)
-	LoginByGithub(context.Context, *LoginByGithubReq) (*LoginReply, error)
-	LoginByGitee(context.Context, *LoginByGiteeReq) (*LoginReply, error)
+	GetOauthUrl(context.Context, *GetOauthUrlReq) (*GetOauthUrlReply, error)
+	LoginByOauth(context.Context, *LoginByOauthReq) (*LoginReply, error)
 	LoginPasswordReset(context.Context, *LoginPasswordResetReq) (*emptypb.Empty, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*LoginReply, error)
+	Logout(context.Context, *LogoutReq) (*emptypb.Empty, error)
+	LogoutAllDevices(context.Context, *LogoutAllDevicesReq) (*emptypb.Empty, error)
+	ListSessions(context.Context, *ListSessionsReq) (*ListSessionsReply, error)
+	LoginByTotp(context.Context, *LoginByTotpReq) (*LoginReply, error)
+	EnrollTotp(context.Context, *EnrollTotpReq) (*EnrollTotpReply, error)
+	ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpReply, error)
+	DisableTotp(context.Context, *DisableTotpReq) (*emptypb.Empty, error)
 	SendPhoneCode(context.Context, *SendPhoneCodeReq) (*emptypb.Empty, error)
 	SendEmailCode(context.Context, *SendEmailCodeReq) (*emptypb.Empty, error)
 	GetCosSessionKey(context.Context, *GetCosSessionKeyReq) (*GetCosSessionKeyReply, error)
 	SetUserPhone(context.Context, *SetUserPhoneReq) (*emptypb.Empty, error)
 	SetUserEmail(context.Context, *SetUserEmailReq) (*emptypb.Empty, error)
 	SetUserPassword(context.Context, *SetUserPasswordReq) (*emptypb.Empty, error)
-	SetUserWechat(context.Context, *SetUserWechatReq) (*emptypb.Empty, error)
-	SetUserQQ(context.Context, *SetUserQQReq) (*emptypb.Empty, error)
-	SetUserGitee(context.Context, *SetUserGiteeReq) (*emptypb.Empty, error)
-	SetUserGithub(context.Context, *SetUserGithubReq) (*emptypb.Empty, error)
+	SetUserIdentity(context.Context, *SetUserIdentityReq) (*emptypb.Empty, error)
 	SetUserFollow(context.Context, *SetUserFollowReq) (*emptypb.Empty, error)
 	SetFollowDbAndCache(context.Context, *SetFollowDbAndCacheReq) (*emptypb.Empty, error)
 	CancelUserFollow(context.Context, *CancelUserFollowReq) (*emptypb.Empty, error)
 	CancelFollowDbAndCache(context.Context, *CancelFollowDbAndCacheReq) (*emptypb.Empty, error)
+	BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error)
+	MuteUser(context.Context, *MuteUserReq) (*emptypb.Empty, error)
+	UnmuteUser(context.Context, *UnmuteUserReq) (*emptypb.Empty, error)
+	SetProfilePrivate(context.Context, *SetProfilePrivateReq) (*emptypb.Empty, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestReq) (*emptypb.Empty, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestReq) (*emptypb.Empty, error)
+	SetUserHandle(context.Context, *SetUserHandleReq) (*emptypb.Empty, error)
+	SetUserRole(context.Context, *SetUserRoleReq) (*emptypb.Empty, error)
+	SuspendUser(context.Context, *SuspendUserReq) (*emptypb.Empty, error)
+	UnsuspendUser(context.Context, *UnsuspendUserReq) (*emptypb.Empty, error)
+	AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogReply, error)
+	CompleteAuditLog(context.Context, *CompleteAuditLogReq) (*emptypb.Empty, error)
 	GetAccount(context.Context, *GetAccountReq) (*GetAccountReply, error)
 	GetProfile(context.Context, *GetProfileReq) (*GetProfileReply, error)
 	GetProfileList(context.Context, *GetProfileListReq) (*GetProfileListReply, error)
@@ -573,6 +869,16 @@ type UserServer interface {
 	GetFollowedList(context.Context, *GetFollowedListReq) (*GetFollowedListReply, error)
 	GetFollowedListCount(context.Context, *GetFollowedListCountReq) (*GetFollowedListCountReply, error)
 	GetUserFollows(context.Context, *GetUserFollowsReq) (*GetUserFollowsReply, error)
+	GetUserBlock(context.Context, *GetUserBlockReq) (*GetUserBlockReply, error)
+	GetUserBlocks(context.Context, *GetUserBlocksReq) (*GetUserBlocksReply, error)
+	GetFollowRecommendations(context.Context, *GetFollowRecommendationsReq) (*GetFollowRecommendationsReply, error)
+	GetFollowRequests(context.Context, *GetFollowRequestsReq) (*GetFollowRequestsReply, error)
+	GetProfileVisible(context.Context, *GetProfileVisibleReq) (*GetProfileVisibleReply, error)
+	GetUserHandle(context.Context, *GetUserHandleReq) (*GetUserHandleReply, error)
+	GetUserByHandle(context.Context, *GetUserByHandleReq) (*GetUserByHandleReply, error)
+	GetHandleUuids(context.Context, *GetHandleUuidsReq) (*GetHandleUuidsReply, error)
+	GetUserRole(context.Context, *GetUserRoleReq) (*GetUserRoleReply, error)
+	GetAuditLogList(context.Context, *GetAuditLogListReq) (*GetAuditLogListReply, error)
 	GetUserSearch(context.Context, *GetUserSearchReq) (*GetUserSearchReply, error)
 	GetAvatarReview(context.Context, *GetAvatarReviewReq) (*GetAvatarReviewReply, error)
 	GetCoverReview(context.Context, *GetCoverReviewReq) (*GetCoverReviewReply, error)
@@ -582,10 +888,12 @@ type UserServer interface {
 	ChangeUserPassword(context.Context, *ChangeUserPasswordReq) (*emptypb.Empty, error)
 	UnbindUserPhone(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
 	UnbindUserEmail(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserWechat(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserQQ(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserGitee(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
-	UnbindUserGithub(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	UnbindUserIdentity(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	DeleteAccount(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error)
+	ExportUserData(context.Context, *ExportUserDataReq) (*ExportUserDataReply, error)
+	GetUserDataExport(context.Context, *GetUserDataExportReq) (*GetUserDataExportReply, error)
+	GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error)
+	PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error)
 	GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserServer()
 }
@@ -615,21 +923,39 @@ func (UnimplementedUserServer) LoginByPassword(context.Context, *LoginByPassword
 func (UnimplementedUserServer) LoginByCode(context.Context, *LoginByCodeReq) (*LoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginByCode not implemented")
 }
-func (UnimplementedUserServer) LoginByWeChat(context.Context, *LoginByWeChatReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByWeChat not implemented")
-}
-func (UnimplementedUserServer) LoginByQQ(context.Context, *LoginByQQReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByQQ not implemented")
-}
-func (UnimplementedUserServer) LoginByGithub(context.Context, *LoginByGithubReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByGithub not implemented")
+func (UnimplementedUserServer) GetOauthUrl(context.Context, *GetOauthUrlReq) (*GetOauthUrlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthUrl not implemented")
 }
-func (UnimplementedUserServer) LoginByGitee(context.Context, *LoginByGiteeReq) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginByGitee not implemented")
+func (UnimplementedUserServer) LoginByOauth(context.Context, *LoginByOauthReq) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByOauth not implemented")
 }
 func (UnimplementedUserServer) LoginPasswordReset(context.Context, *LoginPasswordResetReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginPasswordReset not implemented")
 }
+func (UnimplementedUserServer) RefreshToken(context.Context, *RefreshTokenReq) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServer) Logout(context.Context, *LogoutReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedUserServer) LogoutAllDevices(context.Context, *LogoutAllDevicesReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllDevices not implemented")
+}
+func (UnimplementedUserServer) ListSessions(context.Context, *ListSessionsReq) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServer) LoginByTotp(context.Context, *LoginByTotpReq) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByTotp not implemented")
+}
+func (UnimplementedUserServer) EnrollTotp(context.Context, *EnrollTotpReq) (*EnrollTotpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedUserServer) ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (UnimplementedUserServer) DisableTotp(context.Context, *DisableTotpReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
 func (UnimplementedUserServer) SendPhoneCode(context.Context, *SendPhoneCodeReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPhoneCode not implemented")
 }
@@ -648,17 +974,8 @@ func (UnimplementedUserServer) SetUserEmail(context.Context, *SetUserEmailReq) (
 func (UnimplementedUserServer) SetUserPassword(context.Context, *SetUserPasswordReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserPassword not implemented")
 }
-func (UnimplementedUserServer) SetUserWechat(context.Context, *SetUserWechatReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserWechat not implemented")
-}
-func (UnimplementedUserServer) SetUserQQ(context.Context, *SetUserQQReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserQQ not implemented")
-}
-func (UnimplementedUserServer) SetUserGitee(context.Context, *SetUserGiteeReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserGitee not implemented")
-}
-func (UnimplementedUserServer) SetUserGithub(context.Context, *SetUserGithubReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetUserGithub not implemented")
+func (UnimplementedUserServer) SetUserIdentity(context.Context, *SetUserIdentityReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserIdentity not implemented")
 }
 func (UnimplementedUserServer) SetUserFollow(context.Context, *SetUserFollowReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserFollow not implemented")
@@ -672,6 +989,45 @@ func (UnimplementedUserServer) CancelUserFollow(context.Context, *CancelUserFoll
 func (UnimplementedUserServer) CancelFollowDbAndCache(context.Context, *CancelFollowDbAndCacheReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelFollowDbAndCache not implemented")
 }
+func (UnimplementedUserServer) BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServer) UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServer) MuteUser(context.Context, *MuteUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedUserServer) UnmuteUser(context.Context, *UnmuteUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedUserServer) SetProfilePrivate(context.Context, *SetProfilePrivateReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfilePrivate not implemented")
+}
+func (UnimplementedUserServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedUserServer) RejectFollowRequest(context.Context, *RejectFollowRequestReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedUserServer) SetUserHandle(context.Context, *SetUserHandleReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserHandle not implemented")
+}
+func (UnimplementedUserServer) SetUserRole(context.Context, *SetUserRoleReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedUserServer) SuspendUser(context.Context, *SuspendUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserServer) UnsuspendUser(context.Context, *UnsuspendUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendUser not implemented")
+}
+func (UnimplementedUserServer) AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditLog not implemented")
+}
+func (UnimplementedUserServer) CompleteAuditLog(context.Context, *CompleteAuditLogReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAuditLog not implemented")
+}
 func (UnimplementedUserServer) GetAccount(context.Context, *GetAccountReq) (*GetAccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
@@ -702,6 +1058,36 @@ func (UnimplementedUserServer) GetFollowedListCount(context.Context, *GetFollowe
 func (UnimplementedUserServer) GetUserFollows(context.Context, *GetUserFollowsReq) (*GetUserFollowsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollows not implemented")
 }
+func (UnimplementedUserServer) GetUserBlock(context.Context, *GetUserBlockReq) (*GetUserBlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBlock not implemented")
+}
+func (UnimplementedUserServer) GetUserBlocks(context.Context, *GetUserBlocksReq) (*GetUserBlocksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBlocks not implemented")
+}
+func (UnimplementedUserServer) GetFollowRecommendations(context.Context, *GetFollowRecommendationsReq) (*GetFollowRecommendationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRecommendations not implemented")
+}
+func (UnimplementedUserServer) GetFollowRequests(context.Context, *GetFollowRequestsReq) (*GetFollowRequestsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRequests not implemented")
+}
+func (UnimplementedUserServer) GetProfileVisible(context.Context, *GetProfileVisibleReq) (*GetProfileVisibleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileVisible not implemented")
+}
+func (UnimplementedUserServer) GetUserHandle(context.Context, *GetUserHandleReq) (*GetUserHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserHandle not implemented")
+}
+func (UnimplementedUserServer) GetUserByHandle(context.Context, *GetUserByHandleReq) (*GetUserByHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByHandle not implemented")
+}
+func (UnimplementedUserServer) GetHandleUuids(context.Context, *GetHandleUuidsReq) (*GetHandleUuidsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandleUuids not implemented")
+}
+func (UnimplementedUserServer) GetUserRole(context.Context, *GetUserRoleReq) (*GetUserRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRole not implemented")
+}
+func (UnimplementedUserServer) GetAuditLogList(context.Context, *GetAuditLogListReq) (*GetAuditLogListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogList not implemented")
+}
 func (UnimplementedUserServer) GetUserSearch(context.Context, *GetUserSearchReq) (*GetUserSearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserSearch not implemented")
 }
@@ -729,17 +1115,23 @@ func (UnimplementedUserServer) UnbindUserPhone(context.Context, *UnbindUserAccou
 func (UnimplementedUserServer) UnbindUserEmail(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserEmail not implemented")
 }
-func (UnimplementedUserServer) UnbindUserWechat(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserWechat not implemented")
+func (UnimplementedUserServer) UnbindUserIdentity(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserIdentity not implemented")
+}
+func (UnimplementedUserServer) DeleteAccount(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedUserServer) ExportUserData(context.Context, *ExportUserDataReq) (*ExportUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
 }
-func (UnimplementedUserServer) UnbindUserQQ(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserQQ not implemented")
+func (UnimplementedUserServer) GetUserDataExport(context.Context, *GetUserDataExportReq) (*GetUserDataExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDataExport not implemented")
 }
-func (UnimplementedUserServer) UnbindUserGitee(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserGitee not implemented")
+func (UnimplementedUserServer) GetUserData(context.Context, *GetUserDataReq) (*GetUserDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
 }
-func (UnimplementedUserServer) UnbindUserGithub(context.Context, *UnbindUserAccountReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbindUserGithub not implemented")
+func (UnimplementedUserServer) PurgeUserData(context.Context, *PurgeUserDataReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserData not implemented")
 }
 func (UnimplementedUserServer) GetHealth(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
@@ -878,529 +1270,997 @@ func _User_LoginByCode_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: "/user.v1.User/LoginByCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginByCode(ctx, req.(*LoginByCodeReq))
+		return srv.(UserServer).LoginByCode(ctx, req.(*LoginByCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetOauthUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOauthUrlReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetOauthUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/GetOauthUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetOauthUrl(ctx, req.(*GetOauthUrlReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LoginByOauth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByOauthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LoginByOauth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/LoginByOauth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LoginByOauth(ctx, req.(*LoginByOauthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LoginPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginPasswordResetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LoginPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/LoginPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LoginPasswordReset(ctx, req.(*LoginPasswordResetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Logout(ctx, req.(*LogoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LogoutAllDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllDevicesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LogoutAllDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/LogoutAllDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LogoutAllDevices(ctx, req.(*LogoutAllDevicesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListSessions(ctx, req.(*ListSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LoginByTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LoginByTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/LoginByTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LoginByTotp(ctx, req.(*LoginByTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/EnrollTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).EnrollTotp(ctx, req.(*EnrollTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ConfirmTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/ConfirmTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ConfirmTotp(ctx, req.(*ConfirmTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/DisableTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DisableTotp(ctx, req.(*DisableTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendPhoneCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPhoneCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendPhoneCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SendPhoneCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendPhoneCode(ctx, req.(*SendPhoneCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendEmailCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendEmailCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SendEmailCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendEmailCode(ctx, req.(*SendEmailCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetCosSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCosSessionKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetCosSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/GetCosSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetCosSessionKey(ctx, req.(*GetCosSessionKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPhoneReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SetUserPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserPhone(ctx, req.(*SetUserPhoneReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SetUserEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserEmail(ctx, req.(*SetUserEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SetUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserPassword(ctx, req.(*SetUserPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserIdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SetUserIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserIdentity(ctx, req.(*SetUserIdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SetUserFollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserFollow(ctx, req.(*SetUserFollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetFollowDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFollowDbAndCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetFollowDbAndCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/SetFollowDbAndCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetFollowDbAndCache(ctx, req.(*SetFollowDbAndCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CancelUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelUserFollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CancelUserFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/CancelUserFollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CancelUserFollow(ctx, req.(*CancelUserFollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CancelFollowDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFollowDbAndCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CancelFollowDbAndCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/CancelFollowDbAndCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CancelFollowDbAndCache(ctx, req.(*CancelFollowDbAndCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/MuteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).MuteUser(ctx, req.(*MuteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/UnmuteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnmuteUser(ctx, req.(*UnmuteUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_LoginByWeChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByWeChatReq)
+func _User_SetProfilePrivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfilePrivateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).LoginByWeChat(ctx, in)
+		return srv.(UserServer).SetProfilePrivate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/LoginByWeChat",
+		FullMethod: "/user.v1.User/SetProfilePrivate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginByWeChat(ctx, req.(*LoginByWeChatReq))
+		return srv.(UserServer).SetProfilePrivate(ctx, req.(*SetProfilePrivateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_LoginByQQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByQQReq)
+func _User_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).LoginByQQ(ctx, in)
+		return srv.(UserServer).ApproveFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/LoginByQQ",
+		FullMethod: "/user.v1.User/ApproveFollowRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginByQQ(ctx, req.(*LoginByQQReq))
+		return srv.(UserServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_LoginByGithub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByGithubReq)
+func _User_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).LoginByGithub(ctx, in)
+		return srv.(UserServer).RejectFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/LoginByGithub",
+		FullMethod: "/user.v1.User/RejectFollowRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginByGithub(ctx, req.(*LoginByGithubReq))
+		return srv.(UserServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_LoginByGitee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByGiteeReq)
+func _User_SetUserHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserHandleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).LoginByGitee(ctx, in)
+		return srv.(UserServer).SetUserHandle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/LoginByGitee",
+		FullMethod: "/user.v1.User/SetUserHandle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginByGitee(ctx, req.(*LoginByGiteeReq))
+		return srv.(UserServer).SetUserHandle(ctx, req.(*SetUserHandleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_LoginPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginPasswordResetReq)
+func _User_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).LoginPasswordReset(ctx, in)
+		return srv.(UserServer).SetUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/LoginPasswordReset",
+		FullMethod: "/user.v1.User/SetUserRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginPasswordReset(ctx, req.(*LoginPasswordResetReq))
+		return srv.(UserServer).SetUserRole(ctx, req.(*SetUserRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SendPhoneCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendPhoneCodeReq)
+func _User_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SendPhoneCode(ctx, in)
+		return srv.(UserServer).SuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SendPhoneCode",
+		FullMethod: "/user.v1.User/SuspendUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SendPhoneCode(ctx, req.(*SendPhoneCodeReq))
+		return srv.(UserServer).SuspendUser(ctx, req.(*SuspendUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SendEmailCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendEmailCodeReq)
+func _User_UnsuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SendEmailCode(ctx, in)
+		return srv.(UserServer).UnsuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SendEmailCode",
+		FullMethod: "/user.v1.User/UnsuspendUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SendEmailCode(ctx, req.(*SendEmailCodeReq))
+		return srv.(UserServer).UnsuspendUser(ctx, req.(*UnsuspendUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetCosSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCosSessionKeyReq)
+func _User_AddAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuditLogReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetCosSessionKey(ctx, in)
+		return srv.(UserServer).AddAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetCosSessionKey",
+		FullMethod: "/user.v1.User/AddAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetCosSessionKey(ctx, req.(*GetCosSessionKeyReq))
+		return srv.(UserServer).AddAuditLog(ctx, req.(*AddAuditLogReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserPhoneReq)
+func _User_CompleteAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAuditLogReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserPhone(ctx, in)
+		return srv.(UserServer).CompleteAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserPhone",
+		FullMethod: "/user.v1.User/CompleteAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserPhone(ctx, req.(*SetUserPhoneReq))
+		return srv.(UserServer).CompleteAuditLog(ctx, req.(*CompleteAuditLogReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserEmailReq)
+func _User_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserEmail(ctx, in)
+		return srv.(UserServer).GetAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserEmail",
+		FullMethod: "/user.v1.User/GetAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserEmail(ctx, req.(*SetUserEmailReq))
+		return srv.(UserServer).GetAccount(ctx, req.(*GetAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserPasswordReq)
+func _User_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserPassword(ctx, in)
+		return srv.(UserServer).GetProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserPassword",
+		FullMethod: "/user.v1.User/GetProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserPassword(ctx, req.(*SetUserPasswordReq))
+		return srv.(UserServer).GetProfile(ctx, req.(*GetProfileReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserWechat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserWechatReq)
+func _User_GetProfileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserWechat(ctx, in)
+		return srv.(UserServer).GetProfileList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserWechat",
+		FullMethod: "/user.v1.User/GetProfileList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserWechat(ctx, req.(*SetUserWechatReq))
+		return srv.(UserServer).GetProfileList(ctx, req.(*GetProfileListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserQQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserQQReq)
+func _User_GetProfileUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileUpdateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserQQ(ctx, in)
+		return srv.(UserServer).GetProfileUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserQQ",
+		FullMethod: "/user.v1.User/GetProfileUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserQQ(ctx, req.(*SetUserQQReq))
+		return srv.(UserServer).GetProfileUpdate(ctx, req.(*GetProfileUpdateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserGitee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserGiteeReq)
+func _User_GetUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFollowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserGitee(ctx, in)
+		return srv.(UserServer).GetUserFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserGitee",
+		FullMethod: "/user.v1.User/GetUserFollow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserGitee(ctx, req.(*SetUserGiteeReq))
+		return srv.(UserServer).GetUserFollow(ctx, req.(*GetUserFollowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserGithub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserGithubReq)
+func _User_GetFollowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserGithub(ctx, in)
+		return srv.(UserServer).GetFollowList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserGithub",
+		FullMethod: "/user.v1.User/GetFollowList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserGithub(ctx, req.(*SetUserGithubReq))
+		return srv.(UserServer).GetFollowList(ctx, req.(*GetFollowListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserFollowReq)
+func _User_GetFollowListCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowListCountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserFollow(ctx, in)
+		return srv.(UserServer).GetFollowListCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetUserFollow",
+		FullMethod: "/user.v1.User/GetFollowListCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserFollow(ctx, req.(*SetUserFollowReq))
+		return srv.(UserServer).GetFollowListCount(ctx, req.(*GetFollowListCountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetFollowDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetFollowDbAndCacheReq)
+func _User_GetFollowedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowedListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetFollowDbAndCache(ctx, in)
+		return srv.(UserServer).GetFollowedList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/SetFollowDbAndCache",
+		FullMethod: "/user.v1.User/GetFollowedList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetFollowDbAndCache(ctx, req.(*SetFollowDbAndCacheReq))
+		return srv.(UserServer).GetFollowedList(ctx, req.(*GetFollowedListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_CancelUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelUserFollowReq)
+func _User_GetFollowedListCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowedListCountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).CancelUserFollow(ctx, in)
+		return srv.(UserServer).GetFollowedListCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/CancelUserFollow",
+		FullMethod: "/user.v1.User/GetFollowedListCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).CancelUserFollow(ctx, req.(*CancelUserFollowReq))
+		return srv.(UserServer).GetFollowedListCount(ctx, req.(*GetFollowedListCountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_CancelFollowDbAndCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelFollowDbAndCacheReq)
+func _User_GetUserFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFollowsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).CancelFollowDbAndCache(ctx, in)
+		return srv.(UserServer).GetUserFollows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/CancelFollowDbAndCache",
+		FullMethod: "/user.v1.User/GetUserFollows",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).CancelFollowDbAndCache(ctx, req.(*CancelFollowDbAndCacheReq))
+		return srv.(UserServer).GetUserFollows(ctx, req.(*GetUserFollowsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountReq)
+func _User_GetUserBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBlockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetAccount(ctx, in)
+		return srv.(UserServer).GetUserBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetAccount",
+		FullMethod: "/user.v1.User/GetUserBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetAccount(ctx, req.(*GetAccountReq))
+		return srv.(UserServer).GetUserBlock(ctx, req.(*GetUserBlockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileReq)
+func _User_GetUserBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBlocksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetProfile(ctx, in)
+		return srv.(UserServer).GetUserBlocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetProfile",
+		FullMethod: "/user.v1.User/GetUserBlocks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetProfile(ctx, req.(*GetProfileReq))
+		return srv.(UserServer).GetUserBlocks(ctx, req.(*GetUserBlocksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetProfileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileListReq)
+func _User_GetFollowRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRecommendationsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetProfileList(ctx, in)
+		return srv.(UserServer).GetFollowRecommendations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetProfileList",
+		FullMethod: "/user.v1.User/GetFollowRecommendations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetProfileList(ctx, req.(*GetProfileListReq))
+		return srv.(UserServer).GetFollowRecommendations(ctx, req.(*GetFollowRecommendationsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetProfileUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileUpdateReq)
+func _User_GetFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRequestsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetProfileUpdate(ctx, in)
+		return srv.(UserServer).GetFollowRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetProfileUpdate",
+		FullMethod: "/user.v1.User/GetFollowRequests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetProfileUpdate(ctx, req.(*GetProfileUpdateReq))
+		return srv.(UserServer).GetFollowRequests(ctx, req.(*GetFollowRequestsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetUserFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFollowReq)
+func _User_GetProfileVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileVisibleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetUserFollow(ctx, in)
+		return srv.(UserServer).GetProfileVisible(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetUserFollow",
+		FullMethod: "/user.v1.User/GetProfileVisible",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetUserFollow(ctx, req.(*GetUserFollowReq))
+		return srv.(UserServer).GetProfileVisible(ctx, req.(*GetProfileVisibleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetFollowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowListReq)
+func _User_GetUserHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserHandleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetFollowList(ctx, in)
+		return srv.(UserServer).GetUserHandle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetFollowList",
+		FullMethod: "/user.v1.User/GetUserHandle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetFollowList(ctx, req.(*GetFollowListReq))
+		return srv.(UserServer).GetUserHandle(ctx, req.(*GetUserHandleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetFollowListCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowListCountReq)
+func _User_GetUserByHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByHandleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetFollowListCount(ctx, in)
+		return srv.(UserServer).GetUserByHandle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetFollowListCount",
+		FullMethod: "/user.v1.User/GetUserByHandle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetFollowListCount(ctx, req.(*GetFollowListCountReq))
+		return srv.(UserServer).GetUserByHandle(ctx, req.(*GetUserByHandleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetFollowedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowedListReq)
+func _User_GetHandleUuids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandleUuidsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetFollowedList(ctx, in)
+		return srv.(UserServer).GetHandleUuids(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetFollowedList",
+		FullMethod: "/user.v1.User/GetHandleUuids",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetFollowedList(ctx, req.(*GetFollowedListReq))
+		return srv.(UserServer).GetHandleUuids(ctx, req.(*GetHandleUuidsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetFollowedListCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowedListCountReq)
+func _User_GetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetFollowedListCount(ctx, in)
+		return srv.(UserServer).GetUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetFollowedListCount",
+		FullMethod: "/user.v1.User/GetUserRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetFollowedListCount(ctx, req.(*GetFollowedListCountReq))
+		return srv.(UserServer).GetUserRole(ctx, req.(*GetUserRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetUserFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFollowsReq)
+func _User_GetAuditLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetUserFollows(ctx, in)
+		return srv.(UserServer).GetAuditLogList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/GetUserFollows",
+		FullMethod: "/user.v1.User/GetAuditLogList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetUserFollows(ctx, req.(*GetUserFollowsReq))
+		return srv.(UserServer).GetAuditLogList(ctx, req.(*GetAuditLogListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1567,74 +2427,110 @@ func _User_UnbindUserEmail_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UnbindUserWechat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _User_UnbindUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnbindUserAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UnbindUserWechat(ctx, in)
+		return srv.(UserServer).UnbindUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/UnbindUserWechat",
+		FullMethod: "/user.v1.User/UnbindUserIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UnbindUserWechat(ctx, req.(*UnbindUserAccountReq))
+		return srv.(UserServer).UnbindUserIdentity(ctx, req.(*UnbindUserAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UnbindUserQQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _User_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnbindUserAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UnbindUserQQ(ctx, in)
+		return srv.(UserServer).DeleteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/UnbindUserQQ",
+		FullMethod: "/user.v1.User/DeleteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UnbindUserQQ(ctx, req.(*UnbindUserAccountReq))
+		return srv.(UserServer).DeleteAccount(ctx, req.(*UnbindUserAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UnbindUserGitee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbindUserAccountReq)
+func _User_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UnbindUserGitee(ctx, in)
+		return srv.(UserServer).ExportUserData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/UnbindUserGitee",
+		FullMethod: "/user.v1.User/ExportUserData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UnbindUserGitee(ctx, req.(*UnbindUserAccountReq))
+		return srv.(UserServer).ExportUserData(ctx, req.(*ExportUserDataReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UnbindUserGithub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbindUserAccountReq)
+func _User_GetUserDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/GetUserDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserDataExport(ctx, req.(*GetUserDataExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.v1.User/GetUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserData(ctx, req.(*GetUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_PurgeUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserDataReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UnbindUserGithub(ctx, in)
+		return srv.(UserServer).PurgeUserData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/user.v1.User/UnbindUserGithub",
+		FullMethod: "/user.v1.User/PurgeUserData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UnbindUserGithub(ctx, req.(*UnbindUserAccountReq))
+		return srv.(UserServer).PurgeUserData(ctx, req.(*PurgeUserDataReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1693,24 +2589,48 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _User_LoginByCode_Handler,
 		},
 		{
-			MethodName: "LoginByWeChat",
-			Handler:    _User_LoginByWeChat_Handler,
+			MethodName: "GetOauthUrl",
+			Handler:    _User_GetOauthUrl_Handler,
 		},
 		{
-			MethodName: "LoginByQQ",
-			Handler:    _User_LoginByQQ_Handler,
+			MethodName: "LoginByOauth",
+			Handler:    _User_LoginByOauth_Handler,
 		},
 		{
-			MethodName: "LoginByGithub",
-			Handler:    _User_LoginByGithub_Handler,
+			MethodName: "LoginPasswordReset",
+			Handler:    _User_LoginPasswordReset_Handler,
 		},
 		{
-			MethodName: "LoginByGitee",
-			Handler:    _User_LoginByGitee_Handler,
+			MethodName: "RefreshToken",
+			Handler:    _User_RefreshToken_Handler,
 		},
 		{
-			MethodName: "LoginPasswordReset",
-			Handler:    _User_LoginPasswordReset_Handler,
+			MethodName: "Logout",
+			Handler:    _User_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllDevices",
+			Handler:    _User_LogoutAllDevices_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _User_ListSessions_Handler,
+		},
+		{
+			MethodName: "LoginByTotp",
+			Handler:    _User_LoginByTotp_Handler,
+		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _User_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "ConfirmTotp",
+			Handler:    _User_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _User_DisableTotp_Handler,
 		},
 		{
 			MethodName: "SendPhoneCode",
@@ -1737,20 +2657,8 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _User_SetUserPassword_Handler,
 		},
 		{
-			MethodName: "SetUserWechat",
-			Handler:    _User_SetUserWechat_Handler,
-		},
-		{
-			MethodName: "SetUserQQ",
-			Handler:    _User_SetUserQQ_Handler,
-		},
-		{
-			MethodName: "SetUserGitee",
-			Handler:    _User_SetUserGitee_Handler,
-		},
-		{
-			MethodName: "SetUserGithub",
-			Handler:    _User_SetUserGithub_Handler,
+			MethodName: "SetUserIdentity",
+			Handler:    _User_SetUserIdentity_Handler,
 		},
 		{
 			MethodName: "SetUserFollow",
@@ -1768,6 +2676,58 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelFollowDbAndCache",
 			Handler:    _User_CancelFollowDbAndCache_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _User_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _User_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _User_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _User_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "SetProfilePrivate",
+			Handler:    _User_SetProfilePrivate_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _User_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _User_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "SetUserHandle",
+			Handler:    _User_SetUserHandle_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _User_SetUserRole_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _User_SuspendUser_Handler,
+		},
+		{
+			MethodName: "UnsuspendUser",
+			Handler:    _User_UnsuspendUser_Handler,
+		},
+		{
+			MethodName: "AddAuditLog",
+			Handler:    _User_AddAuditLog_Handler,
+		},
+		{
+			MethodName: "CompleteAuditLog",
+			Handler:    _User_CompleteAuditLog_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _User_GetAccount_Handler,
@@ -1808,6 +2768,46 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserFollows",
 			Handler:    _User_GetUserFollows_Handler,
 		},
+		{
+			MethodName: "GetUserBlock",
+			Handler:    _User_GetUserBlock_Handler,
+		},
+		{
+			MethodName: "GetUserBlocks",
+			Handler:    _User_GetUserBlocks_Handler,
+		},
+		{
+			MethodName: "GetFollowRecommendations",
+			Handler:    _User_GetFollowRecommendations_Handler,
+		},
+		{
+			MethodName: "GetFollowRequests",
+			Handler:    _User_GetFollowRequests_Handler,
+		},
+		{
+			MethodName: "GetProfileVisible",
+			Handler:    _User_GetProfileVisible_Handler,
+		},
+		{
+			MethodName: "GetUserHandle",
+			Handler:    _User_GetUserHandle_Handler,
+		},
+		{
+			MethodName: "GetUserByHandle",
+			Handler:    _User_GetUserByHandle_Handler,
+		},
+		{
+			MethodName: "GetHandleUuids",
+			Handler:    _User_GetHandleUuids_Handler,
+		},
+		{
+			MethodName: "GetUserRole",
+			Handler:    _User_GetUserRole_Handler,
+		},
+		{
+			MethodName: "GetAuditLogList",
+			Handler:    _User_GetAuditLogList_Handler,
+		},
 		{
 			MethodName: "GetUserSearch",
 			Handler:    _User_GetUserSearch_Handler,
@@ -1845,20 +2845,28 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _User_UnbindUserEmail_Handler,
 		},
 		{
-			MethodName: "UnbindUserWechat",
-			Handler:    _User_UnbindUserWechat_Handler,
+			MethodName: "UnbindUserIdentity",
+			Handler:    _User_UnbindUserIdentity_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _User_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _User_ExportUserData_Handler,
 		},
 		{
-			MethodName: "UnbindUserQQ",
-			Handler:    _User_UnbindUserQQ_Handler,
+			MethodName: "GetUserDataExport",
+			Handler:    _User_GetUserDataExport_Handler,
 		},
 		{
-			MethodName: "UnbindUserGitee",
-			Handler:    _User_UnbindUserGitee_Handler,
+			MethodName: "GetUserData",
+			Handler:    _User_GetUserData_Handler,
 		},
 		{
-			MethodName: "UnbindUserGithub",
-			Handler:    _User_UnbindUserGithub_Handler,
+			MethodName: "PurgeUserData",
+			Handler:    _User_PurgeUserData_Handler,
 		},
 		{
 			MethodName: "GetHealth",
@@ -0,0 +1,73 @@
+// Package achievementtest builds the achievement service on testkit, for tests
+// that run a flow across services within one process.
+package achievementtest
+
+import (
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
+	v1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/conf"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+	ggrpc "google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// Achievement is a running achievement service.
+type Achievement struct {
+	Client v1.AchievementClient
+	Conn   *ggrpc.ClientConn
+	DB     *gorm.DB
+	Redis  redis.Cmdable
+}
+
+func newAchievement(db *gorm.DB, redisCmd redis.Cmdable, srv *grpc.Server) (*Achievement, func(), error) {
+	conn, cleanup, err := testkit.Serve(srv)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Achievement{
+		Client: v1.NewAchievementClient(conn),
+		Conn:   conn,
+		DB:     db,
+		Redis:  redisCmd,
+	}, cleanup, nil
+}
+
+// newDB opens a database with the tables of tool/update.
+func newDB() (*gorm.DB, func(), error) {
+	db, cleanup, err := testkit.NewDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = testkit.Migrate(db,
+		&data.Achievement{},
+		&data.Active{},
+		&data.Medal{},
+		&idempotent.Processed{},
+	)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return db, cleanup, nil
+}
+
+func newConfServer() *conf.Server {
+	return &conf.Server{Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"}}
+}
+
+// newRedis starts a redis with the scripts of tool/lua loaded.
+func newRedis() (redis.Cmdable, func(), error) {
+	redisCmd, cleanup, err := testkit.NewRedis()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = testkit.LoadScripts(redisCmd, data.Scripts)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return redisCmd, cleanup, nil
+}
@@ -0,0 +1,21 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package achievementtest
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/data"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/server"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+)
+
+// New starts an achievement service publishing to bus.
+func New(*testkit.Bus, log.Logger) (*Achievement, func(), error) {
+	panic(wire.Build(newDB, newRedis, testkit.NewTransport, newConfServer, data.RepoSet, biz.ProviderSet, service.ProviderSet, server.NewGRPCServer, newAchievement))
+}
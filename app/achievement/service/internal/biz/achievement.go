@@ -9,6 +9,7 @@ import (
 
 type AchievementRepo interface {
 	GetAchievementList(ctx context.Context, uuids []string) ([]*Achievement, error)
+	GetScoreRank(ctx context.Context, size int32) ([]*Achievement, error)
 	GetUserAchievement(ctx context.Context, uuid string) (*Achievement, error)
 	GetUserMedal(ctx context.Context, uuid string) (*Medal, error)
 	GetUserActive(ctx context.Context, uuid string) (*Active, error)
@@ -36,7 +37,13 @@ type AchievementRepo interface {
 	CancelUserMedal(ctx context.Context, medal, uuid string) error
 	AddAchievementScore(ctx context.Context, uuid string, score int32) error
 	AddAchievementScoreToCache(ctx context.Context, uuid string, score int32) error
+	AddAchievement(ctx context.Context, achievements []*Achievement) error
+	AddActive(ctx context.Context, actives []*Active) error
+	AddAchievementToCache(ctx context.Context, achievements []*Achievement) error
 	SendMedalToMq(ctx context.Context, medal, uuid, mode string) error
+	GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error)
+	PurgeUserData(ctx context.Context, uuid string) error
+	PurgeUserDataFromCache(ctx context.Context, uuid string) error
 }
 
 type AchievementUseCase struct {
@@ -187,6 +194,32 @@ func (r *AchievementUseCase) AddAchievementScore(ctx context.Context, uuid strin
 	})
 }
 
+// AddAchievementDbAndCache applies counter deltas aggregated by the message
+// service. A negative delta never takes a counter below zero.
+func (r *AchievementUseCase) AddAchievementDbAndCache(ctx context.Context, achievements []*Achievement, actives []*Active) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		if len(achievements) > 0 {
+			err := r.repo.AddAchievement(ctx, achievements)
+			if err != nil {
+				return v1.ErrorAddAchievementFailed("add achievement failed: %s", err.Error())
+			}
+		}
+		if len(actives) > 0 {
+			err := r.repo.AddActive(ctx, actives)
+			if err != nil {
+				return v1.ErrorAddAchievementFailed("add active failed: %s", err.Error())
+			}
+		}
+		if len(achievements) > 0 {
+			err := r.repo.AddAchievementToCache(ctx, achievements)
+			if err != nil {
+				return v1.ErrorAddAchievementFailed("add achievement to cache failed: %s", err.Error())
+			}
+		}
+		return nil
+	})
+}
+
 func (r *AchievementUseCase) GetAchievementList(ctx context.Context, uuids []string) ([]*Achievement, error) {
 	achievementList, err := r.repo.GetAchievementList(ctx, uuids)
 	if err != nil {
@@ -195,6 +228,19 @@ func (r *AchievementUseCase) GetAchievementList(ctx context.Context, uuids []str
 	return achievementList, nil
 }
 
+// GetScoreRank returns the highest scored users, used to recommend
+// follows to users without any follow history yet.
+func (r *AchievementUseCase) GetScoreRank(ctx context.Context, size int32) ([]*Achievement, error) {
+	if size <= 0 || size > 500 {
+		size = 100
+	}
+	achievementList, err := r.repo.GetScoreRank(ctx, size)
+	if err != nil {
+		return nil, v1.ErrorGetAchievementListFailed("get score rank failed: %s", err.Error())
+	}
+	return achievementList, nil
+}
+
 func (r *AchievementUseCase) GetUserAchievement(ctx context.Context, uuid string) (*Achievement, error) {
 	achievement, err := r.repo.GetUserAchievement(ctx, uuid)
 	if err != nil {
@@ -317,3 +363,27 @@ func (r *AchievementUseCase) AccessUserMedalDbAndCache(ctx context.Context, meda
 		return nil
 	})
 }
+
+func (r *AchievementUseCase) GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error) {
+	files, err := r.repo.GetUserData(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetUserDataFailed("get user data failed: %s", err.Error())
+	}
+	return files, nil
+}
+
+// PurgeUserData drops the achievement, active and medals of a deleted user.
+// What the user gave to others, e.g. agrees, stays in their achievements.
+func (r *AchievementUseCase) PurgeUserData(ctx context.Context, uuid string) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.PurgeUserData(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data failed: %s", err.Error())
+		}
+		err = r.repo.PurgeUserDataFromCache(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data from cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
@@ -36,12 +36,12 @@ type Medal struct {
 }
 
 type Active struct {
+	Uuid  string
 	Agree int32
 }
 
-//easyjson:json
-type CommentMap struct {
-	Uuid  string
-	Medal string
-	Mode  string
+// UserDataFile is one file of the archive a user exports their data to.
+type UserDataFile struct {
+	Name    string
+	Content []byte
 }
@@ -2,16 +2,18 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/pkg/errors"
 	"github.com/the-zion/matrix-core/app/achievement/service/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -285,6 +287,24 @@ func (r *achievementRepo) GetAchievementList(ctx context.Context, uuids []string
 	return achievementList, nil
 }
 
+func (r *achievementRepo) GetScoreRank(ctx context.Context, size int32) ([]*biz.Achievement, error) {
+	list := make([]*Achievement, 0, size)
+	err := r.data.db.WithContext(ctx).Select("uuid", "score", "followed").Where("score > ?", 0).Order("score desc").Limit(int(size)).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get score rank from db: size(%v)", size))
+	}
+
+	achievementList := make([]*biz.Achievement, 0, len(list))
+	for _, item := range list {
+		achievementList = append(achievementList, &biz.Achievement{
+			Uuid:     item.Uuid,
+			Followed: item.Followed,
+			Score:    item.Score,
+		})
+	}
+	return achievementList, nil
+}
+
 func (r *achievementRepo) achievementListExist(ctx context.Context, uuids []string) ([]string, []string, error) {
 	cmd, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		for _, item := range uuids {
@@ -698,22 +718,11 @@ func (r *achievementRepo) setActiveToCache(key string, active *biz.Active) {
 }
 
 func (r *achievementRepo) SendMedalToMq(ctx context.Context, medal, uuid, mode string) error {
-	commentMap := &biz.CommentMap{
-		Uuid:  uuid,
-		Medal: medal,
-		Mode:  mode,
-	}
-
-	data, err := commentMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
+	err := r.data.mqPro.producer.Send(ctx, &event.Medal{
+		Header: event.Header{Mode: mode},
+		Medal:  medal,
+		Uuid:   uuid,
+	}, uuid)
 	if err != nil {
 		return errors.Wrapf(err, fmt.Sprintf("fail to send medal to mq: %v", medal))
 	}
@@ -750,6 +759,94 @@ func (r *achievementRepo) AddAchievementScore(ctx context.Context, uuid string,
 	return nil
 }
 
+func (r *achievementRepo) AddAchievement(ctx context.Context, achievements []*biz.Achievement) error {
+	rows := make([]*Achievement, 0, len(achievements))
+	uuids := make([]string, 0, len(achievements))
+	deltas := map[string][]int32{}
+	for _, item := range achievements {
+		rows = append(rows, &Achievement{
+			Uuid:    item.Uuid,
+			Agree:   positive(item.Agree),
+			View:    positive(item.View),
+			Collect: positive(item.Collect),
+			Score:   positive(item.Score),
+		})
+		uuids = append(uuids, item.Uuid)
+		deltas["agree"] = append(deltas["agree"], item.Agree)
+		deltas["view"] = append(deltas["view"], item.View)
+		deltas["collect"] = append(deltas["collect"], item.Collect)
+		deltas["score"] = append(deltas["score"], item.Score)
+	}
+	updates := map[string]interface{}{}
+	for column, values := range deltas {
+		updates[column] = deltaExpr(column, uuids, values)
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "uuid"}},
+		DoUpdates: clause.Assignments(updates),
+	}).Create(&rows).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add achievement: uuids(%v)", uuids))
+	}
+	return nil
+}
+
+func (r *achievementRepo) AddActive(ctx context.Context, actives []*biz.Active) error {
+	rows := make([]*Active, 0, len(actives))
+	uuids := make([]string, 0, len(actives))
+	deltas := make([]int32, 0, len(actives))
+	for _, item := range actives {
+		rows = append(rows, &Active{
+			Uuid:  item.Uuid,
+			Agree: positive(item.Agree),
+		})
+		uuids = append(uuids, item.Uuid)
+		deltas = append(deltas, item.Agree)
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "uuid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"agree": deltaExpr("agree", uuids, deltas)}),
+	}).Create(&rows).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add active: uuids(%v)", uuids))
+	}
+	return nil
+}
+
+func (r *achievementRepo) AddAchievementToCache(ctx context.Context, achievements []*biz.Achievement) error {
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, item := range achievements {
+			values := []interface{}{"agree", item.Agree, "view", item.View, "collect", item.Collect, "score", item.Score}
+			pipe.EvalSha(ctx, "418732d110a524fd1509d6d92a1b0c5680d5db46", []string{item.Uuid}, values...)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add achievement to cache: achievements(%v)", achievements))
+	}
+	return nil
+}
+
+// deltaExpr adds the delta of each uuid to column, never taking it below zero.
+func deltaExpr(column string, uuids []string, deltas []int32) clause.Expr {
+	var sql strings.Builder
+	args := make([]interface{}, 0, len(uuids)*2)
+	sql.WriteString(fmt.Sprintf("GREATEST(CAST(%s AS SIGNED) + CASE uuid", column))
+	for i, uuid := range uuids {
+		sql.WriteString(" WHEN ? THEN ?")
+		args = append(args, uuid, deltas[i])
+	}
+	sql.WriteString(" ELSE 0 END, 0)")
+	return gorm.Expr(sql.String(), args...)
+}
+
+func positive(delta int32) int32 {
+	if delta < 0 {
+		return 0
+	}
+	return delta
+}
+
 func (r *achievementRepo) AddAchievementScoreToCache(ctx context.Context, uuid string, score int32) error {
 	keys := []string{uuid}
 	values := []interface{}{score}
@@ -759,3 +856,48 @@ func (r *achievementRepo) AddAchievementScoreToCache(ctx context.Context, uuid s
 	}
 	return nil
 }
+
+func (r *achievementRepo) GetUserData(ctx context.Context, uuid string) ([]*biz.UserDataFile, error) {
+	tables := []struct {
+		name string
+		rows interface{}
+	}{
+		{"achievement.json", &[]*Achievement{}},
+		{"active.json", &[]*Active{}},
+		{"medal.json", &[]*Medal{}},
+	}
+	files := make([]*biz.UserDataFile, 0, len(tables))
+	for _, item := range tables {
+		err := r.data.db.WithContext(ctx).Where("uuid = ?", uuid).Find(item.rows).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from db: file(%s), uuid(%s)", item.name, uuid))
+		}
+		content, err := json.MarshalIndent(item.rows, "", "  ")
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to marshal user data: file(%s), uuid(%s)", item.name, uuid))
+		}
+		files = append(files, &biz.UserDataFile{
+			Name:    item.name,
+			Content: content,
+		})
+	}
+	return files, nil
+}
+
+func (r *achievementRepo) PurgeUserData(ctx context.Context, uuid string) error {
+	for _, model := range []interface{}{&Achievement{}, &Active{}, &Medal{}} {
+		err := r.data.DB(ctx).Where("uuid = ?", uuid).Delete(model).Error
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to purge user data: model(%T), uuid(%s)", model, uuid))
+		}
+	}
+	return nil
+}
+
+func (r *achievementRepo) PurgeUserDataFromCache(ctx context.Context, uuid string) error {
+	_, err := r.data.redisCli.Del(ctx, uuid, "medal_"+uuid, "active_"+uuid).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from cache: uuid(%s)", uuid))
+	}
+	return nil
+}
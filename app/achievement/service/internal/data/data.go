@@ -11,16 +11,23 @@ import (
 	_ "github.com/tencentyun/cos-go-sdk-v5"
 	"github.com/the-zion/matrix-core/app/achievement/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/achievement/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"runtime"
 	"time"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewAchievementRepo, NewRecovery, NewRocketmqProducer)
+var ProviderSet = wire.NewSet(ClientSet, RepoSet)
+
+// ClientSet connects to the infrastructure named in the config.
+var ClientSet = wire.NewSet(NewDB, NewRedis, NewTransport)
+
+var RepoSet = wire.NewSet(NewData, NewTransaction, NewAchievementRepo, NewRecovery)
 
 type MqPro struct {
-	producer rocketmq.Producer
+	producer *event.Producer
 }
 
 type Data struct {
@@ -28,12 +35,23 @@ type Data struct {
 	log      *log.Helper
 	redisCli redis.Cmdable
 	mqPro    *MqPro
+	sweeper  *idempotent.Sweeper
 }
 
 type contextTxKey struct{}
 
+// ExecTx runs fn in a transaction, unless fn has been committed before on
+// behalf of the idempotency key in ctx.
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx, first, err := idempotent.Claim(ctx, tx, "tx")
+		if err != nil {
+			return err
+		}
+		if !first {
+			d.log.Infof("skip committed transaction")
+			return nil
+		}
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -115,7 +133,18 @@ func NewRedis(conf *conf.Data) redis.Cmdable {
 	return client
 }
 
-func NewRocketmqProducer(conf *conf.Data) *MqPro {
+func NewTransport(conf *conf.Data) event.Transport {
+	switch conf.Transport {
+	case "", event.TransportRocketMq:
+		return newRocketmqTransport(conf)
+	case event.TransportRedis:
+		return newRedisStreamTransport(conf)
+	}
+	log.NewHelper(log.With(log.GetLogger(), "module", "achievement/data/transport")).Fatalf("unknown transport: %s", conf.Transport)
+	return nil
+}
+
+func newRocketmqTransport(conf *conf.Data) event.Transport {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/rocketmq-producer"))
 	p, err := rocketmq.NewProducer(
 		producer.WithNsResolver(primitive.NewPassthroughResolver([]string{conf.Rocketmq.ServerAddress})),
@@ -125,6 +154,7 @@ func NewRocketmqProducer(conf *conf.Data) *MqPro {
 		}),
 		producer.WithGroupName(conf.Rocketmq.GroupName),
 		producer.WithNamespace(conf.Rocketmq.NameSpace),
+		producer.WithQueueSelector(producer.NewHashQueueSelector()),
 	)
 
 	if err != nil {
@@ -135,24 +165,43 @@ func NewRocketmqProducer(conf *conf.Data) *MqPro {
 	if err != nil {
 		l.Fatalf("start producer error: %v", err)
 	}
+	return event.NewRocketMqTransport(p)
+}
 
-	return &MqPro{
-		producer: p,
+func newRedisStreamTransport(conf *conf.Data) event.Transport {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "achievement/data/redis-stream"))
+	client := redis.NewClient(&redis.Options{
+		Addr:        conf.RedisStream.Addr,
+		DB:          int(conf.RedisStream.Db),
+		DialTimeout: time.Second * 2,
+		PoolSize:    10,
+		Password:    conf.RedisStream.Password,
+	})
+	timeout, cancelFunc := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancelFunc()
+	err := client.Ping(timeout).Err()
+	if err != nil {
+		l.Fatalf("redis stream connect error: %v", err)
 	}
+	return event.NewRedisStreamTransport(client, conf.RedisStream.MaxLen)
 }
 
-func NewData(db *gorm.DB, redisCmd redis.Cmdable, mq *MqPro, logger log.Logger) (*Data, func(), error) {
+func NewData(db *gorm.DB, redisCmd redis.Cmdable, mq event.Transport, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "achievement/data/new-data"))
 
 	d := &Data{
 		db:       db,
 		log:      log.NewHelper(log.With(logger, "module", "creation/data")),
 		redisCli: redisCmd,
-		mqPro:    mq,
+		mqPro:    &MqPro{producer: event.NewProducer(mq)},
 	}
+	d.sweeper = idempotent.NewSweeper(db, logger, idempotent.Processed{}.TableName())
+	d.sweeper.Start()
 	return d, func() {
 		l.Info("closing the data resources")
 
+		d.sweeper.Stop()
+
 		sqlDB, err := db.DB()
 		if err != nil {
 			l.Errorf("close db err: %v", err.Error())
@@ -168,7 +217,7 @@ func NewData(db *gorm.DB, redisCmd redis.Cmdable, mq *MqPro, logger log.Logger)
 			l.Errorf("close redis error: %v", err.Error())
 		}
 
-		err = mq.producer.Shutdown()
+		err = d.mqPro.producer.Shutdown()
 		if err != nil {
 			l.Errorf("shutdown mq producer error: %v", err.Error())
 		}
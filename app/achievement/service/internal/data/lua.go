@@ -0,0 +1,129 @@
+package data
+
+// Scripts are the lua scripts the repos run by their sha1, by name. tool/lua
+// loads them into redis.
+var Scripts = map[string]string{
+	"SetUserMedalToCache": `
+					local key = KEYS[1]
+                    local change = ARGV[1]
+					local value = redis.call("EXISTS", key)
+					if value == 1 then
+  						redis.call("HSET", key, change, 1)
+					end
+					return 0
+	`,
+	"SetAchievementAgreeToCache": `
+					local uuid = KEYS[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						redis.call("HINCRBY", uuid, "agree", 1)
+					end
+					return 0
+	`,
+	"CancelAchievementAgreeFromCache": `
+					local uuid = KEYS[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						local number = tonumber(redis.call("HGET", uuid, "agree"))
+						if number > 0 then
+  							redis.call("HINCRBY", uuid, "agree", -1)
+						end
+					end
+					return 0
+	`,
+	"SetAchievementViewToCache": `
+					local uuid = KEYS[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						redis.call("HINCRBY", uuid, "view", 1)
+					end
+					return 0
+	`,
+	"SetAchievementCollectToCache": `
+					local uuid = KEYS[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						redis.call("HINCRBY", uuid, "collect", 1)
+					end
+					return 0
+	`,
+	"CancelAchievementCollectFromCache": `
+					local uuid = KEYS[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						local number = tonumber(redis.call("HGET", uuid, "collect"))
+						if number > 0 then
+  							redis.call("HINCRBY", uuid, "collect", -1)
+						end
+					end
+					return 0
+	`,
+	"SetAchievementFollowToCache": `
+					local follow = KEYS[1]
+					local exist = redis.call("EXISTS", follow)
+					if exist == 1 then
+						redis.call("HINCRBY", follow, "followed", 1)
+					end
+
+					local followed = KEYS[2]
+					local exist = redis.call("EXISTS", followed)
+					if exist == 1 then
+						redis.call("HINCRBY", followed, "follow", 1)
+					end
+					return 0
+	`,
+	"CancelAchievementFollowFromCache": `
+					local follow = KEYS[1]
+					local exist = redis.call("EXISTS", follow)
+					if exist == 1 then
+						local number = tonumber(redis.call("HGET", follow, "followed"))
+						if number > 0 then
+  							redis.call("HINCRBY", follow, "followed", -1)
+						end
+					end
+
+					local followed = KEYS[2]
+					local exist = redis.call("EXISTS", followed)
+					if exist == 1 then
+						local number = tonumber(redis.call("HGET", followed, "follow"))
+						if number > 0 then
+  							redis.call("HINCRBY", followed, "follow", -1)
+						end
+					end
+					return 0
+	`,
+	"CancelUserMedalFromCache": `
+					local key = KEYS[1]
+					local change = ARGV[1]
+					local exist = redis.call("EXISTS", key)
+					if exist == 1 then
+						redis.call("HSET", key, change, 2)
+					end
+					return 0
+	`,
+	"AddAchievementScoreToCache": `
+					local uuid = KEYS[1]
+					local value = ARGV[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						redis.call("HINCRBY", uuid, "score", value)
+					end
+					return 0
+	`,
+	"AddAchievementToCache": `
+					local uuid = KEYS[1]
+					local exist = redis.call("EXISTS", uuid)
+					if exist == 1 then
+						for i = 1, #ARGV, 2 do
+							local field = ARGV[i]
+							local value = tonumber(ARGV[i + 1])
+							local number = tonumber(redis.call("HGET", uuid, field)) or 0
+							if number + value < 0 then
+								value = -number
+							end
+							redis.call("HINCRBY", uuid, field, value)
+						end
+					end
+					return 0
+	`,
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	v1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	"github.com/the-zion/matrix-core/app/achievement/service/internal/biz"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -70,6 +71,31 @@ func (s *AchievementService) AddAchievementScore(ctx context.Context, req *v1.Ad
 	return &emptypb.Empty{}, nil
 }
 
+func (s *AchievementService) AddAchievementDbAndCache(ctx context.Context, req *v1.AddAchievementDbAndCacheReq) (*emptypb.Empty, error) {
+	achievements := make([]*biz.Achievement, 0, len(req.Achievement))
+	for _, item := range req.Achievement {
+		achievements = append(achievements, &biz.Achievement{
+			Uuid:    item.Uuid,
+			Agree:   item.Agree,
+			View:    item.View,
+			Collect: item.Collect,
+			Score:   item.Score,
+		})
+	}
+	actives := make([]*biz.Active, 0, len(req.Active))
+	for _, item := range req.Active {
+		actives = append(actives, &biz.Active{
+			Uuid:  item.Uuid,
+			Agree: item.Agree,
+		})
+	}
+	err := s.ac.AddAchievementDbAndCache(ctx, achievements, actives)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *AchievementService) GetAchievementList(ctx context.Context, req *v1.GetAchievementListReq) (*v1.GetAchievementListReply, error) {
 	achievementList, err := s.ac.GetAchievementList(ctx, req.Uuids)
 	if err != nil {
@@ -88,6 +114,22 @@ func (s *AchievementService) GetAchievementList(ctx context.Context, req *v1.Get
 	return reply, nil
 }
 
+func (s *AchievementService) GetScoreRank(ctx context.Context, req *v1.GetScoreRankReq) (*v1.GetScoreRankReply, error) {
+	achievementList, err := s.ac.GetScoreRank(ctx, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetScoreRankReply{Achievement: make([]*v1.GetScoreRankReply_Achievement, 0, len(achievementList))}
+	for _, item := range achievementList {
+		reply.Achievement = append(reply.Achievement, &v1.GetScoreRankReply_Achievement{
+			Uuid:     item.Uuid,
+			Score:    item.Score,
+			Followed: item.Followed,
+		})
+	}
+	return reply, nil
+}
+
 func (s *AchievementService) GetUserAchievement(ctx context.Context, req *v1.GetUserAchievementReq) (*v1.GetUserAchievementReply, error) {
 	achievement, err := s.ac.GetUserAchievement(ctx, req.Uuid)
 	if err != nil {
@@ -191,3 +233,26 @@ func (s *AchievementService) AccessUserMedalDbAndCache(ctx context.Context, req
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *AchievementService) GetUserData(ctx context.Context, req *v1.GetUserDataReq) (*v1.GetUserDataReply, error) {
+	files, err := s.ac.GetUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetUserDataReply{Files: make([]*v1.GetUserDataReply_File, 0, len(files))}
+	for _, item := range files {
+		reply.Files = append(reply.Files, &v1.GetUserDataReply_File{
+			Name:    item.Name,
+			Content: item.Content,
+		})
+	}
+	return reply, nil
+}
+
+func (s *AchievementService) PurgeUserData(ctx context.Context, req *v1.PurgeUserDataReq) (*emptypb.Empty, error) {
+	err := s.ac.PurgeUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/the-zion/matrix-core/app/achievement/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"os"
@@ -26,6 +27,7 @@ func NewDB(logger log.Logger) *gorm.DB {
 		&data.Achievement{},
 		&data.Active{},
 		&data.Medal{},
+		&idempotent.Processed{},
 	); err != nil {
 		l.Fatalf("failed creat or update table resources: %v", err)
 	}
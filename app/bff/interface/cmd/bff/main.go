@@ -10,6 +10,8 @@ import (
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/p2c"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/nacos-group/nacos-sdk-go/clients"
@@ -17,6 +19,7 @@ import (
 	"github.com/nacos-group/nacos-sdk-go/vo"
 	"github.com/the-zion/matrix-core/app/bff/interface/internal/conf"
 	"github.com/the-zion/matrix-core/pkg/kube"
+	"github.com/the-zion/matrix-core/pkg/redact"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"gopkg.in/yaml.v3"
@@ -199,14 +202,15 @@ func loggerInit() {
 		}
 
 		tencentLogger.GetProducer().Start()
-		logger = log.With(tencentLogger, logKv...)
+		logger = log.With(redact.Logger(tencentLogger), logKv...)
 	default:
-		logger = log.With(log.NewStdLogger(os.Stdout), logKv...)
+		logger = log.With(redact.Logger(log.NewStdLogger(os.Stdout)), logKv...)
 	}
 }
 
 func appInit() {
 	var err error
+	selector.SetGlobalSelector(p2c.NewBuilder())
 	app, cleanup, err = wireApp(bootstrap.Config.Server, bootstrap.Config.Data, logger, rclient)
 	if err != nil {
 		panic(err)
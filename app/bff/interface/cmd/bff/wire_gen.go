@@ -38,10 +38,10 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logLogger log.Logger,
 	articleRepo := data.NewArticleRepo(dataData, logLogger)
 	columnRepo := data.NewColumnRepo(dataData, logLogger)
 	talkRepo := data.NewTalkRepo(dataData, logLogger)
-	creationUseCase := biz.NewCreationUseCase(creationRepo, articleRepo, columnRepo, talkRepo, recovery, logLogger)
-	talkUseCase := biz.NewTalkUseCase(talkRepo, recovery, logLogger)
-	articleUseCase := biz.NewArticleUseCase(articleRepo, recovery, logLogger)
-	columnUseCase := biz.NewColumnUseCase(columnRepo, recovery, logLogger)
+	creationUseCase := biz.NewCreationUseCase(creationRepo, articleRepo, columnRepo, talkRepo, userRepo, recovery, logLogger)
+	talkUseCase := biz.NewTalkUseCase(talkRepo, userRepo, recovery, logLogger)
+	articleUseCase := biz.NewArticleUseCase(articleRepo, userRepo, recovery, logLogger)
+	columnUseCase := biz.NewColumnUseCase(columnRepo, userRepo, recovery, logLogger)
 	commentRepo := data.NewCommentRepo(dataData, logLogger)
 	achievementUseCase := biz.NewAchievementUseCase(achievementRepo, creationRepo, commentRepo, recovery, logLogger)
 	newsRepo := data.NewNewsRepo(dataData, logLogger)
@@ -49,7 +49,8 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logLogger log.Logger,
 	commentUseCase := biz.NewCommentUseCase(commentRepo, recovery, logLogger)
 	messageRepo := data.NewMessageRepo(dataData, logLogger)
 	messageUseCase := biz.NewMessageUseCase(messageRepo, userRepo, recovery, logLogger)
-	bffService := service.NewBffService(userUseCase, creationUseCase, talkUseCase, articleUseCase, columnUseCase, achievementUseCase, newsUseCase, commentUseCase, messageUseCase, logLogger)
+	adminUseCase := biz.NewAdminUseCase(userRepo, messageRepo, logLogger)
+	bffService := service.NewBffService(userUseCase, creationUseCase, talkUseCase, articleUseCase, columnUseCase, achievementUseCase, newsUseCase, commentUseCase, messageUseCase, adminUseCase, logLogger)
 	httpServer := server.NewHTTPServer(confServer, bffService, logLogger)
 	grpcServer := server.NewGRPCServer(confServer, bffService, logLogger)
 	kratosApp := newApp(registry, httpServer, grpcServer)
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/the-zion/matrix-core/api/bff/interface/v1"
+)
+
+const auditReasonSize = 500
+
+// roleRank orders the roles by the actions they are allowed, an unknown role
+// ranking as a plain user.
+var roleRank = map[string]int{
+	"user":      0,
+	"moderator": 1,
+	"admin":     2,
+}
+
+type AdminUseCase struct {
+	userRepo    UserRepo
+	messageRepo MessageRepo
+	log         *log.Helper
+}
+
+func NewAdminUseCase(userRepo UserRepo, messageRepo MessageRepo, logger log.Logger) *AdminUseCase {
+	return &AdminUseCase{
+		userRepo:    userRepo,
+		messageRepo: messageRepo,
+		log:         log.NewHelper(log.With(logger, "module", "bff/biz/AdminUseCase")),
+	}
+}
+
+func (r *AdminUseCase) GetContentFlagList(ctx context.Context, page int32, kind string, status int32) ([]*ContentFlag, error) {
+	_, _, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return nil, err
+	}
+	return r.messageRepo.GetContentFlagList(ctx, page, kind, status)
+}
+
+func (r *AdminUseCase) PublishContentFlag(ctx context.Context, id int32, reason string) error {
+	uuid, role, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return err
+	}
+
+	return r.audit(ctx, uuid, role, "publish_content_flag", "contentFlag", fmt.Sprintf("%v", id), reason, func() error {
+		return r.messageRepo.PublishContentFlag(ctx, id)
+	})
+}
+
+func (r *AdminUseCase) RemoveContentFlag(ctx context.Context, id int32, reason string) error {
+	uuid, role, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return err
+	}
+
+	return r.audit(ctx, uuid, role, "remove_content_flag", "contentFlag", fmt.Sprintf("%v", id), reason, func() error {
+		return r.messageRepo.RemoveContentFlag(ctx, id)
+	})
+}
+
+func (r *AdminUseCase) SuspendUser(ctx context.Context, userUuid string, until int64, reason string) error {
+	uuid, role, err := r.authorizeOver(ctx, userUuid)
+	if err != nil {
+		return err
+	}
+
+	return r.audit(ctx, uuid, role, "suspend_user", "user", userUuid, reason, func() error {
+		return r.userRepo.SuspendUser(ctx, userUuid, until, reason)
+	})
+}
+
+func (r *AdminUseCase) UnsuspendUser(ctx context.Context, userUuid, reason string) error {
+	uuid, role, err := r.authorizeOver(ctx, userUuid)
+	if err != nil {
+		return err
+	}
+
+	return r.audit(ctx, uuid, role, "unsuspend_user", "user", userUuid, reason, func() error {
+		return r.userRepo.UnsuspendUser(ctx, userUuid)
+	})
+}
+
+func (r *AdminUseCase) SetUserRole(ctx context.Context, userUuid, userRole, reason string) error {
+	uuid, role, err := r.authorize(ctx, "admin")
+	if err != nil {
+		return err
+	}
+	if userUuid == uuid {
+		return v1.ErrorPermissionDenied("permission denied: an admin can not change its own role")
+	}
+
+	return r.audit(ctx, uuid, role, "set_user_role_"+userRole, "user", userUuid, reason, func() error {
+		return r.userRepo.SetUserRole(ctx, userUuid, userRole)
+	})
+}
+
+func (r *AdminUseCase) GetAuditLogList(ctx context.Context, page int32, operator string) ([]*AuditLog, error) {
+	_, _, err := r.authorize(ctx, "admin")
+	if err != nil {
+		return nil, err
+	}
+	return r.userRepo.GetAuditLogList(ctx, page, operator)
+}
+
+func (r *AdminUseCase) GetAppealList(ctx context.Context, page int32, status int32) ([]*Appeal, error) {
+	_, _, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return nil, err
+	}
+	return r.messageRepo.GetAppealList(ctx, page, "", status)
+}
+
+func (r *AdminUseCase) AcceptAppeal(ctx context.Context, id int32, reason string) error {
+	uuid, role, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return err
+	}
+
+	return r.audit(ctx, uuid, role, "accept_appeal", "appeal", fmt.Sprintf("%v", id), reason, func() error {
+		return r.messageRepo.AcceptAppeal(ctx, id)
+	})
+}
+
+func (r *AdminUseCase) RejectAppeal(ctx context.Context, id int32, reason string) error {
+	uuid, role, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return err
+	}
+
+	return r.audit(ctx, uuid, role, "reject_appeal", "appeal", fmt.Sprintf("%v", id), reason, func() error {
+		return r.messageRepo.RejectAppeal(ctx, id)
+	})
+}
+
+// authorize fails with ErrorPermissionDenied unless the caller holds role or
+// one above it. The role is read from the user service rather than the token,
+// which keeps carrying a revoked role until it expires.
+func (r *AdminUseCase) authorize(ctx context.Context, role string) (string, string, error) {
+	uuid := ctx.Value("uuid").(string)
+	userRole, err := r.userRepo.GetUserRole(ctx, uuid)
+	if err != nil {
+		return "", "", err
+	}
+	if roleRank[userRole] < roleRank[role] {
+		return "", "", v1.ErrorPermissionDenied("permission denied: role(%s) is not %s", userRole, role)
+	}
+	return uuid, userRole, nil
+}
+
+// authorizeOver authorizes a moderator acting on userUuid, who must rank
+// below the caller.
+func (r *AdminUseCase) authorizeOver(ctx context.Context, userUuid string) (string, string, error) {
+	uuid, role, err := r.authorize(ctx, "moderator")
+	if err != nil {
+		return "", "", err
+	}
+
+	userRole, err := r.userRepo.GetUserRole(ctx, userUuid)
+	if err != nil {
+		return "", "", err
+	}
+	if roleRank[userRole] >= roleRank[role] {
+		return "", "", v1.ErrorPermissionDenied("permission denied: role(%s) can not act on role(%s)", role, userRole)
+	}
+	return uuid, role, nil
+}
+
+// audit records action as pending before taking it with act, and marks the
+// record done or failed after. Nothing is done when the record can't be
+// written. A record that can't be marked stays pending, its failure logged in
+// full, as the outcome of action is known only from the log then.
+func (r *AdminUseCase) audit(ctx context.Context, operator, role, action, kind, target, reason string, act func() error) error {
+	auditLog := &AuditLog{
+		Operator: operator,
+		Role:     role,
+		Action:   action,
+		Kind:     kind,
+		Target:   target,
+		Reason:   auditReason(reason),
+	}
+	id, err := r.userRepo.AddAuditLog(ctx, auditLog)
+	if err != nil {
+		r.log.Errorf("fail to add audit log: error(%v), auditLog(%+v)", err, *auditLog)
+		return err
+	}
+
+	err = act()
+	failed := err != nil
+	cerr := r.userRepo.CompleteAuditLog(ctx, id, failed)
+	if cerr != nil {
+		r.log.Errorf("fail to complete audit log: id(%v), failed(%v), error(%v), auditLog(%+v)", id, failed, cerr, *auditLog)
+	}
+	return err
+}
+
+// auditReason cuts reason down to what an audit log keeps of it.
+func auditReason(reason string) string {
+	runes := []rune(reason)
+	if len(runes) > auditReasonSize {
+		return string(runes[:auditReasonSize])
+	}
+	return reason
+}
@@ -5,8 +5,13 @@ import (
 	"github.com/google/wire"
 )
 
-var ProviderSet = wire.NewSet(NewUserUseCase, NewArticleUseCase, NewCreationUseCase, NewTalkUseCase, NewColumnUseCase, NewAchievementUseCase, NewNewsUseCase, NewCommentUseCase, NewMessageUseCase)
+var ProviderSet = wire.NewSet(NewUserUseCase, NewArticleUseCase, NewCreationUseCase, NewTalkUseCase, NewColumnUseCase, NewAchievementUseCase, NewNewsUseCase, NewCommentUseCase, NewMessageUseCase, NewAdminUseCase)
 
 type Recovery interface {
 	GroupRecover(context.Context, func(ctx context.Context) error) func() error
 }
+
+// client is the device and ip the request came from, to tell sessions apart.
+func client(ctx context.Context) (string, string) {
+	return ctx.Value("userAgent").(string), ctx.Value("realIp").(string)
+}
@@ -31,8 +31,8 @@ type CommentRepo interface {
 	GetUserSubCommentRepliedList(ctx context.Context, page int32, uuid string) ([]*SubComment, error)
 	GetCommentContentReview(ctx context.Context, page int32, uuid string) ([]*CommentContentReview, error)
 	CreateCommentDraft(ctx context.Context, uuid string) (int32, error)
-	SendComment(ctx context.Context, id int32, uuid, ip string) error
-	SendSubComment(ctx context.Context, id int32, uuid, ip string) error
+	SendComment(ctx context.Context, id, creationId, creationType int32, uuid, ip string) error
+	SendSubComment(ctx context.Context, id, rootId, parentId int32, uuid, ip string) error
 	RemoveComment(ctx context.Context, id int32, uuid string) error
 	RemoveSubComment(ctx context.Context, id int32, uuid string) error
 	SetCommentAgree(ctx context.Context, id, creationId, creationType int32, uuid, userUuid string) error
@@ -408,16 +408,16 @@ func (r *CommentUseCase) GetCommentContentReview(ctx context.Context, page int32
 	return reviewList, nil
 }
 
-func (r *CommentUseCase) SendComment(ctx context.Context, id int32) error {
+func (r *CommentUseCase) SendComment(ctx context.Context, id, creationId, creationType int32) error {
 	uuid := ctx.Value("uuid").(string)
 	ip := ctx.Value("realIp").(string)
-	return r.repo.SendComment(ctx, id, uuid, ip)
+	return r.repo.SendComment(ctx, id, creationId, creationType, uuid, ip)
 }
 
-func (r *CommentUseCase) SendSubComment(ctx context.Context, id int32) error {
+func (r *CommentUseCase) SendSubComment(ctx context.Context, id, rootId, parentId int32) error {
 	uuid := ctx.Value("uuid").(string)
 	ip := ctx.Value("realIp").(string)
-	return r.repo.SendSubComment(ctx, id, uuid, ip)
+	return r.repo.SendSubComment(ctx, id, rootId, parentId, uuid, ip)
 }
 
 func (r *CommentUseCase) RemoveComment(ctx context.Context, id int32) error {
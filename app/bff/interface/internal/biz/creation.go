@@ -57,7 +57,7 @@ type ArticleRepo interface {
 	DeleteArticle(ctx context.Context, id int32, uuid string) error
 	DeleteArticleDraft(ctx context.Context, id int32, uuid string) error
 	SetArticleAgree(ctx context.Context, id int32, uuid, userUuid string) error
-	SetArticleView(ctx context.Context, id int32, uuid string) error
+	SetArticleView(ctx context.Context, id int32, uuid, userUuid, ip string) error
 	SetArticleCollect(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
 	CancelArticleAgree(ctx context.Context, id int32, uuid, userUuid string) error
 	CancelArticleCollect(ctx context.Context, id int32, uuid, userUuid string) error
@@ -86,7 +86,7 @@ type TalkRepo interface {
 	SetTalkAgree(ctx context.Context, id int32, uuid, userUuid string) error
 	CancelTalkAgree(ctx context.Context, id int32, uuid, userUuid string) error
 	CancelTalkCollect(ctx context.Context, id int32, uuid, userUuid string) error
-	SetTalkView(ctx context.Context, id int32, uuid string) error
+	SetTalkView(ctx context.Context, id int32, uuid, userUuid, ip string) error
 	SetTalkCollect(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
 	TalkStatisticJudge(ctx context.Context, id int32, uuid string) (*TalkStatisticJudge, error)
 }
@@ -117,7 +117,7 @@ type ColumnRepo interface {
 	SubscribeJudge(ctx context.Context, id int32, uuid string) (bool, error)
 	DeleteColumn(ctx context.Context, id int32, uuid string) error
 	ColumnStatisticJudge(ctx context.Context, id int32, uuid string) (*ColumnStatisticJudge, error)
-	SetColumnView(ctx context.Context, id int32, uuid string) error
+	SetColumnView(ctx context.Context, id int32, uuid, userUuid, ip string) error
 	SetColumnAgree(ctx context.Context, id int32, uuid, userUuid string) error
 	SetColumnCollect(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
 	CancelColumnAgree(ctx context.Context, id int32, uuid, userUuid string) error
@@ -137,26 +137,30 @@ type CreationUseCase struct {
 	articleRepo ArticleRepo
 	columnRepo  ColumnRepo
 	talkRepo    TalkRepo
+	userRepo    UserRepo
 	re          Recovery
 	log         *log.Helper
 }
 
 type ArticleUseCase struct {
-	repo ArticleRepo
-	re   Recovery
-	log  *log.Helper
+	repo     ArticleRepo
+	userRepo UserRepo
+	re       Recovery
+	log      *log.Helper
 }
 
 type TalkUseCase struct {
-	repo TalkRepo
-	re   Recovery
-	log  *log.Helper
+	repo     TalkRepo
+	userRepo UserRepo
+	re       Recovery
+	log      *log.Helper
 }
 
 type ColumnUseCase struct {
-	repo ColumnRepo
-	re   Recovery
-	log  *log.Helper
+	repo     ColumnRepo
+	userRepo UserRepo
+	re       Recovery
+	log      *log.Helper
 }
 
 type NewsUseCase struct {
@@ -165,38 +169,42 @@ type NewsUseCase struct {
 	log  *log.Helper
 }
 
-func NewCreationUseCase(repo CreationRepo, articleRepo ArticleRepo, columnRepo ColumnRepo, talkRepo TalkRepo, re Recovery, logger log.Logger) *CreationUseCase {
+func NewCreationUseCase(repo CreationRepo, articleRepo ArticleRepo, columnRepo ColumnRepo, talkRepo TalkRepo, userRepo UserRepo, re Recovery, logger log.Logger) *CreationUseCase {
 	return &CreationUseCase{
 		repo:        repo,
 		articleRepo: articleRepo,
 		columnRepo:  columnRepo,
 		talkRepo:    talkRepo,
+		userRepo:    userRepo,
 		re:          re,
 		log:         log.NewHelper(log.With(logger, "module", "bff/biz/CreationUseCase")),
 	}
 }
 
-func NewArticleUseCase(repo ArticleRepo, re Recovery, logger log.Logger) *ArticleUseCase {
+func NewArticleUseCase(repo ArticleRepo, userRepo UserRepo, re Recovery, logger log.Logger) *ArticleUseCase {
 	return &ArticleUseCase{
-		repo: repo,
-		re:   re,
-		log:  log.NewHelper(log.With(logger, "module", "bff/biz/ArticleUseCase")),
+		repo:     repo,
+		userRepo: userRepo,
+		re:       re,
+		log:      log.NewHelper(log.With(logger, "module", "bff/biz/ArticleUseCase")),
 	}
 }
 
-func NewTalkUseCase(repo TalkRepo, re Recovery, logger log.Logger) *TalkUseCase {
+func NewTalkUseCase(repo TalkRepo, userRepo UserRepo, re Recovery, logger log.Logger) *TalkUseCase {
 	return &TalkUseCase{
-		repo: repo,
-		re:   re,
-		log:  log.NewHelper(log.With(logger, "module", "bff/biz/TalkUseCase")),
+		repo:     repo,
+		userRepo: userRepo,
+		re:       re,
+		log:      log.NewHelper(log.With(logger, "module", "bff/biz/TalkUseCase")),
 	}
 }
 
-func NewColumnUseCase(repo ColumnRepo, re Recovery, logger log.Logger) *ColumnUseCase {
+func NewColumnUseCase(repo ColumnRepo, userRepo UserRepo, re Recovery, logger log.Logger) *ColumnUseCase {
 	return &ColumnUseCase{
-		repo: repo,
-		re:   re,
-		log:  log.NewHelper(log.With(logger, "module", "bff/biz/ColumnUseCase")),
+		repo:     repo,
+		userRepo: userRepo,
+		re:       re,
+		log:      log.NewHelper(log.With(logger, "module", "bff/biz/ColumnUseCase")),
 	}
 }
 
@@ -304,6 +312,13 @@ func (r *CreationUseCase) GetCollectionsContentReview(ctx context.Context, page
 }
 
 func (r *CreationUseCase) GetUserTimeLineListVisitor(ctx context.Context, page int32, uuid string) ([]*TimeLine, error) {
+	visible, err := r.userRepo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*TimeLine{}, nil
+	}
 	timeline, err := r.repo.GetUserTimeLineListVisitor(ctx, page, uuid)
 	if err != nil {
 		return nil, err
@@ -407,6 +422,13 @@ func (r *CreationUseCase) GetCollectionsCount(ctx context.Context) (int32, error
 }
 
 func (r *CreationUseCase) GetCollectionsListByVisitor(ctx context.Context, page int32, uuid string) ([]*Collections, error) {
+	visible, err := r.userRepo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*Collections{}, nil
+	}
 	collectionsList, err := r.repo.GetCollectionsListByVisitor(ctx, uuid, page)
 	if err != nil {
 		return nil, err
@@ -573,6 +595,13 @@ func (r *ArticleUseCase) GetUserArticleListSimple(ctx context.Context, page int3
 }
 
 func (r *ArticleUseCase) GetUserArticleListVisitor(ctx context.Context, page int32, uuid string) ([]*Article, error) {
+	visible, err := r.userRepo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*Article{}, nil
+	}
 	articleList, err := r.repo.GetUserArticleListVisitor(ctx, page, uuid)
 	if err != nil {
 		return nil, err
@@ -724,7 +753,9 @@ func (r *ArticleUseCase) SetArticleAgree(ctx context.Context, id int32, uuid str
 }
 
 func (r *ArticleUseCase) SetArticleView(ctx context.Context, id int32, uuid string) error {
-	return r.repo.SetArticleView(ctx, id, uuid)
+	userUuid := ctx.Value("uuid").(string)
+	ip := ctx.Value("realIp").(string)
+	return r.repo.SetArticleView(ctx, id, uuid, userUuid, ip)
 }
 
 func (r *ArticleUseCase) SetArticleCollect(ctx context.Context, id, collectionsId int32, uuid string) error {
@@ -841,6 +872,13 @@ func (r *TalkUseCase) GetUserTalkListSimple(ctx context.Context, page int32) ([]
 }
 
 func (r *TalkUseCase) GetUserTalkListVisitor(ctx context.Context, page int32, uuid string) ([]*Talk, error) {
+	visible, err := r.userRepo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*Talk{}, nil
+	}
 	talkList, err := r.repo.GetUserTalkListVisitor(ctx, page, uuid)
 	if err != nil {
 		return nil, err
@@ -974,7 +1012,9 @@ func (r *TalkUseCase) CancelTalkCollect(ctx context.Context, id int32, uuid stri
 }
 
 func (r *TalkUseCase) SetTalkView(ctx context.Context, id int32, uuid string) error {
-	return r.repo.SetTalkView(ctx, id, uuid)
+	userUuid := ctx.Value("uuid").(string)
+	ip := ctx.Value("realIp").(string)
+	return r.repo.SetTalkView(ctx, id, uuid, userUuid, ip)
 }
 
 func (r *TalkUseCase) SetTalkCollect(ctx context.Context, id, collectionsId int32, uuid string) error {
@@ -1102,6 +1142,13 @@ func (r *ColumnUseCase) GetUserColumnListSimple(ctx context.Context, page int32)
 }
 
 func (r *ColumnUseCase) GetUserColumnListVisitor(ctx context.Context, page int32, uuid string) ([]*Column, error) {
+	visible, err := r.userRepo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*Column{}, nil
+	}
 	columnList, err := r.repo.GetUserColumnListVisitor(ctx, page, uuid)
 	if err != nil {
 		return nil, err
@@ -1269,7 +1316,9 @@ func (r *ColumnUseCase) CancelColumnCollect(ctx context.Context, id int32, uuid
 }
 
 func (r *ColumnUseCase) SetColumnView(ctx context.Context, id int32, uuid string) error {
-	return r.repo.SetColumnView(ctx, id, uuid)
+	userUuid := ctx.Value("uuid").(string)
+	ip := ctx.Value("realIp").(string)
+	return r.repo.SetColumnView(ctx, id, uuid, userUuid, ip)
 }
 
 func (r *ColumnUseCase) AddColumnIncludes(ctx context.Context, id, articleId int32) error {
@@ -1,13 +1,17 @@
 package biz
 
 type UserAccount struct {
-	Phone    string
-	Email    string
-	Qq       string
-	Wechat   string
-	Gitee    int32
-	Github   int32
-	Password string
+	Phone      string
+	Email      string
+	Password   string
+	Totp       bool
+	Identities []string
+}
+
+type UserDataExport struct {
+	Id    string
+	Ready bool
+	Url   string
 }
 
 type UserProfile struct {
@@ -33,6 +37,8 @@ type UserProfile struct {
 	Subscribe   int32
 	Talk        int32
 	Collections int32
+	Private     bool
+	Handle      string
 }
 
 type UserSearch struct {
@@ -51,11 +57,23 @@ type UserProfileUpdate struct {
 	Status int32
 }
 
-type Github struct {
-	Token    string
-	Avatar   string
-	Register bool
-	Uuid     string
+type Token struct {
+	Token        string
+	RefreshToken string
+	Challenge    string
+}
+
+type TotpEnrollment struct {
+	Secret string
+	Uri    string
+}
+
+type Session struct {
+	Id      string
+	Device  string
+	Ip      string
+	Created int64
+	Seen    int64
 }
 
 type Credentials struct {
@@ -242,6 +260,7 @@ type ArticleStatistic struct {
 	Uuid    string
 	Agree   int32
 	View    int32
+	RawView int32
 	Collect int32
 	Comment int32
 }
@@ -486,6 +505,24 @@ type Notification struct {
 	Comment            int32
 	SubComment         int32
 	SystemNotification int32
+	Mention            int32
+}
+
+type UserHandle struct {
+	Handle     string
+	Changeable int64
+}
+
+type Mention struct {
+	Id           int32
+	ContentId    int32
+	CreatedAt    string
+	Kind         string
+	CreationId   int32
+	CreationType int32
+	RootId       int32
+	Uid          string
+	Text         string
 }
 
 type SystemNotification struct {
@@ -502,3 +539,44 @@ type SystemNotification struct {
 	Text             string
 	Comment          string
 }
+
+type ContentFlag struct {
+	Id        int32
+	Kind      string
+	Mode      string
+	ContentId int32
+	Uuid      string
+	Text      string
+	JobId     string
+	Label     string
+	Section   string
+	Status    int32
+	CreatedAt int64
+}
+
+type Appeal struct {
+	Id        int32
+	JobId     string
+	Uuid      string
+	Reason    string
+	Status    int32
+	CreatedAt int64
+	Kind      string
+	Mode      string
+	ContentId int32
+	Text      string
+	Label     string
+	Section   string
+}
+
+type AuditLog struct {
+	Id        int32
+	Operator  string
+	Role      string
+	Action    string
+	Kind      string
+	Target    string
+	Reason    string
+	CreatedAt int64
+	Status    int32
+}
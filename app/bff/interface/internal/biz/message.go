@@ -9,10 +9,19 @@ type MessageRepo interface {
 	GetMailBoxLastTime(ctx context.Context, uuid string) (*MailBox, error)
 	GetMessageNotification(ctx context.Context, uuid string, follows []string) (*Notification, error)
 	GetMessageSystemNotification(ctx context.Context, page int32, uuid string) ([]*SystemNotification, error)
+	GetMessageMention(ctx context.Context, page int32, uuid string) ([]*Mention, error)
 	SetMailBoxLastTime(ctx context.Context, uuid string, time int32) error
 	RemoveMailBoxCommentCount(ctx context.Context, uuid string) error
 	RemoveMailBoxSubCommentCount(ctx context.Context, uuid string) error
 	RemoveMailBoxSystemNotificationCount(ctx context.Context, uuid string) error
+	RemoveMailBoxMentionCount(ctx context.Context, uuid string) error
+	GetContentFlagList(ctx context.Context, page int32, kind string, status int32) ([]*ContentFlag, error)
+	PublishContentFlag(ctx context.Context, id int32) error
+	RemoveContentFlag(ctx context.Context, id int32) error
+	AppealReview(ctx context.Context, uuid, jobId, reason string) error
+	GetAppealList(ctx context.Context, page int32, uuid string, status int32) ([]*Appeal, error)
+	AcceptAppeal(ctx context.Context, id int32) error
+	RejectAppeal(ctx context.Context, id int32) error
 }
 
 type MessageUseCase struct {
@@ -54,6 +63,11 @@ func (r *MessageUseCase) GetMessageSystemNotification(ctx context.Context, page
 	return r.repo.GetMessageSystemNotification(ctx, page, uuid)
 }
 
+func (r *MessageUseCase) GetMessageMention(ctx context.Context, page int32) ([]*Mention, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.GetMessageMention(ctx, page, uuid)
+}
+
 func (r *MessageUseCase) SetMailBoxLastTime(ctx context.Context, time int32) error {
 	uuid := ctx.Value("uuid").(string)
 	return r.repo.SetMailBoxLastTime(ctx, uuid, time)
@@ -73,3 +87,18 @@ func (r *MessageUseCase) RemoveMailBoxSystemNotificationCount(ctx context.Contex
 	uuid := ctx.Value("uuid").(string)
 	return r.repo.RemoveMailBoxSystemNotificationCount(ctx, uuid)
 }
+
+func (r *MessageUseCase) RemoveMailBoxMentionCount(ctx context.Context) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.RemoveMailBoxMentionCount(ctx, uuid)
+}
+
+func (r *MessageUseCase) AppealReview(ctx context.Context, jobId, reason string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.AppealReview(ctx, uuid, jobId, reason)
+}
+
+func (r *MessageUseCase) GetUserAppealList(ctx context.Context, page int32) ([]*Appeal, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.GetAppealList(ctx, page, uuid, 0)
+}
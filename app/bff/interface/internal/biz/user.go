@@ -8,15 +8,21 @@ import (
 
 type UserRepo interface {
 	UserRegister(ctx context.Context, email, password, code string) error
-	LoginByPassword(ctx context.Context, account, password, mode string) (string, error)
-	LoginByCode(ctx context.Context, phone, code string) (string, error)
+	LoginByPassword(ctx context.Context, account, password, mode, device, ip string) (*Token, error)
+	LoginByCode(ctx context.Context, phone, code, device, ip string) (*Token, error)
 	LoginPasswordReset(ctx context.Context, account, password, code, mode string) error
-	LoginByWechat(ctx context.Context, code string) (string, error)
-	LoginByQQ(ctx context.Context, code string) (string, error)
-	LoginByGithub(ctx context.Context, code string) (*Github, error)
-	LoginByGitee(ctx context.Context, code string) (string, error)
-	SendPhoneCode(ctx context.Context, template, phone string) error
-	SendEmailCode(ctx context.Context, template, email string) error
+	RefreshToken(ctx context.Context, refreshToken, ip string) (*Token, error)
+	Logout(ctx context.Context, uuid, id string) error
+	LogoutAllDevices(ctx context.Context, uuid string) error
+	ListSessions(ctx context.Context, uuid string) ([]*Session, error)
+	LoginByTotp(ctx context.Context, challenge, code, ip string) (*Token, error)
+	EnrollTotp(ctx context.Context, uuid string) (*TotpEnrollment, error)
+	ConfirmTotp(ctx context.Context, uuid, code string) ([]string, error)
+	DisableTotp(ctx context.Context, uuid, code string) error
+	GetOauthUrl(ctx context.Context, provider, redirectUri string) (string, string, error)
+	LoginByOauth(ctx context.Context, provider, code, state, device, ip string) (*Token, error)
+	SendPhoneCode(ctx context.Context, template, phone, captcha string) error
+	SendEmailCode(ctx context.Context, template, email, captcha string) error
 	GetCosSessionKey(ctx context.Context, uuid string) (*Credentials, error)
 	GetAccount(ctx context.Context, uuid string) (*UserAccount, error)
 	GetProfile(ctx context.Context, uuid string) (*UserProfile, error)
@@ -28,11 +34,14 @@ type UserRepo interface {
 	GetFollowAchievementList(ctx context.Context, page int32, uuid string, followList []*Follow) ([]*Achievement, error)
 	GetFollowedProfileList(ctx context.Context, page int32, uuid string, followedList []*Follow) ([]*UserProfile, error)
 	GetFollowedAchievementList(ctx context.Context, page int32, uuid string, followedList []*Follow) ([]*Achievement, error)
+	GetFollowRecommendations(ctx context.Context, page int32, uuid string) ([]*Follow, error)
+	GetRecommendProfileList(ctx context.Context, page int32, uuid string, recommendList []*Follow) ([]*UserProfile, error)
+	GetRecommendAchievementList(ctx context.Context, page int32, uuid string, recommendList []*Follow) ([]*Achievement, error)
 	GetSearchAchievementList(ctx context.Context, searchList []*UserSearch) ([]*Achievement, error)
 	GetFollowListCount(ctx context.Context, uuid string) (int32, error)
 	GetFollowedList(ctx context.Context, page int32, uuid string) ([]*Follow, error)
 	GetFollowedListCount(ctx context.Context, uuid string) (int32, error)
-	GetUserFollow(ctx context.Context, uuid, userUuid string) (bool, error)
+	GetUserFollow(ctx context.Context, uuid, userUuid string) (bool, bool, error)
 	GetUserFollows(ctx context.Context, uuid string) (map[string]bool, error)
 	GetUserSearch(ctx context.Context, page int32, search string) ([]*UserSearch, int32, error)
 	GetAvatarReview(ctx context.Context, page int32, uuid string) ([]*UserImageReview, error)
@@ -40,20 +49,37 @@ type UserRepo interface {
 	SetProfileUpdate(ctx context.Context, profile *UserProfileUpdate) error
 	SetUserPhone(ctx context.Context, uuid, phone, code string) error
 	SetUserPassword(ctx context.Context, uuid, password string) error
-	SetUserWechat(ctx context.Context, uuid, code, redirectUrl string) error
-	SetUserQQ(ctx context.Context, uuid, code, redirectUrl string) error
-	SetUserGitee(ctx context.Context, uuid, code, redirectUrl string) error
-	SetUserGithub(ctx context.Context, uuid, code, redirectUrl string) error
+	SetUserIdentity(ctx context.Context, uuid, provider, code, state string) error
 	SetUserEmail(ctx context.Context, uuid, email, code string) error
 	SetUserFollow(ctx context.Context, uuid, userUuid string) error
 	CancelUserFollow(ctx context.Context, uuid, userUuid string) error
-	ChangeUserPassword(ctx context.Context, uuid, oldpassword, password string) error
-	UnbindUserPhone(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error
-	UnbindUserEmail(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error
-	UnbindUserWechat(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error
-	UnbindUserQQ(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error
-	UnbindUserGitee(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error
-	UnbindUserGithub(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error
+	BlockUser(ctx context.Context, uuid, userUuid string) error
+	UnblockUser(ctx context.Context, uuid, userUuid string) error
+	MuteUser(ctx context.Context, uuid, userUuid string) error
+	UnmuteUser(ctx context.Context, uuid, userUuid string) error
+	GetUserBlocks(ctx context.Context, uuid string) ([]string, []string, error)
+	SetProfilePrivate(ctx context.Context, uuid string, private bool) error
+	GetProfileVisible(ctx context.Context, uuid, userUuid string) (bool, error)
+	GetFollowRequests(ctx context.Context, page int32, uuid string) ([]*Follow, error)
+	ApproveFollowRequest(ctx context.Context, uuid, userUuid string) error
+	RejectFollowRequest(ctx context.Context, uuid, userUuid string) error
+	SetUserHandle(ctx context.Context, uuid, handle string) error
+	GetUserHandle(ctx context.Context, uuid string) (*UserHandle, error)
+	GetUserByHandle(ctx context.Context, handle string) (string, error)
+	GetUserRole(ctx context.Context, uuid string) (string, error)
+	SetUserRole(ctx context.Context, uuid, role string) error
+	SuspendUser(ctx context.Context, uuid string, until int64, reason string) error
+	UnsuspendUser(ctx context.Context, uuid string) error
+	AddAuditLog(ctx context.Context, auditLog *AuditLog) (int32, error)
+	CompleteAuditLog(ctx context.Context, id int32, failed bool) error
+	GetAuditLogList(ctx context.Context, page int32, operator string) ([]*AuditLog, error)
+	ChangeUserPassword(ctx context.Context, uuid, oldpassword, password, code string) error
+	UnbindUserPhone(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, state string) error
+	UnbindUserEmail(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, state string) error
+	UnbindUserIdentity(ctx context.Context, uuid, provider, phone, email, account, password, code, choose, mode, state string) error
+	DeleteAccount(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, state string) error
+	ExportUserData(ctx context.Context, uuid string) (string, error)
+	GetUserDataExport(ctx context.Context, uuid string) (*UserDataExport, error)
 }
 
 type UserUseCase struct {
@@ -78,40 +104,75 @@ func (r *UserUseCase) UserRegister(ctx context.Context, email, password, code st
 	return r.repo.UserRegister(ctx, email, password, code)
 }
 
-func (r *UserUseCase) LoginByPassword(ctx context.Context, account, password, mode string) (string, error) {
-	return r.repo.LoginByPassword(ctx, account, password, mode)
+func (r *UserUseCase) LoginByPassword(ctx context.Context, account, password, mode string) (*Token, error) {
+	device, ip := client(ctx)
+	return r.repo.LoginByPassword(ctx, account, password, mode, device, ip)
 }
 
-func (r *UserUseCase) LoginByCode(ctx context.Context, phone, code string) (string, error) {
-	return r.repo.LoginByCode(ctx, phone, code)
+func (r *UserUseCase) LoginByCode(ctx context.Context, phone, code string) (*Token, error) {
+	device, ip := client(ctx)
+	return r.repo.LoginByCode(ctx, phone, code, device, ip)
 }
 
 func (r *UserUseCase) LoginPasswordReset(ctx context.Context, account, password, code, mode string) error {
 	return r.repo.LoginPasswordReset(ctx, account, password, code, mode)
 }
 
-func (r *UserUseCase) LoginByWechat(ctx context.Context, code string) (string, error) {
-	return r.repo.LoginByWechat(ctx, code)
+func (r *UserUseCase) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
+	ip := ctx.Value("realIp").(string)
+	return r.repo.RefreshToken(ctx, refreshToken, ip)
 }
 
-func (r *UserUseCase) LoginByQQ(ctx context.Context, code string) (string, error) {
-	return r.repo.LoginByQQ(ctx, code)
+func (r *UserUseCase) Logout(ctx context.Context, id string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.Logout(ctx, uuid, id)
+}
+
+func (r *UserUseCase) LogoutAllDevices(ctx context.Context) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.LogoutAllDevices(ctx, uuid)
+}
+
+func (r *UserUseCase) ListSessions(ctx context.Context) ([]*Session, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.ListSessions(ctx, uuid)
+}
+
+func (r *UserUseCase) LoginByTotp(ctx context.Context, challenge, code string) (*Token, error) {
+	ip := ctx.Value("realIp").(string)
+	return r.repo.LoginByTotp(ctx, challenge, code, ip)
+}
+
+func (r *UserUseCase) EnrollTotp(ctx context.Context) (*TotpEnrollment, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.EnrollTotp(ctx, uuid)
+}
+
+func (r *UserUseCase) ConfirmTotp(ctx context.Context, code string) ([]string, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.ConfirmTotp(ctx, uuid, code)
 }
 
-func (r *UserUseCase) LoginByGithub(ctx context.Context, code string) (*Github, error) {
-	return r.repo.LoginByGithub(ctx, code)
+func (r *UserUseCase) DisableTotp(ctx context.Context, code string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.DisableTotp(ctx, uuid, code)
+}
+
+func (r *UserUseCase) GetOauthUrl(ctx context.Context, provider, redirectUri string) (string, string, error) {
+	return r.repo.GetOauthUrl(ctx, provider, redirectUri)
 }
 
-func (r *UserUseCase) LoginByGitee(ctx context.Context, code string) (string, error) {
-	return r.repo.LoginByGitee(ctx, code)
+func (r *UserUseCase) LoginByOauth(ctx context.Context, provider, code, state string) (*Token, error) {
+	device, ip := client(ctx)
+	return r.repo.LoginByOauth(ctx, provider, code, state, device, ip)
 }
 
-func (r *UserUseCase) SendPhoneCode(ctx context.Context, template, phone string) error {
-	return r.repo.SendPhoneCode(ctx, template, phone)
+func (r *UserUseCase) SendPhoneCode(ctx context.Context, template, phone, captcha string) error {
+	return r.repo.SendPhoneCode(ctx, template, phone, captcha)
 }
 
-func (r *UserUseCase) SendEmailCode(ctx context.Context, template, email string) error {
-	return r.repo.SendEmailCode(ctx, template, email)
+func (r *UserUseCase) SendEmailCode(ctx context.Context, template, email, captcha string) error {
+	return r.repo.SendEmailCode(ctx, template, email, captcha)
 }
 
 func (r *UserUseCase) GetCosSessionKey(ctx context.Context) (*Credentials, error) {
@@ -152,6 +213,15 @@ func (r *UserUseCase) GetUserInfo(ctx context.Context) (*UserProfile, error) {
 		userProfile.Github = profile.Github
 		userProfile.Introduce = profile.Introduce
 		userProfile.Created = profile.Created
+		userProfile.Private = profile.Private
+		return nil
+	}))
+	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
+		handle, err := r.repo.GetUserHandle(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		userProfile.Handle = handle.Handle
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
@@ -204,6 +274,15 @@ func (r *UserUseCase) GetUserInfoVisitor(ctx context.Context, uuid string) (*Use
 		userProfile.Github = profile.Github
 		userProfile.Introduce = profile.Introduce
 		userProfile.Created = profile.Created
+		userProfile.Private = profile.Private
+		return nil
+	}))
+	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
+		handle, err := r.repo.GetUserHandle(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		userProfile.Handle = handle.Handle
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
@@ -242,12 +321,19 @@ func (r *UserUseCase) GetProfileUpdate(ctx context.Context) (*UserProfileUpdate,
 	return r.repo.GetProfileUpdate(ctx, uuid)
 }
 
-func (r *UserUseCase) GetUserFollow(ctx context.Context, uuid string) (bool, error) {
+func (r *UserUseCase) GetUserFollow(ctx context.Context, uuid string) (bool, bool, error) {
 	userUuid := ctx.Value("uuid").(string)
 	return r.repo.GetUserFollow(ctx, uuid, userUuid)
 }
 
 func (r *UserUseCase) GetFollowList(ctx context.Context, page int32, uuid string) ([]*Follow, error) {
+	visible, err := r.repo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*Follow{}, nil
+	}
 	followList, err := r.repo.GetFollowList(ctx, page, uuid)
 	if err != nil {
 		return nil, err
@@ -292,11 +378,64 @@ func (r *UserUseCase) GetFollowList(ctx context.Context, page int32, uuid string
 	return followList, nil
 }
 
+func (r *UserUseCase) GetFollowRecommendations(ctx context.Context, page int32) ([]*Follow, error) {
+	uuid := ctx.Value("uuid").(string)
+	recommendList, err := r.repo.GetFollowRecommendations(ctx, page, uuid)
+	if err != nil {
+		return nil, err
+	}
+	g, _ := errgroup.WithContext(ctx)
+	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
+		userProfileList, err := r.repo.GetRecommendProfileList(ctx, page, uuid, recommendList)
+		if err != nil {
+			return err
+		}
+		for _, item := range userProfileList {
+			for index, listItem := range recommendList {
+				if listItem.Follow == item.Uuid {
+					recommendList[index].Username = item.Username
+					recommendList[index].Introduce = item.Introduce
+				}
+			}
+		}
+		return nil
+	}))
+	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
+		userAchievementList, err := r.repo.GetRecommendAchievementList(ctx, page, uuid, recommendList)
+		if err != nil {
+			return err
+		}
+		for _, item := range userAchievementList {
+			for index, listItem := range recommendList {
+				if listItem.Follow == item.Uuid {
+					recommendList[index].Agree = item.Agree
+					recommendList[index].View = item.View
+					recommendList[index].FollowNum = item.Follow
+					recommendList[index].FollowedNum = item.Followed
+				}
+			}
+		}
+		return nil
+	}))
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return recommendList, nil
+}
+
 func (r *UserUseCase) GetFollowListCount(ctx context.Context, uuid string) (int32, error) {
 	return r.repo.GetFollowListCount(ctx, uuid)
 }
 
 func (r *UserUseCase) GetFollowedList(ctx context.Context, page int32, uuid string) ([]*Follow, error) {
+	visible, err := r.repo.GetProfileVisible(ctx, uuid, ctx.Value("uuid").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []*Follow{}, nil
+	}
 	followedList, err := r.repo.GetFollowedList(ctx, page, uuid)
 	if err != nil {
 		return nil, err
@@ -449,67 +588,134 @@ func (r *UserUseCase) SetUserPassword(ctx context.Context, password string) erro
 	return r.repo.SetUserPassword(ctx, uuid, password)
 }
 
-func (r *UserUseCase) SetUserWechat(ctx context.Context, code, redirectUrl string) error {
+func (r *UserUseCase) SetUserIdentity(ctx context.Context, provider, code, state string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.SetUserWechat(ctx, uuid, code, redirectUrl)
+	return r.repo.SetUserIdentity(ctx, uuid, provider, code, state)
+}
+
+func (r *UserUseCase) SetUserFollow(ctx context.Context, uuid string) error {
+	userUuid := ctx.Value("uuid").(string)
+	return r.repo.SetUserFollow(ctx, uuid, userUuid)
+}
+
+func (r *UserUseCase) CancelUserFollow(ctx context.Context, uuid string) error {
+	userUuid := ctx.Value("uuid").(string)
+	return r.repo.CancelUserFollow(ctx, uuid, userUuid)
 }
 
-func (r *UserUseCase) SetUserQQ(ctx context.Context, code, redirectUrl string) error {
+func (r *UserUseCase) BlockUser(ctx context.Context, userUuid string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.SetUserQQ(ctx, uuid, code, redirectUrl)
+	return r.repo.BlockUser(ctx, uuid, userUuid)
 }
 
-func (r *UserUseCase) SetUserGitee(ctx context.Context, code, redirectUrl string) error {
+func (r *UserUseCase) UnblockUser(ctx context.Context, userUuid string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.SetUserGitee(ctx, uuid, code, redirectUrl)
+	return r.repo.UnblockUser(ctx, uuid, userUuid)
 }
 
-func (r *UserUseCase) SetUserGithub(ctx context.Context, code, redirectUrl string) error {
+func (r *UserUseCase) MuteUser(ctx context.Context, userUuid string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.SetUserGithub(ctx, uuid, code, redirectUrl)
+	return r.repo.MuteUser(ctx, uuid, userUuid)
 }
 
-func (r *UserUseCase) SetUserFollow(ctx context.Context, uuid string) error {
-	userUuid := ctx.Value("uuid").(string)
-	return r.repo.SetUserFollow(ctx, uuid, userUuid)
+func (r *UserUseCase) UnmuteUser(ctx context.Context, userUuid string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.UnmuteUser(ctx, uuid, userUuid)
 }
 
-func (r *UserUseCase) CancelUserFollow(ctx context.Context, uuid string) error {
-	userUuid := ctx.Value("uuid").(string)
-	return r.repo.CancelUserFollow(ctx, uuid, userUuid)
+func (r *UserUseCase) GetUserBlocks(ctx context.Context) ([]string, []string, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.GetUserBlocks(ctx, uuid)
+}
+
+func (r *UserUseCase) SetProfilePrivate(ctx context.Context, private bool) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.SetProfilePrivate(ctx, uuid, private)
+}
+
+func (r *UserUseCase) GetFollowRequests(ctx context.Context, page int32) ([]*Follow, error) {
+	uuid := ctx.Value("uuid").(string)
+	requestList, err := r.repo.GetFollowRequests(ctx, page, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if len(requestList) == 0 {
+		return requestList, nil
+	}
+	uuids := make([]string, 0, len(requestList))
+	for _, item := range requestList {
+		uuids = append(uuids, item.Followed)
+	}
+	userProfileList, err := r.repo.GetProfileList(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range userProfileList {
+		for index, listItem := range requestList {
+			if listItem.Followed == item.Uuid {
+				requestList[index].Username = item.Username
+				requestList[index].Introduce = item.Introduce
+			}
+		}
+	}
+	return requestList, nil
+}
+
+func (r *UserUseCase) ApproveFollowRequest(ctx context.Context, userUuid string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.ApproveFollowRequest(ctx, uuid, userUuid)
+}
+
+func (r *UserUseCase) RejectFollowRequest(ctx context.Context, userUuid string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.RejectFollowRequest(ctx, uuid, userUuid)
+}
+
+func (r *UserUseCase) SetUserHandle(ctx context.Context, handle string) error {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.SetUserHandle(ctx, uuid, handle)
+}
+
+func (r *UserUseCase) GetUserHandle(ctx context.Context) (*UserHandle, error) {
+	uuid := ctx.Value("uuid").(string)
+	return r.repo.GetUserHandle(ctx, uuid)
+}
+
+func (r *UserUseCase) GetUserByHandle(ctx context.Context, handle string) (string, error) {
+	return r.repo.GetUserByHandle(ctx, handle)
 }
 
-func (r *UserUseCase) ChangeUserPassword(ctx context.Context, oldpassword, password string) error {
+func (r *UserUseCase) ChangeUserPassword(ctx context.Context, oldpassword, password, code string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.ChangeUserPassword(ctx, uuid, oldpassword, password)
+	return r.repo.ChangeUserPassword(ctx, uuid, oldpassword, password, code)
 }
 
-func (r *UserUseCase) UnbindUserPhone(ctx context.Context, phone, email, account, password, code, choose, mode, redirectUri string) error {
+func (r *UserUseCase) UnbindUserPhone(ctx context.Context, phone, email, account, password, code, choose, mode, state string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.UnbindUserPhone(ctx, uuid, phone, email, account, password, code, choose, mode, redirectUri)
+	return r.repo.UnbindUserPhone(ctx, uuid, phone, email, account, password, code, choose, mode, state)
 }
 
-func (r *UserUseCase) UnbindUserEmail(ctx context.Context, phone, email, account, password, code, choose, mode, redirectUri string) error {
+func (r *UserUseCase) UnbindUserEmail(ctx context.Context, phone, email, account, password, code, choose, mode, state string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.UnbindUserEmail(ctx, uuid, phone, email, account, password, code, choose, mode, redirectUri)
+	return r.repo.UnbindUserEmail(ctx, uuid, phone, email, account, password, code, choose, mode, state)
 }
 
-func (r *UserUseCase) UnbindUserWechat(ctx context.Context, phone, email, account, password, code, choose, mode, redirectUri string) error {
+func (r *UserUseCase) UnbindUserIdentity(ctx context.Context, provider, phone, email, account, password, code, choose, mode, state string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.UnbindUserWechat(ctx, uuid, phone, email, account, password, code, choose, mode, redirectUri)
+	return r.repo.UnbindUserIdentity(ctx, uuid, provider, phone, email, account, password, code, choose, mode, state)
 }
 
-func (r *UserUseCase) UnbindUserQQ(ctx context.Context, phone, email, account, password, code, choose, mode, redirectUri string) error {
+func (r *UserUseCase) DeleteAccount(ctx context.Context, phone, email, account, password, code, choose, mode, state string) error {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.UnbindUserQQ(ctx, uuid, phone, email, account, password, code, choose, mode, redirectUri)
+	return r.repo.DeleteAccount(ctx, uuid, phone, email, account, password, code, choose, mode, state)
 }
 
-func (r *UserUseCase) UnbindUserGitee(ctx context.Context, phone, email, account, password, code, choose, mode, redirectUri string) error {
+func (r *UserUseCase) ExportUserData(ctx context.Context) (string, error) {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.UnbindUserGitee(ctx, uuid, phone, email, account, password, code, choose, mode, redirectUri)
+	return r.repo.ExportUserData(ctx, uuid)
 }
 
-func (r *UserUseCase) UnbindUserGithub(ctx context.Context, phone, email, account, password, code, choose, mode, redirectUri string) error {
+func (r *UserUseCase) GetUserDataExport(ctx context.Context) (*UserDataExport, error) {
 	uuid := ctx.Value("uuid").(string)
-	return r.repo.UnbindUserGithub(ctx, uuid, phone, email, account, password, code, choose, mode, redirectUri)
+	return r.repo.GetUserDataExport(ctx, uuid)
 }
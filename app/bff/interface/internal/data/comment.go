@@ -661,11 +661,13 @@ func (r *commentRepo) CreateCommentDraft(ctx context.Context, uuid string) (int3
 	return reply.Id, nil
 }
 
-func (r *commentRepo) SendComment(ctx context.Context, id int32, uuid, ip string) error {
+func (r *commentRepo) SendComment(ctx context.Context, id, creationId, creationType int32, uuid, ip string) error {
 	_, err := r.data.commc.SendComment(ctx, &commentV1.SendCommentReq{
-		Id:   id,
-		Uuid: uuid,
-		Ip:   ip,
+		Id:           id,
+		Uuid:         uuid,
+		Ip:           ip,
+		CreationId:   creationId,
+		CreationType: creationType,
 	})
 	if err != nil {
 		return err
@@ -673,11 +675,13 @@ func (r *commentRepo) SendComment(ctx context.Context, id int32, uuid, ip string
 	return nil
 }
 
-func (r *commentRepo) SendSubComment(ctx context.Context, id int32, uuid, ip string) error {
+func (r *commentRepo) SendSubComment(ctx context.Context, id, rootId, parentId int32, uuid, ip string) error {
 	_, err := r.data.commc.SendSubComment(ctx, &commentV1.SendSubCommentReq{
-		Id:   id,
-		Uuid: uuid,
-		Ip:   ip,
+		Id:       id,
+		Uuid:     uuid,
+		Ip:       ip,
+		RootId:   rootId,
+		ParentId: parentId,
 	})
 	if err != nil {
 		return err
@@ -730,6 +730,7 @@ func (r *articleRepo) GetArticleStatistic(ctx context.Context, id int32, uuid st
 			Agree:   statistic.Agree,
 			Collect: statistic.Collect,
 			View:    statistic.View,
+			RawView: statistic.RawView,
 			Comment: statistic.Comment,
 		}, nil
 	})
@@ -987,10 +988,12 @@ func (r *articleRepo) SetArticleAgree(ctx context.Context, id int32, uuid, userU
 	return nil
 }
 
-func (r *articleRepo) SetArticleView(ctx context.Context, id int32, uuid string) error {
+func (r *articleRepo) SetArticleView(ctx context.Context, id int32, uuid, userUuid, ip string) error {
 	_, err := r.data.cc.SetArticleView(ctx, &creationV1.SetArticleViewReq{
-		Uuid: uuid,
-		Id:   id,
+		Uuid:     uuid,
+		Id:       id,
+		UserUuid: userUuid,
+		Ip:       ip,
 	})
 	if err != nil {
 		return err
@@ -1445,10 +1448,12 @@ func (r *talkRepo) CancelTalkCollect(ctx context.Context, id int32, uuid, userUu
 	return nil
 }
 
-func (r *talkRepo) SetTalkView(ctx context.Context, id int32, uuid string) error {
+func (r *talkRepo) SetTalkView(ctx context.Context, id int32, uuid, userUuid, ip string) error {
 	_, err := r.data.cc.SetTalkView(ctx, &creationV1.SetTalkViewReq{
-		Uuid: uuid,
-		Id:   id,
+		Uuid:     uuid,
+		Id:       id,
+		UserUuid: userUuid,
+		Ip:       ip,
 	})
 	if err != nil {
 		return err
@@ -2005,10 +2010,12 @@ func (r *columnRepo) CancelColumnCollect(ctx context.Context, id int32, uuid, us
 	return nil
 }
 
-func (r *columnRepo) SetColumnView(ctx context.Context, id int32, uuid string) error {
+func (r *columnRepo) SetColumnView(ctx context.Context, id int32, uuid, userUuid, ip string) error {
 	_, err := r.data.cc.SetColumnView(ctx, &creationV1.SetColumnViewReq{
-		Uuid: uuid,
-		Id:   id,
+		Uuid:     uuid,
+		Id:       id,
+		UserUuid: userUuid,
+		Ip:       ip,
 	})
 	if err != nil {
 		return err
@@ -7,8 +7,6 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/selector"
-	"github.com/go-kratos/kratos/v2/selector/p2c"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/google/wire"
 	achievementv1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
@@ -17,6 +15,7 @@ import (
 	messagev1 "github.com/the-zion/matrix-core/api/message/service/v1"
 	userv1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/bff/interface/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/request"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	"go.opentelemetry.io/otel/propagation"
 	"runtime"
@@ -53,7 +52,6 @@ func NewRecovery(d *Data) biz.Recovery {
 
 func NewData(uc userv1.UserClient, cc creationv1.CreationClient, mc messagev1.MessageClient, ac achievementv1.AchievementClient, commc commentv1.CommentClient, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "bff/data"))
-	selector.SetGlobalSelector(p2c.NewBuilder())
 	d := &Data{
 		log:   log.NewHelper(log.With(logger, "module", "creation/data")),
 		uc:    uc,
@@ -77,6 +75,7 @@ func NewUserServiceClient(r *nacos.Registry) userv1.UserClient {
 			recovery.Recovery(),
 			circuitbreaker.Client(),
 			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+			request.Client(),
 		),
 	)
 	if err != nil {
@@ -57,6 +57,7 @@ func (r *messageRepo) GetMessageNotification(ctx context.Context, uuid string, f
 			Comment:            reply.Comment,
 			SubComment:         reply.SubComment,
 			SystemNotification: reply.System,
+			Mention:            reply.Mention,
 		}, nil
 	})
 	if err != nil {
@@ -99,6 +100,37 @@ func (r *messageRepo) GetMessageSystemNotification(ctx context.Context, page int
 	return result.([]*biz.SystemNotification), nil
 }
 
+func (r *messageRepo) GetMessageMention(ctx context.Context, page int32, uuid string) ([]*biz.Mention, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_message_mention_%v_%v", page, uuid), func() (interface{}, error) {
+		mentionList, err := r.data.mc.GetMessageMention(ctx, &messageV1.GetMessageMentionReq{
+			Page: page,
+			Uuid: uuid,
+		})
+		if err != nil {
+			return nil, err
+		}
+		reply := make([]*biz.Mention, 0, len(mentionList.List))
+		for _, item := range mentionList.List {
+			reply = append(reply, &biz.Mention{
+				Id:           item.Id,
+				ContentId:    item.ContentId,
+				CreatedAt:    item.CreatedAt,
+				Kind:         item.Kind,
+				CreationId:   item.CreationId,
+				CreationType: item.CreationType,
+				RootId:       item.RootId,
+				Uid:          item.Uid,
+				Text:         item.Text,
+			})
+		}
+		return reply, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.Mention), nil
+}
+
 func (r *messageRepo) SetMailBoxLastTime(ctx context.Context, uuid string, time int32) error {
 	_, err := r.data.mc.SetMailBoxLastTime(ctx, &messageV1.SetMailBoxLastTimeReq{
 		Uuid: uuid,
@@ -139,3 +171,134 @@ func (r *messageRepo) RemoveMailBoxSystemNotificationCount(ctx context.Context,
 	}
 	return nil
 }
+
+func (r *messageRepo) RemoveMailBoxMentionCount(ctx context.Context, uuid string) error {
+	_, err := r.data.mc.RemoveMailBoxMentionCount(ctx, &messageV1.RemoveMailBoxMentionCountReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *messageRepo) GetContentFlagList(ctx context.Context, page int32, kind string, status int32) ([]*biz.ContentFlag, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_content_flag_list_%v_%s_%v", page, kind, status), func() (interface{}, error) {
+		reply, err := r.data.mc.GetContentFlagList(ctx, &messageV1.GetContentFlagListReq{
+			Page:   page,
+			Kind:   kind,
+			Status: status,
+		})
+		if err != nil {
+			return nil, err
+		}
+		list := make([]*biz.ContentFlag, 0, len(reply.List))
+		for _, item := range reply.List {
+			list = append(list, &biz.ContentFlag{
+				Id:        item.Id,
+				Kind:      item.Kind,
+				Mode:      item.Mode,
+				ContentId: item.ContentId,
+				Uuid:      item.Uuid,
+				Text:      item.Text,
+				JobId:     item.JobId,
+				Label:     item.Label,
+				Section:   item.Section,
+				Status:    item.Status,
+				CreatedAt: item.CreatedAt,
+			})
+		}
+		return list, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.ContentFlag), nil
+}
+
+func (r *messageRepo) PublishContentFlag(ctx context.Context, id int32) error {
+	_, err := r.data.mc.PublishContentFlag(ctx, &messageV1.PublishContentFlagReq{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *messageRepo) RemoveContentFlag(ctx context.Context, id int32) error {
+	_, err := r.data.mc.RemoveContentFlag(ctx, &messageV1.RemoveContentFlagReq{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *messageRepo) AppealReview(ctx context.Context, uuid, jobId, reason string) error {
+	_, err := r.data.mc.AppealReview(ctx, &messageV1.AppealReviewReq{
+		Uuid:   uuid,
+		JobId:  jobId,
+		Reason: reason,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *messageRepo) GetAppealList(ctx context.Context, page int32, uuid string, status int32) ([]*biz.Appeal, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_appeal_list_%v_%s_%v", page, uuid, status), func() (interface{}, error) {
+		reply, err := r.data.mc.GetAppealList(ctx, &messageV1.GetAppealListReq{
+			Page:   page,
+			Uuid:   uuid,
+			Status: status,
+		})
+		if err != nil {
+			return nil, err
+		}
+		list := make([]*biz.Appeal, 0, len(reply.List))
+		for _, item := range reply.List {
+			list = append(list, &biz.Appeal{
+				Id:        item.Id,
+				JobId:     item.JobId,
+				Uuid:      item.Uuid,
+				Reason:    item.Reason,
+				Status:    item.Status,
+				CreatedAt: item.CreatedAt,
+				Kind:      item.Kind,
+				Mode:      item.Mode,
+				ContentId: item.ContentId,
+				Text:      item.Text,
+				Label:     item.Label,
+				Section:   item.Section,
+			})
+		}
+		return list, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.Appeal), nil
+}
+
+func (r *messageRepo) AcceptAppeal(ctx context.Context, id int32) error {
+	_, err := r.data.mc.AcceptAppeal(ctx, &messageV1.AcceptAppealReq{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *messageRepo) RejectAppeal(ctx context.Context, id int32) error {
+	_, err := r.data.mc.RejectAppeal(ctx, &messageV1.RejectAppealReq{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	achievementV1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
 	userV1 "github.com/the-zion/matrix-core/api/user/service/v1"
@@ -38,27 +39,39 @@ func (r *userRepo) UserRegister(ctx context.Context, email, password, code strin
 	return nil
 }
 
-func (r *userRepo) LoginByPassword(ctx context.Context, account, password, mode string) (string, error) {
+func (r *userRepo) LoginByPassword(ctx context.Context, account, password, mode, device, ip string) (*biz.Token, error) {
 	reply, err := r.data.uc.LoginByPassword(ctx, &userV1.LoginByPasswordReq{
 		Account:  account,
 		Password: password,
 		Mode:     mode,
+		Device:   device,
+		Ip:       ip,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return reply.Token, nil
+	return &biz.Token{
+		Token:        reply.Token,
+		RefreshToken: reply.RefreshToken,
+		Challenge:    reply.Challenge,
+	}, nil
 }
 
-func (r *userRepo) LoginByCode(ctx context.Context, phone, code string) (string, error) {
+func (r *userRepo) LoginByCode(ctx context.Context, phone, code, device, ip string) (*biz.Token, error) {
 	reply, err := r.data.uc.LoginByCode(ctx, &userV1.LoginByCodeReq{
-		Phone: phone,
-		Code:  code,
+		Phone:  phone,
+		Code:   code,
+		Device: device,
+		Ip:     ip,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return reply.Token, nil
+	return &biz.Token{
+		Token:        reply.Token,
+		RefreshToken: reply.RefreshToken,
+		Challenge:    reply.Challenge,
+	}, nil
 }
 
 func (r *userRepo) LoginPasswordReset(ctx context.Context, account, password, code, mode string) error {
@@ -74,70 +87,184 @@ func (r *userRepo) LoginPasswordReset(ctx context.Context, account, password, co
 	return nil
 }
 
-func (r *userRepo) LoginByWechat(ctx context.Context, code string) (string, error) {
-	reply, err := r.data.uc.LoginByWeChat(ctx, &userV1.LoginByWeChatReq{
-		Code: code,
+func (r *userRepo) RefreshToken(ctx context.Context, refreshToken, ip string) (*biz.Token, error) {
+	reply, err := r.data.uc.RefreshToken(ctx, &userV1.RefreshTokenReq{
+		RefreshToken: refreshToken,
+		Ip:           ip,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return reply.Token, nil
+	return &biz.Token{
+		Token:        reply.Token,
+		RefreshToken: reply.RefreshToken,
+	}, nil
 }
 
-func (r *userRepo) LoginByQQ(ctx context.Context, code string) (string, error) {
-	reply, err := r.data.uc.LoginByQQ(ctx, &userV1.LoginByQQReq{
-		Code: code,
+func (r *userRepo) Logout(ctx context.Context, uuid, id string) error {
+	_, err := r.data.uc.Logout(ctx, &userV1.LogoutReq{
+		Uuid: uuid,
+		Id:   id,
 	})
 	if err != nil {
-		return "", err
+		return err
 	}
-	return reply.Token, nil
+	return nil
 }
 
-func (r *userRepo) LoginByGithub(ctx context.Context, code string) (*biz.Github, error) {
-	reply, err := r.data.uc.LoginByGithub(ctx, &userV1.LoginByGithubReq{
-		Code: code,
+func (r *userRepo) LogoutAllDevices(ctx context.Context, uuid string) error {
+	_, err := r.data.uc.LogoutAllDevices(ctx, &userV1.LogoutAllDevicesReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) ListSessions(ctx context.Context, uuid string) ([]*biz.Session, error) {
+	reply, err := r.data.uc.ListSessions(ctx, &userV1.ListSessionsReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*biz.Session, 0, len(reply.Session))
+	for _, item := range reply.Session {
+		sessions = append(sessions, &biz.Session{
+			Id:      item.Id,
+			Device:  item.Device,
+			Ip:      item.Ip,
+			Created: item.Created,
+			Seen:    item.Seen,
+		})
+	}
+	return sessions, nil
+}
+
+func (r *userRepo) LoginByTotp(ctx context.Context, challenge, code, ip string) (*biz.Token, error) {
+	reply, err := r.data.uc.LoginByTotp(ctx, &userV1.LoginByTotpReq{
+		Challenge: challenge,
+		Code:      code,
+		Ip:        ip,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &biz.Github{
-		Token: reply.Token,
+	return &biz.Token{
+		Token:        reply.Token,
+		RefreshToken: reply.RefreshToken,
 	}, nil
 }
 
-func (r *userRepo) LoginByGitee(ctx context.Context, code string) (string, error) {
-	reply, err := r.data.uc.LoginByGitee(ctx, &userV1.LoginByGiteeReq{
+func (r *userRepo) EnrollTotp(ctx context.Context, uuid string) (*biz.TotpEnrollment, error) {
+	reply, err := r.data.uc.EnrollTotp(ctx, &userV1.EnrollTotpReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.TotpEnrollment{
+		Secret: reply.Secret,
+		Uri:    reply.Uri,
+	}, nil
+}
+
+func (r *userRepo) ConfirmTotp(ctx context.Context, uuid, code string) ([]string, error) {
+	reply, err := r.data.uc.ConfirmTotp(ctx, &userV1.ConfirmTotpReq{
+		Uuid: uuid,
 		Code: code,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return reply.Token, nil
+	return reply.RecoveryCodes, nil
 }
 
-func (r *userRepo) SendPhoneCode(ctx context.Context, template, phone string) error {
+func (r *userRepo) DisableTotp(ctx context.Context, uuid, code string) error {
+	_, err := r.data.uc.DisableTotp(ctx, &userV1.DisableTotpReq{
+		Uuid: uuid,
+		Code: code,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) GetOauthUrl(ctx context.Context, provider, redirectUri string) (string, string, error) {
+	reply, err := r.data.uc.GetOauthUrl(ctx, &userV1.GetOauthUrlReq{
+		Provider:    provider,
+		RedirectUri: redirectUri,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return reply.Url, reply.State, nil
+}
+
+func (r *userRepo) LoginByOauth(ctx context.Context, provider, code, state, device, ip string) (*biz.Token, error) {
+	reply, err := r.data.uc.LoginByOauth(ctx, &userV1.LoginByOauthReq{
+		Provider: provider,
+		Code:     code,
+		State:    state,
+		Device:   device,
+		Ip:       ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Token{
+		Token:        reply.Token,
+		RefreshToken: reply.RefreshToken,
+		Challenge:    reply.Challenge,
+	}, nil
+}
+
+func (r *userRepo) SendPhoneCode(ctx context.Context, template, phone, captcha string) error {
 	_, err := r.data.uc.SendPhoneCode(ctx, &userV1.SendPhoneCodeReq{
 		Template: template,
 		Phone:    phone,
+		Captcha:  captcha,
 	})
 	if err != nil {
-		return err
+		return sendCodeError(err)
 	}
 	return nil
 }
 
-func (r *userRepo) SendEmailCode(ctx context.Context, template, email string) error {
+func (r *userRepo) SendEmailCode(ctx context.Context, template, email, captcha string) error {
 	_, err := r.data.uc.SendEmailCode(ctx, &userV1.SendEmailCodeReq{
 		Template: template,
 		Email:    email,
+		Captcha:  captcha,
 	})
 	if err != nil {
-		return err
+		return sendCodeError(err)
 	}
 	return nil
 }
 
+// sendCodeError puts a message fit for the user on the rejections of a code
+// request, keeping their reason and retryAfter metadata.
+func sendCodeError(err error) error {
+	var message string
+	switch {
+	case userV1.IsSendCodeTooFrequent(err):
+		message = "验证码发送过于频繁，请稍后再试"
+	case userV1.IsSendCodeLimitExceeded(err):
+		message = "今日验证码发送次数已达上限，请明天再试"
+	case userV1.IsSendCodeSuspended(err):
+		message = "验证码服务繁忙，请稍后再试"
+	case userV1.IsCaptchaInvalid(err):
+		message = "人机验证未通过，请重试"
+	default:
+		return err
+	}
+	e := kerrors.FromError(err)
+	return kerrors.New(int(e.Code), e.Reason, message).WithMetadata(e.Metadata)
+}
+
 func (r *userRepo) GetCosSessionKey(ctx context.Context, uuid string) (*biz.Credentials, error) {
 	reply, err := r.data.uc.GetCosSessionKey(ctx, &userV1.GetCosSessionKeyReq{
 		Uuid: uuid,
@@ -163,13 +290,11 @@ func (r *userRepo) GetAccount(ctx context.Context, uuid string) (*biz.UserAccoun
 			return nil, err
 		}
 		return &biz.UserAccount{
-			Phone:    account.Phone,
-			Email:    account.Email,
-			Qq:       account.Qq,
-			Wechat:   account.Wechat,
-			Gitee:    account.Gitee,
-			Github:   account.Github,
-			Password: account.Password,
+			Phone:      account.Phone,
+			Email:      account.Email,
+			Password:   account.Password,
+			Totp:       account.Totp,
+			Identities: account.Identities,
 		}, nil
 	})
 	if err != nil {
@@ -243,6 +368,7 @@ func (r *userRepo) GetUserInfo(ctx context.Context, uuid string) (*biz.UserProfi
 			Gitee:     reply.Gitee,
 			Introduce: reply.Introduce,
 			Created:   reply.Created,
+			Private:   reply.Private,
 		}, nil
 	})
 	if err != nil {
@@ -278,21 +404,18 @@ func (r *userRepo) GetProfileUpdate(ctx context.Context, uuid string) (*biz.User
 	return result.(*biz.UserProfileUpdate), nil
 }
 
-func (r *userRepo) GetUserFollow(ctx context.Context, uuid, userUuid string) (bool, error) {
+func (r *userRepo) GetUserFollow(ctx context.Context, uuid, userUuid string) (bool, bool, error) {
 	result, err, _ := r.sg.Do(fmt.Sprintf("get_user_follow_%s_%s", uuid, userUuid), func() (interface{}, error) {
-		reply, err := r.data.uc.GetUserFollow(ctx, &userV1.GetUserFollowReq{
+		return r.data.uc.GetUserFollow(ctx, &userV1.GetUserFollowReq{
 			Uuid:     uuid,
 			UserUuid: userUuid,
 		})
-		if err != nil {
-			return false, err
-		}
-		return reply.Follow, nil
 	})
 	if err != nil {
-		return false, err
+		return false, false, err
 	}
-	return result.(bool), nil
+	reply := result.(*userV1.GetUserFollowReply)
+	return reply.Follow, reply.Requested, nil
 }
 
 func (r *userRepo) GetUserFollows(ctx context.Context, uuid string) (map[string]bool, error) {
@@ -378,6 +501,73 @@ func (r *userRepo) GetFollowAchievementList(ctx context.Context, page int32, uui
 	return result.([]*biz.Achievement), nil
 }
 
+func (r *userRepo) GetFollowRecommendations(ctx context.Context, page int32, uuid string) ([]*biz.Follow, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_follow_recommendations_%s_%v", uuid, page), func() (interface{}, error) {
+		recommendList, err := r.data.uc.GetFollowRecommendations(ctx, &userV1.GetFollowRecommendationsReq{
+			Page: page,
+			Uuid: uuid,
+		})
+		if err != nil {
+			return nil, err
+		}
+		reply := make([]*biz.Follow, 0, len(recommendList.Follow))
+		for _, item := range recommendList.Follow {
+			reply = append(reply, &biz.Follow{
+				Follow: item.Uuid,
+			})
+		}
+		return reply, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.Follow), nil
+}
+
+func (r *userRepo) GetRecommendProfileList(ctx context.Context, page int32, uuid string, recommendList []*biz.Follow) ([]*biz.UserProfile, error) {
+	uuids := make([]string, 0, len(recommendList))
+	for _, item := range recommendList {
+		uuids = append(uuids, item.Follow)
+	}
+	result, err, _ := r.sg.Do(fmt.Sprintf("user_recommend_profile_list_%s_%v", uuid, page), func() (interface{}, error) {
+		return r.GetProfileList(ctx, uuids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.UserProfile), nil
+}
+
+func (r *userRepo) GetRecommendAchievementList(ctx context.Context, page int32, uuid string, recommendList []*biz.Follow) ([]*biz.Achievement, error) {
+	uuids := make([]string, 0, len(recommendList))
+	for _, item := range recommendList {
+		uuids = append(uuids, item.Follow)
+	}
+	result, err, _ := r.sg.Do(fmt.Sprintf("user_recommend_achievement_list_%s_%v", uuid, page), func() (interface{}, error) {
+		achievementList, err := r.data.ac.GetAchievementList(ctx, &achievementV1.GetAchievementListReq{
+			Uuids: uuids,
+		})
+		if err != nil {
+			return nil, err
+		}
+		reply := make([]*biz.Achievement, 0, len(achievementList.Achievement))
+		for _, item := range achievementList.Achievement {
+			reply = append(reply, &biz.Achievement{
+				Uuid:     item.Uuid,
+				Agree:    item.Agree,
+				View:     item.View,
+				Followed: item.Followed,
+				Follow:   item.Follow,
+			})
+		}
+		return reply, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.Achievement), nil
+}
+
 func (r *userRepo) GetFollowedProfileList(ctx context.Context, page int32, uuid string, followedList []*biz.Follow) ([]*biz.UserProfile, error) {
 	uuids := make([]string, 0, len(followedList))
 	for _, item := range followedList {
@@ -637,11 +827,12 @@ func (r *userRepo) SetUserPassword(ctx context.Context, uuid, password string) e
 	return nil
 }
 
-func (r *userRepo) SetUserWechat(ctx context.Context, uuid, code, redirectUrl string) error {
-	_, err := r.data.uc.SetUserWechat(ctx, &userV1.SetUserWechatReq{
-		Uuid:        uuid,
-		Code:        code,
-		RedirectUrl: redirectUrl,
+func (r *userRepo) SetUserIdentity(ctx context.Context, uuid, provider, code, state string) error {
+	_, err := r.data.uc.SetUserIdentity(ctx, &userV1.SetUserIdentityReq{
+		Uuid:     uuid,
+		Provider: provider,
+		Code:     code,
+		State:    state,
 	})
 	if err != nil {
 		return err
@@ -649,11 +840,10 @@ func (r *userRepo) SetUserWechat(ctx context.Context, uuid, code, redirectUrl st
 	return nil
 }
 
-func (r *userRepo) SetUserQQ(ctx context.Context, uuid, code, redirectUrl string) error {
-	_, err := r.data.uc.SetUserQQ(ctx, &userV1.SetUserQQReq{
-		Uuid:        uuid,
-		Code:        code,
-		RedirectUrl: redirectUrl,
+func (r *userRepo) SetUserFollow(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.SetUserFollow(ctx, &userV1.SetUserFollowReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
 	})
 	if err != nil {
 		return err
@@ -661,11 +851,10 @@ func (r *userRepo) SetUserQQ(ctx context.Context, uuid, code, redirectUrl string
 	return nil
 }
 
-func (r *userRepo) SetUserGitee(ctx context.Context, uuid, code, redirectUrl string) error {
-	_, err := r.data.uc.SetUserGitee(ctx, &userV1.SetUserGiteeReq{
-		Uuid:        uuid,
-		Code:        code,
-		RedirectUrl: redirectUrl,
+func (r *userRepo) CancelUserFollow(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.CancelUserFollow(ctx, &userV1.CancelUserFollowReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
 	})
 	if err != nil {
 		return err
@@ -673,11 +862,10 @@ func (r *userRepo) SetUserGitee(ctx context.Context, uuid, code, redirectUrl str
 	return nil
 }
 
-func (r *userRepo) SetUserGithub(ctx context.Context, uuid, code, redirectUrl string) error {
-	_, err := r.data.uc.SetUserGithub(ctx, &userV1.SetUserGithubReq{
-		Uuid:        uuid,
-		Code:        code,
-		RedirectUrl: redirectUrl,
+func (r *userRepo) BlockUser(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.BlockUser(ctx, &userV1.BlockUserReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
 	})
 	if err != nil {
 		return err
@@ -685,8 +873,8 @@ func (r *userRepo) SetUserGithub(ctx context.Context, uuid, code, redirectUrl st
 	return nil
 }
 
-func (r *userRepo) SetUserFollow(ctx context.Context, uuid, userUuid string) error {
-	_, err := r.data.uc.SetUserFollow(ctx, &userV1.SetUserFollowReq{
+func (r *userRepo) UnblockUser(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.UnblockUser(ctx, &userV1.UnblockUserReq{
 		Uuid:     uuid,
 		UserUuid: userUuid,
 	})
@@ -696,8 +884,8 @@ func (r *userRepo) SetUserFollow(ctx context.Context, uuid, userUuid string) err
 	return nil
 }
 
-func (r *userRepo) CancelUserFollow(ctx context.Context, uuid, userUuid string) error {
-	_, err := r.data.uc.CancelUserFollow(ctx, &userV1.CancelUserFollowReq{
+func (r *userRepo) MuteUser(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.MuteUser(ctx, &userV1.MuteUserReq{
 		Uuid:     uuid,
 		UserUuid: userUuid,
 	})
@@ -707,11 +895,10 @@ func (r *userRepo) CancelUserFollow(ctx context.Context, uuid, userUuid string)
 	return nil
 }
 
-func (r *userRepo) ChangeUserPassword(ctx context.Context, uuid, oldpassword, password string) error {
-	_, err := r.data.uc.ChangeUserPassword(ctx, &userV1.ChangeUserPasswordReq{
-		Uuid:        uuid,
-		Oldpassword: oldpassword,
-		Password:    password,
+func (r *userRepo) UnmuteUser(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.UnmuteUser(ctx, &userV1.UnmuteUserReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
 	})
 	if err != nil {
 		return err
@@ -719,17 +906,20 @@ func (r *userRepo) ChangeUserPassword(ctx context.Context, uuid, oldpassword, pa
 	return nil
 }
 
-func (r *userRepo) UnbindUserPhone(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error {
-	_, err := r.data.uc.UnbindUserPhone(ctx, &userV1.UnbindUserAccountReq{
-		Uuid:        uuid,
-		Phone:       phone,
-		Email:       email,
-		Account:     account,
-		Password:    password,
-		Code:        code,
-		Choose:      choose,
-		Mode:        mode,
-		RedirectUri: redirectUri,
+func (r *userRepo) GetUserBlocks(ctx context.Context, uuid string) ([]string, []string, error) {
+	reply, err := r.data.uc.GetUserBlocks(ctx, &userV1.GetUserBlocksReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reply.Blocked, reply.Muted, nil
+}
+
+func (r *userRepo) SetProfilePrivate(ctx context.Context, uuid string, private bool) error {
+	_, err := r.data.uc.SetProfilePrivate(ctx, &userV1.SetProfilePrivateReq{
+		Uuid:    uuid,
+		Private: private,
 	})
 	if err != nil {
 		return err
@@ -737,17 +927,44 @@ func (r *userRepo) UnbindUserPhone(ctx context.Context, uuid, phone, email, acco
 	return nil
 }
 
-func (r *userRepo) UnbindUserEmail(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error {
-	_, err := r.data.uc.UnbindUserEmail(ctx, &userV1.UnbindUserAccountReq{
-		Uuid:        uuid,
-		Phone:       phone,
-		Email:       email,
-		Account:     account,
-		Password:    password,
-		Code:        code,
-		Choose:      choose,
-		Mode:        mode,
-		RedirectUri: redirectUri,
+func (r *userRepo) GetProfileVisible(ctx context.Context, uuid, userUuid string) (bool, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_profile_visible_%s_%s", uuid, userUuid), func() (interface{}, error) {
+		reply, err := r.data.uc.GetProfileVisible(ctx, &userV1.GetProfileVisibleReq{
+			Uuid:     uuid,
+			UserUuid: userUuid,
+		})
+		if err != nil {
+			return false, err
+		}
+		return reply.Visible, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.(bool), nil
+}
+
+func (r *userRepo) GetFollowRequests(ctx context.Context, page int32, uuid string) ([]*biz.Follow, error) {
+	reply, err := r.data.uc.GetFollowRequests(ctx, &userV1.GetFollowRequestsReq{
+		Page: page,
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*biz.Follow, 0, len(reply.Follow))
+	for _, item := range reply.Follow {
+		requests = append(requests, &biz.Follow{
+			Followed: item.Uuid,
+		})
+	}
+	return requests, nil
+}
+
+func (r *userRepo) ApproveFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.ApproveFollowRequest(ctx, &userV1.ApproveFollowRequestReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
 	})
 	if err != nil {
 		return err
@@ -755,17 +972,10 @@ func (r *userRepo) UnbindUserEmail(ctx context.Context, uuid, phone, email, acco
 	return nil
 }
 
-func (r *userRepo) UnbindUserWechat(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error {
-	_, err := r.data.uc.UnbindUserWechat(ctx, &userV1.UnbindUserAccountReq{
-		Uuid:        uuid,
-		Phone:       phone,
-		Email:       email,
-		Account:     account,
-		Password:    password,
-		Code:        code,
-		Choose:      choose,
-		Mode:        mode,
-		RedirectUri: redirectUri,
+func (r *userRepo) RejectFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	_, err := r.data.uc.RejectFollowRequest(ctx, &userV1.RejectFollowRequestReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
 	})
 	if err != nil {
 		return err
@@ -773,17 +983,10 @@ func (r *userRepo) UnbindUserWechat(ctx context.Context, uuid, phone, email, acc
 	return nil
 }
 
-func (r *userRepo) UnbindUserQQ(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error {
-	_, err := r.data.uc.UnbindUserQQ(ctx, &userV1.UnbindUserAccountReq{
-		Uuid:        uuid,
-		Phone:       phone,
-		Email:       email,
-		Account:     account,
-		Password:    password,
-		Code:        code,
-		Choose:      choose,
-		Mode:        mode,
-		RedirectUri: redirectUri,
+func (r *userRepo) SetUserHandle(ctx context.Context, uuid, handle string) error {
+	_, err := r.data.uc.SetUserHandle(ctx, &userV1.SetUserHandleReq{
+		Uuid:   uuid,
+		Handle: handle,
 	})
 	if err != nil {
 		return err
@@ -791,17 +994,73 @@ func (r *userRepo) UnbindUserQQ(ctx context.Context, uuid, phone, email, account
 	return nil
 }
 
-func (r *userRepo) UnbindUserGitee(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error {
-	_, err := r.data.uc.UnbindUserGitee(ctx, &userV1.UnbindUserAccountReq{
-		Uuid:        uuid,
-		Phone:       phone,
-		Email:       email,
-		Account:     account,
-		Password:    password,
-		Code:        code,
-		Choose:      choose,
-		Mode:        mode,
-		RedirectUri: redirectUri,
+func (r *userRepo) GetUserHandle(ctx context.Context, uuid string) (*biz.UserHandle, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_user_handle_%s", uuid), func() (interface{}, error) {
+		reply, err := r.data.uc.GetUserHandle(ctx, &userV1.GetUserHandleReq{
+			Uuid: uuid,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &biz.UserHandle{
+			Handle:     reply.Handle,
+			Changeable: reply.Changeable,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*biz.UserHandle), nil
+}
+
+func (r *userRepo) GetUserByHandle(ctx context.Context, handle string) (string, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_user_by_handle_%s", handle), func() (interface{}, error) {
+		reply, err := r.data.uc.GetUserByHandle(ctx, &userV1.GetUserByHandleReq{
+			Handle: handle,
+		})
+		if err != nil {
+			return "", err
+		}
+		return reply.Uuid, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+func (r *userRepo) GetUserRole(ctx context.Context, uuid string) (string, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_user_role_%s", uuid), func() (interface{}, error) {
+		reply, err := r.data.uc.GetUserRole(ctx, &userV1.GetUserRoleReq{
+			Uuid: uuid,
+		})
+		if err != nil {
+			return "", err
+		}
+		return reply.Role, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+func (r *userRepo) SetUserRole(ctx context.Context, uuid, role string) error {
+	_, err := r.data.uc.SetUserRole(ctx, &userV1.SetUserRoleReq{
+		Uuid: uuid,
+		Role: role,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) SuspendUser(ctx context.Context, uuid string, until int64, reason string) error {
+	_, err := r.data.uc.SuspendUser(ctx, &userV1.SuspendUserReq{
+		Uuid:   uuid,
+		Until:  until,
+		Reason: reason,
 	})
 	if err != nil {
 		return err
@@ -809,20 +1068,179 @@ func (r *userRepo) UnbindUserGitee(ctx context.Context, uuid, phone, email, acco
 	return nil
 }
 
-func (r *userRepo) UnbindUserGithub(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, redirectUri string) error {
-	_, err := r.data.uc.UnbindUserGithub(ctx, &userV1.UnbindUserAccountReq{
+func (r *userRepo) UnsuspendUser(ctx context.Context, uuid string) error {
+	_, err := r.data.uc.UnsuspendUser(ctx, &userV1.UnsuspendUserReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) AddAuditLog(ctx context.Context, auditLog *biz.AuditLog) (int32, error) {
+	reply, err := r.data.uc.AddAuditLog(ctx, &userV1.AddAuditLogReq{
+		Operator: auditLog.Operator,
+		Role:     auditLog.Role,
+		Action:   auditLog.Action,
+		Kind:     auditLog.Kind,
+		Target:   auditLog.Target,
+		Reason:   auditLog.Reason,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.Id, nil
+}
+
+func (r *userRepo) CompleteAuditLog(ctx context.Context, id int32, failed bool) error {
+	_, err := r.data.uc.CompleteAuditLog(ctx, &userV1.CompleteAuditLogReq{
+		Id:     id,
+		Failed: failed,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) GetAuditLogList(ctx context.Context, page int32, operator string) ([]*biz.AuditLog, error) {
+	result, err, _ := r.sg.Do(fmt.Sprintf("get_audit_log_list_%v_%s", page, operator), func() (interface{}, error) {
+		reply, err := r.data.uc.GetAuditLogList(ctx, &userV1.GetAuditLogListReq{
+			Page:     page,
+			Operator: operator,
+		})
+		if err != nil {
+			return nil, err
+		}
+		list := make([]*biz.AuditLog, 0, len(reply.List))
+		for _, item := range reply.List {
+			list = append(list, &biz.AuditLog{
+				Id:        item.Id,
+				Operator:  item.Operator,
+				Role:      item.Role,
+				Action:    item.Action,
+				Kind:      item.Kind,
+				Target:    item.Target,
+				Reason:    item.Reason,
+				CreatedAt: item.CreatedAt,
+				Status:    item.Status,
+			})
+		}
+		return list, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*biz.AuditLog), nil
+}
+
+func (r *userRepo) ChangeUserPassword(ctx context.Context, uuid, oldpassword, password, code string) error {
+	_, err := r.data.uc.ChangeUserPassword(ctx, &userV1.ChangeUserPasswordReq{
 		Uuid:        uuid,
-		Phone:       phone,
-		Email:       email,
-		Account:     account,
+		Oldpassword: oldpassword,
 		Password:    password,
 		Code:        code,
-		Choose:      choose,
-		Mode:        mode,
-		RedirectUri: redirectUri,
 	})
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (r *userRepo) UnbindUserPhone(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, state string) error {
+	_, err := r.data.uc.UnbindUserPhone(ctx, &userV1.UnbindUserAccountReq{
+		Uuid:     uuid,
+		Phone:    phone,
+		Email:    email,
+		Account:  account,
+		Password: password,
+		Code:     code,
+		Choose:   choose,
+		Mode:     mode,
+		State:    state,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) UnbindUserEmail(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, state string) error {
+	_, err := r.data.uc.UnbindUserEmail(ctx, &userV1.UnbindUserAccountReq{
+		Uuid:     uuid,
+		Phone:    phone,
+		Email:    email,
+		Account:  account,
+		Password: password,
+		Code:     code,
+		Choose:   choose,
+		Mode:     mode,
+		State:    state,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) UnbindUserIdentity(ctx context.Context, uuid, provider, phone, email, account, password, code, choose, mode, state string) error {
+	_, err := r.data.uc.UnbindUserIdentity(ctx, &userV1.UnbindUserAccountReq{
+		Uuid:     uuid,
+		Phone:    phone,
+		Email:    email,
+		Account:  account,
+		Password: password,
+		Code:     code,
+		Choose:   choose,
+		Mode:     mode,
+		State:    state,
+		Provider: provider,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) DeleteAccount(ctx context.Context, uuid, phone, email, account, password, code, choose, mode, state string) error {
+	_, err := r.data.uc.DeleteAccount(ctx, &userV1.UnbindUserAccountReq{
+		Uuid:     uuid,
+		Phone:    phone,
+		Email:    email,
+		Account:  account,
+		Password: password,
+		Code:     code,
+		Choose:   choose,
+		Mode:     mode,
+		State:    state,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepo) ExportUserData(ctx context.Context, uuid string) (string, error) {
+	reply, err := r.data.uc.ExportUserData(ctx, &userV1.ExportUserDataReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.Id, nil
+}
+
+func (r *userRepo) GetUserDataExport(ctx context.Context, uuid string) (*biz.UserDataExport, error) {
+	reply, err := r.data.uc.GetUserDataExport(ctx, &userV1.GetUserDataExportReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.UserDataExport{
+		Id:    reply.Id,
+		Ready: reply.Ready,
+		Url:   reply.Url,
+	}, nil
+}
@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
@@ -11,6 +10,7 @@ import (
 	v1 "github.com/the-zion/matrix-core/api/bff/interface/v1"
 	"github.com/the-zion/matrix-core/app/bff/interface/internal/conf"
 	"github.com/the-zion/matrix-core/app/bff/interface/internal/service"
+	"github.com/the-zion/matrix-core/pkg/redact"
 	"github.com/the-zion/matrix-core/pkg/responce"
 )
 
@@ -24,7 +24,7 @@ func NewGRPCServer(c *conf.Server, bffService *service.BffService, logger log.Lo
 			})),
 			tracing.Server(),
 			responce.Server(),
-			logging.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
+			redact.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
 			validate.Validator(),
 		),
 	}
@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -12,6 +11,7 @@ import (
 	"github.com/the-zion/matrix-core/api/bff/interface/v1"
 	"github.com/the-zion/matrix-core/app/bff/interface/internal/conf"
 	"github.com/the-zion/matrix-core/app/bff/interface/internal/service"
+	"github.com/the-zion/matrix-core/pkg/redact"
 	"github.com/the-zion/matrix-core/pkg/request"
 )
 
@@ -27,7 +27,7 @@ func NewHTTPServer(c *conf.Server, bffService *service.BffService, logger log.Lo
 			ratelimit.Server(),
 			tracing.Server(),
 			request.Server(),
-			logging.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
+			redact.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
 			validate.Validator(),
 		),
 	}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/bff/interface/v1"
+	"github.com/the-zion/matrix-core/app/bff/interface/internal/biz"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *BffService) GetContentFlagList(ctx context.Context, req *v1.GetContentFlagListReq) (*v1.GetContentFlagListReply, error) {
+	flagList, err := s.adc.GetContentFlagList(ctx, req.Page, req.Kind, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetContentFlagListReply{List: make([]*v1.GetContentFlagListReply_List, 0, len(flagList))}
+	for _, item := range flagList {
+		reply.List = append(reply.List, &v1.GetContentFlagListReply_List{
+			Id:        item.Id,
+			Kind:      item.Kind,
+			Mode:      item.Mode,
+			ContentId: item.ContentId,
+			Uuid:      item.Uuid,
+			Text:      item.Text,
+			JobId:     item.JobId,
+			Label:     item.Label,
+			Section:   item.Section,
+			Status:    item.Status,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+	return reply, nil
+}
+
+func (s *BffService) PublishContentFlag(ctx context.Context, req *v1.PublishContentFlagReq) (*emptypb.Empty, error) {
+	err := s.adc.PublishContentFlag(ctx, req.Id, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) RemoveContentFlag(ctx context.Context, req *v1.RemoveContentFlagReq) (*emptypb.Empty, error) {
+	err := s.adc.RemoveContentFlag(ctx, req.Id, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) SuspendUser(ctx context.Context, req *v1.SuspendUserReq) (*emptypb.Empty, error) {
+	err := s.adc.SuspendUser(ctx, req.Uuid, req.Until, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) UnsuspendUser(ctx context.Context, req *v1.UnsuspendUserReq) (*emptypb.Empty, error) {
+	err := s.adc.UnsuspendUser(ctx, req.Uuid, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) SetUserRole(ctx context.Context, req *v1.SetUserRoleReq) (*emptypb.Empty, error) {
+	err := s.adc.SetUserRole(ctx, req.Uuid, req.Role, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) GetAuditLogList(ctx context.Context, req *v1.GetAuditLogListReq) (*v1.GetAuditLogListReply, error) {
+	logList, err := s.adc.GetAuditLogList(ctx, req.Page, req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetAuditLogListReply{List: make([]*v1.GetAuditLogListReply_AuditLog, 0, len(logList))}
+	for _, item := range logList {
+		reply.List = append(reply.List, &v1.GetAuditLogListReply_AuditLog{
+			Id:        item.Id,
+			Operator:  item.Operator,
+			Role:      item.Role,
+			Action:    item.Action,
+			Kind:      item.Kind,
+			Target:    item.Target,
+			Reason:    item.Reason,
+			CreatedAt: item.CreatedAt,
+			Status:    item.Status,
+		})
+	}
+	return reply, nil
+}
+
+func (s *BffService) GetAppealList(ctx context.Context, req *v1.GetAppealListReq) (*v1.GetAppealListReply, error) {
+	appealList, err := s.adc.GetAppealList(ctx, req.Page, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return appealListReply(appealList), nil
+}
+
+func (s *BffService) AcceptAppeal(ctx context.Context, req *v1.AcceptAppealReq) (*emptypb.Empty, error) {
+	err := s.adc.AcceptAppeal(ctx, req.Id, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) RejectAppeal(ctx context.Context, req *v1.RejectAppealReq) (*emptypb.Empty, error) {
+	err := s.adc.RejectAppeal(ctx, req.Id, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func appealListReply(appealList []*biz.Appeal) *v1.GetAppealListReply {
+	reply := &v1.GetAppealListReply{List: make([]*v1.GetAppealListReply_List, 0, len(appealList))}
+	for _, item := range appealList {
+		reply.List = append(reply.List, &v1.GetAppealListReply_List{
+			Id:        item.Id,
+			JobId:     item.JobId,
+			Uuid:      item.Uuid,
+			Reason:    item.Reason,
+			Status:    item.Status,
+			CreatedAt: item.CreatedAt,
+			Kind:      item.Kind,
+			Mode:      item.Mode,
+			ContentId: item.ContentId,
+			Text:      item.Text,
+			Label:     item.Label,
+			Section:   item.Section,
+		})
+	}
+	return reply
+}
@@ -335,7 +335,7 @@ func (s *BffService) GetCommentContentReview(ctx context.Context, req *v1.GetCom
 }
 
 func (s *BffService) SendComment(ctx context.Context, req *v1.SendCommentReq) (*emptypb.Empty, error) {
-	err := s.commc.SendComment(ctx, req.Id)
+	err := s.commc.SendComment(ctx, req.Id, req.CreationId, req.CreationType)
 	if err != nil {
 		return nil, err
 	}
@@ -343,7 +343,7 @@ func (s *BffService) SendComment(ctx context.Context, req *v1.SendCommentReq) (*
 }
 
 func (s *BffService) SendSubComment(ctx context.Context, req *v1.SendSubCommentReq) (*emptypb.Empty, error) {
-	err := s.commc.SendSubComment(ctx, req.Id)
+	err := s.commc.SendSubComment(ctx, req.Id, req.RootId, req.ParentId)
 	if err != nil {
 		return nil, err
 	}
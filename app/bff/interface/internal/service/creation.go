@@ -440,6 +440,7 @@ func (s *BffService) GetArticleStatistic(ctx context.Context, req *v1.GetArticle
 		Agree:   articleStatistic.Agree,
 		Collect: articleStatistic.Collect,
 		View:    articleStatistic.View,
+		RawView: articleStatistic.RawView,
 		Comment: articleStatistic.Comment,
 	}, nil
 }
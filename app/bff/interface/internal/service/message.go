@@ -16,6 +16,7 @@ func (s *BffService) GetMessageNotification(ctx context.Context, _ *emptypb.Empt
 		Comment:    notification.Comment,
 		SubComment: notification.SubComment,
 		System:     notification.SystemNotification,
+		Mention:    notification.Mention,
 	}, nil
 }
 
@@ -54,6 +55,28 @@ func (s *BffService) GetMessageSystemNotification(ctx context.Context, req *v1.G
 	return reply, nil
 }
 
+func (s *BffService) GetMessageMention(ctx context.Context, req *v1.GetMessageMentionReq) (*v1.GetMessageMentionReply, error) {
+	mentionList, err := s.mc.GetMessageMention(ctx, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetMessageMentionReply{List: make([]*v1.GetMessageMentionReply_List, 0, len(mentionList))}
+	for _, item := range mentionList {
+		reply.List = append(reply.List, &v1.GetMessageMentionReply_List{
+			Id:           item.Id,
+			ContentId:    item.ContentId,
+			CreatedAt:    item.CreatedAt,
+			Kind:         item.Kind,
+			CreationId:   item.CreationId,
+			CreationType: item.CreationType,
+			RootId:       item.RootId,
+			Uid:          item.Uid,
+			Text:         item.Text,
+		})
+	}
+	return reply, nil
+}
+
 func (s *BffService) SetMailBoxLastTime(ctx context.Context, req *v1.SetMailBoxLastTimeReq) (*emptypb.Empty, error) {
 	err := s.mc.SetMailBoxLastTime(ctx, req.Time)
 	if err != nil {
@@ -85,3 +108,27 @@ func (s *BffService) RemoveMailBoxSystemNotificationCount(ctx context.Context, _
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *BffService) RemoveMailBoxMentionCount(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	err := s.mc.RemoveMailBoxMentionCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) AppealReview(ctx context.Context, req *v1.AppealReviewReq) (*emptypb.Empty, error) {
+	err := s.mc.AppealReview(ctx, req.JobId, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) GetUserAppealList(ctx context.Context, req *v1.GetUserAppealListReq) (*v1.GetAppealListReply, error) {
+	appealList, err := s.mc.GetUserAppealList(ctx, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	return appealListReply(appealList), nil
+}
@@ -22,10 +22,11 @@ type BffService struct {
 	nc    *biz.NewsUseCase
 	commc *biz.CommentUseCase
 	mc    *biz.MessageUseCase
+	adc   *biz.AdminUseCase
 	log   *log.Helper
 }
 
-func NewBffService(uc *biz.UserUseCase, cc *biz.CreationUseCase, tc *biz.TalkUseCase, ac *biz.ArticleUseCase, coc *biz.ColumnUseCase, achc *biz.AchievementUseCase, nc *biz.NewsUseCase, commc *biz.CommentUseCase, mc *biz.MessageUseCase, logger log.Logger) *BffService {
+func NewBffService(uc *biz.UserUseCase, cc *biz.CreationUseCase, tc *biz.TalkUseCase, ac *biz.ArticleUseCase, coc *biz.ColumnUseCase, achc *biz.AchievementUseCase, nc *biz.NewsUseCase, commc *biz.CommentUseCase, mc *biz.MessageUseCase, adc *biz.AdminUseCase, logger log.Logger) *BffService {
 	return &BffService{
 		log:   log.NewHelper(log.With(logger, "module", "bff/interface")),
 		uc:    uc,
@@ -37,6 +38,7 @@ func NewBffService(uc *biz.UserUseCase, cc *biz.CreationUseCase, tc *biz.TalkUse
 		nc:    nc,
 		mc:    mc,
 		commc: commc,
+		adc:   adc,
 	}
 }
 
@@ -21,7 +21,9 @@ func (s *BffService) LoginByPassword(ctx context.Context, req *v1.LoginByPasswor
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+		Challenge:    token.Challenge,
 	}, nil
 }
 
@@ -31,7 +33,9 @@ func (s *BffService) LoginByCode(ctx context.Context, req *v1.LoginByCodeReq) (*
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+		Challenge:    token.Challenge,
 	}, nil
 }
 
@@ -43,48 +47,116 @@ func (s *BffService) LoginPasswordReset(ctx context.Context, req *v1.LoginPasswo
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) LoginByWeChat(ctx context.Context, req *v1.LoginByWeChatReq) (*v1.LoginReply, error) {
-	token, err := s.uc.LoginByWechat(ctx, req.Code)
+func (s *BffService) GetOauthUrl(ctx context.Context, req *v1.GetOauthUrlReq) (*v1.GetOauthUrlReply, error) {
+	url, state, err := s.uc.GetOauthUrl(ctx, req.Provider, req.RedirectUri)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.LoginReply{
-		Token: token,
+	return &v1.GetOauthUrlReply{
+		Url:   url,
+		State: state,
 	}, nil
 }
 
-func (s *BffService) LoginByQQ(ctx context.Context, req *v1.LoginByQQReq) (*v1.LoginReply, error) {
-	token, err := s.uc.LoginByQQ(ctx, req.Code)
+func (s *BffService) LoginByOauth(ctx context.Context, req *v1.LoginByOauthReq) (*v1.LoginReply, error) {
+	token, err := s.uc.LoginByOauth(ctx, req.Provider, req.Code, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+		Challenge:    token.Challenge,
 	}, nil
 }
 
-func (s *BffService) LoginByGithub(ctx context.Context, req *v1.LoginByGithubReq) (*v1.LoginReply, error) {
-	github, err := s.uc.LoginByGithub(ctx, req.Code)
+func (s *BffService) RefreshToken(ctx context.Context, req *v1.RefreshTokenReq) (*v1.LoginReply, error) {
+	token, err := s.uc.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: github.Token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
 	}, nil
 }
 
-func (s *BffService) LoginByGitee(ctx context.Context, req *v1.LoginByGiteeReq) (*v1.LoginReply, error) {
-	token, err := s.uc.LoginByGitee(ctx, req.Code)
+func (s *BffService) Logout(ctx context.Context, req *v1.LogoutReq) (*emptypb.Empty, error) {
+	err := s.uc.Logout(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) LogoutAllDevices(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	err := s.uc.LogoutAllDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) ListSessions(ctx context.Context, _ *emptypb.Empty) (*v1.ListSessionsReply, error) {
+	sessions, err := s.uc.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListSessionsReply{Session: make([]*v1.ListSessionsReply_Session, 0, len(sessions))}
+	for _, item := range sessions {
+		reply.Session = append(reply.Session, &v1.ListSessionsReply_Session{
+			Id:      item.Id,
+			Device:  item.Device,
+			Ip:      item.Ip,
+			Created: item.Created,
+			Seen:    item.Seen,
+		})
+	}
+	return reply, nil
+}
+
+func (s *BffService) LoginByTotp(ctx context.Context, req *v1.LoginByTotpReq) (*v1.LoginReply, error) {
+	token, err := s.uc.LoginByTotp(ctx, req.Challenge, req.Code)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+	}, nil
+}
+
+func (s *BffService) EnrollTotp(ctx context.Context, _ *emptypb.Empty) (*v1.EnrollTotpReply, error) {
+	enrollment, err := s.uc.EnrollTotp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EnrollTotpReply{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.Uri,
 	}, nil
 }
 
+func (s *BffService) ConfirmTotp(ctx context.Context, req *v1.ConfirmTotpReq) (*v1.ConfirmTotpReply, error) {
+	codes, err := s.uc.ConfirmTotp(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ConfirmTotpReply{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *BffService) DisableTotp(ctx context.Context, req *v1.DisableTotpReq) (*emptypb.Empty, error) {
+	err := s.uc.DisableTotp(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *BffService) SendPhoneCode(ctx context.Context, req *v1.SendPhoneCodeReq) (*emptypb.Empty, error) {
-	err := s.uc.SendPhoneCode(ctx, req.Template, req.Phone)
+	err := s.uc.SendPhoneCode(ctx, req.Template, req.Phone, req.Captcha)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +164,7 @@ func (s *BffService) SendPhoneCode(ctx context.Context, req *v1.SendPhoneCodeReq
 }
 
 func (s *BffService) SendEmailCode(ctx context.Context, req *v1.SendEmailCodeReq) (*emptypb.Empty, error) {
-	err := s.uc.SendEmailCode(ctx, req.Template, req.Email)
+	err := s.uc.SendEmailCode(ctx, req.Template, req.Email, req.Captcha)
 	if err != nil {
 		return nil, err
 	}
@@ -119,13 +191,11 @@ func (s *BffService) GetAccount(ctx context.Context, _ *emptypb.Empty) (*v1.GetA
 		return nil, err
 	}
 	return &v1.GetAccountReply{
-		Phone:    userAccount.Phone,
-		Email:    userAccount.Email,
-		Qq:       userAccount.Qq,
-		Wechat:   userAccount.Wechat,
-		Gitee:    userAccount.Gitee,
-		Github:   userAccount.Github,
-		Password: userAccount.Password,
+		Phone:      userAccount.Phone,
+		Email:      userAccount.Email,
+		Password:   userAccount.Password,
+		Totp:       userAccount.Totp,
+		Identities: userAccount.Identities,
 	}, nil
 }
 
@@ -190,6 +260,8 @@ func (s *BffService) GetUserInfo(ctx context.Context, _ *emptypb.Empty) (*v1.Get
 		Talk:        userProfile.Talk,
 		Collections: userProfile.Collections,
 		Subscribe:   userProfile.Subscribe,
+		Private:     userProfile.Private,
+		Handle:      userProfile.Handle,
 	}, nil
 }
 
@@ -218,6 +290,8 @@ func (s *BffService) GetUserInfoVisitor(ctx context.Context, req *v1.GetUserInfo
 		Column:      userProfile.Column,
 		Talk:        userProfile.Talk,
 		Collections: userProfile.Collections,
+		Private:     userProfile.Private,
+		Handle:      userProfile.Handle,
 	}, nil
 }
 
@@ -241,12 +315,13 @@ func (s *BffService) GetProfileUpdate(ctx context.Context, _ *emptypb.Empty) (*v
 }
 
 func (s *BffService) GetUserFollow(ctx context.Context, req *v1.GetUserFollowReq) (*v1.GetUserFollowReply, error) {
-	follow, err := s.uc.GetUserFollow(ctx, req.Uuid)
+	follow, requested, err := s.uc.GetUserFollow(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.GetUserFollowReply{
-		Follow: follow,
+		Follow:    follow,
+		Requested: requested,
 	}, nil
 }
 
@@ -443,56 +518,164 @@ func (s *BffService) SetUserPassword(ctx context.Context, req *v1.SetUserPasswor
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) SetUserWechat(ctx context.Context, req *v1.SetUserWechatReq) (*emptypb.Empty, error) {
-	err := s.uc.SetUserWechat(ctx, req.Code, req.RedirectUrl)
+func (s *BffService) SetUserIdentity(ctx context.Context, req *v1.SetUserIdentityReq) (*emptypb.Empty, error) {
+	err := s.uc.SetUserIdentity(ctx, req.Provider, req.Code, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) SetUserQQ(ctx context.Context, req *v1.SetUserQQReq) (*emptypb.Empty, error) {
-	err := s.uc.SetUserQQ(ctx, req.Code, req.RedirectUrl)
+func (s *BffService) SetUserFollow(ctx context.Context, req *v1.SetUserFollowReq) (*emptypb.Empty, error) {
+	err := s.uc.SetUserFollow(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) SetUserGitee(ctx context.Context, req *v1.SetUserGiteeReq) (*emptypb.Empty, error) {
-	err := s.uc.SetUserGitee(ctx, req.Code, req.RedirectUrl)
+func (s *BffService) CancelUserFollow(ctx context.Context, req *v1.CancelUserFollowReq) (*emptypb.Empty, error) {
+	err := s.uc.CancelUserFollow(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) SetUserGithub(ctx context.Context, req *v1.SetUserGithubReq) (*emptypb.Empty, error) {
-	err := s.uc.SetUserGithub(ctx, req.Code, req.RedirectUrl)
+func (s *BffService) BlockUser(ctx context.Context, req *v1.BlockUserReq) (*emptypb.Empty, error) {
+	err := s.uc.BlockUser(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) SetUserFollow(ctx context.Context, req *v1.SetUserFollowReq) (*emptypb.Empty, error) {
-	err := s.uc.SetUserFollow(ctx, req.Uuid)
+func (s *BffService) UnblockUser(ctx context.Context, req *v1.UnblockUserReq) (*emptypb.Empty, error) {
+	err := s.uc.UnblockUser(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) CancelUserFollow(ctx context.Context, req *v1.CancelUserFollowReq) (*emptypb.Empty, error) {
-	err := s.uc.CancelUserFollow(ctx, req.Uuid)
+func (s *BffService) MuteUser(ctx context.Context, req *v1.MuteUserReq) (*emptypb.Empty, error) {
+	err := s.uc.MuteUser(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *BffService) UnmuteUser(ctx context.Context, req *v1.UnmuteUserReq) (*emptypb.Empty, error) {
+	err := s.uc.UnmuteUser(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) GetUserBlocks(ctx context.Context, _ *emptypb.Empty) (*v1.GetUserBlocksReply, error) {
+	blocked, muted, err := s.uc.GetUserBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserBlocksReply{
+		Blocked: blocked,
+		Muted:   muted,
+	}, nil
+}
+
+func (s *BffService) GetFollowRecommendations(ctx context.Context, req *v1.GetFollowRecommendationsReq) (*v1.GetFollowRecommendationsReply, error) {
+	recommendList, err := s.uc.GetFollowRecommendations(ctx, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetFollowRecommendationsReply{Follow: make([]*v1.GetFollowRecommendationsReply_Follow, 0, len(recommendList))}
+	for _, item := range recommendList {
+		reply.Follow = append(reply.Follow, &v1.GetFollowRecommendationsReply_Follow{
+			Uuid:      item.Follow,
+			Username:  item.Username,
+			Introduce: item.Introduce,
+			Agree:     item.Agree,
+			View:      item.View,
+			Follow:    item.FollowNum,
+			Followed:  item.FollowedNum,
+		})
+	}
+	return reply, nil
+}
+
+func (s *BffService) SetProfilePrivate(ctx context.Context, req *v1.SetProfilePrivateReq) (*emptypb.Empty, error) {
+	err := s.uc.SetProfilePrivate(ctx, req.Private)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) GetFollowRequests(ctx context.Context, req *v1.GetFollowRequestsReq) (*v1.GetFollowRequestsReply, error) {
+	requestList, err := s.uc.GetFollowRequests(ctx, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetFollowRequestsReply{Follow: make([]*v1.GetFollowRequestsReply_Follow, 0, len(requestList))}
+	for _, item := range requestList {
+		reply.Follow = append(reply.Follow, &v1.GetFollowRequestsReply_Follow{
+			Uuid:      item.Followed,
+			Username:  item.Username,
+			Introduce: item.Introduce,
+		})
+	}
+	return reply, nil
+}
+
+func (s *BffService) ApproveFollowRequest(ctx context.Context, req *v1.ApproveFollowRequestReq) (*emptypb.Empty, error) {
+	err := s.uc.ApproveFollowRequest(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) RejectFollowRequest(ctx context.Context, req *v1.RejectFollowRequestReq) (*emptypb.Empty, error) {
+	err := s.uc.RejectFollowRequest(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) SetUserHandle(ctx context.Context, req *v1.SetUserHandleReq) (*emptypb.Empty, error) {
+	err := s.uc.SetUserHandle(ctx, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BffService) GetUserHandle(ctx context.Context, _ *emptypb.Empty) (*v1.GetUserHandleReply, error) {
+	handle, err := s.uc.GetUserHandle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserHandleReply{
+		Handle:     handle.Handle,
+		Changeable: handle.Changeable,
+	}, nil
+}
+
+func (s *BffService) GetUserByHandle(ctx context.Context, req *v1.GetUserByHandleReq) (*v1.GetUserByHandleReply, error) {
+	uuid, err := s.uc.GetUserByHandle(ctx, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserByHandleReply{
+		Uuid: uuid,
+	}, nil
+}
+
 func (s *BffService) ChangeUserPassword(ctx context.Context, req *v1.ChangeUserPasswordReq) (*emptypb.Empty, error) {
-	err := s.uc.ChangeUserPassword(ctx, req.Oldpassword, req.Password)
+	err := s.uc.ChangeUserPassword(ctx, req.Oldpassword, req.Password, req.Code)
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +683,7 @@ func (s *BffService) ChangeUserPassword(ctx context.Context, req *v1.ChangeUserP
 }
 
 func (s *BffService) UnbindUserPhone(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.uc.UnbindUserPhone(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+	err := s.uc.UnbindUserPhone(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.State)
 	if err != nil {
 		return nil, err
 	}
@@ -508,41 +691,47 @@ func (s *BffService) UnbindUserPhone(ctx context.Context, req *v1.UnbindUserAcco
 }
 
 func (s *BffService) UnbindUserEmail(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.uc.UnbindUserEmail(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+	err := s.uc.UnbindUserEmail(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) UnbindUserWechat(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.uc.UnbindUserWechat(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+func (s *BffService) UnbindUserIdentity(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
+	err := s.uc.UnbindUserIdentity(ctx, req.Provider, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) UnbindUserQQ(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.uc.UnbindUserQQ(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+func (s *BffService) DeleteAccount(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
+	err := s.uc.DeleteAccount(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *BffService) UnbindUserGitee(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.uc.UnbindUserGitee(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+func (s *BffService) ExportUserData(ctx context.Context, _ *emptypb.Empty) (*v1.ExportUserDataReply, error) {
+	id, err := s.uc.ExportUserData(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	return &v1.ExportUserDataReply{
+		Id: id,
+	}, nil
 }
 
-func (s *BffService) UnbindUserGithub(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.uc.UnbindUserGithub(ctx, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+func (s *BffService) GetUserDataExport(ctx context.Context, _ *emptypb.Empty) (*v1.GetUserDataExportReply, error) {
+	export, err := s.uc.GetUserDataExport(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	return &v1.GetUserDataExportReply{
+		Id:    export.Id,
+		Ready: export.Ready,
+		Url:   export.Url,
+	}, nil
 }
@@ -24,16 +24,18 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logLogger log.Logger,
 	db := data.NewDB(confData)
 	client := data.NewCosServiceClient(confData)
 	cmdable := data.NewRedis(confData)
-	mqPro := data.NewRocketMqProducer(confData)
+	transport := data.NewTransport(confData)
 	creationClient := data.NewCreationServiceClient(registry)
-	dataData, cleanup2, err := data.NewData(db, client, cmdable, mqPro, creationClient, logLogger)
+	userClient := data.NewUserServiceClient(registry)
+	dataData, cleanup2, err := data.NewData(db, client, cmdable, transport, creationClient, userClient, logLogger)
 	if err != nil {
 		return nil, nil, err
 	}
 	commentRepo := data.NewCommentRepo(dataData, logLogger)
 	recovery := data.NewRecovery(dataData)
 	transaction := data.NewTransaction(dataData)
-	commentUseCase := biz.NewCommentUseCase(commentRepo, recovery, transaction, logLogger)
+	publisher := data.NewPublisher(dataData)
+	commentUseCase := biz.NewCommentUseCase(commentRepo, recovery, transaction, publisher, logLogger)
 	commentService := service.NewCommentService(commentUseCase, logLogger)
 	httpServer := server.NewHTTPServer(confServer, commentService, logLogger)
 	grpcServer := server.NewGRPCServer(confServer, commentService, logLogger)
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	v1 "github.com/the-zion/matrix-core/api/comment/service/v1"
 	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
@@ -38,6 +39,7 @@ type CommentRepo interface {
 	GetSubCommentReply(ctx context.Context, id int32, uuid string) (string, error)
 	GetArticleAuthor(ctx context.Context, id int32) (string, error)
 	GetTalkAuthor(ctx context.Context, id int32) (string, error)
+	GetUserBlock(ctx context.Context, uuid, userUuid string) (bool, error)
 
 	CreateCommentDraft(ctx context.Context, uuid string) (int32, error)
 	CreateCommentFolder(ctx context.Context, id int32, uuid string) error
@@ -53,14 +55,6 @@ type CommentRepo interface {
 	AddTalkSubCommentUser(ctx context.Context, parentId int32, rootUser, parentUser, uuid string) error
 
 	SendComment(ctx context.Context, id int32, uuid string) (*CommentDraft, error)
-	SendCommentAgreeToMq(ctx context.Context, id, creationId, creationType int32, uuid, userUuid, mode string) error
-	SendSubCommentAgreeToMq(ctx context.Context, id int32, uuid, userUuid, mode string) error
-	SendCommentToMq(ctx context.Context, comment *Comment, mode string) error
-	SendCommentContentIrregularToMq(ctx context.Context, review *TextReview) error
-	SendSubCommentToMq(ctx context.Context, comment *SubComment, mode string) error
-	SendReviewToMq(ctx context.Context, review *CommentReview) error
-	SendCommentStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error
-	SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error
 
 	SetRecord(ctx context.Context, id int32, uuid, ip string) error
 	SetCommentAgree(ctx context.Context, id int32, uuid string) error
@@ -93,19 +87,26 @@ type CommentRepo interface {
 	RemoveCommentAgree(ctx context.Context, id int32, uuid string) error
 	RemoveCommentCache(ctx context.Context, id, creationId, creationType int32, creationAuthor, uuid string) error
 	RemoveSubCommentCache(ctx context.Context, id, rootId, parentId, creationId, creationType int32, creationAuthor, rootUser, uuid, reply string) error
+	GetUserCommentIds(ctx context.Context, uuid string) ([]int32, error)
+	GetUserSubCommentIds(ctx context.Context, uuid string) ([]int32, error)
+	GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error)
+	PurgeUserData(ctx context.Context, uuid string) error
+	PurgeUserDataFromCache(ctx context.Context, uuid string) error
 }
 
 type CommentUseCase struct {
 	repo CommentRepo
 	tm   Transaction
+	pub  Publisher
 	re   Recovery
 	log  *log.Helper
 }
 
-func NewCommentUseCase(repo CommentRepo, re Recovery, tm Transaction, logger log.Logger) *CommentUseCase {
+func NewCommentUseCase(repo CommentRepo, re Recovery, tm Transaction, pub Publisher, logger log.Logger) *CommentUseCase {
 	return &CommentUseCase{
 		repo: repo,
 		tm:   tm,
+		pub:  pub,
 		re:   re,
 		log:  log.NewHelper(log.With(logger, "module", "comment/biz/CommentUseCase")),
 	}
@@ -288,7 +289,7 @@ func (r *CommentUseCase) CreateCommentDraft(ctx context.Context, uuid string) (i
 }
 
 func (r *CommentUseCase) CreateComment(ctx context.Context, id, creationTd, creationType int32, uuid string) error {
-	err := r.repo.SendCommentToMq(ctx, &Comment{
+	err := r.publishComment(ctx, &Comment{
 		CommentId:    id,
 		Uuid:         uuid,
 		CreationId:   creationTd,
@@ -301,7 +302,7 @@ func (r *CommentUseCase) CreateComment(ctx context.Context, id, creationTd, crea
 }
 
 func (r *CommentUseCase) CommentContentIrregular(ctx context.Context, review *TextReview) error {
-	err := r.repo.SendCommentContentIrregularToMq(ctx, review)
+	err := r.publishCommentContentIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetContentIrregularFailed("set comment content irregular to mq failed: %s", err.Error())
 	}
@@ -309,7 +310,7 @@ func (r *CommentUseCase) CommentContentIrregular(ctx context.Context, review *Te
 }
 
 func (r *CommentUseCase) CreateSubComment(ctx context.Context, id, rootId, parentId int32, uuid string) error {
-	err := r.repo.SendSubCommentToMq(ctx, &SubComment{
+	err := r.publishSubComment(ctx, &SubComment{
 		CommentId: id,
 		Uuid:      uuid,
 		RootId:    rootId,
@@ -321,7 +322,17 @@ func (r *CommentUseCase) CreateSubComment(ctx context.Context, id, rootId, paren
 	return nil
 }
 
-func (r *CommentUseCase) SendComment(ctx context.Context, id int32, uuid, ip string) error {
+func (r *CommentUseCase) SendComment(ctx context.Context, id, creationId, creationType int32, uuid, ip string) error {
+	author, err := r.getCreationAuthor(ctx, creationId, creationType)
+	if err != nil {
+		return v1.ErrorCreateDraftFailed("get creation author failed: %s", err.Error())
+	}
+
+	err = r.checkBlock(ctx, uuid, author)
+	if err != nil {
+		return err
+	}
+
 	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
 		draft, err := r.repo.SendComment(ctx, id, uuid)
 		if err != nil {
@@ -333,7 +344,7 @@ func (r *CommentUseCase) SendComment(ctx context.Context, id int32, uuid, ip str
 			return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 		}
 
-		err = r.repo.SendReviewToMq(ctx, &CommentReview{
+		err = r.publishReview(ctx, &CommentReview{
 			Uuid: draft.Uuid,
 			Id:   draft.Id,
 			Mode: "comment_review",
@@ -345,7 +356,26 @@ func (r *CommentUseCase) SendComment(ctx context.Context, id int32, uuid, ip str
 	})
 }
 
-func (r *CommentUseCase) SendSubComment(ctx context.Context, id int32, uuid, ip string) error {
+func (r *CommentUseCase) SendSubComment(ctx context.Context, id, rootId, parentId int32, uuid, ip string) error {
+	root, err := r.repo.GetRootComment(ctx, rootId)
+	if err != nil {
+		return v1.ErrorCreateDraftFailed("get root comment failed: %s", err.Error())
+	}
+
+	users := []string{root.CreationAuthor, root.Uuid}
+	if parentId != 0 {
+		parent, err := r.repo.GetParentCommentUserId(ctx, parentId, rootId)
+		if err != nil {
+			return v1.ErrorCreateDraftFailed("get parent comment failed: %s", err.Error())
+		}
+		users = append(users, parent)
+	}
+
+	err = r.checkBlock(ctx, uuid, users...)
+	if err != nil {
+		return err
+	}
+
 	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
 		draft, err := r.repo.SendComment(ctx, id, uuid)
 		if err != nil {
@@ -357,7 +387,7 @@ func (r *CommentUseCase) SendSubComment(ctx context.Context, id int32, uuid, ip
 			return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 		}
 
-		err = r.repo.SendReviewToMq(ctx, &CommentReview{
+		err = r.publishReview(ctx, &CommentReview{
 			Uuid: draft.Uuid,
 			Id:   draft.Id,
 			Mode: "sub_comment_review",
@@ -369,8 +399,26 @@ func (r *CommentUseCase) SendSubComment(ctx context.Context, id int32, uuid, ip
 	})
 }
 
+// checkBlock returns ErrorUserBlocked when uuid and any of users blocked
+// the other, users being those a comment of uuid would reply to.
+func (r *CommentUseCase) checkBlock(ctx context.Context, uuid string, users ...string) error {
+	for _, user := range users {
+		if user == "" || user == uuid {
+			continue
+		}
+		blocked, err := r.repo.GetUserBlock(ctx, uuid, user)
+		if err != nil {
+			return v1.ErrorCreateDraftFailed("get user block failed: %s", err.Error())
+		}
+		if blocked {
+			return v1.ErrorUserBlocked("user blocked: uuid(%s), user(%s)", uuid, user)
+		}
+	}
+	return nil
+}
+
 func (r *CommentUseCase) RemoveComment(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendCommentToMq(ctx, &Comment{
+	err := r.publishComment(ctx, &Comment{
 		CommentId: id,
 		Uuid:      uuid,
 	}, "remove_comment_db_and_cache")
@@ -381,7 +429,7 @@ func (r *CommentUseCase) RemoveComment(ctx context.Context, id int32, uuid strin
 }
 
 func (r *CommentUseCase) RemoveSubComment(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendSubCommentToMq(ctx, &SubComment{
+	err := r.publishSubComment(ctx, &SubComment{
 		CommentId: id,
 		Uuid:      uuid,
 	}, "remove_sub_comment_db_and_cache")
@@ -401,7 +449,7 @@ func (r *CommentUseCase) SetCommentAgree(ctx context.Context, id, creationId, cr
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendCommentAgreeToMq(ctx, id, creationId, creationType, uuid, userUuid, "set_comment_agree_db_and_cache")
+		err := r.publishCommentAgree(ctx, id, creationId, creationType, uuid, userUuid, "set_comment_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set comment agree to mq failed: %s", err.Error())
 		}
@@ -420,7 +468,7 @@ func (r *CommentUseCase) SetSubCommentAgree(ctx context.Context, id int32, uuid,
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendSubCommentAgreeToMq(ctx, id, uuid, userUuid, "set_sub_comment_agree_db_and_cache")
+		err := r.publishSubCommentAgree(ctx, id, uuid, userUuid, "set_sub_comment_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set comment agree to mq failed: %s", err.Error())
 		}
@@ -443,11 +491,11 @@ func (r *CommentUseCase) SetCommentAgreeDbAndCache(ctx context.Context, id, crea
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set comment agree to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendCommentStatisticToMq(ctx, uuid, userUuid, "agree")
+		err = r.publishCommentStatistic(ctx, uuid, userUuid, "agree")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set comment agree to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorCreateCommentFailed("send 2 score to mq failed: %s", err.Error())
 		}
@@ -469,11 +517,11 @@ func (r *CommentUseCase) SetSubCommentAgreeDbAndCache(ctx context.Context, id in
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set sub comment agree to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendCommentStatisticToMq(ctx, uuid, userUuid, "agree")
+		err = r.publishCommentStatistic(ctx, uuid, userUuid, "agree")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set comment agree to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorCreateCommentFailed("send 2 score to mq failed: %s", err.Error())
 		}
@@ -491,7 +539,7 @@ func (r *CommentUseCase) CancelCommentAgree(ctx context.Context, id, creationId,
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendCommentAgreeToMq(ctx, id, creationId, creationType, uuid, userUuid, "cancel_comment_agree_db_and_cache")
+		err := r.publishCommentAgree(ctx, id, creationId, creationType, uuid, userUuid, "cancel_comment_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel comment agree from mq failed: %s", err.Error())
 		}
@@ -510,7 +558,7 @@ func (r *CommentUseCase) CancelSubCommentAgree(ctx context.Context, id int32, uu
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendSubCommentAgreeToMq(ctx, id, uuid, userUuid, "cancel_sub_comment_agree_db_and_cache")
+		err := r.publishSubCommentAgree(ctx, id, uuid, userUuid, "cancel_sub_comment_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel sub comment agree from mq failed: %s", err.Error())
 		}
@@ -533,7 +581,7 @@ func (r *CommentUseCase) CancelCommentAgreeDbAndCache(ctx context.Context, id, c
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel comment agree from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendCommentStatisticToMq(ctx, uuid, userUuid, "agree_cancel")
+		err = r.publishCommentStatistic(ctx, uuid, userUuid, "agree_cancel")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel comment agree from mq failed: %s", err.Error())
 		}
@@ -555,7 +603,7 @@ func (r *CommentUseCase) CancelSubCommentAgreeDbAndCache(ctx context.Context, id
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel comment agree from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendCommentStatisticToMq(ctx, uuid, userUuid, "agree_cancel")
+		err = r.publishCommentStatistic(ctx, uuid, userUuid, "agree_cancel")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel comment agree from mq failed: %s", err.Error())
 		}
@@ -613,7 +661,7 @@ func (r *CommentUseCase) CreateCommentDbAndCache(ctx context.Context, id, creati
 			return err
 		}
 
-		err = r.repo.SendScoreToMq(ctx, 5, uuid, "add_score")
+		err = r.publishScore(ctx, 5, uuid, "add_score")
 		if err != nil {
 			return err
 		}
@@ -687,7 +735,7 @@ func (r *CommentUseCase) CreateSubCommentDbAndCache(ctx context.Context, id, roo
 			return err
 		}
 
-		err = r.repo.SendScoreToMq(ctx, 5, uuid, "add_score")
+		err = r.publishScore(ctx, 5, uuid, "add_score")
 		if err != nil {
 			return err
 		}
@@ -802,3 +850,137 @@ func (r *CommentUseCase) reduceSubCommentUser(ctx context.Context, creationType,
 	}
 	return nil
 }
+
+func (r *CommentUseCase) publishReview(ctx context.Context, review *CommentReview) error {
+	return r.pub.Publish(ctx, &event.Review{
+		Header: event.Header{Mode: review.Mode},
+		Id:     review.Id,
+		Uuid:   review.Uuid,
+	}, review.Uuid)
+}
+
+func (r *CommentUseCase) publishComment(ctx context.Context, comment *Comment, mode string) error {
+	return r.pub.Publish(ctx, &event.Comment{
+		Header:       event.Header{Mode: mode},
+		Id:           comment.CommentId,
+		CreationId:   comment.CreationId,
+		CreationType: comment.CreationType,
+		Uuid:         comment.Uuid,
+	}, comment.Uuid)
+}
+
+func (r *CommentUseCase) publishCommentContentIrregular(ctx context.Context, review *TextReview) error {
+	return r.pub.Publish(ctx, &event.TextReview{
+		Header:    event.Header{Mode: review.Mode},
+		CommentId: review.CommentId,
+		Comment:   review.Comment,
+		Kind:      review.Kind,
+		JobId:     review.JobId,
+		Label:     review.Label,
+		Result:    review.Result,
+		Uuid:      review.Uuid,
+		Section:   review.Section,
+	}, review.Uuid)
+}
+
+func (r *CommentUseCase) publishSubComment(ctx context.Context, comment *SubComment, mode string) error {
+	return r.pub.Publish(ctx, &event.Comment{
+		Header:   event.Header{Mode: mode},
+		Id:       comment.CommentId,
+		RootId:   comment.RootId,
+		ParentId: comment.ParentId,
+		Uuid:     comment.Uuid,
+	}, comment.Uuid)
+}
+
+func (r *CommentUseCase) publishCommentAgree(ctx context.Context, id, creationId, creationType int32, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Comment{
+		Header:       event.Header{Mode: mode},
+		Id:           id,
+		CreationId:   creationId,
+		CreationType: creationType,
+		Uuid:         uuid,
+		UserUuid:     userUuid,
+	}, userUuid)
+}
+
+func (r *CommentUseCase) publishSubCommentAgree(ctx context.Context, id int32, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Comment{
+		Header:   event.Header{Mode: mode},
+		Id:       id,
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	}, userUuid)
+}
+
+func (r *CommentUseCase) publishCommentStatistic(ctx context.Context, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Achievement{
+		Header:   event.Header{Mode: mode},
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	}, uuid)
+}
+
+func (r *CommentUseCase) publishScore(ctx context.Context, score int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Score{
+		Header: event.Header{Mode: mode},
+		Uuid:   uuid,
+		Score:  score,
+	}, uuid)
+}
+
+func (r *CommentUseCase) GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error) {
+	files, err := r.repo.GetUserData(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetUserDataFailed("get user data failed: %s", err.Error())
+	}
+	return files, nil
+}
+
+// PurgeUserData removes the comments of a deleted user through the same events
+// as a removal by the user, then drops the drafts, agrees, reviews and records
+// left behind.
+func (r *CommentUseCase) PurgeUserData(ctx context.Context, uuid string) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		ids, err := r.repo.GetUserCommentIds(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("get user comment ids failed: %s", err.Error())
+		}
+		for _, id := range ids {
+			err = r.publishPurge(ctx, id, uuid, event.ModeRemoveComment)
+			if err != nil {
+				return v1.ErrorPurgeUserDataFailed("remove comment to mq failed: %s", err.Error())
+			}
+		}
+
+		ids, err = r.repo.GetUserSubCommentIds(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("get user sub comment ids failed: %s", err.Error())
+		}
+		for _, id := range ids {
+			err = r.publishPurge(ctx, id, uuid, event.ModeRemoveSubComment)
+			if err != nil {
+				return v1.ErrorPurgeUserDataFailed("remove sub comment to mq failed: %s", err.Error())
+			}
+		}
+
+		err = r.repo.PurgeUserData(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data failed: %s", err.Error())
+		}
+
+		err = r.repo.PurgeUserDataFromCache(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data from cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+func (r *CommentUseCase) publishPurge(ctx context.Context, id int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Comment{
+		Header: event.Header{Mode: mode, Key: event.PurgeKey(mode, id)},
+		Id:     id,
+		Uuid:   uuid,
+	}, uuid)
+}
@@ -75,52 +75,8 @@ type TextReview struct {
 	Section   string
 }
 
-//easyjson:json
-type SendCommentMap struct {
-	Uuid         string
-	Id           int32
-	CreationId   int32
-	CreationType int32
-	Mode         string
-}
-
-//easyjson:json
-type SendSubCommentMap struct {
-	Uuid     string
-	Id       int32
-	RootId   int32
-	ParentId int32
-	Mode     string
-}
-
-//easyjson:json
-type SendCommentAgreeMap struct {
-	Uuid         string
-	Id           int32
-	CreationId   int32
-	CreationType int32
-	UserUuid     string
-	Mode         string
-}
-
-//easyjson:json
-type SendSubCommentAgreeMap struct {
-	Uuid     string
-	Id       int32
-	UserUuid string
-	Mode     string
-}
-
-//easyjson:json
-type SendCommentStatisticMap struct {
-	Uuid     string
-	UserUuid string
-	Mode     string
-}
-
-//easyjson:json
-type SendScoreMap struct {
-	Uuid  string
-	Score int32
-	Mode  string
+// UserDataFile is one file of the archive a user exports their data to.
+type UserDataFile struct {
+	Name    string
+	Content []byte
 }
@@ -2,13 +2,14 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/pkg/errors"
 	creationV1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	userV1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/comment/service/internal/biz"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
@@ -1830,6 +1831,17 @@ func (r *commentRepo) GetTalkAuthor(ctx context.Context, id int32) (string, erro
 	return result.Uuid, nil
 }
 
+func (r *commentRepo) GetUserBlock(ctx context.Context, uuid, userUuid string) (bool, error) {
+	result, err := r.data.uc.GetUserBlock(ctx, &userV1.GetUserBlockReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.Blocked, nil
+}
+
 func (r *commentRepo) commentStatisticExist(ctx context.Context, ids []int32) ([]int32, []int32, error) {
 	cmd, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 		for _, item := range ids {
@@ -2218,180 +2230,6 @@ func (r *commentRepo) CancelSubCommentAgreeFromCache(ctx context.Context, id int
 	return nil
 }
 
-func (r *commentRepo) SendReviewToMq(ctx context.Context, review *biz.CommentReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendCommentToMq(ctx context.Context, comment *biz.Comment, mode string) error {
-	commentMap := &biz.SendCommentMap{
-		Uuid:         comment.Uuid,
-		Id:           comment.CommentId,
-		CreationId:   comment.CreationId,
-		CreationType: comment.CreationType,
-		Mode:         mode,
-	}
-	data, err := commentMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{comment.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send comment to mq: %v", comment))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendCommentContentIrregularToMq(ctx context.Context, review *biz.TextReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send comment content review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendSubCommentToMq(ctx context.Context, comment *biz.SubComment, mode string) error {
-	commentMap := &biz.SendSubCommentMap{
-		Uuid:     comment.Uuid,
-		Id:       comment.CommentId,
-		RootId:   comment.RootId,
-		ParentId: comment.ParentId,
-		Mode:     mode,
-	}
-	data, err := commentMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{comment.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send comment to mq: %v", comment))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendCommentAgreeToMq(ctx context.Context, id, creationId, creationType int32, uuid, userUuid, mode string) error {
-	commentMap := &biz.SendCommentAgreeMap{
-		Uuid:         uuid,
-		Id:           id,
-		CreationId:   creationId,
-		CreationType: creationType,
-		UserUuid:     userUuid,
-		Mode:         mode,
-	}
-	data, err := commentMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{userUuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to set comment agree to mq: id(%v), uuid(%s), userUuid(%s), mode(%s)", id, uuid, userUuid, mode))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendSubCommentAgreeToMq(ctx context.Context, id int32, uuid, userUuid, mode string) error {
-	commentMap := &biz.SendSubCommentAgreeMap{
-		Uuid:     uuid,
-		Id:       id,
-		UserUuid: userUuid,
-		Mode:     mode,
-	}
-	data, err := commentMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{userUuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to set sub comment agree to mq: id(%v), uuid(%s), userUuid(%s), mode(%s)", id, uuid, userUuid, mode))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendCommentStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error {
-	achievement := &biz.SendCommentStatisticMap{
-		Uuid:     uuid,
-		UserUuid: userUuid,
-		Mode:     mode,
-	}
-	data, err := achievement.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send talk statistic to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *commentRepo) SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error {
-	scoreMap := &biz.SendScoreMap{
-		Uuid:  uuid,
-		Score: score,
-		Mode:  mode,
-	}
-	data, err := scoreMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send score to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
 func (r *commentRepo) CreateComment(ctx context.Context, id, creationId, creationType int32, creationAuthor, uuid string) error {
 	comment := &Comment{
 		CommentId:      id,
@@ -2971,3 +2809,88 @@ func (r *commentRepo) RemoveSubCommentCache(ctx context.Context, id, rootId, par
 	}
 	return nil
 }
+
+func (r *commentRepo) GetUserCommentIds(ctx context.Context, uuid string) ([]int32, error) {
+	var ids []int32
+	err := r.data.db.WithContext(ctx).Model(&Comment{}).Where("uuid = ?", uuid).Pluck("comment_id", &ids).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user comment ids from db: uuid(%s)", uuid))
+	}
+	return ids, nil
+}
+
+func (r *commentRepo) GetUserSubCommentIds(ctx context.Context, uuid string) ([]int32, error) {
+	var ids []int32
+	err := r.data.db.WithContext(ctx).Model(&SubComment{}).Where("uuid = ?", uuid).Pluck("comment_id", &ids).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user sub comment ids from db: uuid(%s)", uuid))
+	}
+	return ids, nil
+}
+
+func (r *commentRepo) GetUserData(ctx context.Context, uuid string) ([]*biz.UserDataFile, error) {
+	tables := []struct {
+		name string
+		rows interface{}
+	}{
+		{"comments.json", &[]*Comment{}},
+		{"sub_comments.json", &[]*SubComment{}},
+		{"comment_drafts.json", &[]*CommentDraft{}},
+		{"comment_agrees.json", &[]*CommentAgree{}},
+		{"comment_content_reviews.json", &[]*CommentContentReview{}},
+		{"comment_user.json", &[]*CommentUser{}},
+		{"records.json", &[]*Record{}},
+	}
+	files := make([]*biz.UserDataFile, 0, len(tables))
+	for _, item := range tables {
+		err := r.data.db.WithContext(ctx).Where("uuid = ?", uuid).Find(item.rows).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from db: file(%s), uuid(%s)", item.name, uuid))
+		}
+		content, err := json.MarshalIndent(item.rows, "", "  ")
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to marshal user data: file(%s), uuid(%s)", item.name, uuid))
+		}
+		files = append(files, &biz.UserDataFile{
+			Name:    item.name,
+			Content: content,
+		})
+	}
+	return files, nil
+}
+
+// PurgeUserData drops the rows of uuid nobody else sees. Comments are left to
+// the removal events, and agrees stay counted on the comments they were given
+// to.
+func (r *commentRepo) PurgeUserData(ctx context.Context, uuid string) error {
+	for _, model := range []interface{}{&CommentDraft{}, &CommentAgree{}, &CommentContentReview{}, &CommentUser{}, &Record{}} {
+		err := r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(model).Error
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to purge user data: model(%T), uuid(%s)", model, uuid))
+		}
+	}
+	return nil
+}
+
+func (r *commentRepo) PurgeUserDataFromCache(ctx context.Context, uuid string) error {
+	keys := []string{
+		"comment_user_" + uuid,
+		"comment_content_irregular_" + uuid,
+		"user_comment_agree_" + uuid,
+		"user_comment_article_reply_list_" + uuid,
+		"user_comment_talk_reply_list_" + uuid,
+		"user_sub_comment_article_reply_list_" + uuid,
+		"user_sub_comment_talk_reply_list_" + uuid,
+		"user_comment_replied_list_" + uuid,
+		"user_comment_article_replied_list_" + uuid,
+		"user_comment_talk_replied_list_" + uuid,
+		"user_sub_comment_replied_list_" + uuid,
+		"user_sub_comment_article_replied_list_" + uuid,
+		"user_sub_comment_talk_replied_list_" + uuid,
+	}
+	_, err := r.data.redisCli.Del(ctx, keys...).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from cache: uuid(%s)", uuid))
+	}
+	return nil
+}
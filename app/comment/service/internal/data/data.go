@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"fmt"
 	"github.com/apache/rocketmq-client-go/v2"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/apache/rocketmq-client-go/v2/producer"
@@ -10,16 +11,18 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/selector"
-	"github.com/go-kratos/kratos/v2/selector/p2c"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	"github.com/pkg/errors"
 	"github.com/tencentyun/cos-go-sdk-v5"
 	_ "github.com/tencentyun/cos-go-sdk-v5"
 	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	userv1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/comment/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/comment/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	"go.opentelemetry.io/otel/propagation"
 	"gorm.io/driver/mysql"
@@ -30,10 +33,15 @@ import (
 	"time"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewCommentRepo, NewRocketMqProducer, NewCosServiceClient, NewCreationServiceClient, NewRecovery)
+var ProviderSet = wire.NewSet(ClientSet, RepoSet)
+
+// ClientSet connects to the infrastructure and services named in the config.
+var ClientSet = wire.NewSet(NewDB, NewRedis, NewTransport, NewCosServiceClient, NewCreationServiceClient, NewUserServiceClient)
+
+var RepoSet = wire.NewSet(NewData, NewTransaction, NewPublisher, NewCommentRepo, NewRecovery)
 
 type MqPro struct {
-	producer rocketmq.Producer
+	producer *event.Producer
 }
 
 type Data struct {
@@ -42,13 +50,26 @@ type Data struct {
 	cosCli   *cos.Client
 	redisCli redis.Cmdable
 	cc       creationv1.CreationClient
+	uc       userv1.UserClient
 	mqPro    *MqPro
+	relay    *event.Relay
+	sweeper  *idempotent.Sweeper
 }
 
 type contextTxKey struct{}
 
+// ExecTx runs fn in a transaction, unless fn has been committed before on
+// behalf of the idempotency key in ctx.
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx, first, err := idempotent.Claim(ctx, tx, "tx")
+		if err != nil {
+			return err
+		}
+		if !first {
+			d.log.Infof("skip committed transaction")
+			return nil
+		}
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -66,6 +87,19 @@ func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
 
+func NewPublisher(d *Data) biz.Publisher {
+	return d
+}
+
+func (d *Data) Publish(ctx context.Context, e event.Event, key string) error {
+	err := event.Store(ctx, d.DB(ctx), e, key)
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to store event to outbox: mode(%s), key(%s)", event.Mode(e), key))
+	}
+	d.relay.Notify()
+	return nil
+}
+
 func (d *Data) GroupRecover(ctx context.Context, fn func(ctx context.Context) error) func() error {
 	return func() error {
 		defer func() {
@@ -130,7 +164,18 @@ func NewRedis(conf *conf.Data) redis.Cmdable {
 	return client
 }
 
-func NewRocketMqProducer(conf *conf.Data) *MqPro {
+func NewTransport(conf *conf.Data) event.Transport {
+	switch conf.Transport {
+	case "", event.TransportRocketMq:
+		return newRocketmqTransport(conf)
+	case event.TransportRedis:
+		return newRedisStreamTransport(conf)
+	}
+	log.NewHelper(log.With(log.GetLogger(), "module", "comment/data/transport")).Fatalf("unknown transport: %s", conf.Transport)
+	return nil
+}
+
+func newRocketmqTransport(conf *conf.Data) event.Transport {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/rocketmq-producer"))
 	p, err := rocketmq.NewProducer(
 		producer.WithNsResolver(primitive.NewPassthroughResolver([]string{conf.Rocketmq.ServerAddress})),
@@ -140,6 +185,7 @@ func NewRocketMqProducer(conf *conf.Data) *MqPro {
 		}),
 		producer.WithGroupName(conf.Rocketmq.GroupName),
 		producer.WithNamespace(conf.Rocketmq.NameSpace),
+		producer.WithQueueSelector(producer.NewHashQueueSelector()),
 	)
 
 	if err != nil {
@@ -150,9 +196,25 @@ func NewRocketMqProducer(conf *conf.Data) *MqPro {
 	if err != nil {
 		l.Fatalf("start producer error: %v", err)
 	}
-	return &MqPro{
-		producer: p,
+	return event.NewRocketMqTransport(p)
+}
+
+func newRedisStreamTransport(conf *conf.Data) event.Transport {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "comment/data/redis-stream"))
+	client := redis.NewClient(&redis.Options{
+		Addr:        conf.RedisStream.Addr,
+		DB:          int(conf.RedisStream.Db),
+		DialTimeout: time.Second * 2,
+		PoolSize:    10,
+		Password:    conf.RedisStream.Password,
+	})
+	timeout, cancelFunc := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancelFunc()
+	err := client.Ping(timeout).Err()
+	if err != nil {
+		l.Fatalf("redis stream connect error: %v", err)
 	}
+	return event.NewRedisStreamTransport(client, conf.RedisStream.MaxLen)
 }
 
 func NewCosServiceClient(conf *conf.Data) *cos.Client {
@@ -189,20 +251,47 @@ func NewCreationServiceClient(r *nacos.Registry) creationv1.CreationClient {
 	return c
 }
 
-func NewData(db *gorm.DB, cos *cos.Client, redisCmd redis.Cmdable, mq *MqPro, cc creationv1.CreationClient, logger log.Logger) (*Data, func(), error) {
+func NewUserServiceClient(r *nacos.Registry) userv1.UserClient {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "comment/data/new-user-client"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///matrix.user.service.grpc"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			circuitbreaker.Client(),
+			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+		),
+	)
+	if err != nil {
+		l.Fatalf(err.Error())
+	}
+	c := userv1.NewUserClient(conn)
+	return c
+}
+
+func NewData(db *gorm.DB, cos *cos.Client, redisCmd redis.Cmdable, mq event.Transport, cc creationv1.CreationClient, uc userv1.UserClient, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "comment/data/new-data"))
-	selector.SetGlobalSelector(p2c.NewBuilder())
 	d := &Data{
 		log:      log.NewHelper(log.With(logger, "module", "creation/data")),
 		db:       db,
 		redisCli: redisCmd,
 		cosCli:   cos,
 		cc:       cc,
-		mqPro:    mq,
+		uc:       uc,
+		mqPro:    &MqPro{producer: event.NewProducer(mq)},
 	}
+	d.relay = event.NewRelay(db, d.mqPro.producer, logger)
+	d.relay.Start()
+	d.sweeper = idempotent.NewSweeper(db, logger, idempotent.Processed{}.TableName())
+	d.sweeper.Start()
 	return d, func() {
 		l.Info("closing the data resources")
 
+		// stop the relay first, it flushes the outbox through db and the producer
+		d.relay.Stop()
+		d.sweeper.Stop()
+
 		sqlDB, err := db.DB()
 		if err != nil {
 			l.Errorf("close db err: %v", err.Error())
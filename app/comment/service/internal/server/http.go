@@ -3,12 +3,12 @@ package server
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/the-zion/matrix-core/api/comment/service/v1"
 	"github.com/the-zion/matrix-core/app/comment/service/internal/conf"
 	"github.com/the-zion/matrix-core/app/comment/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/redact"
 )
 
 // NewHTTPServer new a HTTP user.
@@ -20,7 +20,7 @@ func NewHTTPServer(c *conf.Server, commentService *service.CommentService, logge
 				l.Error(err)
 				return nil
 			})),
-			logging.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
+			redact.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
 		),
 	}
 	if c.Http.Network != "" {
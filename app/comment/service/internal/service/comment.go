@@ -406,7 +406,7 @@ func (s *CommentService) CreateSubCommentDbAndCache(ctx context.Context, req *v1
 }
 
 func (s *CommentService) SendComment(ctx context.Context, req *v1.SendCommentReq) (*emptypb.Empty, error) {
-	err := s.cc.SendComment(ctx, req.Id, req.Uuid, req.Ip)
+	err := s.cc.SendComment(ctx, req.Id, req.CreationId, req.CreationType, req.Uuid, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +414,7 @@ func (s *CommentService) SendComment(ctx context.Context, req *v1.SendCommentReq
 }
 
 func (s *CommentService) SendSubComment(ctx context.Context, req *v1.SendSubCommentReq) (*emptypb.Empty, error) {
-	err := s.cc.SendSubComment(ctx, req.Id, req.Uuid, req.Ip)
+	err := s.cc.SendSubComment(ctx, req.Id, req.RootId, req.ParentId, req.Uuid, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -533,3 +533,26 @@ func (s *CommentService) AddCommentContentReviewDbAndCache(ctx context.Context,
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *CommentService) GetUserData(ctx context.Context, req *v1.GetUserDataReq) (*v1.GetUserDataReply, error) {
+	files, err := s.cc.GetUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetUserDataReply{Files: make([]*v1.GetUserDataReply_File, 0, len(files))}
+	for _, item := range files {
+		reply.Files = append(reply.Files, &v1.GetUserDataReply_File{
+			Name:    item.Name,
+			Content: item.Content,
+		})
+	}
+	return reply, nil
+}
+
+func (s *CommentService) PurgeUserData(ctx context.Context, req *v1.PurgeUserDataReq) (*emptypb.Empty, error) {
+	err := s.cc.PurgeUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"flag"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/the-zion/matrix-core/app/comment/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"os"
@@ -22,6 +24,9 @@ func NewDB(logger log.Logger) *gorm.DB {
 	if err != nil {
 		l.Fatalf("failed opening connection to db: %v", err)
 	}
+	if err := event.DropSentOutbox(db); err != nil {
+		l.Fatalf("failed drop sent outbox: %v", err)
+	}
 	if err := db.AutoMigrate(
 		&data.Comment{},
 		&data.SubComment{},
@@ -30,6 +35,8 @@ func NewDB(logger log.Logger) *gorm.DB {
 		&data.CommentAgree{},
 		&data.CommentUser{},
 		&data.CommentContentReview{},
+		&event.Outbox{},
+		&idempotent.Processed{},
 	); err != nil {
 		l.Fatalf("failed creat or update table resources: %v", err)
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/nacos-group/nacos-sdk-go/vo"
 	"github.com/the-zion/matrix-core/app/creation/service/internal/conf"
 	"github.com/the-zion/matrix-core/pkg/kube"
+	"github.com/the-zion/matrix-core/pkg/redact"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"gopkg.in/yaml.v3"
@@ -199,9 +200,9 @@ func loggerInit() {
 		}
 
 		tencentLogger.GetProducer().Start()
-		logger = log.With(tencentLogger, logKv...)
+		logger = log.With(redact.Logger(tencentLogger), logKv...)
 	default:
-		logger = log.With(log.NewStdLogger(os.Stdout), logKv...)
+		logger = log.With(redact.Logger(log.NewStdLogger(os.Stdout)), logKv...)
 	}
 }
 
@@ -24,10 +24,10 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logLogger log.Logger,
 	db := data.NewDB(confData)
 	cmdable := data.NewRedis(confData)
 	client := data.NewCosServiceClient(confData)
-	elasticSearch := data.NewElasticsearch(confData)
-	mqPro := data.NewRocketmqProducer(confData)
+	elasticsearchClient := data.NewElasticsearch(confData)
+	transport := data.NewTransport(confData)
 	newsClient := data.NewNewsClient(confData)
-	dataData, cleanup2, err := data.NewData(db, cmdable, client, elasticSearch, mqPro, newsClient, logLogger)
+	dataData, cleanup2, err := data.NewData(confData, db, cmdable, client, elasticsearchClient, transport, newsClient, logLogger)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -35,12 +35,13 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logLogger log.Logger,
 	recovery := data.NewRecovery(dataData)
 	creationRepo := data.NewCreationRepo(dataData, logLogger)
 	transaction := data.NewTransaction(dataData)
-	articleUseCase := biz.NewArticleUseCase(articleRepo, recovery, creationRepo, transaction, logLogger)
+	publisher := data.NewPublisher(dataData)
+	articleUseCase := biz.NewArticleUseCase(articleRepo, recovery, creationRepo, transaction, publisher, logLogger)
 	talkRepo := data.NewTalkRepo(dataData, logLogger)
-	talkUseCase := biz.NewTalkUseCase(talkRepo, recovery, creationRepo, transaction, logLogger)
+	talkUseCase := biz.NewTalkUseCase(talkRepo, recovery, creationRepo, transaction, publisher, logLogger)
 	columnRepo := data.NewColumnRepo(dataData, logLogger)
-	creationUseCase := biz.NewCreationUseCase(creationRepo, articleRepo, talkRepo, columnRepo, transaction, recovery, logLogger)
-	columnUseCase := biz.NewColumnUseCase(columnRepo, recovery, creationRepo, transaction, logLogger)
+	creationUseCase := biz.NewCreationUseCase(creationRepo, articleRepo, talkRepo, columnRepo, transaction, recovery, publisher, logLogger)
+	columnUseCase := biz.NewColumnUseCase(columnRepo, recovery, creationRepo, transaction, publisher, logLogger)
 	newsRepo := data.NewNewsRepo(dataData, logLogger)
 	newsUseCase := biz.NewNewsUseCase(newsRepo, transaction, logLogger)
 	creationService := service.NewCreationService(articleUseCase, talkUseCase, creationUseCase, columnUseCase, newsUseCase, logLogger)
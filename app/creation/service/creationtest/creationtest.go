@@ -0,0 +1,118 @@
+// Package creationtest builds the creation service on testkit, for tests that
+// run a flow across services within one process.
+package creationtest
+
+import (
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
+	"github.com/tencentyun/cos-go-sdk-v5"
+	v1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/app/creation/service/internal/conf"
+	"github.com/the-zion/matrix-core/app/creation/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+	ggrpc "google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+// Creation is a running creation service.
+type Creation struct {
+	Client v1.CreationClient
+	Conn   *ggrpc.ClientConn
+	DB     *gorm.DB
+	Redis  redis.Cmdable
+}
+
+func newCreation(db *gorm.DB, redisCmd redis.Cmdable, srv *grpc.Server) (*Creation, func(), error) {
+	conn, cleanup, err := testkit.Serve(srv)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Creation{
+		Client: v1.NewCreationClient(conn),
+		Conn:   conn,
+		DB:     db,
+		Redis:  redisCmd,
+	}, cleanup, nil
+}
+
+// newDB opens a database with the tables of tool/update.
+func newDB() (*gorm.DB, func(), error) {
+	db, cleanup, err := testkit.NewDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = testkit.Migrate(db,
+		&data.Article{},
+		&data.ArticleReview{},
+		&data.ArticleContentReview{},
+		&data.ArticleStatistic{},
+		&data.ArticleDraft{},
+		&data.ArticleAgree{},
+		&data.ArticleCollect{},
+		&data.Collections{},
+		&data.Collect{},
+		&data.CollectionsDraft{},
+		&data.CollectionsContentReview{},
+		&data.Talk{},
+		&data.TalkReview{},
+		&data.TalkContentReview{},
+		&data.TalkDraft{},
+		&data.TalkStatistic{},
+		&data.TalkAgree{},
+		&data.TalkCollect{},
+		&data.Column{},
+		&data.ColumnReview{},
+		&data.ColumnContentReview{},
+		&data.ColumnDraft{},
+		&data.ColumnStatistic{},
+		&data.ColumnInclusion{},
+		&data.ColumnAgree{},
+		&data.ColumnCollect{},
+		&data.Subscribe{},
+		&data.Record{},
+		&data.CreationUser{},
+		&data.CreationUserVisitor{},
+		&data.TimeLine{},
+		&data.News{},
+		&event.Outbox{},
+		&idempotent.Processed{},
+	)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return db, cleanup, nil
+}
+
+func newConfServer() *conf.Server {
+	return &conf.Server{Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"}}
+}
+
+func newConfData(news *testkit.NewsServer) *conf.Data {
+	return &conf.Data{News: &conf.Data_News{Url: news.URL()}}
+}
+
+func newCos(s *testkit.CosServer) *cos.Client {
+	return s.Client()
+}
+
+func newElasticsearch(s *testkit.SearchServer) (*elasticsearch.Client, error) {
+	return s.Client()
+}
+
+// newRedis starts a redis with the scripts of tool/lua loaded.
+func newRedis() (redis.Cmdable, func(), error) {
+	redisCmd, cleanup, err := testkit.NewRedis()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = testkit.LoadScripts(redisCmd, data.Scripts)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return redisCmd, cleanup, nil
+}
@@ -0,0 +1,22 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package creationtest
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"github.com/the-zion/matrix-core/app/creation/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/creation/service/internal/data"
+	"github.com/the-zion/matrix-core/app/creation/service/internal/server"
+	"github.com/the-zion/matrix-core/app/creation/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+)
+
+// New starts a creation service publishing to bus and keeping its objects in
+// bucket and its documents in search, with news as its news feed.
+func New(*testkit.Bus, *testkit.CosServer, *testkit.SearchServer, *testkit.NewsServer, log.Logger) (*Creation, func(), error) {
+	panic(wire.Build(newDB, newRedis, testkit.NewTransport, newCos, newElasticsearch, newConfServer, newConfData, data.NewNewsClient, data.RepoSet, biz.ProviderSet, service.ProviderSet, server.NewGRPCServer, newCreation))
+}
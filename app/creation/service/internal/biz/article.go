@@ -5,6 +5,7 @@ import (
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	v1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
@@ -61,13 +62,6 @@ type ArticleRepo interface {
 	ArticleDraftMark(ctx context.Context, id int32, uuid string) error
 
 	SendArticle(ctx context.Context, id int32, uuid string) (*ArticleDraft, error)
-	SendReviewToMq(ctx context.Context, review *ArticleReview) error
-	SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error
-	SendArticleToMq(ctx context.Context, article *Article, mode string) error
-	SendStatisticToMq(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error
-	SendArticleStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error
-	SendArticleImageIrregularToMq(ctx context.Context, review *ImageReview) error
-	SendArticleContentIrregularToMq(ctx context.Context, review *TextReview) error
 
 	SetArticleAgree(ctx context.Context, id int32, uuid string) error
 	SetUserArticleAgree(ctx context.Context, id int32, userUuid string) error
@@ -79,6 +73,9 @@ type ArticleRepo interface {
 	SetArticleCollect(ctx context.Context, id int32, uuid string) error
 	SetArticleAgreeToCache(ctx context.Context, id int32, uuid, userUuid string) error
 	SetArticleViewToCache(ctx context.Context, id int32, uuid string) error
+	AddArticleViewer(ctx context.Context, id int32, viewer string) (bool, error)
+	AddArticleView(ctx context.Context, views []*CreationView) error
+	AddArticleViewToCache(ctx context.Context, views []*CreationView) error
 	SetArticleCollectToCache(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
 	SetUserArticleAgreeToCache(ctx context.Context, id int32, userUuid string) error
 	SetUserArticleCollectToCache(ctx context.Context, id int32, userUuid string) error
@@ -103,15 +100,17 @@ type ArticleUseCase struct {
 	repo         ArticleRepo
 	creationRepo CreationRepo
 	tm           Transaction
+	pub          Publisher
 	re           Recovery
 	log          *log.Helper
 }
 
-func NewArticleUseCase(repo ArticleRepo, re Recovery, creationRepo CreationRepo, tm Transaction, logger log.Logger) *ArticleUseCase {
+func NewArticleUseCase(repo ArticleRepo, re Recovery, creationRepo CreationRepo, tm Transaction, pub Publisher, logger log.Logger) *ArticleUseCase {
 	return &ArticleUseCase{
 		repo:         repo,
 		creationRepo: creationRepo,
 		tm:           tm,
+		pub:          pub,
 		re:           re,
 		log:          log.NewHelper(log.With(logger, "module", "creation/biz/articleUseCase")),
 	}
@@ -153,7 +152,7 @@ func (r *ArticleUseCase) GetArticleContentReview(ctx context.Context, page int32
 }
 
 func (r *ArticleUseCase) ArticleImageIrregular(ctx context.Context, review *ImageReview) error {
-	err := r.repo.SendArticleImageIrregularToMq(ctx, review)
+	err := r.publishArticleImageIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetImageIrregularFailed("set article image irregular to mq failed: %s", err.Error())
 	}
@@ -161,7 +160,7 @@ func (r *ArticleUseCase) ArticleImageIrregular(ctx context.Context, review *Imag
 }
 
 func (r *ArticleUseCase) ArticleContentIrregular(ctx context.Context, review *TextReview) error {
-	err := r.repo.SendArticleContentIrregularToMq(ctx, review)
+	err := r.publishArticleContentIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetContentIrregularFailed("set article content irregular to mq failed: %s", err.Error())
 	}
@@ -208,7 +207,7 @@ func (r *ArticleUseCase) AddArticleContentReviewDbAndCache(ctx context.Context,
 }
 
 func (r *ArticleUseCase) CreateArticle(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendArticleToMq(ctx, &Article{
+	err := r.publishArticle(ctx, &Article{
 		ArticleId: id,
 		Uuid:      uuid,
 		Auth:      auth,
@@ -220,7 +219,7 @@ func (r *ArticleUseCase) CreateArticle(ctx context.Context, id, auth int32, uuid
 }
 
 func (r *ArticleUseCase) EditArticle(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendArticleToMq(ctx, &Article{
+	err := r.publishArticle(ctx, &Article{
 		ArticleId: id,
 		Auth:      auth,
 		Uuid:      uuid,
@@ -232,7 +231,7 @@ func (r *ArticleUseCase) EditArticle(ctx context.Context, id, auth int32, uuid s
 }
 
 func (r *ArticleUseCase) DeleteArticle(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendArticleToMq(ctx, &Article{
+	err := r.publishArticle(ctx, &Article{
 		ArticleId: id,
 		Uuid:      uuid,
 	}, "delete_article_cache_and_search")
@@ -297,7 +296,7 @@ func (r *ArticleUseCase) CreateArticleDbCacheAndSearch(ctx context.Context, id,
 			return v1.ErrorCreateArticleFailed("create article search failed: %s", err.Error())
 		}
 
-		err = r.repo.SendScoreToMq(ctx, 50, uuid, "add_score")
+		err = r.publishScore(ctx, 50, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorCreateArticleFailed("send 50 score to mq failed: %s", err.Error())
 		}
@@ -536,7 +535,7 @@ func (r *ArticleUseCase) SendArticle(ctx context.Context, id int32, uuid, ip str
 			return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 		}
 
-		err = r.repo.SendReviewToMq(ctx, &ArticleReview{
+		err = r.publishReview(ctx, &ArticleReview{
 			Uuid: draft.Uuid,
 			Id:   draft.Id,
 			Mode: "article_create_review",
@@ -563,7 +562,7 @@ func (r *ArticleUseCase) SendArticleEdit(ctx context.Context, id int32, uuid, ip
 		return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 	}
 
-	err = r.repo.SendReviewToMq(ctx, &ArticleReview{
+	err = r.publishReview(ctx, &ArticleReview{
 		Uuid: article.Uuid,
 		Id:   article.ArticleId,
 		Mode: "article_edit_review",
@@ -584,7 +583,7 @@ func (r *ArticleUseCase) SetArticleAgree(ctx context.Context, id int32, uuid, us
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "set_article_agree_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "set_article_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set article agree to mq failed: %s", err.Error())
 		}
@@ -607,11 +606,11 @@ func (r *ArticleUseCase) SetArticleAgreeDbAndCache(ctx context.Context, id int32
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set article agree to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendArticleStatisticToMq(ctx, uuid, userUuid, "agree")
+		err = r.publishArticleStatistic(ctx, uuid, userUuid, "agree")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set article agree to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("send 2 score to mq failed: %s", err.Error())
 		}
@@ -619,8 +618,28 @@ func (r *ArticleUseCase) SetArticleAgreeDbAndCache(ctx context.Context, id int32
 	})
 }
 
-func (r *ArticleUseCase) SetArticleView(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, "", "set_article_view_db_and_cache")
+// SetArticleView counts a view of the article id by userUuid, or the client
+// at ip when signed out. Authors viewing their own article are not counted,
+// and a viewer counts once per view window towards the unique views while
+// every view counts towards the raw ones.
+func (r *ArticleUseCase) SetArticleView(ctx context.Context, id int32, uuid, userUuid, ip string) error {
+	if userUuid == uuid {
+		return nil
+	}
+	first := false
+	if viewer := viewerOf(userUuid, ip); viewer != "" {
+		var err error
+		first, err = r.repo.AddArticleViewer(ctx, id, viewer)
+		if err != nil {
+			return v1.ErrorSetViewFailed("set article viewer failed: %s", err.Error())
+		}
+	}
+	err := r.pub.Publish(ctx, &event.Statistic{
+		Header: event.Header{Mode: "set_article_view_db_and_cache"},
+		Id:     id,
+		Uuid:   uuid,
+		Repeat: !first,
+	}, uuid)
 	if err != nil {
 		return v1.ErrorSetViewFailed("set article view failed: %s", err.Error())
 	}
@@ -637,11 +656,11 @@ func (r *ArticleUseCase) SetArticleViewDbAndCache(ctx context.Context, id int32,
 		if err != nil {
 			return v1.ErrorSetViewFailed("set article view to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendArticleStatisticToMq(ctx, uuid, "", "view")
+		err = r.publishArticleStatistic(ctx, uuid, "", "view")
 		if err != nil {
 			return v1.ErrorSetViewFailed("set article view to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 1, uuid, "add_score")
+		err = r.publishScore(ctx, 1, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetViewFailed("send 1 score to mq failed: %s", err.Error())
 		}
@@ -649,6 +668,22 @@ func (r *ArticleUseCase) SetArticleViewDbAndCache(ctx context.Context, id int32,
 	})
 }
 
+// AddArticleViewDbAndCache adds the views aggregated by the message service, the
+// achievements of the authors are credited there as well.
+func (r *ArticleUseCase) AddArticleViewDbAndCache(ctx context.Context, views []*CreationView) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.AddArticleView(ctx, views)
+		if err != nil {
+			return v1.ErrorSetViewFailed("add article view failed: %s", err.Error())
+		}
+		err = r.repo.AddArticleViewToCache(ctx, views)
+		if err != nil {
+			return v1.ErrorSetViewFailed("add article view to cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
 func (r *ArticleUseCase) SetArticleCollect(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error {
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
@@ -659,7 +694,7 @@ func (r *ArticleUseCase) SetArticleCollect(ctx context.Context, id, collectionsI
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, collectionsId, uuid, userUuid, "set_article_collect_db_and_cache")
+		err := r.publishStatistic(ctx, id, collectionsId, uuid, userUuid, "set_article_collect_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set article collect to mq failed: %s", err.Error())
 		}
@@ -694,11 +729,11 @@ func (r *ArticleUseCase) SetArticleCollectDbAndCache(ctx context.Context, id, co
 		if err != nil {
 			return v1.ErrorSetCollectFailed("set article collect to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendArticleStatisticToMq(ctx, uuid, "", "collect")
+		err = r.publishArticleStatistic(ctx, uuid, "", "collect")
 		if err != nil {
 			return v1.ErrorSetCollectFailed("set article collect to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetViewFailed("send 1 score to mq failed: %s", err.Error())
 		}
@@ -716,7 +751,7 @@ func (r *ArticleUseCase) CancelArticleAgree(ctx context.Context, id int32, uuid,
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "cancel_article_agree_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "cancel_article_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("cancel article agree to mq failed: %s", err.Error())
 		}
@@ -739,7 +774,7 @@ func (r *ArticleUseCase) CancelArticleAgreeDbAndCache(ctx context.Context, id in
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel article agree from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendArticleStatisticToMq(ctx, uuid, userUuid, "agree_cancel")
+		err = r.publishArticleStatistic(ctx, uuid, userUuid, "agree_cancel")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel article agree to mq failed: %s", err.Error())
 		}
@@ -757,7 +792,7 @@ func (r *ArticleUseCase) CancelArticleCollect(ctx context.Context, id int32, uui
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "cancel_article_collect_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "cancel_article_collect_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("cancel article collect to mq failed: %s", err.Error())
 		}
@@ -796,7 +831,7 @@ func (r *ArticleUseCase) CancelArticleCollectDbAndCache(ctx context.Context, id
 		if err != nil {
 			return v1.ErrorSetCollectFailed("cancel article collect from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendArticleStatisticToMq(ctx, uuid, "", "collect_cancel")
+		err = r.publishArticleStatistic(ctx, uuid, "", "collect_cancel")
 		if err != nil {
 			return v1.ErrorSetCollectFailed("cancel article collect to mq failed: %s", err.Error())
 		}
@@ -848,3 +883,77 @@ func (r *ArticleUseCase) ReduceArticleComment(ctx context.Context, id int32, uui
 		return nil
 	})
 }
+
+func (r *ArticleUseCase) publishReview(ctx context.Context, review *ArticleReview) error {
+	return r.pub.Publish(ctx, &event.Review{
+		Header: event.Header{Mode: review.Mode},
+		Id:     review.Id,
+		Uuid:   review.Uuid,
+	}, review.Uuid)
+}
+
+func (r *ArticleUseCase) publishScore(ctx context.Context, score int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Score{
+		Header: event.Header{Mode: mode},
+		Uuid:   uuid,
+		Score:  score,
+	}, uuid)
+}
+
+func (r *ArticleUseCase) publishArticle(ctx context.Context, article *Article, mode string) error {
+	return r.pub.Publish(ctx, &event.Creation{
+		Header: event.Header{Mode: mode},
+		Id:     article.ArticleId,
+		Auth:   article.Auth,
+		Uuid:   article.Uuid,
+	}, article.Uuid)
+}
+
+func (r *ArticleUseCase) publishStatistic(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Statistic{
+		Header:        event.Header{Mode: mode},
+		Id:            id,
+		CollectionsId: collectionsId,
+		Uuid:          uuid,
+		UserUuid:      userUuid,
+	}, uuid)
+}
+
+func (r *ArticleUseCase) publishArticleStatistic(ctx context.Context, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Achievement{
+		Header:   event.Header{Mode: mode},
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	}, uuid)
+}
+
+func (r *ArticleUseCase) publishArticleImageIrregular(ctx context.Context, review *ImageReview) error {
+	return r.pub.Publish(ctx, &event.ImageReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Kind:       review.Kind,
+		Uid:        review.Uid,
+		Uuid:       review.Uuid,
+		JobId:      review.JobId,
+		Url:        review.Url,
+		Label:      review.Label,
+		Result:     review.Result,
+		Category:   review.Category,
+		SubLabel:   review.SubLabel,
+		Score:      review.Score,
+	}, review.Uuid)
+}
+
+func (r *ArticleUseCase) publishArticleContentIrregular(ctx context.Context, review *TextReview) error {
+	return r.pub.Publish(ctx, &event.TextReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Title:      review.Title,
+		Kind:       review.Kind,
+		JobId:      review.JobId,
+		Label:      review.Label,
+		Result:     review.Result,
+		Uuid:       review.Uuid,
+		Section:    review.Section,
+	}, review.Uuid)
+}
@@ -6,6 +6,7 @@ import (
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	v1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -62,23 +63,17 @@ type ColumnRepo interface {
 	GetColumnContentReview(ctx context.Context, page int32, uuid string) ([]*TextReview, error)
 
 	SendColumn(ctx context.Context, id int32, uuid string) (*ColumnDraft, error)
-	SendColumnToMq(ctx context.Context, column *Column, mode string) error
-	SendReviewToMq(ctx context.Context, review *ColumnReview) error
-	SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error
-	SendStatisticToMq(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error
-	SendColumnIncludesToMq(ctx context.Context, id, articleId int32, uuid, mode string) error
-	SendColumnSubscribeToMq(ctx context.Context, id int32, uuid, mode string) error
-	SendColumnImageIrregularToMq(ctx context.Context, review *ImageReview) error
-	SendColumnContentIrregularToMq(ctx context.Context, review *TextReview) error
 
 	FreezeColumnCos(ctx context.Context, id int32, uuid string) error
 
 	SetColumnAgree(ctx context.Context, id int32, uuid string) error
 	SetUserColumnAgree(ctx context.Context, id int32, userUuid string) error
 	SetColumnAgreeToCache(ctx context.Context, id int32, uuid, userUuid string) error
-	SendColumnStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error
 	SetColumnView(ctx context.Context, id int32, uuid string) error
 	SetColumnViewToCache(ctx context.Context, id int32, uuid string) error
+	AddColumnViewer(ctx context.Context, id int32, viewer string) (bool, error)
+	AddColumnView(ctx context.Context, views []*CreationView) error
+	AddColumnViewToCache(ctx context.Context, views []*CreationView) error
 	SetColumnUserCollect(ctx context.Context, id, collectionsId int32, userUuid string) error
 	SetColumnCollect(ctx context.Context, id int32, uuid string) error
 	SetColumnCollectToCache(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
@@ -121,15 +116,17 @@ type ColumnUseCase struct {
 	repo         ColumnRepo
 	creationRepo CreationRepo
 	tm           Transaction
+	pub          Publisher
 	re           Recovery
 	log          *log.Helper
 }
 
-func NewColumnUseCase(repo ColumnRepo, re Recovery, creationRepo CreationRepo, tm Transaction, logger log.Logger) *ColumnUseCase {
+func NewColumnUseCase(repo ColumnRepo, re Recovery, creationRepo CreationRepo, tm Transaction, pub Publisher, logger log.Logger) *ColumnUseCase {
 	return &ColumnUseCase{
 		repo:         repo,
 		creationRepo: creationRepo,
 		tm:           tm,
+		pub:          pub,
 		re:           re,
 		log:          log.NewHelper(log.With(logger, "module", "creation/biz/columnUseCase")),
 	}
@@ -147,7 +144,7 @@ func (r *ColumnUseCase) GetLastColumnDraft(ctx context.Context, uuid string) (*C
 }
 
 func (r *ColumnUseCase) ColumnImageIrregular(ctx context.Context, review *ImageReview) error {
-	err := r.repo.SendColumnImageIrregularToMq(ctx, review)
+	err := r.publishColumnImageIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetImageIrregularFailed("set column image irregular to mq failed: %s", err.Error())
 	}
@@ -155,7 +152,7 @@ func (r *ColumnUseCase) ColumnImageIrregular(ctx context.Context, review *ImageR
 }
 
 func (r *ColumnUseCase) ColumnContentIrregular(ctx context.Context, review *TextReview) error {
-	err := r.repo.SendColumnContentIrregularToMq(ctx, review)
+	err := r.publishColumnContentIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetContentIrregularFailed("set column content irregular to mq failed: %s", err.Error())
 	}
@@ -273,7 +270,7 @@ func (r *ColumnUseCase) SubscribeColumn(ctx context.Context, id int32, uuid stri
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendColumnSubscribeToMq(ctx, id, uuid, "set_column_subscribe_db_and_cache")
+		err := r.publishColumnSubscribe(ctx, id, uuid, "set_column_subscribe_db_and_cache")
 		if err != nil {
 			return v1.ErrorSubscribeColumnFailed("set column subscribe to mq failed: %s", err.Error())
 		}
@@ -317,7 +314,7 @@ func (r *ColumnUseCase) CancelSubscribeColumn(ctx context.Context, id int32, uui
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendColumnSubscribeToMq(ctx, id, uuid, "cancel_column_subscribe_db_and_cache")
+		err := r.publishColumnSubscribe(ctx, id, uuid, "cancel_column_subscribe_db_and_cache")
 		if err != nil {
 			return v1.ErrorCancelSubscribeColumnFailed("cancel column subscribe to mq failed: %s", err.Error())
 		}
@@ -368,7 +365,7 @@ func (r *ColumnUseCase) SendColumn(ctx context.Context, id int32, uuid, ip strin
 			return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 		}
 
-		err = r.repo.SendReviewToMq(ctx, &ColumnReview{
+		err = r.publishReview(ctx, &ColumnReview{
 			Uuid: draft.Uuid,
 			Id:   draft.Id,
 			Mode: "column_create_review",
@@ -395,7 +392,7 @@ func (r *ColumnUseCase) SendColumnEdit(ctx context.Context, id int32, uuid, ip s
 		return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 	}
 
-	err = r.repo.SendReviewToMq(ctx, &ColumnReview{
+	err = r.publishReview(ctx, &ColumnReview{
 		Uuid: column.Uuid,
 		Id:   column.ColumnId,
 		Mode: "column_edit_review",
@@ -407,7 +404,7 @@ func (r *ColumnUseCase) SendColumnEdit(ctx context.Context, id int32, uuid, ip s
 }
 
 func (r *ColumnUseCase) CreateColumn(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendColumnToMq(ctx, &Column{
+	err := r.publishColumn(ctx, &Column{
 		ColumnId: id,
 		Uuid:     uuid,
 		Auth:     auth,
@@ -419,7 +416,7 @@ func (r *ColumnUseCase) CreateColumn(ctx context.Context, id, auth int32, uuid s
 }
 
 func (r *ColumnUseCase) EditColumn(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendColumnToMq(ctx, &Column{
+	err := r.publishColumn(ctx, &Column{
 		ColumnId: id,
 		Auth:     auth,
 		Uuid:     uuid,
@@ -431,7 +428,7 @@ func (r *ColumnUseCase) EditColumn(ctx context.Context, id, auth int32, uuid str
 }
 
 func (r *ColumnUseCase) DeleteColumn(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendColumnToMq(ctx, &Column{
+	err := r.publishColumn(ctx, &Column{
 		ColumnId: id,
 		Uuid:     uuid,
 	}, "delete_column_cache_and_search")
@@ -488,7 +485,7 @@ func (r *ColumnUseCase) CreateColumnDbCacheAndSearch(ctx context.Context, id, au
 			return v1.ErrorCreateColumnFailed("create column search failed: %s", err.Error())
 		}
 
-		err = r.repo.SendScoreToMq(ctx, 20, uuid, "add_score")
+		err = r.publishScore(ctx, 20, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorCreateColumnFailed("send 20 score to mq failed: %s", err.Error())
 		}
@@ -691,7 +688,7 @@ func (r *ColumnUseCase) SetColumnAgree(ctx context.Context, id int32, uuid, user
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "set_column_agree_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "set_column_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set column agree to mq failed: %s", err.Error())
 		}
@@ -714,11 +711,11 @@ func (r *ColumnUseCase) SetColumnAgreeDbAndCache(ctx context.Context, id int32,
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set column agree to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendColumnStatisticToMq(ctx, uuid, userUuid, "agree")
+		err = r.publishColumnStatistic(ctx, uuid, userUuid, "agree")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set column agree to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("send 2 score to mq failed: %s", err.Error())
 		}
@@ -726,8 +723,25 @@ func (r *ColumnUseCase) SetColumnAgreeDbAndCache(ctx context.Context, id int32,
 	})
 }
 
-func (r *ColumnUseCase) SetColumnView(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, "", "set_column_view_db_and_cache")
+// SetColumnView counts a view of the column id by userUuid, or the client at ip
+// when signed out. Authors viewing their own column are not counted, and a viewer
+// counts once per view window.
+func (r *ColumnUseCase) SetColumnView(ctx context.Context, id int32, uuid, userUuid, ip string) error {
+	if userUuid == uuid {
+		return nil
+	}
+	viewer := viewerOf(userUuid, ip)
+	if viewer == "" {
+		return nil
+	}
+	first, err := r.repo.AddColumnViewer(ctx, id, viewer)
+	if err != nil {
+		return v1.ErrorSetViewFailed("set column viewer failed: %s", err.Error())
+	}
+	if !first {
+		return nil
+	}
+	err = r.publishStatistic(ctx, id, 0, uuid, "", "set_column_view_db_and_cache")
 	if err != nil {
 		return v1.ErrorSetViewFailed("set column view failed: %s", err.Error())
 	}
@@ -744,11 +758,11 @@ func (r *ColumnUseCase) SetColumnViewDbAndCache(ctx context.Context, id int32, u
 		if err != nil {
 			return v1.ErrorSetViewFailed("set column view to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendColumnStatisticToMq(ctx, uuid, "", "view")
+		err = r.publishColumnStatistic(ctx, uuid, "", "view")
 		if err != nil {
 			return v1.ErrorSetViewFailed("set column view to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 1, uuid, "add_score")
+		err = r.publishScore(ctx, 1, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetViewFailed("send 1 score to mq failed: %s", err.Error())
 		}
@@ -756,6 +770,22 @@ func (r *ColumnUseCase) SetColumnViewDbAndCache(ctx context.Context, id int32, u
 	})
 }
 
+// AddColumnViewDbAndCache adds the views aggregated by the message service, the
+// achievements of the authors are credited there as well.
+func (r *ColumnUseCase) AddColumnViewDbAndCache(ctx context.Context, views []*CreationView) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.AddColumnView(ctx, views)
+		if err != nil {
+			return v1.ErrorSetViewFailed("add column view failed: %s", err.Error())
+		}
+		err = r.repo.AddColumnViewToCache(ctx, views)
+		if err != nil {
+			return v1.ErrorSetViewFailed("add column view to cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
 func (r *ColumnUseCase) CancelColumnAgree(ctx context.Context, id int32, uuid, userUuid string) error {
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
@@ -766,7 +796,7 @@ func (r *ColumnUseCase) CancelColumnAgree(ctx context.Context, id int32, uuid, u
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "cancel_column_agree_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "cancel_column_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("cancel column agree to mq failed: %s", err.Error())
 		}
@@ -789,7 +819,7 @@ func (r *ColumnUseCase) CancelColumnAgreeDbAndCache(ctx context.Context, id int3
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel column agree from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendColumnStatisticToMq(ctx, uuid, userUuid, "agree_cancel")
+		err = r.publishColumnStatistic(ctx, uuid, userUuid, "agree_cancel")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel column agree to mq failed: %s", err.Error())
 		}
@@ -807,7 +837,7 @@ func (r *ColumnUseCase) SetColumnCollect(ctx context.Context, id, collectionsId
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, collectionsId, uuid, userUuid, "set_column_collect_db_and_cache")
+		err := r.publishStatistic(ctx, id, collectionsId, uuid, userUuid, "set_column_collect_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set column collect to mq failed: %s", err.Error())
 		}
@@ -842,11 +872,11 @@ func (r *ColumnUseCase) SetColumnCollectDbAndCache(ctx context.Context, id, coll
 		if err != nil {
 			return v1.ErrorSetCollectFailed("set column collect to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendColumnStatisticToMq(ctx, uuid, "", "collect")
+		err = r.publishColumnStatistic(ctx, uuid, "", "collect")
 		if err != nil {
 			return v1.ErrorSetCollectFailed("set column collect to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetViewFailed("send 1 score to mq failed: %s", err.Error())
 		}
@@ -864,7 +894,7 @@ func (r *ColumnUseCase) CancelColumnCollect(ctx context.Context, id int32, uuid,
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "cancel_column_collect_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "cancel_column_collect_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("cancel column collect to mq failed: %s", err.Error())
 		}
@@ -903,7 +933,7 @@ func (r *ColumnUseCase) CancelColumnCollectDbAndCache(ctx context.Context, id in
 		if err != nil {
 			return v1.ErrorSetCollectFailed("cancel column collect from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendColumnStatisticToMq(ctx, uuid, "", "collect_cancel")
+		err = r.publishColumnStatistic(ctx, uuid, "", "collect_cancel")
 		if err != nil {
 			return v1.ErrorSetCollectFailed("cancel column collect to mq failed: %s", err.Error())
 		}
@@ -921,7 +951,7 @@ func (r *ColumnUseCase) AddColumnIncludes(ctx context.Context, id, articleId int
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendColumnIncludesToMq(ctx, id, articleId, uuid, "add_column_includes_db_and_cache")
+		err := r.publishColumnIncludes(ctx, id, articleId, uuid, "add_column_includes_db_and_cache")
 		if err != nil {
 			return v1.ErrorAddColumnIncludesFailed("add column includes to mq failed: %s", err.Error())
 		}
@@ -955,7 +985,7 @@ func (r *ColumnUseCase) DeleteColumnIncludes(ctx context.Context, id, articleId
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendColumnIncludesToMq(ctx, id, articleId, uuid, "delete_column_includes_db_and_cache")
+		err := r.publishColumnIncludes(ctx, id, articleId, uuid, "delete_column_includes_db_and_cache")
 		if err != nil {
 			return v1.ErrorDeleteColumnIncludesFailed("delete column includes to mq failed: %s", err.Error())
 		}
@@ -978,3 +1008,94 @@ func (r *ColumnUseCase) DeleteColumnIncludesDbAndCache(ctx context.Context, id,
 		return nil
 	})
 }
+
+func (r *ColumnUseCase) publishColumn(ctx context.Context, column *Column, mode string) error {
+	return r.pub.Publish(ctx, &event.Creation{
+		Header: event.Header{Mode: mode},
+		Id:     column.ColumnId,
+		Auth:   column.Auth,
+		Uuid:   column.Uuid,
+	}, column.Uuid)
+}
+
+func (r *ColumnUseCase) publishReview(ctx context.Context, review *ColumnReview) error {
+	return r.pub.Publish(ctx, &event.Review{
+		Header: event.Header{Mode: review.Mode},
+		Id:     review.Id,
+		Uuid:   review.Uuid,
+	}, review.Uuid)
+}
+
+func (r *ColumnUseCase) publishScore(ctx context.Context, score int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Score{
+		Header: event.Header{Mode: mode},
+		Uuid:   uuid,
+		Score:  score,
+	}, uuid)
+}
+
+func (r *ColumnUseCase) publishStatistic(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Statistic{
+		Header:        event.Header{Mode: mode},
+		Id:            id,
+		CollectionsId: collectionsId,
+		Uuid:          uuid,
+		UserUuid:      userUuid,
+	}, uuid)
+}
+
+func (r *ColumnUseCase) publishColumnIncludes(ctx context.Context, id, articleId int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.ColumnIncludes{
+		Header:    event.Header{Mode: mode},
+		Id:        id,
+		ArticleId: articleId,
+		Uuid:      uuid,
+	}, uuid)
+}
+
+func (r *ColumnUseCase) publishColumnSubscribe(ctx context.Context, id int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.ColumnSubscribe{
+		Header: event.Header{Mode: mode},
+		Id:     id,
+		Uuid:   uuid,
+	}, uuid)
+}
+
+func (r *ColumnUseCase) publishColumnImageIrregular(ctx context.Context, review *ImageReview) error {
+	return r.pub.Publish(ctx, &event.ImageReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Kind:       review.Kind,
+		Uid:        review.Uid,
+		Uuid:       review.Uuid,
+		JobId:      review.JobId,
+		Url:        review.Url,
+		Label:      review.Label,
+		Result:     review.Result,
+		Category:   review.Category,
+		SubLabel:   review.SubLabel,
+		Score:      review.Score,
+	}, review.Uuid)
+}
+
+func (r *ColumnUseCase) publishColumnContentIrregular(ctx context.Context, review *TextReview) error {
+	return r.pub.Publish(ctx, &event.TextReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Title:      review.Title,
+		Kind:       review.Kind,
+		JobId:      review.JobId,
+		Label:      review.Label,
+		Result:     review.Result,
+		Uuid:       review.Uuid,
+		Section:    review.Section,
+	}, review.Uuid)
+}
+
+func (r *ColumnUseCase) publishColumnStatistic(ctx context.Context, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Achievement{
+		Header:   event.Header{Mode: mode},
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	}, uuid)
+}
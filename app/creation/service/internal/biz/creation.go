@@ -5,8 +5,12 @@ import (
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	v1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
 	"sort"
+	"strings"
+	"time"
+	"unicode"
 )
 
 type CreationRepo interface {
@@ -58,12 +62,20 @@ type CreationRepo interface {
 	SetRecord(ctx context.Context, id, mode int32, uuid, operation, ip string) error
 	SetLeaderBoardToCache(ctx context.Context, boardList []*LeaderBoard)
 
-	SendReviewToMq(ctx context.Context, review *CollectionsReview) error
-	SendCollectionsToMq(ctx context.Context, collections *Collections, mode string) error
 	SendCollections(ctx context.Context, id int32, uuid string) (*CollectionsDraft, error)
-	SendCollectionsContentIrregularToMq(ctx context.Context, review *TextReview) error
 	SetCollectionsContentIrregular(ctx context.Context, review *TextReview) (*TextReview, error)
 	SetCollectionsContentIrregularToCache(ctx context.Context, review *TextReview) error
+	GetUserArticleIds(ctx context.Context, uuid string) ([]int32, error)
+	GetUserTalkIds(ctx context.Context, uuid string) ([]int32, error)
+	GetUserColumnIds(ctx context.Context, uuid string) ([]int32, error)
+	GetUserCollectionsIds(ctx context.Context, uuid string) ([]int32, error)
+	GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error)
+	PurgeUserData(ctx context.Context, uuid string) error
+	PurgeUserDataFromCache(ctx context.Context, uuid string) error
+	GetUserInterestArticleIds(ctx context.Context, uuid string, size int) ([]int32, error)
+	GetArticleTags(ctx context.Context, ids []int32) ([]string, error)
+	GetTagAuthors(ctx context.Context, tags []string, size int32) (map[string]int32, error)
+	GetAuthorActivity(ctx context.Context, uuids []string, since time.Time) (map[string]int32, error)
 }
 
 type CreationUseCase struct {
@@ -72,11 +84,12 @@ type CreationUseCase struct {
 	talkRepo    TalkRepo
 	columnRepo  ColumnRepo
 	tm          Transaction
+	pub         Publisher
 	re          Recovery
 	log         *log.Helper
 }
 
-func NewCreationUseCase(repo CreationRepo, articleRepo ArticleRepo, talkRepo TalkRepo, columnRepo ColumnRepo, tm Transaction, re Recovery, logger log.Logger) *CreationUseCase {
+func NewCreationUseCase(repo CreationRepo, articleRepo ArticleRepo, talkRepo TalkRepo, columnRepo ColumnRepo, tm Transaction, re Recovery, pub Publisher, logger log.Logger) *CreationUseCase {
 	return &CreationUseCase{
 		repo:        repo,
 		articleRepo: articleRepo,
@@ -84,6 +97,7 @@ func NewCreationUseCase(repo CreationRepo, articleRepo ArticleRepo, talkRepo Tal
 		columnRepo:  columnRepo,
 		re:          re,
 		tm:          tm,
+		pub:         pub,
 		log:         log.NewHelper(log.With(logger, "module", "creation/biz/creationUseCase")),
 	}
 }
@@ -333,7 +347,7 @@ func (r *CreationUseCase) SendCollections(ctx context.Context, id int32, uuid, i
 			return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 		}
 
-		err = r.repo.SendReviewToMq(ctx, &CollectionsReview{
+		err = r.publishReview(ctx, &CollectionsReview{
 			Uuid: draft.Uuid,
 			Id:   draft.Id,
 			Mode: "collections_create_review",
@@ -367,7 +381,7 @@ func (r *CreationUseCase) CreateCollectionsDraft(ctx context.Context, uuid strin
 }
 
 func (r *CreationUseCase) CreateCollections(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendCollectionsToMq(ctx, &Collections{
+	err := r.publishCollections(ctx, &Collections{
 		CollectionsId: id,
 		Uuid:          uuid,
 		Auth:          auth,
@@ -405,7 +419,7 @@ func (r *CreationUseCase) CreateCollectionsDbAndCache(ctx context.Context, id, a
 }
 
 func (r *CreationUseCase) CollectionsContentIrregular(ctx context.Context, review *TextReview) error {
-	err := r.repo.SendCollectionsContentIrregularToMq(ctx, review)
+	err := r.publishCollectionsContentIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetContentIrregularFailed("set collections content irregular to mq failed: %s", err.Error())
 	}
@@ -443,7 +457,7 @@ func (r *CreationUseCase) SendCollectionsEdit(ctx context.Context, id int32, uui
 		return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 	}
 
-	err = r.repo.SendReviewToMq(ctx, &CollectionsReview{
+	err = r.publishReview(ctx, &CollectionsReview{
 		Uuid: collections.Uuid,
 		Id:   id,
 		Mode: "collections_edit_review",
@@ -455,7 +469,7 @@ func (r *CreationUseCase) SendCollectionsEdit(ctx context.Context, id int32, uui
 }
 
 func (r *CreationUseCase) EditCollections(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendCollectionsToMq(ctx, &Collections{
+	err := r.publishCollections(ctx, &Collections{
 		CollectionsId: id,
 		Auth:          auth,
 		Uuid:          uuid,
@@ -480,7 +494,7 @@ func (r *CreationUseCase) EditCollectionsCos(ctx context.Context, id, auth int32
 }
 
 func (r *CreationUseCase) DeleteCollections(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendCollectionsToMq(ctx, &Collections{
+	err := r.publishCollections(ctx, &Collections{
 		CollectionsId: id,
 		Uuid:          uuid,
 	}, "delete_collections_cache")
@@ -520,3 +534,156 @@ func (r *CreationUseCase) DeleteCollectionsCache(ctx context.Context, id int32,
 		return nil
 	})
 }
+
+func (r *CreationUseCase) publishCollectionsContentIrregular(ctx context.Context, review *TextReview) error {
+	return r.pub.Publish(ctx, &event.TextReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Title:      review.Title,
+		Kind:       review.Kind,
+		JobId:      review.JobId,
+		Label:      review.Label,
+		Result:     review.Result,
+		Uuid:       review.Uuid,
+		Section:    review.Section,
+	}, review.Uuid)
+}
+
+func (r *CreationUseCase) publishReview(ctx context.Context, review *CollectionsReview) error {
+	return r.pub.Publish(ctx, &event.Review{
+		Header: event.Header{Mode: review.Mode},
+		Id:     review.Id,
+		Uuid:   review.Uuid,
+	}, review.Uuid)
+}
+
+func (r *CreationUseCase) publishCollections(ctx context.Context, collections *Collections, mode string) error {
+	return r.pub.Publish(ctx, &event.Creation{
+		Header: event.Header{Mode: mode},
+		Id:     collections.CollectionsId,
+		Auth:   collections.Auth,
+		Uuid:   collections.Uuid,
+	}, collections.Uuid)
+}
+
+func (r *CreationUseCase) GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error) {
+	files, err := r.repo.GetUserData(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetUserDataFailed("get user data failed: %s", err.Error())
+	}
+	return files, nil
+}
+
+// PurgeUserData deletes the articles, talks, columns and collections of a
+// deleted user through the same events as a deletion by the user, then drops
+// the drafts, reviews, agrees, collects, subscriptions and records left
+// behind. Agrees and collects stay counted on the creations they were given
+// to.
+func (r *CreationUseCase) PurgeUserData(ctx context.Context, uuid string) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		creations := []struct {
+			mode string
+			ids  func(ctx context.Context, uuid string) ([]int32, error)
+		}{
+			{event.ModeDeleteArticle, r.repo.GetUserArticleIds},
+			{event.ModeDeleteTalk, r.repo.GetUserTalkIds},
+			{event.ModeDeleteColumn, r.repo.GetUserColumnIds},
+			{event.ModeDeleteCollections, r.repo.GetUserCollectionsIds},
+		}
+		for _, item := range creations {
+			ids, err := item.ids(ctx, uuid)
+			if err != nil {
+				return v1.ErrorPurgeUserDataFailed("get user creation ids failed: mode(%s), %s", item.mode, err.Error())
+			}
+			for _, id := range ids {
+				err = r.pub.Publish(ctx, &event.Creation{
+					Header: event.Header{Mode: item.mode, Key: event.PurgeKey(item.mode, id)},
+					Id:     id,
+					Uuid:   uuid,
+				}, uuid)
+				if err != nil {
+					return v1.ErrorPurgeUserDataFailed("delete creation to mq failed: mode(%s), %s", item.mode, err.Error())
+				}
+			}
+		}
+
+		err := r.repo.PurgeUserData(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data failed: %s", err.Error())
+		}
+
+		err = r.repo.PurgeUserDataFromCache(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data from cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// GetUserTags counts the tags of the articles uuid recently agreed or
+// collected, as a measure of what the user is interested in.
+func (r *CreationUseCase) GetUserTags(ctx context.Context, uuid string) (map[string]int32, error) {
+	ids, err := r.repo.GetUserInterestArticleIds(ctx, uuid, 50)
+	if err != nil {
+		return nil, v1.ErrorGetRecommendDataFailed("get user interest article ids failed: %s", err.Error())
+	}
+
+	tags := make(map[string]int32)
+	if len(ids) == 0 {
+		return tags, nil
+	}
+
+	list, err := r.repo.GetArticleTags(ctx, ids)
+	if err != nil {
+		return nil, v1.ErrorGetRecommendDataFailed("get article tags failed: %s", err.Error())
+	}
+
+	for _, item := range list {
+		for _, tag := range splitTags(item) {
+			tags[tag]++
+		}
+	}
+	return tags, nil
+}
+
+// GetTagAuthors counts, per author, the articles matching any of tags among
+// the size best matches.
+func (r *CreationUseCase) GetTagAuthors(ctx context.Context, tags []string, size int32) (map[string]int32, error) {
+	if len(tags) == 0 {
+		return map[string]int32{}, nil
+	}
+	if size <= 0 || size > 200 {
+		size = 100
+	}
+	authors, err := r.repo.GetTagAuthors(ctx, tags, size)
+	if err != nil {
+		return nil, v1.ErrorGetRecommendDataFailed("get tag authors failed: %s", err.Error())
+	}
+	return authors, nil
+}
+
+// GetAuthorActivity counts the public articles and talks each of uuids
+// published in the last days.
+func (r *CreationUseCase) GetAuthorActivity(ctx context.Context, uuids []string, days int32) (map[string]int32, error) {
+	if len(uuids) == 0 {
+		return map[string]int32{}, nil
+	}
+	if days <= 0 || days > 365 {
+		days = 30
+	}
+	activity, err := r.repo.GetAuthorActivity(ctx, uuids, time.Now().AddDate(0, 0, -int(days)))
+	if err != nil {
+		return nil, v1.ErrorGetRecommendDataFailed("get author activity failed: %s", err.Error())
+	}
+	return activity, nil
+}
+
+func splitTags(tags string) []string {
+	fields := strings.FieldsFunc(tags, func(c rune) bool {
+		return unicode.IsSpace(c) || strings.ContainsRune(";,，；、", c)
+	})
+	for i, item := range fields {
+		fields[i] = strings.ToLower(item)
+	}
+	return fields
+}
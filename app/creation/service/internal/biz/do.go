@@ -26,6 +26,7 @@ type ArticleStatistic struct {
 	Uuid      string
 	Agree     int32
 	View      int32
+	RawView   int32
 	Collect   int32
 	Comment   int32
 	Auth      int32
@@ -201,6 +202,15 @@ type NewsSearch struct {
 	Cover   string
 }
 
+// CreationView is the number of unique and raw views a creation got over an
+// aggregation window.
+type CreationView struct {
+	Id    int32
+	Uuid  string
+	Count int32
+	Raw   int32
+}
+
 type CreationUser struct {
 	Article     int32
 	Column      int32
@@ -243,86 +253,8 @@ type TextReview struct {
 	Section    string
 }
 
-//easyjson:json
-type SendCollectionMap struct {
-	Uuid string
-	Id   int32
-	Auth int32
-	Mode string
-}
-
-//easyjson:json
-type SendScoreMap struct {
-	Uuid  string
-	Score int32
-	Mode  string
-}
-
-//easyjson:json
-type SendArticleMap struct {
-	Uuid string
-	Id   int32
-	Auth int32
-	Mode string
-}
-
-//easyjson:json
-type SendStatisticMap struct {
-	Id            int32
-	CollectionsId int32
-	Uuid          string
-	UserUuid      string
-	Mode          string
-}
-
-//easyjson:json
-type SendArticleStatisticMap struct {
-	Uuid     string
-	UserUuid string
-	Mode     string
-}
-
-//easyjson:json
-type SendColumnMap struct {
-	Uuid string
-	Id   int32
-	Auth int32
-	Mode string
-}
-
-//easyjson:json
-type SendColumnIncludesMap struct {
-	Id        int32
-	ArticleId int32
-	Uuid      string
-	Mode      string
-}
-
-//easyjson:json
-type SendColumnSubscribeMap struct {
-	Id   int32
-	Uuid string
-	Mode string
-}
-
-//easyjson:json
-type SendColumnStatisticMap struct {
-	Uuid     string
-	UserUuid string
-	Mode     string
-}
-
-//easyjson:json
-type SendTalkMap struct {
-	Uuid string
-	Id   int32
-	Auth int32
-	Mode string
-}
-
-//easyjson:json
-type SendTalkStatisticMap struct {
-	Uuid     string
-	UserUuid string
-	Mode     string
+// UserDataFile is one file of the archive a user exports their data to.
+type UserDataFile struct {
+	Name    string
+	Content []byte
 }
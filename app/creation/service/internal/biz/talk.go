@@ -5,6 +5,7 @@ import (
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	v1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -47,6 +48,9 @@ type TalkRepo interface {
 	SetUserTalkAgree(ctx context.Context, id int32, userUuid string) error
 	SetTalkView(ctx context.Context, id int32, uuid string) error
 	SetTalkViewToCache(ctx context.Context, id int32, uuid string) error
+	AddTalkViewer(ctx context.Context, id int32, viewer string) (bool, error)
+	AddTalkView(ctx context.Context, views []*CreationView) error
+	AddTalkViewToCache(ctx context.Context, views []*CreationView) error
 	SetTalkAgreeToCache(ctx context.Context, id int32, uuid, userUuid string) error
 	SetTalkCollect(ctx context.Context, id int32, uuid string) error
 	SetTalkUserCollect(ctx context.Context, id, collectionsId int32, userUuid string) error
@@ -76,13 +80,6 @@ type TalkRepo interface {
 	ReduceCreationUserCollect(ctx context.Context, userUuid string) error
 
 	SendTalk(ctx context.Context, id int32, uuid string) (*TalkDraft, error)
-	SendReviewToMq(ctx context.Context, review *TalkReview) error
-	SendTalkToMq(ctx context.Context, talk *Talk, mode string) error
-	SendTalkStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error
-	SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error
-	SendStatisticToMq(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error
-	SendTalkImageIrregularToMq(ctx context.Context, review *ImageReview) error
-	SendTalkContentIrregularToMq(ctx context.Context, review *TextReview) error
 
 	DeleteTalk(ctx context.Context, id int32, uuid string) error
 	DeleteTalkDraft(ctx context.Context, id int32, uuid string) error
@@ -101,15 +98,17 @@ type TalkUseCase struct {
 	repo         TalkRepo
 	creationRepo CreationRepo
 	tm           Transaction
+	pub          Publisher
 	re           Recovery
 	log          *log.Helper
 }
 
-func NewTalkUseCase(repo TalkRepo, re Recovery, creationRepo CreationRepo, tm Transaction, logger log.Logger) *TalkUseCase {
+func NewTalkUseCase(repo TalkRepo, re Recovery, creationRepo CreationRepo, tm Transaction, pub Publisher, logger log.Logger) *TalkUseCase {
 	return &TalkUseCase{
 		repo:         repo,
 		creationRepo: creationRepo,
 		tm:           tm,
+		pub:          pub,
 		re:           re,
 		log:          log.NewHelper(log.With(logger, "module", "creation/biz/talkUseCase")),
 	}
@@ -263,7 +262,7 @@ func (r *TalkUseCase) SendTalk(ctx context.Context, id int32, uuid, ip string) e
 			return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 		}
 
-		err = r.repo.SendReviewToMq(ctx, &TalkReview{
+		err = r.publishReview(ctx, &TalkReview{
 			Uuid: draft.Uuid,
 			Id:   draft.Id,
 			Mode: "talk_create_review",
@@ -290,7 +289,7 @@ func (r *TalkUseCase) SendTalkEdit(ctx context.Context, id int32, uuid, ip strin
 		return v1.ErrorSetRecordFailed("set record failed: %s", err.Error())
 	}
 
-	err = r.repo.SendReviewToMq(ctx, &TalkReview{
+	err = r.publishReview(ctx, &TalkReview{
 		Uuid: talk.Uuid,
 		Id:   talk.TalkId,
 		Mode: "talk_edit_review",
@@ -302,7 +301,7 @@ func (r *TalkUseCase) SendTalkEdit(ctx context.Context, id int32, uuid, ip strin
 }
 
 func (r *TalkUseCase) CreateTalk(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendTalkToMq(ctx, &Talk{
+	err := r.publishTalk(ctx, &Talk{
 		TalkId: id,
 		Uuid:   uuid,
 		Auth:   auth,
@@ -314,7 +313,7 @@ func (r *TalkUseCase) CreateTalk(ctx context.Context, id, auth int32, uuid strin
 }
 
 func (r *TalkUseCase) EditTalk(ctx context.Context, id, auth int32, uuid string) error {
-	err := r.repo.SendTalkToMq(ctx, &Talk{
+	err := r.publishTalk(ctx, &Talk{
 		TalkId: id,
 		Auth:   auth,
 		Uuid:   uuid,
@@ -326,7 +325,7 @@ func (r *TalkUseCase) EditTalk(ctx context.Context, id, auth int32, uuid string)
 }
 
 func (r *TalkUseCase) DeleteTalk(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendTalkToMq(ctx, &Talk{
+	err := r.publishTalk(ctx, &Talk{
 		TalkId: id,
 		Uuid:   uuid,
 	}, "delete_talk_cache_and_search")
@@ -383,7 +382,7 @@ func (r *TalkUseCase) CreateTalkDbCacheAndSearch(ctx context.Context, id, auth i
 			return v1.ErrorCreateTalkFailed("create talk search failed: %s", err.Error())
 		}
 
-		err = r.repo.SendScoreToMq(ctx, 50, uuid, "add_score")
+		err = r.publishScore(ctx, 50, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorCreateTalkFailed("send 50 score to mq failed: %s", err.Error())
 		}
@@ -483,7 +482,7 @@ func (r *TalkUseCase) SetTalkAgree(ctx context.Context, id int32, uuid, userUuid
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "set_talk_agree_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "set_talk_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set talk agree to mq failed: %s", err.Error())
 		}
@@ -506,11 +505,11 @@ func (r *TalkUseCase) SetTalkAgreeDbAndCache(ctx context.Context, id int32, uuid
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set talk agree to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendTalkStatisticToMq(ctx, uuid, userUuid, "agree")
+		err = r.publishTalkStatistic(ctx, uuid, userUuid, "agree")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set talk agree to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("send 2 score to mq failed: %s", err.Error())
 		}
@@ -528,7 +527,7 @@ func (r *TalkUseCase) CancelTalkAgree(ctx context.Context, id int32, uuid, userU
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "cancel_talk_agree_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "cancel_talk_agree_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("cancel talk agree to mq failed: %s", err.Error())
 		}
@@ -551,7 +550,7 @@ func (r *TalkUseCase) CancelTalkAgreeDbAndCache(ctx context.Context, id int32, u
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel talk agree from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendTalkStatisticToMq(ctx, uuid, userUuid, "agree_cancel")
+		err = r.publishTalkStatistic(ctx, uuid, userUuid, "agree_cancel")
 		if err != nil {
 			return v1.ErrorCancelAgreeFailed("cancel talk agree to mq failed: %s", err.Error())
 		}
@@ -559,8 +558,25 @@ func (r *TalkUseCase) CancelTalkAgreeDbAndCache(ctx context.Context, id int32, u
 	})
 }
 
-func (r *TalkUseCase) SetTalkView(ctx context.Context, id int32, uuid string) error {
-	err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, "", "set_talk_view_db_and_cache")
+// SetTalkView counts a view of the talk id by userUuid, or the client at ip
+// when signed out. Authors viewing their own talk are not counted, and a viewer
+// counts once per view window.
+func (r *TalkUseCase) SetTalkView(ctx context.Context, id int32, uuid, userUuid, ip string) error {
+	if userUuid == uuid {
+		return nil
+	}
+	viewer := viewerOf(userUuid, ip)
+	if viewer == "" {
+		return nil
+	}
+	first, err := r.repo.AddTalkViewer(ctx, id, viewer)
+	if err != nil {
+		return v1.ErrorSetViewFailed("set talk viewer failed: %s", err.Error())
+	}
+	if !first {
+		return nil
+	}
+	err = r.publishStatistic(ctx, id, 0, uuid, "", "set_talk_view_db_and_cache")
 	if err != nil {
 		return v1.ErrorSetViewFailed("set talk view failed: %s", err.Error())
 	}
@@ -577,11 +593,11 @@ func (r *TalkUseCase) SetTalkViewDbAndCache(ctx context.Context, id int32, uuid
 		if err != nil {
 			return v1.ErrorSetViewFailed("set talk view to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendTalkStatisticToMq(ctx, uuid, "", "view")
+		err = r.publishTalkStatistic(ctx, uuid, "", "view")
 		if err != nil {
 			return v1.ErrorSetViewFailed("set talk view to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 1, uuid, "add_score")
+		err = r.publishScore(ctx, 1, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetViewFailed("send 1 score to mq failed: %s", err.Error())
 		}
@@ -589,6 +605,22 @@ func (r *TalkUseCase) SetTalkViewDbAndCache(ctx context.Context, id int32, uuid
 	})
 }
 
+// AddTalkViewDbAndCache adds the views aggregated by the message service, the
+// achievements of the authors are credited there as well.
+func (r *TalkUseCase) AddTalkViewDbAndCache(ctx context.Context, views []*CreationView) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.AddTalkView(ctx, views)
+		if err != nil {
+			return v1.ErrorSetViewFailed("add talk view failed: %s", err.Error())
+		}
+		err = r.repo.AddTalkViewToCache(ctx, views)
+		if err != nil {
+			return v1.ErrorSetViewFailed("add talk view to cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
 func (r *TalkUseCase) SetTalkCollect(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error {
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
@@ -599,7 +631,7 @@ func (r *TalkUseCase) SetTalkCollect(ctx context.Context, id, collectionsId int3
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, collectionsId, uuid, userUuid, "set_talk_collect_db_and_cache")
+		err := r.publishStatistic(ctx, id, collectionsId, uuid, userUuid, "set_talk_collect_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("set talk collect to mq failed: %s", err.Error())
 		}
@@ -634,11 +666,11 @@ func (r *TalkUseCase) SetTalkCollectDbAndCache(ctx context.Context, id, collecti
 		if err != nil {
 			return v1.ErrorSetCollectFailed("set talk collect to cache failed: %s", err.Error())
 		}
-		err = r.repo.SendTalkStatisticToMq(ctx, uuid, "", "collect")
+		err = r.publishTalkStatistic(ctx, uuid, "", "collect")
 		if err != nil {
 			return v1.ErrorSetCollectFailed("set talk collect to mq failed: %s", err.Error())
 		}
-		err = r.repo.SendScoreToMq(ctx, 2, uuid, "add_score")
+		err = r.publishScore(ctx, 2, uuid, "add_score")
 		if err != nil {
 			return v1.ErrorSetViewFailed("send 1 score to mq failed: %s", err.Error())
 		}
@@ -656,7 +688,7 @@ func (r *TalkUseCase) CancelTalkCollect(ctx context.Context, id int32, uuid, use
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SendStatisticToMq(ctx, id, 0, uuid, userUuid, "cancel_talk_collect_db_and_cache")
+		err := r.publishStatistic(ctx, id, 0, uuid, userUuid, "cancel_talk_collect_db_and_cache")
 		if err != nil {
 			return v1.ErrorSetAgreeFailed("cancel talk collect to mq failed: %s", err.Error())
 		}
@@ -695,7 +727,7 @@ func (r *TalkUseCase) CancelTalkCollectDbAndCache(ctx context.Context, id int32,
 		if err != nil {
 			return v1.ErrorSetCollectFailed("cancel talk collect from cache failed: %s", err.Error())
 		}
-		err = r.repo.SendTalkStatisticToMq(ctx, uuid, "", "collect_cancel")
+		err = r.publishTalkStatistic(ctx, uuid, "", "collect_cancel")
 		if err != nil {
 			return v1.ErrorSetCollectFailed("cancel talk collect to mq failed: %s", err.Error())
 		}
@@ -749,7 +781,7 @@ func (r *TalkUseCase) ReduceTalkComment(ctx context.Context, id int32, uuid stri
 }
 
 func (r *TalkUseCase) TalkImageIrregular(ctx context.Context, review *ImageReview) error {
-	err := r.repo.SendTalkImageIrregularToMq(ctx, review)
+	err := r.publishTalkImageIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetImageIrregularFailed("set talk image irregular to mq failed: %s", err.Error())
 	}
@@ -757,7 +789,7 @@ func (r *TalkUseCase) TalkImageIrregular(ctx context.Context, review *ImageRevie
 }
 
 func (r *TalkUseCase) TalkContentIrregular(ctx context.Context, review *TextReview) error {
-	err := r.repo.SendTalkContentIrregularToMq(ctx, review)
+	err := r.publishTalkContentIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetContentIrregularFailed("set talk content irregular to mq failed: %s", err.Error())
 	}
@@ -795,3 +827,77 @@ func (r *TalkUseCase) AddTalkContentReviewDbAndCache(ctx context.Context, review
 		return nil
 	})
 }
+
+func (r *TalkUseCase) publishReview(ctx context.Context, review *TalkReview) error {
+	return r.pub.Publish(ctx, &event.Review{
+		Header: event.Header{Mode: review.Mode},
+		Id:     review.Id,
+		Uuid:   review.Uuid,
+	}, review.Uuid)
+}
+
+func (r *TalkUseCase) publishTalk(ctx context.Context, talk *Talk, mode string) error {
+	return r.pub.Publish(ctx, &event.Creation{
+		Header: event.Header{Mode: mode},
+		Id:     talk.TalkId,
+		Auth:   talk.Auth,
+		Uuid:   talk.Uuid,
+	}, talk.Uuid)
+}
+
+func (r *TalkUseCase) publishTalkStatistic(ctx context.Context, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Achievement{
+		Header:   event.Header{Mode: mode},
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	}, uuid)
+}
+
+func (r *TalkUseCase) publishScore(ctx context.Context, score int32, uuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Score{
+		Header: event.Header{Mode: mode},
+		Uuid:   uuid,
+		Score:  score,
+	}, uuid)
+}
+
+func (r *TalkUseCase) publishStatistic(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.Statistic{
+		Header:        event.Header{Mode: mode},
+		Id:            id,
+		CollectionsId: collectionsId,
+		Uuid:          uuid,
+		UserUuid:      userUuid,
+	}, uuid)
+}
+
+func (r *TalkUseCase) publishTalkImageIrregular(ctx context.Context, review *ImageReview) error {
+	return r.pub.Publish(ctx, &event.ImageReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Kind:       review.Kind,
+		Uid:        review.Uid,
+		Uuid:       review.Uuid,
+		JobId:      review.JobId,
+		Url:        review.Url,
+		Label:      review.Label,
+		Result:     review.Result,
+		Category:   review.Category,
+		SubLabel:   review.SubLabel,
+		Score:      review.Score,
+	}, review.Uuid)
+}
+
+func (r *TalkUseCase) publishTalkContentIrregular(ctx context.Context, review *TextReview) error {
+	return r.pub.Publish(ctx, &event.TextReview{
+		Header:     event.Header{Mode: review.Mode},
+		CreationId: review.CreationId,
+		Title:      review.Title,
+		Kind:       review.Kind,
+		JobId:      review.JobId,
+		Label:      review.Label,
+		Result:     review.Result,
+		Uuid:       review.Uuid,
+		Section:    review.Section,
+	}, review.Uuid)
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -455,7 +454,7 @@ func (r *articleRepo) GetArticleStatistic(ctx context.Context, id int32, uuid st
 	}
 
 	if exist == 1 {
-		statistic, err = r.getArticleStatisticFromCache(ctx, key, uuid)
+		statistic, err = r.getArticleStatisticFromCache(ctx, id, key, uuid)
 		if err != nil {
 			return nil, err
 		}
@@ -481,7 +480,7 @@ func (r *articleRepo) GetArticleStatistic(ctx context.Context, id int32, uuid st
 
 func (r *articleRepo) getArticleStatisticFromDB(ctx context.Context, id int32) (*biz.ArticleStatistic, error) {
 	as := &ArticleStatistic{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "agree", "collect", "view", "comment", "auth").Where("article_id = ?", id).First(as).Error
+	err := r.data.db.WithContext(ctx).Select("uuid", "agree", "collect", "view", "raw_view", "comment", "auth").Where("article_id = ?", id).First(as).Error
 	if err != nil {
 		return nil, errors.Wrapf(err, fmt.Sprintf("faile to get statistic from db: id(%v)", id))
 	}
@@ -490,6 +489,7 @@ func (r *articleRepo) getArticleStatisticFromDB(ctx context.Context, id int32) (
 		Agree:   as.Agree,
 		Collect: as.Collect,
 		View:    as.View,
+		RawView: as.RawView,
 		Comment: as.Comment,
 		Auth:    as.Auth,
 	}, nil
@@ -584,7 +584,7 @@ func (r *articleRepo) getArticleListStatisticFromCache(ctx context.Context, exis
 
 func (r *articleRepo) getArticleListStatisticFromDb(ctx context.Context, unExists []int32, articleListStatistic *[]*biz.ArticleStatistic) error {
 	list := make([]*ArticleStatistic, 0, cap(unExists))
-	err := r.data.db.WithContext(ctx).Select("article_id", "uuid", "agree", "comment", "collect", "view", "auth").Where("article_id IN ?", unExists).Find(&list).Error
+	err := r.data.db.WithContext(ctx).Select("article_id", "uuid", "agree", "comment", "collect", "view", "raw_view", "auth").Where("article_id IN ?", unExists).Find(&list).Error
 	if err != nil {
 		return errors.Wrapf(err, fmt.Sprintf("fail to get article statistic list from db: ids(%v)", unExists))
 	}
@@ -621,6 +621,7 @@ func (r *articleRepo) setArticleListStatisticToCache(commentList []*ArticleStati
 			pipe.HSetNX(ctx, key, "comment", item.Comment)
 			pipe.HSetNX(ctx, key, "collect", item.Collect)
 			pipe.HSetNX(ctx, key, "view", item.View)
+			pipe.HSetNX(ctx, key, "raw_view", item.RawView)
 			pipe.HSetNX(ctx, key, "auth", item.Auth)
 			pipe.Expire(ctx, key, time.Minute*30)
 		}
@@ -1613,7 +1614,7 @@ func (r *articleRepo) DeleteArticleDraft(ctx context.Context, id int32, uuid str
 }
 
 func (r *articleRepo) ArticleDraftMark(ctx context.Context, id int32, uuid string) error {
-	err := r.data.db.WithContext(ctx).Model(&ArticleDraft{}).Where("id = ? and uuid = ?", id, uuid).Update("status", 3).Error
+	err := r.data.DB(ctx).Model(&ArticleDraft{}).Where("id = ? and uuid = ?", id, uuid).Update("status", 3).Error
 	if err != nil {
 		return errors.Wrapf(err, fmt.Sprintf("fail to mark draft to 3: uuid(%s), id(%v)", uuid, id))
 	}
@@ -1634,92 +1635,6 @@ func (r *articleRepo) SendArticle(ctx context.Context, id int32, uuid string) (*
 	}, nil
 }
 
-func (r *articleRepo) SendReviewToMq(ctx context.Context, review *biz.ArticleReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *articleRepo) SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error {
-	scoreMap := &biz.SendScoreMap{
-		Uuid:  uuid,
-		Score: score,
-		Mode:  mode,
-	}
-	data, err := scoreMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send score to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *articleRepo) SendArticleToMq(ctx context.Context, article *biz.Article, mode string) error {
-	articleMap := &biz.SendArticleMap{
-		Uuid: article.Uuid,
-		Id:   article.ArticleId,
-		Auth: article.Auth,
-		Mode: mode,
-	}
-	data, err := articleMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{article.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send article to mq: %v", article))
-	}
-	return nil
-}
-
-func (r *articleRepo) SendStatisticToMq(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error {
-	statisticMap := &biz.SendStatisticMap{
-		Id:            id,
-		CollectionsId: collectionsId,
-		Uuid:          uuid,
-		UserUuid:      userUuid,
-		Mode:          mode,
-	}
-	data, err := statisticMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send article statistic to mq: map(%v)", statisticMap))
-	}
-	return nil
-}
-
 func (r *articleRepo) SetArticleAgree(ctx context.Context, id int32, uuid string) error {
 	as := ArticleStatistic{}
 	err := r.data.DB(ctx).Model(&as).Where("article_id = ? and uuid = ?", id, uuid).Update("agree", gorm.Expr("agree + ?", 1)).Error
@@ -1760,7 +1675,10 @@ func (r *articleRepo) SetArticleAgreeToCache(ctx context.Context, id int32, uuid
 
 func (r *articleRepo) SetArticleView(ctx context.Context, id int32, uuid string) error {
 	as := ArticleStatistic{}
-	err := r.data.DB(ctx).Model(&as).Where("article_id = ? and uuid = ?", id, uuid).Update("view", gorm.Expr("view + ?", 1)).Error
+	err := r.data.DB(ctx).Model(&as).Where("article_id = ? and uuid = ?", id, uuid).Updates(map[string]interface{}{
+		"view":     gorm.Expr("view + ?", 1),
+		"raw_view": gorm.Expr("raw_view + ?", 1),
+	}).Error
 	if err != nil {
 		return errors.Wrapf(err, fmt.Sprintf("fail to add article view: id(%v)", id))
 	}
@@ -1770,8 +1688,8 @@ func (r *articleRepo) SetArticleView(ctx context.Context, id int32, uuid string)
 func (r *articleRepo) SetArticleViewToCache(ctx context.Context, id int32, uuid string) error {
 	ids := strconv.Itoa(int(id))
 	keys := []string{"article_" + ids}
-	values := []interface{}{1}
-	_, err := r.data.redisCli.EvalSha(ctx, "6abf0f5d9afbfe7ed552297f59b9d898c6b06747", keys, values...).Result()
+	values := []interface{}{1, 1}
+	_, err := r.data.redisCli.EvalSha(ctx, "1bf3ea3c6793e96ef9df89109faaeb714317542c", keys, values...).Result()
 
 	if err != nil {
 		return errors.Wrapf(err, fmt.Sprintf("fail to add article view to cache: id(%v), uuid(%s)", id, uuid))
@@ -1779,6 +1697,39 @@ func (r *articleRepo) SetArticleViewToCache(ctx context.Context, id int32, uuid
 	return nil
 }
 
+func (r *articleRepo) AddArticleViewer(ctx context.Context, id int32, viewer string) (bool, error) {
+	return r.data.addViewer(ctx, "article_viewer_"+strconv.Itoa(int(id)), viewer)
+}
+
+func (r *articleRepo) AddArticleView(ctx context.Context, views []*biz.CreationView) error {
+	ids := make([]int32, 0, len(views))
+	for _, item := range views {
+		ids = append(ids, item.Id)
+	}
+	err := r.data.DB(ctx).Model(&ArticleStatistic{}).Where("article_id in ?", ids).Updates(map[string]interface{}{
+		"view":     viewExpr("article_id", "view", views, false),
+		"raw_view": viewExpr("article_id", "raw_view", views, true),
+	}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add article view: ids(%v)", ids))
+	}
+	return nil
+}
+
+func (r *articleRepo) AddArticleViewToCache(ctx context.Context, views []*biz.CreationView) error {
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, item := range views {
+			keys := []string{"article_" + strconv.Itoa(int(item.Id))}
+			pipe.EvalSha(ctx, "1bf3ea3c6793e96ef9df89109faaeb714317542c", keys, item.Count, item.Raw)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add article view to cache: views(%v)", views))
+	}
+	return nil
+}
+
 func (r *articleRepo) SetCollectionsArticleCollect(ctx context.Context, id, collectionsId int32, userUuid string) error {
 	collect := &Collect{
 		CollectionsId: collectionsId,
@@ -1996,62 +1947,6 @@ func (r *articleRepo) CancelUserArticleCollectFromCache(ctx context.Context, id
 	return nil
 }
 
-func (r *articleRepo) SendArticleStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error {
-	achievement := &biz.SendArticleStatisticMap{
-		Uuid:     uuid,
-		UserUuid: userUuid,
-		Mode:     mode,
-	}
-	data, err := achievement.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send article statistic to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *articleRepo) SendArticleImageIrregularToMq(ctx context.Context, review *biz.ImageReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send article image review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *articleRepo) SendArticleContentIrregularToMq(ctx context.Context, review *biz.TextReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send article content review to mq: %v", err))
-	}
-	return nil
-}
-
 func (r *articleRepo) getArticleFromCache(ctx context.Context, page int32) ([]*biz.Article, error) {
 	if page < 1 {
 		page = 1
@@ -2198,15 +2093,21 @@ func (r *articleRepo) setColumnArticleToCache(id int32, article []*biz.Article)
 	}
 }
 
-func (r *articleRepo) getArticleStatisticFromCache(ctx context.Context, key, uuid string) (*biz.ArticleStatistic, error) {
-	statistic, err := r.data.redisCli.HMGet(ctx, key, "uuid", "agree", "collect", "view", "comment", "auth").Result()
+func (r *articleRepo) getArticleStatisticFromCache(ctx context.Context, id int32, key, uuid string) (*biz.ArticleStatistic, error) {
+	fields := []string{"uuid", "agree", "collect", "view", "comment", "auth", "raw_view"}
+	statistic, err := r.data.redisCli.HMGet(ctx, key, fields...).Result()
 	if err != nil {
 		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get article statistic form cache: key(%s)", key))
 	}
-	val := []int32{0, 0, 0, 0, 0}
+	missing := make([]string, 0, len(fields))
+	if statistic[0] == nil {
+		missing = append(missing, fields[0])
+	}
+	val := []int32{0, 0, 0, 0, 0, 0}
 	for _index, count := range statistic[1:] {
 		if count == nil {
-			break
+			missing = append(missing, fields[_index+1])
+			continue
 		}
 		num, err := strconv.ParseInt(count.(string), 10, 32)
 		if err != nil {
@@ -2214,22 +2115,79 @@ func (r *articleRepo) getArticleStatisticFromCache(ctx context.Context, key, uui
 		}
 		val[_index] = int32(num)
 	}
-	if val[4] == 2 && statistic[0].(string) != uuid {
-		return nil, errors.Errorf("fail to get article statistic from cache: no auth")
-	}
-	return &biz.ArticleStatistic{
-		Uuid:    statistic[0].(string),
+	as := &biz.ArticleStatistic{
 		Agree:   val[0],
 		Collect: val[1],
 		View:    val[2],
 		Comment: val[3],
-	}, nil
+		Auth:    val[4],
+		RawView: val[5],
+	}
+	if statistic[0] != nil {
+		as.Uuid = statistic[0].(string)
+	}
+	if len(missing) != 0 {
+		as, err = r.fillArticleStatisticToCache(ctx, id, key, as, missing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if as.Auth == 2 && as.Uuid != uuid {
+		return nil, errors.Errorf("fail to get article statistic from cache: no auth")
+	}
+	return as, nil
+}
+
+// fillArticleStatisticToCache takes the fields missing from the cached
+// statistic, as in hashes cached before auth and raw_view were, from the
+// db and writes them to the cache unless they were set meanwhile.
+func (r *articleRepo) fillArticleStatisticToCache(ctx context.Context, id int32, key string, statistic *biz.ArticleStatistic, missing []string) (*biz.ArticleStatistic, error) {
+	db, err := r.getArticleStatisticFromDB(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{}, len(missing))
+	for _, field := range missing {
+		switch field {
+		case "uuid":
+			statistic.Uuid = db.Uuid
+			values[field] = db.Uuid
+		case "agree":
+			statistic.Agree = db.Agree
+			values[field] = db.Agree
+		case "collect":
+			statistic.Collect = db.Collect
+			values[field] = db.Collect
+		case "view":
+			statistic.View = db.View
+			values[field] = db.View
+		case "comment":
+			statistic.Comment = db.Comment
+			values[field] = db.Comment
+		case "auth":
+			statistic.Auth = db.Auth
+			values[field] = db.Auth
+		case "raw_view":
+			statistic.RawView = db.RawView
+			values[field] = db.RawView
+		}
+	}
+	_, err = r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for field, value := range values {
+			pipe.HSetNX(ctx, key, field, value)
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("fail to fill article statistic to cache: key(%s), fields(%v), err(%s)", key, missing, err.Error())
+	}
+	return statistic, nil
 }
 
 func (r *articleRepo) setArticleStatisticToCache(key string, statistic *biz.ArticleStatistic) {
 	ctx := context.Background()
 	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
-		pipe.HMSet(context.Background(), key, "uuid", statistic.Uuid, "agree", statistic.Agree, "collect", statistic.Collect, "view", statistic.View, "comment", statistic.Comment)
+		pipe.HMSet(context.Background(), key, "uuid", statistic.Uuid, "agree", statistic.Agree, "collect", statistic.Collect, "view", statistic.View, "raw_view", statistic.RawView, "comment", statistic.Comment, "auth", statistic.Auth)
 		pipe.Expire(ctx, key, time.Minute*30)
 		return nil
 	})
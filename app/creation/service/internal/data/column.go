@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -235,173 +234,6 @@ func (r *columnRepo) CreateColumnStatistic(ctx context.Context, id, auth int32,
 	return nil
 }
 
-func (r *columnRepo) SendColumnToMq(ctx context.Context, column *biz.Column, mode string) error {
-	columnMap := &biz.SendColumnMap{
-		Uuid: column.Uuid,
-		Id:   column.ColumnId,
-		Auth: column.Auth,
-		Mode: mode,
-	}
-
-	data, err := columnMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{column.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send column to mq: %v", column))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendReviewToMq(ctx context.Context, review *biz.ColumnReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error {
-	scoreMap := &biz.SendScoreMap{
-		Uuid:  uuid,
-		Score: score,
-		Mode:  mode,
-	}
-	data, err := scoreMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send score to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendStatisticToMq(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error {
-	statisticMap := &biz.SendStatisticMap{
-		Id:            id,
-		CollectionsId: collectionsId,
-		Uuid:          uuid,
-		UserUuid:      userUuid,
-		Mode:          mode,
-	}
-
-	data, err := statisticMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send column statistic to mq: map(%v)", statisticMap))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendColumnIncludesToMq(ctx context.Context, id, articleId int32, uuid, mode string) error {
-	includesMap := &biz.SendColumnIncludesMap{
-		Id:        id,
-		ArticleId: articleId,
-		Uuid:      uuid,
-		Mode:      mode,
-	}
-	data, err := includesMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to add column includes to mq: map(%v)", includesMap))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendColumnSubscribeToMq(ctx context.Context, id int32, uuid, mode string) error {
-	subscribeMap := &biz.SendColumnSubscribeMap{
-		Id:   id,
-		Uuid: uuid,
-		Mode: mode,
-	}
-	data, err := subscribeMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to set column subscribe to mq: map(%v)", subscribeMap))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendColumnImageIrregularToMq(ctx context.Context, review *biz.ImageReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send column image review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *columnRepo) SendColumnContentIrregularToMq(ctx context.Context, review *biz.TextReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send column content review to mq: %v", err))
-	}
-	return nil
-}
-
 func (r *columnRepo) CreateColumnCache(ctx context.Context, id, auth int32, uuid, mode string) error {
 	ids := strconv.Itoa(int(id))
 	columnStatistic := "column_" + ids
@@ -1523,6 +1355,36 @@ func (r *columnRepo) SetColumnViewToCache(ctx context.Context, id int32, uuid st
 	return nil
 }
 
+func (r *columnRepo) AddColumnViewer(ctx context.Context, id int32, viewer string) (bool, error) {
+	return r.data.addViewer(ctx, "column_viewer_"+strconv.Itoa(int(id)), viewer)
+}
+
+func (r *columnRepo) AddColumnView(ctx context.Context, views []*biz.CreationView) error {
+	ids := make([]int32, 0, len(views))
+	for _, item := range views {
+		ids = append(ids, item.Id)
+	}
+	err := r.data.DB(ctx).Model(&ColumnStatistic{}).Where("column_id in ?", ids).Update("view", viewExpr("column_id", "view", views, false)).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add column view: ids(%v)", ids))
+	}
+	return nil
+}
+
+func (r *columnRepo) AddColumnViewToCache(ctx context.Context, views []*biz.CreationView) error {
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, item := range views {
+			keys := []string{"column_" + strconv.Itoa(int(item.Id))}
+			pipe.EvalSha(ctx, "6abf0f5d9afbfe7ed552297f59b9d898c6b06747", keys, item.Count)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add column view to cache: views(%v)", views))
+	}
+	return nil
+}
+
 func (r *columnRepo) SetColumnAgreeToCache(ctx context.Context, id int32, uuid, userUuid string) error {
 	hotKey := fmt.Sprintf("column_hot")
 	statisticKey := fmt.Sprintf("column_%v", id)
@@ -1537,28 +1399,6 @@ func (r *columnRepo) SetColumnAgreeToCache(ctx context.Context, id int32, uuid,
 	return nil
 }
 
-func (r *columnRepo) SendColumnStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error {
-	achievement := &biz.SendColumnStatisticMap{
-		Uuid:     uuid,
-		UserUuid: userUuid,
-		Mode:     mode,
-	}
-	data, err := achievement.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send column statistic to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
 func (r *columnRepo) SetColumnUserCollect(ctx context.Context, id, collectionsId int32, userUuid string) error {
 	collect := &Collect{
 		CollectionsId: collectionsId,
@@ -1,9 +1,10 @@
 package data
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
@@ -1234,34 +1235,6 @@ func (r *creationRepo) SendCollections(ctx context.Context, id int32, uuid strin
 	}, nil
 }
 
-func (r *creationRepo) SendCollectionsContentIrregularToMq(ctx context.Context, review *biz.TextReview) error {
-	m := &biz.TextReview{
-		CreationId: review.CreationId,
-		Result:     review.Result,
-		Uuid:       review.Uuid,
-		JobId:      review.JobId,
-		Label:      review.Label,
-		Title:      review.Title,
-		Kind:       review.Kind,
-		Section:    review.Section,
-		Mode:       review.Mode,
-	}
-	data, err := m.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send collections content review to mq: %v", err))
-	}
-	return nil
-}
-
 func (r *creationRepo) SetCollectionsContentIrregular(ctx context.Context, review *biz.TextReview) (*biz.TextReview, error) {
 	ar := &CollectionsContentReview{
 		CollectionsId: review.CreationId,
@@ -1546,43 +1519,319 @@ func (r *creationRepo) SetLeaderBoardToCache(ctx context.Context, boardList []*b
 	}
 }
 
-func (r *creationRepo) SendReviewToMq(ctx context.Context, review *biz.CollectionsReview) error {
-	data, err := review.MarshalJSON()
+// viewExpr adds the count of each view, or its raw count, to column of the row
+// whose idColumn matches its id.
+func viewExpr(idColumn, column string, views []*biz.CreationView, raw bool) clause.Expr {
+	var sql strings.Builder
+	args := make([]interface{}, 0, len(views)*2)
+	sql.WriteString(fmt.Sprintf("%s + CASE %s", column, idColumn))
+	for _, item := range views {
+		count := item.Count
+		if raw {
+			count = item.Raw
+		}
+		sql.WriteString(" WHEN ? THEN ?")
+		args = append(args, item.Id, count)
+	}
+	sql.WriteString(" ELSE 0 END")
+	return gorm.Expr(sql.String(), args...)
+}
+
+// defaultViewWindow is how long a viewer counts once towards the unique views
+// of a creation.
+const defaultViewWindow = 24 * time.Hour
+
+// addViewer adds viewer to the HyperLogLog at key and reports whether it is
+// new there. The HyperLogLog expires one view window after its first viewer.
+// Its estimate errs by about 1%, so a few first views pass for repeats.
+func (d *Data) addViewer(ctx context.Context, key, viewer string) (bool, error) {
+	added, err := d.redisCli.EvalSha(ctx, "33a217b52308e889bcee6f5ac25849ee035f4f08", []string{key}, viewer, int64(d.viewWindow/time.Second)).Int()
 	if err != nil {
-		return err
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to add viewer to cache: key(%s), viewer(%s)", key, viewer))
+	}
+	return added == 1, nil
+}
+
+func (r *creationRepo) GetUserArticleIds(ctx context.Context, uuid string) ([]int32, error) {
+	var ids []int32
+	err := r.data.db.WithContext(ctx).Model(&Article{}).Where("uuid = ?", uuid).Pluck("article_id", &ids).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user article ids from db: uuid(%s)", uuid))
+	}
+	return ids, nil
+}
+
+func (r *creationRepo) GetUserTalkIds(ctx context.Context, uuid string) ([]int32, error) {
+	var ids []int32
+	err := r.data.db.WithContext(ctx).Model(&Talk{}).Where("uuid = ?", uuid).Pluck("talk_id", &ids).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user talk ids from db: uuid(%s)", uuid))
 	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
+	return ids, nil
+}
+
+func (r *creationRepo) GetUserColumnIds(ctx context.Context, uuid string) ([]int32, error) {
+	var ids []int32
+	err := r.data.db.WithContext(ctx).Model(&Column{}).Where("uuid = ?", uuid).Pluck("column_id", &ids).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user column ids from db: uuid(%s)", uuid))
 	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
+	return ids, nil
+}
+
+func (r *creationRepo) GetUserCollectionsIds(ctx context.Context, uuid string) ([]int32, error) {
+	var ids []int32
+	err := r.data.db.WithContext(ctx).Model(&Collections{}).Where("uuid = ?", uuid).Pluck("collections_id", &ids).Error
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send review to mq: %v", err))
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user collections ids from db: uuid(%s)", uuid))
+	}
+	return ids, nil
+}
+
+func (r *creationRepo) GetUserData(ctx context.Context, uuid string) ([]*biz.UserDataFile, error) {
+	tables := []struct {
+		name string
+		rows interface{}
+	}{
+		{"articles.json", &[]*Article{}},
+		{"article_drafts.json", &[]*ArticleDraft{}},
+		{"article_statistics.json", &[]*ArticleStatistic{}},
+		{"article_reviews.json", &[]*ArticleReview{}},
+		{"article_content_reviews.json", &[]*ArticleContentReview{}},
+		{"article_agrees.json", &[]*ArticleAgree{}},
+		{"article_collects.json", &[]*ArticleCollect{}},
+		{"talks.json", &[]*Talk{}},
+		{"talk_drafts.json", &[]*TalkDraft{}},
+		{"talk_statistics.json", &[]*TalkStatistic{}},
+		{"talk_reviews.json", &[]*TalkReview{}},
+		{"talk_content_reviews.json", &[]*TalkContentReview{}},
+		{"talk_agrees.json", &[]*TalkAgree{}},
+		{"talk_collects.json", &[]*TalkCollect{}},
+		{"columns.json", &[]*Column{}},
+		{"column_drafts.json", &[]*ColumnDraft{}},
+		{"column_statistics.json", &[]*ColumnStatistic{}},
+		{"column_reviews.json", &[]*ColumnReview{}},
+		{"column_content_reviews.json", &[]*ColumnContentReview{}},
+		{"column_inclusions.json", &[]*ColumnInclusion{}},
+		{"column_agrees.json", &[]*ColumnAgree{}},
+		{"column_collects.json", &[]*ColumnCollect{}},
+		{"subscribes.json", &[]*Subscribe{}},
+		{"collections.json", &[]*Collections{}},
+		{"collections_drafts.json", &[]*CollectionsDraft{}},
+		{"collections_content_reviews.json", &[]*CollectionsContentReview{}},
+		{"collects.json", &[]*Collect{}},
+		{"time_lines.json", &[]*TimeLine{}},
+		{"creation_user.json", &[]*CreationUser{}},
+		{"records.json", &[]*Record{}},
+	}
+	files := make([]*biz.UserDataFile, 0, len(tables))
+	for _, item := range tables {
+		err := r.data.db.WithContext(ctx).Where("uuid = ?", uuid).Find(item.rows).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from db: file(%s), uuid(%s)", item.name, uuid))
+		}
+		content, err := json.MarshalIndent(item.rows, "", "  ")
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to marshal user data: file(%s), uuid(%s)", item.name, uuid))
+		}
+		files = append(files, &biz.UserDataFile{
+			Name:    item.name,
+			Content: content,
+		})
+	}
+	return files, nil
+}
+
+// PurgeUserData drops the rows of uuid nobody else sees. Creations are left to
+// the deletion events, which also clear their statistics, timelines,
+// inclusions and collects.
+func (r *creationRepo) PurgeUserData(ctx context.Context, uuid string) error {
+	models := []interface{}{
+		&ArticleDraft{}, &TalkDraft{}, &ColumnDraft{}, &CollectionsDraft{},
+		&ArticleReview{}, &ArticleContentReview{}, &TalkReview{}, &TalkContentReview{},
+		&ColumnReview{}, &ColumnContentReview{}, &CollectionsContentReview{},
+		&ArticleAgree{}, &ArticleCollect{}, &TalkAgree{}, &TalkCollect{}, &ColumnAgree{}, &ColumnCollect{},
+		&Subscribe{}, &Record{}, &CreationUser{}, &CreationUserVisitor{},
+	}
+	for _, model := range models {
+		err := r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(model).Error
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to purge user data: model(%T), uuid(%s)", model, uuid))
+		}
 	}
 	return nil
 }
 
-func (r *creationRepo) SendCollectionsToMq(ctx context.Context, collections *biz.Collections, mode string) error {
-	collectionsMap := &biz.SendCollectionMap{
-		Uuid: collections.Uuid,
-		Id:   collections.CollectionsId,
-		Auth: collections.Auth,
-		Mode: mode,
+func (r *creationRepo) PurgeUserDataFromCache(ctx context.Context, uuid string) error {
+	keys := []string{
+		"creation_user_" + uuid,
+		"creation_user_visitor_" + uuid,
+		"user_timeline_list_" + uuid,
+		"user_article_list_" + uuid,
+		"user_article_list_all_" + uuid,
+		"user_article_list_visitor_" + uuid,
+		"user_article_agree_" + uuid,
+		"user_article_collect_" + uuid,
+		"article_image_irregular_" + uuid,
+		"article_content_irregular_" + uuid,
+		"user_talk_list_" + uuid,
+		"user_talk_list_visitor_" + uuid,
+		"user_talk_agree_" + uuid,
+		"user_talk_collect_" + uuid,
+		"talk_image_irregular_" + uuid,
+		"talk_content_irregular_" + uuid,
+		"user_column_list_" + uuid,
+		"user_column_list_visitor_" + uuid,
+		"user_column_agree_" + uuid,
+		"user_column_collect_" + uuid,
+		"user_column_subscribe_" + uuid,
+		"user_column_subscribe_list_" + uuid,
+		"column_image_irregular_" + uuid,
+		"column_content_irregular_" + uuid,
+		"user_collections_list_" + uuid,
+		"user_collections_list_all_" + uuid,
+		"user_collections_list_visitor_" + uuid,
+		"collections_content_irregular_" + uuid,
+	}
+	_, err := r.data.redisCli.Del(ctx, keys...).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from cache: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *creationRepo) GetUserInterestArticleIds(ctx context.Context, uuid string, size int) ([]int32, error) {
+	ids := make([]int32, 0, size*2)
+	for _, model := range []interface{}{&ArticleAgree{}, &ArticleCollect{}} {
+		list := make([]int32, 0, size)
+		err := r.data.db.WithContext(ctx).Model(model).Where("uuid = ? and status = ?", uuid, 1).Order("updated_at desc").Limit(size).Pluck("article_id", &list).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user interest article ids from db: model(%T), uuid(%s)", model, uuid))
+		}
+		ids = append(ids, list...)
+	}
+	return ids, nil
+}
+
+func (r *creationRepo) GetArticleTags(ctx context.Context, ids []int32) ([]string, error) {
+	docs := make([]string, 0, len(ids))
+	exist := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		if exist[id] {
+			continue
+		}
+		exist[id] = true
+		docs = append(docs, strconv.Itoa(int(id)))
+	}
+
+	var buf bytes.Buffer
+	body := map[string]interface{}{
+		"ids": docs,
+	}
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("error encoding query: ids(%v)", ids))
 	}
 
-	data, err := collectionsMap.MarshalJSON()
+	res, err := r.data.elasticSearch.es.Mget(
+		&buf,
+		r.data.elasticSearch.es.Mget.WithContext(ctx),
+		r.data.elasticSearch.es.Mget.WithIndex("article"),
+		r.data.elasticSearch.es.Mget.WithSourceIncludes("tags"),
+	)
 	if err != nil {
-		return err
+		return nil, errors.Wrapf(err, fmt.Sprintf("error getting response from es: ids(%v)", ids))
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		var e map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("error parsing the response body: ids(%v)", ids))
+		} else {
+			return nil, errors.Errorf(fmt.Sprintf("error get article tags from es: reason(%v), ids(%v)", e, ids))
+		}
+	}
+
+	result := map[string]interface{}{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("error parsing the response body: ids(%v)", ids))
+	}
+
+	tags := make([]string, 0, len(docs))
+	for _, doc := range result["docs"].([]interface{}) {
+		source, ok := doc.(map[string]interface{})["_source"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if tag, ok := source["tags"].(string); ok && tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+func (r *creationRepo) GetTagAuthors(ctx context.Context, tags []string, size int32) (map[string]int32, error) {
+	var buf bytes.Buffer
+	body := map[string]interface{}{
+		"size":    size,
+		"_source": []string{"uuid"},
+		"query": map[string]interface{}{
+			"match": map[string]interface{}{
+				"tags": strings.Join(tags, " "),
+			},
+		},
 	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("error encoding query: tags(%v), size(%v)", tags, size))
 	}
-	msg.WithKeys([]string{collections.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
+
+	res, err := r.data.elasticSearch.es.Search(
+		r.data.elasticSearch.es.Search.WithContext(ctx),
+		r.data.elasticSearch.es.Search.WithIndex("article"),
+		r.data.elasticSearch.es.Search.WithBody(&buf),
+	)
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send collections to mq: %v", collections))
+		return nil, errors.Wrapf(err, fmt.Sprintf("error getting response from es: tags(%v), size(%v)", tags, size))
 	}
-	return nil
+	defer res.Body.Close()
+
+	if res.IsError() {
+		var e map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("error parsing the response body: tags(%v), size(%v)", tags, size))
+		} else {
+			return nil, errors.Errorf(fmt.Sprintf("error search tag authors from es: reason(%v), tags(%v), size(%v)", e, tags, size))
+		}
+	}
+
+	result := map[string]interface{}{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("error parsing the response body: tags(%v), size(%v)", tags, size))
+	}
+
+	authors := make(map[string]int32)
+	for _, hit := range result["hits"].(map[string]interface{})["hits"].([]interface{}) {
+		if uuid, ok := hit.(map[string]interface{})["_source"].(map[string]interface{})["uuid"].(string); ok && uuid != "" {
+			authors[uuid]++
+		}
+	}
+	return authors, nil
+}
+
+func (r *creationRepo) GetAuthorActivity(ctx context.Context, uuids []string, since time.Time) (map[string]int32, error) {
+	activity := make(map[string]int32, len(uuids))
+	for _, model := range []interface{}{&Article{}, &Talk{}} {
+		list := make([]struct {
+			Uuid  string
+			Count int32
+		}, 0, len(uuids))
+		err := r.data.db.WithContext(ctx).Model(model).Select("uuid, count(*) as count").Where("uuid IN ? and auth = ? and created_at >= ?", uuids, 1, since).Group("uuid").Scan(&list).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get author activity from db: model(%T), uuids(%v)", model, uuids))
+		}
+		for _, item := range list {
+			activity[item.Uuid] += item.Count
+		}
+	}
+	return activity, nil
 }
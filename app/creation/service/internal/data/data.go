@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"fmt"
 	"github.com/apache/rocketmq-client-go/v2"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/apache/rocketmq-client-go/v2/producer"
@@ -9,9 +10,12 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	"github.com/pkg/errors"
 	"github.com/tencentyun/cos-go-sdk-v5"
 	"github.com/the-zion/matrix-core/app/creation/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/creation/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"net/http"
@@ -20,10 +24,15 @@ import (
 	"time"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewRocketmqProducer, NewCosServiceClient, NewElasticsearch, NewNewsClient, NewArticleRepo, NewTalkRepo, NewCreationRepo, NewColumnRepo, NewNewsRepo, NewRecovery)
+var ProviderSet = wire.NewSet(ClientSet, RepoSet)
+
+// ClientSet connects to the infrastructure named in the config.
+var ClientSet = wire.NewSet(NewDB, NewRedis, NewTransport, NewCosServiceClient, NewElasticsearch, NewNewsClient)
+
+var RepoSet = wire.NewSet(NewData, NewTransaction, NewPublisher, NewArticleRepo, NewTalkRepo, NewCreationRepo, NewColumnRepo, NewNewsRepo, NewRecovery)
 
 type MqPro struct {
-	producer rocketmq.Producer
+	producer *event.Producer
 }
 
 type ElasticSearch struct {
@@ -39,15 +48,28 @@ type Data struct {
 	log           *log.Helper
 	redisCli      redis.Cmdable
 	mqPro         *MqPro
+	relay         *event.Relay
+	sweeper       *idempotent.Sweeper
 	cosCli        *cos.Client
 	elasticSearch *ElasticSearch
 	newsCli       *NewsClient
+	viewWindow    time.Duration
 }
 
 type contextTxKey struct{}
 
+// ExecTx runs fn in a transaction, unless fn has been committed before on
+// behalf of the idempotency key in ctx.
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx, first, err := idempotent.Claim(ctx, tx, "tx")
+		if err != nil {
+			return err
+		}
+		if !first {
+			d.log.Infof("skip committed transaction")
+			return nil
+		}
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -97,6 +119,19 @@ func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
 
+func NewPublisher(d *Data) biz.Publisher {
+	return d
+}
+
+func (d *Data) Publish(ctx context.Context, e event.Event, key string) error {
+	err := event.Store(ctx, d.DB(ctx), e, key)
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to store event to outbox: mode(%s), key(%s)", event.Mode(e), key))
+	}
+	d.relay.Notify()
+	return nil
+}
+
 func NewDB(conf *conf.Data) *gorm.DB {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/mysql"))
 
@@ -144,7 +179,18 @@ func NewCosServiceClient(conf *conf.Data) *cos.Client {
 	})
 }
 
-func NewRocketmqProducer(conf *conf.Data) *MqPro {
+func NewTransport(conf *conf.Data) event.Transport {
+	switch conf.Transport {
+	case "", event.TransportRocketMq:
+		return newRocketmqTransport(conf)
+	case event.TransportRedis:
+		return newRedisStreamTransport(conf)
+	}
+	log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/transport")).Fatalf("unknown transport: %s", conf.Transport)
+	return nil
+}
+
+func newRocketmqTransport(conf *conf.Data) event.Transport {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/rocketmq-producer"))
 	p, err := rocketmq.NewProducer(
 		producer.WithNsResolver(primitive.NewPassthroughResolver([]string{conf.Rocketmq.ServerAddress})),
@@ -154,6 +200,7 @@ func NewRocketmqProducer(conf *conf.Data) *MqPro {
 		}),
 		producer.WithGroupName(conf.Rocketmq.GroupName),
 		producer.WithNamespace(conf.Rocketmq.NameSpace),
+		producer.WithQueueSelector(producer.NewHashQueueSelector()),
 	)
 
 	if err != nil {
@@ -164,12 +211,28 @@ func NewRocketmqProducer(conf *conf.Data) *MqPro {
 	if err != nil {
 		l.Fatalf("start producer error: %v", err)
 	}
-	return &MqPro{
-		producer: p,
+	return event.NewRocketMqTransport(p)
+}
+
+func newRedisStreamTransport(conf *conf.Data) event.Transport {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/redis-stream"))
+	client := redis.NewClient(&redis.Options{
+		Addr:        conf.RedisStream.Addr,
+		DB:          int(conf.RedisStream.Db),
+		DialTimeout: time.Second * 2,
+		PoolSize:    10,
+		Password:    conf.RedisStream.Password,
+	})
+	timeout, cancelFunc := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancelFunc()
+	err := client.Ping(timeout).Err()
+	if err != nil {
+		l.Fatalf("redis stream connect error: %v", err)
 	}
+	return event.NewRedisStreamTransport(client, conf.RedisStream.MaxLen)
 }
 
-func NewElasticsearch(conf *conf.Data) *ElasticSearch {
+func NewElasticsearch(conf *conf.Data) *elasticsearch.Client {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/elastic-search"))
 	cfg := elasticsearch.Config{
 		Username: conf.ElasticSearch.User,
@@ -193,9 +256,7 @@ func NewElasticsearch(conf *conf.Data) *ElasticSearch {
 		l.Fatalf("Error: %s", res.String())
 	}
 
-	return &ElasticSearch{
-		es: es,
-	}
+	return es
 }
 
 func NewNewsClient(conf *conf.Data) *NewsClient {
@@ -204,21 +265,34 @@ func NewNewsClient(conf *conf.Data) *NewsClient {
 	}
 }
 
-func NewData(db *gorm.DB, redisCmd redis.Cmdable, cos *cos.Client, es *ElasticSearch, mq *MqPro, news *NewsClient, logger log.Logger) (*Data, func(), error) {
+func NewData(conf *conf.Data, db *gorm.DB, redisCmd redis.Cmdable, cos *cos.Client, es *elasticsearch.Client, mq event.Transport, news *NewsClient, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "creation/data/new-data"))
 
+	viewWindow := conf.View.GetWindow().AsDuration()
+	if viewWindow <= 0 {
+		viewWindow = defaultViewWindow
+	}
 	d := &Data{
 		db:            db,
 		log:           log.NewHelper(log.With(logger, "module", "creation/data")),
 		cosCli:        cos,
 		redisCli:      redisCmd,
-		mqPro:         mq,
-		elasticSearch: es,
+		mqPro:         &MqPro{producer: event.NewProducer(mq)},
+		elasticSearch: &ElasticSearch{es: es},
 		newsCli:       news,
+		viewWindow:    viewWindow,
 	}
+	d.relay = event.NewRelay(db, d.mqPro.producer, logger)
+	d.relay.Start()
+	d.sweeper = idempotent.NewSweeper(db, logger, idempotent.Processed{}.TableName())
+	d.sweeper.Start()
 	return d, func() {
 		l.Info("closing the data resources")
 
+		// stop the relay first, it flushes the outbox through db and the producer
+		d.relay.Stop()
+		d.sweeper.Stop()
+
 		sqlDB, err := db.DB()
 		if err != nil {
 			l.Errorf("close db err: %v", err.Error())
@@ -94,6 +94,7 @@ type ArticleStatistic struct {
 	Uuid      string         `gorm:"index;size:20"`
 	Agree     int32          `gorm:"index;type:int unsigned;default:0"`
 	View      int32          `gorm:"type:int unsigned;default:0"`
+	RawView   int32          `gorm:"type:int unsigned;default:0"`
 	Collect   int32          `gorm:"type:int unsigned;default:0"`
 	Comment   int32          `gorm:"type:int unsigned;default:0"`
 	Auth      int32          `gorm:"default:1"`
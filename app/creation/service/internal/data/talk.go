@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -1234,23 +1233,6 @@ func (r *talkRepo) SendTalk(ctx context.Context, id int32, uuid string) (*biz.Ta
 	}, nil
 }
 
-func (r *talkRepo) SendReviewToMq(ctx context.Context, review *biz.TalkReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send review to mq: %v", err))
-	}
-	return nil
-}
-
 func (r *talkRepo) DeleteTalkDraft(ctx context.Context, id int32, uuid string) error {
 	td := &TalkDraft{}
 	td.ID = uint(id)
@@ -1358,29 +1340,6 @@ func (r *talkRepo) CreateTalkStatistic(ctx context.Context, id, auth int32, uuid
 	return nil
 }
 
-func (r *talkRepo) SendTalkToMq(ctx context.Context, talk *biz.Talk, mode string) error {
-	talkMap := &biz.SendTalkMap{
-		Uuid: talk.Uuid,
-		Id:   talk.TalkId,
-		Auth: talk.Auth,
-		Mode: mode,
-	}
-	data, err := talkMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{talk.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send talk to mq: %v", talk))
-	}
-	return nil
-}
-
 func (r *talkRepo) CreateTalkCache(ctx context.Context, id, auth int32, uuid, mode string) error {
 	ids := strconv.Itoa(int(id))
 	talkStatistic := "talk_" + ids
@@ -1509,6 +1468,36 @@ func (r *talkRepo) SetTalkViewToCache(ctx context.Context, id int32, uuid string
 	return nil
 }
 
+func (r *talkRepo) AddTalkViewer(ctx context.Context, id int32, viewer string) (bool, error) {
+	return r.data.addViewer(ctx, "talk_viewer_"+strconv.Itoa(int(id)), viewer)
+}
+
+func (r *talkRepo) AddTalkView(ctx context.Context, views []*biz.CreationView) error {
+	ids := make([]int32, 0, len(views))
+	for _, item := range views {
+		ids = append(ids, item.Id)
+	}
+	err := r.data.DB(ctx).Model(&TalkStatistic{}).Where("talk_id in ?", ids).Update("view", viewExpr("talk_id", "view", views, false)).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add talk view: ids(%v)", ids))
+	}
+	return nil
+}
+
+func (r *talkRepo) AddTalkViewToCache(ctx context.Context, views []*biz.CreationView) error {
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, item := range views {
+			keys := []string{"talk_" + strconv.Itoa(int(item.Id))}
+			pipe.EvalSha(ctx, "6abf0f5d9afbfe7ed552297f59b9d898c6b06747", keys, item.Count)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add talk view to cache: views(%v)", views))
+	}
+	return nil
+}
+
 func (r *talkRepo) GetTalkAgreeJudge(ctx context.Context, id int32, uuid string) (bool, error) {
 	ids := strconv.Itoa(int(id))
 	judge, err := r.data.redisCli.SIsMember(ctx, "talk_agree_"+ids, uuid).Result()
@@ -1551,108 +1540,6 @@ func (r *talkRepo) EditTalkCosIntroduce(ctx context.Context, id int32, uuid stri
 	return nil
 }
 
-func (r *talkRepo) SendTalkStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error {
-	achievement := &biz.SendTalkStatisticMap{
-		Uuid:     uuid,
-		UserUuid: userUuid,
-		Mode:     mode,
-	}
-	data, err := achievement.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send talk statistic to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *talkRepo) SendScoreToMq(ctx context.Context, score int32, uuid, mode string) error {
-	scoreMap := &biz.SendScoreMap{
-		Uuid:  uuid,
-		Score: score,
-		Mode:  mode,
-	}
-	data, err := scoreMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send score to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *talkRepo) SendStatisticToMq(ctx context.Context, id, collectionsId int32, uuid, userUuid, mode string) error {
-	statisticMap := &biz.SendStatisticMap{
-		Id:            id,
-		CollectionsId: collectionsId,
-		Uuid:          uuid,
-		UserUuid:      userUuid,
-		Mode:          mode,
-	}
-	data, err := statisticMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send talk statistic to mq: map(%v)", statisticMap))
-	}
-	return nil
-}
-
-func (r *talkRepo) SendTalkImageIrregularToMq(ctx context.Context, review *biz.ImageReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send talk image review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *talkRepo) SendTalkContentIrregularToMq(ctx context.Context, review *biz.TextReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send talk content review to mq: %v", err))
-	}
-	return nil
-}
-
 func (r *talkRepo) SetTalkUserCollect(ctx context.Context, id, collectionsId int32, userUuid string) error {
 	collect := &Collect{
 		CollectionsId: collectionsId,
@@ -127,6 +127,7 @@ func (s *CreationService) GetArticleStatistic(ctx context.Context, req *v1.GetAr
 		Agree:   articleStatistic.Agree,
 		Collect: articleStatistic.Collect,
 		View:    articleStatistic.View,
+		RawView: articleStatistic.RawView,
 		Comment: articleStatistic.Comment,
 	}, nil
 }
@@ -439,7 +440,7 @@ func (s *CreationService) SetArticleAgree(ctx context.Context, req *v1.SetArticl
 }
 
 func (s *CreationService) SetArticleView(ctx context.Context, req *v1.SetArticleViewReq) (*emptypb.Empty, error) {
-	err := s.ac.SetArticleView(ctx, req.Id, req.Uuid)
+	err := s.ac.SetArticleView(ctx, req.Id, req.Uuid, req.UserUuid, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -454,6 +455,14 @@ func (s *CreationService) SetArticleViewDbAndCache(ctx context.Context, req *v1.
 	return &emptypb.Empty{}, nil
 }
 
+func (s *CreationService) AddArticleViewDbAndCache(ctx context.Context, req *v1.AddCreationViewDbAndCacheReq) (*emptypb.Empty, error) {
+	err := s.ac.AddArticleViewDbAndCache(ctx, creationViews(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *CreationService) SetArticleAgreeDbAndCache(ctx context.Context, req *v1.SetArticleAgreeDbAndCacheReq) (*emptypb.Empty, error) {
 	err := s.ac.SetArticleAgreeDbAndCache(ctx, req.Id, req.Uuid, req.UserUuid)
 	if err != nil {
@@ -536,7 +536,7 @@ func (s *CreationService) CancelColumnCollectDbAndCache(ctx context.Context, req
 }
 
 func (s *CreationService) SetColumnView(ctx context.Context, req *v1.SetColumnViewReq) (*emptypb.Empty, error) {
-	err := s.coc.SetColumnView(ctx, req.Id, req.Uuid)
+	err := s.coc.SetColumnView(ctx, req.Id, req.Uuid, req.UserUuid, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -551,6 +551,14 @@ func (s *CreationService) SetColumnViewDbAndCache(ctx context.Context, req *v1.S
 	return &emptypb.Empty{}, nil
 }
 
+func (s *CreationService) AddColumnViewDbAndCache(ctx context.Context, req *v1.AddCreationViewDbAndCacheReq) (*emptypb.Empty, error) {
+	err := s.coc.AddColumnViewDbAndCache(ctx, creationViews(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *CreationService) AddColumnIncludes(ctx context.Context, req *v1.AddColumnIncludesReq) (*emptypb.Empty, error) {
 	err := s.coc.AddColumnIncludes(ctx, req.Id, req.ArticleId, req.Uuid)
 	if err != nil {
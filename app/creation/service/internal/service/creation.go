@@ -403,3 +403,63 @@ func (s *CreationService) ReduceCreationComment(ctx context.Context, req *v1.Red
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func creationViews(req *v1.AddCreationViewDbAndCacheReq) []*biz.CreationView {
+	views := make([]*biz.CreationView, 0, len(req.View))
+	for _, item := range req.View {
+		views = append(views, &biz.CreationView{
+			Id:    item.Id,
+			Uuid:  item.Uuid,
+			Count: item.Count,
+			Raw:   item.Raw,
+		})
+	}
+	return views
+}
+
+func (s *CreationService) GetUserData(ctx context.Context, req *v1.GetUserDataReq) (*v1.GetUserDataReply, error) {
+	files, err := s.cc.GetUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetUserDataReply{Files: make([]*v1.GetUserDataReply_File, 0, len(files))}
+	for _, item := range files {
+		reply.Files = append(reply.Files, &v1.GetUserDataReply_File{
+			Name:    item.Name,
+			Content: item.Content,
+		})
+	}
+	return reply, nil
+}
+
+func (s *CreationService) PurgeUserData(ctx context.Context, req *v1.PurgeUserDataReq) (*emptypb.Empty, error) {
+	err := s.cc.PurgeUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CreationService) GetUserTags(ctx context.Context, req *v1.GetUserTagsReq) (*v1.GetUserTagsReply, error) {
+	tags, err := s.cc.GetUserTags(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserTagsReply{Tags: tags}, nil
+}
+
+func (s *CreationService) GetTagAuthors(ctx context.Context, req *v1.GetTagAuthorsReq) (*v1.GetTagAuthorsReply, error) {
+	authors, err := s.cc.GetTagAuthors(ctx, req.Tags, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetTagAuthorsReply{Authors: authors}, nil
+}
+
+func (s *CreationService) GetAuthorActivity(ctx context.Context, req *v1.GetAuthorActivityReq) (*v1.GetAuthorActivityReply, error) {
+	activity, err := s.cc.GetAuthorActivity(ctx, req.Uuids, req.Days)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetAuthorActivityReply{Activity: activity}, nil
+}
@@ -419,7 +419,7 @@ func (s *CreationService) CancelTalkCollectDbAndCache(ctx context.Context, req *
 }
 
 func (s *CreationService) SetTalkView(ctx context.Context, req *v1.SetTalkViewReq) (*emptypb.Empty, error) {
-	err := s.tc.SetTalkView(ctx, req.Id, req.Uuid)
+	err := s.tc.SetTalkView(ctx, req.Id, req.Uuid, req.UserUuid, req.Ip)
 	if err != nil {
 		return nil, err
 	}
@@ -434,6 +434,14 @@ func (s *CreationService) SetTalkViewDbAndCache(ctx context.Context, req *v1.Set
 	return &emptypb.Empty{}, nil
 }
 
+func (s *CreationService) AddTalkViewDbAndCache(ctx context.Context, req *v1.AddCreationViewDbAndCacheReq) (*emptypb.Empty, error) {
+	err := s.tc.AddTalkViewDbAndCache(ctx, creationViews(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *CreationService) SetTalkCollect(ctx context.Context, req *v1.SetTalkCollectReq) (*emptypb.Empty, error) {
 	err := s.tc.SetTalkCollect(ctx, req.Id, req.CollectionsId, req.Uuid, req.UserUuid)
 	if err != nil {
@@ -4,6 +4,8 @@ import (
 	"flag"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/the-zion/matrix-core/app/creation/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"os"
@@ -22,6 +24,11 @@ func NewDB(logger log.Logger) *gorm.DB {
 	if err != nil {
 		l.Fatalf("failed opening connection to db: %v", err)
 	}
+	// raw views were not counted apart before, so they start from the views
+	backfillRawView := !db.Migrator().HasColumn(&data.ArticleStatistic{}, "RawView")
+	if err := event.DropSentOutbox(db); err != nil {
+		l.Fatalf("failed drop sent outbox: %v", err)
+	}
 	if err := db.AutoMigrate(
 		&data.Article{},
 		&data.ArticleReview{},
@@ -56,9 +63,16 @@ func NewDB(logger log.Logger) *gorm.DB {
 		&data.CreationUserVisitor{},
 		&data.TimeLine{},
 		&data.News{},
+		&event.Outbox{},
+		&idempotent.Processed{},
 	); err != nil {
 		l.Fatalf("failed creat or update table resources: %v", err)
 	}
+	if backfillRawView {
+		if err := db.Exec("UPDATE article_statistics SET raw_view = view").Error; err != nil {
+			l.Fatalf("failed backfill article raw view: %v", err)
+		}
+	}
 	return db
 }
 
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/the-zion/matrix-core/app/message/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// deadletter lists the events the message service gave up on and replays
+// them to the matrix topic once the cause is fixed.
+//
+//	deadletter -source ... list -mode create_article_db_cache_and_search
+//	deadletter -source ... -rocketmq 127.0.0.1:9876 replay -id 12
+//	deadletter -source ... -rocketmq 127.0.0.1:9876 replay -mode create_article_db_cache_and_search
+//	deadletter -source ... -transport redis -redis 127.0.0.1:6379 replay -id 12
+var (
+	source    string
+	address   string
+	secretKey string
+	accessKey string
+	nameSpace string
+	groupName string
+	transport string
+	redisAddr string
+	redisPass string
+	redisDb   int
+)
+
+func init() {
+	flag.StringVar(&source, "source",
+		"root:123456@tcp(127.0.0.1:3306)/core?charset=utf8mb4&parseTime=True&loc=Local",
+		"database source, eg: -source source path")
+	flag.StringVar(&address, "rocketmq", "127.0.0.1:9876", "rocketmq name server, eg: -rocketmq 127.0.0.1:9876")
+	flag.StringVar(&secretKey, "secretKey", "", "rocketmq secret key, eg: -secretKey xxx")
+	flag.StringVar(&accessKey, "accessKey", "", "rocketmq access key, eg: -accessKey xxx")
+	flag.StringVar(&nameSpace, "namespace", "", "rocketmq namespace, eg: -namespace xxx")
+	flag.StringVar(&groupName, "group", "matrix-deadletter", "rocketmq producer group, eg: -group xxx")
+	flag.StringVar(&transport, "transport", event.TransportRocketMq, "transport to replay to, eg: -transport redis")
+	flag.StringVar(&redisAddr, "redis", "127.0.0.1:6379", "redis stream server, eg: -redis 127.0.0.1:6379")
+	flag.StringVar(&redisPass, "redisPassword", "", "redis stream password, eg: -redisPassword xxx")
+	flag.IntVar(&redisDb, "redisDb", 0, "redis stream db, eg: -redisDb 0")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] list|replay [-id id] [-mode mode] [-limit n]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+type filter struct {
+	id    uint
+	mode  string
+	limit int
+}
+
+func parseFilter(args []string) *filter {
+	f := &filter{}
+	fs := flag.NewFlagSet(args[0], flag.ExitOnError)
+	fs.UintVar(&f.id, "id", 0, "dead letter id, eg: -id 12")
+	fs.StringVar(&f.mode, "mode", "", "event mode, eg: -mode view")
+	fs.IntVar(&f.limit, "limit", 100, "max dead letters, eg: -limit 100")
+	_ = fs.Parse(args[1:])
+	return f
+}
+
+func find(db *gorm.DB, f *filter) ([]*data.DeadLetter, error) {
+	q := db.Order("id asc").Limit(f.limit)
+	if f.id != 0 {
+		q = q.Where("id = ?", f.id)
+	}
+	if f.mode != "" {
+		q = q.Where("mode = ?", f.mode)
+	}
+	var list []*data.DeadLetter
+	err := q.Find(&list).Error
+	return list, err
+}
+
+func list(db *gorm.DB, f *filter) error {
+	letters, err := find(db, f)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tCREATED\tMODE\tATTEMPTS\tMSG ID\tREASON")
+	for _, dl := range letters {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\n", dl.ID, dl.CreatedAt.Format("2006-01-02 15:04:05"), dl.Mode, dl.Attempts, dl.MsgId, strings.ReplaceAll(dl.Reason, "\n", " "))
+	}
+	return w.Flush()
+}
+
+func newProducer() (*event.Producer, error) {
+	if transport == event.TransportRedis {
+		client := redis.NewClient(&redis.Options{
+			Addr:     redisAddr,
+			DB:       redisDb,
+			Password: redisPass,
+		})
+		if err := client.Ping(context.Background()).Err(); err != nil {
+			return nil, err
+		}
+		return event.NewProducer(event.NewRedisStreamTransport(client, 0)), nil
+	}
+
+	p, err := rocketmq.NewProducer(
+		producer.WithNsResolver(primitive.NewPassthroughResolver([]string{address})),
+		producer.WithCredentials(primitive.Credentials{
+			SecretKey: secretKey,
+			AccessKey: accessKey,
+		}),
+		producer.WithGroupName(groupName),
+		producer.WithNamespace(nameSpace),
+		producer.WithQueueSelector(producer.NewHashQueueSelector()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.Start(); err != nil {
+		return nil, err
+	}
+	return event.NewProducer(event.NewRocketMqTransport(p)), nil
+}
+
+// replay publishes the selected dead letters again and removes each one as
+// soon as it is back on the topic.
+func replay(db *gorm.DB, f *filter, l *log.Helper) error {
+	if f.id == 0 && f.mode == "" {
+		return fmt.Errorf("replay needs -id or -mode")
+	}
+	letters, err := find(db, f)
+	if err != nil {
+		return err
+	}
+
+	p, err := newProducer()
+	if err != nil {
+		return err
+	}
+	defer p.Shutdown()
+
+	for _, dl := range letters {
+		if err = p.Resend(context.Background(), []byte(dl.Body), dl.MsgId); err != nil {
+			return fmt.Errorf("fail to replay dead letter: id(%v), err(%v)", dl.ID, err)
+		}
+		if err = db.Delete(dl).Error; err != nil {
+			return fmt.Errorf("fail to remove replayed dead letter: id(%v), err(%v)", dl.ID, err)
+		}
+		l.Infof("dead letter replayed: id(%v), mode(%s)", dl.ID, dl.Mode)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	logger := log.NewStdLogger(os.Stdout)
+	l := log.NewHelper(log.With(logger, "tool", "deadletter"))
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db, err := gorm.Open(mysql.Open(source), &gorm.Config{})
+	if err != nil {
+		l.Fatalf("failed opening connection to db: %v", err)
+	}
+
+	f := parseFilter(flag.Args())
+	switch flag.Arg(0) {
+	case "list":
+		err = list(db, f)
+	case "replay":
+		err = replay(db, f, l)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		l.Fatal(err)
+	}
+}
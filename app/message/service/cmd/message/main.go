@@ -10,6 +10,9 @@ import (
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/p2c"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/nacos-group/nacos-sdk-go/clients"
@@ -18,6 +21,7 @@ import (
 	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
 	"github.com/the-zion/matrix-core/app/message/service/internal/server"
 	"github.com/the-zion/matrix-core/pkg/kube"
+	"github.com/the-zion/matrix-core/pkg/redact"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"gopkg.in/yaml.v3"
@@ -68,7 +72,7 @@ func init() {
 	flag.StringVar(&logSelect, "log", "default", "log select, eg: -log default")
 }
 
-func newApp(r *nacos.Registry, hs *http.Server, gs *grpc.Server, mq *server.RocketMqConsumerServer) *kratos.App {
+func newApp(r *nacos.Registry, hs *http.Server, gs *grpc.Server, mq transport.Server, cs *server.CounterServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -79,6 +83,7 @@ func newApp(r *nacos.Registry, hs *http.Server, gs *grpc.Server, mq *server.Rock
 			hs,
 			gs,
 			mq,
+			cs,
 		),
 	)
 }
@@ -201,14 +206,15 @@ func loggerInit() {
 		}
 
 		tencentLogger.GetProducer().Start()
-		logger = log.With(tencentLogger, logKv...)
+		logger = log.With(redact.Logger(tencentLogger), logKv...)
 	default:
-		logger = log.With(log.NewStdLogger(os.Stdout), logKv...)
+		logger = log.With(redact.Logger(log.NewStdLogger(os.Stdout)), logKv...)
 	}
 }
 
 func appInit() {
 	var err error
+	selector.SetGlobalSelector(p2c.NewBuilder())
 	app, cleanup, err = wireApp(bootstrap.Config.Server, bootstrap.Config.Data, logger, rclient)
 	if err != nil {
 		panic(err)
@@ -41,18 +41,29 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logLogger log.Logger,
 	bizJwt := data.NewJwt(dataData)
 	userUseCase := biz.NewUserUseCase(userRepo, messageRepo, transaction, bizJwt, logLogger)
 	creationRepo := data.NewCreationRepo(dataData, logLogger)
-	creationUseCase := biz.NewCreationUseCase(creationRepo, messageRepo, transaction, bizJwt, logLogger)
+	moderationRepo := data.NewModerationRepo(dataData, logLogger)
+	mentionUseCase := biz.NewMentionUseCase(messageRepo, creationRepo, transaction, logLogger)
+	creationUseCase := biz.NewCreationUseCase(creationRepo, messageRepo, moderationRepo, mentionUseCase, transaction, bizJwt, logLogger)
 	achievementRepo := data.NewAchievementRepo(dataData, logLogger)
 	commentRepo := data.NewCommentRepo(dataData, logLogger)
 	recovery := data.NewRecovery(dataData)
 	achievementUseCase := biz.NewAchievementUseCase(achievementRepo, creationRepo, commentRepo, recovery, logLogger)
-	commentUseCase := biz.NewCommentUseCase(commentRepo, messageRepo, transaction, bizJwt, logLogger)
+	commentUseCase := biz.NewCommentUseCase(commentRepo, messageRepo, moderationRepo, mentionUseCase, transaction, bizJwt, logLogger)
 	messageUseCase := biz.NewMessageUseCase(messageRepo, recovery, logLogger)
-	messageService := service.NewMessageService(userUseCase, creationUseCase, achievementUseCase, commentUseCase, messageUseCase, logLogger)
+	counterRepo := data.NewCounterRepo(dataData, logLogger)
+	counterUseCase := biz.NewCounterUseCase(confServer, counterRepo, creationRepo, achievementRepo, logLogger)
+	moderationUseCase := biz.NewModerationUseCase(moderationRepo, creationUseCase, commentUseCase, transaction, logLogger)
+	messageService := service.NewMessageService(userUseCase, creationUseCase, achievementUseCase, commentUseCase, messageUseCase, counterUseCase, moderationUseCase, logLogger)
 	httpServer := server.NewHTTPServer(confServer, messageService, logLogger)
 	grpcServer := server.NewGRPCServer(confServer, messageService, logLogger)
-	rocketMqConsumerServer := server.NewRocketMqConsumerServer(confServer, messageService, logLogger)
-	kratosApp := newApp(registry, httpServer, grpcServer, rocketMqConsumerServer)
+	deadLetterRepo := data.NewDeadLetterRepo(dataData, logLogger)
+	deadLetterUseCase := biz.NewDeadLetterUseCase(deadLetterRepo, logLogger)
+	dedupRepo := data.NewDedupRepo(dataData, logLogger)
+	dedupUseCase := biz.NewDedupUseCase(dedupRepo, logLogger)
+	handlerRegistry := service.NewHandlerRegistry(confServer, messageService, deadLetterUseCase, dedupUseCase, logLogger)
+	transportServer := server.NewConsumerServer(confServer, confData, handlerRegistry, logLogger)
+	counterServer := server.NewCounterServer(confServer, counterUseCase, logLogger)
+	kratosApp := newApp(registry, httpServer, grpcServer, transportServer, counterServer)
 	return kratosApp, func() {
 		cleanup2()
 	}, nil
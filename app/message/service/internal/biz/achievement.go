@@ -8,16 +8,11 @@ import (
 )
 
 type AchievementRepo interface {
-	SetAchievementAgree(ctx context.Context, uuid, userUuid string) error
-	CancelAchievementAgree(ctx context.Context, uuid, userUuid string) error
-	SetAchievementView(ctx context.Context, uuid string) error
-	SetAchievementCollect(ctx context.Context, uuid string) error
-	CancelAchievementCollect(ctx context.Context, uuid string) error
 	SetAchievementFollow(ctx context.Context, follow, followed string) error
 	SetUserMedalDbAndCache(ctx context.Context, medal, uuid string) error
 	CancelAchievementFollow(ctx context.Context, follow, followed string) error
 	CancelUserMedalDbAndCache(ctx context.Context, medal, uuid string) error
-	AddAchievementScore(ctx context.Context, uuid string, score int32) error
+	AddAchievementDbAndCache(ctx context.Context, achievements []*AchievementDelta, actives []*ActiveDelta) error
 	GetUserAchievement(ctx context.Context, uuid string) (int32, int32, error)
 	GetUserActive(ctx context.Context, uuid string) (int32, error)
 	AccessUserMedalDbAndCache(ctx context.Context, medal, uuid string) error
@@ -41,30 +36,10 @@ func NewAchievementUseCase(repo AchievementRepo, creationRepo CreationRepo, comm
 	}
 }
 
-func (r *AchievementUseCase) SetAchievementAgree(ctx context.Context, uuid, userUuid string) error {
-	return r.repo.SetAchievementAgree(ctx, uuid, userUuid)
-}
-
-func (r *AchievementUseCase) CancelAchievementAgree(ctx context.Context, uuid, userUuid string) error {
-	return r.repo.CancelAchievementAgree(ctx, uuid, userUuid)
-}
-
-func (r *AchievementUseCase) SetAchievementView(ctx context.Context, uuid string) error {
-	return r.repo.SetAchievementView(ctx, uuid)
-}
-
-func (r *AchievementUseCase) SetAchievementCollect(ctx context.Context, uuid string) error {
-	return r.repo.SetAchievementCollect(ctx, uuid)
-}
-
 func (r *AchievementUseCase) SetUserMedalDbAndCache(ctx context.Context, medal, uuid string) error {
 	return r.repo.SetUserMedalDbAndCache(ctx, medal, uuid)
 }
 
-func (r *AchievementUseCase) CancelAchievementCollect(ctx context.Context, uuid string) error {
-	return r.repo.CancelAchievementCollect(ctx, uuid)
-}
-
 func (r *AchievementUseCase) SetAchievementFollow(ctx context.Context, follow, followed string) error {
 	return r.repo.SetAchievementFollow(ctx, follow, followed)
 }
@@ -240,7 +215,3 @@ func (r *AchievementUseCase) getUserMedalProgress(ctx context.Context, uuid stri
 	}
 	return m, nil
 }
-
-func (r *AchievementUseCase) AddAchievementScore(ctx context.Context, uuid string, score int32) error {
-	return r.repo.AddAchievementScore(ctx, uuid, score)
-}
@@ -5,7 +5,7 @@ import (
 	"github.com/google/wire"
 )
 
-var ProviderSet = wire.NewSet(NewUserUseCase, NewCreationUseCase, NewAchievementUseCase, NewCommentUseCase, NewMessageUseCase)
+var ProviderSet = wire.NewSet(NewUserUseCase, NewCreationUseCase, NewAchievementUseCase, NewCommentUseCase, NewMessageUseCase, NewMentionUseCase, NewModerationUseCase, NewDeadLetterUseCase, NewDedupUseCase, NewCounterUseCase)
 
 type Jwt interface {
 	JwtCheck(token string) (string, error)
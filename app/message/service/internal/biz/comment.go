@@ -30,20 +30,24 @@ type CommentRepo interface {
 }
 
 type CommentUseCase struct {
-	repo        CommentRepo
-	messageRepo MessageRepo
-	tm          Transaction
-	jwt         Jwt
-	log         *log.Helper
+	repo           CommentRepo
+	messageRepo    MessageRepo
+	moderationRepo ModerationRepo
+	mention        *MentionUseCase
+	tm             Transaction
+	jwt            Jwt
+	log            *log.Helper
 }
 
-func NewCommentUseCase(repo CommentRepo, messageRepo MessageRepo, tm Transaction, jwt Jwt, logger log.Logger) *CommentUseCase {
+func NewCommentUseCase(repo CommentRepo, messageRepo MessageRepo, moderationRepo ModerationRepo, mention *MentionUseCase, tm Transaction, jwt Jwt, logger log.Logger) *CommentUseCase {
 	return &CommentUseCase{
-		repo:        repo,
-		messageRepo: messageRepo,
-		tm:          tm,
-		jwt:         jwt,
-		log:         log.NewHelper(log.With(logger, "module", "message/biz/commentUseCase")),
+		repo:           repo,
+		messageRepo:    messageRepo,
+		moderationRepo: moderationRepo,
+		mention:        mention,
+		tm:             tm,
+		jwt:            jwt,
+		log:            log.NewHelper(log.With(logger, "module", "message/biz/commentUseCase")),
 	}
 }
 
@@ -121,11 +125,23 @@ func (r *CommentUseCase) CommentCreateReview(ctx context.Context, tr *TextReview
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.CommentCreateReviewPass(ctx, int32(aid), int32(cid), int32(cType), uuid)
+		err = r.commentCreatePass(ctx, int32(aid), int32(cid), int32(cType), uuid, comment)
 		if err != nil {
 			return err
 		}
 	} else {
+		err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+			Kind:         "comment",
+			Mode:         "create",
+			ContentId:    int32(aid),
+			CreationId:   int32(cid),
+			CreationType: int32(cType),
+			Uuid:         uuid,
+			Text:         comment,
+		})
+		if err != nil {
+			return err
+		}
 		err = r.repo.CommentContentIrregular(ctx, tr, int32(aid), comment, kind, uuid)
 		if err != nil {
 			return err
@@ -204,11 +220,23 @@ func (r *CommentUseCase) SubCommentCreateReview(ctx context.Context, tr *TextRev
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.SubCommentCreateReviewPass(ctx, int32(aid), int32(rid), int32(pid), uuid)
+		err = r.subCommentCreatePass(ctx, int32(aid), int32(rid), int32(pid), uuid, comment)
 		if err != nil {
 			return err
 		}
 	} else {
+		err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+			Kind:      "subComment",
+			Mode:      "create",
+			ContentId: int32(aid),
+			RootId:    int32(rid),
+			ParentId:  int32(pid),
+			Uuid:      uuid,
+			Text:      comment,
+		})
+		if err != nil {
+			return err
+		}
 		err = r.repo.CommentContentIrregular(ctx, tr, int32(aid), comment, kind, uuid)
 		if err != nil {
 			return err
@@ -217,12 +245,43 @@ func (r *CommentUseCase) SubCommentCreateReview(ctx context.Context, tr *TextRev
 	return nil
 }
 
+func (r *CommentUseCase) commentCreatePass(ctx context.Context, id, creationId, creationType int32, uuid, comment string) error {
+	err := r.repo.CommentCreateReviewPass(ctx, id, creationId, creationType, uuid)
+	if err != nil {
+		return err
+	}
+	r.mention.Notify(ctx, &Mention{
+		ContentId:    id,
+		Kind:         "comment",
+		CreationId:   creationId,
+		CreationType: creationType,
+		Uid:          uuid,
+		Text:         mentionText(comment),
+	}, comment)
+	return nil
+}
+
+func (r *CommentUseCase) subCommentCreatePass(ctx context.Context, id, rootId, parentId int32, uuid, comment string) error {
+	err := r.repo.SubCommentCreateReviewPass(ctx, id, rootId, parentId, uuid)
+	if err != nil {
+		return err
+	}
+	r.mention.Notify(ctx, &Mention{
+		ContentId: id,
+		Kind:      "subComment",
+		RootId:    rootId,
+		Uid:       uuid,
+		Text:      mentionText(comment),
+	}, comment)
+	return nil
+}
+
 func (r *CommentUseCase) CreateCommentDbAndCache(ctx context.Context, id, createId, createType int32, uuid string) error {
 	author, err := r.repo.CreateCommentDbAndCache(ctx, id, createId, createType, uuid)
 	if err != nil {
 		return err
 	}
-	if author != "" && author != uuid {
+	if author != "" && author != uuid && !r.silenced(ctx, author, uuid) {
 		r.repo.SetCommentCount(ctx, author)
 	}
 	return nil
@@ -233,17 +292,29 @@ func (r *CommentUseCase) CreateSubCommentDbAndCache(ctx context.Context, id, roo
 	if err != nil {
 		return err
 	}
-	if root != "" && root != uuid {
+	if root != "" && root != uuid && !r.silenced(ctx, root, uuid) {
 		r.repo.SetSubCommentCount(ctx, root)
 	}
 
-	if parent != "" && root != parent && parent != uuid {
+	if parent != "" && root != parent && parent != uuid && !r.silenced(ctx, parent, uuid) {
 		r.repo.SetSubCommentCount(ctx, parent)
 	}
 
 	return nil
 }
 
+// silenced reports whether the comments of userUuid are kept out of the
+// mailbox of uuid, which a block or mute between them does. The mailbox
+// count is best effort, so a failed lookup counts the comment.
+func (r *CommentUseCase) silenced(ctx context.Context, uuid, userUuid string) bool {
+	silenced, err := r.messageRepo.GetUserBlock(ctx, uuid, userUuid)
+	if err != nil {
+		r.log.Errorf("fail to get user block: error(%v), uuid(%s), userUuid(%s)", err, uuid, userUuid)
+		return false
+	}
+	return silenced
+}
+
 func (r *CommentUseCase) RemoveCommentDbAndCache(ctx context.Context, id int32, uuid string) error {
 	return r.repo.RemoveCommentDbAndCache(ctx, id, uuid)
 }
@@ -0,0 +1,286 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const defaultCounterMaxKeys = 5000
+
+const (
+	CounterArticle = "article"
+	CounterTalk    = "talk"
+	CounterColumn  = "column"
+)
+
+type CounterRepo interface {
+	AddCounter(ctx context.Context, key string, deltas map[string]int64) (int64, error)
+	NewCounterBatch(ctx context.Context) error
+	GetCounterBatchList(ctx context.Context) ([]string, error)
+	GetCounterBatch(ctx context.Context, batch string) (map[string]int64, error)
+	RemoveCounterBatch(ctx context.Context, batch string) error
+}
+
+// CounterUseCase stages the deltas of view, agree, collect and score counters
+// per entity and flushes them in batches, so a hot creation costs one update
+// per window instead of one per event. A delta is staged before its event is
+// acknowledged and a batch is removed only once all of it is written through,
+// so neither a crash nor a failed write loses deltas.
+type CounterUseCase struct {
+	repo            CounterRepo
+	creationRepo    CreationRepo
+	achievementRepo AchievementRepo
+	maxKeys         int64
+	full            chan struct{}
+	flushing        sync.Mutex
+	log             *log.Helper
+}
+
+func NewCounterUseCase(conf *conf.Server, repo CounterRepo, creationRepo CreationRepo, achievementRepo AchievementRepo, logger log.Logger) *CounterUseCase {
+	maxKeys := int64(conf.Counter.GetMaxKeys())
+	if maxKeys <= 0 {
+		maxKeys = defaultCounterMaxKeys
+	}
+	return &CounterUseCase{
+		repo:            repo,
+		creationRepo:    creationRepo,
+		achievementRepo: achievementRepo,
+		maxKeys:         maxKeys,
+		full:            make(chan struct{}, 1),
+		log:             log.NewHelper(log.With(logger, "module", "message/biz/counterUseCase")),
+	}
+}
+
+// AddView counts one view of the creation id of kind written by uuid. A view
+// that is not a repeat of its viewer also counts as unique and earns the
+// author a view and a point of score.
+func (r *CounterUseCase) AddView(ctx context.Context, kind string, id int32, uuid string, repeat bool) error {
+	deltas := map[string]int64{}
+	prefix := fmt.Sprintf("view:%s:%v:%s:", kind, id, uuid)
+	deltas[prefix+"raw"] = 1
+	if !repeat {
+		deltas[prefix+"count"] = 1
+		deltas["achievement:"+uuid+":view"] = 1
+		deltas["achievement:"+uuid+":score"] = 1
+	}
+	return r.add(ctx, deltas)
+}
+
+// AddAchievement changes the achievement counters of delta.Uuid by delta.
+func (r *CounterUseCase) AddAchievement(ctx context.Context, delta *AchievementDelta) error {
+	prefix := "achievement:" + delta.Uuid + ":"
+	return r.add(ctx, map[string]int64{
+		prefix + "agree":   int64(delta.Agree),
+		prefix + "view":    int64(delta.View),
+		prefix + "collect": int64(delta.Collect),
+		prefix + "score":   int64(delta.Score),
+	})
+}
+
+// AddAgree changes the agrees the creations of uuid got and, unless userUuid
+// is empty, the agrees userUuid gave by n.
+func (r *CounterUseCase) AddAgree(ctx context.Context, uuid, userUuid string, n int32) error {
+	deltas := map[string]int64{
+		"achievement:" + uuid + ":agree": int64(n),
+	}
+	if userUuid != "" {
+		deltas["active:"+userUuid+":agree"] = int64(n)
+	}
+	return r.add(ctx, deltas)
+}
+
+// Full is signalled once more keys are staged than configured.
+func (r *CounterUseCase) Full() <-chan struct{} {
+	return r.full
+}
+
+// Close flushes what is staged. Deltas staged afterwards, by events still in
+// flight during shutdown, are flushed by the next process.
+func (r *CounterUseCase) Close(ctx context.Context) error {
+	return r.Flush(ctx)
+}
+
+// Flush moves the staged deltas into a batch and writes through every batch
+// not written yet, those left over by a failed flush of any process included.
+// A batch is written under its id as the idempotency key, so the part of it a
+// failed flush wrote is not written again.
+func (r *CounterUseCase) Flush(ctx context.Context) error {
+	r.flushing.Lock()
+	defer r.flushing.Unlock()
+
+	err := r.repo.NewCounterBatch(ctx)
+	if err != nil {
+		r.log.Errorf("fail to stage counter batch: err(%v)", err)
+		return err
+	}
+	batchList, err := r.repo.GetCounterBatchList(ctx)
+	if err != nil {
+		r.log.Errorf("fail to get counter batch list: err(%v)", err)
+		return err
+	}
+	sort.Strings(batchList)
+
+	var failed error
+	for _, batch := range batchList {
+		err = r.flushBatch(ctx, batch)
+		if err != nil {
+			r.log.Errorf("fail to flush counter batch: batch(%s), err(%v)", batch, err)
+			failed = err
+		}
+	}
+	return failed
+}
+
+func (r *CounterUseCase) flushBatch(ctx context.Context, batch string) error {
+	deltas, err := r.repo.GetCounterBatch(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	views, achievements, actives := r.parse(deltas)
+	ctx = idempotent.NewContext(ctx, batch)
+	for _, kind := range []string{CounterArticle, CounterTalk, CounterColumn} {
+		if len(views[kind]) == 0 {
+			continue
+		}
+		err = r.flushViews(ctx, kind, views[kind])
+		if err != nil {
+			return err
+		}
+	}
+	if len(achievements) > 0 || len(actives) > 0 {
+		err = r.achievementRepo.AddAchievementDbAndCache(ctx, achievements, actives)
+		if err != nil {
+			return err
+		}
+	}
+	return r.repo.RemoveCounterBatch(ctx, batch)
+}
+
+func (r *CounterUseCase) flushViews(ctx context.Context, kind string, views []*CreationView) error {
+	switch kind {
+	case CounterArticle:
+		return r.creationRepo.AddArticleViewDbAndCache(ctx, views)
+	case CounterTalk:
+		return r.creationRepo.AddTalkViewDbAndCache(ctx, views)
+	default:
+		return r.creationRepo.AddColumnViewDbAndCache(ctx, views)
+	}
+}
+
+// add stages deltas once per idempotency key of ctx.
+func (r *CounterUseCase) add(ctx context.Context, deltas map[string]int64) error {
+	for field, delta := range deltas {
+		if delta == 0 {
+			delete(deltas, field)
+		}
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+	keys, err := r.repo.AddCounter(ctx, idempotent.Child(ctx, "counter"), deltas)
+	if err != nil {
+		return err
+	}
+	if keys >= r.maxKeys {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// parse turns the staged fields of a batch back into the deltas of each
+// entity, ordered so that a batch written again makes the same requests.
+func (r *CounterUseCase) parse(deltas map[string]int64) (map[string][]*CreationView, []*AchievementDelta, []*ActiveDelta) {
+	views := map[string]map[int32]*CreationView{}
+	achievements := map[string]*AchievementDelta{}
+	actives := map[string]*ActiveDelta{}
+	for field, delta := range deltas {
+		parts := strings.Split(field, ":")
+		n := int32(delta)
+		switch {
+		case parts[0] == "view" && len(parts) == 5:
+			id, err := strconv.ParseInt(parts[2], 10, 32)
+			if err != nil {
+				r.log.Errorf("fail to parse counter field: field(%s)", field)
+				continue
+			}
+			byId, ok := views[parts[1]]
+			if !ok {
+				byId = map[int32]*CreationView{}
+				views[parts[1]] = byId
+			}
+			item, ok := byId[int32(id)]
+			if !ok {
+				item = &CreationView{Id: int32(id), Uuid: parts[3]}
+				byId[int32(id)] = item
+			}
+			if parts[4] == "count" {
+				item.Count += n
+			} else {
+				item.Raw += n
+			}
+		case parts[0] == "achievement" && len(parts) == 3:
+			item, ok := achievements[parts[1]]
+			if !ok {
+				item = &AchievementDelta{Uuid: parts[1]}
+				achievements[parts[1]] = item
+			}
+			switch parts[2] {
+			case "agree":
+				item.Agree += n
+			case "view":
+				item.View += n
+			case "collect":
+				item.Collect += n
+			case "score":
+				item.Score += n
+			}
+		case parts[0] == "active" && len(parts) == 3:
+			item, ok := actives[parts[1]]
+			if !ok {
+				item = &ActiveDelta{Uuid: parts[1]}
+				actives[parts[1]] = item
+			}
+			item.Agree += n
+		default:
+			r.log.Errorf("fail to parse counter field: field(%s)", field)
+		}
+	}
+
+	viewList := make(map[string][]*CreationView, len(views))
+	for kind, byId := range views {
+		list := make([]*CreationView, 0, len(byId))
+		for _, item := range byId {
+			list = append(list, item)
+		}
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].Id < list[j].Id
+		})
+		viewList[kind] = list
+	}
+	achievementList := make([]*AchievementDelta, 0, len(achievements))
+	for _, item := range achievements {
+		achievementList = append(achievementList, item)
+	}
+	sort.Slice(achievementList, func(i, j int) bool {
+		return achievementList[i].Uuid < achievementList[j].Uuid
+	})
+	activeList := make([]*ActiveDelta, 0, len(actives))
+	for _, item := range actives {
+		activeList = append(activeList, item)
+	}
+	sort.Slice(activeList, func(i, j int) bool {
+		return activeList[i].Uuid < activeList[j].Uuid
+	})
+	return viewList, achievementList, activeList
+}
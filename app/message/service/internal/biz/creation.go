@@ -20,7 +20,8 @@ type CreationRepo interface {
 	EditArticleCosAndSearch(ctx context.Context, id, auth int32, uuid string) error
 	DeleteArticleCacheAndSearch(ctx context.Context, id int32, uuid string) error
 	SetCreationUpdateTime(ctx context.Context, uuid string)
-	SetArticleViewDbAndCache(ctx context.Context, id int32, uuid string) error
+	GetCreationText(ctx context.Context, object string) (string, error)
+	AddArticleViewDbAndCache(ctx context.Context, views []*CreationView) error
 	SetArticleAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
 	SetArticleCollectDbAndCache(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
 	CancelArticleAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
@@ -39,7 +40,7 @@ type CreationRepo interface {
 	AddTalkContentReviewDbAndCache(ctx context.Context, creationId, result int32, uuid, jobId, label, title, kind string, section string) error
 	EditTalkCosAndSearch(ctx context.Context, id, auth int32, uuid string) error
 	DeleteTalkCacheAndSearch(ctx context.Context, id int32, uuid string) error
-	SetTalkViewDbAndCache(ctx context.Context, id int32, uuid string) error
+	AddTalkViewDbAndCache(ctx context.Context, views []*CreationView) error
 	SetTalkAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
 	CancelTalkAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
 	SetTalkCollectDbAndCache(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
@@ -55,7 +56,7 @@ type CreationRepo interface {
 	EditColumnCosAndSearch(ctx context.Context, id, auth int32, uuid string) error
 	DeleteColumnCacheAndSearch(ctx context.Context, id int32, uuid string) error
 	SetColumnAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
-	SetColumnViewDbAndCache(ctx context.Context, id int32, uuid string) error
+	AddColumnViewDbAndCache(ctx context.Context, views []*CreationView) error
 	CancelColumnAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
 	SetColumnCollectDbAndCache(ctx context.Context, id, collectionsId int32, uuid, userUuid string) error
 	CancelColumnCollectDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error
@@ -80,20 +81,24 @@ type CreationRepo interface {
 }
 
 type CreationUseCase struct {
-	repo        CreationRepo
-	messageRepo MessageRepo
-	tm          Transaction
-	jwt         Jwt
-	log         *log.Helper
+	repo           CreationRepo
+	messageRepo    MessageRepo
+	moderationRepo ModerationRepo
+	mention        *MentionUseCase
+	tm             Transaction
+	jwt            Jwt
+	log            *log.Helper
 }
 
-func NewCreationUseCase(repo CreationRepo, messageRepo MessageRepo, tm Transaction, jwt Jwt, logger log.Logger) *CreationUseCase {
+func NewCreationUseCase(repo CreationRepo, messageRepo MessageRepo, moderationRepo ModerationRepo, mention *MentionUseCase, tm Transaction, jwt Jwt, logger log.Logger) *CreationUseCase {
 	return &CreationUseCase{
-		repo:        repo,
-		messageRepo: messageRepo,
-		tm:          tm,
-		jwt:         jwt,
-		log:         log.NewHelper(log.With(logger, "module", "message/biz/creationUseCase")),
+		repo:           repo,
+		messageRepo:    messageRepo,
+		moderationRepo: moderationRepo,
+		mention:        mention,
+		tm:             tm,
+		jwt:            jwt,
+		log:            log.NewHelper(log.With(logger, "module", "message/biz/creationUseCase")),
 	}
 }
 
@@ -161,12 +166,22 @@ func (r *CreationUseCase) ArticleCreateReview(ctx context.Context, tr *TextRevie
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.ArticleCreateReviewPass(ctx, int32(aid), int32(auth), uuid)
+		err = r.articleCreatePass(ctx, int32(aid), int32(auth), uuid, title, tr.Object)
 		if err != nil {
 			return err
 		}
-		r.repo.SetCreationUpdateTime(ctx, uuid)
 	} else {
+		err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+			Kind:      "article",
+			Mode:      "create",
+			ContentId: int32(aid),
+			Auth:      int32(auth),
+			Uuid:      uuid,
+			Text:      title,
+		})
+		if err != nil {
+			return err
+		}
 		err = r.repo.ArticleContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 		if err != nil {
 			return err
@@ -175,6 +190,25 @@ func (r *CreationUseCase) ArticleCreateReview(ctx context.Context, tr *TextRevie
 	return nil
 }
 
+func (r *CreationUseCase) articleCreatePass(ctx context.Context, id, auth int32, uuid, title, object string) error {
+	err := r.repo.ArticleCreateReviewPass(ctx, id, auth, uuid)
+	if err != nil {
+		return err
+	}
+	r.repo.SetCreationUpdateTime(ctx, uuid)
+	if auth != 2 {
+		r.mention.NotifyObject(ctx, &Mention{
+			ContentId:    id,
+			Kind:         "article",
+			CreationId:   id,
+			CreationType: 1,
+			Uid:          uuid,
+			Text:         mentionText(title),
+		}, object)
+	}
+	return nil
+}
+
 func (r *CreationUseCase) ArticleEditReview(ctx context.Context, tr *TextReview) error {
 	var err error
 	var token, id, auths, title, kind string
@@ -231,14 +265,21 @@ func (r *CreationUseCase) ArticleEditReview(ctx context.Context, tr *TextReview)
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.ArticleEditReviewPass(ctx, int32(aid), int32(auth), uuid)
-	} else {
-		err = r.repo.ArticleContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
+		return r.repo.ArticleEditReviewPass(ctx, int32(aid), int32(auth), uuid)
 	}
+
+	err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+		Kind:      "article",
+		Mode:      "edit",
+		ContentId: int32(aid),
+		Auth:      int32(auth),
+		Uuid:      uuid,
+		Text:      title,
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.repo.ArticleContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 }
 
 func (r *CreationUseCase) ArticleImageReview(ctx context.Context, ar *ImageReview) error {
@@ -304,10 +345,6 @@ func (r *CreationUseCase) DeleteArticleCacheAndSearch(ctx context.Context, id in
 	return r.repo.DeleteArticleCacheAndSearch(ctx, id, uuid)
 }
 
-func (r *CreationUseCase) SetArticleViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	return r.repo.SetArticleViewDbAndCache(ctx, id, uuid)
-}
-
 func (r *CreationUseCase) SetArticleAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error {
 	return r.repo.SetArticleAgreeDbAndCache(ctx, id, uuid, userUuid)
 }
@@ -434,12 +471,22 @@ func (r *CreationUseCase) TalkCreateReview(ctx context.Context, tr *TextReview)
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.TalkCreateReviewPass(ctx, int32(aid), int32(auth), uuid)
+		err = r.talkCreatePass(ctx, int32(aid), int32(auth), uuid, title, tr.Object)
 		if err != nil {
 			return err
 		}
-		r.repo.SetCreationUpdateTime(ctx, uuid)
 	} else {
+		err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+			Kind:      "talk",
+			Mode:      "create",
+			ContentId: int32(aid),
+			Auth:      int32(auth),
+			Uuid:      uuid,
+			Text:      title,
+		})
+		if err != nil {
+			return err
+		}
 		err = r.repo.TalkContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 		if err != nil {
 			return err
@@ -448,6 +495,25 @@ func (r *CreationUseCase) TalkCreateReview(ctx context.Context, tr *TextReview)
 	return nil
 }
 
+func (r *CreationUseCase) talkCreatePass(ctx context.Context, id, auth int32, uuid, title, object string) error {
+	err := r.repo.TalkCreateReviewPass(ctx, id, auth, uuid)
+	if err != nil {
+		return err
+	}
+	r.repo.SetCreationUpdateTime(ctx, uuid)
+	if auth != 2 {
+		r.mention.NotifyObject(ctx, &Mention{
+			ContentId:    id,
+			Kind:         "talk",
+			CreationId:   id,
+			CreationType: 3,
+			Uid:          uuid,
+			Text:         mentionText(title),
+		}, object)
+	}
+	return nil
+}
+
 func (r *CreationUseCase) TalkEditReview(ctx context.Context, tr *TextReview) error {
 	var err error
 	var token, id, auths, title, kind string
@@ -504,14 +570,21 @@ func (r *CreationUseCase) TalkEditReview(ctx context.Context, tr *TextReview) er
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.TalkEditReviewPass(ctx, int32(aid), int32(auth), uuid)
-	} else {
-		err = r.repo.TalkContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
+		return r.repo.TalkEditReviewPass(ctx, int32(aid), int32(auth), uuid)
 	}
+
+	err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+		Kind:      "talk",
+		Mode:      "edit",
+		ContentId: int32(aid),
+		Auth:      int32(auth),
+		Uuid:      uuid,
+		Text:      title,
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.repo.TalkContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 }
 
 func (r *CreationUseCase) TalkImageReview(ctx context.Context, ar *ImageReview) error {
@@ -577,10 +650,6 @@ func (r *CreationUseCase) DeleteTalkCacheAndSearch(ctx context.Context, id int32
 	return r.repo.DeleteTalkCacheAndSearch(ctx, id, uuid)
 }
 
-func (r *CreationUseCase) SetTalkViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	return r.repo.SetTalkViewDbAndCache(ctx, id, uuid)
-}
-
 func (r *CreationUseCase) SetTalkAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error {
 	return r.repo.SetTalkAgreeDbAndCache(ctx, id, uuid, userUuid)
 }
@@ -707,12 +776,22 @@ func (r *CreationUseCase) ColumnCreateReview(ctx context.Context, tr *TextReview
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.ColumnCreateReviewPass(ctx, int32(aid), int32(auth), uuid)
+		err = r.columnCreatePass(ctx, int32(aid), int32(auth), uuid)
 		if err != nil {
 			return err
 		}
-		r.repo.SetCreationUpdateTime(ctx, uuid)
 	} else {
+		err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+			Kind:      "column",
+			Mode:      "create",
+			ContentId: int32(aid),
+			Auth:      int32(auth),
+			Uuid:      uuid,
+			Text:      title,
+		})
+		if err != nil {
+			return err
+		}
 		err = r.repo.ColumnContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 		if err != nil {
 			return err
@@ -721,6 +800,15 @@ func (r *CreationUseCase) ColumnCreateReview(ctx context.Context, tr *TextReview
 	return nil
 }
 
+func (r *CreationUseCase) columnCreatePass(ctx context.Context, id, auth int32, uuid string) error {
+	err := r.repo.ColumnCreateReviewPass(ctx, id, auth, uuid)
+	if err != nil {
+		return err
+	}
+	r.repo.SetCreationUpdateTime(ctx, uuid)
+	return nil
+}
+
 func (r *CreationUseCase) ColumnEditReview(ctx context.Context, tr *TextReview) error {
 	var err error
 	var token, id, auths, title, kind string
@@ -777,14 +865,21 @@ func (r *CreationUseCase) ColumnEditReview(ctx context.Context, tr *TextReview)
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.ColumnEditReviewPass(ctx, int32(aid), int32(auth), uuid)
-	} else {
-		err = r.repo.ColumnContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
+		return r.repo.ColumnEditReviewPass(ctx, int32(aid), int32(auth), uuid)
 	}
+
+	err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+		Kind:      "column",
+		Mode:      "edit",
+		ContentId: int32(aid),
+		Auth:      int32(auth),
+		Uuid:      uuid,
+		Text:      title,
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.repo.ColumnContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 }
 
 func (r *CreationUseCase) ColumnImageReview(ctx context.Context, ar *ImageReview) error {
@@ -850,10 +945,6 @@ func (r *CreationUseCase) DeleteColumnCacheAndSearch(ctx context.Context, id int
 	return r.repo.DeleteColumnCacheAndSearch(ctx, id, uuid)
 }
 
-func (r *CreationUseCase) SetColumnViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	return r.repo.SetColumnViewDbAndCache(ctx, id, uuid)
-}
-
 func (r *CreationUseCase) SetColumnAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error {
 	return r.repo.SetColumnAgreeDbAndCache(ctx, id, uuid, userUuid)
 }
@@ -988,14 +1079,21 @@ func (r *CreationUseCase) CollectionsCreateReview(ctx context.Context, tr *TextR
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.CollectionsCreateReviewPass(ctx, int32(aid), int32(auth), uuid)
-	} else {
-		err = r.repo.CollectionsContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
+		return r.repo.CollectionsCreateReviewPass(ctx, int32(aid), int32(auth), uuid)
 	}
+
+	err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+		Kind:      "collections",
+		Mode:      "create",
+		ContentId: int32(aid),
+		Auth:      int32(auth),
+		Uuid:      uuid,
+		Text:      title,
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.repo.CollectionsContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 }
 
 func (r *CreationUseCase) CollectionsEditReview(ctx context.Context, tr *TextReview) error {
@@ -1054,14 +1152,21 @@ func (r *CreationUseCase) CollectionsEditReview(ctx context.Context, tr *TextRev
 	}
 
 	if tr.Result == 0 {
-		err = r.repo.CollectionsEditReviewPass(ctx, int32(aid), int32(auth), uuid)
-	} else {
-		err = r.repo.CollectionsContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
+		return r.repo.CollectionsEditReviewPass(ctx, int32(aid), int32(auth), uuid)
 	}
+
+	err = addContentFlag(ctx, r.moderationRepo, tr, &ContentFlag{
+		Kind:      "collections",
+		Mode:      "edit",
+		ContentId: int32(aid),
+		Auth:      int32(auth),
+		Uuid:      uuid,
+		Text:      title,
+	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.repo.CollectionsContentIrregular(ctx, tr, int32(aid), title, kind, uuid)
 }
 
 func (r *CreationUseCase) CreateCollectionsDbAndCache(ctx context.Context, id, auth int32, uuid string) error {
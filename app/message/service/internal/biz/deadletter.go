@@ -0,0 +1,26 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type DeadLetterRepo interface {
+	AddDeadLetter(ctx context.Context, dl *DeadLetter) error
+}
+
+type DeadLetterUseCase struct {
+	repo DeadLetterRepo
+	log  *log.Helper
+}
+
+func NewDeadLetterUseCase(repo DeadLetterRepo, logger log.Logger) *DeadLetterUseCase {
+	return &DeadLetterUseCase{
+		repo: repo,
+		log:  log.NewHelper(log.With(logger, "module", "message/biz/deadLetterUseCase")),
+	}
+}
+
+func (r *DeadLetterUseCase) AddDeadLetter(ctx context.Context, dl *DeadLetter) error {
+	return r.repo.AddDeadLetter(ctx, dl)
+}
@@ -0,0 +1,48 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+)
+
+type DedupRepo interface {
+	GetProcessedEvent(ctx context.Context, key string) (bool, error)
+	AddProcessedEvent(ctx context.Context, key, mode string) (bool, error)
+}
+
+type DedupUseCase struct {
+	repo DedupRepo
+	log  *log.Helper
+}
+
+func NewDedupUseCase(repo DedupRepo, logger log.Logger) *DedupUseCase {
+	return &DedupUseCase{
+		repo: repo,
+		log:  log.NewHelper(log.With(logger, "module", "message/biz/dedupUseCase")),
+	}
+}
+
+// Once runs fn unless the event identified by key has been processed before,
+// and records the key once fn succeeds. fn runs under key, which the calls and
+// transactions it makes derive their own keys from, so that a delivery after
+// a partial failure, or a duplicate delivered meanwhile, repeats none of the
+// side effects committed already. No transaction is held open across fn.
+func (r *DedupUseCase) Once(ctx context.Context, key, mode string, fn func(ctx context.Context) error) error {
+	processed, err := r.repo.GetProcessedEvent(ctx, key)
+	if err != nil {
+		return err
+	}
+	if processed {
+		r.log.Infof("skip duplicate event: key(%s), mode(%s)", key, mode)
+		return nil
+	}
+
+	err = fn(idempotent.NewContext(ctx, key))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.repo.AddProcessedEvent(ctx, key, mode)
+	return err
+}
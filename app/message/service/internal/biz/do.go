@@ -99,6 +99,7 @@ type Notification struct {
 	Comment            int32
 	SubComment         int32
 	SystemNotification int32
+	Mention            int32
 }
 
 //easyjson:json
@@ -116,3 +117,93 @@ type SystemNotification struct {
 	Text             string
 	Comment          string
 }
+
+//easyjson:json
+type Mention struct {
+	Id           int32
+	ContentId    int32
+	CreatedAt    string
+	Kind         string
+	CreationId   int32
+	CreationType int32
+	RootId       int32
+	Uid          string
+	Uuid         string
+	Text         string
+}
+
+// ContentFlag is a creation or comment the review found irregular, with what
+// its review pass needs to publish it anyway. Kind is the kind of the
+// content, Mode the review, create or edit, and Object the text in the cos.
+type ContentFlag struct {
+	Id           int32
+	Kind         string
+	Mode         string
+	ContentId    int32
+	Auth         int32
+	CreationId   int32
+	CreationType int32
+	RootId       int32
+	ParentId     int32
+	Uuid         string
+	Text         string
+	Object       string
+	JobId        string
+	Label        string
+	Section      string
+	Status       int32
+	CreatedAt    int64
+}
+
+// Appeal is the request of an author to publish the content of a flag, listed
+// with the flag it was made against.
+type Appeal struct {
+	Id        int32
+	FlagId    int32
+	JobId     string
+	Uuid      string
+	Reason    string
+	Status    int32
+	CreatedAt int64
+	Flag      *ContentFlag
+}
+
+type DeadLetter struct {
+	Id        int32
+	MsgId     string
+	Mode      string
+	Body      string
+	Reason    string
+	Attempts  int32
+	CreatedAt string
+}
+
+// CreationView is the number of unique and raw views a creation got over a
+// counter window.
+type CreationView struct {
+	Id    int32
+	Uuid  string
+	Count int32
+	Raw   int32
+}
+
+// AchievementDelta is the change of the achievement counters of a user over a
+// counter window.
+type AchievementDelta struct {
+	Uuid    string
+	Agree   int32
+	View    int32
+	Collect int32
+	Score   int32
+}
+
+// ActiveDelta is the change of the agrees a user gave over a counter window.
+type ActiveDelta struct {
+	Uuid  string
+	Agree int32
+}
+
+type UserDataFile struct {
+	Name    string
+	Content []byte
+}
@@ -160,3 +160,132 @@ func (v *SystemNotification) UnmarshalJSON(data []byte) error {
 func (v *SystemNotification) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz(l, v)
 }
+func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz1(in *jlexer.Lexer, out *Mention) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "contentId":
+			out.ContentId = int32(in.Int32())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "creationId":
+			out.CreationId = int32(in.Int32())
+		case "creationType":
+			out.CreationType = int32(in.Int32())
+		case "rootId":
+			out.RootId = int32(in.Int32())
+		case "uid":
+			out.Uid = string(in.String())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz1(out *jwriter.Writer, in Mention) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"contentId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ContentId))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"creationId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CreationId))
+	}
+	{
+		const prefix string = ",\"creationType\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CreationType))
+	}
+	{
+		const prefix string = ",\"rootId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.RootId))
+	}
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uid))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Mention) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Mention) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Mention) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Mention) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppMessageServiceInternalBiz1(l, v)
+}
@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"regexp"
+	"strings"
+)
+
+const (
+	mentionLimit    = 10
+	mentionTextSize = 100
+)
+
+// mentionRegexp matches a handle after an @ that does not end a word, an email
+// or another mention.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^a-zA-Z0-9_@.])@([a-zA-Z0-9_]{3,20})\b`)
+
+type MentionUseCase struct {
+	messageRepo  MessageRepo
+	creationRepo CreationRepo
+	tm           Transaction
+	log          *log.Helper
+}
+
+func NewMentionUseCase(messageRepo MessageRepo, creationRepo CreationRepo, tm Transaction, logger log.Logger) *MentionUseCase {
+	return &MentionUseCase{
+		messageRepo:  messageRepo,
+		creationRepo: creationRepo,
+		tm:           tm,
+		log:          log.NewHelper(log.With(logger, "module", "message/biz/mentionUseCase")),
+	}
+}
+
+// NotifyObject notifies the users mentioned in object, a text of the creation
+// bucket, as Notify does.
+func (r *MentionUseCase) NotifyObject(ctx context.Context, mention *Mention, object string) {
+	text, err := r.creationRepo.GetCreationText(ctx, object)
+	if err != nil {
+		r.log.Errorf("fail to get creation text: error(%v), object(%s)", err, object)
+		return
+	}
+	r.Notify(ctx, mention, text)
+}
+
+// Notify tells each user mentioned in text, up to mentionLimit of them, that
+// mention.Uid mentioned it, leaving out mention.Uid itself and the users who
+// blocked or muted it. Mentions are best effort, so failures are only logged.
+func (r *MentionUseCase) Notify(ctx context.Context, mention *Mention, text string) {
+	handles := parseMentions(text)
+	if len(handles) == 0 {
+		return
+	}
+
+	uuids, err := r.messageRepo.GetHandleUuids(ctx, handles)
+	if err != nil {
+		r.log.Errorf("fail to get handle uuids: error(%v), handles(%v)", err, handles)
+		return
+	}
+
+	for _, handle := range handles {
+		uuid, ok := uuids[handle]
+		if !ok || uuid == mention.Uid || r.silenced(ctx, uuid, mention.Uid) {
+			continue
+		}
+
+		m := *mention
+		m.Uuid = uuid
+		err = r.tm.ExecTx(ctx, func(ctx context.Context) error {
+			notification, err := r.messageRepo.AddMailBoxMention(ctx, &m)
+			if err != nil {
+				return err
+			}
+			return r.messageRepo.AddMailBoxMentionToCache(ctx, notification)
+		})
+		if err != nil {
+			r.log.Errorf("fail to add mail box mention: error(%v), mention(%v)", err, m)
+		}
+	}
+}
+
+// silenced reports whether uuid keeps the mentions of userUuid out of its
+// mailbox. A failed lookup lets the mention through.
+func (r *MentionUseCase) silenced(ctx context.Context, uuid, userUuid string) bool {
+	silenced, err := r.messageRepo.GetUserBlock(ctx, uuid, userUuid)
+	if err != nil {
+		r.log.Errorf("fail to get user block: error(%v), uuid(%s), userUuid(%s)", err, uuid, userUuid)
+		return false
+	}
+	return silenced
+}
+
+// parseMentions lists the handles mentioned in text, lowercased, each once and
+// in the order they first appear, up to mentionLimit of them.
+func parseMentions(text string) []string {
+	handles := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		handle := strings.ToLower(match[1])
+		if seen[handle] {
+			continue
+		}
+		seen[handle] = true
+		handles = append(handles, handle)
+		if len(handles) == mentionLimit {
+			break
+		}
+	}
+	return handles
+}
+
+// mentionText cuts text down to what a mention keeps of it.
+func mentionText(text string) string {
+	runes := []rune(text)
+	if len(runes) > mentionTextSize {
+		return string(runes[:mentionTextSize])
+	}
+	return text
+}
@@ -10,12 +10,19 @@ type MessageRepo interface {
 	GetMailBoxLastTime(ctx context.Context, uuid string) (*MailBox, error)
 	GetMessageNotification(ctx context.Context, uuid string, follows []string) (*Notification, error)
 	GetMessageSystemNotification(ctx context.Context, page int32, uuid string) ([]*SystemNotification, error)
+	GetMessageMention(ctx context.Context, page int32, uuid string) ([]*Mention, error)
 	SetMailBoxLastTime(ctx context.Context, uuid string, time int32) error
 	RemoveMailBoxCommentCount(ctx context.Context, uuid string) error
 	RemoveMailBoxSubCommentCount(ctx context.Context, uuid string) error
 	RemoveMailBoxSystemNotificationCount(ctx context.Context, uuid string) error
+	RemoveMailBoxMentionCount(ctx context.Context, uuid string) error
 	AddMailBoxSystemNotification(ctx context.Context, contentId int32, notificationType string, title string, uuid string, label string, result int32, section string, text string, uid string, comment string) (*SystemNotification, error)
 	AddMailBoxSystemNotificationToCache(ctx context.Context, notification *SystemNotification) error
+	AddMailBoxMention(ctx context.Context, mention *Mention) (*Mention, error)
+	AddMailBoxMentionToCache(ctx context.Context, mention *Mention) error
+	GetHandleUuids(ctx context.Context, handles []string) (map[string]string, error)
+	GetUserBlock(ctx context.Context, uuid, userUuid string) (bool, error)
+	GetUserBlocks(ctx context.Context, uuid string) ([]string, error)
 }
 
 type MessageUseCase struct {
@@ -40,7 +47,27 @@ func (r *MessageUseCase) GetMailBoxLastTime(ctx context.Context, uuid string) (*
 	return mailbox, nil
 }
 
+// GetMessageNotification leaves the users uuid blocked or muted out of the
+// timeline.
 func (r *MessageUseCase) GetMessageNotification(ctx context.Context, uuid string, follows []string) (*Notification, error) {
+	blocks, err := r.repo.GetUserBlocks(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetMessageNotificationFailed("get user blocks failed: %s", err.Error())
+	}
+	if len(blocks) != 0 {
+		blocked := make(map[string]bool, len(blocks))
+		for _, item := range blocks {
+			blocked[item] = true
+		}
+		shown := make([]string, 0, len(follows))
+		for _, item := range follows {
+			if !blocked[item] {
+				shown = append(shown, item)
+			}
+		}
+		follows = shown
+	}
+
 	mailbox, err := r.repo.GetMessageNotification(ctx, uuid, follows)
 	if err != nil {
 		return nil, v1.ErrorGetMessageNotificationFailed("get message notification failed: %s", err.Error())
@@ -56,6 +83,14 @@ func (r *MessageUseCase) GetMessageSystemNotification(ctx context.Context, page
 	return notificationList, nil
 }
 
+func (r *MessageUseCase) GetMessageMention(ctx context.Context, page int32, uuid string) ([]*Mention, error) {
+	mentionList, err := r.repo.GetMessageMention(ctx, page, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetMessageNotificationFailed("get message mention failed: %s", err.Error())
+	}
+	return mentionList, nil
+}
+
 func (r *MessageUseCase) SetMailBoxLastTime(ctx context.Context, uuid string, time int32) error {
 	err := r.repo.SetMailBoxLastTime(ctx, uuid, time)
 	if err != nil {
@@ -87,3 +122,11 @@ func (r *MessageUseCase) RemoveMailBoxSystemNotificationCount(ctx context.Contex
 	}
 	return nil
 }
+
+func (r *MessageUseCase) RemoveMailBoxMentionCount(ctx context.Context, uuid string) error {
+	err := r.repo.RemoveMailBoxMentionCount(ctx, uuid)
+	if err != nil {
+		return v1.ErrorRemoveMailboxMentionFailed("remove mail box mention count failed: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	v1 "github.com/the-zion/matrix-core/api/message/service/v1"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+)
+
+type ModerationRepo interface {
+	AddContentFlag(ctx context.Context, flag *ContentFlag) error
+	GetContentFlagList(ctx context.Context, page int32, kind string, status int32) ([]*ContentFlag, error)
+	LockContentFlag(ctx context.Context, id int32) (*ContentFlag, error)
+	SetContentFlagStatus(ctx context.Context, id, status int32) error
+	LockContentFlagByJobId(ctx context.Context, jobId string) (*ContentFlag, error)
+	AddAppeal(ctx context.Context, appeal *Appeal) error
+	GetAppeal(ctx context.Context, id int32) (*Appeal, error)
+	LockAppeal(ctx context.Context, id int32) (*Appeal, error)
+	GetAppealList(ctx context.Context, page int32, uuid string, status int32) ([]*Appeal, error)
+	SetAppealStatus(ctx context.Context, jobId string, status int32) error
+}
+
+type ModerationUseCase struct {
+	repo     ModerationRepo
+	creation *CreationUseCase
+	comment  *CommentUseCase
+	tm       Transaction
+	log      *log.Helper
+}
+
+func NewModerationUseCase(repo ModerationRepo, creation *CreationUseCase, comment *CommentUseCase, tm Transaction, logger log.Logger) *ModerationUseCase {
+	return &ModerationUseCase{
+		repo:     repo,
+		creation: creation,
+		comment:  comment,
+		tm:       tm,
+		log:      log.NewHelper(log.With(logger, "module", "message/biz/moderationUseCase")),
+	}
+}
+
+// GetContentFlagList lists the flags of kind, any kind when empty, in status,
+// the pending ones when 0, newest first.
+func (r *ModerationUseCase) GetContentFlagList(ctx context.Context, page int32, kind string, status int32) ([]*ContentFlag, error) {
+	if status == 0 {
+		status = 1
+	}
+	list, err := r.repo.GetContentFlagList(ctx, page, kind, status)
+	if err != nil {
+		return nil, v1.ErrorGetContentFlagFailed("get content flag list failed: %s", err.Error())
+	}
+	return list, nil
+}
+
+// PublishContentFlag overrules the review of the flag id, publishing the
+// content through the same pass its review would have taken.
+func (r *ModerationUseCase) PublishContentFlag(ctx context.Context, id int32) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		flag, err := r.lockPendingFlag(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = r.publish(ctx, flag)
+		if err != nil {
+			return v1.ErrorPublishContentFlagFailed("publish content flag failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// RemoveContentFlag upholds the review of the flag id.
+func (r *ModerationUseCase) RemoveContentFlag(ctx context.Context, id int32) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		flag, err := r.lockPendingFlag(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = r.uphold(ctx, flag)
+		if err != nil {
+			return v1.ErrorRemoveContentFlagFailed("remove content flag failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// AppealReview appeals the review job appeal.JobId on behalf of its author,
+// queueing the appeal for the moderators while the flag of the job waits for
+// them.
+func (r *ModerationUseCase) AppealReview(ctx context.Context, appeal *Appeal) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		flag, err := r.repo.LockContentFlagByJobId(ctx, appeal.JobId)
+		if kerrors.IsNotFound(err) || (err == nil && flag.Uuid != appeal.Uuid) {
+			return v1.ErrorContentFlagNotFound("content flag not found: jobId(%s), uuid(%s)", appeal.JobId, appeal.Uuid)
+		}
+		if err != nil {
+			return v1.ErrorAppealReviewFailed("lock content flag failed: %s", err.Error())
+		}
+		if flag.Status != 1 {
+			return v1.ErrorContentFlagResolved("content flag resolved: jobId(%s), status(%v)", appeal.JobId, flag.Status)
+		}
+
+		appeal.FlagId = flag.Id
+		err = r.repo.AddAppeal(ctx, appeal)
+		if kerrors.IsConflict(err) {
+			return v1.ErrorAppealExist("appeal exist: jobId(%s)", appeal.JobId)
+		}
+		if err != nil {
+			return v1.ErrorAppealReviewFailed("add appeal failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// GetAppealList lists the appeals of uuid, or of anyone when empty, in status,
+// newest first. Status 0 lists the appeals of uuid in any status, and the
+// pending ones when uuid is empty.
+func (r *ModerationUseCase) GetAppealList(ctx context.Context, page int32, uuid string, status int32) ([]*Appeal, error) {
+	if uuid == "" && status == 0 {
+		status = 1
+	}
+	list, err := r.repo.GetAppealList(ctx, page, uuid, status)
+	if err != nil {
+		return nil, v1.ErrorGetAppealFailed("get appeal list failed: %s", err.Error())
+	}
+	return list, nil
+}
+
+// AcceptAppeal publishes the content of the appeal id exactly as if its review
+// had passed.
+func (r *ModerationUseCase) AcceptAppeal(ctx context.Context, id int32) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		flag, err := r.lockPendingAppeal(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = r.publish(ctx, flag)
+		if err != nil {
+			return v1.ErrorAcceptAppealFailed("accept appeal failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// RejectAppeal upholds the review the appeal id was made against.
+func (r *ModerationUseCase) RejectAppeal(ctx context.Context, id int32) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		flag, err := r.lockPendingAppeal(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = r.uphold(ctx, flag)
+		if err != nil {
+			return v1.ErrorRejectAppealFailed("reject appeal failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// publish publishes the content of flag through its review pass, resolving
+// flag and the appeal made against it as published. The pass calls the other
+// services before the transaction commits, so its calls are keyed by the
+// flag: when the transaction rolls back after they went through, resolving
+// the flag again, by itself or by its appeal, repeats none of them.
+func (r *ModerationUseCase) publish(ctx context.Context, flag *ContentFlag) error {
+	err := r.pass(idempotent.NewContext(ctx, fmt.Sprintf("content_flag_%v", flag.Id)), flag)
+	if err != nil {
+		return errors.Wrapf(err, "fail to publish content: id(%v)", flag.Id)
+	}
+
+	err = r.repo.SetContentFlagStatus(ctx, flag.Id, 2)
+	if err != nil {
+		return err
+	}
+	return r.repo.SetAppealStatus(ctx, flag.JobId, 2)
+}
+
+// uphold resolves flag and the appeal made against it as removed. Flagged
+// content was never published: a creation stays unpublished and an edit is
+// dropped, leaving the version published before it.
+func (r *ModerationUseCase) uphold(ctx context.Context, flag *ContentFlag) error {
+	err := r.repo.SetContentFlagStatus(ctx, flag.Id, 3)
+	if err != nil {
+		return err
+	}
+	return r.repo.SetAppealStatus(ctx, flag.JobId, 3)
+}
+
+// lockPendingAppeal locks the appeal id and its flag, in the order the flag
+// paths lock them, and returns the flag while both are pending.
+func (r *ModerationUseCase) lockPendingAppeal(ctx context.Context, id int32) (*ContentFlag, error) {
+	appeal, err := r.repo.GetAppeal(ctx, id)
+	if kerrors.IsNotFound(err) {
+		return nil, v1.ErrorAppealNotFound("appeal not found: id(%v)", id)
+	}
+	if err != nil {
+		return nil, v1.ErrorGetAppealFailed("get appeal failed: %s", err.Error())
+	}
+
+	flag, err := r.repo.LockContentFlag(ctx, appeal.FlagId)
+	if err != nil {
+		return nil, v1.ErrorGetAppealFailed("lock content flag failed: %s", err.Error())
+	}
+
+	appeal, err = r.repo.LockAppeal(ctx, id)
+	if err != nil {
+		return nil, v1.ErrorGetAppealFailed("lock appeal failed: %s", err.Error())
+	}
+	if appeal.Status != 1 || flag.Status != 1 {
+		return nil, v1.ErrorAppealResolved("appeal resolved: id(%v), status(%v)", id, appeal.Status)
+	}
+	return flag, nil
+}
+
+func (r *ModerationUseCase) lockPendingFlag(ctx context.Context, id int32) (*ContentFlag, error) {
+	flag, err := r.repo.LockContentFlag(ctx, id)
+	if kerrors.IsNotFound(err) {
+		return nil, v1.ErrorContentFlagNotFound("content flag not found: id(%v)", id)
+	}
+	if err != nil {
+		return nil, v1.ErrorGetContentFlagFailed("lock content flag failed: %s", err.Error())
+	}
+	if flag.Status != 1 {
+		return nil, v1.ErrorContentFlagResolved("content flag resolved: id(%v), status(%v)", id, flag.Status)
+	}
+	return flag, nil
+}
+
+// pass runs the review pass of the content of flag.
+func (r *ModerationUseCase) pass(ctx context.Context, flag *ContentFlag) error {
+	switch flag.Kind + "/" + flag.Mode {
+	case "article/create":
+		return r.creation.articleCreatePass(ctx, flag.ContentId, flag.Auth, flag.Uuid, flag.Text, flag.Object)
+	case "article/edit":
+		return r.creation.repo.ArticleEditReviewPass(ctx, flag.ContentId, flag.Auth, flag.Uuid)
+	case "talk/create":
+		return r.creation.talkCreatePass(ctx, flag.ContentId, flag.Auth, flag.Uuid, flag.Text, flag.Object)
+	case "talk/edit":
+		return r.creation.repo.TalkEditReviewPass(ctx, flag.ContentId, flag.Auth, flag.Uuid)
+	case "column/create":
+		return r.creation.columnCreatePass(ctx, flag.ContentId, flag.Auth, flag.Uuid)
+	case "column/edit":
+		return r.creation.repo.ColumnEditReviewPass(ctx, flag.ContentId, flag.Auth, flag.Uuid)
+	case "collections/create":
+		return r.creation.repo.CollectionsCreateReviewPass(ctx, flag.ContentId, flag.Auth, flag.Uuid)
+	case "collections/edit":
+		return r.creation.repo.CollectionsEditReviewPass(ctx, flag.ContentId, flag.Auth, flag.Uuid)
+	case "comment/create":
+		return r.comment.commentCreatePass(ctx, flag.ContentId, flag.CreationId, flag.CreationType, flag.Uuid, flag.Text)
+	case "subComment/create":
+		return r.comment.subCommentCreatePass(ctx, flag.ContentId, flag.RootId, flag.ParentId, flag.Uuid, flag.Text)
+	}
+	return errors.Errorf("unknown content flag: kind(%s), mode(%s)", flag.Kind, flag.Mode)
+}
+
+// addContentFlag queues the content tr found irregular for the moderators.
+func addContentFlag(ctx context.Context, repo ModerationRepo, tr *TextReview, flag *ContentFlag) error {
+	flag.Object = tr.Object
+	flag.JobId = tr.JobId
+	flag.Label = tr.Label
+	flag.Section = tr.Section
+	return repo.AddContentFlag(ctx, flag)
+}
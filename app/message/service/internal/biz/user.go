@@ -5,18 +5,23 @@ import (
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/pkg/event"
 )
 
 type UserRepo interface {
 	AvatarIrregular(ctx context.Context, review *ImageReview, uuid string) error
 	CoverIrregular(ctx context.Context, review *ImageReview, uuid string) error
-	UploadProfileToCos(msg map[string]interface{}) error
+	UploadProfileToCos(profile *event.Profile) error
 	ProfileReviewPass(ctx context.Context, uuid, update string) error
 	ProfileReviewNotPass(ctx context.Context, uuid string) error
 	SetFollowDbAndCache(ctx context.Context, uuid, userId string) error
 	CancelFollowDbAndCache(ctx context.Context, uuid, userId string) error
 	AddAvatarReviewDbAndCache(ctx context.Context, score, result int32, uuid, jobId, label, category, subLabel string) error
 	AddCoverReviewDbAndCache(ctx context.Context, score, result int32, uuid, jobId, label, category, subLabel string) error
+	PurgeUserData(ctx context.Context, uuid string) error
+	PurgeUserCos(ctx context.Context, uuid string) error
+	GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error)
+	UploadUserDataExport(ctx context.Context, uuid, id string, files []*UserDataFile) error
 }
 
 type UserUseCase struct {
@@ -37,8 +42,8 @@ func NewUserUseCase(repo UserRepo, messageRepo MessageRepo, tm Transaction, jwt
 	}
 }
 
-func (r *UserUseCase) UploadProfileToCos(msg map[string]interface{}) error {
-	return r.repo.UploadProfileToCos(msg)
+func (r *UserUseCase) UploadProfileToCos(profile *event.Profile) error {
+	return r.repo.UploadProfileToCos(profile)
 }
 
 func (r *UserUseCase) AvatarReview(ctx context.Context, ar *ImageReview) error {
@@ -160,6 +165,48 @@ func (r *UserUseCase) CancelFollowDbAndCache(ctx context.Context, uuid, userId s
 	return r.repo.CancelFollowDbAndCache(ctx, uuid, userId)
 }
 
+// FollowRequest tells uuid, a private user, that userId asks to follow it.
+func (r *UserUseCase) FollowRequest(ctx context.Context, uuid, userId string) error {
+	r.addFollowNotification(ctx, "follow-request", uuid, userId)
+	return nil
+}
+
+// FollowApprove tells userId that uuid approved its request to follow it.
+func (r *UserUseCase) FollowApprove(ctx context.Context, uuid, userId string) error {
+	r.addFollowNotification(ctx, "follow-approve", userId, uuid)
+	return nil
+}
+
+// addFollowNotification notifies uuid about uid, unless uuid muted uid or
+// either blocked the other since.
+func (r *UserUseCase) addFollowNotification(ctx context.Context, notificationType, uuid, uid string) {
+	silenced, err := r.messageRepo.GetUserBlock(ctx, uuid, uid)
+	if err != nil {
+		r.log.Errorf("fail to get user block: error(%v), uuid(%s), userUuid(%s)", err, uuid, uid)
+		return
+	}
+
+	if silenced {
+		return
+	}
+
+	err = r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		notification, err := r.messageRepo.AddMailBoxSystemNotification(ctx, 0, notificationType, "", uuid, "", 0, "", "", uid, "")
+		if err != nil {
+			return err
+		}
+
+		err = r.messageRepo.AddMailBoxSystemNotificationToCache(ctx, notification)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("fail to add mail box system notification for %s: error(%v), uuid(%s), uid(%s)", notificationType, err, uuid, uid)
+	}
+}
+
 func (r *UserUseCase) AddAvatarReviewDbAndCache(ctx context.Context, score, result int32, uuid, jobId, label, category, subLabel string) error {
 	err := r.repo.AddAvatarReviewDbAndCache(ctx, score, result, uuid, jobId, label, category, subLabel)
 	if err != nil {
@@ -205,3 +252,22 @@ func (r *UserUseCase) AddCoverReviewDbAndCache(ctx context.Context, score, resul
 	}
 	return nil
 }
+
+// DeleteAccount purges a deleted account from every service, then its objects
+// in cos.
+func (r *UserUseCase) DeleteAccount(ctx context.Context, uuid string) error {
+	err := r.repo.PurgeUserData(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	return r.repo.PurgeUserCos(ctx, uuid)
+}
+
+// ExportUserData builds the archive id of everything held about uuid.
+func (r *UserUseCase) ExportUserData(ctx context.Context, uuid, id string) error {
+	files, err := r.repo.GetUserData(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	return r.repo.UploadUserDataExport(ctx, uuid, id, files)
+}
@@ -0,0 +1,247 @@
+package data
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/tencentyun/cos-go-sdk-v5"
+	achievementV1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	commentV1 "github.com/the-zion/matrix-core/api/comment/service/v1"
+	creationV1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	userV1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"io/ioutil"
+)
+
+// cosPrefix is where a bucket keeps the objects of one user.
+type cosPrefix struct {
+	bucket string
+	cli    *cos.Client
+	prefix string
+}
+
+func (r *userRepo) userCosPrefixes(uuid string) []cosPrefix {
+	return []cosPrefix{
+		{"user", r.data.cosUserCli.cos, "avatar/" + uuid + "/"},
+		{"user", r.data.cosUserCli.cos, "cover/" + uuid + "/"},
+		{"user", r.data.cosUserCli.cos, "profile/" + uuid},
+		{"creation", r.data.cosCreationCli.cos, "article/" + uuid + "/"},
+		{"creation", r.data.cosCreationCli.cos, "talk/" + uuid + "/"},
+		{"creation", r.data.cosCreationCli.cos, "column/" + uuid + "/"},
+		{"creation", r.data.cosCreationCli.cos, "collections/" + uuid + "/"},
+		{"comment", r.data.cosCommentCli.cos, "comment/" + uuid + "/"},
+	}
+}
+
+// PurgeUserData asks every service in turn to purge what it holds about uuid,
+// then drops the notifications kept here. Each step may be repeated, so a
+// retry after a failure starts over.
+func (r *userRepo) PurgeUserData(ctx context.Context, uuid string) error {
+	_, err := r.data.uc.PurgeUserData(ctx, &userV1.PurgeUserDataReq{Uuid: uuid})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from user service: uuid(%s)", uuid))
+	}
+	_, err = r.data.cc.PurgeUserData(ctx, &creationV1.PurgeUserDataReq{Uuid: uuid})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from creation service: uuid(%s)", uuid))
+	}
+	_, err = r.data.commc.PurgeUserData(ctx, &commentV1.PurgeUserDataReq{Uuid: uuid})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from comment service: uuid(%s)", uuid))
+	}
+	_, err = r.data.ac.PurgeUserData(ctx, &achievementV1.PurgeUserDataReq{Uuid: uuid})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from achievement service: uuid(%s)", uuid))
+	}
+
+	err = r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(&SystemNotification{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge system notifications: uuid(%s)", uuid))
+	}
+	err = r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(&Mention{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge mentions: uuid(%s)", uuid))
+	}
+	_, err = r.data.redisCli.Del(ctx, "system_notification_"+uuid, "mention_notification_"+uuid).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge notifications from cache: uuid(%s)", uuid))
+	}
+	for _, key := range []string{"mailbox_last_time", "message_comment", "message_sub_comment", "message_system", "message_mention", "message_timeline"} {
+		_, err = r.data.redisCli.HDel(ctx, key, uuid).Result()
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to purge mailbox from cache: key(%s), uuid(%s)", key, uuid))
+		}
+	}
+	return nil
+}
+
+// PurgeUserCos deletes every object uuid uploaded or was given, export
+// archives included.
+func (r *userRepo) PurgeUserCos(ctx context.Context, uuid string) error {
+	prefixes := append(r.userCosPrefixes(uuid), cosPrefix{"user", r.data.cosUserCli.cos, "export/" + uuid + "/"})
+	for _, item := range prefixes {
+		err := r.walkCos(ctx, item, func(contents []cos.Object) error {
+			objects := make([]cos.Object, 0, len(contents))
+			for _, object := range contents {
+				objects = append(objects, cos.Object{Key: object.Key})
+			}
+			_, _, err := item.cli.Object.DeleteMulti(ctx, &cos.ObjectDeleteMultiOptions{
+				Quiet:   true,
+				Objects: objects,
+			})
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to purge user cos: bucket(%s), prefix(%s)", item.bucket, item.prefix))
+		}
+	}
+	return nil
+}
+
+// walkCos hands fn the objects under prefix a page at a time.
+func (r *userRepo) walkCos(ctx context.Context, prefix cosPrefix, fn func(contents []cos.Object) error) error {
+	marker := ""
+	for {
+		res, _, err := prefix.cli.Bucket.Get(ctx, &cos.BucketGetOptions{
+			Prefix:  prefix.prefix,
+			Marker:  marker,
+			MaxKeys: 1000,
+		})
+		if err != nil {
+			return err
+		}
+		if len(res.Contents) > 0 {
+			err = fn(res.Contents)
+			if err != nil {
+				return err
+			}
+		}
+		if !res.IsTruncated {
+			return nil
+		}
+		marker = res.NextMarker
+	}
+}
+
+// GetUserData collects what every service holds about uuid, along with its
+// objects in cos, each file under a folder named after where it came from.
+func (r *userRepo) GetUserData(ctx context.Context, uuid string) ([]*biz.UserDataFile, error) {
+	files := make([]*biz.UserDataFile, 0)
+	add := func(folder, name string, content []byte) {
+		files = append(files, &biz.UserDataFile{
+			Name:    folder + "/" + name,
+			Content: content,
+		})
+	}
+
+	user, err := r.data.uc.GetUserData(ctx, &userV1.GetUserDataReq{Uuid: uuid})
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from user service: uuid(%s)", uuid))
+	}
+	for _, item := range user.Files {
+		add("user", item.Name, item.Content)
+	}
+
+	creation, err := r.data.cc.GetUserData(ctx, &creationV1.GetUserDataReq{Uuid: uuid})
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from creation service: uuid(%s)", uuid))
+	}
+	for _, item := range creation.Files {
+		add("creation", item.Name, item.Content)
+	}
+
+	comment, err := r.data.commc.GetUserData(ctx, &commentV1.GetUserDataReq{Uuid: uuid})
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from comment service: uuid(%s)", uuid))
+	}
+	for _, item := range comment.Files {
+		add("comment", item.Name, item.Content)
+	}
+
+	achievement, err := r.data.ac.GetUserData(ctx, &achievementV1.GetUserDataReq{Uuid: uuid})
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from achievement service: uuid(%s)", uuid))
+	}
+	for _, item := range achievement.Files {
+		add("achievement", item.Name, item.Content)
+	}
+
+	notifications := make([]*SystemNotification, 0)
+	err = r.data.db.WithContext(ctx).Where("uuid = ?", uuid).Find(&notifications).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get system notifications: uuid(%s)", uuid))
+	}
+	content, err := json.MarshalIndent(notifications, "", "  ")
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to marshal system notifications: uuid(%s)", uuid))
+	}
+	add("message", "system_notifications.json", content)
+
+	mentions := make([]*Mention, 0)
+	err = r.data.db.WithContext(ctx).Where("uuid = ?", uuid).Find(&mentions).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get mentions: uuid(%s)", uuid))
+	}
+	content, err = json.MarshalIndent(mentions, "", "  ")
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to marshal mentions: uuid(%s)", uuid))
+	}
+	add("message", "mentions.json", content)
+
+	for _, item := range r.userCosPrefixes(uuid) {
+		prefix := item
+		err = r.walkCos(ctx, prefix, func(contents []cos.Object) error {
+			for _, object := range contents {
+				res, err := prefix.cli.Object.Get(ctx, object.Key, nil)
+				if err != nil {
+					return err
+				}
+				body, err := ioutil.ReadAll(res.Body)
+				res.Body.Close()
+				if err != nil {
+					return err
+				}
+				add("cos/"+prefix.bucket, object.Key, body)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user cos: bucket(%s), prefix(%s)", item.bucket, item.prefix))
+		}
+	}
+	return files, nil
+}
+
+// UploadUserDataExport zips files into the archive id of uuid, where the user
+// service looks for it.
+func (r *userRepo) UploadUserDataExport(ctx context.Context, uuid, id string, files []*biz.UserDataFile) error {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for _, item := range files {
+		f, err := w.Create(item.Name)
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to create user data export file: file(%s), uuid(%s)", item.Name, uuid))
+		}
+		_, err = f.Write(item.Content)
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to write user data export file: file(%s), uuid(%s)", item.Name, uuid))
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to close user data export: uuid(%s), id(%s)", uuid, id))
+	}
+
+	_, err = r.data.cosUserCli.cos.Object.Put(ctx, "export/"+uuid+"/"+id+".zip", buf, &cos.ObjectPutOptions{
+		ObjectPutHeaderOptions: &cos.ObjectPutHeaderOptions{
+			ContentType: "application/zip",
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to upload user data export to cos: uuid(%s), id(%s)", uuid, id))
+	}
+	return nil
+}
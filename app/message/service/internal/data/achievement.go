@@ -20,58 +20,6 @@ func NewAchievementRepo(data *Data, logger log.Logger) biz.AchievementRepo {
 	}
 }
 
-func (r *achievementRepo) SetAchievementAgree(ctx context.Context, uuid, userUuid string) error {
-	_, err := r.data.ac.SetAchievementAgree(ctx, &achievementv1.SetAchievementAgreeReq{
-		Uuid:     uuid,
-		UserUuid: userUuid,
-	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (r *achievementRepo) CancelAchievementAgree(ctx context.Context, uuid, userUuid string) error {
-	_, err := r.data.ac.CancelAchievementAgree(ctx, &achievementv1.CancelAchievementAgreeReq{
-		Uuid:     uuid,
-		UserUuid: userUuid,
-	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (r *achievementRepo) SetAchievementView(ctx context.Context, uuid string) error {
-	_, err := r.data.ac.SetAchievementView(ctx, &achievementv1.SetAchievementViewReq{
-		Uuid: uuid,
-	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (r *achievementRepo) SetAchievementCollect(ctx context.Context, uuid string) error {
-	_, err := r.data.ac.SetAchievementCollect(ctx, &achievementv1.SetAchievementCollectReq{
-		Uuid: uuid,
-	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (r *achievementRepo) CancelAchievementCollect(ctx context.Context, uuid string) error {
-	_, err := r.data.ac.CancelAchievementCollect(ctx, &achievementv1.CancelAchievementCollectReq{
-		Uuid: uuid,
-	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 func (r *achievementRepo) SetAchievementFollow(ctx context.Context, follow, followed string) error {
 	_, err := r.data.ac.SetAchievementFollow(ctx, &achievementv1.SetAchievementFollowReq{
 		Follow:   follow,
@@ -116,11 +64,27 @@ func (r *achievementRepo) CancelUserMedalDbAndCache(ctx context.Context, medal,
 	return nil
 }
 
-func (r *achievementRepo) AddAchievementScore(ctx context.Context, uuid string, score int32) error {
-	_, err := r.data.ac.AddAchievementScore(ctx, &achievementv1.AddAchievementScoreReq{
-		Uuid:  uuid,
-		Score: score,
-	})
+func (r *achievementRepo) AddAchievementDbAndCache(ctx context.Context, achievements []*biz.AchievementDelta, actives []*biz.ActiveDelta) error {
+	req := &achievementv1.AddAchievementDbAndCacheReq{
+		Achievement: make([]*achievementv1.AddAchievementDbAndCacheReq_Achievement, 0, len(achievements)),
+		Active:      make([]*achievementv1.AddAchievementDbAndCacheReq_Active, 0, len(actives)),
+	}
+	for _, item := range achievements {
+		req.Achievement = append(req.Achievement, &achievementv1.AddAchievementDbAndCacheReq_Achievement{
+			Uuid:    item.Uuid,
+			Agree:   item.Agree,
+			View:    item.View,
+			Collect: item.Collect,
+			Score:   item.Score,
+		})
+	}
+	for _, item := range actives {
+		req.Active = append(req.Active, &achievementv1.AddAchievementDbAndCacheReq_Active{
+			Uuid:  item.Uuid,
+			Agree: item.Agree,
+		})
+	}
+	_, err := r.data.ac.AddAchievementDbAndCache(ctx, req)
 	if err != nil {
 		return err
 	}
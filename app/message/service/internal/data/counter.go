@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"strconv"
+	"time"
+)
+
+// counterClaimExpiration outlives the redeliveries of an event, after which
+// its key is recorded as processed.
+const counterClaimExpiration = 24 * time.Hour
+
+var _ biz.CounterRepo = (*counterRepo)(nil)
+
+type counterRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCounterRepo(data *Data, logger log.Logger) biz.CounterRepo {
+	return &counterRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "message/data/counter")),
+	}
+}
+
+// AddCounter adds deltas to the staged counters unless key has added them
+// before, and returns how many counters are staged.
+func (r *counterRepo) AddCounter(ctx context.Context, key string, deltas map[string]int64) (int64, error) {
+	claimKey := ""
+	if key != "" {
+		claimKey = "counter_claim_" + key
+	}
+	values := make([]interface{}, 0, len(deltas)*2+1)
+	values = append(values, int64(counterClaimExpiration/time.Second))
+	for field, delta := range deltas {
+		values = append(values, field, delta)
+	}
+	keys, err := r.data.redisCli.EvalSha(ctx, "9bae7a617601e13b90f7edc346106f6932f6b280", []string{"counter_pending", claimKey}, values...).Int64()
+	if err != nil {
+		return 0, errors.Wrapf(err, fmt.Sprintf("fail to add counter: key(%s), deltas(%v)", key, deltas))
+	}
+	return keys, nil
+}
+
+// NewCounterBatch moves the staged counters, if any, into a batch of their own.
+func (r *counterRepo) NewCounterBatch(ctx context.Context) error {
+	batch := xid.New().String()
+	_, err := r.data.redisCli.EvalSha(ctx, "cfce730f57501469b5b883d66fcf9dc8aa022ece", []string{"counter_pending", "counter_batch_" + batch, "counter_batch_list"}, batch).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to new counter batch: batch(%s)", batch))
+	}
+	return nil
+}
+
+func (r *counterRepo) GetCounterBatchList(ctx context.Context) ([]string, error) {
+	list, err := r.data.redisCli.SMembers(ctx, "counter_batch_list").Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to get counter batch list")
+	}
+	return list, nil
+}
+
+func (r *counterRepo) GetCounterBatch(ctx context.Context, batch string) (map[string]int64, error) {
+	fields, err := r.data.redisCli.HGetAll(ctx, "counter_batch_"+batch).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get counter batch: batch(%s)", batch))
+	}
+	deltas := make(map[string]int64, len(fields))
+	for field, value := range fields {
+		delta, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to covert string to int64: batch(%s), field(%s), value(%s)", batch, field, value))
+		}
+		deltas[field] = delta
+	}
+	return deltas, nil
+}
+
+func (r *counterRepo) RemoveCounterBatch(ctx context.Context, batch string) error {
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, "counter_batch_"+batch)
+		pipe.SRem(ctx, "counter_batch_list", batch)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to remove counter batch: batch(%s)", batch))
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/tencentyun/cos-go-sdk-v5"
 	creationV1 "github.com/the-zion/matrix-core/api/creation/service/v1"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"io/ioutil"
 	"strconv"
 	"time"
 )
@@ -159,11 +160,24 @@ func (r *creationRepo) SetCreationUpdateTime(ctx context.Context, uuid string) {
 	}
 }
 
-func (r *creationRepo) SetArticleViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	_, err := r.data.cc.SetArticleViewDbAndCache(ctx, &creationV1.SetArticleViewDbAndCacheReq{
-		Id:   id,
-		Uuid: uuid,
-	})
+// GetCreationText reads object, a text of the creation bucket such as one a
+// review ran on.
+func (r *creationRepo) GetCreationText(ctx context.Context, object string) (string, error) {
+	res, err := r.data.cosCreationCli.cos.Object.Get(ctx, object, &cos.ObjectGetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to get creation text from cos: object(%s)", object))
+	}
+
+	text, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to read creation text: object(%s)", object))
+	}
+	return string(text), nil
+}
+
+func (r *creationRepo) AddArticleViewDbAndCache(ctx context.Context, views []*biz.CreationView) error {
+	_, err := r.data.cc.AddArticleViewDbAndCache(ctx, creationViewReq(views))
 	if err != nil {
 		return err
 	}
@@ -408,11 +422,8 @@ func (r *creationRepo) EditTalkCosAndSearch(ctx context.Context, id, auth int32,
 	return nil
 }
 
-func (r *creationRepo) SetTalkViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	_, err := r.data.cc.SetTalkViewDbAndCache(ctx, &creationV1.SetTalkViewDbAndCacheReq{
-		Id:   id,
-		Uuid: uuid,
-	})
+func (r *creationRepo) AddTalkViewDbAndCache(ctx context.Context, views []*biz.CreationView) error {
+	_, err := r.data.cc.AddTalkViewDbAndCache(ctx, creationViewReq(views))
 	if err != nil {
 		return err
 	}
@@ -619,11 +630,8 @@ func (r *creationRepo) SetColumnAgreeDbAndCache(ctx context.Context, id int32, u
 	return nil
 }
 
-func (r *creationRepo) SetColumnViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	_, err := r.data.cc.SetColumnViewDbAndCache(ctx, &creationV1.SetColumnViewDbAndCacheReq{
-		Id:   id,
-		Uuid: uuid,
-	})
+func (r *creationRepo) AddColumnViewDbAndCache(ctx context.Context, views []*biz.CreationView) error {
+	_, err := r.data.cc.AddColumnViewDbAndCache(ctx, creationViewReq(views))
 	if err != nil {
 		return err
 	}
@@ -883,3 +891,18 @@ func (r *creationRepo) GetCreationUser(ctx context.Context, uuid string) (int32,
 	}
 	return reply.Article, reply.Talk, reply.Collect, nil
 }
+
+func creationViewReq(views []*biz.CreationView) *creationV1.AddCreationViewDbAndCacheReq {
+	req := &creationV1.AddCreationViewDbAndCacheReq{
+		View: make([]*creationV1.AddCreationViewDbAndCacheReq_View, 0, len(views)),
+	}
+	for _, item := range views {
+		req.View = append(req.View, &creationV1.AddCreationViewDbAndCacheReq_View{
+			Id:    item.Id,
+			Uuid:  item.Uuid,
+			Count: item.Count,
+			Raw:   item.Raw,
+		})
+	}
+	return req
+}
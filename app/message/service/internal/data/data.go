@@ -7,8 +7,6 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/selector"
-	"github.com/go-kratos/kratos/v2/selector/p2c"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
@@ -20,6 +18,8 @@ import (
 	userv1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"github.com/the-zion/matrix-core/pkg/jwtclaim"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	"go.opentelemetry.io/otel/propagation"
 	"gorm.io/driver/mysql"
@@ -30,7 +30,12 @@ import (
 	"time"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewCreationRepo, NewCommentRepo, NewMessageRepo, NewAchievementRepo, NewUserServiceClient, NewCreationServiceClient, NewAchievementServiceClient, NewCommentServiceClient, NewCosUserClient, NewCosCreationClient, NewCosCommentClient, NewJwtClient, NewJwt, NewRecovery, NewTransaction, NewRedis, NewDB)
+var ProviderSet = wire.NewSet(ClientSet, RepoSet)
+
+// ClientSet connects to the infrastructure and services named in the config.
+var ClientSet = wire.NewSet(NewDB, NewRedis, NewUserServiceClient, NewCreationServiceClient, NewAchievementServiceClient, NewCommentServiceClient, NewCosUserClient, NewCosCreationClient, NewCosCommentClient, NewJwtClient)
+
+var RepoSet = wire.NewSet(NewData, NewUserRepo, NewCreationRepo, NewCommentRepo, NewMessageRepo, NewAchievementRepo, NewDeadLetterRepo, NewDedupRepo, NewCounterRepo, NewModerationRepo, NewJwt, NewRecovery, NewTransaction)
 
 type CosUser struct {
 	cos *cos.Client
@@ -47,7 +52,7 @@ type CosComment struct {
 }
 
 type Jwt struct {
-	key string
+	verifier *jwtclaim.Verifier
 }
 
 type Data struct {
@@ -62,12 +67,31 @@ type Data struct {
 	cosUserCli     *CosUser
 	cosCreationCli *CosCreation
 	cosCommentCli  *CosComment
+	sweeper        *idempotent.Sweeper
 }
 
 type contextTxKey struct{}
 
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB)
+	if ok {
+		return tx
+	}
+	return d.db
+}
+
+// ExecTx runs fn in a transaction, unless fn has been committed before on
+// behalf of the idempotency key in ctx.
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx, first, err := idempotent.Claim(ctx, tx, "tx")
+		if err != nil {
+			return err
+		}
+		if !first {
+			d.log.Infof("skip committed transaction")
+			return nil
+		}
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -119,6 +143,7 @@ func NewUserServiceClient(r *nacos.Registry) userv1.UserClient {
 			recovery.Recovery(),
 			circuitbreaker.Client(),
 			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+			idempotent.Client(),
 		),
 	)
 	if err != nil {
@@ -138,6 +163,7 @@ func NewCreationServiceClient(r *nacos.Registry) creationv1.CreationClient {
 			recovery.Recovery(),
 			circuitbreaker.Client(),
 			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+			idempotent.Client(),
 		),
 	)
 	if err != nil {
@@ -157,6 +183,7 @@ func NewCommentServiceClient(r *nacos.Registry) commentv1.CommentClient {
 			recovery.Recovery(),
 			circuitbreaker.Client(),
 			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+			idempotent.Client(),
 		),
 	)
 	if err != nil {
@@ -176,6 +203,7 @@ func NewAchievementServiceClient(r *nacos.Registry) achievementv1.AchievementCli
 			recovery.Recovery(),
 			circuitbreaker.Client(),
 			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+			idempotent.Client(),
 		),
 	)
 	if err != nil {
@@ -280,7 +308,6 @@ func NewRedis(conf *conf.Data) redis.Cmdable {
 
 func NewData(db *gorm.DB, redisCmd redis.Cmdable, uc userv1.UserClient, cc creationv1.CreationClient, commc commentv1.CommentClient, ac achievementv1.AchievementClient, jwt Jwt, cosUser *CosUser, cosCreation *CosCreation, cosComment *CosComment, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "message/data"))
-	selector.SetGlobalSelector(p2c.NewBuilder())
 	d := &Data{
 		db:             db,
 		log:            log.NewHelper(log.With(logger, "module", "creation/data")),
@@ -294,9 +321,13 @@ func NewData(db *gorm.DB, redisCmd redis.Cmdable, uc userv1.UserClient, cc creat
 		cosCreationCli: cosCreation,
 		cosCommentCli:  cosComment,
 	}
+	d.sweeper = idempotent.NewSweeper(db, logger, idempotent.Processed{}.TableName(), ProcessedEvent{}.TableName())
+	d.sweeper.Start()
 	return d, func() {
 		l.Info("closing the data resources")
 
+		d.sweeper.Stop()
+
 		sqlDB, err := db.DB()
 		if err != nil {
 			l.Errorf("close db err: %v", err.Error())
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+)
+
+var _ biz.DeadLetterRepo = (*deadLetterRepo)(nil)
+
+type deadLetterRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDeadLetterRepo(data *Data, logger log.Logger) biz.DeadLetterRepo {
+	return &deadLetterRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "message/data/deadLetter")),
+	}
+}
+
+func (r *deadLetterRepo) AddDeadLetter(ctx context.Context, dl *biz.DeadLetter) error {
+	err := r.data.db.WithContext(ctx).Create(&DeadLetter{
+		MsgId:    dl.MsgId,
+		Mode:     dl.Mode,
+		Body:     dl.Body,
+		Reason:   dl.Reason,
+		Attempts: dl.Attempts,
+	}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add dead letter: msgId(%s), mode(%s)", dl.MsgId, dl.Mode))
+	}
+	return nil
+}
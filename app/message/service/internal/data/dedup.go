@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"gorm.io/gorm/clause"
+)
+
+var _ biz.DedupRepo = (*dedupRepo)(nil)
+
+type dedupRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDedupRepo(data *Data, logger log.Logger) biz.DedupRepo {
+	return &dedupRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "message/data/dedup")),
+	}
+}
+
+func (r *dedupRepo) GetProcessedEvent(ctx context.Context, key string) (bool, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&ProcessedEvent{}).Where("event_key = ?", key).Count(&count).Error
+	if err != nil {
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to get processed event: key(%s)", key))
+	}
+	return count > 0, nil
+}
+
+func (r *dedupRepo) AddProcessedEvent(ctx context.Context, key, mode string) (bool, error) {
+	result := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&ProcessedEvent{
+		EventKey: key,
+		Mode:     mode,
+	})
+	if result.Error != nil {
+		return false, errors.Wrapf(result.Error, fmt.Sprintf("fail to add processed event: key(%s), mode(%s)", key, mode))
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/jwtclaim"
 )
 
 var (
@@ -17,18 +18,11 @@ var (
 	ErrUnSupportSigningMethod = errors.Unauthorized("UN_SUPPORT_SIGNING_METHOD", "Wrong signing method")
 )
 
-func claimsFunc() jwt.Claims {
-	return &jwt.MapClaims{}
-}
-
+// JwtCheck returns the uuid of the token a client attached to an upload. The
+// review callbacks, their retries and dead letter replays can come long after
+// the token expired, so only its signature and issuer are checked.
 func (d *Data) JwtCheck(jwtToken string) (string, error) {
-	var (
-		tokenInfo *jwt.Token
-		err       error
-	)
-	tokenInfo, err = jwt.ParseWithClaims(jwtToken, claimsFunc(), func(token *jwt.Token) (interface{}, error) {
-		return []byte(d.jwt.key), nil
-	})
+	claims, err := d.jwt.verifier.VerifyIgnoringExpiry(jwtToken)
 	if err != nil {
 		ve, ok := err.(*jwt.ValidationError)
 		if !ok {
@@ -42,14 +36,7 @@ func (d *Data) JwtCheck(jwtToken string) (string, error) {
 		}
 		return "", ErrTokenParseFail
 	}
-	if !tokenInfo.Valid {
-		return "", ErrTokenInvalid
-	}
-	if tokenInfo.Method != jwt.SigningMethodHS256 {
-		return "", ErrUnSupportSigningMethod
-	}
-	token := *((tokenInfo.Claims.(jwt.Claims)).(*jwt.MapClaims))
-	return token["uuid"].(string), nil
+	return claims.Uuid, nil
 }
 
 func NewJwt(d *Data) biz.Jwt {
@@ -58,6 +45,6 @@ func NewJwt(d *Data) biz.Jwt {
 
 func NewJwtClient(conf *conf.Data) Jwt {
 	return Jwt{
-		key: conf.Jwt.Key,
+		verifier: jwtclaim.NewVerifier(conf.Jwt.JwksUrl, conf.Jwt.JwksTtl.AsDuration()),
 	}
 }
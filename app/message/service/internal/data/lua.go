@@ -0,0 +1,52 @@
+package data
+
+// Scripts are the lua scripts the repos run by their sha1, by name. tool/lua
+// loads them into redis.
+var Scripts = map[string]string{
+	"AddMailBoxSystemNotificationToCache": `
+					local key = KEYS[1]
+					local value = ARGV[1]
+					local uuid = ARGV[2]
+					local exist = redis.call("EXISTS", key)
+					if exist == 1 then
+						redis.call("LPUSH", key, value)
+					end
+					redis.call("HINCRBY", "message_system", uuid, 1)
+					return 0
+	`,
+	"AddMailBoxMentionToCache": `
+					local key = KEYS[1]
+					local value = ARGV[1]
+					local uuid = ARGV[2]
+					local exist = redis.call("EXISTS", key)
+					if exist == 1 then
+						redis.call("LPUSH", key, value)
+					end
+					redis.call("HINCRBY", "message_mention", uuid, 1)
+					return 0
+	`,
+	"AddCounter": `
+					local pendingKey = KEYS[1]
+					local claimKey = KEYS[2]
+					local expire = ARGV[1]
+					if claimKey ~= "" and redis.call("SET", claimKey, 1, "NX", "EX", expire) == false then
+						return -1
+					end
+					for i = 2, #ARGV, 2 do
+						redis.call("HINCRBY", pendingKey, ARGV[i], ARGV[i + 1])
+					end
+					return redis.call("HLEN", pendingKey)
+	`,
+	"NewCounterBatch": `
+					local pendingKey = KEYS[1]
+					local batchKey = KEYS[2]
+					local batchListKey = KEYS[3]
+					local batch = ARGV[1]
+					if redis.call("EXISTS", pendingKey) == 0 then
+						return 0
+					end
+					redis.call("RENAME", pendingKey, batchKey)
+					redis.call("SADD", batchListKey, batch)
+					return 1
+	`,
+}
@@ -0,0 +1,41 @@
+package data
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// TestScriptsCoverEvalSha guards against a repo calling a script by a sha1
+// that tool/lua never loads, which only shows up as NOSCRIPT in production.
+func TestScriptsCoverEvalSha(t *testing.T) {
+	loaded := make(map[string]string, len(Scripts))
+	for name, script := range Scripts {
+		sum := sha1.Sum([]byte(script))
+		loaded[hex.EncodeToString(sum[:])] = name
+	}
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	evalSha := regexp.MustCompile(`EvalSha\([^,]+,\s*"([0-9a-f]{40})"`)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range evalSha.FindAllStringSubmatch(string(src), -1) {
+			if _, ok := loaded[m[1]]; !ok {
+				t.Errorf("%s: EvalSha %s matches no script in Scripts", file, m[1])
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/pkg/errors"
+	userV1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
 	"strconv"
 	"time"
@@ -49,6 +50,7 @@ func (r *messageRepo) GetMessageNotification(ctx context.Context, uuid string, f
 		pipe.HMGet(context.Background(), "message_comment", uuid)
 		pipe.HMGet(context.Background(), "message_sub_comment", uuid)
 		pipe.HMGet(context.Background(), "message_system", uuid)
+		pipe.HMGet(context.Background(), "message_mention", uuid)
 		return nil
 	})
 	if err != nil {
@@ -100,11 +102,23 @@ func (r *messageRepo) GetMessageNotification(ctx context.Context, uuid string, f
 	}
 	system = int32(s)
 
+	var mention int32
+	var m int64
+	mentions := result[4].(*redis.SliceCmd).Val()[0]
+	if mentions != nil {
+		m, err = strconv.ParseInt(mentions.(string), 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to covert string to int64: mentions(%v)", mentions))
+		}
+	}
+	mention = int32(m)
+
 	return &biz.Notification{
 		Timeline:           timeline,
 		Comment:            comment,
 		SubComment:         subComment,
 		SystemNotification: system,
+		Mention:            mention,
 	}, nil
 }
 
@@ -221,6 +235,105 @@ func (r *messageRepo) setMessageSystemNotificationToCache(key string, notificati
 	}
 }
 
+func (r *messageRepo) GetMessageMention(ctx context.Context, page int32, uuid string) ([]*biz.Mention, error) {
+	key := "mention_notification_" + uuid
+	mention, err := r.getMessageMentionFromCache(ctx, page, key)
+	if err != nil {
+		return nil, err
+	}
+
+	size := len(mention)
+	if size != 0 {
+		return mention, nil
+	}
+
+	mention, err = r.getMessageMentionFromDB(ctx, page, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	size = len(mention)
+	if size != 0 {
+		newCtx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+		go r.data.Recover(newCtx, func(ctx context.Context) {
+			defer cancel()
+			r.setMessageMentionToCache(key, mention)
+		})()
+	}
+	return mention, nil
+}
+
+func (r *messageRepo) getMessageMentionFromCache(ctx context.Context, page int32, key string) ([]*biz.Mention, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int64(page - 1)
+	list, err := r.data.redisCli.LRange(ctx, key, index*20, index*20+19).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get message mention from cache: key(%s), page(%v)", key, page))
+	}
+
+	mentionList := make([]*biz.Mention, 0, len(list))
+	for _, item := range list {
+		var mention = &biz.Mention{}
+		err = mention.UnmarshalJSON([]byte(item))
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("json unmarshal error: mention(%v)", item))
+		}
+		mentionList = append(mentionList, mention)
+	}
+	return mentionList, nil
+}
+
+func (r *messageRepo) getMessageMentionFromDB(ctx context.Context, page int32, uuid string) ([]*biz.Mention, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int(page - 1)
+	list := make([]*Mention, 0)
+	err := r.data.db.WithContext(ctx).Select("id", "content_id", "created_at", "kind", "creation_id", "creation_type", "root_id", "uid", "uuid", "text").Where("uuid", uuid).Order("id desc").Offset(index * 20).Limit(20).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get message mention from db: page(%v), uuid(%s)", page, uuid))
+	}
+
+	mention := make([]*biz.Mention, 0, len(list))
+	for _, item := range list {
+		mention = append(mention, &biz.Mention{
+			Id:           int32(item.ID),
+			ContentId:    item.ContentId,
+			CreatedAt:    item.CreatedAt.Format("2006-01-02"),
+			Kind:         item.Kind,
+			CreationId:   item.CreationId,
+			CreationType: item.CreationType,
+			RootId:       item.RootId,
+			Uid:          item.Uid,
+			Uuid:         item.Uuid,
+			Text:         item.Text,
+		})
+	}
+	return mention, nil
+}
+
+func (r *messageRepo) setMessageMentionToCache(key string, mention []*biz.Mention) {
+	ctx := context.Background()
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		list := make([]interface{}, 0, len(mention))
+		for _, item := range mention {
+			m, err := item.MarshalJSON()
+			if err != nil {
+				return errors.Wrapf(err, fmt.Sprintf("fail to marshal mention: mention(%v)", item))
+			}
+			list = append(list, m)
+		}
+		pipe.RPush(ctx, key, list...)
+		pipe.Expire(ctx, key, time.Minute*30)
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("fail to set message mention to cache: mentions(%v), err(%v)", mention, err)
+	}
+}
+
 func (r *messageRepo) SetMailBoxLastTime(ctx context.Context, uuid string, time int32) error {
 	_, err := r.data.redisCli.HSet(ctx, "mailbox_last_time", uuid, time).Result()
 	if err != nil {
@@ -253,6 +366,14 @@ func (r *messageRepo) RemoveMailBoxSystemNotificationCount(ctx context.Context,
 	return nil
 }
 
+func (r *messageRepo) RemoveMailBoxMentionCount(ctx context.Context, uuid string) error {
+	_, err := r.data.redisCli.HSet(ctx, "message_mention", uuid, 0).Result()
+	if err != nil {
+		return errors.Wrapf(err, "fail to remove mailbox mention count: uuid(%s)", uuid)
+	}
+	return nil
+}
+
 func (r *messageRepo) AddMailBoxSystemNotification(ctx context.Context, contentId int32, notificationType string, title string, uuid string, label string, result int32, section string, text string, uid string, comment string) (*biz.SystemNotification, error) {
 	ar := &SystemNotification{
 		ContentId:        contentId,
@@ -266,7 +387,7 @@ func (r *messageRepo) AddMailBoxSystemNotification(ctx context.Context, contentI
 		Text:             text,
 		Comment:          comment,
 	}
-	err := r.data.db.WithContext(ctx).Select("ContentId", "NotificationType", "Title", "Uuid", "Uid", "Label", "Result", "Section", "Text", "Comment").Create(ar).Error
+	err := r.data.DB(ctx).Select("ContentId", "NotificationType", "Title", "Uuid", "Uid", "Label", "Result", "Section", "Text", "Comment").Create(ar).Error
 	if err != nil {
 		return nil, errors.Wrapf(err, "fail to add mail box notification: notification(%v)", ar)
 	}
@@ -299,3 +420,82 @@ func (r *messageRepo) AddMailBoxSystemNotificationToCache(ctx context.Context, n
 	}
 	return nil
 }
+
+func (r *messageRepo) AddMailBoxMention(ctx context.Context, mention *biz.Mention) (*biz.Mention, error) {
+	m := &Mention{
+		ContentId:    mention.ContentId,
+		Kind:         mention.Kind,
+		CreationId:   mention.CreationId,
+		CreationType: mention.CreationType,
+		RootId:       mention.RootId,
+		Uid:          mention.Uid,
+		Uuid:         mention.Uuid,
+		Text:         mention.Text,
+	}
+	err := r.data.DB(ctx).Create(m).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to add mail box mention: mention(%v)", m)
+	}
+	return &biz.Mention{
+		Id:           int32(m.ID),
+		ContentId:    mention.ContentId,
+		CreatedAt:    time.Now().Format("2006-01-02"),
+		Kind:         mention.Kind,
+		CreationId:   mention.CreationId,
+		CreationType: mention.CreationType,
+		RootId:       mention.RootId,
+		Uid:          mention.Uid,
+		Uuid:         mention.Uuid,
+		Text:         mention.Text,
+	}, nil
+}
+
+// AddMailBoxMentionToCache pushes mention to the cached mentions of the user
+// it names, if cached, and counts it in its mailbox.
+func (r *messageRepo) AddMailBoxMentionToCache(ctx context.Context, mention *biz.Mention) error {
+	marshal, err := mention.MarshalJSON()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set mention to json: json.Marshal(%v)", mention))
+	}
+	keys := []string{"mention_notification_" + mention.Uuid}
+	values := []interface{}{marshal, mention.Uuid}
+	_, err = r.data.redisCli.EvalSha(ctx, "23d45d62a7a918b5986fd2f8cf47bc4f1f67da9e", keys, values...).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set mention to cache: mention(%v)", mention))
+	}
+	return nil
+}
+
+// GetHandleUuids maps the handles held by someone to the users holding them.
+func (r *messageRepo) GetHandleUuids(ctx context.Context, handles []string) (map[string]string, error) {
+	reply, err := r.data.uc.GetHandleUuids(ctx, &userV1.GetHandleUuidsReq{
+		Handles: handles,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Uuids, nil
+}
+
+// GetUserBlock reports whether uuid muted userUuid or either blocked the other.
+func (r *messageRepo) GetUserBlock(ctx context.Context, uuid, userUuid string) (bool, error) {
+	reply, err := r.data.uc.GetUserBlock(ctx, &userV1.GetUserBlockReq{
+		Uuid:     uuid,
+		UserUuid: userUuid,
+	})
+	if err != nil {
+		return false, err
+	}
+	return reply.Blocked || reply.Muted, nil
+}
+
+// GetUserBlocks lists the users uuid blocked or muted.
+func (r *messageRepo) GetUserBlocks(ctx context.Context, uuid string) ([]string, error) {
+	reply, err := r.data.uc.GetUserBlocks(ctx, &userV1.GetUserBlocksReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(reply.Blocked, reply.Muted...), nil
+}
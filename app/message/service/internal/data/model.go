@@ -1,6 +1,9 @@
 package data
 
-import "gorm.io/gorm"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type SystemNotification struct {
 	gorm.Model
@@ -15,3 +18,74 @@ type SystemNotification struct {
 	Text             string
 	Comment          string `gorm:"size:100"`
 }
+
+// Mention tells Uuid that Uid mentioned it in the content ContentId of Kind:
+// an article, a talk, a comment on the creation CreationId of CreationType, or
+// a sub comment under the comment RootId.
+type Mention struct {
+	gorm.Model
+	ContentId    int32
+	Kind         string `gorm:"size:20"`
+	CreationId   int32
+	CreationType int32
+	RootId       int32
+	Uid          string `gorm:"size:20"`
+	Uuid         string `gorm:"index;size:20"`
+	Text         string `gorm:"size:100"`
+}
+
+// ContentFlag waits for a moderator while Status is 1, and was published or
+// removed by one once it is 2 or 3.
+type ContentFlag struct {
+	gorm.Model
+	Kind         string `gorm:"index:idx_status_kind,priority:2;size:20"`
+	Mode         string `gorm:"size:20"`
+	ContentId    int32
+	Auth         int32
+	CreationId   int32
+	CreationType int32
+	RootId       int32
+	ParentId     int32
+	Uuid         string `gorm:"index;size:20"`
+	Text         string `gorm:"type:text"`
+	Object       string `gorm:"size:1000"`
+	JobId        string `gorm:"uniqueIndex;size:100"`
+	Label        string `gorm:"size:100"`
+	Section      string `gorm:"type:text"`
+	Status       int32  `gorm:"index:idx_status_kind,priority:1"`
+}
+
+// Appeal waits for a moderator while Status is 1, and was accepted or
+// rejected by one once it is 2 or 3. A review job is appealed once.
+type Appeal struct {
+	gorm.Model
+	FlagId int32  `gorm:"index"`
+	JobId  string `gorm:"uniqueIndex;size:100"`
+	Uuid   string `gorm:"index;size:20"`
+	Reason string `gorm:"size:500"`
+	Status int32  `gorm:"index"`
+}
+
+type DeadLetter struct {
+	gorm.Model
+	MsgId    string `gorm:"index;size:100"`
+	Mode     string `gorm:"index;size:100"`
+	Body     string `gorm:"type:text"`
+	Reason   string `gorm:"type:text"`
+	Attempts int32
+}
+
+// ProcessedEvent spells out gorm.Model to index CreatedAt, by which the
+// events older than idempotent.Retention are swept.
+type ProcessedEvent struct {
+	ID        uint      `gorm:"primarykey"`
+	CreatedAt time.Time `gorm:"index"`
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	EventKey  string         `gorm:"uniqueIndex;size:100"`
+	Mode      string         `gorm:"size:100"`
+}
+
+func (ProcessedEvent) TableName() string {
+	return "processed_events"
+}
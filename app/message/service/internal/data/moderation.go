@@ -0,0 +1,242 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ biz.ModerationRepo = (*moderationRepo)(nil)
+
+type moderationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewModerationRepo(data *Data, logger log.Logger) biz.ModerationRepo {
+	return &moderationRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "message/data/moderation")),
+	}
+}
+
+// AddContentFlag records flag once per review job, so that a review delivered
+// again is not queued twice.
+func (r *moderationRepo) AddContentFlag(ctx context.Context, flag *biz.ContentFlag) error {
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&ContentFlag{
+		Kind:         flag.Kind,
+		Mode:         flag.Mode,
+		ContentId:    flag.ContentId,
+		Auth:         flag.Auth,
+		CreationId:   flag.CreationId,
+		CreationType: flag.CreationType,
+		RootId:       flag.RootId,
+		ParentId:     flag.ParentId,
+		Uuid:         flag.Uuid,
+		Text:         flag.Text,
+		Object:       flag.Object,
+		JobId:        flag.JobId,
+		Label:        flag.Label,
+		Section:      flag.Section,
+		Status:       1,
+	}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add content flag: kind(%s), id(%v), jobId(%s)", flag.Kind, flag.ContentId, flag.JobId))
+	}
+	return nil
+}
+
+func (r *moderationRepo) GetContentFlagList(ctx context.Context, page int32, kind string, status int32) ([]*biz.ContentFlag, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int(page - 1)
+	list := make([]*ContentFlag, 0)
+	db := r.data.db.WithContext(ctx).Where("status = ?", status)
+	if kind != "" {
+		db = db.Where("kind = ?", kind)
+	}
+	err := db.Order("id desc").Offset(index * 20).Limit(20).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get content flag list from db: page(%v), kind(%s), status(%v)", page, kind, status))
+	}
+
+	reply := make([]*biz.ContentFlag, 0, len(list))
+	for _, item := range list {
+		reply = append(reply, contentFlag(item))
+	}
+	return reply, nil
+}
+
+// LockContentFlag reads the flag id and locks it until the transaction ends.
+func (r *moderationRepo) LockContentFlag(ctx context.Context, id int32) (*biz.ContentFlag, error) {
+	flag := &ContentFlag{}
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(flag).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("content flag not found from db", fmt.Sprintf("id(%v)", id))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to lock content flag: id(%v)", id))
+	}
+	return contentFlag(flag), nil
+}
+
+func (r *moderationRepo) SetContentFlagStatus(ctx context.Context, id, status int32) error {
+	err := r.data.DB(ctx).Model(&ContentFlag{}).Where("id = ?", id).Update("status", status).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set content flag status: id(%v), status(%v)", id, status))
+	}
+	return nil
+}
+
+// LockContentFlagByJobId reads the flag of the review job jobId and locks it
+// until the transaction ends.
+func (r *moderationRepo) LockContentFlagByJobId(ctx context.Context, jobId string) (*biz.ContentFlag, error) {
+	flag := &ContentFlag{}
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("job_id = ?", jobId).First(flag).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("content flag not found from db", fmt.Sprintf("jobId(%s)", jobId))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to lock content flag: jobId(%s)", jobId))
+	}
+	return contentFlag(flag), nil
+}
+
+// AddAppeal records appeal, failing with Conflict when its job was appealed
+// before.
+func (r *moderationRepo) AddAppeal(ctx context.Context, appeal *biz.Appeal) error {
+	result := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&Appeal{
+		FlagId: appeal.FlagId,
+		JobId:  appeal.JobId,
+		Uuid:   appeal.Uuid,
+		Reason: appeal.Reason,
+		Status: 1,
+	})
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, fmt.Sprintf("fail to add appeal: jobId(%s), uuid(%s)", appeal.JobId, appeal.Uuid))
+	}
+	if result.RowsAffected == 0 {
+		return kerrors.Conflict("appeal exist", fmt.Sprintf("jobId(%s)", appeal.JobId))
+	}
+	return nil
+}
+
+func (r *moderationRepo) GetAppeal(ctx context.Context, id int32) (*biz.Appeal, error) {
+	appeal := &Appeal{}
+	err := r.data.db.WithContext(ctx).Where("id = ?", id).First(appeal).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("appeal not found from db", fmt.Sprintf("id(%v)", id))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get appeal from db: id(%v)", id))
+	}
+	return bizAppeal(appeal), nil
+}
+
+// LockAppeal reads the appeal id and locks it until the transaction ends.
+func (r *moderationRepo) LockAppeal(ctx context.Context, id int32) (*biz.Appeal, error) {
+	appeal := &Appeal{}
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(appeal).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("appeal not found from db", fmt.Sprintf("id(%v)", id))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to lock appeal: id(%v)", id))
+	}
+	return bizAppeal(appeal), nil
+}
+
+// GetAppealList lists the appeals of uuid, of anyone when empty, in status, any
+// status when 0, each with its flag.
+func (r *moderationRepo) GetAppealList(ctx context.Context, page int32, uuid string, status int32) ([]*biz.Appeal, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int(page - 1)
+	list := make([]*Appeal, 0)
+	db := r.data.db.WithContext(ctx)
+	if uuid != "" {
+		db = db.Where("uuid = ?", uuid)
+	}
+	if status != 0 {
+		db = db.Where("status = ?", status)
+	}
+	err := db.Order("id desc").Offset(index * 20).Limit(20).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get appeal list from db: page(%v), uuid(%s), status(%v)", page, uuid, status))
+	}
+
+	ids := make([]int32, 0, len(list))
+	for _, item := range list {
+		ids = append(ids, item.FlagId)
+	}
+	flagList := make([]*ContentFlag, 0)
+	if len(ids) > 0 {
+		err = r.data.db.WithContext(ctx).Where("id IN ?", ids).Find(&flagList).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get appeal content flags from db: ids(%v)", ids))
+		}
+	}
+	flags := make(map[int32]*biz.ContentFlag, len(flagList))
+	for _, item := range flagList {
+		flags[int32(item.ID)] = contentFlag(item)
+	}
+
+	reply := make([]*biz.Appeal, 0, len(list))
+	for _, item := range list {
+		appeal := bizAppeal(item)
+		appeal.Flag = flags[item.FlagId]
+		reply = append(reply, appeal)
+	}
+	return reply, nil
+}
+
+// SetAppealStatus resolves the pending appeal of the review job jobId, if there
+// is one.
+func (r *moderationRepo) SetAppealStatus(ctx context.Context, jobId string, status int32) error {
+	err := r.data.DB(ctx).Model(&Appeal{}).Where("job_id = ? and status = ?", jobId, 1).Update("status", status).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set appeal status: jobId(%s), status(%v)", jobId, status))
+	}
+	return nil
+}
+
+func bizAppeal(appeal *Appeal) *biz.Appeal {
+	return &biz.Appeal{
+		Id:        int32(appeal.ID),
+		FlagId:    appeal.FlagId,
+		JobId:     appeal.JobId,
+		Uuid:      appeal.Uuid,
+		Reason:    appeal.Reason,
+		Status:    appeal.Status,
+		CreatedAt: appeal.CreatedAt.Unix(),
+	}
+}
+
+func contentFlag(flag *ContentFlag) *biz.ContentFlag {
+	return &biz.ContentFlag{
+		Id:           int32(flag.ID),
+		Kind:         flag.Kind,
+		Mode:         flag.Mode,
+		ContentId:    flag.ContentId,
+		Auth:         flag.Auth,
+		CreationId:   flag.CreationId,
+		CreationType: flag.CreationType,
+		RootId:       flag.RootId,
+		ParentId:     flag.ParentId,
+		Uuid:         flag.Uuid,
+		Text:         flag.Text,
+		Object:       flag.Object,
+		JobId:        flag.JobId,
+		Label:        flag.Label,
+		Section:      flag.Section,
+		Status:       flag.Status,
+		CreatedAt:    flag.CreatedAt.Unix(),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/tencentyun/cos-go-sdk-v5"
 	userV1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"net/http"
 	"strings"
 )
@@ -27,8 +28,8 @@ func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
 	}
 }
 
-func (r *userRepo) UploadProfileToCos(msg map[string]interface{}) error {
-	key := "profile/" + msg["uuid"].(string)
+func (r *userRepo) UploadProfileToCos(profile *event.Profile) error {
+	key := "profile/" + profile.Uuid
 
 	opt := &cos.ObjectPutOptions{
 		ObjectPutHeaderOptions: &cos.ObjectPutHeaderOptions{
@@ -37,12 +38,12 @@ func (r *userRepo) UploadProfileToCos(msg map[string]interface{}) error {
 		},
 	}
 
-	opt.XCosMetaXXX.Add("x-cos-meta-uuid", msg["uuid"].(string))
-	opt.XCosMetaXXX.Add("x-cos-meta-update", msg["updated"].(string))
+	opt.XCosMetaXXX.Add("x-cos-meta-uuid", profile.Uuid)
+	opt.XCosMetaXXX.Add("x-cos-meta-update", profile.Updated)
 
-	m, err := json.Marshal(msg)
+	m, err := json.Marshal(profile)
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to marshal profile message: profile(%v)", msg))
+		return errors.Wrapf(err, fmt.Sprintf("fail to marshal profile message: profile(%v)", profile))
 	}
 
 	f := strings.NewReader(string(m))
@@ -50,7 +51,7 @@ func (r *userRepo) UploadProfileToCos(msg map[string]interface{}) error {
 		context.Background(), key, f, opt,
 	)
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to upload profile to cos: profile(%v)", msg))
+		return errors.Wrapf(err, fmt.Sprintf("fail to upload profile to cos: profile(%v)", profile))
 	}
 	return nil
 }
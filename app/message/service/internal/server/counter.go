@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"time"
+)
+
+const defaultCounterWindow = time.Second
+
+// CounterServer flushes the counters staged by the counter usecase every
+// window, or earlier once too many are staged, and a last time on stop.
+type CounterServer struct {
+	counter *biz.CounterUseCase
+	window  time.Duration
+	cancel  context.CancelFunc
+	stopped chan struct{}
+	log     *log.Helper
+}
+
+func NewCounterServer(conf *conf.Server, counter *biz.CounterUseCase, logger log.Logger) *CounterServer {
+	window := conf.Counter.GetWindow().AsDuration()
+	if window <= 0 {
+		window = defaultCounterWindow
+	}
+	return &CounterServer{
+		counter: counter,
+		window:  window,
+		stopped: make(chan struct{}),
+		log:     log.NewHelper(log.With(logger, "server", "message/server/counter")),
+	}
+}
+
+func (s *CounterServer) Start(_ context.Context) error {
+	log.Info("counter starting")
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run(ctx)
+	return nil
+}
+
+func (s *CounterServer) Stop(ctx context.Context) error {
+	log.Info("counter closing")
+	if s.cancel != nil {
+		s.cancel()
+		<-s.stopped
+	}
+	return s.counter.Close(ctx)
+}
+
+func (s *CounterServer) run(ctx context.Context) {
+	defer close(s.stopped)
+	ticker := time.NewTicker(s.window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.counter.Full():
+		}
+		_ = s.counter.Flush(ctx)
+	}
+}
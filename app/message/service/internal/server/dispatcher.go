@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/app/message/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"sync"
+)
+
+const (
+	defaultBatchSize = 1
+	defaultWorkers   = 20
+)
+
+// errHeld fails a delivery that was not handled because an earlier delivery
+// with its order key failed.
+var errHeld = errors.New("held behind a failed delivery of the same order key")
+
+// delivery is one message of a consumed batch. attempt counts its earlier
+// deliveries.
+type delivery struct {
+	id       string
+	body     []byte
+	attempt  int32
+	orderKey string
+}
+
+// dispatcher runs the deliveries of a batch through the handler registry on
+// bounded worker pools. Modes with their own pool in the config can't be
+// slowed down by the rest, which share one pool.
+type dispatcher struct {
+	registry *service.HandlerRegistry
+	shared   chan struct{}
+	pools    map[string]chan struct{}
+}
+
+func newDispatcher(c *conf.Server_Consumer, registry *service.HandlerRegistry) *dispatcher {
+	workers := c.GetWorkers()
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	d := &dispatcher{
+		registry: registry,
+		shared:   make(chan struct{}, workers),
+		pools:    map[string]chan struct{}{},
+	}
+	for mode, n := range c.GetModeWorkers() {
+		if n > 0 {
+			d.pools[mode] = make(chan struct{}, n)
+		}
+	}
+	return d
+}
+
+func batchSize(c *conf.Server_Consumer) int {
+	if c.GetBatchSize() <= 0 {
+		return defaultBatchSize
+	}
+	return int(c.GetBatchSize())
+}
+
+// dispatch handles ds and returns the error of each. Deliveries sharing an
+// order key are handled one after another in batch order and the ones after
+// a failure fail with errHeld; all others run in parallel.
+func (d *dispatcher) dispatch(ctx context.Context, ds []*delivery) []error {
+	errs := make([]error, len(ds))
+	if len(ds) == 1 {
+		errs[0] = d.handle(ctx, ds[0])
+		return errs
+	}
+
+	lanes := map[string][]int{}
+	var order []string
+	for i, dl := range ds {
+		key := dl.orderKey
+		if key == "" {
+			key = dl.id
+		}
+		if _, ok := lanes[key]; !ok {
+			order = append(order, key)
+		}
+		lanes[key] = append(lanes[key], i)
+	}
+
+	var wg sync.WaitGroup
+	for _, key := range order {
+		wg.Add(1)
+		go func(lane []int) {
+			defer wg.Done()
+			var failed bool
+			for _, i := range lane {
+				if failed {
+					errs[i] = errHeld
+					continue
+				}
+				errs[i] = d.handle(ctx, ds[i])
+				failed = errs[i] != nil
+			}
+		}(lanes[key])
+	}
+	wg.Wait()
+	return errs
+}
+
+func (d *dispatcher) handle(ctx context.Context, dl *delivery) error {
+	pool, ok := d.pools[peekMode(dl.body)]
+	if !ok {
+		pool = d.shared
+	}
+	select {
+	case pool <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-pool }()
+	return d.registry.Handle(ctx, dl.id, dl.body, dl.attempt)
+}
+
+func peekMode(body []byte) string {
+	h := &event.Header{}
+	_ = easyjson.Unmarshal(body, h)
+	return h.Mode
+}
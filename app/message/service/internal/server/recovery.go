@@ -5,20 +5,30 @@ import (
 	"github.com/apache/rocketmq-client-go/v2/consumer"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
 	"runtime"
 )
 
+// MqRecovery turns a panic of fn into a redelivery of the batch, which would
+// otherwise be acknowledged by the zero ConsumeResult and lost.
 func MqRecovery(fn func(ctx context.Context,
 	msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error)) func(ctx context.Context,
 	msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
 	return func(ctx context.Context,
-		msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+		msgs ...*primitive.MessageExt) (result consumer.ConsumeResult, err error) {
 		defer func() {
 			if rerr := recover(); rerr != nil {
 				buf := make([]byte, 64<<10)
 				n := runtime.Stack(buf, false)
 				buf = buf[:n]
 				log.Context(ctx).Errorf("%v: %+v\n%s\n", rerr, msgs, buf)
+				var reconsumeTimes int32
+				for _, msg := range msgs {
+					if msg.ReconsumeTimes > reconsumeTimes {
+						reconsumeTimes = msg.ReconsumeTimes
+					}
+				}
+				result, err = retryLater(ctx, reconsumeTimes), errors.Errorf("consumer panic: %v", rerr)
 			}
 		}()
 		return fn(ctx, msgs...)
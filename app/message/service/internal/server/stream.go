@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/app/message/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	streamGroup     = "matrix-message"
+	streamBatch     = 16
+	streamBlock     = 5 * time.Second
+	reclaimInterval = 5 * time.Second
+)
+
+// retryBackoff is how long a failed entry stays pending before it is
+// claimed again, following the broker delay levels of delayLevels.
+var retryBackoff = []time.Duration{
+	10 * time.Second, 30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute,
+	8 * time.Minute, 20 * time.Minute, 30 * time.Minute, time.Hour, 2 * time.Hour,
+}
+
+func backoff(retryCount int64) time.Duration {
+	if retryCount < 1 {
+		return retryBackoff[0]
+	}
+	if int(retryCount) > len(retryBackoff) {
+		return retryBackoff[len(retryBackoff)-1]
+	}
+	return retryBackoff[retryCount-1]
+}
+
+// RedisStreamConsumerServer consumes the matrix stream through a consumer
+// group. Entries whose handler fails are left pending and are claimed again
+// once they have been idle for their backoff, which also picks up the entries
+// of consumers that died before acknowledging them.
+//
+// An entry with an order key isn't handled while an older entry of the key
+// read by this consumer is still pending; it waits in the pending list behind
+// it. Ordering therefore holds per consumer: an entry claimed from another
+// consumer is handled once the key is free here, at the cost of one attempt.
+type RedisStreamConsumerServer struct {
+	client     *redis.Client
+	group      string
+	consumer   string
+	block      time.Duration
+	batch      int64
+	dispatcher *dispatcher
+	held       map[string]string
+	keys       map[string]string
+	cancel     context.CancelFunc
+	stopped    chan struct{}
+	log        *log.Helper
+}
+
+func NewRedisStreamConsumerServer(cs *conf.Server, conf *conf.Data, registry *service.HandlerRegistry, logger log.Logger) *RedisStreamConsumerServer {
+	l := log.NewHelper(log.With(logger, "server", "message/server/redis-stream-consumer"))
+	client := redis.NewClient(&redis.Options{
+		Addr:        conf.RedisStream.Addr,
+		DB:          int(conf.RedisStream.Db),
+		DialTimeout: time.Second * 2,
+		PoolSize:    10,
+		Password:    conf.RedisStream.Password,
+	})
+	consumer := conf.RedisStream.Consumer
+	if consumer == "" {
+		consumer, _ = os.Hostname()
+	}
+	group := conf.RedisStream.Group
+	if group == "" {
+		group = streamGroup
+	}
+	block := conf.RedisStream.Block.AsDuration()
+	if block <= 0 {
+		block = streamBlock
+	}
+	batch := int64(cs.Consumer.GetBatchSize())
+	if batch <= 0 {
+		batch = streamBatch
+	}
+	return &RedisStreamConsumerServer{
+		client:     client,
+		group:      group,
+		consumer:   consumer,
+		block:      block,
+		batch:      batch,
+		dispatcher: newDispatcher(cs.Consumer, registry),
+		held:       map[string]string{},
+		keys:       map[string]string{},
+		stopped:    make(chan struct{}),
+		log:        l,
+	}
+}
+
+func (s *RedisStreamConsumerServer) Start(ctx context.Context) error {
+	log.Info("redis stream consumer starting")
+	err := s.client.XGroupCreateMkStream(ctx, event.Topic, s.group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run(ctx)
+	return nil
+}
+
+func (s *RedisStreamConsumerServer) Stop(_ context.Context) error {
+	log.Info("redis stream consumer closing")
+	if s.cancel != nil {
+		s.cancel()
+		<-s.stopped
+	}
+	return s.client.Close()
+}
+
+func (s *RedisStreamConsumerServer) run(ctx context.Context) {
+	defer close(s.stopped)
+	var reclaimed time.Time
+	for ctx.Err() == nil {
+		if time.Since(reclaimed) >= reclaimInterval {
+			s.reclaim(ctx)
+			reclaimed = time.Now()
+		}
+
+		streams, err := s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  []string{event.Topic, ">"},
+			Count:    s.batch,
+			Block:    s.block,
+		}).Result()
+		if err == redis.Nil || ctx.Err() != nil {
+			continue
+		}
+		if err != nil {
+			s.log.Errorf("fail to read stream: group(%s), err(%s)", s.group, err.Error())
+			time.Sleep(time.Second)
+			continue
+		}
+		for _, stream := range streams {
+			attempts := make([]int32, len(stream.Messages))
+			s.handle(ctx, stream.Messages, attempts)
+		}
+	}
+}
+
+// reclaim pages through the pending list of the group, claims the entries
+// that have been idle for their backoff and handles them again.
+func (s *RedisStreamConsumerServer) reclaim(ctx context.Context) {
+	claimed := map[string]bool{}
+	start := "-"
+	for ctx.Err() == nil {
+		pending, err := s.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: event.Topic,
+			Group:  s.group,
+			Start:  start,
+			End:    "+",
+			Count:  s.batch,
+		}).Result()
+		if err == redis.Nil {
+			return
+		}
+		if err != nil {
+			s.log.Errorf("fail to get pending entries: group(%s), start(%s), err(%s)", s.group, start, err.Error())
+			return
+		}
+		s.reclaimPage(ctx, pending, claimed)
+		if int64(len(pending)) < s.batch {
+			return
+		}
+		last := pending[len(pending)-1].ID
+		if start = nextID(last); start == last {
+			return
+		}
+	}
+}
+
+// reclaimPage claims and handles the due entries of one page of the pending
+// list. claimed collects the entries claimed by the pages before it.
+func (s *RedisStreamConsumerServer) reclaimPage(ctx context.Context, pending []redis.XPendingExt, claimed map[string]bool) {
+	var msgs []redis.XMessage
+	var attempts []int32
+	for _, p := range pending {
+		if key, ok := s.keys[p.ID]; ok {
+			if owner := s.held[key]; owner != p.ID && !claimed[owner] && s.pending(ctx, owner) {
+				continue
+			}
+		}
+		wait := backoff(p.RetryCount)
+		if p.Idle < wait {
+			continue
+		}
+		claim, err := s.client.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   event.Topic,
+			Group:    s.group,
+			Consumer: s.consumer,
+			MinIdle:  wait,
+			Messages: []string{p.ID},
+		}).Result()
+		if err != nil {
+			s.log.Errorf("fail to claim pending entry: id(%s), err(%s)", p.ID, err.Error())
+			continue
+		}
+		if len(claim) == 0 || claim[0].Values == nil {
+			// trimmed off the stream while pending, nothing left to handle
+			s.ack(ctx, p.ID)
+			continue
+		}
+		claimed[p.ID] = true
+		msgs = append(msgs, claim[0])
+		attempts = append(attempts, int32(p.RetryCount))
+	}
+	if len(msgs) > 0 {
+		s.handle(ctx, msgs, attempts)
+	}
+}
+
+// nextID returns the smallest entry id after id, so that a range starting at
+// it leaves id out.
+func nextID(id string) string {
+	i := strings.IndexByte(id, '-')
+	if i < 0 {
+		return id
+	}
+	seq, err := strconv.ParseUint(id[i+1:], 10, 64)
+	if err != nil {
+		return id
+	}
+	if seq == math.MaxUint64 {
+		ms, err := strconv.ParseUint(id[:i], 10, 64)
+		if err != nil {
+			return id
+		}
+		return strconv.FormatUint(ms+1, 10) + "-0"
+	}
+	return id[:i+1] + strconv.FormatUint(seq+1, 10)
+}
+
+// handle runs msgs through the dispatcher and acknowledges the ones that
+// shouldn't be redelivered. attempts counts the earlier deliveries of each.
+// The entries of a key held by an older pending entry outside msgs are left
+// pending untouched.
+func (s *RedisStreamConsumerServer) handle(ctx context.Context, msgs []redis.XMessage, attempts []int32) {
+	inBatch := map[string]bool{}
+	for _, msg := range msgs {
+		inBatch[msg.ID] = true
+	}
+
+	ds := make([]*delivery, 0, len(msgs))
+	for i, msg := range msgs {
+		body, _ := msg.Values[event.StreamBody].(string)
+		key, _ := msg.Values[event.StreamOrderKey].(string)
+		if key != "" {
+			s.keys[msg.ID] = key
+			if owner, ok := s.held[key]; ok && !inBatch[owner] {
+				continue
+			}
+		}
+		ds = append(ds, &delivery{
+			id:       msg.ID,
+			body:     []byte(body),
+			attempt:  attempts[i],
+			orderKey: key,
+		})
+	}
+
+	for i, err := range s.dispatcher.dispatch(ctx, ds) {
+		dl := ds[i]
+		if err != nil {
+			if !errors.Is(err, errHeld) {
+				s.log.Errorf("fail to consume msg: id(%s), reconsumeTimes(%v), err(%s)", dl.id, dl.attempt, err.Error())
+			}
+			if dl.orderKey != "" && !inBatch[s.held[dl.orderKey]] {
+				s.held[dl.orderKey] = dl.id
+			}
+			continue
+		}
+		s.ack(ctx, dl.id)
+	}
+}
+
+// pending reports whether id is still pending in the group, it may have been
+// claimed and acknowledged by another consumer meanwhile. An entry that is no
+// longer pending frees its order key.
+func (s *RedisStreamConsumerServer) pending(ctx context.Context, id string) bool {
+	if id == "" {
+		return false
+	}
+	pending, err := s.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: event.Topic,
+		Group:  s.group,
+		Start:  id,
+		End:    id,
+		Count:  1,
+	}).Result()
+	if err != nil && err != redis.Nil {
+		return true
+	}
+	if len(pending) > 0 {
+		return true
+	}
+	s.forget(id)
+	return false
+}
+
+// ack acknowledges id and frees its order key.
+func (s *RedisStreamConsumerServer) ack(ctx context.Context, id string) {
+	err := s.client.XAck(ctx, event.Topic, s.group, id).Err()
+	if err != nil {
+		s.log.Errorf("fail to ack msg: id(%s), err(%s)", id, err.Error())
+		return
+	}
+	s.forget(id)
+}
+
+func (s *RedisStreamConsumerServer) forget(id string) {
+	if key, ok := s.keys[id]; ok {
+		if s.held[key] == id {
+			delete(s.held, key)
+		}
+		delete(s.keys, id)
+	}
+}
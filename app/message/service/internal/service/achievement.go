@@ -2,26 +2,16 @@ package service
 
 import (
 	"context"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/event"
 )
 
-func (s *MessageService) SetAchievementAgree(ctx context.Context, uuid, userUuid string) error {
-	return s.ac.SetAchievementAgree(ctx, uuid, userUuid)
+func (s *MessageService) AddAchievementAgree(ctx context.Context, uuid, userUuid string, n int32) error {
+	return s.counter.AddAgree(ctx, uuid, userUuid, n)
 }
 
-func (s *MessageService) CancelAchievementAgree(ctx context.Context, uuid, userUuid string) error {
-	return s.ac.CancelAchievementAgree(ctx, uuid, userUuid)
-}
-
-func (s *MessageService) SetAchievementView(ctx context.Context, uuid string) error {
-	return s.ac.SetAchievementView(ctx, uuid)
-}
-
-func (s *MessageService) SetAchievementCollect(ctx context.Context, uuid string) error {
-	return s.ac.SetAchievementCollect(ctx, uuid)
-}
-
-func (s *MessageService) CancelAchievementCollect(ctx context.Context, uuid string) error {
-	return s.ac.CancelAchievementCollect(ctx, uuid)
+func (s *MessageService) AddAchievement(ctx context.Context, delta *biz.AchievementDelta) error {
+	return s.counter.AddAchievement(ctx, delta)
 }
 
 func (s *MessageService) SetAchievementFollow(ctx context.Context, follow, followed string) error {
@@ -32,10 +22,6 @@ func (s *MessageService) CancelAchievementFollow(ctx context.Context, follow, fo
 	return s.ac.CancelAchievementFollow(ctx, follow, followed)
 }
 
-func (s *MessageService) AddAchievementScore(ctx context.Context, uuid string, score int32) error {
-	return s.ac.AddAchievementScore(ctx, uuid, score)
-}
-
 func (s *MessageService) SetUserMedalDbAndCache(ctx context.Context, medal, uuid string) error {
 	return s.ac.SetUserMedalDbAndCache(ctx, medal, uuid)
 }
@@ -47,3 +33,50 @@ func (s *MessageService) CancelUserMedalDbAndCache(ctx context.Context, medal, u
 func (s *MessageService) AccessUserMedalDbAndCache(ctx context.Context, medal, uuid string) error {
 	return s.ac.AccessUserMedalDbAndCache(ctx, medal, uuid)
 }
+
+func (s *MessageService) registerAchievementHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Achievement)
+		return s.AddAchievementAgree(ctx, m.Uuid, m.UserUuid, m.Times())
+	})
+	r.Register(event.ModeAgreeCancel, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Achievement)
+		return s.AddAchievementAgree(ctx, m.Uuid, m.UserUuid, -m.Times())
+	})
+	r.Register(event.ModeView, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Achievement)
+		return s.AddAchievement(ctx, &biz.AchievementDelta{Uuid: m.Uuid, View: m.Times()})
+	})
+	r.Register(event.ModeCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Achievement)
+		return s.AddAchievement(ctx, &biz.AchievementDelta{Uuid: m.Uuid, Collect: m.Times()})
+	})
+	r.Register(event.ModeCollectCancel, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Achievement)
+		return s.AddAchievement(ctx, &biz.AchievementDelta{Uuid: m.Uuid, Collect: -m.Times()})
+	})
+	r.Register(event.ModeFollow, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.AchievementFollow)
+		return s.SetAchievementFollow(ctx, m.Follow, m.Followed)
+	})
+	r.Register(event.ModeFollowCancel, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.AchievementFollow)
+		return s.CancelAchievementFollow(ctx, m.Follow, m.Followed)
+	})
+	r.Register(event.ModeAddScore, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Score)
+		return s.AddAchievement(ctx, &biz.AchievementDelta{Uuid: m.Uuid, Score: m.Score})
+	})
+	r.Register(event.ModeSetUserMedal, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Medal)
+		return s.SetUserMedalDbAndCache(ctx, m.Medal, m.Uuid)
+	})
+	r.Register(event.ModeCancelUserMedal, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Medal)
+		return s.CancelUserMedalDbAndCache(ctx, m.Medal, m.Uuid)
+	})
+	r.Register(event.ModeAccessUserMedal, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Medal)
+		return s.AccessUserMedalDbAndCache(ctx, m.Medal, m.Uuid)
+	})
+}
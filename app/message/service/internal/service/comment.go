@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	v1 "github.com/the-zion/matrix-core/api/message/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -75,3 +76,50 @@ func (s *MessageService) CancelSubCommentAgreeDbAndCache(ctx context.Context, id
 func (s *MessageService) AddCommentContentReviewDbAndCache(ctx context.Context, commentId, result int32, uuid, jobId, label, comment, kind string, section string) error {
 	return s.commc.AddCommentContentReviewDbAndCache(ctx, commentId, result, uuid, jobId, label, comment, kind, section)
 }
+
+func (s *MessageService) registerCommentHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeCommentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewCreateComment(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeSubCommentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewCreateSubComment(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCreateComment, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.CreateCommentDbAndCache(ctx, m.Id, m.CreationId, m.CreationType, m.Uuid)
+	})
+	r.Register(event.ModeCreateSubComment, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.CreateSubCommentDbAndCache(ctx, m.Id, m.RootId, m.ParentId, m.Uuid)
+	})
+	r.Register(event.ModeRemoveComment, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.RemoveCommentDbAndCache(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeRemoveSubComment, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.RemoveSubCommentDbAndCache(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeSetCommentAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.SetCommentAgreeDbAndCache(ctx, m.Id, m.CreationId, m.CreationType, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeSetSubCommentAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.SetSubCommentAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelCommentAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.CancelCommentAgreeDbAndCache(ctx, m.Id, m.CreationId, m.CreationType, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelSubCommentAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Comment)
+		return s.CancelSubCommentAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeAddCommentContentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.TextReview)
+		return s.AddCommentContentReviewDbAndCache(ctx, m.CommentId, m.Result, m.Uuid, m.JobId, m.Label, m.Comment, m.Kind, m.Section)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	v1 "github.com/the-zion/matrix-core/api/message/service/v1"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -76,8 +77,8 @@ func (s *MessageService) DeleteArticleCacheAndSearch(ctx context.Context, id int
 	return s.cc.DeleteArticleCacheAndSearch(ctx, id, uuid)
 }
 
-func (s *MessageService) SetArticleViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	return s.cc.SetArticleViewDbAndCache(ctx, id, uuid)
+func (s *MessageService) AddArticleView(ctx context.Context, id int32, uuid string, repeat bool) error {
+	return s.counter.AddView(ctx, biz.CounterArticle, id, uuid, repeat)
 }
 
 func (s *MessageService) SetArticleAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error {
@@ -180,8 +181,8 @@ func (s *MessageService) DeleteTalkCacheAndSearch(ctx context.Context, id int32,
 	return s.cc.DeleteTalkCacheAndSearch(ctx, id, uuid)
 }
 
-func (s *MessageService) SetTalkViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	return s.cc.SetTalkViewDbAndCache(ctx, id, uuid)
+func (s *MessageService) AddTalkView(ctx context.Context, id int32, uuid string, repeat bool) error {
+	return s.counter.AddView(ctx, biz.CounterTalk, id, uuid, repeat)
 }
 
 func (s *MessageService) SetTalkAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error {
@@ -276,8 +277,8 @@ func (s *MessageService) DeleteColumnCacheAndSearch(ctx context.Context, id int3
 	return s.cc.DeleteColumnCacheAndSearch(ctx, id, uuid)
 }
 
-func (s *MessageService) SetColumnViewDbAndCache(ctx context.Context, id int32, uuid string) error {
-	return s.cc.SetColumnViewDbAndCache(ctx, id, uuid)
+func (s *MessageService) AddColumnView(ctx context.Context, id int32, uuid string, repeat bool) error {
+	return s.counter.AddView(ctx, biz.CounterColumn, id, uuid, repeat)
 }
 
 func (s *MessageService) SetColumnAgreeDbAndCache(ctx context.Context, id int32, uuid, userUuid string) error {
@@ -359,3 +360,199 @@ func (s *MessageService) DeleteCollectionsCache(ctx context.Context, id int32, u
 func (s *MessageService) AddCollectionsContentReviewDbAndCache(ctx context.Context, creationId, result int32, uuid, jobId, label, title, kind string, section string) error {
 	return s.cc.AddCollectionsContentReviewDbAndCache(ctx, creationId, result, uuid, jobId, label, title, kind, section)
 }
+
+func (s *MessageService) registerArticleHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeArticleCreateReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewCreateArticle(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeArticleEditReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewEditArticle(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCreateArticle, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.CreateArticleDbCacheAndSearch(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeEditArticle, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.EditArticleCosAndSearch(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeDeleteArticle, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.DeleteArticleCacheAndSearch(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeSetArticleView, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.AddArticleView(ctx, m.Id, m.Uuid, m.Repeat)
+	})
+	r.Register(event.ModeSetArticleAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.SetArticleAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelArticleAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.CancelArticleAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeSetArticleCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.SetArticleCollectDbAndCache(ctx, m.Id, m.CollectionsId, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelArticleCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.CancelArticleCollectDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeAddArticleImageReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ImageReview)
+		return s.AddArticleImageReviewDbAndCache(ctx, m.CreationId, m.Score, m.Result, m.Kind, m.Uid, m.Uuid, m.JobId, m.Label, m.Category, m.SubLabel)
+	})
+	r.Register(event.ModeAddArticleContentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.TextReview)
+		return s.AddArticleContentReviewDbAndCache(ctx, m.CreationId, m.Result, m.Uuid, m.JobId, m.Label, m.Title, m.Kind, m.Section)
+	})
+}
+
+func (s *MessageService) registerTalkHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeTalkCreateReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewCreateTalk(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeTalkEditReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewEditTalk(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCreateTalk, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.CreateTalkDbCacheAndSearch(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeEditTalk, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.EditTalkCosAndSearch(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeDeleteTalk, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.DeleteTalkCacheAndSearch(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeSetTalkView, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.AddTalkView(ctx, m.Id, m.Uuid, m.Repeat)
+	})
+	r.Register(event.ModeSetTalkAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.SetTalkAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelTalkAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.CancelTalkAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeSetTalkCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.SetTalkCollectDbAndCache(ctx, m.Id, m.CollectionsId, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelTalkCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.CancelTalkCollectDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeAddTalkImageReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ImageReview)
+		return s.AddTalkImageReviewDbAndCache(ctx, m.CreationId, m.Score, m.Result, m.Kind, m.Uid, m.Uuid, m.JobId, m.Label, m.Category, m.SubLabel)
+	})
+	r.Register(event.ModeAddTalkContentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.TextReview)
+		return s.AddTalkContentReviewDbAndCache(ctx, m.CreationId, m.Result, m.Uuid, m.JobId, m.Label, m.Title, m.Kind, m.Section)
+	})
+}
+
+func (s *MessageService) registerColumnHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeColumnCreateReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewCreateColumn(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeColumnEditReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewEditColumn(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCreateColumn, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.CreateColumnDbCacheAndSearch(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeEditColumn, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.EditColumnCosAndSearch(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeDeleteColumn, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.DeleteColumnCacheAndSearch(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeSetColumnView, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.AddColumnView(ctx, m.Id, m.Uuid, m.Repeat)
+	})
+	r.Register(event.ModeSetColumnAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.SetColumnAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelColumnAgree, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.CancelColumnAgreeDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeSetColumnCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.SetColumnCollectDbAndCache(ctx, m.Id, m.CollectionsId, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeCancelColumnCollect, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Statistic)
+		return s.CancelColumnCollectDbAndCache(ctx, m.Id, m.Uuid, m.UserUuid)
+	})
+	r.Register(event.ModeAddColumnIncludes, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ColumnIncludes)
+		return s.AddColumnIncludesDbAndCache(ctx, m.Id, m.ArticleId, m.Uuid)
+	})
+	r.Register(event.ModeDeleteColumnIncludes, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ColumnIncludes)
+		return s.DeleteColumnIncludesDbAndCache(ctx, m.Id, m.ArticleId, m.Uuid)
+	})
+	r.Register(event.ModeSetColumnSubscribe, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ColumnSubscribe)
+		return s.SetColumnSubscribeDbAndCache(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCancelColumnSubscribe, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ColumnSubscribe)
+		return s.CancelColumnSubscribeDbAndCache(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeAddColumnImageReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ImageReview)
+		return s.AddColumnImageReviewDbAndCache(ctx, m.CreationId, m.Score, m.Result, m.Kind, m.Uid, m.Uuid, m.JobId, m.Label, m.Category, m.SubLabel)
+	})
+	r.Register(event.ModeAddColumnContentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.TextReview)
+		return s.AddColumnContentReviewDbAndCache(ctx, m.CreationId, m.Result, m.Uuid, m.JobId, m.Label, m.Title, m.Kind, m.Section)
+	})
+}
+
+func (s *MessageService) registerCollectionsHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeCollectionsCreateReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewCreateCollections(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCollectionsEditReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Review)
+		return s.ToReviewEditCollections(m.Id, m.Uuid)
+	})
+	r.Register(event.ModeCreateCollections, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.CreateCollectionsDbAndCache(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeEditCollections, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.EditCollectionsCos(ctx, m.Id, m.Auth, m.Uuid)
+	})
+	r.Register(event.ModeDeleteCollections, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Creation)
+		return s.DeleteCollectionsCache(ctx, m.Id, m.Uuid)
+	})
+	r.Register(event.ModeAddCollectionsContentReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.TextReview)
+		return s.AddCollectionsContentReviewDbAndCache(ctx, m.CreationId, m.Result, m.Uuid, m.JobId, m.Label, m.Title, m.Kind, m.Section)
+	})
+}
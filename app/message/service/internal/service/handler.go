@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"runtime"
+)
+
+// defaultMaxRetry is the retry budget of modes without one in the config.
+const defaultMaxRetry int32 = 10
+
+// Handler consumes one decoded event of the mode it was registered for.
+type Handler func(ctx context.Context, e event.Event) error
+
+// HandlerRegistry routes the events of the matrix topic to the handlers each
+// domain registered for its modes. Events nobody can handle are counted and
+// moved to the dead letters instead of being acknowledged silently, and so
+// are events whose handler still fails once their retry budget is spent.
+type HandlerRegistry struct {
+	handlers   map[string]Handler
+	maxRetry   int32
+	modeRetry  map[string]int32
+	dlc        *biz.DeadLetterUseCase
+	dc         *biz.DedupUseCase
+	unknown    syncint64.Counter
+	deadLetter syncint64.Counter
+	log        *log.Helper
+}
+
+func NewHandlerRegistry(c *conf.Server, ms *MessageService, dlc *biz.DeadLetterUseCase, dc *biz.DedupUseCase, logger log.Logger) *HandlerRegistry {
+	r := newHandlerRegistry(c, dlc, dc, logger)
+	ms.registerAchievementHandlers(r)
+	ms.registerCommentHandlers(r)
+	ms.registerArticleHandlers(r)
+	ms.registerTalkHandlers(r)
+	ms.registerColumnHandlers(r)
+	ms.registerCollectionsHandlers(r)
+	ms.registerFollowHandlers(r)
+	ms.registerPictureHandlers(r)
+	ms.registerProfileHandlers(r)
+	ms.registerAccountHandlers(r)
+	return r
+}
+
+// newHandlerRegistry returns a registry without any handler registered.
+func newHandlerRegistry(c *conf.Server, dlc *biz.DeadLetterUseCase, dc *biz.DedupUseCase, logger log.Logger) *HandlerRegistry {
+	meter := global.Meter("matrix.message.service")
+	unknown, err := meter.SyncInt64().Counter("message.consumer.unknown_mode",
+		instrument.WithDescription("events whose mode has no registered handler"))
+	if err != nil {
+		panic(err)
+	}
+	deadLetter, err := meter.SyncInt64().Counter("message.consumer.dead_letter",
+		instrument.WithDescription("events moved to the dead letters"))
+	if err != nil {
+		panic(err)
+	}
+
+	maxRetry := c.Rocketmq.MaxRetry
+	if maxRetry <= 0 {
+		maxRetry = defaultMaxRetry
+	}
+
+	return &HandlerRegistry{
+		handlers:   map[string]Handler{},
+		maxRetry:   maxRetry,
+		modeRetry:  c.Rocketmq.ModeMaxRetry,
+		dlc:        dlc,
+		dc:         dc,
+		unknown:    unknown,
+		deadLetter: deadLetter,
+		log:        log.NewHelper(log.With(logger, "module", "message/service/handlerRegistry")),
+	}
+}
+
+// Register binds h to mode. Registering a mode twice is a programming error.
+func (r *HandlerRegistry) Register(mode string, h Handler) {
+	if _, ok := r.handlers[mode]; ok {
+		panic(fmt.Sprintf("handler registered twice: mode(%s)", mode))
+	}
+	r.handlers[mode] = h
+}
+
+// MaxRetry returns the retry budget of mode.
+func (r *HandlerRegistry) MaxRetry(mode string) int32 {
+	if n, ok := r.modeRetry[mode]; ok && n >= 0 {
+		return n
+	}
+	return r.maxRetry
+}
+
+// MaxRetryOfAll returns the largest retry budget of any mode, which the
+// transport must allow before it gives up on a message by itself.
+func (r *HandlerRegistry) MaxRetryOfAll() int32 {
+	max := r.maxRetry
+	for _, n := range r.modeRetry {
+		if n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// Handle decodes body and runs the handler registered for its mode at most
+// once per event key; events of legacy producers fall back to msgId. attempt
+// counts the earlier deliveries of the same message. A non-nil error means
+// the message should be redelivered.
+func (r *HandlerRegistry) Handle(ctx context.Context, msgId string, body []byte, attempt int32) error {
+	e, err := event.Decode(body)
+	if event.Retryable(err) {
+		return err
+	}
+	if errors.Is(err, event.ErrUnknownMode) {
+		return r.unknownMode(ctx, msgId, body, err, attempt)
+	}
+	if err != nil {
+		return r.toDeadLetter(ctx, msgId, body, err, attempt)
+	}
+
+	mode := event.Mode(e)
+	h, ok := r.handlers[mode]
+	if !ok {
+		return r.unknownMode(ctx, msgId, body, errors.Wrapf(event.ErrUnknownMode, "no handler for mode(%s)", mode), attempt)
+	}
+
+	key := event.Key(e)
+	if key == "" {
+		key = msgId
+	}
+	err = r.dc.Once(ctx, key, mode, func(ctx context.Context) error {
+		return call(ctx, h, e)
+	})
+	if err == nil {
+		return nil
+	}
+	if attempt < r.MaxRetry(mode) {
+		return err
+	}
+	return r.toDeadLetter(ctx, msgId, body, errors.Wrapf(err, "retry budget(%v) exhausted", r.MaxRetry(mode)), attempt)
+}
+
+func (r *HandlerRegistry) unknownMode(ctx context.Context, msgId string, body []byte, reason error, attempt int32) error {
+	r.unknown.Add(ctx, 1, attribute.String("mode", peekMode(body)))
+	return r.toDeadLetter(ctx, msgId, body, reason, attempt)
+}
+
+func (r *HandlerRegistry) toDeadLetter(ctx context.Context, msgId string, body []byte, reason error, attempt int32) error {
+	mode := peekMode(body)
+	r.log.Errorf("move msg to dead letters: id(%s), mode(%s), reason(%s)", msgId, mode, reason.Error())
+	err := r.dlc.AddDeadLetter(ctx, &biz.DeadLetter{
+		MsgId:    msgId,
+		Mode:     mode,
+		Body:     string(body),
+		Reason:   reason.Error(),
+		Attempts: attempt + 1,
+	})
+	if err != nil {
+		return err
+	}
+	r.deadLetter.Add(ctx, 1, attribute.String("mode", mode))
+	return nil
+}
+
+// call runs h and turns a panic into an error, so that a handler panicking on
+// some event spends its retry budget instead of being redelivered forever.
+func call(ctx context.Context, h Handler, e event.Event) (err error) {
+	defer func() {
+		if rerr := recover(); rerr != nil {
+			buf := make([]byte, 64<<10)
+			n := runtime.Stack(buf, false)
+			buf = buf[:n]
+			err = errors.Errorf("handler panic: %v\n%s", rerr, buf)
+		}
+	}()
+	return h(ctx, e)
+}
+
+func peekMode(body []byte) string {
+	h := &event.Header{}
+	_ = h.UnmarshalJSON(body)
+	return h.Mode
+}
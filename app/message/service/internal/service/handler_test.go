@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"io"
+	"strings"
+	"testing"
+)
+
+type fakeDeadLetterRepo struct {
+	list []*biz.DeadLetter
+}
+
+func (r *fakeDeadLetterRepo) AddDeadLetter(_ context.Context, dl *biz.DeadLetter) error {
+	r.list = append(r.list, dl)
+	return nil
+}
+
+type fakeDedupRepo struct {
+	keys map[string]string
+}
+
+func (r *fakeDedupRepo) GetProcessedEvent(_ context.Context, key string) (bool, error) {
+	_, ok := r.keys[key]
+	return ok, nil
+}
+
+func (r *fakeDedupRepo) AddProcessedEvent(_ context.Context, key, mode string) (bool, error) {
+	if _, ok := r.keys[key]; ok {
+		return false, nil
+	}
+	r.keys[key] = mode
+	return true, nil
+}
+
+type registryTest struct {
+	registry    *HandlerRegistry
+	deadLetters *fakeDeadLetterRepo
+	processed   *fakeDedupRepo
+}
+
+func newRegistryTest(maxRetry int32, modeMaxRetry map[string]int32) *registryTest {
+	logger := log.NewStdLogger(io.Discard)
+	deadLetters := &fakeDeadLetterRepo{}
+	processed := &fakeDedupRepo{keys: map[string]string{}}
+	c := &conf.Server{Rocketmq: &conf.Server_RocketMq{MaxRetry: maxRetry, ModeMaxRetry: modeMaxRetry}}
+	return &registryTest{
+		registry:    newHandlerRegistry(c, biz.NewDeadLetterUseCase(deadLetters, logger), biz.NewDedupUseCase(processed, logger), logger),
+		deadLetters: deadLetters,
+		processed:   processed,
+	}
+}
+
+func followBody(t *testing.T, mode, key string) []byte {
+	body, err := event.Encode(&event.Follow{
+		Header: event.Header{Mode: mode, Key: key},
+		Uuid:   "uuid",
+		UserId: "userId",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestHandleRunsHandlerOncePerKey(t *testing.T) {
+	rt := newRegistryTest(3, nil)
+	var calls int
+	var key string
+	rt.registry.Register(event.ModeSetFollow, func(ctx context.Context, e event.Event) error {
+		calls++
+		key, _ = idempotent.FromContext(ctx)
+		return nil
+	})
+
+	body := followBody(t, event.ModeSetFollow, "key")
+	for attempt := int32(0); attempt < 2; attempt++ {
+		if err := rt.registry.Handle(context.Background(), "msgId", body, attempt); err != nil {
+			t.Fatalf("attempt(%v): %v", attempt, err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("handler ran %v times, want 1", calls)
+	}
+	if key != "key" {
+		t.Fatalf("handler ran under key(%s), want key", key)
+	}
+}
+
+func TestHandleMovesUnknownModeToDeadLetter(t *testing.T) {
+	rt := newRegistryTest(3, nil)
+
+	unregistered := followBody(t, event.ModeSetFollow, "key")
+	unknown := []byte(`{"mode":"no_such_mode","version":1,"key":"key"}`)
+	for _, body := range [][]byte{unregistered, unknown} {
+		if err := rt.registry.Handle(context.Background(), "msgId", body, 0); err != nil {
+			t.Fatalf("body(%s): %v", body, err)
+		}
+	}
+	if len(rt.deadLetters.list) != 2 {
+		t.Fatalf("got %v dead letters, want 2", len(rt.deadLetters.list))
+	}
+	for _, dl := range rt.deadLetters.list {
+		if !strings.Contains(dl.Reason, event.ErrUnknownMode.Error()) {
+			t.Fatalf("dead letter of mode(%s) has reason(%s), want an unknown mode", dl.Mode, dl.Reason)
+		}
+		if dl.Attempts != 1 {
+			t.Fatalf("dead letter of mode(%s) has attempts(%v), want 1", dl.Mode, dl.Attempts)
+		}
+	}
+	if len(rt.processed.keys) != 0 {
+		t.Fatalf("unknown modes recorded as processed: %v", rt.processed.keys)
+	}
+}
+
+func TestHandleSpendsRetryBudget(t *testing.T) {
+	rt := newRegistryTest(3, map[string]int32{event.ModeCancelFollow: 1})
+	failure := errors.New("downstream unavailable")
+	for _, mode := range []string{event.ModeSetFollow, event.ModeCancelFollow} {
+		rt.registry.Register(mode, func(ctx context.Context, e event.Event) error {
+			return failure
+		})
+	}
+
+	for _, tc := range []struct {
+		mode     string
+		maxRetry int32
+	}{
+		{mode: event.ModeSetFollow, maxRetry: 3},
+		{mode: event.ModeCancelFollow, maxRetry: 1},
+	} {
+		rt.deadLetters.list = nil
+		body := followBody(t, tc.mode, tc.mode)
+		for attempt := int32(0); attempt < tc.maxRetry; attempt++ {
+			err := rt.registry.Handle(context.Background(), "msgId", body, attempt)
+			if !errors.Is(err, failure) {
+				t.Fatalf("mode(%s) attempt(%v): got %v, want a redelivery", tc.mode, attempt, err)
+			}
+			if len(rt.deadLetters.list) != 0 {
+				t.Fatalf("mode(%s) attempt(%v): moved to dead letters within its budget", tc.mode, attempt)
+			}
+		}
+		err := rt.registry.Handle(context.Background(), "msgId", body, tc.maxRetry)
+		if err != nil {
+			t.Fatalf("mode(%s): got %v once the budget is spent, want an acknowledgement", tc.mode, err)
+		}
+		if len(rt.deadLetters.list) != 1 || rt.deadLetters.list[0].Attempts != tc.maxRetry+1 {
+			t.Fatalf("mode(%s): got dead letters %+v, want one of %v attempts", tc.mode, rt.deadLetters.list, tc.maxRetry+1)
+		}
+	}
+	if len(rt.processed.keys) != 0 {
+		t.Fatalf("failed events recorded as processed: %v", rt.processed.keys)
+	}
+}
+
+func TestHandleTurnsPanicIntoError(t *testing.T) {
+	rt := newRegistryTest(3, nil)
+	rt.registry.Register(event.ModeSetFollow, func(ctx context.Context, e event.Event) error {
+		panic("nil map")
+	})
+
+	body := followBody(t, event.ModeSetFollow, "key")
+	err := rt.registry.Handle(context.Background(), "msgId", body, 0)
+	if err == nil || !strings.Contains(err.Error(), "handler panic: nil map") {
+		t.Fatalf("got %v, want the panic as an error", err)
+	}
+	if len(rt.processed.keys) != 0 {
+		t.Fatalf("panicked event recorded as processed: %v", rt.processed.keys)
+	}
+
+	err = rt.registry.Handle(context.Background(), "msgId", body, 3)
+	if err != nil {
+		t.Fatalf("got %v once the budget is spent, want an acknowledgement", err)
+	}
+	if len(rt.deadLetters.list) != 1 {
+		t.Fatalf("got %v dead letters, want 1", len(rt.deadLetters.list))
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	rt := newRegistryTest(3, nil)
+	h := func(ctx context.Context, e event.Event) error {
+		return nil
+	}
+	rt.registry.Register(event.ModeSetFollow, h)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("registering a mode twice did not panic")
+		}
+	}()
+	rt.registry.Register(event.ModeSetFollow, h)
+}
+
+func TestMessageServiceHandlersHaveKnownModes(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	c := &conf.Server{Rocketmq: &conf.Server_RocketMq{}}
+	ms := NewMessageService(nil, nil, nil, nil, nil, nil, nil, logger)
+	r := NewHandlerRegistry(c, ms, biz.NewDeadLetterUseCase(&fakeDeadLetterRepo{}, logger), biz.NewDedupUseCase(&fakeDedupRepo{keys: map[string]string{}}, logger), logger)
+	if len(r.handlers) == 0 {
+		t.Fatal("no handler registered")
+	}
+	for mode := range r.handlers {
+		if _, err := event.New(mode); err != nil {
+			t.Errorf("handler registered for mode(%s): %v", mode, err)
+		}
+	}
+}
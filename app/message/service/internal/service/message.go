@@ -26,6 +26,7 @@ func (s *MessageService) GetMessageNotification(ctx context.Context, req *v1.Get
 		Comment:    notification.Comment,
 		SubComment: notification.SubComment,
 		System:     notification.SystemNotification,
+		Mention:    notification.Mention,
 	}, nil
 }
 
@@ -54,6 +55,29 @@ func (s *MessageService) GetMessageSystemNotification(ctx context.Context, req *
 	return reply, nil
 }
 
+func (s *MessageService) GetMessageMention(ctx context.Context, req *v1.GetMessageMentionReq) (*v1.GetMessageMentionReply, error) {
+	mentionList, err := s.mc.GetMessageMention(ctx, req.Page, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetMessageMentionReply{List: make([]*v1.GetMessageMentionReply_List, 0, len(mentionList))}
+	for _, item := range mentionList {
+		reply.List = append(reply.List, &v1.GetMessageMentionReply_List{
+			Id:           item.Id,
+			ContentId:    item.ContentId,
+			CreatedAt:    item.CreatedAt,
+			Kind:         item.Kind,
+			CreationId:   item.CreationId,
+			CreationType: item.CreationType,
+			RootId:       item.RootId,
+			Uid:          item.Uid,
+			Uuid:         item.Uuid,
+			Text:         item.Text,
+		})
+	}
+	return reply, nil
+}
+
 func (s *MessageService) SetMailBoxLastTime(ctx context.Context, req *v1.SetMailBoxLastTimeReq) (*emptypb.Empty, error) {
 	err := s.mc.SetMailBoxLastTime(ctx, req.Uuid, req.Time)
 	if err != nil {
@@ -85,3 +109,11 @@ func (s *MessageService) RemoveMailBoxSystemNotificationCount(ctx context.Contex
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *MessageService) RemoveMailBoxMentionCount(ctx context.Context, req *v1.RemoveMailBoxMentionCountReq) (*emptypb.Empty, error) {
+	err := s.mc.RemoveMailBoxMentionCount(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/message/service/v1"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *MessageService) GetContentFlagList(ctx context.Context, req *v1.GetContentFlagListReq) (*v1.GetContentFlagListReply, error) {
+	flagList, err := s.modc.GetContentFlagList(ctx, req.Page, req.Kind, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetContentFlagListReply{List: make([]*v1.GetContentFlagListReply_List, 0, len(flagList))}
+	for _, item := range flagList {
+		reply.List = append(reply.List, &v1.GetContentFlagListReply_List{
+			Id:        item.Id,
+			Kind:      item.Kind,
+			Mode:      item.Mode,
+			ContentId: item.ContentId,
+			Uuid:      item.Uuid,
+			Text:      item.Text,
+			JobId:     item.JobId,
+			Label:     item.Label,
+			Section:   item.Section,
+			Status:    item.Status,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+	return reply, nil
+}
+
+func (s *MessageService) PublishContentFlag(ctx context.Context, req *v1.PublishContentFlagReq) (*emptypb.Empty, error) {
+	err := s.modc.PublishContentFlag(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MessageService) RemoveContentFlag(ctx context.Context, req *v1.RemoveContentFlagReq) (*emptypb.Empty, error) {
+	err := s.modc.RemoveContentFlag(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MessageService) AppealReview(ctx context.Context, req *v1.AppealReviewReq) (*emptypb.Empty, error) {
+	err := s.modc.AppealReview(ctx, &biz.Appeal{
+		JobId:  req.JobId,
+		Uuid:   req.Uuid,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MessageService) GetAppealList(ctx context.Context, req *v1.GetAppealListReq) (*v1.GetAppealListReply, error) {
+	appealList, err := s.modc.GetAppealList(ctx, req.Page, req.Uuid, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetAppealListReply{List: make([]*v1.GetAppealListReply_List, 0, len(appealList))}
+	for _, item := range appealList {
+		appeal := &v1.GetAppealListReply_List{
+			Id:        item.Id,
+			JobId:     item.JobId,
+			Uuid:      item.Uuid,
+			Reason:    item.Reason,
+			Status:    item.Status,
+			CreatedAt: item.CreatedAt,
+		}
+		if item.Flag != nil {
+			appeal.Kind = item.Flag.Kind
+			appeal.Mode = item.Flag.Mode
+			appeal.ContentId = item.Flag.ContentId
+			appeal.Text = item.Flag.Text
+			appeal.Label = item.Flag.Label
+			appeal.Section = item.Flag.Section
+		}
+		reply.List = append(reply.List, appeal)
+	}
+	return reply, nil
+}
+
+func (s *MessageService) AcceptAppeal(ctx context.Context, req *v1.AcceptAppealReq) (*emptypb.Empty, error) {
+	err := s.modc.AcceptAppeal(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MessageService) RejectAppeal(ctx context.Context, req *v1.RejectAppealReq) (*emptypb.Empty, error) {
+	err := s.modc.RejectAppeal(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
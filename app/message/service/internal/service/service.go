@@ -10,26 +10,30 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-var ProviderSet = wire.NewSet(NewMessageService)
+var ProviderSet = wire.NewSet(NewMessageService, NewHandlerRegistry)
 
 type MessageService struct {
 	v1.UnimplementedMessageServer
-	uc    *biz.UserUseCase
-	cc    *biz.CreationUseCase
-	ac    *biz.AchievementUseCase
-	commc *biz.CommentUseCase
-	mc    *biz.MessageUseCase
-	log   *log.Helper
+	uc      *biz.UserUseCase
+	cc      *biz.CreationUseCase
+	ac      *biz.AchievementUseCase
+	commc   *biz.CommentUseCase
+	mc      *biz.MessageUseCase
+	counter *biz.CounterUseCase
+	modc    *biz.ModerationUseCase
+	log     *log.Helper
 }
 
-func NewMessageService(uc *biz.UserUseCase, cc *biz.CreationUseCase, ac *biz.AchievementUseCase, commc *biz.CommentUseCase, mc *biz.MessageUseCase, logger log.Logger) *MessageService {
+func NewMessageService(uc *biz.UserUseCase, cc *biz.CreationUseCase, ac *biz.AchievementUseCase, commc *biz.CommentUseCase, mc *biz.MessageUseCase, counter *biz.CounterUseCase, modc *biz.ModerationUseCase, logger log.Logger) *MessageService {
 	return &MessageService{
-		log:   log.NewHelper(log.With(logger, "module", "message/service")),
-		uc:    uc,
-		cc:    cc,
-		ac:    ac,
-		commc: commc,
-		mc:    mc,
+		log:     log.NewHelper(log.With(logger, "module", "message/service")),
+		uc:      uc,
+		cc:      cc,
+		ac:      ac,
+		commc:   commc,
+		mc:      mc,
+		counter: counter,
+		modc:    modc,
 	}
 }
 
@@ -4,11 +4,12 @@ import (
 	"context"
 	"github.com/the-zion/matrix-core/api/message/service/v1"
 	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func (s *MessageService) UploadProfileToCos(msg map[string]interface{}) error {
-	return s.uc.UploadProfileToCos(msg)
+func (s *MessageService) UploadProfileToCos(profile *event.Profile) error {
+	return s.uc.UploadProfileToCos(profile)
 }
 
 func (s *MessageService) ProfileReview(ctx context.Context, req *v1.TextReviewReq) (*emptypb.Empty, error) {
@@ -80,6 +81,14 @@ func (s *MessageService) CancelFollowDbAndCache(ctx context.Context, uuid, userI
 	return s.uc.CancelFollowDbAndCache(ctx, uuid, userId)
 }
 
+func (s *MessageService) FollowRequest(ctx context.Context, uuid, userId string) error {
+	return s.uc.FollowRequest(ctx, uuid, userId)
+}
+
+func (s *MessageService) FollowApprove(ctx context.Context, uuid, userId string) error {
+	return s.uc.FollowApprove(ctx, uuid, userId)
+}
+
 func (s *MessageService) AddAvatarReviewDbAndCache(ctx context.Context, score, result int32, uuid, jobId, label, category, subLabel string) error {
 	return s.uc.AddAvatarReviewDbAndCache(ctx, score, result, uuid, jobId, label, category, subLabel)
 }
@@ -87,3 +96,57 @@ func (s *MessageService) AddAvatarReviewDbAndCache(ctx context.Context, score, r
 func (s *MessageService) AddCoverReviewDbAndCache(ctx context.Context, score, result int32, uuid, jobId, label, category, subLabel string) error {
 	return s.uc.AddCoverReviewDbAndCache(ctx, score, result, uuid, jobId, label, category, subLabel)
 }
+
+func (s *MessageService) DeleteAccount(ctx context.Context, uuid string) error {
+	return s.uc.DeleteAccount(ctx, uuid)
+}
+
+func (s *MessageService) ExportUserData(ctx context.Context, uuid, id string) error {
+	return s.uc.ExportUserData(ctx, uuid, id)
+}
+
+func (s *MessageService) registerFollowHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeSetFollow, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Follow)
+		return s.SetFollowDbAndCache(ctx, m.Uuid, m.UserId)
+	})
+	r.Register(event.ModeCancelFollow, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Follow)
+		return s.CancelFollowDbAndCache(ctx, m.Uuid, m.UserId)
+	})
+	r.Register(event.ModeFollowRequest, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Follow)
+		return s.FollowRequest(ctx, m.Uuid, m.UserId)
+	})
+	r.Register(event.ModeFollowApprove, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Follow)
+		return s.FollowApprove(ctx, m.Uuid, m.UserId)
+	})
+}
+
+func (s *MessageService) registerPictureHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeAddAvatarReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ImageReview)
+		return s.AddAvatarReviewDbAndCache(ctx, m.Score, m.Result, m.Uuid, m.JobId, m.Label, m.Category, m.SubLabel)
+	})
+	r.Register(event.ModeAddCoverReview, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.ImageReview)
+		return s.AddCoverReviewDbAndCache(ctx, m.Score, m.Result, m.Uuid, m.JobId, m.Label, m.Category, m.SubLabel)
+	})
+}
+
+func (s *MessageService) registerProfileHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeUserProfileUpdate, func(ctx context.Context, e event.Event) error {
+		return s.UploadProfileToCos(e.(*event.Profile))
+	})
+}
+
+func (s *MessageService) registerAccountHandlers(r *HandlerRegistry) {
+	r.Register(event.ModeAccountDeleted, func(ctx context.Context, e event.Event) error {
+		return s.DeleteAccount(ctx, e.(*event.Account).Uuid)
+	})
+	r.Register(event.ModeExportUserData, func(ctx context.Context, e event.Event) error {
+		m := e.(*event.Account)
+		return s.ExportUserData(ctx, m.Uuid, m.ExportId)
+	})
+}
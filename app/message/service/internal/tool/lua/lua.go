@@ -6,26 +6,14 @@ import (
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
+	"github.com/the-zion/matrix-core/app/message/service/internal/data"
 	"os"
 	"time"
 )
 
 var (
-	addr      string
-	password  string
-	scriptBox = map[string]string{
-		"AddMailBoxSystemNotificationToCache": `
-					local key = KEYS[1]
-					local value = ARGV[1]
-					local uuid = ARGV[2]
-					local exist = redis.call("EXISTS", key)
-					if exist == 1 then
-						redis.call("LPUSH", key, value)
-					end
-					redis.call("HINCRBY", "message_system", uuid, 1)
-					return 0
-	`,
-	}
+	addr     string
+	password string
 )
 
 func NewRedis(logger log.Logger) redis.Cmdable {
@@ -33,7 +21,6 @@ func NewRedis(logger log.Logger) redis.Cmdable {
 
 	client := redis.NewClient(&redis.Options{
 		Addr:        addr,
-		DB:          4,
 		DialTimeout: time.Second * 2,
 		PoolSize:    10,
 		Password:    password,
@@ -55,7 +42,7 @@ func main() {
 }
 
 func ScriptLoad(r redis.Cmdable) {
-	for key, value := range scriptBox {
+	for key, value := range data.Scripts {
 		result, err := r.ScriptLoad(context.Background(), value).Result()
 		if err != nil {
 			fmt.Println(err)
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/the-zion/matrix-core/app/message/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"os"
@@ -24,6 +25,12 @@ func NewDB(logger log.Logger) *gorm.DB {
 	}
 	if err := db.AutoMigrate(
 		&data.SystemNotification{},
+		&data.Mention{},
+		&data.ContentFlag{},
+		&data.Appeal{},
+		&data.DeadLetter{},
+		&data.ProcessedEvent{},
+		&idempotent.Processed{},
 	); err != nil {
 		l.Fatalf("failed creat or update table resources: %v", err)
 	}
@@ -0,0 +1,324 @@
+package messagetest_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	messagev1 "github.com/the-zion/matrix-core/api/message/service/v1"
+	"github.com/the-zion/matrix-core/app/achievement/service/achievementtest"
+	"github.com/the-zion/matrix-core/app/creation/service/creationtest"
+	"github.com/the-zion/matrix-core/app/message/service/messagetest"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	author = "authorauthorauthor01"
+	reader = "readerreaderreader01"
+)
+
+type flow struct {
+	bus         *testkit.Bus
+	bucket      *testkit.CosServer
+	search      *testkit.SearchServer
+	news        *testkit.NewsServer
+	issuer      *testkit.Issuer
+	creation    *creationtest.Creation
+	achievement *achievementtest.Achievement
+	message     *messagetest.Message
+}
+
+func newFlow(t *testing.T) *flow {
+	logger := log.NewStdLogger(io.Discard)
+	f := &flow{
+		bus:    testkit.NewBus(),
+		search: testkit.NewSearchServer(),
+		news:   testkit.NewNewsServer(),
+	}
+	t.Cleanup(f.search.Close)
+	t.Cleanup(f.news.Close)
+	var err error
+	f.bucket, err = testkit.NewCosServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(f.bucket.Close)
+	f.issuer, err = testkit.NewIssuer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(f.issuer.Close)
+
+	var cleanup func()
+	f.achievement, cleanup, err = achievementtest.New(f.bus, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	f.creation, cleanup, err = creationtest.New(f.bus, f.bucket, f.search, f.news, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	f.message, cleanup, err = messagetest.New(f.bus, f.bucket, f.issuer, f.creation.Client, f.achievement.Client, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return f
+}
+
+// settle delivers events until the bus and the outbox of the creation
+// service, which its relay publishes from in the background, are both empty.
+// Events the bus or the message service gave up on fail the test.
+func (f *flow) settle(t *testing.T) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		n := f.bus.Drain(context.Background())
+		var outbox int64
+		if err := f.creation.DB.Model(&event.Outbox{}).Count(&outbox).Error; err != nil {
+			t.Fatal(err)
+		}
+		if n == 0 && outbox == 0 {
+			if dead := f.bus.Dead(); len(dead) != 0 {
+				t.Fatalf("gave up on %v events, first: %s", len(dead), dead[0].Body)
+			}
+			var letters int64
+			if err := f.message.DB.Table("dead_letters").Count(&letters).Error; err != nil {
+				t.Fatal(err)
+			}
+			if letters != 0 {
+				t.Fatalf("moved %v events to dead letters", letters)
+			}
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("events did not settle")
+}
+
+func (f *flow) put(t *testing.T, name, body string) {
+	_, err := f.bucket.Client().Object.Put(context.Background(), name, strings.NewReader(body), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// sendArticle creates an article of author and sends it to review, returning
+// its id as a number and as the review callbacks carry it.
+func (f *flow) sendArticle(t *testing.T) (int32, string) {
+	ctx := context.Background()
+	draft, err := f.creation.Client.CreateArticleDraft(ctx, &creationv1.CreateArticleDraftReq{Uuid: author})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := strconv.Itoa(int(draft.Id))
+	prefix := "article/" + author + "/" + id + "/"
+	f.put(t, prefix+"content", `{"html":"<p>hello</p>"}`)
+	f.put(t, prefix+"search", fmt.Sprintf(`{"id":%s,"uuid":"%s","title":"hello","text":"hello"}`, id, author))
+
+	_, err = f.creation.Client.SendArticle(ctx, &creationv1.SendArticleReq{Id: draft.Id, Uuid: author, Ip: "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+	return draft.Id, id
+}
+
+type reviewCallback func(ctx context.Context, in *messagev1.TextReviewReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
+// review calls back with the result of the review job jobId of the article
+// id, made for kind, create or edit, and found irregular unless result is 0.
+// The token it carries has expired, as it may have by the time a review comes.
+func (f *flow) review(t *testing.T, callback reviewCallback, jobId, id, kind string, result int32) {
+	token, err := f.issuer.ExpiredToken(author)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var label string
+	if result != 0 {
+		label = "Abuse"
+	}
+	_, err = callback(context.Background(), &messagev1.TextReviewReq{
+		JobsDetail: &messagev1.JobsDetailStruct{
+			JobId:  jobId,
+			State:  "Success",
+			Object: "article/" + author + "/" + id + "/content",
+			Label:  label,
+			Result: result,
+			CosHeaders: map[string]string{
+				"X-Cos-Meta-Token": token,
+				"X-Cos-Meta-Id":    id,
+				"X-Cos-Meta-Auth":  "1",
+				"X-Cos-Meta-Title": "hello",
+				"X-Cos-Meta-Kind":  kind,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+}
+
+// flag returns the pending flag of the moderators.
+func (f *flow) flag(t *testing.T) *messagev1.GetContentFlagListReply_List {
+	reply, err := f.message.Client.GetContentFlagList(context.Background(), &messagev1.GetContentFlagListReq{Page: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.List) != 1 {
+		t.Fatalf("got %v pending flags, want 1", len(reply.List))
+	}
+	return reply.List[0]
+}
+
+type achievement struct {
+	Agree int32
+	Score int32
+}
+
+func (f *flow) achievementOf(t *testing.T, uuid string) *achievement {
+	a := &achievement{}
+	err := f.achievement.DB.Table("achievements").Where("uuid = ?", uuid).Take(a).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func count(t *testing.T, db *gorm.DB, table, query string, args ...interface{}) int64 {
+	var n int64
+	if err := db.Table(table).Where(query, args...).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// TestArticleFlow sends an article through review into the cache and search
+// index, agrees with it and checks the achievement of its author.
+func TestArticleFlow(t *testing.T) {
+	ctx := context.Background()
+	f := newFlow(t)
+
+	aid, id := f.sendArticle(t)
+	if jobs := f.bucket.Jobs(); len(jobs) != 1 {
+		t.Fatalf("got %v review jobs, want 1", len(jobs))
+	}
+	f.review(t, f.message.Client.ArticleCreateReview, "testkit-1", id, "create", 0)
+
+	if n := count(t, f.creation.DB, "articles", "article_id = ? AND uuid = ?", aid, author); n != 1 {
+		t.Fatalf("got %v rows of article(%s), want 1", n, id)
+	}
+	if f.search.Doc("article", id) == nil {
+		t.Fatalf("article(%s) is not in the search index", id)
+	}
+	cached, err := f.creation.Redis.HGet(ctx, "article_"+id, "uuid").Result()
+	if err != nil || cached != author {
+		t.Fatalf("article(%s) is cached for uuid(%s), want %s: %v", id, cached, author, err)
+	}
+
+	if err = f.message.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+	created := f.achievementOf(t, author)
+	if created.Score <= 0 {
+		t.Fatalf("author has score(%v) for a new article, want some", created.Score)
+	}
+
+	_, err = f.creation.Client.SetArticleAgree(ctx, &creationv1.SetArticleAgreeReq{Id: aid, Uuid: author, UserUuid: reader})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+	if err = f.message.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+
+	agree, err := f.creation.Redis.HGet(ctx, "article_"+id, "agree").Int()
+	if err != nil || agree != 1 {
+		t.Fatalf("article(%s) has agree(%v) in the cache, want 1: %v", id, agree, err)
+	}
+	agreed := f.achievementOf(t, author)
+	if agreed.Agree != 1 || agreed.Score != created.Score+2 {
+		t.Fatalf("author has agree(%v) and score(%v), want 1 and %v", agreed.Agree, agreed.Score, created.Score+2)
+	}
+}
+
+// TestPublishContentFlagOnce publishes a flagged article, then publishes it
+// again as if the transaction resolving the flag had rolled back after the
+// creation service published it. Repeating the pass would create the article
+// twice, which settle reports as a dead letter.
+func TestPublishContentFlagOnce(t *testing.T) {
+	ctx := context.Background()
+	f := newFlow(t)
+
+	aid, id := f.sendArticle(t)
+	f.review(t, f.message.Client.ArticleCreateReview, "testkit-1", id, "create", 1)
+	flag := f.flag(t)
+
+	for i := 0; i < 2; i++ {
+		_, err := f.message.Client.PublishContentFlag(ctx, &messagev1.PublishContentFlagReq{Id: flag.Id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.settle(t)
+		err = f.message.DB.Table("content_flags").Where("id = ?", flag.Id).Update("status", 1).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := count(t, f.creation.DB, "articles", "article_id = ? AND uuid = ?", aid, author); n != 1 {
+		t.Fatalf("got %v rows of article(%s), want 1", n, id)
+	}
+}
+
+// TestRemoveContentFlagOfEdit upholds the review of an edit, which must leave
+// the article as it was published.
+func TestRemoveContentFlagOfEdit(t *testing.T) {
+	ctx := context.Background()
+	f := newFlow(t)
+
+	aid, id := f.sendArticle(t)
+	f.review(t, f.message.Client.ArticleCreateReview, "testkit-1", id, "create", 0)
+
+	_, err := f.creation.Client.SendArticleEdit(ctx, &creationv1.SendArticleEditReq{Id: aid, Uuid: author, Ip: "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+	f.review(t, f.message.Client.ArticleEditReview, "testkit-2", id, "edit", 1)
+	flag := f.flag(t)
+	if flag.Mode != "edit" {
+		t.Fatalf("got a flag of mode(%s), want edit", flag.Mode)
+	}
+
+	_, err = f.message.Client.RemoveContentFlag(ctx, &messagev1.RemoveContentFlagReq{Id: flag.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.settle(t)
+
+	if n := count(t, f.creation.DB, "articles", "article_id = ? AND uuid = ?", aid, author); n != 1 {
+		t.Fatalf("got %v rows of article(%s), want 1", n, id)
+	}
+	if f.search.Doc("article", id) == nil {
+		t.Fatalf("article(%s) is not in the search index", id)
+	}
+	cached, err := f.creation.Redis.HGet(ctx, "article_"+id, "uuid").Result()
+	if err != nil || cached != author {
+		t.Fatalf("article(%s) is cached for uuid(%s), want %s: %v", id, cached, author, err)
+	}
+}
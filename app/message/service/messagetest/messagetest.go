@@ -0,0 +1,129 @@
+// Package messagetest builds the message service on testkit, for tests that
+// run a flow across services within one process. The services the message
+// service calls are passed in, e.g. as built by creationtest and
+// achievementtest; the user and comment services are left unimplemented.
+package messagetest
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
+	commentv1 "github.com/the-zion/matrix-core/api/comment/service/v1"
+	v1 "github.com/the-zion/matrix-core/api/message/service/v1"
+	userv1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/message/service/internal/conf"
+	"github.com/the-zion/matrix-core/app/message/service/internal/data"
+	"github.com/the-zion/matrix-core/app/message/service/internal/server"
+	"github.com/the-zion/matrix-core/app/message/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+	"gorm.io/gorm"
+)
+
+// Message is a running message service consuming the events of a bus.
+type Message struct {
+	Client  v1.MessageClient
+	DB      *gorm.DB
+	Redis   redis.Cmdable
+	counter *biz.CounterUseCase
+}
+
+// Flush writes the staged counters through, as the counter server does once
+// per window.
+func (m *Message) Flush(ctx context.Context) error {
+	return m.counter.Flush(ctx)
+}
+
+func newMessage(c *conf.Server, bus *testkit.Bus, db *gorm.DB, redisCmd redis.Cmdable, srv *grpc.Server, registry *service.HandlerRegistry, counter *biz.CounterUseCase, logger log.Logger) (*Message, func(), error) {
+	conn, cleanup, err := testkit.Serve(srv)
+	if err != nil {
+		return nil, nil, err
+	}
+	bus.Subscribe(event.Topic, server.MqRecovery(server.NewConsumeFunc(c, registry, logger)))
+	return &Message{
+		Client:  v1.NewMessageClient(conn),
+		DB:      db,
+		Redis:   redisCmd,
+		counter: counter,
+	}, cleanup, nil
+}
+
+// newDB opens a database with the tables of tool/update.
+func newDB() (*gorm.DB, func(), error) {
+	db, cleanup, err := testkit.NewDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = testkit.Migrate(db,
+		&data.SystemNotification{},
+		&data.Mention{},
+		&data.ContentFlag{},
+		&data.Appeal{},
+		&data.DeadLetter{},
+		&data.ProcessedEvent{},
+		&idempotent.Processed{},
+	)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return db, cleanup, nil
+}
+
+func newConfServer() *conf.Server {
+	return &conf.Server{
+		Grpc:     &conf.Server_GRPC{Addr: "127.0.0.1:0"},
+		Rocketmq: &conf.Server_RocketMq{},
+		Consumer: &conf.Server_Consumer{},
+		Counter:  &conf.Server_Counter{},
+	}
+}
+
+// newConfData points the buckets at s and the token verifier at issuer.
+func newConfData(s *testkit.CosServer, issuer *testkit.Issuer) *conf.Data {
+	callback := map[string]string{
+		"article_create": "http://127.0.0.1/cos/review/article/create",
+		"article_edit":   "http://127.0.0.1/cos/review/article/edit",
+	}
+	return &conf.Data{
+		Jwt: &conf.Data_Jwt{JwksUrl: issuer.URL()},
+		Cos: &conf.Data_Cos{
+			BucketUser:     &conf.Data_Cos_BucketUser{BucketUrl: s.URL()},
+			BucketCreation: &conf.Data_Cos_BucketCreation{BucketUrl: s.URL(), CiUrl: s.URL(), Callback: callback},
+			BucketComment:  &conf.Data_Cos_BucketComment{BucketUrl: s.URL(), CiUrl: s.URL(), Callback: map[string]string{}},
+		},
+	}
+}
+
+func newUserClient() (userv1.UserClient, func(), error) {
+	conn, cleanup, err := testkit.Unimplemented()
+	if err != nil {
+		return nil, nil, err
+	}
+	return userv1.NewUserClient(conn), cleanup, nil
+}
+
+func newCommentClient() (commentv1.CommentClient, func(), error) {
+	conn, cleanup, err := testkit.Unimplemented()
+	if err != nil {
+		return nil, nil, err
+	}
+	return commentv1.NewCommentClient(conn), cleanup, nil
+}
+
+// newRedis starts a redis with the scripts of tool/lua loaded.
+func newRedis() (redis.Cmdable, func(), error) {
+	redisCmd, cleanup, err := testkit.NewRedis()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = testkit.LoadScripts(redisCmd, data.Scripts)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return redisCmd, cleanup, nil
+}
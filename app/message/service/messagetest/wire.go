@@ -0,0 +1,24 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package messagetest
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	achievementv1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/app/message/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/message/service/internal/data"
+	"github.com/the-zion/matrix-core/app/message/service/internal/server"
+	"github.com/the-zion/matrix-core/app/message/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+)
+
+// New starts a message service consuming the events of bus, reviewing the
+// objects of bucket and verifying the tokens of issuer.
+func New(*testkit.Bus, *testkit.CosServer, *testkit.Issuer, creationv1.CreationClient, achievementv1.AchievementClient, log.Logger) (*Message, func(), error) {
+	panic(wire.Build(newDB, newRedis, newUserClient, newCommentClient, newConfServer, newConfData, data.NewJwtClient, data.NewCosUserClient, data.NewCosCreationClient, data.NewCosCommentClient, data.RepoSet, biz.ProviderSet, service.ProviderSet, server.NewGRPCServer, newMessage))
+}
@@ -10,13 +10,17 @@ import (
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/p2c"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/nacos-group/nacos-sdk-go/clients"
 	"github.com/nacos-group/nacos-sdk-go/common/constant"
 	"github.com/nacos-group/nacos-sdk-go/vo"
 	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"github.com/the-zion/matrix-core/app/user/service/internal/server"
 	"github.com/the-zion/matrix-core/pkg/kube"
+	"github.com/the-zion/matrix-core/pkg/redact"
 	"github.com/the-zion/matrix-core/pkg/trace"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"gopkg.in/yaml.v3"
@@ -67,7 +71,7 @@ func init() {
 	flag.StringVar(&logSelect, "log", "default", "log select, eg: -log default")
 }
 
-func newApp(r *nacos.Registry, hs *http.Server, gs *grpc.Server) *kratos.App {
+func newApp(r *nacos.Registry, hs *http.Server, gs *grpc.Server, rs *server.RecommendServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -77,6 +81,7 @@ func newApp(r *nacos.Registry, hs *http.Server, gs *grpc.Server) *kratos.App {
 		kratos.Server(
 			hs,
 			gs,
+			rs,
 		),
 	)
 }
@@ -199,14 +204,15 @@ func loggerInit() {
 		}
 
 		tencentLogger.GetProducer().Start()
-		logger = log.With(tencentLogger, logKv...)
+		logger = log.With(redact.Logger(tencentLogger), logKv...)
 	default:
-		logger = log.With(log.NewStdLogger(os.Stdout), logKv...)
+		logger = log.With(redact.Logger(log.NewStdLogger(os.Stdout)), logKv...)
 	}
 }
 
 func appInit() {
 	var err error
+	selector.SetGlobalSelector(p2c.NewBuilder())
 	app, cleanup, err = wireApp(bootstrap.Config.Server, bootstrap.Config.Data, bootstrap.Config.Auth, logger, rclient)
 	if err != nil {
 		panic(err)
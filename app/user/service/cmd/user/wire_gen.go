@@ -23,30 +23,47 @@ import (
 func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logLogger log.Logger, registry *nacos.Registry) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	cmdable := data.NewRedis(confData)
-	mqPro := data.NewRocketmqProducer(confData)
-	elasticSearch := data.NewElasticsearch(confData)
+	transport := data.NewTransport(confData)
+	elasticsearchClient := data.NewElasticsearch(confData)
 	cos := data.NewCosClient(confData)
 	client := data.NewCosServiceClient(confData)
-	github := data.NewGithub(confData)
-	wechat := data.NewWechat(confData)
-	qq := data.NewQQ(confData)
-	gitee := data.NewGitee(confData)
 	aliCode := data.NewPhoneCodeClient(confData)
 	mail := data.NewMail(confData)
-	dataData, cleanup2, err := data.NewData(db, cmdable, mqPro, elasticSearch, cos, client, github, wechat, qq, gitee, aliCode, mail, logLogger)
+	creationClient := data.NewCreationServiceClient(registry)
+	achievementClient := data.NewAchievementServiceClient(registry)
+	dataData, cleanup2, err := data.NewData(db, cmdable, transport, elasticsearchClient, cos, client, aliCode, mail, creationClient, achievementClient, logLogger)
 	if err != nil {
 		return nil, nil, err
 	}
 	userRepo := data.NewUserRepo(dataData, logLogger)
 	recovery := data.NewRecovery(dataData)
 	transaction := data.NewTransaction(dataData)
-	userUseCase := biz.NewUserUseCase(userRepo, recovery, transaction, logLogger)
+	publisher := data.NewPublisher(dataData)
+	userUseCase := biz.NewUserUseCase(userRepo, recovery, transaction, publisher, logLogger)
 	authRepo := data.NewAuthRepo(dataData, logLogger)
-	authUseCase := biz.NewAuthUseCase(auth, authRepo, recovery, userRepo, transaction, logLogger)
-	userService := service.NewUserService(userUseCase, authUseCase, logLogger)
+	adminRepo := data.NewAdminRepo(dataData, logLogger)
+	signer, err := biz.NewSigner(auth, logLogger)
+	if err != nil {
+		cleanup2()
+		return nil, nil, err
+	}
+	captcha := data.NewCaptcha(auth)
+	identityProviders, err := data.NewIdentityProviders(confData, logLogger)
+	if err != nil {
+		cleanup2()
+		return nil, nil, err
+	}
+	authUseCase := biz.NewAuthUseCase(auth, signer, captcha, identityProviders, authRepo, recovery, userRepo, adminRepo, transaction, publisher, logLogger)
+	recommendRepo := data.NewRecommendRepo(dataData, logLogger)
+	recommendUseCase := biz.NewRecommendUseCase(confServer, recommendRepo, logLogger)
+	handleRepo := data.NewHandleRepo(dataData, logLogger)
+	handleUseCase := biz.NewHandleUseCase(confServer, handleRepo, transaction, logLogger)
+	adminUseCase := biz.NewAdminUseCase(adminRepo, authRepo, transaction, logLogger)
+	userService := service.NewUserService(userUseCase, authUseCase, recommendUseCase, handleUseCase, adminUseCase, logLogger)
 	httpServer := server.NewHTTPServer(confServer, userService, logLogger)
 	grpcServer := server.NewGRPCServer(confServer, userService, logLogger)
-	kratosApp := newApp(registry, httpServer, grpcServer)
+	recommendServer := server.NewRecommendServer(confServer, recommendUseCase, logLogger)
+	kratosApp := newApp(registry, httpServer, grpcServer, recommendServer)
 	return kratosApp, func() {
 		cleanup2()
 	}, nil
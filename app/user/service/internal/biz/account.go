@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"time"
+)
+
+const (
+	// userDataExportTtl is how long an export archive is kept, and how long
+	// a user waits before asking for the next one.
+	userDataExportTtl    = 24 * time.Hour
+	userDataExportUrlTtl = time.Hour
+)
+
+// DeleteAccount closes the account of uuid once its owner verified it. The
+// sign in methods go at once; everything else the services hold about it is
+// purged when the account_deleted event is handled.
+func (r *AuthUseCase) DeleteAccount(ctx context.Context, uuid, phone, email, password, code, choose, state string) error {
+	_, err := r.VerifyAccount(ctx, uuid, phone, email, password, code, choose, state)
+	if err != nil {
+		return err
+	}
+
+	err = r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.DeleteUser(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		return r.pub.Publish(ctx, &event.Account{
+			Header: event.Header{Mode: event.ModeAccountDeleted},
+			Uuid:   uuid,
+		}, uuid)
+	})
+	if err != nil {
+		return v1.ErrorDeleteAccountFailed("delete account failed: %s", err.Error())
+	}
+	r.revokeSessions(ctx, uuid)
+	return nil
+}
+
+// ExportUserData starts building the data archive of uuid and returns its id.
+// One archive may be asked for a day.
+func (r *UserUseCase) ExportUserData(ctx context.Context, uuid string) (string, error) {
+	id, err := r.repo.SetUserDataExport(ctx, uuid, userDataExportTtl)
+	if kerrors.IsConflict(err) {
+		return "", v1.ErrorExportTooFrequent("export user data failed: %s", err.Error())
+	}
+	if err != nil {
+		return "", v1.ErrorExportUserDataFailed("export user data failed: %s", err.Error())
+	}
+
+	err = r.pub.Publish(ctx, &event.Account{
+		Header:   event.Header{Mode: event.ModeExportUserData},
+		Uuid:     uuid,
+		ExportId: id,
+	}, uuid)
+	if err != nil {
+		r.deleteUserDataExport(ctx, uuid)
+		return "", v1.ErrorExportUserDataFailed("export user data failed: %s", err.Error())
+	}
+	return id, nil
+}
+
+func (r *UserUseCase) deleteUserDataExport(ctx context.Context, uuid string) {
+	err := r.repo.DeleteUserDataExport(ctx, uuid)
+	if err != nil {
+		r.log.Errorf("fail to delete user data export: uuid(%s), err(%v)", uuid, err)
+	}
+}
+
+// GetUserDataExport reports whether the last archive of uuid was built, and if
+// so a link to download it.
+func (r *UserUseCase) GetUserDataExport(ctx context.Context, uuid string) (*UserDataExport, error) {
+	id, err := r.repo.GetUserDataExport(ctx, uuid)
+	if kerrors.IsNotFound(err) {
+		return &UserDataExport{}, nil
+	}
+	if err != nil {
+		return nil, v1.ErrorExportUserDataFailed("get user data export failed: %s", err.Error())
+	}
+
+	url, err := r.repo.GetUserDataExportUrl(ctx, uuid, id, userDataExportUrlTtl)
+	if kerrors.IsNotFound(err) {
+		return &UserDataExport{Id: id}, nil
+	}
+	if err != nil {
+		return nil, v1.ErrorExportUserDataFailed("get user data export failed: %s", err.Error())
+	}
+	return &UserDataExport{
+		Id:    id,
+		Ready: true,
+		Url:   url,
+	}, nil
+}
+
+func (r *UserUseCase) GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error) {
+	files, err := r.repo.GetUserData(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetUserDataFailed("get user data failed: %s", err.Error())
+	}
+	return files, nil
+}
+
+// PurgeUserData drops the profile, reviews and follows of a deleted user. The
+// follow counts of the other side are taken back through the same event as an
+// unfollow.
+func (r *UserUseCase) PurgeUserData(ctx context.Context, uuid string) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		follows, err := r.repo.GetUserFollowRelations(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("get user follow relations failed: %s", err.Error())
+		}
+		for _, follow := range follows {
+			err = r.repo.CancelUserFollowFromCache(ctx, follow.Follow, follow.Followed)
+			if err != nil {
+				return v1.ErrorPurgeUserDataFailed("cancel follow failed: %s", err.Error())
+			}
+			err = r.pub.Publish(ctx, &event.AchievementFollow{
+				Header:   event.Header{Mode: event.ModeFollowCancel, Key: event.PurgeKey(event.ModeFollowCancel, follow.Id)},
+				Follow:   follow.Follow,
+				Followed: follow.Followed,
+			}, follow.Follow)
+			if err != nil {
+				return v1.ErrorPurgeUserDataFailed("cancel follow to mq failed: %s", err.Error())
+			}
+		}
+
+		err = r.repo.PurgeUserData(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data failed: %s", err.Error())
+		}
+
+		err = r.repo.PurgeUserDataFromCache(ctx, uuid)
+		if err != nil {
+			return v1.ErrorPurgeUserDataFailed("purge user data from cache failed: %s", err.Error())
+		}
+		return nil
+	})
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"time"
+)
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+type AdminRepo interface {
+	GetUserRole(ctx context.Context, uuid string) (string, error)
+	SetUserRole(ctx context.Context, uuid, role string) error
+	GetUserSuspension(ctx context.Context, uuid string) (*Suspension, error)
+	SetUserSuspension(ctx context.Context, suspension *Suspension) error
+	CancelUserSuspension(ctx context.Context, uuid string) error
+	AddAuditLog(ctx context.Context, auditLog *AuditLog) (int32, error)
+	CompleteAuditLog(ctx context.Context, id int32, failed bool) error
+	GetAuditLogList(ctx context.Context, page int32, operator string) ([]*AuditLog, error)
+}
+
+type AdminUseCase struct {
+	repo     AdminRepo
+	authRepo AuthRepo
+	tm       Transaction
+	log      *log.Helper
+}
+
+func NewAdminUseCase(repo AdminRepo, authRepo AuthRepo, tm Transaction, logger log.Logger) *AdminUseCase {
+	return &AdminUseCase{
+		repo:     repo,
+		authRepo: authRepo,
+		tm:       tm,
+		log:      log.NewHelper(log.With(logger, "module", "user/biz/adminUseCase")),
+	}
+}
+
+// GetUserRole returns the role of uuid, RoleUser unless it was given another.
+func (r *AdminUseCase) GetUserRole(ctx context.Context, uuid string) (string, error) {
+	role, err := r.repo.GetUserRole(ctx, uuid)
+	if err != nil {
+		return "", v1.ErrorGetUserRoleFailed("get user role failed: %s", err.Error())
+	}
+	return userRole(role), nil
+}
+
+// SetUserRole gives role to uuid. The access tokens of uuid carry the new role
+// from their next refresh on.
+func (r *AdminUseCase) SetUserRole(ctx context.Context, uuid, role string) error {
+	err := r.repo.SetUserRole(ctx, uuid, role)
+	if err != nil {
+		return v1.ErrorSetUserRoleFailed("set user role failed: %s", err.Error())
+	}
+	return nil
+}
+
+// SuspendUser keeps suspension.Uuid from signing in until suspension.Until, or
+// until it is lifted when Until is 0, and signs it out everywhere.
+func (r *AdminUseCase) SuspendUser(ctx context.Context, suspension *Suspension) error {
+	if suspension.Until != 0 && suspension.Until <= time.Now().Unix() {
+		return v1.ErrorSuspendUserFailed("suspend user failed: until(%v) has passed", suspension.Until)
+	}
+
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.SetUserSuspension(ctx, suspension)
+		if err != nil {
+			return v1.ErrorSuspendUserFailed("set user suspension failed: %s", err.Error())
+		}
+
+		err = r.authRepo.DeleteUserSessions(ctx, suspension.Uuid)
+		if err != nil {
+			return v1.ErrorSuspendUserFailed("delete user sessions failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+func (r *AdminUseCase) UnsuspendUser(ctx context.Context, uuid string) error {
+	err := r.repo.CancelUserSuspension(ctx, uuid)
+	if err != nil {
+		return v1.ErrorSuspendUserFailed("cancel user suspension failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *AdminUseCase) AddAuditLog(ctx context.Context, auditLog *AuditLog) (int32, error) {
+	id, err := r.repo.AddAuditLog(ctx, auditLog)
+	if err != nil {
+		return 0, v1.ErrorAddAuditLogFailed("add audit log failed: %s", err.Error())
+	}
+	return id, nil
+}
+
+func (r *AdminUseCase) CompleteAuditLog(ctx context.Context, id int32, failed bool) error {
+	err := r.repo.CompleteAuditLog(ctx, id, failed)
+	if err != nil {
+		return v1.ErrorAddAuditLogFailed("complete audit log failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *AdminUseCase) GetAuditLogList(ctx context.Context, page int32, operator string) ([]*AuditLog, error) {
+	list, err := r.repo.GetAuditLogList(ctx, page, operator)
+	if err != nil {
+		return nil, v1.ErrorGetAuditLogFailed("get audit log list failed: %s", err.Error())
+	}
+	return list, nil
+}
+
+// checkSuspension fails with ErrorUserSuspended while uuid is suspended.
+func (r *AuthUseCase) checkSuspension(ctx context.Context, uuid string) error {
+	suspension, err := r.adminRepo.GetUserSuspension(ctx, uuid)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return v1.ErrorLoginFailed("get user suspension failed: %s", err.Error())
+	}
+	if suspension.Until == 0 {
+		return v1.ErrorUserSuspended("user is suspended: %s", suspension.Reason)
+	}
+	return v1.ErrorUserSuspended("user is suspended until %s: %s", time.Unix(suspension.Until, 0).Format(time.RFC3339), suspension.Reason)
+}
+
+func userRole(role string) string {
+	if role == "" {
+		return RoleUser
+	}
+	return role
+}
@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"time"
+)
+
+// AttemptPolicy says how failed credential checks of one subject are punished.
+// The first Free-1 failures within Window are let through; from the Free-th on
+// every failure locks the subject for Base, doubling each time up to Max. The
+// window restarts with every failure and a success of an account clears it.
+// An attempt counts as a failure from its start until it succeeds.
+type AttemptPolicy struct {
+	Free   int64
+	Base   time.Duration
+	Max    time.Duration
+	Window time.Duration
+}
+
+var (
+	accountAttemptPolicy = &AttemptPolicy{Free: 5, Base: 30 * time.Second, Max: time.Hour, Window: 24 * time.Hour}
+	ipAttemptPolicy      = &AttemptPolicy{Free: 30, Base: 30 * time.Second, Max: time.Hour, Window: time.Hour}
+)
+
+// attempt runs check, a credential check of account made from ip, unless one
+// of them is locked out. A failure is reserved against both before check runs,
+// so that concurrent attempts cannot get past the lock together, and is taken
+// back when check succeeds. A lockout is returned as ErrorAccountLocked. When
+// the failure cannot be reserved check does not run, as the lockout could not
+// be enforced, and the error of the reservation is returned; any other error
+// is the one of check.
+func (r *AuthUseCase) attempt(ctx context.Context, account, ip string, check func() error) error {
+	account = "account_" + account
+	subjects := []string{account}
+	policies := []*AttemptPolicy{accountAttemptPolicy}
+	if ip != "" {
+		subjects = append(subjects, "ip_"+ip)
+		policies = append(policies, ipAttemptPolicy)
+	}
+
+	wait, lock, err := r.repo.ReserveAttempt(ctx, subjects, policies)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return v1.ErrorAccountLocked("too many failed attempts, retry in %s", wait.Round(time.Second)).
+			WithMetadata(map[string]string{"retryAfter": wait.Round(time.Second).String()})
+	}
+	if lock > 0 {
+		r.log.Warnf("too many failed attempts, subjects locked: subjects(%v), lock(%s)", subjects, lock)
+	}
+
+	err = check()
+	if err != nil {
+		return err
+	}
+
+	err = r.repo.ResetAttempts(ctx, account)
+	if err != nil {
+		r.log.Errorf("fail to reset attempts: subject(%s), err(%v)", account, err)
+	}
+	if ip != "" {
+		err = r.repo.RefundAttempt(ctx, "ip_"+ip, ipAttemptPolicy)
+		if err != nil {
+			r.log.Errorf("fail to refund attempt: subject(%s), err(%v)", "ip_"+ip, err)
+		}
+	}
+	return nil
+}
+
+func (r *AuthUseCase) verifyPhoneCode(ctx context.Context, phone, code, ip string) error {
+	err := r.attempt(ctx, "phone_"+phone, ip, func() error {
+		return r.repo.VerifyPhoneCode(ctx, phone, code)
+	})
+	if err != nil && !v1.IsAccountLocked(err) {
+		return v1.ErrorVerifyCodeFailed("verify code failed: %s", err.Error())
+	}
+	return err
+}
+
+func (r *AuthUseCase) verifyEmailCode(ctx context.Context, email, code, ip string) error {
+	err := r.attempt(ctx, "email_"+email, ip, func() error {
+		return r.repo.VerifyEmailCode(ctx, email, code)
+	})
+	if err != nil && !v1.IsAccountLocked(err) {
+		return v1.ErrorVerifyCodeFailed("verify code failed: %s", err.Error())
+	}
+	return err
+}
+
+func (r *AuthUseCase) verifyPassword(ctx context.Context, account, password, mode, ip string) (*User, error) {
+	if mode != "phone" {
+		mode = "email"
+	}
+	var user *User
+	err := r.attempt(ctx, mode+"_"+account, ip, func() error {
+		var err error
+		user, err = r.repo.VerifyPassword(ctx, account, password, mode)
+		return err
+	})
+	if err != nil && !v1.IsAccountLocked(err) {
+		return nil, v1.ErrorVerifyPasswordFailed("verify password failed: %s", err.Error())
+	}
+	return user, err
+}
+
+// realIp is the client address the bff forwards with every call, empty when
+// the caller did not send one.
+func realIp(ctx context.Context) string {
+	ip, _ := ctx.Value("realIp").(string)
+	return ip
+}
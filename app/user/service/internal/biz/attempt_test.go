@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeAttemptRepo answers the attempt calls of AuthRepo; any other call
+// panics.
+type fakeAttemptRepo struct {
+	AuthRepo
+	wait     time.Duration
+	err      error
+	checked  int
+	refunded []string
+	reset    []string
+}
+
+func (r *fakeAttemptRepo) ReserveAttempt(context.Context, []string, []*AttemptPolicy) (time.Duration, time.Duration, error) {
+	return r.wait, 0, r.err
+}
+
+func (r *fakeAttemptRepo) RefundAttempt(_ context.Context, subject string, _ *AttemptPolicy) error {
+	r.refunded = append(r.refunded, subject)
+	return nil
+}
+
+func (r *fakeAttemptRepo) ResetAttempts(_ context.Context, subject string) error {
+	r.reset = append(r.reset, subject)
+	return nil
+}
+
+func (r *fakeAttemptRepo) VerifyPassword(context.Context, string, string, string) (*User, error) {
+	r.checked++
+	return &User{}, nil
+}
+
+func (r *fakeAttemptRepo) VerifyPhoneCode(context.Context, string, string) error {
+	r.checked++
+	return nil
+}
+
+func newAttemptTest(repo AuthRepo) *AuthUseCase {
+	return &AuthUseCase{
+		repo: repo,
+		log:  log.NewHelper(log.NewStdLogger(io.Discard)),
+	}
+}
+
+func TestAttempt(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    *fakeAttemptRepo
+		checked int
+		is      func(error) bool
+	}{
+		{
+			name:    "reserved",
+			repo:    &fakeAttemptRepo{},
+			checked: 1,
+		},
+		{
+			name: "locked",
+			repo: &fakeAttemptRepo{wait: 90 * time.Second},
+			is:   v1.IsAccountLocked,
+		},
+		{
+			name: "reserve failed",
+			repo: &fakeAttemptRepo{err: errors.New("redis down")},
+			is:   v1.IsVerifyPasswordFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newAttemptTest(tt.repo)
+			_, err := r.verifyPassword(context.Background(), "someone@matrix.com", "password", "email", "127.0.0.1")
+			if tt.is == nil && err != nil {
+				t.Fatalf("got error %v, want none", err)
+			}
+			if tt.is != nil && !tt.is(err) {
+				t.Fatalf("got error %v of the wrong kind", err)
+			}
+			if tt.repo.checked != tt.checked {
+				t.Fatalf("checked the password %v times, want %v", tt.repo.checked, tt.checked)
+			}
+		})
+	}
+}
+
+// TestAttemptLockedRetryAfter tells the client when it may try again.
+func TestAttemptLockedRetryAfter(t *testing.T) {
+	r := newAttemptTest(&fakeAttemptRepo{wait: 90*time.Second + 400*time.Millisecond})
+	err := r.verifyPhoneCode(context.Background(), "13000000000", "123456", "127.0.0.1")
+	if !v1.IsAccountLocked(err) {
+		t.Fatalf("got error %v, want account locked", err)
+	}
+	if got := kerrors.FromError(err).Metadata["retryAfter"]; got != "1m30s" {
+		t.Fatalf("got retryAfter(%s), want 1m30s", got)
+	}
+}
+
+// TestAttemptReserveFailed fails the code check closed instead of letting it
+// past a lockout that cannot be enforced.
+func TestAttemptReserveFailed(t *testing.T) {
+	repo := &fakeAttemptRepo{err: errors.New("redis down")}
+	r := newAttemptTest(repo)
+	err := r.verifyPhoneCode(context.Background(), "13000000000", "123456", "127.0.0.1")
+	if !v1.IsVerifyCodeFailed(err) {
+		t.Fatalf("got error %v, want verify code failed", err)
+	}
+	if repo.checked != 0 {
+		t.Fatalf("checked the code %v times, want none", repo.checked)
+	}
+}
+
+// TestAttemptSucceeded clears the failures of the account and takes back the
+// one reserved against the ip.
+func TestAttemptSucceeded(t *testing.T) {
+	repo := &fakeAttemptRepo{}
+	r := newAttemptTest(repo)
+	err := r.verifyPhoneCode(context.Background(), "13000000000", "123456", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.reset) != 1 || repo.reset[0] != "account_phone_13000000000" {
+		t.Fatalf("reset attempts of %v, want the account", repo.reset)
+	}
+	if len(repo.refunded) != 1 || repo.refunded[0] != "ip_127.0.0.1" {
+		t.Fatalf("refunded attempts of %v, want the ip", repo.refunded)
+	}
+}
@@ -3,15 +3,21 @@ package biz
 import (
 	"context"
 	"github.com/google/wire"
+	"github.com/the-zion/matrix-core/pkg/event"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewUserUseCase, NewAuthUseCase)
+var ProviderSet = wire.NewSet(NewUserUseCase, NewAuthUseCase, NewRecommendUseCase, NewHandleUseCase, NewAdminUseCase, NewSigner)
 
 type Transaction interface {
 	ExecTx(context.Context, func(ctx context.Context) error) error
 }
 
+// Publisher emits an event once the surrounding transaction, if any, commits.
+type Publisher interface {
+	Publish(ctx context.Context, e event.Event, key string) error
+}
+
 type Recovery interface {
 	GroupRecover(context.Context, func(ctx context.Context) error) func() error
 }
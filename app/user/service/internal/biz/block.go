@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+)
+
+// BlockUser blocks userUuid for uuid. Neither can follow the other while the
+// block stands, so the follows between them are cancelled, counters included.
+func (r *UserUseCase) BlockUser(ctx context.Context, uuid, userUuid string) error {
+	if uuid == userUuid {
+		return v1.ErrorBlockUserFailed("uuid and userUuid are the same: %s", uuid)
+	}
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.repo.SetUserBlock(ctx, uuid, userUuid)
+		if err != nil {
+			return v1.ErrorBlockUserFailed("set block failed: %s", err.Error())
+		}
+
+		err = r.cancelBlockedFollow(ctx, uuid, userUuid)
+		if err != nil {
+			return v1.ErrorBlockUserFailed("cancel follow failed: %s", err.Error())
+		}
+
+		err = r.cancelBlockedFollow(ctx, userUuid, uuid)
+		if err != nil {
+			return v1.ErrorBlockUserFailed("cancel followed failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// cancelBlockedFollow drops the follow of uuid on userUuid, or the request for
+// it. The cache is cleared even without a follow in the db, as a follow not
+// yet written is already cached.
+func (r *UserUseCase) cancelBlockedFollow(ctx context.Context, uuid, userUuid string) error {
+	err := r.repo.CancelFollowRequest(ctx, uuid, userUuid)
+	if err != nil {
+		return err
+	}
+
+	follow, err := r.repo.GetUserFollow(ctx, uuid, userUuid)
+	if err != nil {
+		return err
+	}
+
+	err = r.repo.CancelUserFollowFromCache(ctx, uuid, userUuid)
+	if err != nil {
+		return err
+	}
+
+	if !follow {
+		return nil
+	}
+
+	err = r.repo.CancelUserFollow(ctx, uuid, userUuid)
+	if err != nil {
+		return err
+	}
+	return r.publishUserStatistic(ctx, uuid, userUuid, "follow_cancel")
+}
+
+func (r *UserUseCase) UnblockUser(ctx context.Context, uuid, userUuid string) error {
+	err := r.repo.CancelUserBlock(ctx, uuid, userUuid, 1)
+	if err != nil {
+		return v1.ErrorBlockUserFailed("cancel block failed: %s", err.Error())
+	}
+	return nil
+}
+
+// MuteUser keeps userUuid out of the mailbox of uuid, but lets them follow
+// and reply to each other.
+func (r *UserUseCase) MuteUser(ctx context.Context, uuid, userUuid string) error {
+	if uuid == userUuid {
+		return v1.ErrorBlockUserFailed("uuid and userUuid are the same: %s", uuid)
+	}
+	err := r.repo.SetUserMute(ctx, uuid, userUuid)
+	if err != nil {
+		return v1.ErrorBlockUserFailed("set mute failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *UserUseCase) UnmuteUser(ctx context.Context, uuid, userUuid string) error {
+	err := r.repo.CancelUserBlock(ctx, uuid, userUuid, 2)
+	if err != nil {
+		return v1.ErrorBlockUserFailed("cancel mute failed: %s", err.Error())
+	}
+	return nil
+}
+
+// GetUserBlock reports whether either of uuid and userUuid blocked the other,
+// and whether uuid muted userUuid.
+func (r *UserUseCase) GetUserBlock(ctx context.Context, uuid, userUuid string) (bool, bool, error) {
+	blocks, err := r.repo.GetUserBlock(ctx, uuid, userUuid)
+	if err != nil {
+		return false, false, v1.ErrorGetUserBlockFailed("get user block failed: %s", err.Error())
+	}
+	var blocked, muted bool
+	for _, item := range blocks {
+		switch {
+		case item.Status == 1:
+			blocked = true
+		case item.Status == 2 && item.Uuid == uuid:
+			muted = true
+		}
+	}
+	return blocked, muted, nil
+}
+
+// GetUserBlocks lists the users uuid blocked and those it muted.
+func (r *UserUseCase) GetUserBlocks(ctx context.Context, uuid string) ([]string, []string, error) {
+	blocks, err := r.repo.GetUserBlocks(ctx, uuid)
+	if err != nil {
+		return nil, nil, v1.ErrorGetUserBlockFailed("get user blocks failed: %s", err.Error())
+	}
+	blocked := make([]string, 0, len(blocks))
+	muted := make([]string, 0)
+	for _, item := range blocks {
+		if item.Status == 1 {
+			blocked = append(blocked, item.Blocked)
+		} else {
+			muted = append(muted, item.Blocked)
+		}
+	}
+	return blocked, muted, nil
+}
@@ -5,14 +5,12 @@ import (
 )
 
 type User struct {
-	Uuid     string
-	Phone    string
-	Email    string
-	Qq       string
-	Wechat   string
-	Gitee    int32
-	Github   int32
-	Password string
+	Uuid       string
+	Phone      string
+	Email      string
+	Password   string
+	Totp       bool
+	Identities []string
 }
 
 type Profile struct {
@@ -28,6 +26,7 @@ type Profile struct {
 	Github    string
 	Gitee     string
 	Introduce string
+	Private   bool
 }
 
 type UserSearch struct {
@@ -43,11 +42,38 @@ type ProfileUpdate struct {
 	Status int32
 }
 
-type Github struct {
-	Token    string
-	Avatar   string
-	Register bool
-	Uuid     string
+// Token is an access token and the refresh token of its session. A login
+// that still needs a second factor only carries the Challenge to complete it.
+type Token struct {
+	Token        string
+	RefreshToken string
+	Challenge    string
+}
+
+// Totp is the authenticator of a user, Enabled once its first code was
+// confirmed.
+type Totp struct {
+	Uuid    string
+	Secret  string
+	Enabled bool
+}
+
+// TotpChallenge is a login waiting for its totp code.
+type TotpChallenge struct {
+	Uuid   string
+	Device string
+	Ip     string
+}
+
+// Session is a device a user is signed in on. Created and Seen are unix
+// seconds of the login and of the last token refresh.
+type Session struct {
+	Id      string
+	Uuid    string
+	Device  string
+	Ip      string
+	Created int64
+	Seen    int64
 }
 
 type Credentials struct {
@@ -71,6 +97,38 @@ type Follows struct {
 	Follow int32
 }
 
+type Block struct {
+	Uuid    string
+	Blocked string
+	Status  int32
+}
+
+type Handle struct {
+	Uuid       string
+	Handle     string
+	Status     int32
+	Update     time.Time
+	Changeable int64
+}
+
+type Suspension struct {
+	Uuid   string
+	Until  int64
+	Reason string
+}
+
+type AuditLog struct {
+	Id        int32
+	Operator  string
+	Role      string
+	Action    string
+	Kind      string
+	Target    string
+	Reason    string
+	CreatedAt int64
+	Status    int32
+}
+
 //easyjson:json
 type ImageReview struct {
 	Id       int32
@@ -98,16 +156,18 @@ type ProfileUpdateMap struct {
 	Introduce string
 }
 
-//easyjson:json
-type SendUserStatisticMap struct {
-	Follow   string
-	Followed string
-	Mode     string
+type UserDataFile struct {
+	Name    string
+	Content []byte
 }
 
-//easyjson:json
-type SetFollowMap struct {
-	Uuid   string
-	UserId string
-	Mode   string
+type UserDataExport struct {
+	Id    string
+	Ready bool
+	Url   string
+}
+
+type Recommend struct {
+	Uuid  string
+	Score int32
 }
@@ -90,167 +90,7 @@ func (v *UserSearchMap) UnmarshalJSON(data []byte) error {
 func (v *UserSearchMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz(l, v)
 }
-func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(in *jlexer.Lexer, out *SetFollowMap) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "uuid":
-			out.Uuid = string(in.String())
-		case "userId":
-			out.UserId = string(in.String())
-		case "mode":
-			out.Mode = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(out *jwriter.Writer, in SetFollowMap) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"uuid\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Uuid))
-	}
-	{
-		const prefix string = ",\"userId\":"
-		out.RawString(prefix)
-		out.String(string(in.UserId))
-	}
-	{
-		const prefix string = ",\"mode\":"
-		out.RawString(prefix)
-		out.String(string(in.Mode))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v SetFollowMap) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetFollowMap) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetFollowMap) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetFollowMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(l, v)
-}
-func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(in *jlexer.Lexer, out *SendUserStatisticMap) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "follow":
-			out.Follow = string(in.String())
-		case "followed":
-			out.Followed = string(in.String())
-		case "mode":
-			out.Mode = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(out *jwriter.Writer, in SendUserStatisticMap) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"follow\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Follow))
-	}
-	{
-		const prefix string = ",\"followed\":"
-		out.RawString(prefix)
-		out.String(string(in.Followed))
-	}
-	{
-		const prefix string = ",\"mode\":"
-		out.RawString(prefix)
-		out.String(string(in.Mode))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v SendUserStatisticMap) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SendUserStatisticMap) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *SendUserStatisticMap) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SendUserStatisticMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(l, v)
-}
-func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(in *jlexer.Lexer, out *ProfileUpdateMap) {
+func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(in *jlexer.Lexer, out *ProfileUpdateMap) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -283,7 +123,7 @@ func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(
 		in.Consumed()
 	}
 }
-func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(out *jwriter.Writer, in ProfileUpdateMap) {
+func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(out *jwriter.Writer, in ProfileUpdateMap) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -303,27 +143,27 @@ func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(
 // MarshalJSON supports json.Marshaler interface
 func (v ProfileUpdateMap) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(&w, v)
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ProfileUpdateMap) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(w, v)
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ProfileUpdateMap) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(&r, v)
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ProfileUpdateMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(l, v)
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz1(l, v)
 }
-func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(in *jlexer.Lexer, out *ProfileUpdate) {
+func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(in *jlexer.Lexer, out *ProfileUpdate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -370,6 +210,8 @@ func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(
 			out.Gitee = string(in.String())
 		case "introduce":
 			out.Introduce = string(in.String())
+		case "private":
+			out.Private = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -380,7 +222,7 @@ func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(
 		in.Consumed()
 	}
 }
-func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(out *jwriter.Writer, in ProfileUpdate) {
+func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(out *jwriter.Writer, in ProfileUpdate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -454,33 +296,38 @@ func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(
 		out.RawString(prefix)
 		out.String(string(in.Introduce))
 	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ProfileUpdate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(&w, v)
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ProfileUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(w, v)
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ProfileUpdate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(&r, v)
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ProfileUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz4(l, v)
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz2(l, v)
 }
-func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(in *jlexer.Lexer, out *ImageReview) {
+func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(in *jlexer.Lexer, out *ImageReview) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -531,7 +378,7 @@ func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(
 		in.Consumed()
 	}
 }
-func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(out *jwriter.Writer, in ImageReview) {
+func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(out *jwriter.Writer, in ImageReview) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -596,23 +443,23 @@ func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(
 // MarshalJSON supports json.Marshaler interface
 func (v ImageReview) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(&w, v)
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ImageReview) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(w, v)
+	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ImageReview) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(&r, v)
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ImageReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz5(l, v)
+	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppUserServiceInternalBiz3(l, v)
 }
@@ -0,0 +1,168 @@
+package biz
+
+import (
+	"context"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"strings"
+	"time"
+)
+
+const (
+	defaultHandleCooldown = time.Hour * 24 * 30
+	defaultHandleHold     = time.Hour * 24 * 14
+)
+
+// defaultReservedHandles are never given out, on top of those reserved in the
+// config.
+var defaultReservedHandles = []string{
+	"admin", "administrator", "root", "system", "official", "support", "help", "matrix",
+	"moderator", "staff", "security", "everyone", "here", "null", "undefined",
+}
+
+type HandleRepo interface {
+	GetUserHandle(ctx context.Context, uuid string) (*Handle, error)
+	LockHandle(ctx context.Context, handle string) (*Handle, error)
+	SetHandle(ctx context.Context, uuid, handle string) error
+	ReleaseHandle(ctx context.Context, uuid string) error
+	GetHandleUuids(ctx context.Context, handles []string) (map[string]string, error)
+}
+
+type HandleUseCase struct {
+	repo     HandleRepo
+	tm       Transaction
+	cooldown time.Duration
+	hold     time.Duration
+	reserved map[string]bool
+	log      *log.Helper
+}
+
+func NewHandleUseCase(conf *conf.Server, repo HandleRepo, tm Transaction, logger log.Logger) *HandleUseCase {
+	cooldown := conf.Handle.GetCooldown().AsDuration()
+	if cooldown <= 0 {
+		cooldown = defaultHandleCooldown
+	}
+	hold := conf.Handle.GetHold().AsDuration()
+	if hold <= 0 {
+		hold = defaultHandleHold
+	}
+	reserved := make(map[string]bool, len(defaultReservedHandles)+len(conf.Handle.GetReserved()))
+	for _, item := range defaultReservedHandles {
+		reserved[item] = true
+	}
+	for _, item := range conf.Handle.GetReserved() {
+		reserved[strings.ToLower(item)] = true
+	}
+	return &HandleUseCase{
+		repo:     repo,
+		tm:       tm,
+		cooldown: cooldown,
+		hold:     hold,
+		reserved: reserved,
+		log:      log.NewHelper(log.With(logger, "module", "user/biz/handleUseCase")),
+	}
+}
+
+// SetUserHandle gives handle, compared case-insensitively, to uuid. A user may
+// change its handle once per cooldown. The handle it gives up stays held for
+// it until the hold ends, so that it can take it back before anyone else.
+func (r *HandleUseCase) SetUserHandle(ctx context.Context, uuid, handle string) error {
+	handle = strings.ToLower(handle)
+	if r.reserved[handle] {
+		return v1.ErrorHandleReserved("handle is reserved: %s", handle)
+	}
+
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		current, err := r.repo.GetUserHandle(ctx, uuid)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return v1.ErrorSetHandleFailed("get user handle failed: %s", err.Error())
+		}
+
+		if err == nil {
+			if current.Handle == handle {
+				return nil
+			}
+			changeable := current.Update.Add(r.cooldown)
+			if time.Now().Before(changeable) {
+				return v1.ErrorHandleCooldown("handle can not be changed until %s", changeable.Format(time.RFC3339))
+			}
+		}
+
+		owner, err := r.repo.LockHandle(ctx, handle)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return v1.ErrorSetHandleFailed("lock handle failed: %s", err.Error())
+		}
+
+		if err == nil && owner.Uuid != uuid && (owner.Status == 1 || time.Now().Before(owner.Update.Add(r.hold))) {
+			return v1.ErrorHandleTaken("handle is taken: %s", handle)
+		}
+
+		err = r.repo.ReleaseHandle(ctx, uuid)
+		if err != nil {
+			return v1.ErrorSetHandleFailed("release handle failed: %s", err.Error())
+		}
+
+		err = r.repo.SetHandle(ctx, uuid, handle)
+		if kerrors.IsConflict(err) {
+			return v1.ErrorHandleTaken("handle is taken: %s", handle)
+		}
+		if err != nil {
+			return v1.ErrorSetHandleFailed("set handle failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+// GetUserHandle returns the handle of uuid, empty while it has none, and when
+// it may be changed next.
+func (r *HandleUseCase) GetUserHandle(ctx context.Context, uuid string) (*Handle, error) {
+	handle, err := r.repo.GetUserHandle(ctx, uuid)
+	if kerrors.IsNotFound(err) {
+		return &Handle{
+			Uuid: uuid,
+		}, nil
+	}
+	if err != nil {
+		return nil, v1.ErrorGetHandleFailed("get user handle failed: %s", err.Error())
+	}
+	handle.Changeable = handle.Update.Add(r.cooldown).Unix()
+	return handle, nil
+}
+
+func (r *HandleUseCase) GetUserByHandle(ctx context.Context, handle string) (string, error) {
+	handle = strings.ToLower(handle)
+	uuids, err := r.repo.GetHandleUuids(ctx, []string{handle})
+	if err != nil {
+		return "", v1.ErrorGetHandleFailed("get handle uuids failed: %s", err.Error())
+	}
+
+	uuid, ok := uuids[handle]
+	if !ok {
+		return "", v1.ErrorHandleNotFound("handle not found: %s", handle)
+	}
+	return uuid, nil
+}
+
+// GetHandleUuids maps each of handles to the user holding it, leaving out the
+// handles no one holds. The keys are the handles as asked for.
+func (r *HandleUseCase) GetHandleUuids(ctx context.Context, handles []string) (map[string]string, error) {
+	lower := make([]string, 0, len(handles))
+	for _, item := range handles {
+		lower = append(lower, strings.ToLower(item))
+	}
+
+	uuids, err := r.repo.GetHandleUuids(ctx, lower)
+	if err != nil {
+		return nil, v1.ErrorGetHandleFailed("get handle uuids failed: %s", err.Error())
+	}
+
+	reply := make(map[string]string, len(uuids))
+	for index, item := range handles {
+		if uuid, ok := uuids[lower[index]]; ok {
+			reply[item] = uuid
+		}
+	}
+	return reply, nil
+}
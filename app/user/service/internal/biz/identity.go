@@ -0,0 +1,194 @@
+package biz
+
+import (
+	"context"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"time"
+)
+
+const oauthStateTtl = 10 * time.Minute
+
+// Identity is an account of a user at an oauth provider, Subject being the id
+// the provider knows it by.
+type Identity struct {
+	Provider string
+	Subject  string
+	Name     string
+	Avatar   string
+	Homepage string
+}
+
+// OauthState is an authorization a client was sent to a provider for, kept
+// until the provider redirects back with its state.
+type OauthState struct {
+	Provider    string
+	RedirectUri string
+	Nonce       string
+}
+
+// IdentityProvider signs users in with an oauth2 or openid connect provider.
+// AuthUrl is where a client is sent to authorize, Exchange turns the code it
+// comes back with into the identity that authorized.
+type IdentityProvider interface {
+	AuthUrl(ctx context.Context, state, nonce, redirectUri string) (string, error)
+	Exchange(ctx context.Context, code, nonce, redirectUri string) (*Identity, error)
+}
+
+// IdentityProviders are the configured providers by name.
+type IdentityProviders map[string]IdentityProvider
+
+// GetOauthUrl starts an authorization with provider and returns the url to
+// send the client to, and the state the provider hands back with the code.
+func (r *AuthUseCase) GetOauthUrl(ctx context.Context, provider, redirectUri string) (string, string, error) {
+	p, ok := r.providers[provider]
+	if !ok {
+		return "", "", v1.ErrorProviderNotFound("get oauth url failed: provider(%s)", provider)
+	}
+
+	state, err := randomSecret()
+	if err != nil {
+		return "", "", v1.ErrorLoginFailed("get oauth url failed: %s", err.Error())
+	}
+	nonce, err := randomSecret()
+	if err != nil {
+		return "", "", v1.ErrorLoginFailed("get oauth url failed: %s", err.Error())
+	}
+	url, err := p.AuthUrl(ctx, state, nonce, redirectUri)
+	if err != nil {
+		return "", "", v1.ErrorLoginFailed("get oauth url failed: %s", err.Error())
+	}
+	err = r.repo.CreateOauthState(ctx, hashSecret(state), &OauthState{
+		Provider:    provider,
+		RedirectUri: redirectUri,
+		Nonce:       nonce,
+	}, oauthStateTtl)
+	if err != nil {
+		return "", "", v1.ErrorLoginFailed("get oauth url failed: %s", err.Error())
+	}
+	return url, state, nil
+}
+
+// LoginByOauth signs in the user of the identity that authorized at provider,
+// creating the user the first time.
+func (r *AuthUseCase) LoginByOauth(ctx context.Context, provider, code, state, device, ip string) (*Token, error) {
+	identity, err := r.identity(ctx, provider, code, state)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.repo.FindUserByIdentity(ctx, identity.Provider, identity.Subject)
+	if kerrors.IsNotFound(err) {
+		name := identity.Name
+		if name == "" {
+			name = identity.Subject
+		}
+		err = r.tm.ExecTx(ctx, func(ctx context.Context) error {
+			user, err = r.repo.CreateUserWithIdentity(ctx, identity)
+			if err != nil {
+				return err
+			}
+			err = r.repo.CreateUserProfileWithIdentity(ctx, name, user.Uuid, identity)
+			if err != nil {
+				return err
+			}
+			err = r.repo.CreateUserProfileUpdateWithIdentity(ctx, name, user.Uuid, identity)
+			if err != nil {
+				return err
+			}
+			err = r.repo.CreateUserSearch(ctx, name, user.Uuid)
+			if err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, v1.ErrorLoginFailed("login failed: %s", err.Error())
+		}
+		if identity.Avatar != "" {
+			r.repo.SetUserAvatar(ctx, user.Uuid, identity.Avatar)
+		}
+	}
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("login failed: %s", err.Error())
+	}
+
+	token, err := r.login(ctx, user.Uuid, device, ip)
+	if v1.IsUserSuspended(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("login failed: %s", err.Error())
+	}
+	return token, nil
+}
+
+// SetUserIdentity binds the identity that authorized at provider to uuid.
+func (r *AuthUseCase) SetUserIdentity(ctx context.Context, uuid, provider, code, state string) error {
+	identity, err := r.identity(ctx, provider, code, state)
+	if err != nil {
+		return err
+	}
+
+	err = r.repo.CreateUserIdentity(ctx, uuid, identity)
+	if kerrors.IsConflict(err) {
+		return v1.ErrorIdentityConflict("set user identity failed: %s", err.Error())
+	}
+	if err != nil {
+		return v1.ErrorSetIdentityFailed("set user identity failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *AuthUseCase) UnbindUserIdentity(ctx context.Context, uuid, provider, phone, email, password, code, choose, state string) error {
+	user, err := r.VerifyAccount(ctx, uuid, phone, email, password, code, choose, state)
+	if err != nil {
+		return err
+	}
+
+	bound := false
+	for _, item := range user.Identities {
+		if item == provider {
+			bound = true
+			break
+		}
+	}
+	if !bound {
+		return v1.ErrorUnbindAccountFailed("unbind user identity failed: provider(%s) not bound", provider)
+	}
+	if user.Phone == "" && user.Email == "" && len(user.Identities) == 1 {
+		return v1.ErrorUniqueAccount("unbind user identity failed: unique account")
+	}
+
+	err = r.repo.DeleteUserIdentity(ctx, uuid, provider)
+	if err != nil {
+		return v1.ErrorUnbindAccountFailed("unbind user identity failed: %s", err.Error())
+	}
+	r.revokeSessions(ctx, uuid)
+	return nil
+}
+
+// identity completes an authorization at provider. Its state is taken on the
+// first try, so neither a failed exchange nor a replayed redirect can use it
+// again.
+func (r *AuthUseCase) identity(ctx context.Context, provider, code, state string) (*Identity, error) {
+	p, ok := r.providers[provider]
+	if !ok {
+		return nil, v1.ErrorProviderNotFound("oauth failed: provider(%s)", provider)
+	}
+
+	pending, err := r.repo.TakeOauthState(ctx, hashSecret(state))
+	if kerrors.IsNotFound(err) || (err == nil && pending.Provider != provider) {
+		return nil, v1.ErrorOauthStateInvalid("oauth failed: state expired or unknown")
+	}
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("oauth failed: %s", err.Error())
+	}
+
+	identity, err := p.Exchange(ctx, code, pending.Nonce, pending.RedirectUri)
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("oauth failed: %s", err.Error())
+	}
+	identity.Provider = provider
+	return identity, nil
+}
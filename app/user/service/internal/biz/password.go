@@ -0,0 +1,92 @@
+package biz
+
+import (
+	_ "embed"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	defaultPasswordMinLength  = 8
+	defaultPasswordMaxLength  = 128
+	defaultPasswordMinClasses = 2
+)
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords holds the lowercased entries of common_passwords.txt.
+var commonPasswords = func() map[string]struct{} {
+	m := map[string]struct{}{}
+	for _, line := range strings.Split(commonPasswordList, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m[strings.ToLower(line)] = struct{}{}
+	}
+	return m
+}()
+
+// PasswordPolicy is what a new password must meet: a length in runes between
+// MinLength and MaxLength, characters of at least MinClasses of lower case,
+// upper case, digits and symbols, and not being a common password unless
+// AllowCommon.
+type PasswordPolicy struct {
+	MinLength   int
+	MaxLength   int
+	MinClasses  int
+	AllowCommon bool
+}
+
+func newPasswordPolicy(c *conf.Auth_PasswordPolicy) *PasswordPolicy {
+	policy := &PasswordPolicy{
+		MinLength:   int(c.GetMinLength()),
+		MaxLength:   int(c.GetMaxLength()),
+		MinClasses:  int(c.GetMinClasses()),
+		AllowCommon: c.GetAllowCommon(),
+	}
+	if policy.MinLength <= 0 {
+		policy.MinLength = defaultPasswordMinLength
+	}
+	if policy.MaxLength <= 0 {
+		policy.MaxLength = defaultPasswordMaxLength
+	}
+	if policy.MinClasses <= 0 {
+		policy.MinClasses = defaultPasswordMinClasses
+	}
+	return policy
+}
+
+// Check returns ErrorPasswordTooWeak, naming the first rule password breaks.
+func (p *PasswordPolicy) Check(password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength || length > p.MaxLength {
+		return v1.ErrorPasswordTooWeak("password must be %d to %d characters long", p.MinLength, p.MaxLength)
+	}
+
+	var lower, upper, digit, symbol int
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower = 1
+		case unicode.IsUpper(c):
+			upper = 1
+		case unicode.IsDigit(c):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	if lower+upper+digit+symbol < p.MinClasses {
+		return v1.ErrorPasswordTooWeak("password must mix at least %d of lower case, upper case, digits and symbols", p.MinClasses)
+	}
+
+	if _, ok := commonPasswords[strings.ToLower(password)]; ok && !p.AllowCommon {
+		return v1.ErrorPasswordTooWeak("password is too common")
+	}
+	return nil
+}
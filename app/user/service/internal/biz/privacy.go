@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
+)
+
+// SetProfilePrivate turns the privacy of uuid on or off. Once uuid is public
+// again, the users still waiting for it to approve them follow it right away.
+func (r *UserUseCase) SetProfilePrivate(ctx context.Context, uuid string, private bool) error {
+	err := r.repo.SetProfilePrivate(ctx, uuid, private)
+	if err != nil {
+		return v1.ErrorSetProfilePrivateFailed("set profile private failed: %s", err.Error())
+	}
+
+	if private {
+		return nil
+	}
+
+	requests, err := r.repo.GetFollowRequestList(ctx, uuid)
+	if err != nil {
+		return v1.ErrorSetProfilePrivateFailed("get follow request list failed: %s", err.Error())
+	}
+
+	for _, item := range requests {
+		err = r.approveFollowRequest(ctx, uuid, item)
+		if err != nil && !v1.IsFollowRequestNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetProfileVisible reports whether userUuid, empty for a visitor who is not
+// logged in, may see what uuid published: uuid is public, or userUuid is uuid
+// or follows it.
+func (r *UserUseCase) GetProfileVisible(ctx context.Context, uuid, userUuid string) (bool, error) {
+	if uuid == userUuid {
+		return true, nil
+	}
+
+	private, err := r.repo.GetProfilePrivate(ctx, uuid)
+	if err != nil {
+		return false, v1.ErrorGetProfileVisibleFailed("get profile private failed: %s", err.Error())
+	}
+
+	if !private {
+		return true, nil
+	}
+
+	if userUuid == "" {
+		return false, nil
+	}
+
+	follow, err := r.repo.GetUserFollow(ctx, uuid, userUuid)
+	if err != nil {
+		return false, v1.ErrorGetProfileVisibleFailed("get user follow failed: %s", err.Error())
+	}
+	return follow, nil
+}
+
+func (r *UserUseCase) GetFollowRequest(ctx context.Context, uuid, userUuid string) (bool, error) {
+	requested, err := r.repo.GetFollowRequest(ctx, uuid, userUuid)
+	if err != nil {
+		return false, v1.ErrorGetUserFollowFailed("get follow request failed: %s", err.Error())
+	}
+	return requested, nil
+}
+
+func (r *UserUseCase) GetFollowRequests(ctx context.Context, page int32, uuid string) ([]*Follow, error) {
+	requests, err := r.repo.GetFollowRequests(ctx, page, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetFollowRequestsFailed("get follow requests failed: %s", err.Error())
+	}
+	return requests, nil
+}
+
+// requestFollow asks private uuid to let userId follow it. uuid is notified
+// once per request, however often userId asks.
+func (r *UserUseCase) requestFollow(ctx context.Context, uuid, userId string) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		requested, err := r.repo.GetFollowRequest(ctx, uuid, userId)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("get follow request failed: %s", err.Error())
+		}
+
+		if requested {
+			return nil
+		}
+
+		err = r.repo.SetFollowRequest(ctx, uuid, userId)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("set follow request failed: %s", err.Error())
+		}
+
+		err = r.publishFollow(ctx, &Follow{
+			Follow:   uuid,
+			Followed: userId,
+		}, event.ModeFollowRequest)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("publish follow request failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+func (r *UserUseCase) ApproveFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	return r.approveFollowRequest(ctx, uuid, userUuid)
+}
+
+// approveFollowRequest turns the request of userUuid into a follow of uuid,
+// counters included, and tells userUuid it was approved.
+func (r *UserUseCase) approveFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		requested, err := r.repo.GetFollowRequest(ctx, uuid, userUuid)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("get follow request failed: %s", err.Error())
+		}
+
+		if !requested {
+			return v1.ErrorFollowRequestNotFound("follow request not found: uuid(%s), userUuid(%s)", uuid, userUuid)
+		}
+
+		err = r.repo.SetUserFollow(ctx, uuid, userUuid)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("set follow failed: %s", err.Error())
+		}
+
+		err = r.repo.SetUserFollowToCache(ctx, uuid, userUuid)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("set follow to cache failed: %s", err.Error())
+		}
+
+		err = r.publishUserStatistic(ctx, uuid, userUuid, "follow")
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("publish follow statistic failed: %s", err.Error())
+		}
+
+		err = r.publishFollow(ctx, &Follow{
+			Follow:   uuid,
+			Followed: userUuid,
+		}, event.ModeFollowApprove)
+		if err != nil {
+			return v1.ErrorFollowRequestFailed("publish follow approve failed: %s", err.Error())
+		}
+		return nil
+	})
+}
+
+func (r *UserUseCase) RejectFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	requested, err := r.repo.GetFollowRequest(ctx, uuid, userUuid)
+	if err != nil {
+		return v1.ErrorFollowRequestFailed("get follow request failed: %s", err.Error())
+	}
+
+	if !requested {
+		return v1.ErrorFollowRequestNotFound("follow request not found: uuid(%s), userUuid(%s)", uuid, userUuid)
+	}
+
+	err = r.repo.CancelFollowRequest(ctx, uuid, userUuid)
+	if err != nil {
+		return v1.ErrorFollowRequestFailed("cancel follow request failed: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"sort"
+	"time"
+)
+
+const (
+	defaultRecommendSize = 50
+	defaultRecommendTTL  = time.Hour * 48
+	recommendColdSize    = 100
+	recommendUserBatch   = 100
+	recommendTagSize     = 10
+	recommendActiveDays  = 30
+	recommendScoreCap    = 10
+)
+
+type RecommendRepo interface {
+	GetUserUuids(ctx context.Context, lastId uint, size int) ([]string, uint, error)
+	GetFollowees(ctx context.Context, uuid string) ([]string, error)
+	GetFollowsOfFollows(ctx context.Context, followees []string, size int) (map[string]int32, error)
+	GetUserTags(ctx context.Context, uuid string) (map[string]int32, error)
+	GetTagAuthors(ctx context.Context, tags []string) (map[string]int32, error)
+	GetAuthorActivity(ctx context.Context, uuids []string, days int32) (map[string]int32, error)
+	GetScoreRank(ctx context.Context, size int32) ([]*Recommend, error)
+	GetRecommendExcluded(ctx context.Context, uuid string) ([]string, error)
+	FollowRecommendationsExist(ctx context.Context, uuid string) (bool, error)
+	GetFollowRecommendations(ctx context.Context, page int32, uuid string) ([]string, error)
+	GetColdRecommendations(ctx context.Context, page int32) ([]string, error)
+	SetFollowRecommendations(ctx context.Context, uuid string, list []*Recommend, ttl time.Duration) error
+	SetColdRecommendations(ctx context.Context, list []*Recommend) error
+	DeleteFollowRecommendations(ctx context.Context, uuid string) error
+	LockRecommend(ctx context.Context, ttl time.Duration) (bool, error)
+}
+
+type RecommendUseCase struct {
+	repo RecommendRepo
+	size int
+	ttl  time.Duration
+	log  *log.Helper
+}
+
+func NewRecommendUseCase(conf *conf.Server, repo RecommendRepo, logger log.Logger) *RecommendUseCase {
+	size := int(conf.Recommend.GetSize())
+	if size <= 0 {
+		size = defaultRecommendSize
+	}
+	ttl := conf.Recommend.GetTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = defaultRecommendTTL
+	}
+	return &RecommendUseCase{
+		repo: repo,
+		size: size,
+		ttl:  ttl,
+		log:  log.NewHelper(log.With(logger, "module", "user/biz/recommendUseCase")),
+	}
+}
+
+// GetFollowRecommendations pages through the users recommended to uuid, or
+// through the top scored authors while none were computed for it yet. Users
+// followed or blocked since the last refresh are left out.
+func (r *RecommendUseCase) GetFollowRecommendations(ctx context.Context, page int32, uuid string) ([]string, error) {
+	exist, err := r.repo.FollowRecommendationsExist(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetFollowRecommendationsFailed("get follow recommendations exist failed: %s", err.Error())
+	}
+
+	var list []string
+	if exist {
+		list, err = r.repo.GetFollowRecommendations(ctx, page, uuid)
+	} else {
+		list, err = r.repo.GetColdRecommendations(ctx, page)
+	}
+	if err != nil {
+		return nil, v1.ErrorGetFollowRecommendationsFailed("get follow recommendations failed: %s", err.Error())
+	}
+
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	followees, err := r.repo.GetFollowees(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetFollowRecommendationsFailed("get followees failed: %s", err.Error())
+	}
+
+	excluded, err := r.excluded(ctx, uuid, followees)
+	if err != nil {
+		return nil, v1.ErrorGetFollowRecommendationsFailed("get recommend excluded failed: %s", err.Error())
+	}
+
+	recommendations := make([]string, 0, len(list))
+	for _, item := range list {
+		if !excluded[item] {
+			recommendations = append(recommendations, item)
+		}
+	}
+	return recommendations, nil
+}
+
+// excluded collects the users never recommended to uuid: itself, the users it
+// follows, those it blocked or muted and those who blocked it.
+func (r *RecommendUseCase) excluded(ctx context.Context, uuid string, followees []string) (map[string]bool, error) {
+	blocks, err := r.repo.GetRecommendExcluded(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]bool, len(followees)+len(blocks)+1)
+	excluded[uuid] = true
+	for _, item := range followees {
+		excluded[item] = true
+	}
+	for _, item := range blocks {
+		excluded[item] = true
+	}
+	return excluded, nil
+}
+
+// RefreshFollowRecommendations recomputes the recommendations of every user,
+// and the top scored authors recommended to users without any. Only one
+// instance refreshes per interval.
+func (r *RecommendUseCase) RefreshFollowRecommendations(ctx context.Context, interval time.Duration) error {
+	ok, err := r.repo.LockRecommend(ctx, interval)
+	if err != nil {
+		return v1.ErrorGetFollowRecommendationsFailed("lock recommend failed: %s", err.Error())
+	}
+
+	if !ok {
+		return nil
+	}
+
+	rank, err := r.repo.GetScoreRank(ctx, recommendColdSize)
+	if err != nil {
+		return v1.ErrorGetFollowRecommendationsFailed("get score rank failed: %s", err.Error())
+	}
+
+	err = r.repo.SetColdRecommendations(ctx, rank)
+	if err != nil {
+		return v1.ErrorGetFollowRecommendationsFailed("set cold recommendations failed: %s", err.Error())
+	}
+
+	var lastId uint
+	for {
+		uuids, last, err := r.repo.GetUserUuids(ctx, lastId, recommendUserBatch)
+		if err != nil {
+			return v1.ErrorGetFollowRecommendationsFailed("get user uuids failed: %s", err.Error())
+		}
+
+		for _, uuid := range uuids {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			err = r.refreshUserRecommendations(ctx, uuid)
+			if err != nil {
+				r.log.Errorf("fail to refresh follow recommendations: uuid(%s), err(%v)", uuid, err)
+			}
+		}
+
+		if len(uuids) < recommendUserBatch {
+			return nil
+		}
+		lastId = last
+	}
+}
+
+// refreshUserRecommendations scores the candidates of uuid by the users it
+// follows who follow them, three points each, by their articles on the tags
+// uuid is interested in, two points each, and by what they published lately,
+// a point each. The last two are capped so that a prolific author does not
+// outweigh the follow graph.
+func (r *RecommendUseCase) refreshUserRecommendations(ctx context.Context, uuid string) error {
+	followees, err := r.repo.GetFollowees(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	excluded, err := r.excluded(ctx, uuid, followees)
+	if err != nil {
+		return err
+	}
+
+	scores := make(map[string]int32)
+	if len(followees) != 0 {
+		overlap, err := r.repo.GetFollowsOfFollows(ctx, followees, r.size*4)
+		if err != nil {
+			return err
+		}
+		for item, count := range overlap {
+			scores[item] += 3 * count
+		}
+	}
+
+	tags, err := r.repo.GetUserTags(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) != 0 {
+		authors, err := r.repo.GetTagAuthors(ctx, topTags(tags, recommendTagSize))
+		if err != nil {
+			return err
+		}
+		for item, count := range authors {
+			scores[item] += 2 * capScore(count)
+		}
+	}
+
+	for item := range excluded {
+		delete(scores, item)
+	}
+
+	if len(scores) == 0 {
+		return r.repo.DeleteFollowRecommendations(ctx, uuid)
+	}
+
+	candidates := make([]string, 0, len(scores))
+	for item := range scores {
+		candidates = append(candidates, item)
+	}
+
+	activity, err := r.repo.GetAuthorActivity(ctx, candidates, recommendActiveDays)
+	if err != nil {
+		return err
+	}
+
+	list := make([]*Recommend, 0, len(scores))
+	for item, score := range scores {
+		list = append(list, &Recommend{
+			Uuid:  item,
+			Score: score + capScore(activity[item]),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score > list[j].Score
+		}
+		return list[i].Uuid < list[j].Uuid
+	})
+	if len(list) > r.size {
+		list = list[:r.size]
+	}
+	return r.repo.SetFollowRecommendations(ctx, uuid, list, r.ttl)
+}
+
+func topTags(tags map[string]int32, size int) []string {
+	list := make([]string, 0, len(tags))
+	for item := range tags {
+		list = append(list, item)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if tags[list[i]] != tags[list[j]] {
+			return tags[list[i]] > tags[list[j]]
+		}
+		return list[i] < list[j]
+	})
+	if len(list) > size {
+		list = list[:size]
+	}
+	return list
+}
+
+func capScore(score int32) int32 {
+	if score > recommendScoreCap {
+		return recommendScoreCap
+	}
+	return score
+}
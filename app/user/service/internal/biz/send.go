@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"time"
+)
+
+const (
+	defaultSendCooldown       = 60 * time.Second
+	defaultSendRecipientDaily = 10
+	defaultSendIpDaily        = 30
+	defaultSendSmsDaily       = 5000
+)
+
+// SendLimit bounds how often codes are sent: a recipient waits Cooldown
+// between two codes and gets at most RecipientDaily a day, a client ip asks
+// for at most IpDaily a day, and no more than SmsDaily sms leave in total a
+// day, which caps what an attack can cost.
+type SendLimit struct {
+	Cooldown       time.Duration
+	RecipientDaily int64
+	IpDaily        int64
+	SmsDaily       int64
+}
+
+type SendVerdict int32
+
+const (
+	SendAllowed SendVerdict = iota
+	SendCooldown
+	SendRecipientCapped
+	SendIpCapped
+	SendSuspended
+)
+
+// Captcha checks the token a client sends along with a request for a code,
+// before anything is sent.
+type Captcha interface {
+	Verify(ctx context.Context, token, recipient, ip string) error
+}
+
+func newSendLimit(c *conf.Auth_SendLimit) *SendLimit {
+	limit := &SendLimit{
+		Cooldown:       c.GetCooldown().AsDuration(),
+		RecipientDaily: c.GetRecipientDaily(),
+		IpDaily:        c.GetIpDaily(),
+		SmsDaily:       c.GetSmsDaily(),
+	}
+	if limit.Cooldown <= 0 {
+		limit.Cooldown = defaultSendCooldown
+	}
+	if limit.RecipientDaily <= 0 {
+		limit.RecipientDaily = defaultSendRecipientDaily
+	}
+	if limit.IpDaily <= 0 {
+		limit.IpDaily = defaultSendIpDaily
+	}
+	if limit.SmsDaily <= 0 {
+		limit.SmsDaily = defaultSendSmsDaily
+	}
+	return limit
+}
+
+// reserveSend checks the captcha and the send limits of a code to recipient
+// over channel, "phone" or "email", and counts the send when it is allowed.
+func (r *AuthUseCase) reserveSend(ctx context.Context, channel, recipient, captcha string) error {
+	ip := realIp(ctx)
+	err := r.captcha.Verify(ctx, captcha, recipient, ip)
+	if err != nil {
+		return v1.ErrorCaptchaInvalid("send code failed: %s", err.Error())
+	}
+
+	verdict, wait, err := r.repo.ReserveCodeSend(ctx, channel, recipient, ip, r.sendLimit)
+	if err != nil {
+		return v1.ErrorSendCodeFailed("send code failed: %s", err.Error())
+	}
+	retryAfter := map[string]string{"retryAfter": wait.String()}
+	switch verdict {
+	case SendCooldown:
+		return v1.ErrorSendCodeTooFrequent("send code failed: retry in %s", wait).WithMetadata(retryAfter)
+	case SendRecipientCapped:
+		return v1.ErrorSendCodeLimitExceeded("send code failed: daily limit of recipient reached").WithMetadata(retryAfter)
+	case SendIpCapped:
+		return v1.ErrorSendCodeLimitExceeded("send code failed: daily limit of ip reached: ip(%s)", ip).WithMetadata(retryAfter)
+	case SendSuspended:
+		r.log.Errorf("daily sms budget exhausted, sms suspended: limit(%d), resume in %s", r.sendLimit.SmsDaily, wait)
+		return v1.ErrorSendCodeSuspended("send code failed: sms suspended").WithMetadata(retryAfter)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/jwtclaim"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAccessTtl  = 15 * time.Minute
+	defaultRefreshTtl = 30 * 24 * time.Hour
+	maxDeviceLen      = 200
+)
+
+// RefreshToken trades a refresh token for a new access token and a new refresh
+// token of the same session. A refresh token is good for one use: presenting
+// one that was already traded revokes its session, as it has been stolen or
+// the client has lost track of it.
+func (r *AuthUseCase) RefreshToken(ctx context.Context, refreshToken, ip string) (*Token, error) {
+	parts := strings.SplitN(refreshToken, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, v1.ErrorRefreshTokenInvalid("refresh token failed: malformed token")
+	}
+	id, secret := parts[0], parts[1]
+
+	newSecret, err := randomSecret()
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("refresh token failed: %s", err.Error())
+	}
+
+	uuid, err := r.repo.RotateSession(ctx, id, hashSecret(secret), hashSecret(newSecret), ip, time.Now().Unix(), r.refreshTtl)
+	if err != nil {
+		return nil, v1.ErrorRefreshTokenInvalid("refresh token failed: %s", err.Error())
+	}
+
+	err = r.checkSuspension(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := r.signToken(ctx, uuid, id)
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("refresh token failed: %s", err.Error())
+	}
+	return &Token{
+		Token:        token,
+		RefreshToken: id + "." + newSecret,
+	}, nil
+}
+
+func (r *AuthUseCase) Logout(ctx context.Context, uuid, id string) error {
+	err := r.repo.DeleteSession(ctx, uuid, id)
+	if err != nil {
+		return v1.ErrorLogoutFailed("logout failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *AuthUseCase) LogoutAllDevices(ctx context.Context, uuid string) error {
+	err := r.repo.DeleteUserSessions(ctx, uuid)
+	if err != nil {
+		return v1.ErrorLogoutFailed("logout all devices failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *AuthUseCase) ListSessions(ctx context.Context, uuid string) ([]*Session, error) {
+	sessions, err := r.repo.GetUserSessions(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetSessionsFailed("get sessions failed: %s", err.Error())
+	}
+	return sessions, nil
+}
+
+// newSession signs uuid in on device and returns the tokens of the session.
+func (r *AuthUseCase) newSession(ctx context.Context, uuid, device, ip string) (*Token, error) {
+	secret, err := randomSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if runes := []rune(device); len(runes) > maxDeviceLen {
+		device = string(runes[:maxDeviceLen])
+	}
+	now := time.Now().Unix()
+	session, err := r.repo.CreateSession(ctx, &Session{
+		Uuid:    uuid,
+		Device:  device,
+		Ip:      ip,
+		Created: now,
+		Seen:    now,
+	}, hashSecret(secret), r.refreshTtl)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := r.signToken(ctx, uuid, session.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		Token:        token,
+		RefreshToken: session.Id + "." + secret,
+	}, nil
+}
+
+// revokeSessions signs uuid out everywhere after its credentials changed. The
+// change already happened, so a failure is only logged.
+func (r *AuthUseCase) revokeSessions(ctx context.Context, uuid string) {
+	err := r.repo.DeleteUserSessions(ctx, uuid)
+	if err != nil {
+		r.log.Errorf("fail to revoke user sessions: uuid(%s), err(%v)", uuid, err)
+	}
+}
+
+// signToken issues an access token of the session sid, carrying the role of
+// uuid. Access tokens are not checked against the session store, so a revoked
+// session keeps working until its last access token expires.
+func (r *AuthUseCase) signToken(ctx context.Context, uuid, sid string) (string, error) {
+	role, err := r.adminRepo.GetUserRole(ctx, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	nowTime := time.Now()
+	signedString, err := r.signer.Sign(&jwtclaim.JwtCustomClaims{
+		Uuid: uuid,
+		Sid:  sid,
+		Role: userRole(role),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        xid.New().String(),
+			Issuer:    jwtclaim.Issuer,
+			IssuedAt:  jwt.NewNumericDate(nowTime),
+			ExpiresAt: jwt.NewNumericDate(nowTime.Add(r.accessTtl)),
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to sign token: uuid(%v)", uuid))
+	}
+	return signedString, nil
+}
+
+// Jwks is the public key set other services verify access tokens with.
+func (r *AuthUseCase) Jwks() (*jwtclaim.Jwks, error) {
+	return r.signer.Jwks()
+}
+
+// NewSigner loads the signing keys of the auth config. Without keys it fails
+// unless dev_signing_key is set, in which case a throwaway key is generated;
+// that only suits a single development instance, as tokens do not survive a
+// restart and other replicas cannot verify them.
+func NewSigner(conf *conf.Auth, logger log.Logger) (*jwtclaim.Signer, error) {
+	l := log.NewHelper(log.With(logger, "module", "user/biz/signer"))
+	if len(conf.Keys) == 0 {
+		if !conf.DevSigningKey {
+			return nil, errors.New("fail to load signing keys: no auth.keys configured and auth.dev_signing_key not set")
+		}
+		key, err := jwtclaim.GenerateSigningKey()
+		if err != nil {
+			return nil, err
+		}
+		l.Warnf("no signing key configured, using a generated one: kid(%s)", key.Kid)
+		return jwtclaim.NewSigner([]*jwtclaim.SigningKey{key}, key.Kid)
+	}
+
+	keys := make([]*jwtclaim.SigningKey, 0, len(conf.Keys))
+	for _, item := range conf.Keys {
+		data, err := ioutil.ReadFile(item.File)
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to read signing key: kid(%s), file(%s)", item.Kid, item.File))
+		}
+		key, err := jwtclaim.ParseSigningKey(item.Kid, data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return jwtclaim.NewSigner(keys, conf.ActiveKid)
+}
+
+func randomSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", errors.Wrapf(err, "fail to generate refresh token")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecret is what the session store keeps of a refresh token, so a leak of
+// the store does not leak usable tokens.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
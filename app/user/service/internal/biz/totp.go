@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	"crypto/subtle"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/pkg/errors"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/pkg/util"
+	"strings"
+	"time"
+)
+
+const (
+	totpIssuer        = "matrix"
+	totpRecoveryCodes = 10
+	totpChallengeTtl  = 5 * time.Minute
+
+	// totpSkew is how many steps a code may be off, for clocks that drift.
+	totpSkew = 1
+)
+
+// TotpEnrollment is a secret waiting for confirmation and the uri its qr code
+// encodes.
+type TotpEnrollment struct {
+	Secret string
+	Uri    string
+}
+
+// EnrollTotp generates a totp secret for uuid. It is not asked for before the
+// first code of it was confirmed, and enrolling again replaces a secret that
+// was not.
+func (r *AuthUseCase) EnrollTotp(ctx context.Context, uuid string) (*TotpEnrollment, error) {
+	totp, err := r.repo.GetTotp(ctx, uuid)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, v1.ErrorTotpFailed("enroll totp failed: %s", err.Error())
+	}
+	if err == nil && totp.Enabled {
+		return nil, v1.ErrorTotpAlreadyEnabled("enroll totp failed: totp already enabled")
+	}
+
+	account, err := r.userRepo.GetAccount(ctx, uuid)
+	if err != nil {
+		return nil, v1.ErrorGetAccountFailed("get user account failed: %s", err.Error())
+	}
+	label := uuid
+	if account.Email != "" {
+		label = account.Email
+	} else if account.Phone != "" {
+		label = account.Phone
+	}
+
+	secret, err := util.TotpSecret()
+	if err != nil {
+		return nil, v1.ErrorTotpFailed("enroll totp failed: %s", err.Error())
+	}
+	err = r.repo.SetTotpSecret(ctx, uuid, secret)
+	if err != nil {
+		return nil, v1.ErrorTotpFailed("enroll totp failed: %s", err.Error())
+	}
+	return &TotpEnrollment{
+		Secret: secret,
+		Uri:    util.TotpUri(totpIssuer, label, secret),
+	}, nil
+}
+
+// ConfirmTotp enables the enrolled secret of uuid with a first code of it and
+// returns the recovery codes, which are shown this once.
+func (r *AuthUseCase) ConfirmTotp(ctx context.Context, uuid, code string) ([]string, error) {
+	totp, err := r.repo.GetTotp(ctx, uuid)
+	if kerrors.IsNotFound(err) {
+		return nil, v1.ErrorTotpNotEnabled("confirm totp failed: totp not enrolled")
+	}
+	if err != nil {
+		return nil, v1.ErrorTotpFailed("confirm totp failed: %s", err.Error())
+	}
+	if totp.Enabled {
+		return nil, v1.ErrorTotpAlreadyEnabled("confirm totp failed: totp already enabled")
+	}
+
+	err = r.attempt(ctx, "totp_"+uuid, realIp(ctx), func() error {
+		return r.checkTotpCode(ctx, totp, code)
+	})
+	if v1.IsAccountLocked(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, v1.ErrorTotpInvalid("confirm totp failed: %s", err.Error())
+	}
+
+	codes, err := util.RecoveryCodes(totpRecoveryCodes)
+	if err != nil {
+		return nil, v1.ErrorTotpFailed("confirm totp failed: %s", err.Error())
+	}
+	hashes := make([]string, 0, len(codes))
+	for _, item := range codes {
+		hashes = append(hashes, hashSecret(item))
+	}
+	err = r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		err = r.repo.EnableTotp(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		err = r.repo.SetTotpRecoveryCodes(ctx, uuid, hashes)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, v1.ErrorTotpFailed("confirm totp failed: %s", err.Error())
+	}
+	return codes, nil
+}
+
+func (r *AuthUseCase) DisableTotp(ctx context.Context, uuid, code string) error {
+	err := r.verifyTotp(ctx, uuid, code, realIp(ctx))
+	if err != nil {
+		return err
+	}
+
+	err = r.tm.ExecTx(ctx, func(ctx context.Context) error {
+		return r.repo.DeleteTotp(ctx, uuid)
+	})
+	if err != nil {
+		return v1.ErrorTotpFailed("disable totp failed: %s", err.Error())
+	}
+	r.revokeSessions(ctx, uuid)
+	return nil
+}
+
+// LoginByTotp completes a login that was answered with a challenge.
+func (r *AuthUseCase) LoginByTotp(ctx context.Context, challenge, code, ip string) (*Token, error) {
+	hash := hashSecret(challenge)
+	pending, err := r.repo.GetTotpChallenge(ctx, hash)
+	if kerrors.IsNotFound(err) {
+		return nil, v1.ErrorTotpChallengeInvalid("login failed: challenge expired or unknown")
+	}
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("login failed: %s", err.Error())
+	}
+
+	err = r.verifyTotp(ctx, pending.Uuid, code, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.repo.DeleteTotpChallenge(ctx, hash)
+	if err != nil {
+		r.log.Errorf("fail to delete totp challenge: uuid(%s), err(%v)", pending.Uuid, err)
+	}
+
+	err = r.checkSuspension(ctx, pending.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := r.newSession(ctx, pending.Uuid, pending.Device, ip)
+	if err != nil {
+		return nil, v1.ErrorLoginFailed("login failed: %s", err.Error())
+	}
+	return token, nil
+}
+
+// login signs uuid in once its first factor was checked, unless it is
+// suspended. An account with totp enabled gets a challenge instead, to be
+// completed with LoginByTotp.
+func (r *AuthUseCase) login(ctx context.Context, uuid, device, ip string) (*Token, error) {
+	err := r.checkSuspension(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	totp, err := r.repo.GetTotp(ctx, uuid)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || !totp.Enabled {
+		return r.newSession(ctx, uuid, device, ip)
+	}
+
+	challenge, err := randomSecret()
+	if err != nil {
+		return nil, err
+	}
+	if runes := []rune(device); len(runes) > maxDeviceLen {
+		device = string(runes[:maxDeviceLen])
+	}
+	err = r.repo.CreateTotpChallenge(ctx, hashSecret(challenge), &TotpChallenge{
+		Uuid:   uuid,
+		Device: device,
+		Ip:     ip,
+	}, totpChallengeTtl)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		Challenge: challenge,
+	}, nil
+}
+
+// verifyTotp checks a code of the authenticator of uuid, or one of its
+// recovery codes, which are told apart by their dash.
+func (r *AuthUseCase) verifyTotp(ctx context.Context, uuid, code, ip string) error {
+	totp, err := r.repo.GetTotp(ctx, uuid)
+	if kerrors.IsNotFound(err) || (err == nil && !totp.Enabled) {
+		return v1.ErrorTotpNotEnabled("verify totp failed: totp not enabled")
+	}
+	if err != nil {
+		return v1.ErrorTotpFailed("verify totp failed: %s", err.Error())
+	}
+
+	err = r.attempt(ctx, "totp_"+uuid, ip, func() error {
+		if strings.Contains(code, "-") {
+			return r.repo.UseTotpRecoveryCode(ctx, uuid, hashSecret(strings.ToLower(strings.TrimSpace(code))))
+		}
+		return r.checkTotpCode(ctx, totp, code)
+	})
+	if err != nil && !v1.IsAccountLocked(err) {
+		return v1.ErrorTotpInvalid("verify totp failed: %s", err.Error())
+	}
+	return err
+}
+
+// checkTotpCode accepts a code of the current step or of totpSkew steps around
+// it, each code only once.
+func (r *AuthUseCase) checkTotpCode(ctx context.Context, totp *Totp, code string) error {
+	now := util.TotpStep(time.Now())
+	for step := now - totpSkew; step <= now+totpSkew; step++ {
+		expect, err := util.TotpCode(totp.Secret, step)
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare([]byte(expect), []byte(code)) != 1 {
+			continue
+		}
+		ok, err := r.repo.UseTotpStep(ctx, totp.Uuid, step, (2*totpSkew+1)*util.TotpPeriod*time.Second)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("code already used")
+		}
+		return nil
+	}
+	return errors.New("code mismatch")
+}
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/pkg/util"
+	"testing"
+	"time"
+)
+
+// fakeTotpRepo holds the totp of one user and the steps used of it, on top of
+// the attempts of fakeAttemptRepo.
+type fakeTotpRepo struct {
+	fakeAttemptRepo
+	totp *Totp
+	used map[int64]bool
+	ttl  time.Duration
+}
+
+func newFakeTotpRepo(t *testing.T) *fakeTotpRepo {
+	secret, err := util.TotpSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeTotpRepo{
+		totp: &Totp{Uuid: "someone", Secret: secret, Enabled: true},
+		used: map[int64]bool{},
+	}
+}
+
+func (r *fakeTotpRepo) GetTotp(context.Context, string) (*Totp, error) {
+	return r.totp, nil
+}
+
+func (r *fakeTotpRepo) UseTotpStep(_ context.Context, _ string, step int64, ttl time.Duration) (bool, error) {
+	r.ttl = ttl
+	if r.used[step] {
+		return false, nil
+	}
+	r.used[step] = true
+	return true, nil
+}
+
+// totpCodeAt returns the code offset steps away from now. It waits out the
+// last second of a step, so that the code is checked in the step it was made
+// for.
+func totpCodeAt(t *testing.T, secret string, offset int64) string {
+	if time.Now().Unix()%util.TotpPeriod == util.TotpPeriod-1 {
+		time.Sleep(time.Second)
+	}
+	code, err := util.TotpCode(secret, util.TotpStep(time.Now())+offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// TestVerifyTotpWindow accepts the code of the current step and of the steps
+// on either side of it, for clocks that drift.
+func TestVerifyTotpWindow(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int64
+		ok     bool
+	}{
+		{"two steps behind", -2, false},
+		{"one step behind", -1, true},
+		{"current step", 0, true},
+		{"one step ahead", 1, true},
+		{"two steps ahead", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeTotpRepo(t)
+			r := newAttemptTest(repo)
+			err := r.verifyTotp(context.Background(), "someone", totpCodeAt(t, repo.totp.Secret, tt.offset), "127.0.0.1")
+			if tt.ok && err != nil {
+				t.Fatalf("got error %v, want none", err)
+			}
+			if !tt.ok && !v1.IsTotpInvalid(err) {
+				t.Fatalf("got error %v, want totp invalid", err)
+			}
+		})
+	}
+}
+
+// TestVerifyTotpReplay rejects a code used before within its window, while a
+// code of another step still passes.
+func TestVerifyTotpReplay(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeTotpRepo(t)
+	r := newAttemptTest(repo)
+
+	code := totpCodeAt(t, repo.totp.Secret, 0)
+	if err := r.verifyTotp(ctx, "someone", code, "127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if repo.ttl != 90*time.Second {
+		t.Fatalf("got step kept for %s, want the 90s of the window", repo.ttl)
+	}
+	if err := r.verifyTotp(ctx, "someone", code, "127.0.0.1"); !v1.IsTotpInvalid(err) {
+		t.Fatalf("got error %v using the code again, want totp invalid", err)
+	}
+	if err := r.verifyTotp(ctx, "someone", totpCodeAt(t, repo.totp.Secret, 1), "127.0.0.1"); err != nil {
+		t.Fatalf("got error %v with the code of the next step, want none", err)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/pkg/event"
 	"golang.org/x/sync/errgroup"
+	"time"
 )
 
 type UserRepo interface {
@@ -31,13 +33,29 @@ type UserRepo interface {
 	SetProfileUpdate(ctx context.Context, profile *ProfileUpdate, status int32) (*ProfileUpdate, error)
 	SetUserFollow(ctx context.Context, uuid, userId string) error
 	SetUserFollowToCache(ctx context.Context, uuid, userId string) error
-	SetFollowToMq(ctx context.Context, follow *Follow, mode string) error
 	CancelUserFollow(ctx context.Context, uuid, userId string) error
 	CancelUserFollowFromCache(ctx context.Context, uuid, userId string) error
-	SendImageIrregularToMq(ctx context.Context, review *ImageReview) error
-	SendProfileToMq(ctx context.Context, profile *ProfileUpdate) error
-	SendUserStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error
+	GetUserBlock(ctx context.Context, uuid, userUuid string) ([]*Block, error)
+	GetUserBlocks(ctx context.Context, uuid string) ([]*Block, error)
+	SetUserBlock(ctx context.Context, uuid, userUuid string) error
+	SetUserMute(ctx context.Context, uuid, userUuid string) error
+	CancelUserBlock(ctx context.Context, uuid, userUuid string, status int32) error
+	SetProfilePrivate(ctx context.Context, uuid string, private bool) error
+	GetProfilePrivate(ctx context.Context, uuid string) (bool, error)
+	GetFollowRequest(ctx context.Context, uuid, userUuid string) (bool, error)
+	GetFollowRequests(ctx context.Context, page int32, uuid string) ([]*Follow, error)
+	GetFollowRequestList(ctx context.Context, uuid string) ([]string, error)
+	SetFollowRequest(ctx context.Context, uuid, userUuid string) error
+	CancelFollowRequest(ctx context.Context, uuid, userUuid string) error
 	ModifyProfileUpdateStatus(ctx context.Context, uuid, update string) error
+	SetUserDataExport(ctx context.Context, uuid string, ttl time.Duration) (string, error)
+	GetUserDataExport(ctx context.Context, uuid string) (string, error)
+	GetUserDataExportUrl(ctx context.Context, uuid, id string, ttl time.Duration) (string, error)
+	DeleteUserDataExport(ctx context.Context, uuid string) error
+	GetUserFollowRelations(ctx context.Context, uuid string) ([]*Follow, error)
+	GetUserData(ctx context.Context, uuid string) ([]*UserDataFile, error)
+	PurgeUserData(ctx context.Context, uuid string) error
+	PurgeUserDataFromCache(ctx context.Context, uuid string) error
 }
 
 type UserUseCase struct {
@@ -45,13 +63,15 @@ type UserUseCase struct {
 	log  *log.Helper
 	re   Recovery
 	tm   Transaction
+	pub  Publisher
 }
 
-func NewUserUseCase(repo UserRepo, re Recovery, tm Transaction, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, re Recovery, tm Transaction, pub Publisher, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo: repo,
 		log:  log.NewHelper(log.With(logger, "module", "user/biz/userUseCase")),
 		tm:   tm,
+		pub:  pub,
 		re:   re,
 	}
 }
@@ -174,7 +194,7 @@ func (r *UserUseCase) SetProfileUpdate(ctx context.Context, profile *ProfileUpda
 			return err
 		}
 		p.Mode = "user_profile_update"
-		err = r.repo.SendProfileToMq(ctx, p)
+		err = r.publishProfile(ctx, p)
 		if err != nil {
 			return err
 		}
@@ -190,7 +210,7 @@ func (r *UserUseCase) SetProfileUpdate(ctx context.Context, profile *ProfileUpda
 }
 
 func (r *UserUseCase) AvatarIrregular(ctx context.Context, review *ImageReview) error {
-	err := r.repo.SendImageIrregularToMq(ctx, review)
+	err := r.publishImageIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetImageIrregularFailed("send picture review to mq failed: %s", err.Error())
 	}
@@ -214,7 +234,7 @@ func (r *UserUseCase) AddAvatarReviewDbAndCache(ctx context.Context, review *Ima
 }
 
 func (r *UserUseCase) CoverIrregular(ctx context.Context, review *ImageReview) error {
-	err := r.repo.SendImageIrregularToMq(ctx, review)
+	err := r.publishImageIrregular(ctx, review)
 	if err != nil {
 		return v1.ErrorSetImageIrregularFailed("send picture review to mq failed: %s", err.Error())
 	}
@@ -239,7 +259,27 @@ func (r *UserUseCase) AddCoverReviewDbAndCache(ctx context.Context, review *Imag
 
 func (r *UserUseCase) SetUserFollow(ctx context.Context, uuid, userId string) error {
 	if uuid == userId {
-		return v1.ErrorSetFollowFailed("uuid and userId are the same: %s")
+		return v1.ErrorSetFollowFailed("uuid and userId are the same: %s", uuid)
+	}
+	blocked, _, err := r.GetUserBlock(ctx, uuid, userId)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return v1.ErrorUserBlocked("user blocked: uuid(%s), userId(%s)", uuid, userId)
+	}
+	private, err := r.repo.GetProfilePrivate(ctx, uuid)
+	if err != nil {
+		return v1.ErrorSetFollowFailed("get profile private failed: %s", err.Error())
+	}
+	if private {
+		follow, err := r.repo.GetUserFollow(ctx, uuid, userId)
+		if err != nil {
+			return v1.ErrorSetFollowFailed("get user follow failed: %s", err.Error())
+		}
+		if !follow {
+			return r.requestFollow(ctx, uuid, userId)
+		}
 	}
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
@@ -250,7 +290,7 @@ func (r *UserUseCase) SetUserFollow(ctx context.Context, uuid, userId string) er
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SetFollowToMq(ctx, &Follow{
+		err := r.publishFollow(ctx, &Follow{
 			Follow:   uuid,
 			Followed: userId,
 		}, "set_follow_db_and_cache")
@@ -260,7 +300,7 @@ func (r *UserUseCase) SetUserFollow(ctx context.Context, uuid, userId string) er
 		return nil
 	}))
 
-	err := g.Wait()
+	err = g.Wait()
 	if err != nil {
 		return nil
 	}
@@ -269,7 +309,20 @@ func (r *UserUseCase) SetUserFollow(ctx context.Context, uuid, userId string) er
 
 func (r *UserUseCase) SetFollowDbAndCache(ctx context.Context, uuid, userId string) error {
 	return r.tm.ExecTx(ctx, func(ctx context.Context) error {
-		err := r.repo.SetUserFollow(ctx, uuid, userId)
+		// a block set since the follow was asked for wins over it
+		blocked, _, err := r.GetUserBlock(ctx, uuid, userId)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			err = r.repo.CancelUserFollowFromCache(ctx, uuid, userId)
+			if err != nil {
+				return v1.ErrorSetFollowFailed("cancel follow from cache failed: %s", err.Error())
+			}
+			return nil
+		}
+
+		err = r.repo.SetUserFollow(ctx, uuid, userId)
 		if err != nil {
 			return v1.ErrorSetFollowFailed("set follow failed: %s", err.Error())
 		}
@@ -279,7 +332,7 @@ func (r *UserUseCase) SetFollowDbAndCache(ctx context.Context, uuid, userId stri
 			return v1.ErrorSetFollowFailed("set follow to cache failed: %s", err.Error())
 		}
 
-		err = r.repo.SendUserStatisticToMq(ctx, uuid, userId, "follow")
+		err = r.publishUserStatistic(ctx, uuid, userId, "follow")
 		if err != nil {
 			return v1.ErrorSetFollowFailed("set follow failed: %s", err.Error())
 		}
@@ -289,6 +342,17 @@ func (r *UserUseCase) SetFollowDbAndCache(ctx context.Context, uuid, userId stri
 }
 
 func (r *UserUseCase) CancelUserFollow(ctx context.Context, uuid, userId string) error {
+	requested, err := r.repo.GetFollowRequest(ctx, uuid, userId)
+	if err != nil {
+		return v1.ErrorCancelFollowFailed("get follow request failed: %s", err.Error())
+	}
+	if requested {
+		err = r.repo.CancelFollowRequest(ctx, uuid, userId)
+		if err != nil {
+			return v1.ErrorCancelFollowFailed("cancel follow request failed: %s", err.Error())
+		}
+		return nil
+	}
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
 		err := r.repo.CancelUserFollowFromCache(ctx, uuid, userId)
@@ -298,7 +362,7 @@ func (r *UserUseCase) CancelUserFollow(ctx context.Context, uuid, userId string)
 		return nil
 	}))
 	g.Go(r.re.GroupRecover(ctx, func(ctx context.Context) error {
-		err := r.repo.SetFollowToMq(ctx, &Follow{
+		err := r.publishFollow(ctx, &Follow{
 			Follow:   uuid,
 			Followed: userId,
 		}, "cancel_follow_db_and_cache")
@@ -308,7 +372,7 @@ func (r *UserUseCase) CancelUserFollow(ctx context.Context, uuid, userId string)
 		return nil
 	}))
 
-	err := g.Wait()
+	err = g.Wait()
 	if err != nil {
 		return nil
 	}
@@ -327,7 +391,7 @@ func (r *UserUseCase) CancelFollowDbAndCache(ctx context.Context, uuid, userId s
 			return v1.ErrorCancelFollowFailed("cancel follow failed: %s", err.Error())
 		}
 
-		err = r.repo.SendUserStatisticToMq(ctx, uuid, userId, "follow_cancel")
+		err = r.publishUserStatistic(ctx, uuid, userId, "follow_cancel")
 		if err != nil {
 			return v1.ErrorSetFollowFailed("cancel follow failed: %s", err.Error())
 		}
@@ -381,3 +445,52 @@ func (r *UserUseCase) ProfileReviewNotPass(ctx context.Context, uuid string) err
 	}
 	return nil
 }
+
+func (r *UserUseCase) publishProfile(ctx context.Context, profile *ProfileUpdate) error {
+	return r.pub.Publish(ctx, &event.Profile{
+		Header:    event.Header{Mode: profile.Mode},
+		Created:   profile.Created,
+		Updated:   profile.Updated,
+		Uuid:      profile.Uuid,
+		Username:  profile.Username,
+		Avatar:    profile.Avatar,
+		School:    profile.School,
+		Company:   profile.Company,
+		Job:       profile.Job,
+		Homepage:  profile.Homepage,
+		Github:    profile.Github,
+		Gitee:     profile.Gitee,
+		Introduce: profile.Introduce,
+		Status:    profile.Status,
+	}, profile.Uuid)
+}
+
+func (r *UserUseCase) publishImageIrregular(ctx context.Context, review *ImageReview) error {
+	return r.pub.Publish(ctx, &event.ImageReview{
+		Header:   event.Header{Mode: review.Mode},
+		Uuid:     review.Uuid,
+		JobId:    review.JobId,
+		Url:      review.Url,
+		Label:    review.Label,
+		Result:   review.Result,
+		Category: review.Category,
+		SubLabel: review.SubLabel,
+		Score:    review.Score,
+	}, review.Uuid)
+}
+
+func (r *UserUseCase) publishUserStatistic(ctx context.Context, uuid, userUuid, mode string) error {
+	return r.pub.Publish(ctx, &event.AchievementFollow{
+		Header:   event.Header{Mode: mode},
+		Follow:   uuid,
+		Followed: userUuid,
+	}, uuid)
+}
+
+func (r *UserUseCase) publishFollow(ctx context.Context, follow *Follow, mode string) error {
+	return r.pub.Publish(ctx, &event.Follow{
+		Header: event.Header{Mode: mode},
+		Uuid:   follow.Follow,
+		UserId: follow.Followed,
+	}, follow.Followed)
+}
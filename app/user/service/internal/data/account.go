@@ -0,0 +1,222 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"net/http"
+	"time"
+)
+
+// DeleteUser frees the phone and email of uuid for new accounts and removes
+// every way to sign in to it.
+func (r *authRepo) DeleteUser(ctx context.Context, uuid string) error {
+	err := r.data.DB(ctx).Model(&User{}).Where("uuid = ?", uuid).Updates(map[string]interface{}{
+		"phone":    nil,
+		"email":    nil,
+		"password": "",
+	}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to clear user account: uuid(%s)", uuid))
+	}
+	err = r.data.DB(ctx).Where("uuid = ?", uuid).Delete(&User{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete user: uuid(%s)", uuid))
+	}
+	err = r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(&UserIdentity{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete user identities: uuid(%s)", uuid))
+	}
+	return r.DeleteTotp(ctx, uuid)
+}
+
+func (r *userRepo) SetUserDataExport(ctx context.Context, uuid string, ttl time.Duration) (string, error) {
+	id := xid.New().String()
+	ok, err := r.data.redisCli.SetNX(ctx, "user_data_export_"+uuid, id, ttl).Result()
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to set user data export: uuid(%s)", uuid))
+	}
+	if !ok {
+		return "", kerrors.Conflict("user data export already exists", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	return id, nil
+}
+
+func (r *userRepo) GetUserDataExport(ctx context.Context, uuid string) (string, error) {
+	id, err := r.data.redisCli.Get(ctx, "user_data_export_"+uuid).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", kerrors.NotFound("user data export not found from cache", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to get user data export: uuid(%s)", uuid))
+	}
+	return id, nil
+}
+
+func (r *userRepo) DeleteUserDataExport(ctx context.Context, uuid string) error {
+	_, err := r.data.redisCli.Del(ctx, "user_data_export_"+uuid).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete user data export: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+// GetUserDataExportUrl signs a download link of the archive id, once the
+// message service has uploaded it.
+func (r *userRepo) GetUserDataExportUrl(ctx context.Context, uuid, id string, ttl time.Duration) (string, error) {
+	name := "export/" + uuid + "/" + id + ".zip"
+	ok, err := r.data.cosCli.Object.IsExist(ctx, name)
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to get user data export from cos: uuid(%s), id(%s)", uuid, id))
+	}
+	if !ok {
+		return "", kerrors.NotFound("user data export not found from cos", fmt.Sprintf("uuid(%s), id(%s)", uuid, id))
+	}
+	credential := r.data.cosCli.GetCredential()
+	if credential == nil {
+		return "", errors.Errorf(fmt.Sprintf("fail to get cos credential: uuid(%s), id(%s)", uuid, id))
+	}
+	u, err := r.data.cosCli.Object.GetPresignedURL(ctx, http.MethodGet, name, credential.SecretID, credential.SecretKey, ttl, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to sign user data export url: uuid(%s), id(%s)", uuid, id))
+	}
+	return u.String(), nil
+}
+
+// GetUserFollowRelations lists the follows uuid is on either side of.
+func (r *userRepo) GetUserFollowRelations(ctx context.Context, uuid string) ([]*biz.Follow, error) {
+	follows := make([]*Follow, 0)
+	err := r.data.DB(ctx).Where("(follow = ? or followed = ?) and status = ?", uuid, uuid, 1).Find(&follows).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user follow relations: uuid(%s)", uuid))
+	}
+	reply := make([]*biz.Follow, 0, len(follows))
+	for _, item := range follows {
+		reply = append(reply, &biz.Follow{
+			Id:       int32(item.ID),
+			Follow:   item.Follow,
+			Followed: item.Followed,
+			Status:   item.Status,
+		})
+	}
+	return reply, nil
+}
+
+func (r *userRepo) GetUserData(ctx context.Context, uuid string) ([]*biz.UserDataFile, error) {
+	// secrets are left out of the archive by naming the columns to export
+	tables := []struct {
+		name    string
+		query   string
+		model   interface{}
+		columns []string
+		rows    interface{}
+	}{
+		{"user.json", "uuid = ?", &User{}, []string{"created_at", "uuid", "email", "phone", "role"}, &[]map[string]interface{}{}},
+		{"user_identities.json", "uuid = ?", &UserIdentity{}, []string{"created_at", "provider", "subject"}, &[]map[string]interface{}{}},
+		{"totp.json", "uuid = ?", &Totp{}, []string{"created_at", "enabled"}, &[]map[string]interface{}{}},
+		{"profiles.json", "uuid = ?", &Profile{}, nil, &[]*Profile{}},
+		{"profile_updates.json", "uuid = ?", &ProfileUpdate{}, nil, &[]*ProfileUpdate{}},
+		{"avatar_reviews.json", "uuid = ?", &AvatarReview{}, nil, &[]*AvatarReview{}},
+		{"cover_reviews.json", "uuid = ?", &CoverReview{}, nil, &[]*CoverReview{}},
+		{"follows.json", "follow = ?", &Follow{}, nil, &[]*Follow{}},
+		{"followers.json", "followed = ?", &Follow{}, nil, &[]*Follow{}},
+		{"blocks.json", "uuid = ?", &Block{}, []string{"created_at", "blocked", "status"}, &[]map[string]interface{}{}},
+		{"handles.json", "uuid = ?", &Handle{}, []string{"created_at", "updated_at", "handle", "status"}, &[]map[string]interface{}{}},
+		{"suspensions.json", "uuid = ?", &Suspension{}, []string{"created_at", "updated_at", "until", "reason", "status"}, &[]map[string]interface{}{}},
+	}
+	files := make([]*biz.UserDataFile, 0, len(tables))
+	for _, item := range tables {
+		db := r.data.db.WithContext(ctx).Model(item.model)
+		if item.columns != nil {
+			db = db.Select(item.columns)
+		}
+		err := db.Where(item.query, uuid).Find(item.rows).Error
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user data from db: file(%s), uuid(%s)", item.name, uuid))
+		}
+		content, err := json.MarshalIndent(item.rows, "", "  ")
+		if err != nil {
+			return nil, errors.Wrapf(err, fmt.Sprintf("fail to marshal user data: file(%s), uuid(%s)", item.name, uuid))
+		}
+		files = append(files, &biz.UserDataFile{
+			Name:    item.name,
+			Content: content,
+		})
+	}
+	return files, nil
+}
+
+// PurgeUserData drops the profile, reviews, handles, suspension, follows and
+// blocks of uuid and takes it out of the user search.
+func (r *userRepo) PurgeUserData(ctx context.Context, uuid string) error {
+	models := []interface{}{
+		&Profile{}, &ProfileUpdate{}, &AvatarReview{}, &CoverReview{}, &Handle{}, &Suspension{},
+	}
+	for _, model := range models {
+		err := r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(model).Error
+		if err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to purge user data: model(%T), uuid(%s)", model, uuid))
+		}
+	}
+	err := r.data.DB(ctx).Unscoped().Where("follow = ? or followed = ?", uuid, uuid).Delete(&Follow{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user follows: uuid(%s)", uuid))
+	}
+	err = r.data.DB(ctx).Unscoped().Where("uuid = ? or blocked = ?", uuid, uuid).Delete(&Block{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user blocks: uuid(%s)", uuid))
+	}
+	return r.deleteUserSearch(ctx, uuid)
+}
+
+func (r *userRepo) deleteUserSearch(ctx context.Context, uuid string) error {
+	req := esapi.DeleteRequest{
+		Index:      "user",
+		DocumentID: uuid,
+		Refresh:    "true",
+	}
+	res, err := req.Do(ctx, r.data.elasticSearch.es)
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("error getting user search delete response: uuid(%s)", uuid))
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		var e map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("error parsing the response body: uuid(%s)", uuid))
+		} else {
+			return errors.Errorf(fmt.Sprintf("error delete document to es: reason(%v), uuid(%s)", e, uuid))
+		}
+	}
+	return nil
+}
+
+func (r *userRepo) PurgeUserDataFromCache(ctx context.Context, uuid string) error {
+	keys := []string{
+		"profile_" + uuid,
+		"user_follows_" + uuid,
+		"follow_" + uuid,
+		"followed_" + uuid,
+		"avatar_irregular_" + uuid,
+		"cover_irregular_" + uuid,
+		"user_data_export_" + uuid,
+		"follow_recommend_" + uuid,
+	}
+	_, err := r.data.redisCli.Del(ctx, keys...).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user data from cache: uuid(%s)", uuid))
+	}
+
+	_, err = r.data.redisCli.ZRem(ctx, "follow_recommend", uuid).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to purge user from follow recommend: uuid(%s)", uuid))
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var _ biz.AdminRepo = (*adminRepo)(nil)
+
+type adminRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminRepo(data *Data, logger log.Logger) biz.AdminRepo {
+	return &adminRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "user/data/admin")),
+	}
+}
+
+func (r *adminRepo) GetUserRole(ctx context.Context, uuid string) (string, error) {
+	user := &User{}
+	err := r.data.DB(ctx).Select("role").Where("uuid = ?", uuid).First(user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", kerrors.NotFound("user not found from db", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to get user role from db: uuid(%s)", uuid))
+	}
+	return user.Role, nil
+}
+
+func (r *adminRepo) SetUserRole(ctx context.Context, uuid, role string) error {
+	result := r.data.DB(ctx).Model(&User{}).Where("uuid = ?", uuid).Update("role", role)
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, fmt.Sprintf("fail to set user role: uuid(%s), role(%s)", uuid, role))
+	}
+	if result.RowsAffected == 0 {
+		return kerrors.NotFound("user not found from db", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	return nil
+}
+
+// GetUserSuspension returns the suspension uuid is under now, NotFound if none.
+func (r *adminRepo) GetUserSuspension(ctx context.Context, uuid string) (*biz.Suspension, error) {
+	suspension := &Suspension{}
+	err := r.data.db.WithContext(ctx).Where("uuid = ? and status = ? and (until = ? or until > ?)", uuid, 1, 0, time.Now().Unix()).First(suspension).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("suspension not found from db", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user suspension from db: uuid(%s)", uuid))
+	}
+	return &biz.Suspension{
+		Uuid:   suspension.Uuid,
+		Until:  suspension.Until,
+		Reason: suspension.Reason,
+	}, nil
+}
+
+// SetUserSuspension suspends uuid, replacing the suspension it had before.
+func (r *adminRepo) SetUserSuspension(ctx context.Context, suspension *biz.Suspension) error {
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "uuid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"until": suspension.Until, "reason": suspension.Reason, "status": 1}),
+	}).Create(&Suspension{
+		Uuid:   suspension.Uuid,
+		Until:  suspension.Until,
+		Reason: suspension.Reason,
+		Status: 1,
+	}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set user suspension: uuid(%s)", suspension.Uuid))
+	}
+	return nil
+}
+
+func (r *adminRepo) CancelUserSuspension(ctx context.Context, uuid string) error {
+	err := r.data.DB(ctx).Model(&Suspension{}).Where("uuid = ? and status = ?", uuid, 1).Update("status", 2).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to cancel user suspension: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+// AddAuditLog records an action about to be taken as pending, status 1, and
+// returns the id CompleteAuditLog takes. Logs from before actions were
+// recorded ahead of time are done, status 2.
+func (r *adminRepo) AddAuditLog(ctx context.Context, auditLog *biz.AuditLog) (int32, error) {
+	al := &AuditLog{
+		Operator: auditLog.Operator,
+		Role:     auditLog.Role,
+		Action:   auditLog.Action,
+		Kind:     auditLog.Kind,
+		Target:   auditLog.Target,
+		Reason:   auditLog.Reason,
+		Status:   1,
+	}
+	err := r.data.DB(ctx).Create(al).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, fmt.Sprintf("fail to add audit log: operator(%s), action(%s), kind(%s), target(%s)", auditLog.Operator, auditLog.Action, auditLog.Kind, auditLog.Target))
+	}
+	return int32(al.ID), nil
+}
+
+// CompleteAuditLog marks the pending log id as done, status 2, or as failed,
+// status 3.
+func (r *adminRepo) CompleteAuditLog(ctx context.Context, id int32, failed bool) error {
+	status := 2
+	if failed {
+		status = 3
+	}
+	err := r.data.DB(ctx).Model(&AuditLog{}).Where("id = ? and status = ?", id, 1).Update("status", status).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to complete audit log: id(%v), failed(%v)", id, failed))
+	}
+	return nil
+}
+
+func (r *adminRepo) GetAuditLogList(ctx context.Context, page int32, operator string) ([]*biz.AuditLog, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int(page - 1)
+	list := make([]*AuditLog, 0)
+	db := r.data.db.WithContext(ctx)
+	if operator != "" {
+		db = db.Where("operator = ?", operator)
+	}
+	err := db.Order("id desc").Offset(index * 20).Limit(20).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get audit log list from db: page(%v), operator(%s)", page, operator))
+	}
+
+	reply := make([]*biz.AuditLog, 0, len(list))
+	for _, item := range list {
+		reply = append(reply, &biz.AuditLog{
+			Id:        int32(item.ID),
+			Operator:  item.Operator,
+			Role:      item.Role,
+			Action:    item.Action,
+			Kind:      item.Kind,
+			Target:    item.Target,
+			Reason:    item.Reason,
+			CreatedAt: item.CreatedAt.Unix(),
+			Status:    item.Status,
+		})
+	}
+	return reply, nil
+}
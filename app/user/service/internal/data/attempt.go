@@ -0,0 +1,51 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"time"
+)
+
+// Failed attempts of a subject are counted in "attempt_"+subject; once the
+// policy starts locking, "attempt_lock_"+subject exists for the length of the
+// lock.
+
+// ReserveAttempt counts an attempt as a failure against every subject, all
+// at once and only if none of them is locked, before the attempt is checked.
+// It returns how long the longest lock still lasts, when nothing is counted,
+// or else the longest lock the reservation started.
+func (r *authRepo) ReserveAttempt(ctx context.Context, subjects []string, policies []*biz.AttemptPolicy) (time.Duration, time.Duration, error) {
+	keys := make([]string, 0, 2*len(subjects))
+	values := make([]interface{}, 0, 4*len(subjects))
+	for i, subject := range subjects {
+		policy := policies[i]
+		keys = append(keys, "attempt_"+subject, "attempt_lock_"+subject)
+		values = append(values, int64(policy.Window/time.Second), policy.Free, int64(policy.Base/time.Second), int64(policy.Max/time.Second))
+	}
+	result, err := r.data.redisCli.EvalSha(ctx, "a7021f3243e928fee1209221bfed4d938e8a0a57", keys, values...).Int64Slice()
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, fmt.Sprintf("fail to reserve attempt: subjects(%v)", subjects))
+	}
+	return time.Duration(result[0]) * time.Millisecond, time.Duration(result[1]) * time.Second, nil
+}
+
+// RefundAttempt takes back the failure reserved for an attempt of subject
+// that succeeded, along with the lock if it was the one to start it.
+func (r *authRepo) RefundAttempt(ctx context.Context, subject string, policy *biz.AttemptPolicy) error {
+	keys := []string{"attempt_" + subject, "attempt_lock_" + subject}
+	_, err := r.data.redisCli.EvalSha(ctx, "4a77e05bc27b375840ed361e98ad0bef6f2cc489", keys, policy.Free).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to refund attempt: subject(%s)", subject))
+	}
+	return nil
+}
+
+func (r *authRepo) ResetAttempts(ctx context.Context, subject string) error {
+	_, err := r.data.redisCli.Del(ctx, "attempt_"+subject, "attempt_lock_"+subject).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to reset attempts: subject(%s)", subject))
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+	"io"
+	"testing"
+	"time"
+)
+
+var testAttemptPolicy = &biz.AttemptPolicy{Free: 3, Base: 30 * time.Second, Max: 100 * time.Second, Window: time.Hour}
+
+func newAttemptRepo(t *testing.T) (*authRepo, redis.Cmdable) {
+	redisCli, cleanup, err := testkit.NewRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	if err = testkit.LoadScripts(redisCli, Scripts); err != nil {
+		t.Fatal(err)
+	}
+	return NewAuthRepo(&Data{redisCli: redisCli}, log.NewStdLogger(io.Discard)).(*authRepo), redisCli
+}
+
+type reservation struct {
+	wait time.Duration
+	lock time.Duration
+}
+
+func reserve(t *testing.T, r *authRepo, subjects ...string) reservation {
+	policies := make([]*biz.AttemptPolicy, len(subjects))
+	for i := range subjects {
+		policies[i] = testAttemptPolicy
+	}
+	wait, lock, err := r.ReserveAttempt(context.Background(), subjects, policies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reservation{wait: wait, lock: lock}
+}
+
+func attempts(t *testing.T, redisCli redis.Cmdable, subject string) int64 {
+	n, err := redisCli.Get(context.Background(), "attempt_"+subject).Int64()
+	if err != nil && err != redis.Nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// TestReserveAttempt counts failures up to the free ones, then locks the
+// subject for the base lock, doubling it with every failure up to the max.
+// While locked nothing is counted.
+func TestReserveAttempt(t *testing.T) {
+	ctx := context.Background()
+	r, redisCli := newAttemptRepo(t)
+
+	for i, want := range []time.Duration{0, 0, 30 * time.Second} {
+		if got := reserve(t, r, "a"); got.wait != 0 || got.lock != want {
+			t.Fatalf("reservation %v got %+v, want lock(%s)", i+1, got, want)
+		}
+	}
+	if got := reserve(t, r, "a"); got.wait <= 0 || got.wait > 30*time.Second || got.lock != 0 {
+		t.Fatalf("reservation while locked got %+v, want to wait up to 30s", got)
+	}
+	if n := attempts(t, redisCli, "a"); n != 3 {
+		t.Fatalf("got %v attempts, want 3", n)
+	}
+
+	for _, want := range []time.Duration{60 * time.Second, 100 * time.Second, 100 * time.Second} {
+		// the lock ran out
+		redisCli.Del(ctx, "attempt_lock_a")
+		if got := reserve(t, r, "a"); got.wait != 0 || got.lock != want {
+			t.Fatalf("reservation after the lock got %+v, want lock(%s)", got, want)
+		}
+	}
+	ttl, err := redisCli.TTL(ctx, "attempt_a").Result()
+	if err != nil || ttl != time.Hour {
+		t.Fatalf("got attempts expiring in %s, want the window: %v", ttl, err)
+	}
+}
+
+// TestReserveAttemptSubjects counts against every subject or, while any of
+// them is locked, against none.
+func TestReserveAttemptSubjects(t *testing.T) {
+	r, redisCli := newAttemptRepo(t)
+
+	for i := 0; i < 3; i++ {
+		reserve(t, r, "a")
+	}
+	if got := reserve(t, r, "b", "a"); got.wait <= 0 {
+		t.Fatalf("reservation with a locked subject got %+v, want to wait", got)
+	}
+	if n := attempts(t, redisCli, "b"); n != 0 {
+		t.Fatalf("got %v attempts of b, want none", n)
+	}
+
+	reserve(t, r, "b", "c")
+	if nb, nc := attempts(t, redisCli, "b"), attempts(t, redisCli, "c"); nb != 1 || nc != 1 {
+		t.Fatalf("got %v attempts of b and %v of c, want 1 each", nb, nc)
+	}
+}
+
+// TestRefundAttempt takes back a reserved failure, and the lock it started.
+func TestRefundAttempt(t *testing.T) {
+	ctx := context.Background()
+	r, redisCli := newAttemptRepo(t)
+
+	for i := 0; i < 3; i++ {
+		reserve(t, r, "a")
+	}
+	if err := r.RefundAttempt(ctx, "a", testAttemptPolicy); err != nil {
+		t.Fatal(err)
+	}
+	if n := attempts(t, redisCli, "a"); n != 2 {
+		t.Fatalf("got %v attempts, want 2", n)
+	}
+	if got := reserve(t, r, "a"); got.wait != 0 || got.lock != 30*time.Second {
+		t.Fatalf("reservation after a refund got %+v, want to lock again", got)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := r.RefundAttempt(ctx, "a", testAttemptPolicy); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exist, err := redisCli.Exists(ctx, "attempt_a", "attempt_lock_a").Result()
+	if err != nil || exist != 0 {
+		t.Fatalf("got %v keys left after refunding every attempt, want none: %v", exist, err)
+	}
+
+	// A refund of a subject without attempts, e.g. reset meanwhile, does not
+	// count below zero.
+	if err = r.RefundAttempt(ctx, "a", testAttemptPolicy); err != nil {
+		t.Fatal(err)
+	}
+	if n := attempts(t, redisCli, "a"); n != 0 {
+		t.Fatalf("got %v attempts, want none", n)
+	}
+}
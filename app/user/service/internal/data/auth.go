@@ -12,6 +12,7 @@ import (
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"github.com/pkg/errors"
 	"github.com/rs/xid"
 	"github.com/tencentyun/cos-go-sdk-v5"
@@ -19,7 +20,6 @@ import (
 	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/user/service/internal/pkg/util"
 	"gorm.io/gorm"
-	"io/ioutil"
 	"net/http"
 	"strings"
 	"time"
@@ -27,6 +27,8 @@ import (
 
 var _ biz.AuthRepo = (*authRepo)(nil)
 
+const maxCodeAttempts = 5
+
 type authRepo struct {
 	data *Data
 	log  *log.Helper
@@ -41,7 +43,7 @@ func NewAuthRepo(data *Data, logger log.Logger) biz.AuthRepo {
 
 func (r *authRepo) FindUserByPhone(ctx context.Context, phone string) (*biz.User, error) {
 	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email", "wechat", "qq", "github", "gitee").Where("phone = ?", phone).First(user).Error
+	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email").Where("phone = ?", phone).First(user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, kerrors.NotFound("phone not found from db", fmt.Sprintf("phone(%s)", phone))
 	}
@@ -53,16 +55,12 @@ func (r *authRepo) FindUserByPhone(ctx context.Context, phone string) (*biz.User
 		Password: user.Password,
 		Phone:    user.Phone,
 		Email:    user.Email,
-		Wechat:   user.Wechat,
-		Qq:       user.Qq,
-		Github:   user.Github,
-		Gitee:    user.Gitee,
 	}, nil
 }
 
 func (r *authRepo) FindUserByEmail(ctx context.Context, email string) (*biz.User, error) {
 	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email", "wechat", "qq", "github", "gitee").Where("email = ?", email).First(user).Error
+	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email").Where("email = ?", email).First(user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, kerrors.NotFound("email not found from db", fmt.Sprintf("email(%s)", email))
 	}
@@ -74,94 +72,6 @@ func (r *authRepo) FindUserByEmail(ctx context.Context, email string) (*biz.User
 		Password: user.Password,
 		Phone:    user.Phone,
 		Email:    user.Email,
-		Wechat:   user.Wechat,
-		Qq:       user.Qq,
-		Github:   user.Github,
-		Gitee:    user.Gitee,
-	}, nil
-}
-
-func (r *authRepo) FindUserByWechat(ctx context.Context, wechat string) (*biz.User, error) {
-	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email", "wechat", "qq", "github", "gitee").Where("wechat = ?", wechat).First(user).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, kerrors.NotFound("wechat not found from db", fmt.Sprintf("wechat(%s)", wechat))
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to find user by wechat: wechat(%s)", wechat))
-	}
-	return &biz.User{
-		Uuid:     user.Uuid,
-		Password: user.Password,
-		Phone:    user.Phone,
-		Email:    user.Email,
-		Wechat:   user.Wechat,
-		Qq:       user.Qq,
-		Github:   user.Github,
-		Gitee:    user.Gitee,
-	}, nil
-}
-
-func (r *authRepo) FindUserByQQ(ctx context.Context, qq string) (*biz.User, error) {
-	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email", "wechat", "qq", "github", "gitee").Where("qq = ?", qq).First(user).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, kerrors.NotFound("qq not found from db", fmt.Sprintf("qq(%s)", qq))
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to find user by qq: qq(%s)", qq))
-	}
-	return &biz.User{
-		Uuid:     user.Uuid,
-		Password: user.Password,
-		Phone:    user.Phone,
-		Email:    user.Email,
-		Wechat:   user.Wechat,
-		Qq:       user.Qq,
-		Github:   user.Github,
-		Gitee:    user.Gitee,
-	}, nil
-}
-
-func (r *authRepo) FindUserByGithub(ctx context.Context, github int32) (*biz.User, error) {
-	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email", "wechat", "qq", "github", "gitee").Where("github = ?", github).First(user).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, kerrors.NotFound("github not found from db", fmt.Sprintf("github(%v)", github))
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to find user by github: github(%v)", github))
-	}
-	return &biz.User{
-		Uuid:     user.Uuid,
-		Password: user.Password,
-		Phone:    user.Phone,
-		Email:    user.Email,
-		Wechat:   user.Wechat,
-		Qq:       user.Qq,
-		Github:   user.Github,
-		Gitee:    user.Gitee,
-	}, nil
-}
-
-func (r *authRepo) FindUserByGitee(ctx context.Context, gitee int32) (*biz.User, error) {
-	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email", "wechat", "qq", "github", "gitee").Where("gitee = ?", gitee).First(user).Error
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, kerrors.NotFound("gitee not found from db", fmt.Sprintf("gitee(%v)", gitee))
-	}
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to find user by gitee: gitee(%v)", gitee))
-	}
-	return &biz.User{
-		Uuid:     user.Uuid,
-		Password: user.Password,
-		Phone:    user.Phone,
-		Email:    user.Email,
-		Wechat:   user.Wechat,
-		Qq:       user.Qq,
-		Github:   user.Github,
-		Gitee:    user.Gitee,
 	}, nil
 }
 
@@ -187,7 +97,7 @@ func (r *authRepo) CreateUserWithEmail(ctx context.Context, email, password stri
 
 	hashPassword, err := util.HashPassword(password)
 	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to hash password: password(%s)", password))
+		return nil, errors.Wrapf(err, "fail to hash password")
 	}
 
 	user := &User{
@@ -212,97 +122,6 @@ func (r *authRepo) CreateUserWithEmail(ctx context.Context, email, password stri
 	}, nil
 }
 
-func (r *authRepo) CreateUserWithWechat(ctx context.Context, wechat string) (*biz.User, error) {
-	uuid := xid.New().String()
-	user := &User{
-		Uuid:   uuid,
-		Wechat: wechat,
-	}
-	err := r.data.DB(ctx).Select("Wechat", "Uuid").Create(user).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return nil, kerrors.Conflict("github conflict", fmt.Sprintf("wechat(%v)", wechat))
-		} else {
-			return nil, errors.Wrapf(err, fmt.Sprintf("fail to create a user: wechat(%v)", wechat))
-		}
-	}
-
-	return &biz.User{
-		Uuid:   uuid,
-		Wechat: wechat,
-	}, nil
-}
-
-func (r *authRepo) CreateUserWithQQ(ctx context.Context, qq string) (*biz.User, error) {
-	uuid := xid.New().String()
-
-	user := &User{
-		Uuid: uuid,
-		Qq:   qq,
-	}
-	err := r.data.DB(ctx).Select("Qq", "Uuid").Create(user).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return nil, kerrors.Conflict("github conflict", fmt.Sprintf("qq(%s)", qq))
-		} else {
-			return nil, errors.Wrapf(err, fmt.Sprintf("fail to create a user: qq(%s)", qq))
-		}
-	}
-
-	return &biz.User{
-		Uuid: uuid,
-		Qq:   qq,
-	}, nil
-}
-
-func (r *authRepo) CreateUserWithGithub(ctx context.Context, github int32) (*biz.User, error) {
-	uuid := xid.New().String()
-
-	user := &User{
-		Uuid:   uuid,
-		Github: github,
-	}
-	err := r.data.DB(ctx).Select("Github", "Uuid").Create(user).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return nil, kerrors.Conflict("github conflict", fmt.Sprintf("github(%v)", github))
-		} else {
-			return nil, errors.Wrapf(err, fmt.Sprintf("fail to create a user: github(%v)", github))
-		}
-	}
-
-	return &biz.User{
-		Uuid:   uuid,
-		Github: github,
-	}, nil
-}
-
-func (r *authRepo) CreateUserWithGitee(ctx context.Context, gitee int32) (*biz.User, error) {
-	uuid := xid.New().String()
-
-	user := &User{
-		Uuid:  uuid,
-		Gitee: gitee,
-	}
-	err := r.data.DB(ctx).Select("Gitee", "Uuid").Create(user).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return nil, kerrors.Conflict("github conflict", fmt.Sprintf("gitee(%v)", gitee))
-		} else {
-			return nil, errors.Wrapf(err, fmt.Sprintf("fail to create a user: gitee(%v)", gitee))
-		}
-	}
-
-	return &biz.User{
-		Uuid:   uuid,
-		Github: gitee,
-	}, nil
-}
-
 func (r *authRepo) CreateUserProfile(ctx context.Context, account, uuid string) error {
 	p := &Profile{
 		Uuid:     uuid,
@@ -316,34 +135,6 @@ func (r *authRepo) CreateUserProfile(ctx context.Context, account, uuid string)
 	return nil
 }
 
-func (r *authRepo) CreateUserProfileWithGithub(ctx context.Context, account, github, uuid string) error {
-	p := &Profile{
-		Uuid:     uuid,
-		Username: account,
-		Github:   github,
-		Updated:  time.Now().Unix(),
-	}
-	err := r.data.DB(ctx).Select("Uuid", "Username", "Github", "Updated").Create(p).Error
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to register a profile: uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *authRepo) CreateUserProfileWithGitee(ctx context.Context, account, gitee, uuid string) error {
-	p := &Profile{
-		Uuid:     uuid,
-		Username: account,
-		Gitee:    gitee,
-		Updated:  time.Now().Unix(),
-	}
-	err := r.data.DB(ctx).Select("Uuid", "Username", "Gitee", "Updated").Create(p).Error
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to register a profile: uuid(%s)", uuid))
-	}
-	return nil
-}
-
 func (r *authRepo) CreateUserProfileUpdate(ctx context.Context, account, uuid string) error {
 	pu := &ProfileUpdate{}
 	pu.Uuid = uuid
@@ -356,32 +147,6 @@ func (r *authRepo) CreateUserProfileUpdate(ctx context.Context, account, uuid st
 	return nil
 }
 
-func (r *authRepo) CreateUserProfileUpdateWithGithub(ctx context.Context, account, uuid, github string) error {
-	pu := &ProfileUpdate{}
-	pu.Uuid = uuid
-	pu.Username = account
-	pu.Github = github
-	pu.Updated = time.Now().Unix()
-	err := r.data.DB(ctx).Select("Uuid", "Username", "Github", "Updated").Create(pu).Error
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to create table: profile_update, uuid(%s)", uuid))
-	}
-	return nil
-}
-
-func (r *authRepo) CreateUserProfileUpdateWithGitee(ctx context.Context, account, uuid, gitee string) error {
-	pu := &ProfileUpdate{}
-	pu.Uuid = uuid
-	pu.Username = account
-	pu.Gitee = gitee
-	pu.Updated = time.Now().Unix()
-	err := r.data.DB(ctx).Select("Uuid", "Username", "Gitee", "Updated").Create(pu).Error
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to create table: profile_update, uuid(%s)", uuid))
-	}
-	return nil
-}
-
 func (r *authRepo) CreateUserSearch(ctx context.Context, account, uuid string) error {
 	user := &biz.UserSearchMap{
 		Username:  account,
@@ -441,67 +206,15 @@ func (r *authRepo) SetUserEmail(ctx context.Context, uuid, email string) error {
 	return nil
 }
 
-func (r *authRepo) SetUserWechat(ctx context.Context, uuid, wechat string) error {
-	err := r.data.db.WithContext(ctx).Model(&User{}).Where("uuid = ?", uuid).Update("wechat", wechat).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return kerrors.Conflict("wechat conflict", fmt.Sprintf("uuid(%s), wechat(%s)", uuid, wechat))
-		} else {
-			return errors.Wrapf(err, fmt.Sprintf("fail to set user wechat: uuid(%s), wechat(%s)", uuid, wechat))
-		}
-	}
-	return nil
-}
-
-func (r *authRepo) SetUserQQ(ctx context.Context, uuid, qq string) error {
-	err := r.data.db.WithContext(ctx).Model(&User{}).Where("uuid = ?", uuid).Update("qq", qq).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return kerrors.Conflict("qq conflict", fmt.Sprintf("uuid(%s), qq(%s)", uuid, qq))
-		} else {
-			return errors.Wrapf(err, fmt.Sprintf("fail to set user qq: uuid(%s), qq(%s)", uuid, qq))
-		}
-	}
-	return nil
-}
-
-func (r *authRepo) SetUserGitee(ctx context.Context, uuid string, gitee int32) error {
-	err := r.data.db.WithContext(ctx).Model(&User{}).Where("uuid = ?", uuid).Update("gitee", gitee).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return kerrors.Conflict("gitee conflict", fmt.Sprintf("uuid(%s), gitee(%s)", uuid, gitee))
-		} else {
-			return errors.Wrapf(err, fmt.Sprintf("fail to set user gitee: uuid(%s), gitee(%s)", uuid, gitee))
-		}
-	}
-	return nil
-}
-
-func (r *authRepo) SetUserGithub(ctx context.Context, uuid string, github int32) error {
-	err := r.data.db.WithContext(ctx).Model(&User{}).Where("uuid = ?", uuid).Update("github", github).Error
-	if err != nil {
-		e := err.Error()
-		if strings.Contains(e, "Duplicate") {
-			return kerrors.Conflict("github conflict", fmt.Sprintf("uuid(%s), github(%s)", uuid, github))
-		} else {
-			return errors.Wrapf(err, fmt.Sprintf("fail to set user github: uuid(%s), github(%s)", uuid, github))
-		}
-	}
-	return nil
-}
-
 func (r *authRepo) SetUserPassword(ctx context.Context, uuid, password string) error {
 	p, err := util.HashPassword(password)
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to hash password: %s", password))
+		return errors.Wrapf(err, fmt.Sprintf("fail to hash password: uuid(%s)", uuid))
 	}
 
 	err = r.data.db.WithContext(ctx).Model(&User{}).Where("uuid = ?", uuid).Update("password", p).Error
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to set user password: uuid(%s), password(%s)", uuid, password))
+		return errors.Wrapf(err, fmt.Sprintf("fail to set user password: uuid(%s)", uuid))
 	}
 	return nil
 }
@@ -570,7 +283,7 @@ func (r *authRepo) SendPhoneCode(ctx context.Context, template, phone string) er
 	code := util.RandomNumber()
 	err := r.setCodeToCache(ctx, "phone_"+phone, code)
 	if err != nil {
-		return errors.Wrapf(err, "fail to send user phone code: phone(%s), code(%s), template(%s)", phone, code, template)
+		return errors.Wrapf(err, "fail to send user phone code: phone(%s), template(%s)", phone, template)
 	}
 	go r.data.Recover(ctx, func(ctx context.Context) {
 		sendSmsRequest := &dysmsapi20170525.SendSmsRequest{
@@ -590,9 +303,9 @@ func (r *authRepo) SendPhoneCode(ctx context.Context, template, phone string) er
 			}
 			msg, _err := utilService.AssertAsString(err.Message)
 			if _err != nil {
-				r.log.Errorf("fail to assert message as string: phone(%s), code(%s), template(%s), err(%v)", phone, code, template, err.Error())
+				r.log.Errorf("fail to assert message as string: phone(%s), template(%s), err(%v)", phone, template, err.Error())
 			}
-			r.log.Errorf("fail to send user phone code: phone(%s), code(%s), template(%s), err(%v)", phone, code, template, *msg)
+			r.log.Errorf("fail to send user phone code: phone(%s), template(%s), err(%v)", phone, template, *msg)
 		}
 	})()
 	return nil
@@ -602,7 +315,7 @@ func (r *authRepo) SendEmailCode(ctx context.Context, template, email string) er
 	code := util.RandomNumber()
 	err := r.setCodeToCache(ctx, "email_"+email, code)
 	if err != nil {
-		return errors.Wrapf(err, "fail to send email code: email(%s) code(%s) template(%s)", email, code, template)
+		return errors.Wrapf(err, "fail to send email code: email(%s) template(%s)", email, template)
 	}
 	go r.data.Recover(ctx, func(ctx context.Context) {
 		m := r.data.mail.message
@@ -612,7 +325,7 @@ func (r *authRepo) SendEmailCode(ctx context.Context, template, email string) er
 		m.SetBody("text/html", util.GetEmailTemplate(template, code))
 		err = d.DialAndSend(m)
 		if err != nil {
-			r.log.Errorf("fail to send email code: email(%s) code(%s) template(%s) error: %v", email, code, template, err.Error())
+			r.log.Errorf("fail to send email code: email(%s) template(%s) error: %v", email, template, err.Error())
 		}
 	})()
 	return nil
@@ -628,15 +341,22 @@ func (r *authRepo) VerifyEmailCode(ctx context.Context, email, code string) erro
 	return r.verifyCode(ctx, key, code)
 }
 
+// verifyCode checks code against the one sent to key. A code is good for one
+// use and is dropped after maxCodeAttempts wrong guesses, so that guessing one
+// takes asking for a new code every few tries.
 func (r *authRepo) verifyCode(ctx context.Context, key, code string) error {
-	codeInCache, err := r.getCodeFromCache(ctx, key)
+	result, err := r.data.redisCli.EvalSha(ctx, "49964e616747dda3915154c8fc55bcae2134b397", []string{key, key + "_attempt"}, code, maxCodeAttempts).Int64()
 	if err != nil {
-		return err
+		return errors.Wrapf(err, fmt.Sprintf("fail to verify code: key(%s)", key))
 	}
-	if code != codeInCache {
-		return errors.Errorf("code error: code(%s)", code)
+	switch result {
+	case 0:
+		return errors.Errorf("code not found: key(%s)", key)
+	case -1:
+		return errors.Errorf("code error: key(%s)", key)
+	case -2:
+		return errors.Errorf("code error, code invalidated: key(%s)", key)
 	}
-	r.removeCodeFromCache(ctx, key)
 	return nil
 }
 
@@ -654,20 +374,33 @@ func (r *authRepo) VerifyPassword(ctx context.Context, account, password, mode s
 
 	pass := util.CheckPasswordHash(password, user.Password)
 	if !pass {
-		return nil, errors.Errorf("password error: password(%s)", password)
+		return nil, errors.Errorf("password error: account(%s)", account)
+	}
+	if util.PasswordNeedsRehash(user.Password) {
+		r.rehashPassword(ctx, user.Uuid, password)
 	}
 	return user, nil
 }
 
+// rehashPassword replaces the hash of a password just checked by one of the
+// current algorithm. The check already passed, so a failure is only logged and
+// tried again on the next sign in.
+func (r *authRepo) rehashPassword(ctx context.Context, uuid, password string) {
+	err := r.SetUserPassword(ctx, uuid, password)
+	if err != nil {
+		r.log.Errorf("fail to rehash user password: uuid(%s), err(%v)", uuid, err)
+	}
+}
+
 func (r *authRepo) PasswordResetByPhone(ctx context.Context, phone, password string) error {
 	hashPassword, err := util.HashPassword(password)
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to hash password: password(%s)", password))
+		return errors.Wrapf(err, "fail to hash password")
 	}
 
 	err = r.data.db.WithContext(ctx).Model(&User{}).Where("phone = ?", phone).Update("password", hashPassword).Error
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to reset password: password(%s)", password))
+		return errors.Wrapf(err, fmt.Sprintf("fail to reset password: phone(%s)", phone))
 	}
 	return nil
 }
@@ -675,12 +408,12 @@ func (r *authRepo) PasswordResetByPhone(ctx context.Context, phone, password str
 func (r *authRepo) PasswordResetByEmail(ctx context.Context, email, password string) error {
 	hashPassword, err := util.HashPassword(password)
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to hash password: password(%s)", password))
+		return errors.Wrapf(err, "fail to hash password")
 	}
 
 	err = r.data.db.WithContext(ctx).Model(&User{}).Where("email = ?", email).Update("password", hashPassword).Error
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to reset password: password(%s)", password))
+		return errors.Wrapf(err, fmt.Sprintf("fail to reset password: email(%s)", email))
 	}
 	return nil
 }
@@ -728,22 +461,6 @@ func (r *authRepo) UnbindUserEmail(ctx context.Context, uuid string) error {
 	return r.unbindUserAccount(ctx, "email", uuid)
 }
 
-func (r *authRepo) UnbindUserWechat(ctx context.Context, uuid string) error {
-	return r.unbindUserAccount(ctx, "wechat", uuid)
-}
-
-func (r *authRepo) UnbindUserQQ(ctx context.Context, uuid string) error {
-	return r.unbindUserAccount(ctx, "qq", uuid)
-}
-
-func (r *authRepo) UnbindUserGitee(ctx context.Context, uuid string) error {
-	return r.unbindUserAccount(ctx, "gitee", uuid)
-}
-
-func (r *authRepo) UnbindUserGithub(ctx context.Context, uuid string) error {
-	return r.unbindUserAccount(ctx, "github", uuid)
-}
-
 func (r *authRepo) unbindUserAccount(ctx context.Context, account, uuid string) error {
 	err := r.data.db.WithContext(ctx).Model(&User{}).Where("uuid = ?", uuid).Update(account, nil).Error
 	if err != nil {
@@ -752,334 +469,14 @@ func (r *authRepo) unbindUserAccount(ctx context.Context, account, uuid string)
 	return nil
 }
 
-func (r *authRepo) GetWechatAccessToken(ctx context.Context, code string) (string, string, error) {
-	url := r.data.wechat.accessTokenUrl + "?appid=" + r.data.wechat.appid + "&secret=" + r.data.wechat.secret + "&code=" + code + "&grant_type=" + r.data.wechat.grantType
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return "", "", errors.Wrapf(err, fmt.Sprintf("fail to new wechat access token request: code(%s)", code))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return "", "", errors.Wrapf(err, fmt.Sprintf("fail to get wechat access token: code(%s)", code))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return "", "", fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", "", errors.Wrapf(err, fmt.Sprintf("fail to  read body from responce data: code(%s)", code))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return "", "", errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: code(%s)", code))
-	}
-	token := data["access_token"].(string)
-	openId := data["openid"].(string)
-	return token, openId, nil
-}
-
-func (r *authRepo) GetQQAccessToken(ctx context.Context, code string) (string, error) {
-	url := r.data.qq.accessTokenUrl + "?client_id=" + r.data.qq.clientId + "&client_secret=" + r.data.qq.clientSecret + "&code=" + code + "&grant_type=" + r.data.qq.grantType + "&redirect_uri=" + r.data.qq.redirectUri + "&fmt=json"
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to new qq access token request: code(%s)", code))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to get qq access token: code(%s)", code))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to read body from responce data: code(%s)", code))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: code(%s)", code))
-	}
-	token := data["access_token"].(string)
-	return token, nil
-}
-
-func (r *authRepo) GetQQOpenId(ctx context.Context, token string) (string, error) {
-	url := r.data.qq.openIdUrl + "?access_token=" + token + "&fmt=json"
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to new qq open id request: token(%s)", token))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to get qq openid: token(%s)", token))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]string{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to read body from responce data: token(%s)", token))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: token(%s)", token))
-	}
-	openId := data["openid"]
-	return openId, nil
-}
-
-func (r *authRepo) GetGithubAccessToken(ctx context.Context, code string) (string, error) {
-	url := r.data.github.accessTokenUrl + "?client_id=" + r.data.github.clientId + "&client_secret=" + r.data.github.clientSecret + "&code=" + code
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to new github access token request: code(%s)", code))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to get github access token: code(%s)", code))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]string{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to  read body from responce data: code(%s)", code))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: code(%s)", code))
-	}
-	token := data["access_token"]
-	return token, nil
-}
-
-func (r *authRepo) GetGiteeAccessToken(ctx context.Context, code, redirectUrl string) (string, error) {
-	if redirectUrl == "" {
-		redirectUrl = r.data.gitee.redirectUri
-	}
-	url := r.data.gitee.accessTokenUrl + "?client_id=" + r.data.gitee.clientId + "&client_secret=" + r.data.gitee.clientSecret + "&code=" + code + "&redirect_uri=" + redirectUrl + "&grant_type=" + r.data.gitee.grantType
-	method := "POST"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to new gitee access token request: code(%s)", code))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to get gitee access token: code(%s)", code))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to read body from responce data: code(%s)", code))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: code(%s)", code))
-	}
-	token := data["access_token"].(string)
-	return token, nil
-}
-
-func (r *authRepo) GetWechatUserInfo(ctx context.Context, token, openid string) (map[string]interface{}, error) {
-	url := r.data.wechat.userInfoUrl + "?access_token=" + token + "&openid=" + openid
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new request: token(%s)", token))
-	}
-
-	req.Header.Set("Accept", "application/json")
-
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get wechat user info: token(%s)", token))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to  read body from responce data: token(%s)", token))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: token(%s)", token))
-	}
-	return data, nil
-}
-
-func (r *authRepo) GetQQUserInfo(ctx context.Context, token, openid string) (map[string]interface{}, error) {
-	url := r.data.qq.userInfoUrl + "?access_token=" + token + "&oauth_consumer_key=" + r.data.qq.clientId + "&openid=" + openid
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new request: token(%s) openid(%s)", token, openid))
-	}
-
-	req.Header.Set("Accept", "application/json")
-
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get qq user info: token(%s) openid(%s)", token, openid))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to  read body from responce data: token(%s) openid(%s)", token, openid))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: token(%s) openid(%s)", token, openid))
-	}
-	return data, nil
-}
-
-func (r *authRepo) GetGithubUserInfo(ctx context.Context, token string) (map[string]interface{}, error) {
-	url := r.data.github.userInfoUrl
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new request: token(%s)", token))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "token "+token)
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get github user info: token(%s)", token))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to  read body from responce data: token(%s)", token))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: token(%s)", token))
-	}
-	return data, nil
-}
-
-func (r *authRepo) GetGiteeUserInfo(ctx context.Context, token string) (map[string]interface{}, error) {
-	url := r.data.gitee.userInfoUrl + "?access_token=" + token
-	method := "GET"
-	client := &http.Client{}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new request: token(%s)", token))
-	}
-
-	req.Header.Set("Accept", "application/json")
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get gitee user info: token(%s)", token))
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid status code: %d", res.StatusCode)
-	}
-
-	data := map[string]interface{}{}
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to read body from responce data: token(%s)", token))
-	}
-
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, errors.Wrapf(err, fmt.Sprintf("fail to unmarshal responce data: token(%s)", token))
-	}
-	return data, nil
-}
-
 func (r *authRepo) setCodeToCache(ctx context.Context, key, code string) error {
-	err := r.data.redisCli.Set(ctx, key, code, time.Minute*2).Err()
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, code, time.Minute*2)
+		pipe.Del(ctx, key+"_attempt")
+		return nil
+	})
 	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to set code to cache: redis.Set(%v), code(%s)", key, code))
+		return errors.Wrapf(err, fmt.Sprintf("fail to set code to cache: redis.Set(%v)", key))
 	}
 	return nil
 }
-
-func (r *authRepo) getCodeFromCache(ctx context.Context, key string) (string, error) {
-	code, err := r.data.redisCli.Get(ctx, key).Result()
-	if err != nil {
-		return "", errors.Wrapf(err, fmt.Sprintf("fail to get code from cache: redis.Get(%v)", key))
-	}
-	return code, nil
-}
-
-func (r *authRepo) removeCodeFromCache(ctx context.Context, key string) {
-	_, err := r.data.redisCli.Del(ctx, key).Result()
-	if err != nil {
-		r.log.Errorf("fail to delete code from cache: redis.Del(key, %v), error(%v)", key, err)
-	}
-}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"gorm.io/gorm/clause"
+)
+
+// GetUserBlock lists the blocks and mutes between uuid and userUuid, whichever
+// of them set them.
+func (r *userRepo) GetUserBlock(ctx context.Context, uuid, userUuid string) ([]*biz.Block, error) {
+	list := make([]*Block, 0)
+	err := r.data.DB(ctx).Where("(uuid = ? and blocked = ?) or (uuid = ? and blocked = ?)", uuid, userUuid, userUuid, uuid).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user block from db: uuid(%s), userUuid(%s)", uuid, userUuid))
+	}
+	return blockList(list), nil
+}
+
+func (r *userRepo) GetUserBlocks(ctx context.Context, uuid string) ([]*biz.Block, error) {
+	list := make([]*Block, 0)
+	err := r.data.db.WithContext(ctx).Where("uuid = ?", uuid).Order("id desc").Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user blocks from db: uuid(%s)", uuid))
+	}
+	return blockList(list), nil
+}
+
+func blockList(list []*Block) []*biz.Block {
+	blocks := make([]*biz.Block, 0, len(list))
+	for _, item := range list {
+		blocks = append(blocks, &biz.Block{
+			Uuid:    item.Uuid,
+			Blocked: item.Blocked,
+			Status:  item.Status,
+		})
+	}
+	return blocks
+}
+
+// SetUserBlock blocks userUuid for uuid, turning a mute into a block.
+func (r *userRepo) SetUserBlock(ctx context.Context, uuid, userUuid string) error {
+	block := &Block{
+		Uuid:    uuid,
+		Blocked: userUuid,
+		Status:  1,
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{"status": 1}),
+	}).Create(block).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add a block: uuid(%s), blocked(%s)", uuid, userUuid))
+	}
+	return nil
+}
+
+// SetUserMute mutes userUuid for uuid. A block already there is left as it is.
+func (r *userRepo) SetUserMute(ctx context.Context, uuid, userUuid string) error {
+	block := &Block{
+		Uuid:    uuid,
+		Blocked: userUuid,
+		Status:  2,
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(block).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add a mute: uuid(%s), blocked(%s)", uuid, userUuid))
+	}
+	return nil
+}
+
+// CancelUserBlock lifts the block (status 1) or mute (status 2) uuid set on
+// userUuid.
+func (r *userRepo) CancelUserBlock(ctx context.Context, uuid, userUuid string, status int32) error {
+	err := r.data.DB(ctx).Unscoped().Where("uuid = ? and blocked = ? and status = ?", uuid, userUuid, status).Delete(&Block{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to cancel a block: uuid(%s), blocked(%s), status(%v)", uuid, userUuid, status))
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"fmt"
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	dysmsapi20170525 "github.com/alibabacloud-go/dysmsapi-20170525/v3/client"
 	"github.com/alibabacloud-go/tea/tea"
@@ -9,13 +10,25 @@ import (
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/apache/rocketmq-client-go/v2/producer"
 	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/go-kratos/kratos/contrib/registry/nacos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	"github.com/pkg/errors"
 	"github.com/tencentyun/cos-go-sdk-v5"
 	"github.com/tencentyun/qcloud-cos-sts-sdk/go"
+	achievementv1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
 	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
 	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"github.com/the-zion/matrix-core/pkg/trace"
+	"go.opentelemetry.io/otel/propagation"
 	"gopkg.in/gomail.v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -25,7 +38,12 @@ import (
 	"time"
 )
 
-var ProviderSet = wire.NewSet(NewData, NewDB, NewTransaction, NewRedis, NewRocketmqProducer, NewCosClient, NewCosServiceClient, NewUserRepo, NewAuthRepo, NewElasticsearch, NewGithub, NewWechat, NewQQ, NewGitee, NewPhoneCodeClient, NewMail, NewRecovery)
+var ProviderSet = wire.NewSet(ClientSet, RepoSet)
+
+// ClientSet connects to the infrastructure, services and third parties named in the config.
+var ClientSet = wire.NewSet(NewDB, NewRedis, NewTransport, NewCosClient, NewCosServiceClient, NewElasticsearch, NewIdentityProviders, NewPhoneCodeClient, NewMail, NewCreationServiceClient, NewAchievementServiceClient)
+
+var RepoSet = wire.NewSet(NewData, NewTransaction, NewPublisher, NewUserRepo, NewAuthRepo, NewRecommendRepo, NewHandleRepo, NewAdminRepo, NewCaptcha, NewRecovery)
 
 type Cos struct {
 	client *sts.Client
@@ -33,47 +51,13 @@ type Cos struct {
 }
 
 type MqPro struct {
-	producer rocketmq.Producer
+	producer *event.Producer
 }
 
 type ElasticSearch struct {
 	es *elasticsearch.Client
 }
 
-type Github struct {
-	accessTokenUrl string
-	userInfoUrl    string
-	clientId       string
-	clientSecret   string
-}
-
-type Wechat struct {
-	accessTokenUrl string
-	userInfoUrl    string
-	appid          string
-	secret         string
-	grantType      string
-}
-
-type QQ struct {
-	accessTokenUrl string
-	openIdUrl      string
-	userInfoUrl    string
-	clientId       string
-	clientSecret   string
-	grantType      string
-	redirectUri    string
-}
-
-type Gitee struct {
-	accessTokenUrl string
-	userInfoUrl    string
-	clientId       string
-	clientSecret   string
-	grantType      string
-	redirectUri    string
-}
-
 type AliCode struct {
 	client   *dysmsapi20170525.Client
 	signName string
@@ -90,20 +74,30 @@ type Data struct {
 	redisCli      redis.Cmdable
 	cosCli        *cos.Client
 	mqPro         *MqPro
+	relay         *event.Relay
+	sweeper       *idempotent.Sweeper
 	elasticSearch *ElasticSearch
 	cos           *Cos
-	github        *Github
-	gitee         *Gitee
-	wechat        *Wechat
-	qq            *QQ
 	aliCode       *AliCode
 	mail          *Mail
+	cc            creationv1.CreationClient
+	ac            achievementv1.AchievementClient
 }
 
 type contextTxKey struct{}
 
+// ExecTx runs fn in a transaction, unless fn has been committed before on
+// behalf of the idempotency key in ctx.
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx, first, err := idempotent.Claim(ctx, tx, "tx")
+		if err != nil {
+			return err
+		}
+		if !first {
+			d.log.Infof("skip committed transaction")
+			return nil
+		}
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -121,6 +115,19 @@ func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
 
+func NewPublisher(d *Data) biz.Publisher {
+	return d
+}
+
+func (d *Data) Publish(ctx context.Context, e event.Event, key string) error {
+	err := event.Store(ctx, d.DB(ctx), e, key)
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to store event to outbox: mode(%s), key(%s)", event.Mode(e), key))
+	}
+	d.relay.Notify()
+	return nil
+}
+
 func (d *Data) GroupRecover(ctx context.Context, fn func(ctx context.Context) error) func() error {
 	return func() error {
 		defer func() {
@@ -184,7 +191,18 @@ func NewRedis(conf *conf.Data) redis.Cmdable {
 	return client
 }
 
-func NewRocketmqProducer(conf *conf.Data) *MqPro {
+func NewTransport(conf *conf.Data) event.Transport {
+	switch conf.Transport {
+	case "", event.TransportRocketMq:
+		return newRocketmqTransport(conf)
+	case event.TransportRedis:
+		return newRedisStreamTransport(conf)
+	}
+	log.NewHelper(log.With(log.GetLogger(), "module", "user/data/transport")).Fatalf("unknown transport: %s", conf.Transport)
+	return nil
+}
+
+func newRocketmqTransport(conf *conf.Data) event.Transport {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "user/data/rocketmq-producer"))
 	p, err := rocketmq.NewProducer(
 		producer.WithNsResolver(primitive.NewPassthroughResolver([]string{conf.Rocketmq.ServerAddress})),
@@ -194,6 +212,7 @@ func NewRocketmqProducer(conf *conf.Data) *MqPro {
 		}),
 		producer.WithGroupName(conf.Rocketmq.GroupName),
 		producer.WithNamespace(conf.Rocketmq.NameSpace),
+		producer.WithQueueSelector(producer.NewHashQueueSelector()),
 	)
 
 	if err != nil {
@@ -204,9 +223,25 @@ func NewRocketmqProducer(conf *conf.Data) *MqPro {
 	if err != nil {
 		l.Fatalf("start producer error: %v", err)
 	}
-	return &MqPro{
-		producer: p,
+	return event.NewRocketMqTransport(p)
+}
+
+func newRedisStreamTransport(conf *conf.Data) event.Transport {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "user/data/redis-stream"))
+	client := redis.NewClient(&redis.Options{
+		Addr:        conf.RedisStream.Addr,
+		DB:          int(conf.RedisStream.Db),
+		DialTimeout: time.Second * 2,
+		PoolSize:    10,
+		Password:    conf.RedisStream.Password,
+	})
+	timeout, cancelFunc := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancelFunc()
+	err := client.Ping(timeout).Err()
+	if err != nil {
+		l.Fatalf("redis stream connect error: %v", err)
 	}
+	return event.NewRedisStreamTransport(client, conf.RedisStream.MaxLen)
 }
 
 func NewCosClient(conf *conf.Data) *Cos {
@@ -250,7 +285,7 @@ func NewCosServiceClient(conf *conf.Data) *cos.Client {
 	})
 }
 
-func NewElasticsearch(conf *conf.Data) *ElasticSearch {
+func NewElasticsearch(conf *conf.Data) *elasticsearch.Client {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "user/data/elastic-search"))
 	cfg := elasticsearch.Config{
 		Username: conf.ElasticSearch.User,
@@ -274,51 +309,7 @@ func NewElasticsearch(conf *conf.Data) *ElasticSearch {
 		l.Fatalf("Error: %s", res.String())
 	}
 
-	return &ElasticSearch{
-		es: es,
-	}
-}
-
-func NewGithub(conf *conf.Data) *Github {
-	return &Github{
-		accessTokenUrl: conf.Github.AccessTokenUrl,
-		userInfoUrl:    conf.Github.UserInfoUrl,
-		clientId:       conf.Github.ClientId,
-		clientSecret:   conf.Github.ClientSecret,
-	}
-}
-
-func NewWechat(conf *conf.Data) *Wechat {
-	return &Wechat{
-		accessTokenUrl: conf.Wechat.AccessTokenUrl,
-		userInfoUrl:    conf.Wechat.UserInfoUrl,
-		appid:          conf.Wechat.Appid,
-		secret:         conf.Wechat.Secret,
-		grantType:      conf.Wechat.GrantType,
-	}
-}
-
-func NewQQ(conf *conf.Data) *QQ {
-	return &QQ{
-		accessTokenUrl: conf.Qq.AccessTokenUrl,
-		openIdUrl:      conf.Qq.OpenIdUrl,
-		userInfoUrl:    conf.Qq.UserInfoUrl,
-		clientId:       conf.Qq.ClientId,
-		clientSecret:   conf.Qq.ClientSecret,
-		grantType:      conf.Qq.GrantType,
-		redirectUri:    conf.Qq.RedirectUri,
-	}
-}
-
-func NewGitee(conf *conf.Data) *Gitee {
-	return &Gitee{
-		accessTokenUrl: conf.Gitee.AccessTokenUrl,
-		userInfoUrl:    conf.Gitee.UserInfoUrl,
-		clientId:       conf.Gitee.ClientId,
-		clientSecret:   conf.Gitee.ClientSecret,
-		grantType:      conf.Gitee.GrantType,
-		redirectUri:    conf.Gitee.RedirectUri,
-	}
+	return es
 }
 
 func NewPhoneCodeClient(conf *conf.Data) *AliCode {
@@ -348,28 +339,72 @@ func NewMail(conf *conf.Data) *Mail {
 	}
 }
 
-func NewData(db *gorm.DB, redisCmd redis.Cmdable, mp *MqPro, es *ElasticSearch, cos *Cos, cosCli *cos.Client, github *Github, wechat *Wechat, qq *QQ, gitee *Gitee, code *AliCode, mailCli *Mail, logger log.Logger) (*Data, func(), error) {
+func NewCreationServiceClient(r *nacos.Registry) creationv1.CreationClient {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "user/data/new-creation-client"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///matrix.creation.service.grpc"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			circuitbreaker.Client(),
+			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+		),
+	)
+	if err != nil {
+		l.Fatalf(err.Error())
+	}
+	c := creationv1.NewCreationClient(conn)
+	return c
+}
+
+func NewAchievementServiceClient(r *nacos.Registry) achievementv1.AchievementClient {
+	l := log.NewHelper(log.With(log.GetLogger(), "module", "user/data/new-achievement-client"))
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///matrix.achievement.service.grpc"),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			circuitbreaker.Client(),
+			tracing.Client(tracing.WithPropagator(propagation.NewCompositeTextMapPropagator(trace.Metadata{}, propagation.Baggage{}, propagation.TraceContext{}))),
+		),
+	)
+	if err != nil {
+		l.Fatalf(err.Error())
+	}
+	c := achievementv1.NewAchievementClient(conn)
+	return c
+}
+
+func NewData(db *gorm.DB, redisCmd redis.Cmdable, mp event.Transport, es *elasticsearch.Client, cos *Cos, cosCli *cos.Client, code *AliCode, mailCli *Mail, cc creationv1.CreationClient, ac achievementv1.AchievementClient, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(log.GetLogger(), "module", "user/data/new-data"))
 
 	d := &Data{
 		log:           log.NewHelper(log.With(logger, "module", "creation/data")),
 		db:            db,
-		mqPro:         mp,
+		mqPro:         &MqPro{producer: event.NewProducer(mp)},
 		redisCli:      redisCmd,
-		elasticSearch: es,
+		elasticSearch: &ElasticSearch{es: es},
 		cos:           cos,
 		cosCli:        cosCli,
-		github:        github,
-		wechat:        wechat,
-		qq:            qq,
-		gitee:         gitee,
 		aliCode:       code,
 		mail:          mailCli,
+		cc:            cc,
+		ac:            ac,
 	}
+	d.relay = event.NewRelay(db, d.mqPro.producer, logger)
+	d.relay.Start()
+	d.sweeper = idempotent.NewSweeper(db, logger, idempotent.Processed{}.TableName())
+	d.sweeper.Start()
 	return d, func() {
 		var err error
 		l.Info("closing the data resources")
 
+		// stop the relay first, it flushes the outbox through db and the producer
+		d.relay.Stop()
+		d.sweeper.Stop()
+
 		mailCli.message.Reset()
 		mail, err := mailCli.dialer.Dial()
 		if err != nil {
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+var _ biz.HandleRepo = (*handleRepo)(nil)
+
+type handleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewHandleRepo(data *Data, logger log.Logger) biz.HandleRepo {
+	return &handleRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "user/data/handle")),
+	}
+}
+
+func (r *handleRepo) GetUserHandle(ctx context.Context, uuid string) (*biz.Handle, error) {
+	handle := &Handle{}
+	err := r.data.DB(ctx).Where("uuid = ? and status = ?", uuid, 1).First(handle).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("handle not found from db", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user handle from db: uuid(%s)", uuid))
+	}
+	return &biz.Handle{
+		Uuid:   handle.Uuid,
+		Handle: handle.Handle,
+		Status: handle.Status,
+		Update: handle.UpdatedAt,
+	}, nil
+}
+
+// LockHandle reads the row of handle, held or given up, and locks it until the
+// transaction ends.
+func (r *handleRepo) LockHandle(ctx context.Context, handle string) (*biz.Handle, error) {
+	h := &Handle{}
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("handle = ?", handle).First(h).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("handle not found from db", fmt.Sprintf("handle(%s)", handle))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to lock handle: handle(%s)", handle))
+	}
+	return &biz.Handle{
+		Uuid:   h.Uuid,
+		Handle: h.Handle,
+		Status: h.Status,
+		Update: h.UpdatedAt,
+	}, nil
+}
+
+// SetHandle gives handle to uuid, taking over the row of handle if someone gave
+// it up before.
+func (r *handleRepo) SetHandle(ctx context.Context, uuid, handle string) error {
+	result := r.data.DB(ctx).Model(&Handle{}).Where("handle = ?", handle).Updates(map[string]interface{}{"uuid": uuid, "status": 1})
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, fmt.Sprintf("fail to set handle: uuid(%s), handle(%s)", uuid, handle))
+	}
+
+	if result.RowsAffected != 0 {
+		return nil
+	}
+
+	err := r.data.DB(ctx).Create(&Handle{
+		Uuid:   uuid,
+		Handle: handle,
+		Status: 1,
+	}).Error
+	if err != nil {
+		e := err.Error()
+		if strings.Contains(e, "Duplicate") {
+			return kerrors.Conflict("handle conflict", fmt.Sprintf("handle(%s)", handle))
+		} else {
+			return errors.Wrapf(err, fmt.Sprintf("fail to create handle: uuid(%s), handle(%s)", uuid, handle))
+		}
+	}
+	return nil
+}
+
+// ReleaseHandle gives up the handle uuid holds, if any.
+func (r *handleRepo) ReleaseHandle(ctx context.Context, uuid string) error {
+	err := r.data.DB(ctx).Model(&Handle{}).Where("uuid = ? and status = ?", uuid, 1).Update("status", 2).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to release handle: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *handleRepo) GetHandleUuids(ctx context.Context, handles []string) (map[string]string, error) {
+	list := make([]*Handle, 0, len(handles))
+	err := r.data.db.WithContext(ctx).Select("uuid", "handle").Where("handle IN ? and status = ?", handles, 1).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get handle uuids from db: handles(%v)", handles))
+	}
+
+	uuids := make(map[string]string, len(list))
+	for _, item := range list {
+		uuids[item.Handle] = item.Uuid
+	}
+	return uuids, nil
+}
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+// The identities of a user at oauth providers live in user_identities. Redis
+// keeps "oauth_state_"+hash for an authorization until its provider redirects
+// back.
+
+func (r *authRepo) FindUserByIdentity(ctx context.Context, provider, subject string) (*biz.User, error) {
+	identity := &UserIdentity{}
+	err := r.data.db.WithContext(ctx).Where("provider = ? and subject = ?", provider, subject).First(identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("identity not found from db", fmt.Sprintf("provider(%s), subject(%s)", provider, subject))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to find user by identity: provider(%s), subject(%s)", provider, subject))
+	}
+
+	user := &User{}
+	err = r.data.db.WithContext(ctx).Select("uuid", "password", "phone", "email").Where("uuid = ?", identity.Uuid).First(user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("user not found from db", fmt.Sprintf("uuid(%s)", identity.Uuid))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to find user by identity: provider(%s), subject(%s)", provider, subject))
+	}
+	return &biz.User{
+		Uuid:     user.Uuid,
+		Password: user.Password,
+		Phone:    user.Phone,
+		Email:    user.Email,
+	}, nil
+}
+
+func (r *authRepo) CreateUserWithIdentity(ctx context.Context, identity *biz.Identity) (*biz.User, error) {
+	uuid := xid.New().String()
+	user := &User{
+		Uuid: uuid,
+	}
+	err := r.data.DB(ctx).Select("Uuid").Create(user).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to create a user: provider(%s), subject(%s)", identity.Provider, identity.Subject))
+	}
+
+	err = r.CreateUserIdentity(ctx, uuid, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.User{
+		Uuid:       uuid,
+		Identities: []string{identity.Provider},
+	}, nil
+}
+
+func (r *authRepo) CreateUserIdentity(ctx context.Context, uuid string, identity *biz.Identity) error {
+	ui := &UserIdentity{
+		Uuid:     uuid,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	}
+	err := r.data.DB(ctx).Create(ui).Error
+	if err != nil {
+		e := err.Error()
+		if strings.Contains(e, "Duplicate") {
+			return kerrors.Conflict("identity conflict", fmt.Sprintf("provider(%s), subject(%s)", identity.Provider, identity.Subject))
+		} else {
+			return errors.Wrapf(err, fmt.Sprintf("fail to create user identity: uuid(%s), provider(%s)", uuid, identity.Provider))
+		}
+	}
+	return nil
+}
+
+// CreateUserProfileWithIdentity also links the profile to the account at the
+// providers the profile has a column for.
+func (r *authRepo) CreateUserProfileWithIdentity(ctx context.Context, account, uuid string, identity *biz.Identity) error {
+	p := &Profile{
+		Uuid:     uuid,
+		Username: account,
+		Updated:  time.Now().Unix(),
+	}
+	fields := []interface{}{"Username", "Updated"}
+	switch identity.Provider {
+	case "github":
+		p.Github = identity.Homepage
+		fields = append(fields, "Github")
+	case "gitee":
+		p.Gitee = identity.Homepage
+		fields = append(fields, "Gitee")
+	}
+	err := r.data.DB(ctx).Select("Uuid", fields...).Create(p).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to register a profile: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) CreateUserProfileUpdateWithIdentity(ctx context.Context, account, uuid string, identity *biz.Identity) error {
+	pu := &ProfileUpdate{}
+	pu.Uuid = uuid
+	pu.Username = account
+	pu.Updated = time.Now().Unix()
+	fields := []interface{}{"Username", "Updated"}
+	switch identity.Provider {
+	case "github":
+		pu.Github = identity.Homepage
+		fields = append(fields, "Github")
+	case "gitee":
+		pu.Gitee = identity.Homepage
+		fields = append(fields, "Gitee")
+	}
+	err := r.data.DB(ctx).Select("Uuid", fields...).Create(pu).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to create table: profile_update, uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) DeleteUserIdentity(ctx context.Context, uuid, provider string) error {
+	err := r.data.db.WithContext(ctx).Unscoped().Where("uuid = ? and provider = ?", uuid, provider).Delete(&UserIdentity{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete user identity: uuid(%s), provider(%s)", uuid, provider))
+	}
+	return nil
+}
+
+func (r *authRepo) CreateOauthState(ctx context.Context, hash string, state *biz.OauthState, ttl time.Duration) error {
+	key := "oauth_state_" + hash
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "provider", state.Provider, "redirect_uri", state.RedirectUri, "nonce", state.Nonce)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to create oauth state: provider(%s)", state.Provider))
+	}
+	return nil
+}
+
+// TakeOauthState reads and deletes the state at once, so it is used once.
+func (r *authRepo) TakeOauthState(ctx context.Context, hash string) (*biz.OauthState, error) {
+	key := "oauth_state_" + hash
+	var get *redis.StringStringMapCmd
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to take oauth state")
+	}
+	val := get.Val()
+	if len(val) == 0 {
+		return nil, kerrors.NotFound("oauth state not found from cache", "")
+	}
+	return &biz.OauthState{
+		Provider:    val["provider"],
+		RedirectUri: val["redirect_uri"],
+		Nonce:       val["nonce"],
+	}, nil
+}
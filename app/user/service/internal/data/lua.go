@@ -0,0 +1,176 @@
+package data
+
+// Scripts are the lua scripts the repos run by their sha1, by name. tool/lua
+// loads them into redis.
+var Scripts = map[string]string{
+	"SetAvatarIrregularToCache": `
+					local key = KEYS[1]
+					local value = ARGV[1]
+					local exist = redis.call("EXISTS", key)
+					if exist == 1 then
+						redis.call("LPUSH", key, value)
+					end
+					return 0
+	`,
+	"SetCoverIrregularToCache": `
+					local key = KEYS[1]
+					local value = ARGV[1]
+					local exist = redis.call("EXISTS", key)
+					if exist == 1 then
+						redis.call("LPUSH", key, value)
+					end
+					return 0
+	`,
+	"SetUserFollowToCache": `
+					local key = KEYS[1]
+					local value = ARGV[1]
+					local value2 =  ARGV[2]
+					local exist = redis.call("EXISTS", key)
+					if exist == 1 then
+						redis.call("SADD", key, value)
+					end
+
+					local followList = KEYS[2]
+					local score = ARGV[3]
+					local exist = redis.call("EXISTS", followList)
+					if exist == 1 then
+						redis.call("ZADD", followList, score, value)
+					end
+
+					local followedList = KEYS[3]
+					local exist = redis.call("EXISTS", followedList)
+					if exist == 1 then
+						redis.call("ZADD", followedList, score, value2)
+					end
+					return 0
+	`,
+	"RotateSession": `
+					local key = KEYS[1]
+					local refresh = ARGV[1]
+					local newRefresh = ARGV[2]
+					local ip = ARGV[3]
+					local seen = ARGV[4]
+					local ttl = ARGV[5]
+					local current = redis.call("HGET", key, "refresh")
+					if current == false then
+						return {0, ""}
+					end
+					local uuid = redis.call("HGET", key, "uuid")
+					if current ~= refresh then
+						redis.call("DEL", key)
+						return {-1, uuid}
+					end
+					redis.call("HMSET", key, "refresh", newRefresh, "ip", ip, "seen", seen)
+					redis.call("EXPIRE", key, ttl)
+					return {1, uuid}
+	`,
+	"VerifyCode": `
+					local key = KEYS[1]
+					local attemptKey = KEYS[2]
+					local code = ARGV[1]
+					local max = tonumber(ARGV[2])
+					local current = redis.call("GET", key)
+					if current == false then
+						return 0
+					end
+					if current == code then
+						redis.call("DEL", key, attemptKey)
+						return 1
+					end
+					local count = redis.call("INCR", attemptKey)
+					if count == 1 then
+						redis.call("PEXPIRE", attemptKey, redis.call("PTTL", key))
+					end
+					if count >= max then
+						redis.call("DEL", key, attemptKey)
+						return -2
+					end
+					return -1
+	`,
+	"ReserveAttempt": `
+					local n = #KEYS / 2
+					local wait = 0
+					for i = 1, n do
+						local ttl = redis.call("PTTL", KEYS[2 * i])
+						if ttl > wait then
+							wait = ttl
+						end
+					end
+					if wait > 0 then
+						return {wait, 0}
+					end
+					local locked = 0
+					for i = 1, n do
+						local key = KEYS[2 * i - 1]
+						local lockKey = KEYS[2 * i]
+						local window = ARGV[4 * i - 3]
+						local free = tonumber(ARGV[4 * i - 2])
+						local base = tonumber(ARGV[4 * i - 1])
+						local max = tonumber(ARGV[4 * i])
+						local count = redis.call("INCR", key)
+						redis.call("EXPIRE", key, window)
+						if count >= free then
+							local lock = math.min(base * 2 ^ math.min(count - free, 30), max)
+							redis.call("SET", lockKey, 1, "EX", lock)
+							if lock > locked then
+								locked = lock
+							end
+						end
+					end
+					return {0, locked}
+	`,
+	"RefundAttempt": `
+					local key = KEYS[1]
+					local lockKey = KEYS[2]
+					local free = tonumber(ARGV[1])
+					if redis.call("EXISTS", key) == 0 then
+						return 0
+					end
+					local count = redis.call("DECR", key)
+					if count < free then
+						redis.call("DEL", lockKey)
+					end
+					if count <= 0 then
+						redis.call("DEL", key)
+					end
+					return count
+	`,
+	"ReserveCodeSend": `
+					local cooldownKey = KEYS[1]
+					local recipientKey = KEYS[2]
+					local ipKey = KEYS[3]
+					local globalKey = KEYS[4]
+					local cooldown = ARGV[1]
+					local recipientCap = tonumber(ARGV[2])
+					local ipCap = tonumber(ARGV[3])
+					local globalCap = tonumber(ARGV[4])
+					local dayTtl = ARGV[5]
+					local wait = redis.call("TTL", cooldownKey)
+					if wait > 0 then
+						return {1, wait}
+					end
+					if tonumber(redis.call("GET", recipientKey) or "0") >= recipientCap then
+						return {2, redis.call("TTL", recipientKey)}
+					end
+					if ipCap > 0 and tonumber(redis.call("GET", ipKey) or "0") >= ipCap then
+						return {3, redis.call("TTL", ipKey)}
+					end
+					if globalCap > 0 and tonumber(redis.call("GET", globalKey) or "0") >= globalCap then
+						return {4, redis.call("TTL", globalKey)}
+					end
+					redis.call("SET", cooldownKey, 1, "EX", cooldown)
+					local counters = {recipientKey}
+					if ipCap > 0 then
+						table.insert(counters, ipKey)
+					end
+					if globalCap > 0 then
+						table.insert(counters, globalKey)
+					end
+					for _, key in ipairs(counters) do
+						if redis.call("INCR", key) == 1 then
+							redis.call("EXPIRE", key, dayTtl)
+						end
+					end
+					return {0, 0}
+	`,
+}
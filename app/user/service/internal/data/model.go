@@ -10,11 +10,17 @@ type User struct {
 	Uuid     string `gorm:"uniqueIndex;size:20"`
 	Email    string `gorm:"uniqueIndex;size:50"`
 	Phone    string `gorm:"uniqueIndex;size:20"`
-	Wechat   string `gorm:"uniqueIndex;size:100"`
-	Qq       string `gorm:"uniqueIndex;size:100"`
-	Gitee    int32  `gorm:"uniqueIndex;size:100"`
-	Github   int32  `gorm:"uniqueIndex"`
 	Password string `gorm:"size:500"`
+	Role     string `gorm:"size:20"`
+}
+
+// UserIdentity binds the account Subject at an oauth provider to a user, each
+// subject to one user and each user to one account per provider.
+type UserIdentity struct {
+	gorm.Model
+	Uuid     string `gorm:"uniqueIndex:idx_user_provider;size:20"`
+	Provider string `gorm:"uniqueIndex:idx_user_provider;uniqueIndex:idx_identity;size:20"`
+	Subject  string `gorm:"uniqueIndex:idx_identity;size:100"`
 }
 
 //easyjson:json
@@ -31,6 +37,7 @@ type Profile struct {
 	Github    string `gorm:"size:100"`
 	Gitee     string `gorm:"size:100"`
 	Introduce string `gorm:"size:100"`
+	Private   bool
 }
 
 type ProfileUpdate struct {
@@ -68,3 +75,53 @@ type Follow struct {
 	Followed string `gorm:"uniqueIndex:idx_follow;index;size:20"`
 	Status   int32
 }
+
+type Block struct {
+	gorm.Model
+	Uuid    string `gorm:"uniqueIndex:idx_block;size:20"`
+	Blocked string `gorm:"uniqueIndex:idx_block;index;size:20"`
+	Status  int32
+}
+
+// Handle is held by Uuid while Status is 1. Once given up, with Status 2, it
+// stays held for Uuid until the hold counted from UpdatedAt ends.
+type Handle struct {
+	gorm.Model
+	Uuid   string `gorm:"index;size:20"`
+	Handle string `gorm:"uniqueIndex;size:20"`
+	Status int32
+}
+
+// Suspension keeps Uuid from signing in while Status is 1, until Until or,
+// when Until is 0, until it is lifted.
+type Suspension struct {
+	gorm.Model
+	Uuid   string `gorm:"uniqueIndex;size:20"`
+	Until  int64
+	Reason string `gorm:"size:500"`
+	Status int32
+}
+
+type AuditLog struct {
+	gorm.Model
+	Operator string `gorm:"index;size:20"`
+	Role     string `gorm:"size:20"`
+	Action   string `gorm:"size:50"`
+	Kind     string `gorm:"size:20"`
+	Target   string `gorm:"size:100"`
+	Reason   string `gorm:"size:500"`
+	Status   int32  `gorm:"default:2"`
+}
+
+type Totp struct {
+	gorm.Model
+	Uuid    string `gorm:"uniqueIndex;size:20"`
+	Secret  string `gorm:"size:100"`
+	Enabled bool
+}
+
+type TotpRecovery struct {
+	gorm.Model
+	Uuid string `gorm:"index;size:20"`
+	Hash string `gorm:"size:64"`
+}
@@ -62,6 +62,8 @@ func easyjsonC80ae7adDecodeGithubComTheZionMatrixCoreAppUserServiceInternalData(
 			out.Gitee = string(in.String())
 		case "introduce":
 			out.Introduce = string(in.String())
+		case "private":
+			out.Private = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -136,6 +138,11 @@ func easyjsonC80ae7adEncodeGithubComTheZionMatrixCoreAppUserServiceInternalData(
 		out.RawString(prefix)
 		out.String(string(in.Introduce))
 	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,451 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Identity providers are built from the oauth entries of the data config by
+// kind: "oidc" reads its endpoints from the discovery document of its issuer,
+// "oauth2" has them configured along with the claims of the userinfo response
+// to read, "wechat" and "qq" speak the dialects of those two.
+
+const (
+	oauthTimeout  = 10 * time.Second
+	oauthMaxReply = 1 << 20
+)
+
+func NewIdentityProviders(conf *conf.Data, logger log.Logger) (biz.IdentityProviders, error) {
+	l := log.NewHelper(log.With(logger, "module", "user/data/oauth"))
+	client := &http.Client{Timeout: oauthTimeout}
+	providers := make(biz.IdentityProviders, len(conf.Oauth))
+	for _, item := range conf.Oauth {
+		if item.Name == "" {
+			return nil, errors.Errorf("oauth provider without name: kind(%s)", item.Kind)
+		}
+		if _, ok := providers[item.Name]; ok {
+			return nil, errors.Errorf("duplicate oauth provider: name(%s)", item.Name)
+		}
+
+		base := &oauthProvider{conf: item, client: client}
+		switch item.Kind {
+		case "oidc":
+			if item.Issuer == "" {
+				return nil, errors.Errorf("oidc provider without issuer: name(%s)", item.Name)
+			}
+			providers[item.Name] = &oidcProvider{oauthProvider: base, issuer: strings.TrimSuffix(item.Issuer, "/")}
+		case "oauth2":
+			providers[item.Name] = base
+		case "wechat":
+			providers[item.Name] = &wechatProvider{oauthProvider: base}
+		case "qq":
+			providers[item.Name] = &qqProvider{oauthProvider: base}
+		default:
+			return nil, errors.Errorf("unknown oauth provider kind: name(%s), kind(%s)", item.Name, item.Kind)
+		}
+		l.Infof("oauth provider configured: name(%s), kind(%s)", item.Name, item.Kind)
+	}
+	return providers, nil
+}
+
+// oauthProvider is a plain oauth2 provider.
+type oauthProvider struct {
+	conf   *conf.Data_Oauth
+	client *http.Client
+}
+
+type oauthToken struct {
+	AccessToken      string `json:"access_token"`
+	IdToken          string `json:"id_token"`
+	Openid           string `json:"openid"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (p *oauthProvider) AuthUrl(_ context.Context, state, _, redirectUri string) (string, error) {
+	return p.authUrl(p.conf.AuthUrl, url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.conf.ClientId},
+		"redirect_uri":  {p.redirectUri(redirectUri)},
+		"state":         {state},
+	}, p.conf.Scopes)
+}
+
+func (p *oauthProvider) Exchange(ctx context.Context, code, _, redirectUri string) (*biz.Identity, error) {
+	token, err := p.token(ctx, p.conf.TokenUrl, code, redirectUri)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := p.userinfo(ctx, p.conf.UserinfoUrl, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return p.identity(claims)
+}
+
+func (p *oauthProvider) redirectUri(redirectUri string) string {
+	if redirectUri == "" {
+		return p.conf.RedirectUri
+	}
+	return redirectUri
+}
+
+func (p *oauthProvider) authUrl(endpoint string, query url.Values, scopes []string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to parse auth url: provider(%s)", p.conf.Name))
+	}
+	q := u.Query()
+	for key, values := range query {
+		q[key] = values
+	}
+	if len(scopes) != 0 {
+		q.Set("scope", strings.Join(scopes, " "))
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func (p *oauthProvider) token(ctx context.Context, endpoint, code, redirectUri string) (*oauthToken, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.redirectUri(redirectUri)},
+		"client_id":     {p.conf.ClientId},
+		"client_secret": {p.conf.ClientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new access token request: provider(%s)", p.conf.Name))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	token := &oauthToken{}
+	err = p.do(req, token)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get access token: provider(%s)", p.conf.Name))
+	}
+	if token.Error != "" {
+		return nil, errors.Errorf("fail to get access token: provider(%s), error(%s), description(%s)", p.conf.Name, token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.Errorf("fail to get access token: provider(%s), no access token in reply", p.conf.Name)
+	}
+	return token, nil
+}
+
+func (p *oauthProvider) userinfo(ctx context.Context, endpoint, accessToken string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new user info request: provider(%s)", p.conf.Name))
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	claims := map[string]interface{}{}
+	err = p.do(req, &claims)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user info: provider(%s)", p.conf.Name))
+	}
+	return claims, nil
+}
+
+func (p *oauthProvider) identity(claims map[string]interface{}) (*biz.Identity, error) {
+	subject := claim(claims, p.conf.SubjectClaim, "sub")
+	if subject == "" {
+		return nil, errors.Errorf("no subject in user info: provider(%s)", p.conf.Name)
+	}
+	return &biz.Identity{
+		Subject:  subject,
+		Name:     claim(claims, p.conf.NameClaim, "name"),
+		Avatar:   claim(claims, p.conf.AvatarClaim, "picture"),
+		Homepage: claim(claims, p.conf.HomepageClaim, "profile"),
+	}, nil
+}
+
+func (p *oauthProvider) do(req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, oauthMaxReply))
+	if err != nil {
+		return errors.Wrapf(err, "fail to read body from responce data")
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("invalid status code: %d", res.StatusCode)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err = decoder.Decode(v)
+	if err != nil {
+		return errors.Wrapf(err, "fail to unmarshal responce data")
+	}
+	return nil
+}
+
+// claim is the string value of the claim name, or of fallback when no name is
+// configured. Numeric ids come out in decimal.
+func claim(claims map[string]interface{}, name, fallback string) string {
+	if name == "" {
+		name = fallback
+	}
+	switch value := claims[name].(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return ""
+}
+
+// oidcProvider is an openid connect provider. The id token comes straight from
+// the token endpoint over tls, so its signature is not checked, but it has to
+// be issued by the issuer to this client for this very login.
+type oidcProvider struct {
+	*oauthProvider
+	issuer    string
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+func (p *oidcProvider) AuthUrl(ctx context.Context, state, nonce, redirectUri string) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.authUrl(discovery.AuthorizationEndpoint, url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.conf.ClientId},
+		"redirect_uri":  {p.redirectUri(redirectUri)},
+		"state":         {state},
+		"nonce":         {nonce},
+	}, p.scopes())
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, nonce, redirectUri string) (*biz.Identity, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.token(ctx, discovery.TokenEndpoint, code, redirectUri)
+	if err != nil {
+		return nil, err
+	}
+
+	idClaims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(token.IdToken, idClaims)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to parse id token: provider(%s)", p.conf.Name))
+	}
+	if !idClaims.VerifyIssuer(p.issuer, true) || !idClaims.VerifyAudience(p.conf.ClientId, true) {
+		return nil, errors.Errorf("id token not issued to this client: provider(%s)", p.conf.Name)
+	}
+	if !idClaims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.Errorf("id token expired: provider(%s)", p.conf.Name)
+	}
+	if claim(idClaims, "nonce", "") != nonce {
+		return nil, errors.Errorf("id token nonce mismatch: provider(%s)", p.conf.Name)
+	}
+
+	claims := map[string]interface{}(idClaims)
+	if discovery.UserinfoEndpoint != "" {
+		claims, err = p.userinfo(ctx, discovery.UserinfoEndpoint, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		if claim(claims, "sub", "") != claim(idClaims, "sub", "") {
+			return nil, errors.Errorf("user info subject mismatch: provider(%s)", p.conf.Name)
+		}
+	}
+	return p.identity(claims)
+}
+
+func (p *oidcProvider) scopes() []string {
+	for _, scope := range p.conf.Scopes {
+		if scope == "openid" {
+			return p.conf.Scopes
+		}
+	}
+	if len(p.conf.Scopes) == 0 {
+		return []string{"openid", "profile"}
+	}
+	return append([]string{"openid"}, p.conf.Scopes...)
+}
+
+// discover fetches the discovery document once it is first needed, and again
+// on the next login when that failed.
+func (p *oidcProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to new discovery request: provider(%s)", p.conf.Name))
+	}
+	discovery := &oidcDiscovery{}
+	err = p.do(req, discovery)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get discovery document: provider(%s), issuer(%s)", p.conf.Name, p.issuer))
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != p.issuer {
+		return nil, errors.Errorf("discovery issuer mismatch: provider(%s), issuer(%s), got(%s)", p.conf.Name, p.issuer, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" {
+		return nil, errors.Errorf("discovery document without endpoints: provider(%s)", p.conf.Name)
+	}
+	p.discovery = discovery
+	return discovery, nil
+}
+
+// wechatProvider signs in with the qr code login of the wechat open platform,
+// which names the client appid and answers everything with query strings.
+type wechatProvider struct {
+	*oauthProvider
+}
+
+func (p *wechatProvider) AuthUrl(_ context.Context, state, _, redirectUri string) (string, error) {
+	scopes := p.conf.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"snsapi_login"}
+	}
+	authUrl, err := p.authUrl(p.conf.AuthUrl, url.Values{
+		"appid":         {p.conf.ClientId},
+		"redirect_uri":  {p.redirectUri(redirectUri)},
+		"response_type": {"code"},
+		"state":         {state},
+	}, scopes)
+	if err != nil {
+		return "", err
+	}
+	return authUrl + "#wechat_redirect", nil
+}
+
+func (p *wechatProvider) Exchange(ctx context.Context, code, _, _ string) (*biz.Identity, error) {
+	query := url.Values{
+		"appid":      {p.conf.ClientId},
+		"secret":     {p.conf.ClientSecret},
+		"code":       {code},
+		"grant_type": {"authorization_code"},
+	}
+	token := &oauthToken{}
+	err := p.get(ctx, p.conf.TokenUrl, query, token)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get access token: provider(%s)", p.conf.Name))
+	}
+	if token.AccessToken == "" || token.Openid == "" {
+		return nil, errors.Errorf("fail to get access token: provider(%s), no access token in reply", p.conf.Name)
+	}
+
+	claims := map[string]interface{}{}
+	err = p.get(ctx, p.conf.UserinfoUrl, url.Values{"access_token": {token.AccessToken}, "openid": {token.Openid}}, &claims)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user info: provider(%s)", p.conf.Name))
+	}
+	return &biz.Identity{
+		Subject: token.Openid,
+		Name:    claim(claims, "nickname", ""),
+		Avatar:  claim(claims, "headimgurl", ""),
+	}, nil
+}
+
+func (p *oauthProvider) get(ctx context.Context, endpoint string, query url.Values, v interface{}) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	return p.do(req, v)
+}
+
+// qqProvider signs in with qq connect, which hands out the openid with the
+// access token and wants it back along with the app id for the user info.
+type qqProvider struct {
+	*oauthProvider
+}
+
+func (p *qqProvider) AuthUrl(ctx context.Context, state, nonce, redirectUri string) (string, error) {
+	if len(p.conf.Scopes) == 0 {
+		return p.authUrl(p.conf.AuthUrl, url.Values{
+			"response_type": {"code"},
+			"client_id":     {p.conf.ClientId},
+			"redirect_uri":  {p.redirectUri(redirectUri)},
+			"state":         {state},
+		}, []string{"get_user_info"})
+	}
+	return p.oauthProvider.AuthUrl(ctx, state, nonce, redirectUri)
+}
+
+func (p *qqProvider) Exchange(ctx context.Context, code, _, redirectUri string) (*biz.Identity, error) {
+	query := url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.conf.ClientId},
+		"client_secret": {p.conf.ClientSecret},
+		"code":          {code},
+		"redirect_uri":  {p.redirectUri(redirectUri)},
+		"need_openid":   {"1"},
+		"fmt":           {"json"},
+	}
+	token := &oauthToken{}
+	err := p.get(ctx, p.conf.TokenUrl, query, token)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get access token: provider(%s)", p.conf.Name))
+	}
+	if token.AccessToken == "" || token.Openid == "" {
+		return nil, errors.Errorf("fail to get access token: provider(%s), no access token in reply", p.conf.Name)
+	}
+
+	claims := map[string]interface{}{}
+	err = p.get(ctx, p.conf.UserinfoUrl, url.Values{"access_token": {token.AccessToken}, "oauth_consumer_key": {p.conf.ClientId}, "openid": {token.Openid}}, &claims)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user info: provider(%s)", p.conf.Name))
+	}
+	avatar := claim(claims, "figureurl_qq", "")
+	if avatar == "" {
+		avatar = claim(claims, "figureurl_qq_2", "")
+	}
+	if avatar == "" {
+		avatar = claim(claims, "figureurl_qq_1", "")
+	}
+	return &biz.Identity{
+		Subject: token.Openid,
+		Name:    claim(claims, "nickname", ""),
+		Avatar:  avatar,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SetProfilePrivate turns the privacy of uuid on or off, dropping the cached
+// profile so that it is read again with it.
+func (r *userRepo) SetProfilePrivate(ctx context.Context, uuid string, private bool) error {
+	err := r.data.DB(ctx).Model(&Profile{}).Where("uuid = ?", uuid).Update("private", private).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set profile private: uuid(%s), private(%v)", uuid, private))
+	}
+
+	_, err = r.data.redisCli.Del(ctx, "profile_"+uuid).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete profile from cache: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+// GetProfilePrivate reads the privacy of uuid from the db, as the cached
+// profiles may have been filled from a partial row.
+func (r *userRepo) GetProfilePrivate(ctx context.Context, uuid string) (bool, error) {
+	p := &Profile{}
+	err := r.data.DB(ctx).Select("private").Where("uuid = ?", uuid).First(p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to get profile private from db: uuid(%s)", uuid))
+	}
+	return p.Private, nil
+}
+
+// GetFollowRequest reports whether userUuid asked to follow uuid and is still
+// waiting for it to approve.
+func (r *userRepo) GetFollowRequest(ctx context.Context, uuid, userUuid string) (bool, error) {
+	f := &Follow{}
+	err := r.data.DB(ctx).Select("id").Where("follow = ? and followed = ? and status = ?", uuid, userUuid, 3).First(f).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to get follow request from db: follow(%s), followed(%s)", uuid, userUuid))
+	}
+	return true, nil
+}
+
+func (r *userRepo) GetFollowRequests(ctx context.Context, page int32, uuid string) ([]*biz.Follow, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int(page - 1)
+	list := make([]*Follow, 0)
+	err := r.data.db.WithContext(ctx).Select("followed", "id", "updated_at").Where("follow = ? and status = ?", uuid, 3).Order("updated_at desc").Offset(index * 10).Limit(10).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get follow requests from db: uuid(%s), page(%v)", uuid, page))
+	}
+
+	requests := make([]*biz.Follow, 0, len(list))
+	for _, item := range list {
+		requests = append(requests, &biz.Follow{
+			Followed: item.Followed,
+			Id:       int32(item.ID),
+			Update:   item.UpdatedAt,
+		})
+	}
+	return requests, nil
+}
+
+// GetFollowRequestList lists every user waiting for uuid to approve them.
+func (r *userRepo) GetFollowRequestList(ctx context.Context, uuid string) ([]string, error) {
+	list := make([]string, 0)
+	err := r.data.DB(ctx).Model(&Follow{}).Where("follow = ? and status = ?", uuid, 3).Pluck("followed", &list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get follow request list from db: uuid(%s)", uuid))
+	}
+	return list, nil
+}
+
+// SetFollowRequest records that userUuid asks to follow uuid. The row is the
+// one of the follow, which approving turns active.
+func (r *userRepo) SetFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	follow := &Follow{
+		Follow:   uuid,
+		Followed: userUuid,
+		Status:   3,
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{"status": 3}),
+	}).Create(follow).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to add a follow request: follow(%s), followed(%s)", uuid, userUuid))
+	}
+	return nil
+}
+
+// CancelFollowRequest drops the request of userUuid to follow uuid, leaving an
+// active follow as it is.
+func (r *userRepo) CancelFollowRequest(ctx context.Context, uuid, userUuid string) error {
+	err := r.data.DB(ctx).Model(&Follow{}).Where("follow = ? and followed = ? and status = ?", uuid, userUuid, 3).Update("status", 2).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to cancel a follow request: follow(%s), followed(%s)", uuid, userUuid))
+	}
+	return nil
+}
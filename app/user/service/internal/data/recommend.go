@@ -0,0 +1,220 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	achievementv1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"time"
+)
+
+var _ biz.RecommendRepo = (*recommendRepo)(nil)
+
+type recommendRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRecommendRepo(data *Data, logger log.Logger) biz.RecommendRepo {
+	return &recommendRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "user/data/recommend")),
+	}
+}
+
+func (r *recommendRepo) GetUserUuids(ctx context.Context, lastId uint, size int) ([]string, uint, error) {
+	list := make([]*User, 0, size)
+	err := r.data.db.WithContext(ctx).Select("id", "uuid").Where("id > ?", lastId).Order("id").Limit(size).Find(&list).Error
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, fmt.Sprintf("fail to get user uuids from db: lastId(%v)", lastId))
+	}
+
+	uuids := make([]string, 0, len(list))
+	for _, item := range list {
+		uuids = append(uuids, item.Uuid)
+		lastId = item.ID
+	}
+	return uuids, lastId, nil
+}
+
+// GetFollowees lists the users uuid follows.
+func (r *recommendRepo) GetFollowees(ctx context.Context, uuid string) ([]string, error) {
+	list := make([]string, 0)
+	err := r.data.db.WithContext(ctx).Model(&Follow{}).Where("follow = ? and status = ?", uuid, 1).Pluck("followed", &list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get followees from db: uuid(%s)", uuid))
+	}
+	return list, nil
+}
+
+// GetFollowsOfFollows counts, for each user followed by any of followees, how
+// many of followees follow them.
+func (r *recommendRepo) GetFollowsOfFollows(ctx context.Context, followees []string, size int) (map[string]int32, error) {
+	list := make([]struct {
+		Followed string
+		Count    int32
+	}, 0, size)
+	err := r.data.db.WithContext(ctx).Model(&Follow{}).Select("followed, count(*) as count").Where("follow IN ? and status = ?", followees, 1).Group("followed").Order("count desc").Limit(size).Scan(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get follows of follows from db: followees(%v)", followees))
+	}
+
+	overlap := make(map[string]int32, len(list))
+	for _, item := range list {
+		overlap[item.Followed] = item.Count
+	}
+	return overlap, nil
+}
+
+func (r *recommendRepo) GetUserTags(ctx context.Context, uuid string) (map[string]int32, error) {
+	reply, err := r.data.cc.GetUserTags(ctx, &creationv1.GetUserTagsReq{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Tags, nil
+}
+
+func (r *recommendRepo) GetTagAuthors(ctx context.Context, tags []string) (map[string]int32, error) {
+	reply, err := r.data.cc.GetTagAuthors(ctx, &creationv1.GetTagAuthorsReq{
+		Tags: tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Authors, nil
+}
+
+func (r *recommendRepo) GetAuthorActivity(ctx context.Context, uuids []string, days int32) (map[string]int32, error) {
+	reply, err := r.data.cc.GetAuthorActivity(ctx, &creationv1.GetAuthorActivityReq{
+		Uuids: uuids,
+		Days:  days,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Activity, nil
+}
+
+func (r *recommendRepo) GetScoreRank(ctx context.Context, size int32) ([]*biz.Recommend, error) {
+	reply, err := r.data.ac.GetScoreRank(ctx, &achievementv1.GetScoreRankReq{
+		Size: size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rank := make([]*biz.Recommend, 0, len(reply.Achievement))
+	for _, item := range reply.Achievement {
+		rank = append(rank, &biz.Recommend{
+			Uuid:  item.Uuid,
+			Score: item.Score,
+		})
+	}
+	return rank, nil
+}
+
+// GetRecommendExcluded lists the users uuid blocked or muted and those who
+// blocked uuid.
+func (r *recommendRepo) GetRecommendExcluded(ctx context.Context, uuid string) ([]string, error) {
+	list := make([]*Block, 0)
+	err := r.data.db.WithContext(ctx).Select("uuid", "blocked").Where("uuid = ? or (blocked = ? and status = ?)", uuid, uuid, 1).Find(&list).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get recommend excluded from db: uuid(%s)", uuid))
+	}
+
+	excluded := make([]string, 0, len(list))
+	for _, item := range list {
+		if item.Uuid == uuid {
+			excluded = append(excluded, item.Blocked)
+		} else {
+			excluded = append(excluded, item.Uuid)
+		}
+	}
+	return excluded, nil
+}
+
+func (r *recommendRepo) FollowRecommendationsExist(ctx context.Context, uuid string) (bool, error) {
+	exist, err := r.data.redisCli.Exists(ctx, "follow_recommend_"+uuid).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to judge if follow recommendations exist from cache: uuid(%s)", uuid))
+	}
+	return exist == 1, nil
+}
+
+func (r *recommendRepo) GetFollowRecommendations(ctx context.Context, page int32, uuid string) ([]string, error) {
+	return r.getRecommendationsFromCache(ctx, page, "follow_recommend_"+uuid)
+}
+
+func (r *recommendRepo) GetColdRecommendations(ctx context.Context, page int32) ([]string, error) {
+	return r.getRecommendationsFromCache(ctx, page, "follow_recommend")
+}
+
+func (r *recommendRepo) getRecommendationsFromCache(ctx context.Context, page int32, key string) ([]string, error) {
+	if page < 1 {
+		page = 1
+	}
+	index := int64(page - 1)
+	list, err := r.data.redisCli.ZRevRange(ctx, key, index*10, index*10+9).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get recommendations from cache: key(%s), page(%v)", key, page))
+	}
+	return list, nil
+}
+
+func (r *recommendRepo) SetFollowRecommendations(ctx context.Context, uuid string, list []*biz.Recommend, ttl time.Duration) error {
+	return r.setRecommendationsToCache(ctx, "follow_recommend_"+uuid, list, ttl)
+}
+
+// SetColdRecommendations keeps the top scored authors until the next refresh
+// replaces them.
+func (r *recommendRepo) SetColdRecommendations(ctx context.Context, list []*biz.Recommend) error {
+	return r.setRecommendationsToCache(ctx, "follow_recommend", list, 0)
+}
+
+func (r *recommendRepo) setRecommendationsToCache(ctx context.Context, key string, list []*biz.Recommend, ttl time.Duration) error {
+	z := make([]*redis.Z, 0, len(list))
+	for _, item := range list {
+		z = append(z, &redis.Z{
+			Score:  float64(item.Score),
+			Member: item.Uuid,
+		})
+	}
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if len(z) != 0 {
+			pipe.ZAddNX(ctx, key, z...)
+		}
+		if ttl > 0 {
+			pipe.Expire(ctx, key, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set recommendations to cache: key(%s)", key))
+	}
+	return nil
+}
+
+func (r *recommendRepo) DeleteFollowRecommendations(ctx context.Context, uuid string) error {
+	_, err := r.data.redisCli.Del(ctx, "follow_recommend_"+uuid).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete follow recommendations from cache: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+// LockRecommend takes the refresh of the recommendations for ttl, reporting
+// false when another instance already took it.
+func (r *recommendRepo) LockRecommend(ctx context.Context, ttl time.Duration) (bool, error) {
+	ok, err := r.data.redisCli.SetNX(ctx, "follow_recommend_lock", 1, ttl).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to lock recommend: ttl(%v)", ttl))
+	}
+	return ok, nil
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	achievementv1 "github.com/the-zion/matrix-core/api/achievement/service/v1"
+	creationv1 "github.com/the-zion/matrix-core/api/creation/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"github.com/the-zion/matrix-core/pkg/testkit"
+	"google.golang.org/grpc"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeCreationClient answers the tag and activity queries of the
+// recommendations; any other call panics.
+type fakeCreationClient struct {
+	creationv1.CreationClient
+	tags     map[string]int32
+	authors  map[string]int32
+	activity map[string]int32
+}
+
+func (c *fakeCreationClient) GetUserTags(context.Context, *creationv1.GetUserTagsReq, ...grpc.CallOption) (*creationv1.GetUserTagsReply, error) {
+	return &creationv1.GetUserTagsReply{Tags: c.tags}, nil
+}
+
+func (c *fakeCreationClient) GetTagAuthors(context.Context, *creationv1.GetTagAuthorsReq, ...grpc.CallOption) (*creationv1.GetTagAuthorsReply, error) {
+	return &creationv1.GetTagAuthorsReply{Authors: c.authors}, nil
+}
+
+func (c *fakeCreationClient) GetAuthorActivity(context.Context, *creationv1.GetAuthorActivityReq, ...grpc.CallOption) (*creationv1.GetAuthorActivityReply, error) {
+	return &creationv1.GetAuthorActivityReply{Activity: c.activity}, nil
+}
+
+type fakeAchievementClient struct {
+	achievementv1.AchievementClient
+}
+
+func (c *fakeAchievementClient) GetScoreRank(context.Context, *achievementv1.GetScoreRankReq, ...grpc.CallOption) (*achievementv1.GetScoreRankReply, error) {
+	return &achievementv1.GetScoreRankReply{}, nil
+}
+
+// TestRefreshFollowRecommendations ranks the follows of the users "me"
+// follows above the authors of its tags, and leaves out its followers, the
+// users it unfollowed or follows and those it blocked.
+func TestRefreshFollowRecommendations(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup, err := testkit.NewDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	if err = testkit.Migrate(db, &User{}, &Follow{}, &Block{}); err != nil {
+		t.Fatal(err)
+	}
+	redisCli, cleanup, err := testkit.NewRedis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+
+	if err = db.Create(&User{Uuid: "me"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	follows := []*Follow{
+		{Follow: "me", Followed: "a", Status: 1},
+		{Follow: "me", Followed: "b", Status: 1},
+		{Follow: "me", Followed: "c", Status: 2},
+		{Follow: "a", Followed: "b", Status: 1},
+		{Follow: "a", Followed: "d", Status: 1},
+		{Follow: "a", Followed: "e", Status: 1},
+		{Follow: "a", Followed: "f", Status: 1},
+		{Follow: "b", Followed: "d", Status: 1},
+		{Follow: "b", Followed: "f", Status: 2},
+		{Follow: "b", Followed: "me", Status: 1},
+		{Follow: "c", Followed: "g", Status: 1},
+		{Follow: "x", Followed: "me", Status: 1},
+		{Follow: "x", Followed: "y", Status: 1},
+	}
+	if err = db.Create(follows).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err = db.Create(&Block{Uuid: "me", Blocked: "e", Status: 1}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	d := &Data{
+		db:       db,
+		redisCli: redisCli,
+		cc: &fakeCreationClient{
+			tags:     map[string]int32{"go": 3},
+			authors:  map[string]int32{"h": 1, "f": 1},
+			activity: map[string]int32{"h": 1},
+		},
+		ac: &fakeAchievementClient{},
+	}
+	logger := log.NewStdLogger(io.Discard)
+	uc := biz.NewRecommendUseCase(&conf.Server{}, NewRecommendRepo(d, logger), logger)
+	if err = uc.RefreshFollowRecommendations(ctx, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := uc.GetFollowRecommendations(ctx, 1, "me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// d is followed by a and b, f by a and one of its tags, h by its tags and
+	// lately active.
+	want := []string{"d", "f", "h"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got recommendations %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sends are counted per day in keys suffixed with the date: "send_day_" for a
+// recipient, "send_ip_day_" for a client ip and "send_sms_day_" for every sms
+// sent. "send_cooldown_"+recipient exists while the recipient has to wait.
+
+func (r *authRepo) ReserveCodeSend(ctx context.Context, channel, recipient, ip string, limit *biz.SendLimit) (biz.SendVerdict, time.Duration, error) {
+	day := time.Now().Format("20060102")
+	keys := []string{
+		"send_cooldown_" + recipient,
+		"send_day_" + recipient + "_" + day,
+		"send_ip_day_" + ip + "_" + day,
+		"send_sms_day_" + day,
+	}
+	ipCap, globalCap := limit.IpDaily, int64(0)
+	if ip == "" {
+		ipCap = 0
+	}
+	if channel == "phone" {
+		globalCap = limit.SmsDaily
+	}
+
+	result, err := r.data.redisCli.EvalSha(ctx, "f8b956020947c6102bb7d6578653bd9bedc1b2c9", keys, int64(limit.Cooldown/time.Second), limit.RecipientDaily, ipCap, globalCap, int64(24*time.Hour/time.Second)).Int64Slice()
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, fmt.Sprintf("fail to reserve code send: channel(%s), recipient(%s), ip(%s)", channel, recipient, ip))
+	}
+	return biz.SendVerdict(result[0]), time.Duration(result[1]) * time.Second, nil
+}
+
+const powMaxAge = 5 * time.Minute
+
+// powCaptcha is the built in captcha: the client proves some work was spent
+// on this very request. The token is "<unix time>.<nonce>" and the sha256 of
+// "<recipient>.<token>" must start with difficulty zero bits.
+type powCaptcha struct {
+	difficulty int
+}
+
+func NewCaptcha(conf *conf.Auth) biz.Captcha {
+	return &powCaptcha{
+		difficulty: int(conf.SendLimit.GetPowDifficulty()),
+	}
+}
+
+func (c *powCaptcha) Verify(_ context.Context, token, recipient, _ string) error {
+	if c.difficulty <= 0 {
+		return nil
+	}
+
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return errors.Errorf("malformed captcha: token(%s)", token)
+	}
+	unix, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return errors.Errorf("malformed captcha: token(%s)", token)
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > powMaxAge || age < -powMaxAge {
+		return errors.Errorf("captcha expired: token(%s)", token)
+	}
+
+	sum := sha256.Sum256([]byte(recipient + "." + token))
+	zeros := 0
+	for _, b := range sum {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+	if zeros < c.difficulty {
+		return errors.Errorf("captcha not solved: token(%s)", token)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"strconv"
+	"time"
+)
+
+// Sessions live in redis: the hash "session_"+id holds a session and the hash
+// of its current refresh token, the sorted set "user_session_"+uuid indexes the
+// sessions of a user by login time. Both expire with the refresh token, the
+// index may keep ids of sessions already gone until the next listing.
+
+func (r *authRepo) CreateSession(ctx context.Context, session *biz.Session, refresh string, ttl time.Duration) (*biz.Session, error) {
+	session.Id = xid.New().String()
+	key := "session_" + session.Id
+	indexKey := "user_session_" + session.Uuid
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "uuid", session.Uuid, "refresh", refresh, "device", session.Device, "ip", session.Ip, "created", session.Created, "seen", session.Seen)
+		pipe.Expire(ctx, key, ttl)
+		pipe.ZAdd(ctx, indexKey, &redis.Z{Score: float64(session.Created), Member: session.Id})
+		pipe.Expire(ctx, indexKey, ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to create session: uuid(%s)", session.Uuid))
+	}
+	return session, nil
+}
+
+func (r *authRepo) RotateSession(ctx context.Context, id, refresh, newRefresh, ip string, seen int64, ttl time.Duration) (string, error) {
+	result, err := r.data.redisCli.EvalSha(ctx, "751b7a67d1dfa758505ec6b4d844bc0e24d4c96b", []string{"session_" + id}, refresh, newRefresh, ip, seen, int64(ttl/time.Second)).Slice()
+	if err != nil {
+		return "", errors.Wrapf(err, fmt.Sprintf("fail to rotate session: id(%s)", id))
+	}
+	code, uuid := result[0].(int64), result[1].(string)
+	switch code {
+	case 0:
+		return "", errors.Errorf("session not found: id(%s)", id)
+	case -1:
+		r.log.Errorf("refresh token reused, session revoked: id(%s), uuid(%s), ip(%s)", id, uuid, ip)
+		return "", errors.Errorf("refresh token reused: id(%s)", id)
+	}
+	_, err = r.data.redisCli.Expire(ctx, "user_session_"+uuid, ttl).Result()
+	if err != nil {
+		r.log.Errorf("fail to expire user session index: uuid(%s), err(%v)", uuid, err)
+	}
+	return uuid, nil
+}
+
+func (r *authRepo) DeleteSession(ctx context.Context, uuid, id string) error {
+	key := "session_" + id
+	owner, err := r.data.redisCli.HGet(ctx, key, "uuid").Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to get session: id(%s)", id))
+	}
+	if owner != uuid {
+		return errors.Errorf("session not owned by user: id(%s), uuid(%s)", id, uuid)
+	}
+	_, err = r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.ZRem(ctx, "user_session_"+uuid, id)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete session: id(%s), uuid(%s)", id, uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) DeleteUserSessions(ctx context.Context, uuid string) error {
+	indexKey := "user_session_" + uuid
+	ids, err := r.data.redisCli.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to get user sessions: uuid(%s)", uuid))
+	}
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, "session_"+id)
+	}
+	keys = append(keys, indexKey)
+	_, err = r.data.redisCli.Del(ctx, keys...).Result()
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete user sessions: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) GetUserSessions(ctx context.Context, uuid string) ([]*biz.Session, error) {
+	indexKey := "user_session_" + uuid
+	ids, err := r.data.redisCli.ZRevRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user sessions: uuid(%s)", uuid))
+	}
+	if len(ids) == 0 {
+		return []*biz.Session{}, nil
+	}
+
+	cmd, err := r.data.redisCli.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			pipe.HMGet(ctx, "session_"+id, "device", "ip", "created", "seen")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get user sessions: uuid(%s)", uuid))
+	}
+
+	sessions := make([]*biz.Session, 0, len(ids))
+	gone := make([]interface{}, 0)
+	for index, item := range cmd {
+		val := item.(*redis.SliceCmd).Val()
+		if val[2] == nil {
+			gone = append(gone, ids[index])
+			continue
+		}
+		created, _ := strconv.ParseInt(val[2].(string), 10, 64)
+		seen, _ := strconv.ParseInt(val[3].(string), 10, 64)
+		sessions = append(sessions, &biz.Session{
+			Id:      ids[index],
+			Uuid:    uuid,
+			Device:  val[0].(string),
+			Ip:      val[1].(string),
+			Created: created,
+			Seen:    seen,
+		})
+	}
+
+	if len(gone) != 0 {
+		_, err = r.data.redisCli.ZRem(ctx, indexKey, gone...).Result()
+		if err != nil {
+			r.log.Errorf("fail to remove expired sessions from index: uuid(%s), err(%v)", uuid, err)
+		}
+	}
+	return sessions, nil
+}
@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
+)
+
+// A totp secret and the hashes of its recovery codes live in mysql. Redis
+// keeps "totp_step_"+uuid+"_"+step while a code of that step may still be
+// accepted, so a code cannot be used twice, and "totp_challenge_"+hash for a
+// login waiting for its second factor.
+
+func (r *authRepo) GetTotp(ctx context.Context, uuid string) (*biz.Totp, error) {
+	totp := &Totp{}
+	err := r.data.DB(ctx).Where("uuid = ?", uuid).First(totp).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NotFound("totp not found from db", fmt.Sprintf("uuid(%s)", uuid))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get totp: uuid(%s)", uuid))
+	}
+	return &biz.Totp{
+		Uuid:    totp.Uuid,
+		Secret:  totp.Secret,
+		Enabled: totp.Enabled,
+	}, nil
+}
+
+func (r *authRepo) SetTotpSecret(ctx context.Context, uuid, secret string) error {
+	totp := &Totp{
+		Uuid:   uuid,
+		Secret: secret,
+	}
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]interface{}{"secret": secret, "enabled": false}),
+	}).Create(totp).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to set totp secret: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) EnableTotp(ctx context.Context, uuid string) error {
+	err := r.data.DB(ctx).Model(&Totp{}).Where("uuid = ?", uuid).Update("enabled", true).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to enable totp: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) SetTotpRecoveryCodes(ctx context.Context, uuid string, hashes []string) error {
+	err := r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(&TotpRecovery{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete totp recovery codes: uuid(%s)", uuid))
+	}
+
+	codes := make([]*TotpRecovery, 0, len(hashes))
+	for _, hash := range hashes {
+		codes = append(codes, &TotpRecovery{
+			Uuid: uuid,
+			Hash: hash,
+		})
+	}
+	err = r.data.DB(ctx).Create(&codes).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to create totp recovery codes: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) UseTotpRecoveryCode(ctx context.Context, uuid, hash string) error {
+	result := r.data.DB(ctx).Unscoped().Where("uuid = ? and hash = ?", uuid, hash).Delete(&TotpRecovery{})
+	if result.Error != nil {
+		return errors.Wrapf(result.Error, fmt.Sprintf("fail to use totp recovery code: uuid(%s)", uuid))
+	}
+	if result.RowsAffected == 0 {
+		return errors.Errorf("recovery code not found: uuid(%s)", uuid)
+	}
+	return nil
+}
+
+func (r *authRepo) DeleteTotp(ctx context.Context, uuid string) error {
+	err := r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(&Totp{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete totp: uuid(%s)", uuid))
+	}
+	err = r.data.DB(ctx).Unscoped().Where("uuid = ?", uuid).Delete(&TotpRecovery{}).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to delete totp recovery codes: uuid(%s)", uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) UseTotpStep(ctx context.Context, uuid string, step int64, ttl time.Duration) (bool, error) {
+	key := "totp_step_" + uuid + "_" + strconv.FormatInt(step, 10)
+	ok, err := r.data.redisCli.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, fmt.Sprintf("fail to use totp step: uuid(%s), step(%v)", uuid, step))
+	}
+	return ok, nil
+}
+
+func (r *authRepo) CreateTotpChallenge(ctx context.Context, hash string, challenge *biz.TotpChallenge, ttl time.Duration) error {
+	key := "totp_challenge_" + hash
+	_, err := r.data.redisCli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "uuid", challenge.Uuid, "device", challenge.Device, "ip", challenge.Ip)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to create totp challenge: uuid(%s)", challenge.Uuid))
+	}
+	return nil
+}
+
+func (r *authRepo) GetTotpChallenge(ctx context.Context, hash string) (*biz.TotpChallenge, error) {
+	val, err := r.data.redisCli.HMGet(ctx, "totp_challenge_"+hash, "uuid", "device", "ip").Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to get totp challenge")
+	}
+	if val[0] == nil {
+		return nil, kerrors.NotFound("totp challenge not found from cache", "")
+	}
+	return &biz.TotpChallenge{
+		Uuid:   val[0].(string),
+		Device: val[1].(string),
+		Ip:     val[2].(string),
+	}, nil
+}
+
+func (r *authRepo) DeleteTotpChallenge(ctx context.Context, hash string) error {
+	_, err := r.data.redisCli.Del(ctx, "totp_challenge_"+hash).Result()
+	if err != nil {
+		return errors.Wrapf(err, "fail to delete totp challenge")
+	}
+	return nil
+}
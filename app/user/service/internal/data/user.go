@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -35,21 +34,29 @@ func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
 
 func (r *userRepo) GetAccount(ctx context.Context, uuid string) (*biz.User, error) {
 	user := &User{}
-	err := r.data.db.WithContext(ctx).Select("password", "phone", "email", "wechat", "qq", "github", "gitee").Where("uuid = ?", uuid).First(user).Error
+	err := r.data.db.WithContext(ctx).Select("password", "phone", "email").Where("uuid = ?", uuid).First(user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, kerrors.NotFound("user not found from db", fmt.Sprintf("uuid(%v)", uuid))
 	}
 	if err != nil {
 		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get account: uuid(%v)", uuid))
 	}
+	var totp int64
+	err = r.data.db.WithContext(ctx).Model(&Totp{}).Where("uuid = ? and enabled = ?", uuid, true).Count(&totp).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get account totp: uuid(%v)", uuid))
+	}
+	identities := make([]string, 0)
+	err = r.data.db.WithContext(ctx).Model(&UserIdentity{}).Where("uuid = ?", uuid).Order("provider").Pluck("provider", &identities).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to get account identities: uuid(%v)", uuid))
+	}
 	return &biz.User{
-		Phone:    user.Phone,
-		Email:    user.Email,
-		Qq:       user.Qq,
-		Wechat:   user.Wechat,
-		Gitee:    user.Gitee,
-		Github:   user.Github,
-		Password: user.Password,
+		Phone:      user.Phone,
+		Email:      user.Email,
+		Password:   user.Password,
+		Totp:       totp > 0,
+		Identities: identities,
 	}, nil
 }
 
@@ -84,6 +91,7 @@ func (r *userRepo) GetProfile(ctx context.Context, uuid string) (*biz.Profile, e
 		Github:    target.Github,
 		Gitee:     target.Gitee,
 		Introduce: target.Introduce,
+		Private:   target.Private,
 	}, nil
 }
 
@@ -318,7 +326,7 @@ func (r *userRepo) setFollowToCache(uuid string, follow []*biz.Follow) {
 		return nil
 	})
 	if err != nil {
-		r.log.Errorf("fail to set user follow to cache: uuid(%s), follow(%v), err(%v)", follow, err)
+		r.log.Errorf("fail to set user follow to cache: uuid(%s), follow(%v), err(%v)", uuid, follow, err)
 	}
 }
 
@@ -580,6 +588,11 @@ func (r *userRepo) SetProfile(ctx context.Context, profile *biz.ProfileUpdate) e
 	if err != nil {
 		return errors.Wrapf(err, fmt.Sprintf("fail to set profile: profile(%v)", profile))
 	}
+	// cache the whole row, not only the reviewed fields
+	err = r.data.DB(ctx).Where("uuid = ?", profile.Uuid).First(p).Error
+	if err != nil {
+		return errors.Wrapf(err, fmt.Sprintf("fail to get profile: uuid(%v)", profile.Uuid))
+	}
 	r.updateProfileToCache(ctx, p, "profile_"+profile.Uuid)
 	return nil
 }
@@ -604,62 +617,6 @@ func (r *userRepo) SetProfileUpdate(ctx context.Context, profile *biz.ProfileUpd
 	return profile, nil
 }
 
-func (r *userRepo) SendProfileToMq(ctx context.Context, profile *biz.ProfileUpdate) error {
-	data, err := profile.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{profile.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send profile to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *userRepo) SendImageIrregularToMq(ctx context.Context, review *biz.ImageReview) error {
-	data, err := review.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{review.Uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send picture review to mq: %v", err))
-	}
-	return nil
-}
-
-func (r *userRepo) SendUserStatisticToMq(ctx context.Context, uuid, userUuid, mode string) error {
-	achievement := &biz.SendUserStatisticMap{
-		Follow:   uuid,
-		Followed: userUuid,
-		Mode:     mode,
-	}
-	data, err := achievement.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{uuid})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send user statistic to mq: uuid(%s)", uuid))
-	}
-	return nil
-}
-
 func (r *userRepo) ModifyProfileUpdateStatus(ctx context.Context, uuid, update string) error {
 	updateTime, err := strconv.ParseInt(update, 10, 64)
 	if err != nil {
@@ -1130,25 +1087,3 @@ func (r *userRepo) CancelUserFollowFromCache(ctx context.Context, uuid, userUuid
 	}
 	return nil
 }
-
-func (r *userRepo) SetFollowToMq(ctx context.Context, follow *biz.Follow, mode string) error {
-	followMap := &biz.SetFollowMap{
-		Uuid:   follow.Follow,
-		UserId: follow.Followed,
-		Mode:   mode,
-	}
-	data, err := followMap.MarshalJSON()
-	if err != nil {
-		return err
-	}
-	msg := &primitive.Message{
-		Topic: "matrix",
-		Body:  data,
-	}
-	msg.WithKeys([]string{follow.Followed})
-	_, err = r.data.mqPro.producer.SendSync(ctx, msg)
-	if err != nil {
-		return errors.Wrapf(err, fmt.Sprintf("fail to send follow to mq: %v", follow))
-	}
-	return nil
-}
@@ -1,15 +1,98 @@
 package util
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
+	"strings"
 )
 
+// The argon2id parameters new hashes are made with. A hash records the ones
+// it was made with, so raising them only asks for a rehash of older hashes.
+const (
+	argon2Memory  = 19 * 1024
+	argon2Time    = 2
+	argon2Threads = 1
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+	argon2Prefix  = "$argon2id$"
+)
+
+// HashPassword hashes password with argon2id, in the PHC string format:
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>.
 func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 10)
-	return string(bytes), err
+	salt := make([]byte, argon2SaltLen)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	b64 := base64.RawStdEncoding
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2Prefix, argon2.Version, argon2Memory, argon2Time, argon2Threads, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
 }
 
+// CheckPasswordHash reports whether password matches hash, an argon2id hash
+// or a bcrypt one of before.
 func CheckPasswordHash(password, hash string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
+	if !strings.HasPrefix(hash, argon2Prefix) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		return err == nil
+	}
+	p, ok := parseArgon2(hash)
+	if !ok {
+		return false
+	}
+	key := argon2.IDKey([]byte(password), p.salt, p.time, p.memory, p.threads, uint32(len(p.key)))
+	return subtle.ConstantTimeCompare(key, p.key) == 1
+}
+
+// PasswordNeedsRehash reports whether hash was made by bcrypt or with other
+// argon2id parameters than HashPassword uses now. A password checked against
+// such a hash is hashed again.
+func PasswordNeedsRehash(hash string) bool {
+	p, ok := parseArgon2(hash)
+	if !ok {
+		return true
+	}
+	return p.version != argon2.Version || p.memory != argon2Memory || p.time != argon2Time || p.threads != argon2Threads || len(p.key) != argon2KeyLen
+}
+
+type argon2Hash struct {
+	version int
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func parseArgon2(hash string) (*argon2Hash, bool) {
+	if !strings.HasPrefix(hash, argon2Prefix) {
+		return nil, false
+	}
+	parts := strings.Split(strings.TrimPrefix(hash, argon2Prefix), "$")
+	if len(parts) != 4 {
+		return nil, false
+	}
+	p := &argon2Hash{}
+	_, err := fmt.Sscanf(parts[0], "v=%d", &p.version)
+	if err != nil {
+		return nil, false
+	}
+	_, err = fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads)
+	if err != nil {
+		return nil, false
+	}
+	p.salt, err = base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, false
+	}
+	p.key, err = base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil || len(p.key) == 0 {
+		return nil, false
+	}
+	return p, true
 }
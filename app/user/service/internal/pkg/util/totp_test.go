@@ -0,0 +1,54 @@
+package util
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestTotpCode checks the SHA1 test vectors of RFC 6238, appendix B. The RFC
+// gives 8 digits; a 6 digit code is their last 6.
+func TestTotpCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		time int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		code, err := TotpCode(secret, TotpStep(time.Unix(tt.time, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != tt.code {
+			t.Errorf("got code(%s) at %v, want %s", code, tt.time, tt.code)
+		}
+	}
+}
+
+// TestTotpSecret reads back the secrets it hands out, in either case as
+// authenticator apps show them.
+func TestTotpSecret(t *testing.T) {
+	secret, err := TotpSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	upper, err := TotpCode(secret, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lower, err := TotpCode(strings.ToLower(secret), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upper != lower {
+		t.Fatalf("got code(%s) of the lower case secret, want %s", lower, upper)
+	}
+}
@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -12,6 +11,9 @@ import (
 	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
 	"github.com/the-zion/matrix-core/app/user/service/internal/service"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	"github.com/the-zion/matrix-core/pkg/redact"
+	"github.com/the-zion/matrix-core/pkg/request"
 	"github.com/the-zion/matrix-core/pkg/responce"
 )
 
@@ -26,9 +28,11 @@ func NewGRPCServer(c *conf.Server, userService *service.UserService, logger log.
 			})),
 			ratelimit.Server(),
 			tracing.Server(),
+			request.Server(),
 			responce.Server(),
-			logging.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
+			redact.Server(log.NewFilter(logger, log.FilterLevel(log.LevelError))),
 			validate.Validator(),
+			idempotent.Server(),
 		),
 	}
 	if c.Grpc.Network != "" {
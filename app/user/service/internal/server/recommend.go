@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	"github.com/the-zion/matrix-core/app/user/service/internal/conf"
+	"time"
+)
+
+const defaultRecommendInterval = time.Hour * 24
+
+// RecommendServer refreshes the follow recommendations once on start and then
+// every interval. The refresh is locked in redis, so only one of the running
+// instances does it per interval.
+type RecommendServer struct {
+	recommend *biz.RecommendUseCase
+	interval  time.Duration
+	cancel    context.CancelFunc
+	stopped   chan struct{}
+	log       *log.Helper
+}
+
+func NewRecommendServer(conf *conf.Server, recommend *biz.RecommendUseCase, logger log.Logger) *RecommendServer {
+	interval := conf.Recommend.GetInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultRecommendInterval
+	}
+	return &RecommendServer{
+		recommend: recommend,
+		interval:  interval,
+		stopped:   make(chan struct{}),
+		log:       log.NewHelper(log.With(logger, "server", "user/server/recommend")),
+	}
+}
+
+func (s *RecommendServer) Start(_ context.Context) error {
+	log.Info("recommend starting")
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run(ctx)
+	return nil
+}
+
+func (s *RecommendServer) Stop(_ context.Context) error {
+	log.Info("recommend closing")
+	if s.cancel != nil {
+		s.cancel()
+		<-s.stopped
+	}
+	return nil
+}
+
+func (s *RecommendServer) run(ctx context.Context) {
+	defer close(s.stopped)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		err := s.recommend.RefreshFollowRecommendations(ctx, s.interval)
+		if err != nil && ctx.Err() == nil {
+			s.log.Errorf("fail to refresh follow recommendations: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
+	"github.com/the-zion/matrix-core/app/user/service/internal/biz"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UserService) GetUserRole(ctx context.Context, req *v1.GetUserRoleReq) (*v1.GetUserRoleReply, error) {
+	role, err := s.adc.GetUserRole(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserRoleReply{
+		Role: role,
+	}, nil
+}
+
+func (s *UserService) SetUserRole(ctx context.Context, req *v1.SetUserRoleReq) (*emptypb.Empty, error) {
+	err := s.adc.SetUserRole(ctx, req.Uuid, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) SuspendUser(ctx context.Context, req *v1.SuspendUserReq) (*emptypb.Empty, error) {
+	err := s.adc.SuspendUser(ctx, &biz.Suspension{
+		Uuid:   req.Uuid,
+		Until:  req.Until,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) UnsuspendUser(ctx context.Context, req *v1.UnsuspendUserReq) (*emptypb.Empty, error) {
+	err := s.adc.UnsuspendUser(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) AddAuditLog(ctx context.Context, req *v1.AddAuditLogReq) (*v1.AddAuditLogReply, error) {
+	id, err := s.adc.AddAuditLog(ctx, &biz.AuditLog{
+		Operator: req.Operator,
+		Role:     req.Role,
+		Action:   req.Action,
+		Kind:     req.Kind,
+		Target:   req.Target,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AddAuditLogReply{Id: id}, nil
+}
+
+func (s *UserService) CompleteAuditLog(ctx context.Context, req *v1.CompleteAuditLogReq) (*emptypb.Empty, error) {
+	err := s.adc.CompleteAuditLog(ctx, req.Id, req.Failed)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) GetAuditLogList(ctx context.Context, req *v1.GetAuditLogListReq) (*v1.GetAuditLogListReply, error) {
+	list, err := s.adc.GetAuditLogList(ctx, req.Page, req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetAuditLogListReply{List: make([]*v1.GetAuditLogListReply_AuditLog, 0, len(list))}
+	for _, item := range list {
+		reply.List = append(reply.List, &v1.GetAuditLogListReply_AuditLog{
+			Id:        item.Id,
+			Operator:  item.Operator,
+			Role:      item.Role,
+			Action:    item.Action,
+			Kind:      item.Kind,
+			Target:    item.Target,
+			Reason:    item.Reason,
+			CreatedAt: item.CreatedAt,
+			Status:    item.Status,
+		})
+	}
+	return reply, nil
+}
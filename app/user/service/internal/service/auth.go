@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	v1 "github.com/the-zion/matrix-core/api/user/service/v1"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"net/http"
 )
 
 func (s *UserService) UserRegister(ctx context.Context, req *v1.UserRegisterReq) (*emptypb.Empty, error) {
@@ -15,123 +17,171 @@ func (s *UserService) UserRegister(ctx context.Context, req *v1.UserRegisterReq)
 }
 
 func (s *UserService) LoginByPassword(ctx context.Context, req *v1.LoginByPasswordReq) (*v1.LoginReply, error) {
-	token, err := s.ac.LoginByPassword(ctx, req.Account, req.Password, req.Mode)
+	token, err := s.ac.LoginByPassword(ctx, req.Account, req.Password, req.Mode, req.Device, req.Ip)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+		Challenge:    token.Challenge,
 	}, nil
 }
 
 func (s *UserService) LoginByCode(ctx context.Context, req *v1.LoginByCodeReq) (*v1.LoginReply, error) {
-	token, err := s.ac.LoginByCode(ctx, req.Phone, req.Code)
+	token, err := s.ac.LoginByCode(ctx, req.Phone, req.Code, req.Device, req.Ip)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+		Challenge:    token.Challenge,
 	}, nil
 }
 
-func (s *UserService) LoginByWeChat(ctx context.Context, req *v1.LoginByWeChatReq) (*v1.LoginReply, error) {
-	token, err := s.ac.LoginByWechat(ctx, req.Code)
+func (s *UserService) GetOauthUrl(ctx context.Context, req *v1.GetOauthUrlReq) (*v1.GetOauthUrlReply, error) {
+	url, state, err := s.ac.GetOauthUrl(ctx, req.Provider, req.RedirectUri)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.LoginReply{
-		Token: token,
+	return &v1.GetOauthUrlReply{
+		Url:   url,
+		State: state,
 	}, nil
 }
 
-func (s *UserService) LoginByQQ(ctx context.Context, req *v1.LoginByQQReq) (*v1.LoginReply, error) {
-	token, err := s.ac.LoginByQQ(ctx, req.Code)
+func (s *UserService) LoginByOauth(ctx context.Context, req *v1.LoginByOauthReq) (*v1.LoginReply, error) {
+	token, err := s.ac.LoginByOauth(ctx, req.Provider, req.Code, req.State, req.Device, req.Ip)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+		Challenge:    token.Challenge,
 	}, nil
 }
 
-func (s *UserService) LoginByGithub(ctx context.Context, req *v1.LoginByGithubReq) (*v1.LoginReply, error) {
-	github, err := s.ac.LoginByGithub(ctx, req.Code)
+func (s *UserService) LoginPasswordReset(ctx context.Context, req *v1.LoginPasswordResetReq) (*emptypb.Empty, error) {
+	err := s.ac.LoginPasswordReset(ctx, req.Account, req.Password, req.Code, req.Mode)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.LoginReply{
-		Token: github.Token,
-	}, nil
+	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) LoginByGitee(ctx context.Context, req *v1.LoginByGiteeReq) (*v1.LoginReply, error) {
-	token, err := s.ac.LoginByGitee(ctx, req.Code)
+func (s *UserService) RefreshToken(ctx context.Context, req *v1.RefreshTokenReq) (*v1.LoginReply, error) {
+	token, err := s.ac.RefreshToken(ctx, req.RefreshToken, req.Ip)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
 	}, nil
 }
 
-func (s *UserService) LoginPasswordReset(ctx context.Context, req *v1.LoginPasswordResetReq) (*emptypb.Empty, error) {
-	err := s.ac.LoginPasswordReset(ctx, req.Account, req.Password, req.Code, req.Mode)
+func (s *UserService) Logout(ctx context.Context, req *v1.LogoutReq) (*emptypb.Empty, error) {
+	err := s.ac.Logout(ctx, req.Uuid, req.Id)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) SetUserPhone(ctx context.Context, req *v1.SetUserPhoneReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserPhone(ctx, req.Uuid, req.Phone, req.Code)
+func (s *UserService) LogoutAllDevices(ctx context.Context, req *v1.LogoutAllDevicesReq) (*emptypb.Empty, error) {
+	err := s.ac.LogoutAllDevices(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) SetUserEmail(ctx context.Context, req *v1.SetUserEmailReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserEmail(ctx, req.Uuid, req.Email, req.Code)
+func (s *UserService) ListSessions(ctx context.Context, req *v1.ListSessionsReq) (*v1.ListSessionsReply, error) {
+	sessions, err := s.ac.ListSessions(ctx, req.Uuid)
 	if err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	reply := &v1.ListSessionsReply{Session: make([]*v1.ListSessionsReply_Session, 0, len(sessions))}
+	for _, item := range sessions {
+		reply.Session = append(reply.Session, &v1.ListSessionsReply_Session{
+			Id:      item.Id,
+			Device:  item.Device,
+			Ip:      item.Ip,
+			Created: item.Created,
+			Seen:    item.Seen,
+		})
+	}
+	return reply, nil
 }
 
-func (s *UserService) SetUserPassword(ctx context.Context, req *v1.SetUserPasswordReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserPassword(ctx, req.Uuid, req.Password)
+func (s *UserService) LoginByTotp(ctx context.Context, req *v1.LoginByTotpReq) (*v1.LoginReply, error) {
+	token, err := s.ac.LoginByTotp(ctx, req.Challenge, req.Code, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LoginReply{
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+	}, nil
+}
+
+func (s *UserService) EnrollTotp(ctx context.Context, req *v1.EnrollTotpReq) (*v1.EnrollTotpReply, error) {
+	enrollment, err := s.ac.EnrollTotp(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EnrollTotpReply{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.Uri,
+	}, nil
+}
+
+func (s *UserService) ConfirmTotp(ctx context.Context, req *v1.ConfirmTotpReq) (*v1.ConfirmTotpReply, error) {
+	codes, err := s.ac.ConfirmTotp(ctx, req.Uuid, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ConfirmTotpReply{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *UserService) DisableTotp(ctx context.Context, req *v1.DisableTotpReq) (*emptypb.Empty, error) {
+	err := s.ac.DisableTotp(ctx, req.Uuid, req.Code)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) SetUserWechat(ctx context.Context, req *v1.SetUserWechatReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserWechat(ctx, req.Uuid, req.Code)
+func (s *UserService) SetUserPhone(ctx context.Context, req *v1.SetUserPhoneReq) (*emptypb.Empty, error) {
+	err := s.ac.SetUserPhone(ctx, req.Uuid, req.Phone, req.Code)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) SetUserQQ(ctx context.Context, req *v1.SetUserQQReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserQQ(ctx, req.Uuid, req.Code)
+func (s *UserService) SetUserEmail(ctx context.Context, req *v1.SetUserEmailReq) (*emptypb.Empty, error) {
+	err := s.ac.SetUserEmail(ctx, req.Uuid, req.Email, req.Code)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) SetUserGitee(ctx context.Context, req *v1.SetUserGiteeReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserGitee(ctx, req.Uuid, req.Code, req.RedirectUrl)
+func (s *UserService) SetUserPassword(ctx context.Context, req *v1.SetUserPasswordReq) (*emptypb.Empty, error) {
+	err := s.ac.SetUserPassword(ctx, req.Uuid, req.Password)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) SetUserGithub(ctx context.Context, req *v1.SetUserGithubReq) (*emptypb.Empty, error) {
-	err := s.ac.SetUserGithub(ctx, req.Uuid, req.Code)
+func (s *UserService) SetUserIdentity(ctx context.Context, req *v1.SetUserIdentityReq) (*emptypb.Empty, error) {
+	err := s.ac.SetUserIdentity(ctx, req.Uuid, req.Provider, req.Code, req.State)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +189,7 @@ func (s *UserService) SetUserGithub(ctx context.Context, req *v1.SetUserGithubRe
 }
 
 func (s *UserService) SendPhoneCode(ctx context.Context, req *v1.SendPhoneCodeReq) (*emptypb.Empty, error) {
-	err := s.ac.SendPhoneCode(ctx, req.Template, req.Phone)
+	err := s.ac.SendPhoneCode(ctx, req.Template, req.Phone, req.Captcha)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +197,7 @@ func (s *UserService) SendPhoneCode(ctx context.Context, req *v1.SendPhoneCodeRe
 }
 
 func (s *UserService) SendEmailCode(ctx context.Context, req *v1.SendEmailCodeReq) (*emptypb.Empty, error) {
-	err := s.ac.SendEmailCode(ctx, req.Template, req.Email)
+	err := s.ac.SendEmailCode(ctx, req.Template, req.Email, req.Captcha)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +219,7 @@ func (s *UserService) GetCosSessionKey(ctx context.Context, req *v1.GetCosSessio
 }
 
 func (s *UserService) ChangeUserPassword(ctx context.Context, req *v1.ChangeUserPasswordReq) (*emptypb.Empty, error) {
-	err := s.ac.ChangeUserPassword(ctx, req.Uuid, req.Oldpassword, req.Password)
+	err := s.ac.ChangeUserPassword(ctx, req.Uuid, req.Oldpassword, req.Password, req.Code)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +227,7 @@ func (s *UserService) ChangeUserPassword(ctx context.Context, req *v1.ChangeUser
 }
 
 func (s *UserService) UnbindUserPhone(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.ac.UnbindUserPhone(ctx, req.Uuid, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+	err := s.ac.UnbindUserPhone(ctx, req.Uuid, req.Phone, req.Email, req.Password, req.Code, req.Choose, req.State)
 	if err != nil {
 		return nil, err
 	}
@@ -185,41 +235,42 @@ func (s *UserService) UnbindUserPhone(ctx context.Context, req *v1.UnbindUserAcc
 }
 
 func (s *UserService) UnbindUserEmail(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.ac.UnbindUserEmail(ctx, req.Uuid, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+	err := s.ac.UnbindUserEmail(ctx, req.Uuid, req.Phone, req.Email, req.Password, req.Code, req.Choose, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) UnbindUserWechat(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.ac.UnbindUserWechat(ctx, req.Uuid, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+func (s *UserService) UnbindUserIdentity(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
+	err := s.ac.UnbindUserIdentity(ctx, req.Uuid, req.Provider, req.Phone, req.Email, req.Password, req.Code, req.Choose, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) UnbindUserQQ(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.ac.UnbindUserQQ(ctx, req.Uuid, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+func (s *UserService) DeleteAccount(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
+	err := s.ac.DeleteAccount(ctx, req.Uuid, req.Phone, req.Email, req.Password, req.Code, req.Choose, req.State)
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *UserService) UnbindUserGitee(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.ac.UnbindUserGitee(ctx, req.Uuid, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+// Jwks serves the public keys access tokens are signed with. It is plain
+// http so that off-the-shelf JWKS clients can read it.
+func (s *UserService) Jwks(w http.ResponseWriter, _ *http.Request) {
+	set, err := s.ac.Jwks()
 	if err != nil {
-		return nil, err
+		s.log.Errorf("fail to build jwks: err(%v)", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
 	}
-	return &emptypb.Empty{}, nil
-}
-
-func (s *UserService) UnbindUserGithub(ctx context.Context, req *v1.UnbindUserAccountReq) (*emptypb.Empty, error) {
-	err := s.ac.UnbindUserGithub(ctx, req.Uuid, req.Phone, req.Email, req.Account, req.Password, req.Code, req.Choose, req.Mode, req.RedirectUri)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	err = json.NewEncoder(w).Encode(set)
 	if err != nil {
-		return nil, err
+		s.log.Errorf("fail to write jwks: err(%v)", err)
 	}
-	return &emptypb.Empty{}, nil
 }
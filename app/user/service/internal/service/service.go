@@ -15,14 +15,20 @@ type UserService struct {
 	v1.UnimplementedUserServer
 	uc  *biz.UserUseCase
 	ac  *biz.AuthUseCase
+	rc  *biz.RecommendUseCase
+	hc  *biz.HandleUseCase
+	adc *biz.AdminUseCase
 	log *log.Helper
 }
 
-func NewUserService(uc *biz.UserUseCase, ac *biz.AuthUseCase, logger log.Logger) *UserService {
+func NewUserService(uc *biz.UserUseCase, ac *biz.AuthUseCase, rc *biz.RecommendUseCase, hc *biz.HandleUseCase, adc *biz.AdminUseCase, logger log.Logger) *UserService {
 	return &UserService{
 		log: log.NewHelper(log.With(logger, "module", "user/service")),
 		uc:  uc,
 		ac:  ac,
+		rc:  rc,
+		hc:  hc,
+		adc: adc,
 	}
 }
 
@@ -13,13 +13,11 @@ func (s *UserService) GetAccount(ctx context.Context, req *v1.GetAccountReq) (*v
 		return nil, err
 	}
 	return &v1.GetAccountReply{
-		Phone:    user.Phone,
-		Email:    user.Email,
-		Qq:       user.Qq,
-		Wechat:   user.Wechat,
-		Gitee:    user.Gitee,
-		Github:   user.Github,
-		Password: user.Password,
+		Phone:      user.Phone,
+		Email:      user.Email,
+		Password:   user.Password,
+		Totp:       user.Totp,
+		Identities: user.Identities,
 	}, nil
 }
 
@@ -40,6 +38,7 @@ func (s *UserService) GetProfile(ctx context.Context, req *v1.GetProfileReq) (*v
 		Github:    profile.Github,
 		Gitee:     profile.Gitee,
 		Created:   profile.Created,
+		Private:   profile.Private,
 	}, nil
 }
 
@@ -83,8 +82,16 @@ func (s *UserService) GetUserFollow(ctx context.Context, req *v1.GetUserFollowRe
 	if err != nil {
 		return nil, err
 	}
+	var requested bool
+	if !follow {
+		requested, err = s.uc.GetFollowRequest(ctx, req.Uuid, req.UserUuid)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &v1.GetUserFollowReply{
-		Follow: follow,
+		Follow:    follow,
+		Requested: requested,
 	}, nil
 }
 
@@ -344,3 +351,203 @@ func (s *UserService) ProfileReviewNotPass(ctx context.Context, req *v1.ProfileR
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *UserService) ExportUserData(ctx context.Context, req *v1.ExportUserDataReq) (*v1.ExportUserDataReply, error) {
+	id, err := s.uc.ExportUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExportUserDataReply{
+		Id: id,
+	}, nil
+}
+
+func (s *UserService) GetUserDataExport(ctx context.Context, req *v1.GetUserDataExportReq) (*v1.GetUserDataExportReply, error) {
+	export, err := s.uc.GetUserDataExport(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserDataExportReply{
+		Id:    export.Id,
+		Ready: export.Ready,
+		Url:   export.Url,
+	}, nil
+}
+
+func (s *UserService) GetUserData(ctx context.Context, req *v1.GetUserDataReq) (*v1.GetUserDataReply, error) {
+	files, err := s.uc.GetUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetUserDataReply{Files: make([]*v1.GetUserDataReply_File, 0, len(files))}
+	for _, item := range files {
+		reply.Files = append(reply.Files, &v1.GetUserDataReply_File{
+			Name:    item.Name,
+			Content: item.Content,
+		})
+	}
+	return reply, nil
+}
+
+func (s *UserService) PurgeUserData(ctx context.Context, req *v1.PurgeUserDataReq) (*emptypb.Empty, error) {
+	err := s.uc.PurgeUserData(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) BlockUser(ctx context.Context, req *v1.BlockUserReq) (*emptypb.Empty, error) {
+	err := s.uc.BlockUser(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) UnblockUser(ctx context.Context, req *v1.UnblockUserReq) (*emptypb.Empty, error) {
+	err := s.uc.UnblockUser(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) MuteUser(ctx context.Context, req *v1.MuteUserReq) (*emptypb.Empty, error) {
+	err := s.uc.MuteUser(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) UnmuteUser(ctx context.Context, req *v1.UnmuteUserReq) (*emptypb.Empty, error) {
+	err := s.uc.UnmuteUser(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) SetProfilePrivate(ctx context.Context, req *v1.SetProfilePrivateReq) (*emptypb.Empty, error) {
+	err := s.uc.SetProfilePrivate(ctx, req.Uuid, req.Private)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) ApproveFollowRequest(ctx context.Context, req *v1.ApproveFollowRequestReq) (*emptypb.Empty, error) {
+	err := s.uc.ApproveFollowRequest(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) RejectFollowRequest(ctx context.Context, req *v1.RejectFollowRequestReq) (*emptypb.Empty, error) {
+	err := s.uc.RejectFollowRequest(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) GetUserBlock(ctx context.Context, req *v1.GetUserBlockReq) (*v1.GetUserBlockReply, error) {
+	blocked, muted, err := s.uc.GetUserBlock(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserBlockReply{
+		Blocked: blocked,
+		Muted:   muted,
+	}, nil
+}
+
+func (s *UserService) GetUserBlocks(ctx context.Context, req *v1.GetUserBlocksReq) (*v1.GetUserBlocksReply, error) {
+	blocked, muted, err := s.uc.GetUserBlocks(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserBlocksReply{
+		Blocked: blocked,
+		Muted:   muted,
+	}, nil
+}
+
+func (s *UserService) GetFollowRecommendations(ctx context.Context, req *v1.GetFollowRecommendationsReq) (*v1.GetFollowRecommendationsReply, error) {
+	recommendations, err := s.rc.GetFollowRecommendations(ctx, req.Page, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetFollowRecommendationsReply{Follow: make([]*v1.GetFollowRecommendationsReply_Follow, 0, len(recommendations))}
+	for _, item := range recommendations {
+		reply.Follow = append(reply.Follow, &v1.GetFollowRecommendationsReply_Follow{
+			Uuid: item,
+		})
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetFollowRequests(ctx context.Context, req *v1.GetFollowRequestsReq) (*v1.GetFollowRequestsReply, error) {
+	requests, err := s.uc.GetFollowRequests(ctx, req.Page, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetFollowRequestsReply{Follow: make([]*v1.GetFollowRequestsReply_Follow, 0, len(requests))}
+	for _, item := range requests {
+		reply.Follow = append(reply.Follow, &v1.GetFollowRequestsReply_Follow{
+			Uuid: item.Followed,
+		})
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetProfileVisible(ctx context.Context, req *v1.GetProfileVisibleReq) (*v1.GetProfileVisibleReply, error) {
+	visible, err := s.uc.GetProfileVisible(ctx, req.Uuid, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetProfileVisibleReply{
+		Visible: visible,
+	}, nil
+}
+
+func (s *UserService) SetUserHandle(ctx context.Context, req *v1.SetUserHandleReq) (*emptypb.Empty, error) {
+	err := s.hc.SetUserHandle(ctx, req.Uuid, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserService) GetUserHandle(ctx context.Context, req *v1.GetUserHandleReq) (*v1.GetUserHandleReply, error) {
+	handle, err := s.hc.GetUserHandle(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserHandleReply{
+		Handle:     handle.Handle,
+		Changeable: handle.Changeable,
+	}, nil
+}
+
+func (s *UserService) GetUserByHandle(ctx context.Context, req *v1.GetUserByHandleReq) (*v1.GetUserByHandleReply, error) {
+	uuid, err := s.hc.GetUserByHandle(ctx, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUserByHandleReply{
+		Uuid: uuid,
+	}, nil
+}
+
+func (s *UserService) GetHandleUuids(ctx context.Context, req *v1.GetHandleUuidsReq) (*v1.GetHandleUuidsReply, error) {
+	uuids, err := s.hc.GetHandleUuids(ctx, req.Handles)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetHandleUuidsReply{
+		Uuids: uuids,
+	}, nil
+}
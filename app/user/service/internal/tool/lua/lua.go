@@ -6,56 +6,14 @@ import (
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
+	"github.com/the-zion/matrix-core/app/user/service/internal/data"
 	"os"
 	"time"
 )
 
 var (
-	addr      string
-	password  string
-	scriptBox = map[string]string{
-		"SetAvatarIrregularToCache": `
-					local key = KEYS[1]
-					local value = ARGV[1]
-					local exist = redis.call("EXISTS", key)
-					if exist == 1 then
-						redis.call("LPUSH", key, value)
-					end
-					return 0
-	`,
-		"SetCoverIrregularToCache": `
-					local key = KEYS[1]
-					local value = ARGV[1]
-					local exist = redis.call("EXISTS", key)
-					if exist == 1 then
-						redis.call("LPUSH", key, value)
-					end
-					return 0
-	`,
-		"SetUserFollowToCache": `
-					local key = KEYS[1]
-					local value = ARGV[1]
-					local value2 =  ARGV[2]
-					local exist = redis.call("EXISTS", key)
-					if exist == 1 then
-						redis.call("SADD", key, value)
-					end
-
-					local followList = KEYS[2]
-					local score = ARGV[3]
-					local exist = redis.call("EXISTS", followList)
-					if exist == 1 then
-						redis.call("ZADD", followList, score, value)
-					end
-
-					local followedList = KEYS[3]
-					local exist = redis.call("EXISTS", followedList)
-					if exist == 1 then
-						redis.call("ZADD", followedList, score, value2)
-					end
-					return 0
-	`,
-	}
+	addr     string
+	password string
 )
 
 func NewRedis(logger log.Logger) redis.Cmdable {
@@ -84,7 +42,7 @@ func main() {
 }
 
 func ScriptLoad(r redis.Cmdable) {
-	for key, value := range scriptBox {
+	for key, value := range data.Scripts {
 		result, err := r.ScriptLoad(context.Background(), value).Result()
 		if err != nil {
 			fmt.Println(err)
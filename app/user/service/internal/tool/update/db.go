@@ -4,6 +4,8 @@ import (
 	"flag"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/the-zion/matrix-core/app/user/service/internal/data"
+	"github.com/the-zion/matrix-core/pkg/event"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"os"
@@ -22,19 +24,54 @@ func NewDB(logger log.Logger) *gorm.DB {
 	if err != nil {
 		l.Fatalf("failed opening connection to db: %v", err)
 	}
+	if err := event.DropSentOutbox(db); err != nil {
+		l.Fatalf("failed drop sent outbox: %v", err)
+	}
 	if err := db.AutoMigrate(
 		&data.User{},
 		&data.Profile{},
 		&data.ProfileUpdate{},
 		&data.Follow{},
+		&data.Block{},
+		&data.Handle{},
+		&data.Suspension{},
+		&data.AuditLog{},
 		&data.AvatarReview{},
 		&data.CoverReview{},
+		&data.Totp{},
+		&data.TotpRecovery{},
+		&data.UserIdentity{},
+		&event.Outbox{},
+		&idempotent.Processed{},
 	); err != nil {
 		l.Fatalf("failed creat or update table resources: %v", err)
 	}
+	backfillIdentities(db, l)
 	return db
 }
 
+// backfillIdentities copies the accounts bound in the columns users had per
+// provider into user_identities, under the provider of the same name. Running
+// it again skips the rows already copied; the old columns are left to drop by
+// hand.
+func backfillIdentities(db *gorm.DB, l *log.Helper) {
+	for _, column := range []string{"wechat", "qq", "gitee", "github"} {
+		if !db.Migrator().HasColumn(&data.User{}, column) {
+			continue
+		}
+		subject, bound := column, column+" <> ''"
+		if column == "gitee" || column == "github" {
+			subject, bound = "CAST("+column+" AS CHAR)", column+" <> 0"
+		}
+		result := db.Exec("INSERT IGNORE INTO user_identities (created_at, updated_at, uuid, provider, subject) "+
+			"SELECT NOW(), NOW(), uuid, ?, "+subject+" FROM users WHERE deleted_at IS NULL AND "+column+" IS NOT NULL AND "+bound, column)
+		if result.Error != nil {
+			l.Fatalf("failed backfill user identities: provider(%s), err(%v)", column, result.Error)
+		}
+		l.Infof("backfilled user identities: provider(%s), rows(%d)", column, result.RowsAffected)
+	}
+}
+
 func main() {
 	flag.Parse()
 	logger := log.NewStdLogger(os.Stdout)
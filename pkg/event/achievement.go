@@ -0,0 +1,95 @@
+package event
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	ModeAgree           = "agree"
+	ModeAgreeCancel     = "agree_cancel"
+	ModeView            = "view"
+	ModeCollect         = "collect"
+	ModeCollectCancel   = "collect_cancel"
+	ModeFollow          = "follow"
+	ModeFollowCancel    = "follow_cancel"
+	ModeAddScore        = "add_score"
+	ModeSetUserMedal    = "set_user_medal_db_and_cache"
+	ModeCancelUserMedal = "cancel_user_medal_db_and_cache"
+	ModeAccessUserMedal = "access_user_medal_db_and_cache"
+)
+
+func init() {
+	register(1, func() Event { return &Achievement{} }, ModeAgree, ModeAgreeCancel, ModeView, ModeCollect, ModeCollectCancel)
+	register(1, func() Event { return &AchievementFollow{} }, ModeFollow, ModeFollowCancel)
+	register(1, func() Event { return &Score{} }, ModeAddScore)
+	register(1, func() Event { return &Medal{} }, ModeSetUserMedal, ModeCancelUserMedal, ModeAccessUserMedal)
+}
+
+// Achievement moves the agree, view and collect counters of the author Uuid.
+// UserUuid is the acting user and is only carried by agree modes. Count is
+// the number of moves the event stands for when producers batch them, zero
+// means one.
+//
+//easyjson:json
+type Achievement struct {
+	Header
+	Uuid     string
+	UserUuid string
+	Count    int32 `json:",omitempty"`
+}
+
+// Times returns the number of moves of e.
+func (e *Achievement) Times() int32 {
+	if e.Count == 0 {
+		return 1
+	}
+	return e.Count
+}
+
+func (e *Achievement) Validate() error {
+	err := present("uuid", e.Uuid)
+	if err != nil {
+		return err
+	}
+	if e.Count < 0 {
+		return errors.Errorf("count must not be negative, got %v", e.Count)
+	}
+	switch e.Mode {
+	case ModeAgree, ModeAgreeCancel:
+		return present("userUuid", e.UserUuid)
+	}
+	return nil
+}
+
+//easyjson:json
+type AchievementFollow struct {
+	Header
+	Follow   string
+	Followed string
+}
+
+func (e *AchievementFollow) Validate() error {
+	return first(present("follow", e.Follow), present("followed", e.Followed))
+}
+
+//easyjson:json
+type Score struct {
+	Header
+	Uuid  string
+	Score int32
+}
+
+func (e *Score) Validate() error {
+	return first(present("uuid", e.Uuid), positive("score", e.Score))
+}
+
+//easyjson:json
+type Medal struct {
+	Header
+	Medal string
+	Uuid  string
+}
+
+func (e *Medal) Validate() error {
+	return first(present("medal", e.Medal), present("uuid", e.Uuid))
+}
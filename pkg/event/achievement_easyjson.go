@@ -0,0 +1,402 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package event
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent(in *jlexer.Lexer, out *Score) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "score":
+			out.Score = int32(in.Int32())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent(out *jwriter.Writer, in Score) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Score))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Score) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Score) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Score) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Score) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent(l, v)
+}
+func easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent1(in *jlexer.Lexer, out *Medal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "medal":
+			out.Medal = string(in.String())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent1(out *jwriter.Writer, in Medal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"medal\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Medal))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Medal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Medal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Medal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Medal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent1(l, v)
+}
+func easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent2(in *jlexer.Lexer, out *AchievementFollow) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "follow":
+			out.Follow = string(in.String())
+		case "followed":
+			out.Followed = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent2(out *jwriter.Writer, in AchievementFollow) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"follow\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Follow))
+	}
+	{
+		const prefix string = ",\"followed\":"
+		out.RawString(prefix)
+		out.String(string(in.Followed))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AchievementFollow) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AchievementFollow) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AchievementFollow) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AchievementFollow) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent2(l, v)
+}
+func easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent3(in *jlexer.Lexer, out *Achievement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "userUuid":
+			out.UserUuid = string(in.String())
+		case "count":
+			out.Count = int32(in.Int32())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent3(out *jwriter.Writer, in Achievement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"userUuid\":"
+		out.RawString(prefix)
+		out.String(string(in.UserUuid))
+	}
+	if in.Count != 0 {
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Count))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Achievement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Achievement) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson62cbe3bEncodeGithubComTheZionMatrixCorePkgEvent3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Achievement) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Achievement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson62cbe3bDecodeGithubComTheZionMatrixCorePkgEvent3(l, v)
+}
@@ -0,0 +1,66 @@
+package event
+
+import (
+	"fmt"
+)
+
+const (
+	ModeCreateComment         = "create_comment_db_and_cache"
+	ModeCreateSubComment      = "create_sub_comment_db_and_cache"
+	ModeRemoveComment         = "remove_comment_db_and_cache"
+	ModeRemoveSubComment      = "remove_sub_comment_db_and_cache"
+	ModeSetCommentAgree       = "set_comment_agree_db_and_cache"
+	ModeSetSubCommentAgree    = "set_sub_comment_agree_db_and_cache"
+	ModeCancelCommentAgree    = "cancel_comment_agree_db_and_cache"
+	ModeCancelSubCommentAgree = "cancel_sub_comment_agree_db_and_cache"
+)
+
+func init() {
+	register(1, func() Event { return &Comment{} },
+		ModeCreateComment, ModeCreateSubComment, ModeRemoveComment, ModeRemoveSubComment,
+		ModeSetCommentAgree, ModeSetSubCommentAgree, ModeCancelCommentAgree, ModeCancelSubCommentAgree)
+}
+
+// Comment creates, removes or agrees a comment. Root comments carry the
+// creation they belong to, sub comments carry RootId and ParentId, and agree
+// modes carry the acting UserUuid.
+//
+//easyjson:json
+type Comment struct {
+	Header
+	Id           int32
+	CreationId   int32
+	CreationType int32
+	RootId       int32
+	ParentId     int32
+	Uuid         string
+	UserUuid     string
+}
+
+func (e *Comment) Validate() error {
+	err := first(positive("id", e.Id), present("uuid", e.Uuid))
+	if err != nil {
+		return err
+	}
+	switch e.Mode {
+	case ModeCreateComment:
+		return first(positive("creationId", e.CreationId), positive("creationType", e.CreationType))
+	case ModeCreateSubComment:
+		return positive("rootId", e.RootId)
+	case ModeSetCommentAgree, ModeCancelCommentAgree:
+		return first(positive("creationId", e.CreationId), positive("creationType", e.CreationType), present("userUuid", e.UserUuid))
+	case ModeSetSubCommentAgree, ModeCancelSubCommentAgree:
+		return present("userUuid", e.UserUuid)
+	}
+	return nil
+}
+
+// orderKey keeps the creation and removal of one comment in order; agrees only
+// move counters.
+func (e *Comment) orderKey() string {
+	switch e.Mode {
+	case ModeCreateComment, ModeCreateSubComment, ModeRemoveComment, ModeRemoveSubComment:
+		return fmt.Sprintf("comment:%d", e.Id)
+	}
+	return ""
+}
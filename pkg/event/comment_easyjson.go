@@ -0,0 +1,148 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package event
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE9abebc9DecodeGithubComTheZionMatrixCorePkgEvent(in *jlexer.Lexer, out *Comment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "creationId":
+			out.CreationId = int32(in.Int32())
+		case "creationType":
+			out.CreationType = int32(in.Int32())
+		case "rootId":
+			out.RootId = int32(in.Int32())
+		case "parentId":
+			out.ParentId = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "userUuid":
+			out.UserUuid = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE9abebc9EncodeGithubComTheZionMatrixCorePkgEvent(out *jwriter.Writer, in Comment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"creationId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CreationId))
+	}
+	{
+		const prefix string = ",\"creationType\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CreationType))
+	}
+	{
+		const prefix string = ",\"rootId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.RootId))
+	}
+	{
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ParentId))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"userUuid\":"
+		out.RawString(prefix)
+		out.String(string(in.UserUuid))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Comment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE9abebc9EncodeGithubComTheZionMatrixCorePkgEvent(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE9abebc9EncodeGithubComTheZionMatrixCorePkgEvent(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Comment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE9abebc9DecodeGithubComTheZionMatrixCorePkgEvent(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE9abebc9DecodeGithubComTheZionMatrixCorePkgEvent(l, v)
+}
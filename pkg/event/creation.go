@@ -0,0 +1,135 @@
+package event
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ModeCreateArticle         = "create_article_db_cache_and_search"
+	ModeEditArticle           = "edit_article_cos_and_search"
+	ModeDeleteArticle         = "delete_article_cache_and_search"
+	ModeSetArticleView        = "set_article_view_db_and_cache"
+	ModeSetArticleAgree       = "set_article_agree_db_and_cache"
+	ModeCancelArticleAgree    = "cancel_article_agree_db_and_cache"
+	ModeSetArticleCollect     = "set_article_collect_db_and_cache"
+	ModeCancelArticleCollect  = "cancel_article_collect_db_and_cache"
+	ModeCreateTalk            = "create_talk_db_cache_and_search"
+	ModeEditTalk              = "edit_talk_cos_and_search"
+	ModeDeleteTalk            = "delete_talk_cache_and_search"
+	ModeSetTalkView           = "set_talk_view_db_and_cache"
+	ModeSetTalkAgree          = "set_talk_agree_db_and_cache"
+	ModeCancelTalkAgree       = "cancel_talk_agree_db_and_cache"
+	ModeSetTalkCollect        = "set_talk_collect_db_and_cache"
+	ModeCancelTalkCollect     = "cancel_talk_collect_db_and_cache"
+	ModeCreateColumn          = "create_column_db_cache_and_search"
+	ModeEditColumn            = "edit_column_cos_and_search"
+	ModeDeleteColumn          = "delete_column_cache_and_search"
+	ModeSetColumnView         = "set_column_view_db_and_cache"
+	ModeSetColumnAgree        = "set_column_agree_db_and_cache"
+	ModeCancelColumnAgree     = "cancel_column_agree_db_and_cache"
+	ModeSetColumnCollect      = "set_column_collect_db_and_cache"
+	ModeCancelColumnCollect   = "cancel_column_collect_db_and_cache"
+	ModeAddColumnIncludes     = "add_column_includes_db_and_cache"
+	ModeDeleteColumnIncludes  = "delete_column_includes_db_and_cache"
+	ModeSetColumnSubscribe    = "set_column_subscribe_db_and_cache"
+	ModeCancelColumnSubscribe = "cancel_column_subscribe_db_and_cache"
+	ModeCreateCollections     = "create_collections_db_and_cache"
+	ModeEditCollections       = "edit_collections_cos"
+	ModeDeleteCollections     = "delete_collections_cache"
+)
+
+func init() {
+	register(1, func() Event { return &Creation{} },
+		ModeCreateArticle, ModeEditArticle, ModeDeleteArticle,
+		ModeCreateTalk, ModeEditTalk, ModeDeleteTalk,
+		ModeCreateColumn, ModeEditColumn, ModeDeleteColumn,
+		ModeCreateCollections, ModeEditCollections, ModeDeleteCollections)
+	register(1, func() Event { return &Statistic{} },
+		ModeSetArticleView, ModeSetArticleAgree, ModeCancelArticleAgree, ModeSetArticleCollect, ModeCancelArticleCollect,
+		ModeSetTalkView, ModeSetTalkAgree, ModeCancelTalkAgree, ModeSetTalkCollect, ModeCancelTalkCollect,
+		ModeSetColumnView, ModeSetColumnAgree, ModeCancelColumnAgree, ModeSetColumnCollect, ModeCancelColumnCollect)
+	register(1, func() Event { return &ColumnIncludes{} }, ModeAddColumnIncludes, ModeDeleteColumnIncludes)
+	register(1, func() Event { return &ColumnSubscribe{} }, ModeSetColumnSubscribe, ModeCancelColumnSubscribe)
+}
+
+// Creation publishes, edits or removes an article, talk, column or collections.
+//
+//easyjson:json
+type Creation struct {
+	Header
+	Id   int32
+	Auth int32
+	Uuid string
+}
+
+func (e *Creation) Validate() error {
+	return first(positive("id", e.Id), present("uuid", e.Uuid))
+}
+
+// orderKey keeps the create, edit and delete of one creation in order. The
+// kind is the second word of the mode, e.g. article in create_article_...
+func (e *Creation) orderKey() string {
+	kind := strings.SplitN(e.Mode, "_", 3)[1]
+	return fmt.Sprintf("%s:%d", kind, e.Id)
+}
+
+// Statistic moves the view, agree or collect counter of a creation. UserUuid
+// is the acting user and is empty for views. Repeat marks a view by a viewer
+// already counted within the view window; it only moves the raw view count.
+// Counter moves commute, so statistics are consumed in any order.
+//
+//easyjson:json
+type Statistic struct {
+	Header
+	Id            int32
+	CollectionsId int32
+	Uuid          string
+	UserUuid      string
+	Repeat        bool `json:",omitempty"`
+}
+
+func (e *Statistic) Validate() error {
+	err := first(positive("id", e.Id), present("uuid", e.Uuid))
+	if err != nil {
+		return err
+	}
+	switch e.Mode {
+	case ModeSetArticleView, ModeSetTalkView, ModeSetColumnView:
+		return nil
+	case ModeSetArticleCollect, ModeSetTalkCollect, ModeSetColumnCollect:
+		return first(positive("collectionsId", e.CollectionsId), present("userUuid", e.UserUuid))
+	}
+	return present("userUuid", e.UserUuid)
+}
+
+//easyjson:json
+type ColumnIncludes struct {
+	Header
+	Id        int32
+	ArticleId int32
+	Uuid      string
+}
+
+func (e *ColumnIncludes) Validate() error {
+	return first(positive("id", e.Id), positive("articleId", e.ArticleId), present("uuid", e.Uuid))
+}
+
+func (e *ColumnIncludes) orderKey() string {
+	return fmt.Sprintf("column:%d", e.Id)
+}
+
+//easyjson:json
+type ColumnSubscribe struct {
+	Header
+	Id   int32
+	Uuid string
+}
+
+func (e *ColumnSubscribe) Validate() error {
+	return first(positive("id", e.Id), present("uuid", e.Uuid))
+}
+
+func (e *ColumnSubscribe) orderKey() string {
+	return fmt.Sprintf("column:%d", e.Id)
+}
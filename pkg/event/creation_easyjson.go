@@ -0,0 +1,430 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package event
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent(in *jlexer.Lexer, out *Statistic) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "collectionsId":
+			out.CollectionsId = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "userUuid":
+			out.UserUuid = string(in.String())
+		case "repeat":
+			out.Repeat = bool(in.Bool())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent(out *jwriter.Writer, in Statistic) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"collectionsId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CollectionsId))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"userUuid\":"
+		out.RawString(prefix)
+		out.String(string(in.UserUuid))
+	}
+	if in.Repeat {
+		const prefix string = ",\"repeat\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Repeat))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Statistic) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Statistic) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Statistic) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Statistic) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent(l, v)
+}
+func easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent1(in *jlexer.Lexer, out *Creation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "auth":
+			out.Auth = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent1(out *jwriter.Writer, in Creation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"auth\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Auth))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Creation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Creation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Creation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Creation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent1(l, v)
+}
+func easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent2(in *jlexer.Lexer, out *ColumnSubscribe) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent2(out *jwriter.Writer, in ColumnSubscribe) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ColumnSubscribe) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ColumnSubscribe) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ColumnSubscribe) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ColumnSubscribe) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent2(l, v)
+}
+func easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent3(in *jlexer.Lexer, out *ColumnIncludes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "articleId":
+			out.ArticleId = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent3(out *jwriter.Writer, in ColumnIncludes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"articleId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.ArticleId))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ColumnIncludes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ColumnIncludes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9cd577fEncodeGithubComTheZionMatrixCorePkgEvent3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ColumnIncludes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ColumnIncludes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9cd577fDecodeGithubComTheZionMatrixCorePkgEvent3(l, v)
+}
@@ -0,0 +1,180 @@
+package event
+
+import (
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+)
+
+// Topic is the RocketMQ topic every matrix service publishes to.
+const Topic = "matrix"
+
+// Version is the schema version stamped on every event produced by this build.
+// Bump it together with the minimum version of the affected kinds whenever a
+// payload changes incompatibly.
+const Version int32 = 1
+
+// legacyVersion is carried by messages from producers that predate the
+// version field. Their layout is identical to version 1.
+const legacyVersion int32 = 0
+
+var (
+	ErrMalformed   = errors.New("malformed event")
+	ErrUnknownMode = errors.New("unknown event mode")
+	ErrOutdated    = errors.New("outdated event version")
+	ErrUnsupported = errors.New("unsupported event version")
+)
+
+// Header is carried by every event. Key identifies one logical event across
+// redeliveries and replays so that consumers can drop duplicates; legacy
+// producers leave it empty.
+//
+//easyjson:json
+type Header struct {
+	Mode    string
+	Version int32
+	Key     string
+}
+
+func (h *Header) header() *Header {
+	return h
+}
+
+// Event is a typed payload published to the matrix topic.
+type Event interface {
+	easyjson.Marshaler
+	easyjson.Unmarshaler
+	header() *Header
+	Validate() error
+}
+
+type kind struct {
+	minVersion int32
+	new        func() Event
+}
+
+var kinds = map[string]kind{}
+
+func register(minVersion int32, fn func() Event, modes ...string) {
+	for _, mode := range modes {
+		if _, ok := kinds[mode]; ok {
+			panic("event: mode registered twice: " + mode)
+		}
+		kinds[mode] = kind{minVersion: minVersion, new: fn}
+	}
+}
+
+// Mode returns the mode an event was produced with.
+func Mode(e Event) string {
+	return e.header().Mode
+}
+
+// Key returns the idempotency key of an event.
+func Key(e Event) string {
+	return e.header().Key
+}
+
+// OrderKey returns the entity whose events are consumed in the order they were
+// published, e.g. "article:12", or "" if e may be consumed in any order.
+func OrderKey(e Event) string {
+	if o, ok := e.(interface{ orderKey() string }); ok {
+		return o.orderKey()
+	}
+	return ""
+}
+
+// New returns an empty event of the kind registered for mode.
+func New(mode string) (Event, error) {
+	k, ok := kinds[mode]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownMode, "mode(%s)", mode)
+	}
+	e := k.new()
+	e.header().Mode = mode
+	return e, nil
+}
+
+// Encode stamps the current version and, unless set, a fresh idempotency key
+// on e, validates it and returns its wire form.
+func Encode(e Event) ([]byte, error) {
+	h := e.header()
+	if _, ok := kinds[h.Mode]; !ok {
+		return nil, errors.Wrapf(ErrUnknownMode, "mode(%s)", h.Mode)
+	}
+	h.Version = Version
+	if h.Key == "" {
+		h.Key = xid.New().String()
+	}
+	if err := e.Validate(); err != nil {
+		return nil, errors.Wrapf(ErrMalformed, "mode(%s): %s", h.Mode, err.Error())
+	}
+	return easyjson.Marshal(e)
+}
+
+// Decode parses and validates a message body. The returned error wraps one of
+// ErrMalformed, ErrUnknownMode, ErrOutdated or ErrUnsupported.
+func Decode(body []byte) (Event, error) {
+	h := &Header{}
+	if err := easyjson.Unmarshal(body, h); err != nil {
+		return nil, errors.Wrapf(ErrMalformed, "header: %s", err.Error())
+	}
+	if h.Mode == "" {
+		return nil, errors.Wrap(ErrMalformed, "header: mode is empty")
+	}
+	k, ok := kinds[h.Mode]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownMode, "mode(%s)", h.Mode)
+	}
+	version := h.Version
+	if version == legacyVersion {
+		version = 1
+	}
+	if version < k.minVersion {
+		return nil, errors.Wrapf(ErrOutdated, "mode(%s), version(%v), min(%v)", h.Mode, version, k.minVersion)
+	}
+	if version > Version {
+		return nil, errors.Wrapf(ErrUnsupported, "mode(%s), version(%v), max(%v)", h.Mode, version, Version)
+	}
+	e := k.new()
+	if err := easyjson.Unmarshal(body, e); err != nil {
+		return nil, errors.Wrapf(ErrMalformed, "mode(%s): %s", h.Mode, err.Error())
+	}
+	e.header().Version = version
+	if err := e.Validate(); err != nil {
+		return nil, errors.Wrapf(ErrMalformed, "mode(%s): %s", h.Mode, err.Error())
+	}
+	return e, nil
+}
+
+// Retryable reports whether a decode error may go away on redelivery, which is
+// only the case when a newer producer is rolled out ahead of this consumer.
+func Retryable(err error) bool {
+	return errors.Is(err, ErrUnsupported)
+}
+
+func required(field string) error {
+	return errors.Errorf("%s is required", field)
+}
+
+func positive(field string, v int32) error {
+	if v <= 0 {
+		return errors.Errorf("%s must be positive, got %v", field, v)
+	}
+	return nil
+}
+
+func present(field, v string) error {
+	if v == "" {
+		return required(field)
+	}
+	return nil
+}
+
+func first(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,6 @@
 // Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
 
-package biz
+package event
 
 import (
 	json "encoding/json"
@@ -17,7 +17,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppAchievementServiceInternalBiz(in *jlexer.Lexer, out *CommentMap) {
+func easyjsonF642ad3eDecodeGithubComTheZionMatrixCorePkgEvent(in *jlexer.Lexer, out *Header) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -36,12 +36,12 @@ func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppAchievementServiceIntern
 			continue
 		}
 		switch key {
-		case "uuid":
-			out.Uuid = string(in.String())
-		case "medal":
-			out.Medal = string(in.String())
 		case "mode":
 			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -52,48 +52,48 @@ func easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppAchievementServiceIntern
 		in.Consumed()
 	}
 }
-func easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppAchievementServiceInternalBiz(out *jwriter.Writer, in CommentMap) {
+func easyjsonF642ad3eEncodeGithubComTheZionMatrixCorePkgEvent(out *jwriter.Writer, in Header) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"uuid\":"
+		const prefix string = ",\"mode\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Uuid))
+		out.String(string(in.Mode))
 	}
 	{
-		const prefix string = ",\"medal\":"
+		const prefix string = ",\"version\":"
 		out.RawString(prefix)
-		out.String(string(in.Medal))
+		out.Int32(int32(in.Version))
 	}
 	{
-		const prefix string = ",\"mode\":"
+		const prefix string = ",\"key\":"
 		out.RawString(prefix)
-		out.String(string(in.Mode))
+		out.String(string(in.Key))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommentMap) MarshalJSON() ([]byte, error) {
+func (v Header) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppAchievementServiceInternalBiz(&w, v)
+	easyjsonF642ad3eEncodeGithubComTheZionMatrixCorePkgEvent(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommentMap) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson899f4d6bEncodeGithubComTheZionMatrixCoreAppAchievementServiceInternalBiz(w, v)
+func (v Header) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeGithubComTheZionMatrixCorePkgEvent(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommentMap) UnmarshalJSON(data []byte) error {
+func (v *Header) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppAchievementServiceInternalBiz(&r, v)
+	easyjsonF642ad3eDecodeGithubComTheZionMatrixCorePkgEvent(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommentMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson899f4d6bDecodeGithubComTheZionMatrixCoreAppAchievementServiceInternalBiz(l, v)
+func (v *Header) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeGithubComTheZionMatrixCorePkgEvent(l, v)
 }
@@ -0,0 +1,159 @@
+package event
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// Outbox is an event waiting to be published. It is written in the same
+// transaction as the state change that caused it, published by a Relay after
+// that transaction commits and deleted once published.
+type Outbox struct {
+	gorm.Model
+	Mode   string `gorm:"size:100"`
+	MsgKey string `gorm:"size:100"`
+	Body   string `gorm:"type:text"`
+}
+
+func (Outbox) TableName() string {
+	return "outbox"
+}
+
+// DropSentOutbox deletes the rows relays used to keep flagged as sent after
+// publishing them, and the flag with them. It is run by the update tools
+// ahead of migrating Outbox.
+func DropSentOutbox(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&Outbox{}) || !db.Migrator().HasColumn(&Outbox{}, "sent") {
+		return nil
+	}
+	err := db.Exec("DELETE FROM outbox WHERE sent = ?", true).Error
+	if err != nil {
+		return errors.Wrapf(err, "fail to delete sent outbox")
+	}
+	err = db.Migrator().DropColumn(&Outbox{}, "sent")
+	if err != nil {
+		return errors.Wrapf(err, "fail to drop outbox column: sent")
+	}
+	return nil
+}
+
+// Store encodes e and adds it to the outbox through db, which should be the
+// transaction of the surrounding state change if there is one. An event
+// stored on behalf of an idempotency key in ctx is keyed after it.
+func Store(ctx context.Context, db *gorm.DB, e Event, key string) error {
+	derive(ctx, e)
+	body, err := Encode(e)
+	if err != nil {
+		return err
+	}
+	return db.Create(&Outbox{
+		Mode:   Mode(e),
+		MsgKey: key,
+		Body:   string(body),
+	}).Error
+}
+
+const (
+	relayInterval = 500 * time.Millisecond
+	relayBatch    = 100
+)
+
+// Relay publishes the pending outbox rows in insertion order and deletes
+// them. Rows are claimed with SKIP LOCKED so that every replica of a service
+// can run a relay; an event published twice after a crash is dropped by the
+// consumer through its idempotency key.
+type Relay struct {
+	db       *gorm.DB
+	producer *Producer
+	notify   chan struct{}
+	done     chan struct{}
+	stopped  chan struct{}
+	log      *log.Helper
+}
+
+func NewRelay(db *gorm.DB, producer *Producer, logger log.Logger) *Relay {
+	return &Relay{
+		db:       db,
+		producer: producer,
+		notify:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+		log:      log.NewHelper(log.With(logger, "module", "event/outbox-relay")),
+	}
+}
+
+func (r *Relay) Start() {
+	go r.run()
+}
+
+// Stop flushes what it can and waits for the relay to exit.
+func (r *Relay) Stop() {
+	close(r.done)
+	<-r.stopped
+}
+
+// Notify wakes the relay up ahead of its next tick, e.g. right after a store.
+func (r *Relay) Notify() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Relay) run() {
+	defer close(r.stopped)
+	ticker := time.NewTicker(relayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.done:
+			r.drain()
+			return
+		case <-ticker.C:
+		case <-r.notify:
+		}
+		r.drain()
+	}
+}
+
+func (r *Relay) drain() {
+	for {
+		n, err := r.flush(context.Background())
+		if err != nil {
+			r.log.Errorf("fail to relay outbox: %s", err.Error())
+			return
+		}
+		if n < relayBatch {
+			return
+		}
+	}
+}
+
+func (r *Relay) flush(ctx context.Context) (int, error) {
+	var n int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var list []*Outbox
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Order("id asc").Limit(relayBatch).Find(&list).Error
+		if err != nil {
+			return errors.Wrapf(err, "fail to get pending outbox")
+		}
+		for _, item := range list {
+			err = r.producer.Resend(ctx, []byte(item.Body), item.MsgKey)
+			if err != nil {
+				return errors.Wrapf(err, "fail to publish outbox: id(%v), mode(%s)", item.ID, item.Mode)
+			}
+			err = tx.Unscoped().Delete(item).Error
+			if err != nil {
+				return errors.Wrapf(err, "fail to delete sent outbox: id(%v)", item.ID)
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+)
+
+// Producer publishes validated events to the matrix topic.
+type Producer struct {
+	transport Transport
+}
+
+func NewProducer(t Transport) *Producer {
+	return &Producer{
+		transport: t,
+	}
+}
+
+// Send encodes e and publishes it with key as the message key. An event sent
+// on behalf of an idempotency key in ctx is keyed after it.
+func (p *Producer) Send(ctx context.Context, e Event, key string) error {
+	derive(ctx, e)
+	body, err := Encode(e)
+	if err != nil {
+		return err
+	}
+	return p.transport.Publish(ctx, Topic, body, key, OrderKey(e))
+}
+
+// Resend publishes an encoded body as is, e.g. an outbox row or a replayed
+// dead letter. The order key is read back from the body when it still
+// decodes.
+func (p *Producer) Resend(ctx context.Context, body []byte, key string) error {
+	var orderKey string
+	if e, err := Decode(body); err == nil {
+		orderKey = OrderKey(e)
+	}
+	return p.transport.Publish(ctx, Topic, body, key, orderKey)
+}
+
+// derive keys e, unless it has a key of its own, with a child of the
+// idempotency key in ctx, so that an event redelivered downstream is sent
+// again under the key it was first sent with.
+func derive(ctx context.Context, e Event) {
+	h := e.header()
+	if h.Key == "" {
+		h.Key = idempotent.Child(ctx, h.Mode)
+	}
+}
+
+func (p *Producer) Shutdown() error {
+	return p.transport.Close()
+}
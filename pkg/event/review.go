@@ -0,0 +1,117 @@
+package event
+
+const (
+	ModeArticleCreateReview         = "article_create_review"
+	ModeArticleEditReview           = "article_edit_review"
+	ModeTalkCreateReview            = "talk_create_review"
+	ModeTalkEditReview              = "talk_edit_review"
+	ModeColumnCreateReview          = "column_create_review"
+	ModeColumnEditReview            = "column_edit_review"
+	ModeCollectionsCreateReview     = "collections_create_review"
+	ModeCollectionsEditReview       = "collections_edit_review"
+	ModeCommentReview               = "comment_review"
+	ModeSubCommentReview            = "sub_comment_review"
+	ModeAddArticleImageReview       = "add_article_image_review_db_and_cache"
+	ModeAddTalkImageReview          = "add_talk_image_review_db_and_cache"
+	ModeAddColumnImageReview        = "add_column_image_review_db_and_cache"
+	ModeAddAvatarReview             = "add_avatar_review_db_and_cache"
+	ModeAddCoverReview              = "add_cover_review_db_and_cache"
+	ModeAddArticleContentReview     = "add_article_content_review_db_and_cache"
+	ModeAddTalkContentReview        = "add_talk_content_review_db_and_cache"
+	ModeAddColumnContentReview      = "add_column_content_review_db_and_cache"
+	ModeAddCollectionsContentReview = "add_collections_content_review_db_and_cache"
+	ModeAddCommentContentReview     = "add_comment_content_review_db_and_cache"
+)
+
+func init() {
+	register(1, func() Event { return &Review{} },
+		ModeArticleCreateReview, ModeArticleEditReview,
+		ModeTalkCreateReview, ModeTalkEditReview,
+		ModeColumnCreateReview, ModeColumnEditReview,
+		ModeCollectionsCreateReview, ModeCollectionsEditReview,
+		ModeCommentReview, ModeSubCommentReview)
+	register(1, func() Event { return &ImageReview{} },
+		ModeAddArticleImageReview, ModeAddTalkImageReview, ModeAddColumnImageReview,
+		ModeAddAvatarReview, ModeAddCoverReview)
+	register(1, func() Event { return &TextReview{} },
+		ModeAddArticleContentReview, ModeAddTalkContentReview, ModeAddColumnContentReview,
+		ModeAddCollectionsContentReview, ModeAddCommentContentReview)
+}
+
+// Review asks the message service to submit the draft Id of Uuid to the
+// machine review.
+//
+//easyjson:json
+type Review struct {
+	Header
+	Id   int32
+	Uuid string
+}
+
+func (e *Review) Validate() error {
+	return first(positive("id", e.Id), present("uuid", e.Uuid))
+}
+
+// ImageReview records an irregular image found by the machine review. Avatar
+// and cover reviews carry no CreationId.
+//
+//easyjson:json
+type ImageReview struct {
+	Header
+	Id         int32
+	CreationId int32
+	Kind       string
+	Uid        string
+	CreateAt   string
+	Uuid       string
+	JobId      string
+	Url        string
+	Label      string
+	Result     int32
+	Category   string
+	SubLabel   string
+	Score      int32
+}
+
+func (e *ImageReview) Validate() error {
+	err := first(present("uuid", e.Uuid), present("jobId", e.JobId))
+	if err != nil {
+		return err
+	}
+	switch e.Mode {
+	case ModeAddAvatarReview, ModeAddCoverReview:
+		return nil
+	}
+	return positive("creationId", e.CreationId)
+}
+
+// TextReview records irregular text found by the machine review. Comment
+// reviews carry CommentId and Comment instead of CreationId and Title.
+//
+//easyjson:json
+type TextReview struct {
+	Header
+	Id         int32
+	CreationId int32
+	CommentId  int32
+	CreateAt   string
+	Title      string
+	Comment    string
+	Kind       string
+	JobId      string
+	Label      string
+	Result     int32
+	Uuid       string
+	Section    string
+}
+
+func (e *TextReview) Validate() error {
+	err := first(present("uuid", e.Uuid), present("jobId", e.JobId))
+	if err != nil {
+		return err
+	}
+	if e.Mode == ModeAddCommentContentReview {
+		return positive("commentId", e.CommentId)
+	}
+	return positive("creationId", e.CreationId)
+}
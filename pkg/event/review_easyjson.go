@@ -0,0 +1,448 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package event
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent(in *jlexer.Lexer, out *TextReview) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "creationId":
+			out.CreationId = int32(in.Int32())
+		case "commentId":
+			out.CommentId = int32(in.Int32())
+		case "createAt":
+			out.CreateAt = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "comment":
+			out.Comment = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "jobId":
+			out.JobId = string(in.String())
+		case "label":
+			out.Label = string(in.String())
+		case "result":
+			out.Result = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "section":
+			out.Section = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent(out *jwriter.Writer, in TextReview) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"creationId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CreationId))
+	}
+	{
+		const prefix string = ",\"commentId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CommentId))
+	}
+	{
+		const prefix string = ",\"createAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreateAt))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"jobId\":"
+		out.RawString(prefix)
+		out.String(string(in.JobId))
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Result))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"section\":"
+		out.RawString(prefix)
+		out.String(string(in.Section))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TextReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TextReview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TextReview) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TextReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent(l, v)
+}
+func easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent1(in *jlexer.Lexer, out *Review) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent1(out *jwriter.Writer, in Review) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Review) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Review) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Review) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Review) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent1(l, v)
+}
+func easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent2(in *jlexer.Lexer, out *ImageReview) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int32(in.Int32())
+		case "creationId":
+			out.CreationId = int32(in.Int32())
+		case "kind":
+			out.Kind = string(in.String())
+		case "uid":
+			out.Uid = string(in.String())
+		case "createAt":
+			out.CreateAt = string(in.String())
+		case "uuid":
+			out.Uuid = string(in.String())
+		case "jobId":
+			out.JobId = string(in.String())
+		case "url":
+			out.Url = string(in.String())
+		case "label":
+			out.Label = string(in.String())
+		case "result":
+			out.Result = int32(in.Int32())
+		case "category":
+			out.Category = string(in.String())
+		case "subLabel":
+			out.SubLabel = string(in.String())
+		case "score":
+			out.Score = int32(in.Int32())
+		case "mode":
+			out.Mode = string(in.String())
+		case "version":
+			out.Version = int32(in.Int32())
+		case "key":
+			out.Key = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent2(out *jwriter.Writer, in ImageReview) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int32(int32(in.Id))
+	}
+	{
+		const prefix string = ",\"creationId\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.CreationId))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uid))
+	}
+	{
+		const prefix string = ",\"createAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreateAt))
+	}
+	{
+		const prefix string = ",\"uuid\":"
+		out.RawString(prefix)
+		out.String(string(in.Uuid))
+	}
+	{
+		const prefix string = ",\"jobId\":"
+		out.RawString(prefix)
+		out.String(string(in.JobId))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.Url))
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Result))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"subLabel\":"
+		out.RawString(prefix)
+		out.String(string(in.SubLabel))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Score))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Version))
+	}
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageReview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComTheZionMatrixCorePkgEvent2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageReview) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComTheZionMatrixCorePkgEvent2(l, v)
+}
@@ -0,0 +1,94 @@
+package event
+
+import (
+	"context"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/go-redis/redis/v8"
+)
+
+// The transports selectable through the transport field of a service's data
+// config. An empty value means TransportRocketMq.
+const (
+	TransportRocketMq = "rocketmq"
+	TransportRedis    = "redis"
+)
+
+// The fields of a redis stream entry.
+const (
+	StreamBody     = "body"
+	StreamKey      = "key"
+	StreamOrderKey = "order"
+)
+
+// Transport carries encoded events to the consumers of a topic. Events with
+// the same non-empty orderKey must reach the consumers in publish order.
+type Transport interface {
+	Publish(ctx context.Context, topic string, body []byte, key, orderKey string) error
+	Close() error
+}
+
+type rocketMqTransport struct {
+	producer rocketmq.Producer
+}
+
+// NewRocketMqTransport publishes through a started rocketmq producer, which
+// should select queues with producer.NewHashQueueSelector so that orderKey
+// pins the events of an entity to one queue.
+func NewRocketMqTransport(p rocketmq.Producer) Transport {
+	return &rocketMqTransport{
+		producer: p,
+	}
+}
+
+func (t *rocketMqTransport) Publish(ctx context.Context, topic string, body []byte, key, orderKey string) error {
+	msg := &primitive.Message{
+		Topic: topic,
+		Body:  body,
+	}
+	msg.WithKeys([]string{key})
+	if orderKey != "" {
+		msg.WithShardingKey(orderKey)
+	}
+	_, err := t.producer.SendSync(ctx, msg)
+	return err
+}
+
+func (t *rocketMqTransport) Close() error {
+	return t.producer.Shutdown()
+}
+
+type redisStreamTransport struct {
+	client redis.UniversalClient
+	maxLen int64
+}
+
+// NewRedisStreamTransport appends events to the redis stream named after the
+// topic. The stream is trimmed to about maxLen entries, or kept whole if
+// maxLen is not positive.
+func NewRedisStreamTransport(client redis.UniversalClient, maxLen int64) Transport {
+	return &redisStreamTransport{
+		client: client,
+		maxLen: maxLen,
+	}
+}
+
+func (t *redisStreamTransport) Publish(ctx context.Context, topic string, body []byte, key, orderKey string) error {
+	values := []interface{}{StreamBody, body, StreamKey, key}
+	if orderKey != "" {
+		values = append(values, StreamOrderKey, orderKey)
+	}
+	args := &redis.XAddArgs{
+		Stream: topic,
+		Values: values,
+	}
+	if t.maxLen > 0 {
+		args.MaxLen = t.maxLen
+		args.Approx = true
+	}
+	return t.client.XAdd(ctx, args).Err()
+}
+
+func (t *redisStreamTransport) Close() error {
+	return t.client.Close()
+}
@@ -0,0 +1,98 @@
+package event
+
+import (
+	"fmt"
+)
+
+const (
+	ModeSetFollow         = "set_follow_db_and_cache"
+	ModeCancelFollow      = "cancel_follow_db_and_cache"
+	ModeFollowRequest     = "follow_request"
+	ModeFollowApprove     = "follow_approve"
+	ModeUserProfileUpdate = "user_profile_update"
+	ModeAccountDeleted    = "account_deleted"
+	ModeExportUserData    = "export_user_data"
+)
+
+func init() {
+	register(1, func() Event { return &Follow{} }, ModeSetFollow, ModeCancelFollow, ModeFollowRequest, ModeFollowApprove)
+	register(1, func() Event { return &Profile{} }, ModeUserProfileUpdate)
+	register(1, func() Event { return &Account{} }, ModeAccountDeleted, ModeExportUserData)
+}
+
+// Follow persists a follow of UserId by Uuid, or notifies Uuid, a private
+// user, that UserId asked to follow it and UserId that Uuid approved.
+//
+//easyjson:json
+type Follow struct {
+	Header
+	Uuid   string
+	UserId string
+}
+
+func (e *Follow) Validate() error {
+	return first(present("uuid", e.Uuid), present("userId", e.UserId))
+}
+
+func (e *Follow) orderKey() string {
+	return "user:" + e.Uuid
+}
+
+// Profile is an edited profile waiting to be uploaded for review.
+//
+//easyjson:json
+type Profile struct {
+	Header
+	Created   string
+	Updated   string
+	Uuid      string
+	Username  string
+	Avatar    string
+	School    string
+	Company   string
+	Job       string
+	Homepage  string
+	Github    string
+	Gitee     string
+	Introduce string
+	Status    int32
+}
+
+func (e *Profile) Validate() error {
+	return first(present("uuid", e.Uuid), present("updated", e.Updated))
+}
+
+func (e *Profile) orderKey() string {
+	return "user:" + e.Uuid
+}
+
+// Account asks every service to purge what it holds about a deleted Uuid, or
+// to hand it over for the export archive named ExportId.
+//
+//easyjson:json
+type Account struct {
+	Header
+	Uuid     string
+	ExportId string
+}
+
+func (e *Account) Validate() error {
+	err := present("uuid", e.Uuid)
+	if err != nil {
+		return err
+	}
+	if e.Mode == ModeExportUserData {
+		return present("exportId", e.ExportId)
+	}
+	return nil
+}
+
+func (e *Account) orderKey() string {
+	return "user:" + e.Uuid
+}
+
+// PurgeKey is the idempotency key of an event a service publishes while it
+// purges a deleted account, so that purging again doesn't repeat the event.
+func PurgeKey(mode string, id int32) string {
+	return fmt.Sprintf("purge:%s:%d", mode, id)
+}
@@ -0,0 +1,138 @@
+// Package idempotent carries the idempotency key of an event through the calls
+// it causes, so that a redelivered event repeats none of the side effects its
+// first delivery committed. Every call and transaction derives its own child
+// key from the key of its caller, the way event.PurgeKey names the events of
+// a purge, and a transaction records its key along with its writes.
+package idempotent
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sync"
+	"time"
+)
+
+// Header is the request header a key is passed to another service in.
+const Header = "x-idempotency-key"
+
+type scopeKey struct{}
+
+// scope is the key of a call together with how many children of each name
+// it has derived so far.
+type scope struct {
+	key string
+	mu  sync.Mutex
+	seq map[string]int
+}
+
+// NewContext returns ctx scoped to key, whose children are derived afresh.
+func NewContext(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{key: key, seq: map[string]int{}})
+}
+
+// FromContext returns the key ctx is scoped to.
+func FromContext(ctx context.Context) (string, bool) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return "", false
+	}
+	return s.key, true
+}
+
+// Child derives the key of the next call named name made within the scope of
+// ctx, or returns "" if ctx has no key. The n-th call of a name gets the same
+// key on every delivery as long as calls of that name are made in order.
+func Child(ctx context.Context, name string) string {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return ""
+	}
+	s.mu.Lock()
+	n := s.seq[name]
+	s.seq[name]++
+	s.mu.Unlock()
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s/%s#%d", s.key, name, n)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Client passes a child key of the caller on to every request. The child is
+// named after the operation and the request, so that concurrent calls of one
+// operation keep their keys apart.
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				if key := Child(ctx, callName(tr.Operation(), req)); key != "" {
+					tr.RequestHeader().Set(Header, key)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Server scopes a request to the key it was passed.
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if key := tr.RequestHeader().Get(Header); key != "" {
+					ctx = NewContext(ctx, key)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func callName(operation string, req interface{}) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return operation
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return operation
+	}
+	sum := sha1.Sum(body)
+	return operation + "?" + hex.EncodeToString(sum[:])
+}
+
+// Processed is a key whose transaction has committed.
+type Processed struct {
+	ID             uint      `gorm:"primarykey"`
+	IdempotencyKey string    `gorm:"uniqueIndex;size:100"`
+	CreatedAt      time.Time `gorm:"index"`
+}
+
+func (Processed) TableName() string {
+	return "processed_key"
+}
+
+// Claim records the next child key named name of ctx through db, which should
+// be the transaction the key guards. It returns false if the key has been
+// claimed by a committed transaction before, and otherwise ctx scoped to the
+// claimed key. A concurrent claim of the same key waits until the first one
+// commits or rolls back. Without a key in ctx nothing is claimed.
+func Claim(ctx context.Context, db *gorm.DB, name string) (context.Context, bool, error) {
+	key := Child(ctx, name)
+	if key == "" {
+		return ctx, true, nil
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&Processed{IdempotencyKey: key})
+	if result.Error != nil {
+		return ctx, false, errors.Wrapf(result.Error, "fail to claim idempotency key: key(%s)", key)
+	}
+	if result.RowsAffected == 0 {
+		return ctx, false, nil
+	}
+	return NewContext(ctx, key), true, nil
+}
@@ -0,0 +1,94 @@
+package idempotent
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// Retention is how long a processed key is kept. An event redelivered later
+// than that is processed again.
+const Retention = 7 * 24 * time.Hour
+
+const (
+	sweepInterval = time.Hour
+	sweepBatch    = 1000
+)
+
+// Sweeper deletes the keys older than Retention from tables, which hold
+// processed keys by their created_at, in batches small enough not to hold up
+// the claims made meanwhile.
+type Sweeper struct {
+	db      *gorm.DB
+	tables  []string
+	done    chan struct{}
+	stopped chan struct{}
+	log     *log.Helper
+}
+
+func NewSweeper(db *gorm.DB, logger log.Logger, tables ...string) *Sweeper {
+	return &Sweeper{
+		db:      db,
+		tables:  tables,
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		log:     log.NewHelper(log.With(logger, "module", "idempotent/sweeper")),
+	}
+}
+
+func (s *Sweeper) Start() {
+	go s.run()
+}
+
+// Stop waits for a sweep in progress to finish.
+func (s *Sweeper) Stop() {
+	close(s.done)
+	<-s.stopped
+}
+
+func (s *Sweeper) run() {
+	defer close(s.stopped)
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		for _, table := range s.tables {
+			err := s.sweep(context.Background(), table, time.Now().Add(-Retention))
+			if err != nil {
+				s.log.Errorf("fail to sweep processed keys: %s", err.Error())
+			}
+		}
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sweeper) sweep(ctx context.Context, table string, before time.Time) error {
+	for {
+		select {
+		case <-s.done:
+			return nil
+		default:
+		}
+		var ids []uint
+		err := s.db.WithContext(ctx).Table(table).Where("created_at < ?", before).Order("id").Limit(sweepBatch).Pluck("id", &ids).Error
+		if err != nil {
+			return errors.Wrapf(err, "fail to get processed keys: table(%s)", table)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		result := s.db.WithContext(ctx).Exec(fmt.Sprintf("DELETE FROM `%s` WHERE id IN ?", table), ids)
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "fail to delete processed keys: table(%s)", table)
+		}
+		if result.RowsAffected < sweepBatch {
+			return nil
+		}
+	}
+}
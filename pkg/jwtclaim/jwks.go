@@ -0,0 +1,84 @@
+package jwtclaim
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+// Jwk is a public key as published in a JSON Web Key Set, limited to the key
+// types the user service signs with: RSA and Ed25519 (OKP).
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type Jwks struct {
+	Keys []Jwk `json:"keys"`
+}
+
+func NewJwk(kid string, pub crypto.PublicKey) (Jwk, error) {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return Jwk{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return Jwk{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: "EdDSA",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+		}, nil
+	}
+	return Jwk{}, errors.Errorf("unsupported key type: kid(%s), type(%T)", kid, pub)
+}
+
+// PublicKey decodes k into a key usable by the jwt verifiers.
+func (k Jwk) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fail to decode rsa modulus: kid(%s)", k.Kid)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fail to decode rsa exponent: kid(%s)", k.Kid)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, errors.Errorf("invalid rsa key: kid(%s)", k.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, errors.Errorf("unsupported curve: kid(%s), crv(%s)", k.Kid, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fail to decode ed25519 key: kid(%s)", k.Kid)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.Errorf("invalid ed25519 key: kid(%s)", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, errors.Errorf("unsupported key type: kid(%s), kty(%s)", k.Kid, k.Kty)
+}
@@ -2,7 +2,14 @@ package jwtclaim
 
 import "github.com/golang-jwt/jwt/v4"
 
+// Issuer is stamped on every access token signed by the user service.
+const Issuer = "matrix"
+
+// JwtCustomClaims are the claims of a matrix access token. Sid is the session
+// the token was issued for and Role the role of the user when it was.
 type JwtCustomClaims struct {
 	Uuid string `json:"uuid"`
-	jwt.StandardClaims
+	Sid  string `json:"sid,omitempty"`
+	Role string `json:"role,omitempty"`
+	jwt.RegisteredClaims
 }
@@ -0,0 +1,120 @@
+package jwtclaim
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+)
+
+// SigningKey is a private key of the user service and the kid it is published
+// under.
+type SigningKey struct {
+	Kid    string
+	Method jwt.SigningMethod
+	key    crypto.Signer
+}
+
+// ParseSigningKey reads a PEM encoded RSA (PKCS#1 or PKCS#8) or Ed25519
+// (PKCS#8) private key. RSA keys sign with RS256, Ed25519 keys with EdDSA.
+func ParseSigningKey(kid string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("fail to decode pem: kid(%s)", kid)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to parse private key: kid(%s)", kid)
+	}
+	return newSigningKey(kid, key)
+}
+
+// GenerateSigningKey creates an Ed25519 key under a random kid.
+func GenerateSigningKey() (*SigningKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to generate signing key")
+	}
+	return newSigningKey(xid.New().String(), key)
+}
+
+func newSigningKey(kid string, key interface{}) (*SigningKey, error) {
+	if kid == "" {
+		return nil, errors.New("signing key without kid")
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, errors.Errorf("rsa key shorter than 2048 bits: kid(%s)", kid)
+		}
+		return &SigningKey{Kid: kid, Method: jwt.SigningMethodRS256, key: k}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{Kid: kid, Method: jwt.SigningMethodEdDSA, key: k}, nil
+	}
+	return nil, errors.Errorf("unsupported private key type: kid(%s), type(%T)", kid, key)
+}
+
+// Signer signs tokens with its active key and publishes the public part of
+// every key it holds. Rotating a key is done in two deployments: add the new
+// key while the old one stays active, so that verifiers learn it, then make
+// it active and drop the old key once the last token signed by it expired and
+// the uploads carrying such tokens were reviewed, see VerifyIgnoringExpiry.
+type Signer struct {
+	active *SigningKey
+	keys   []*SigningKey
+}
+
+func NewSigner(keys []*SigningKey, activeKid string) (*Signer, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no signing key")
+	}
+	s := &Signer{keys: keys}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key.Kid] {
+			return nil, errors.Errorf("duplicate signing key: kid(%s)", key.Kid)
+		}
+		seen[key.Kid] = true
+		if key.Kid == activeKid {
+			s.active = key
+		}
+	}
+	if activeKid == "" {
+		s.active = keys[0]
+	}
+	if s.active == nil {
+		return nil, errors.Errorf("active signing key not found: kid(%s)", activeKid)
+	}
+	return s, nil
+}
+
+func (s *Signer) Sign(claims *JwtCustomClaims) (string, error) {
+	token := jwt.NewWithClaims(s.active.Method, claims)
+	token.Header["kid"] = s.active.Kid
+	signed, err := token.SignedString(s.active.key)
+	if err != nil {
+		return "", errors.Wrapf(err, "fail to sign token: kid(%s)", s.active.Kid)
+	}
+	return signed, nil
+}
+
+func (s *Signer) Jwks() (*Jwks, error) {
+	set := &Jwks{Keys: make([]Jwk, 0, len(s.keys))}
+	for _, key := range s.keys {
+		jwk, err := NewJwk(key.Kid, key.key.Public())
+		if err != nil {
+			return nil, err
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
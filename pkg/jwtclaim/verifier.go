@@ -0,0 +1,144 @@
+package jwtclaim
+
+import (
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJwksTtl = 10 * time.Minute
+	jwksMinRefresh = 10 * time.Second
+	jwksTimeout    = 5 * time.Second
+	jwksMaxSize    = 1 << 20
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// Verifier checks access tokens against the JWKS published by the user
+// service. The key set is cached for ttl and fetched again early when a token
+// names a kid it does not know, which is how a freshly rotated key is picked
+// up; such early fetches happen at most once every jwksMinRefresh. When the
+// endpoint is unreachable the cached keys keep being used.
+type Verifier struct {
+	url     string
+	ttl     time.Duration
+	client  *http.Client
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	fetched time.Time
+	tried   time.Time
+}
+
+func NewVerifier(url string, ttl time.Duration) *Verifier {
+	if ttl <= 0 {
+		ttl = defaultJwksTtl
+	}
+	return &Verifier{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksTimeout},
+		keys:   map[string]crypto.PublicKey{},
+	}
+}
+
+// Verify parses token and checks its signature, expiry and issuer. Errors
+// from the token itself are *jwt.ValidationError.
+func (v *Verifier) Verify(token string) (*JwtCustomClaims, error) {
+	return v.verify(token, true)
+}
+
+// VerifyIgnoringExpiry is Verify without the time checks. It is for tokens
+// handed back long after they were issued, like the one attached to an upload
+// and read again by the review callback, and must not authenticate a request.
+func (v *Verifier) VerifyIgnoringExpiry(token string) (*JwtCustomClaims, error) {
+	return v.verify(token, false)
+}
+
+func (v *Verifier) verify(token string, expiry bool) (*JwtCustomClaims, error) {
+	options := []jwt.ParserOption{jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()})}
+	if !expiry {
+		options = append(options, jwt.WithoutClaimsValidation())
+	}
+	claims := &JwtCustomClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, v.keyFunc, options...)
+	if err != nil {
+		return nil, err
+	}
+	if expiry && !claims.VerifyExpiresAt(time.Now(), true) {
+		return nil, jwt.NewValidationError("token has no expiry", jwt.ValidationErrorExpired)
+	}
+	if !claims.VerifyIssuer(Issuer, true) {
+		return nil, jwt.NewValidationError("token has invalid issuer", jwt.ValidationErrorIssuer)
+	}
+	if claims.Uuid == "" {
+		return nil, jwt.NewValidationError("token has no uuid", jwt.ValidationErrorClaimsInvalid)
+	}
+	return claims, nil
+}
+
+func (v *Verifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("token has no kid")
+	}
+	return v.key(kid)
+}
+
+func (v *Verifier) key(kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key, ok := v.keys[kid]
+	now := time.Now()
+	if (!ok || now.Sub(v.fetched) > v.ttl) && now.Sub(v.tried) >= jwksMinRefresh {
+		v.tried = now
+		keys, err := v.fetch()
+		if err != nil && !ok {
+			return nil, err
+		}
+		if err == nil {
+			v.keys, v.fetched = keys, now
+			key, ok = keys[kid]
+		}
+	}
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownKey, "kid(%s)", kid)
+	}
+	return key, nil
+}
+
+// fetch downloads the key set. Keys of a type the verifier does not know are
+// skipped so that the user service can publish them ahead of an upgrade.
+func (v *Verifier) fetch() (map[string]crypto.PublicKey, error) {
+	resp, err := v.client.Get(v.url)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to fetch jwks: url(%s)", v.url))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("fail to fetch jwks: url(%s), status(%d)", v.url, resp.StatusCode)
+	}
+
+	set := &Jwks{}
+	err = json.NewDecoder(io.LimitReader(resp.Body, jwksMaxSize)).Decode(set)
+	if err != nil {
+		return nil, errors.Wrapf(err, fmt.Sprintf("fail to decode jwks: url(%s)", v.url))
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
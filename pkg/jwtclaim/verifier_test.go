@@ -0,0 +1,270 @@
+package jwtclaim
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// jwksServer publishes the public keys of the signing keys it was last given.
+type jwksServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	keys    []*SigningKey
+	fetches int
+}
+
+func newJwksServer(t *testing.T, keys ...*SigningKey) *jwksServer {
+	s := &jwksServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.fetches++
+		signer, err := NewSigner(s.keys, "")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		jwks, err := signer.Jwks()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) publish(keys ...*SigningKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) fetched() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches
+}
+
+func newRsaKey(t *testing.T, kid string) (*SigningKey, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingKey, err := newSigningKey(kid, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingKey, key
+}
+
+func newEd25519Key(t *testing.T, kid string) (*SigningKey, ed25519.PrivateKey) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingKey, err := newSigningKey(kid, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingKey, key
+}
+
+func testClaims(expiresAt time.Time) *JwtCustomClaims {
+	return &JwtCustomClaims{
+		Uuid: "someone",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+}
+
+func sign(t *testing.T, key *SigningKey, claims *JwtCustomClaims) string {
+	signer, err := NewSigner([]*SigningKey{key}, key.Kid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := signer.Sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// signAs signs claims with method and key under kid, bypassing the checks of
+// Signer, the way a forged token would be.
+func signAs(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims *JwtCustomClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, rsaPrivate := newRsaKey(t, "rsa")
+	edKey, edPrivate := newEd25519Key(t, "ed")
+	forgedKey, _ := newEd25519Key(t, "ed")
+	strangerKey, _ := newEd25519Key(t, "stranger")
+	server := newJwksServer(t, rsaKey, edKey)
+
+	rsaPublic, err := x509.MarshalPKIXPublicKey(rsaPrivate.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPublicPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaPublic})
+	valid := time.Now().Add(time.Hour)
+	expired := time.Now().Add(-time.Hour)
+	otherIssuer := testClaims(valid)
+	otherIssuer.Issuer = "someone else"
+
+	tests := []struct {
+		name  string
+		token string
+		// errors is the set of jwt.ValidationError flags expected, none for a
+		// valid token.
+		errors         uint32
+		ignoringExpiry bool
+	}{
+		{
+			name:  "rs256",
+			token: sign(t, rsaKey, testClaims(valid)),
+		},
+		{
+			name:  "eddsa",
+			token: sign(t, edKey, testClaims(valid)),
+		},
+		{
+			name:           "expired",
+			token:          sign(t, edKey, testClaims(expired)),
+			errors:         jwt.ValidationErrorExpired,
+			ignoringExpiry: true,
+		},
+		{
+			name:   "other issuer",
+			token:  sign(t, edKey, otherIssuer),
+			errors: jwt.ValidationErrorIssuer,
+		},
+		{
+			name:   "bad signature",
+			token:  sign(t, forgedKey, testClaims(valid)),
+			errors: jwt.ValidationErrorSignatureInvalid,
+		},
+		{
+			name:   "unknown kid",
+			token:  sign(t, strangerKey, testClaims(valid)),
+			errors: jwt.ValidationErrorUnverifiable,
+		},
+		{
+			name:   "alg none",
+			token:  signAs(t, jwt.SigningMethodNone, "ed", jwt.UnsafeAllowNoneSignatureType, testClaims(valid)),
+			errors: jwt.ValidationErrorSignatureInvalid,
+		},
+		{
+			name:   "hs256 with the rsa public key",
+			token:  signAs(t, jwt.SigningMethodHS256, "rsa", rsaPublicPem, testClaims(valid)),
+			errors: jwt.ValidationErrorSignatureInvalid,
+		},
+		{
+			name:   "hs256 with the ed25519 public key",
+			token:  signAs(t, jwt.SigningMethodHS256, "ed", []byte(edPrivate.Public().(ed25519.PublicKey)), testClaims(valid)),
+			errors: jwt.ValidationErrorSignatureInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVerifier(server.URL, time.Minute)
+			claims, err := v.Verify(tt.token)
+			if tt.errors == 0 {
+				if err != nil {
+					t.Fatalf("got error %v, want none", err)
+				}
+				if claims.Uuid != "someone" {
+					t.Fatalf("got uuid(%s), want someone", claims.Uuid)
+				}
+				return
+			}
+			ve, ok := err.(*jwt.ValidationError)
+			if !ok || ve.Errors&tt.errors == 0 {
+				t.Fatalf("got error %v, want validation error(%b)", err, tt.errors)
+			}
+
+			_, err = v.VerifyIgnoringExpiry(tt.token)
+			if tt.ignoringExpiry && err != nil {
+				t.Fatalf("got error %v ignoring expiry, want none", err)
+			}
+			if !tt.ignoringExpiry && err == nil {
+				t.Fatal("got no error ignoring expiry")
+			}
+		})
+	}
+}
+
+// TestVerifierRotation follows a key rotation: the new key is picked up as
+// soon as a token names it, at most once every jwksMinRefresh, and a dropped
+// key is forgotten once the cached set expires.
+func TestVerifierRotation(t *testing.T) {
+	oldKey, _ := newEd25519Key(t, "old")
+	newKey, _ := newRsaKey(t, "new")
+	server := newJwksServer(t, oldKey)
+	v := NewVerifier(server.URL, time.Minute)
+	oldToken := sign(t, oldKey, testClaims(time.Now().Add(time.Hour)))
+	newToken := sign(t, newKey, testClaims(time.Now().Add(time.Hour)))
+
+	if _, err := v.Verify(oldToken); err != nil {
+		t.Fatal(err)
+	}
+
+	server.publish(oldKey, newKey)
+	if _, err := v.Verify(newToken); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("got error %v within jwksMinRefresh of the last fetch, want unknown key", err)
+	}
+	if n := server.fetched(); n != 1 {
+		t.Fatalf("fetched the key set %v times, want once", n)
+	}
+
+	v.tried = v.tried.Add(-jwksMinRefresh)
+	if _, err := v.Verify(newToken); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Verify(oldToken); err != nil {
+		t.Fatal(err)
+	}
+	if n := server.fetched(); n != 2 {
+		t.Fatalf("fetched the key set %v times, want twice", n)
+	}
+
+	server.publish(newKey)
+	v.tried = v.tried.Add(-jwksMinRefresh)
+	if _, err := v.Verify(oldToken); err != nil {
+		t.Fatalf("got error %v before the cached key set expired, want none", err)
+	}
+	v.fetched = v.fetched.Add(-time.Minute - time.Second)
+	if _, err := v.Verify(oldToken); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("got error %v after the cached key set expired, want unknown key", err)
+	}
+
+	// The cached keys outlive an unreachable endpoint.
+	server.Close()
+	v.fetched = v.fetched.Add(-time.Minute - time.Second)
+	v.tried = v.tried.Add(-jwksMinRefresh)
+	if _, err := v.Verify(newToken); err != nil {
+		t.Fatalf("got error %v with the endpoint down, want the cached key used", err)
+	}
+}
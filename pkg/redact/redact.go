@@ -0,0 +1,140 @@
+// Package redact keeps secrets out of the logs: the values logged under known
+// keys, and the request fields marked (redact.sensitive) in their proto.
+package redact
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sync"
+)
+
+const mask = "***"
+
+// Keys are the log keys whose values are secrets.
+var Keys = []string{"password", "oldpassword", "code", "token", "refreshToken", "secret", "recoveryCodes"}
+
+// Logger masks the values logged under Keys.
+func Logger(logger log.Logger) log.Logger {
+	return log.NewFilter(logger, log.FilterKey(Keys...))
+}
+
+// Server is logging.Server with the fields of the request marked sensitive
+// masked in what is logged. The handler still gets the request as it came.
+func Server(logger log.Logger) middleware.Middleware {
+	logged := logging.Server(logger)
+	return func(handler middleware.Handler) middleware.Handler {
+		next := logged(func(ctx context.Context, req interface{}) (interface{}, error) {
+			if r, ok := req.(*request); ok {
+				req = r.m
+			}
+			return handler(ctx, req)
+		})
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if m, ok := req.(proto.Message); ok && hasSensitive(m.ProtoReflect().Descriptor()) {
+				return next(ctx, &request{m: m})
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// request is how a request with sensitive fields shows to the logging
+// middleware, which logs its String.
+type request struct {
+	m proto.Message
+}
+
+func (r *request) String() string {
+	return fmt.Sprint(Message(r.m))
+}
+
+// Message returns a copy of m with its sensitive fields masked, those of the
+// messages within included.
+func Message(m proto.Message) proto.Message {
+	c := proto.Clone(m)
+	scrub(c.ProtoReflect())
+	return c
+}
+
+func scrub(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case sensitive(fd):
+			maskField(m, fd)
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					scrub(v.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				for i := 0; i < v.List().Len(); i++ {
+					scrub(v.List().Get(i).Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			scrub(v.Message())
+		}
+		return true
+	})
+}
+
+// maskField masks strings where they are and drops values of other kinds.
+func maskField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	switch {
+	case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+		l := m.Mutable(fd).List()
+		for i := 0; i < l.Len(); i++ {
+			l.Set(i, protoreflect.ValueOfString(mask))
+		}
+	case !fd.IsList() && !fd.IsMap() && fd.Kind() == protoreflect.StringKind:
+		m.Set(fd, protoreflect.ValueOfString(mask))
+	default:
+		m.Clear(fd)
+	}
+}
+
+func sensitive(fd protoreflect.FieldDescriptor) bool {
+	is, _ := proto.GetExtension(fd.Options(), E_Sensitive).(bool)
+	return is
+}
+
+// found caches by message name whether a message holds sensitive fields.
+var found sync.Map
+
+func hasSensitive(md protoreflect.MessageDescriptor) bool {
+	if is, ok := found.Load(md.FullName()); ok {
+		return is.(bool)
+	}
+	is := walk(md, map[protoreflect.FullName]bool{})
+	found.Store(md.FullName(), is)
+	return is
+}
+
+func walk(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if sensitive(fd) {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Kind() == protoreflect.MessageKind && walk(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
@@ -12,9 +12,25 @@ func Server() middleware.Middleware {
 			if header, ok := transport.FromServerContext(ctx); ok {
 				ctx = context.WithValue(ctx, "uuid", header.RequestHeader().Get("uuid"))
 				ctx = context.WithValue(ctx, "realIp", header.RequestHeader().Get("realIp"))
+				ctx = context.WithValue(ctx, "userAgent", header.RequestHeader().Get("User-Agent"))
 			}
 			reply, err = handler(ctx, req)
 			return
 		}
 	}
 }
+
+// Client forwards the realIp of the incoming request to the called service,
+// where Server puts it back into the context.
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if header, ok := transport.FromClientContext(ctx); ok {
+				if ip, _ := ctx.Value("realIp").(string); ip != "" {
+					header.RequestHeader().Set("realIp", ip)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
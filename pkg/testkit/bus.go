@@ -0,0 +1,147 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"sync"
+)
+
+// maxReconsumeTimes matches the default of the rocketmq push consumer.
+const maxReconsumeTimes = 16
+
+// ConsumeFunc has the signature of a rocketmq push consumer subscription.
+type ConsumeFunc func(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error)
+
+// Bus is an in-process stand-in for the rocketmq broker. It implements
+// rocketmq.Producer, so it can back event.Producer, and delivers what was sent
+// to the subscribers of the topic when Drain is called. Messages the
+// subscriber asks to retry are redelivered with ReconsumeTimes bumped, and
+// messages still failing after maxReconsumeTimes are kept aside in Dead.
+type Bus struct {
+	mu    sync.Mutex
+	seq   int64
+	queue []*primitive.MessageExt
+	subs  map[string][]ConsumeFunc
+	dead  []*primitive.MessageExt
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs: map[string][]ConsumeFunc{},
+	}
+}
+
+func (b *Bus) Start() error {
+	return nil
+}
+
+func (b *Bus) Shutdown() error {
+	return nil
+}
+
+func (b *Bus) SendSync(_ context.Context, msgs ...*primitive.Message) (*primitive.SendResult, error) {
+	id := b.enqueue(msgs...)
+	return &primitive.SendResult{
+		Status: primitive.SendOK,
+		MsgID:  id,
+	}, nil
+}
+
+func (b *Bus) SendAsync(ctx context.Context, fn func(ctx context.Context, result *primitive.SendResult, err error), msgs ...*primitive.Message) error {
+	result, err := b.SendSync(ctx, msgs...)
+	fn(ctx, result, err)
+	return nil
+}
+
+func (b *Bus) SendOneWay(ctx context.Context, msgs ...*primitive.Message) error {
+	_, err := b.SendSync(ctx, msgs...)
+	return err
+}
+
+// Subscribe registers fn for the messages of topic, like a push consumer.
+func (b *Bus) Subscribe(topic string, fn ConsumeFunc) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[topic] = append(b.subs[topic], fn)
+}
+
+// Pending returns the number of messages waiting for delivery.
+func (b *Bus) Pending() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.queue)
+}
+
+// Dead returns the messages the subscribers gave up on.
+func (b *Bus) Dead() []*primitive.MessageExt {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*primitive.MessageExt(nil), b.dead...)
+}
+
+// Drain delivers messages, including the ones sent while delivering, until
+// the queue is empty. It returns the number of deliveries made.
+func (b *Bus) Drain(ctx context.Context) int {
+	var n int
+	for {
+		msg, ok := b.dequeue()
+		if !ok {
+			return n
+		}
+		n++
+		if b.deliver(ctx, msg) {
+			continue
+		}
+		b.mu.Lock()
+		if msg.ReconsumeTimes >= maxReconsumeTimes {
+			b.dead = append(b.dead, msg)
+		} else {
+			msg.ReconsumeTimes++
+			b.queue = append(b.queue, msg)
+		}
+		b.mu.Unlock()
+	}
+}
+
+func (b *Bus) deliver(ctx context.Context, msg *primitive.MessageExt) bool {
+	b.mu.Lock()
+	subs := b.subs[msg.Topic]
+	b.mu.Unlock()
+	for _, fn := range subs {
+		ctx := primitive.WithConcurrentlyCtx(ctx, &primitive.ConsumeConcurrentlyContext{})
+		result, err := fn(ctx, msg)
+		if err != nil || result != consumer.ConsumeSuccess {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Bus) enqueue(msgs ...*primitive.Message) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var id string
+	for _, m := range msgs {
+		b.seq++
+		id = fmt.Sprintf("testkit-%d", b.seq)
+		ext := &primitive.MessageExt{MsgId: id}
+		ext.Topic = m.Topic
+		ext.Body = append([]byte(nil), m.Body...)
+		ext.WithProperties(m.GetProperties())
+		b.queue = append(b.queue, ext)
+	}
+	return id
+}
+
+func (b *Bus) dequeue() (*primitive.MessageExt, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.queue) == 0 {
+		return nil, false
+	}
+	msg := b.queue[0]
+	b.queue = b.queue[1:]
+	return msg, true
+}
@@ -0,0 +1,207 @@
+package testkit
+
+import (
+	"crypto/md5"
+	"fmt"
+	"github.com/tencentyun/cos-go-sdk-v5"
+	"hash/crc64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CosServer is a cos bucket stand-in that keeps its objects as files under a
+// directory. It serves put, get, head, delete and copy of objects, and
+// accepts text auditing jobs without running them; the auditing callback is
+// left to the caller.
+type CosServer struct {
+	dir    string
+	server *httptest.Server
+	mu     sync.Mutex
+	jobs   [][]byte
+}
+
+// NewCosServer serves a bucket from dir, or from a temporary directory removed
+// on Close if dir is empty.
+func NewCosServer(dir string) (*CosServer, error) {
+	s := &CosServer{dir: dir}
+	if dir == "" {
+		tmp, err := ioutil.TempDir("", "testkit-cos")
+		if err != nil {
+			return nil, err
+		}
+		s.dir = tmp
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s, nil
+}
+
+// NewCos returns a client of a new temporary bucket and its cleanup.
+func NewCos() (*cos.Client, func(), error) {
+	s, err := NewCosServer("")
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.Client(), s.Close, nil
+}
+
+// Client returns a cos client whose bucket and CI endpoints are this server.
+func (s *CosServer) Client() *cos.Client {
+	u, _ := url.Parse(s.server.URL)
+	return cos.NewClient(&cos.BaseURL{BucketURL: u, CIURL: u}, s.server.Client())
+}
+
+// URL is the address of the bucket, for clients built from a config.
+func (s *CosServer) URL() string {
+	return s.server.URL
+}
+
+// Dir returns the directory the objects are stored in.
+func (s *CosServer) Dir() string {
+	return s.dir
+}
+
+// Jobs returns the bodies of the text auditing jobs submitted so far.
+func (s *CosServer) Jobs() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]byte(nil), s.jobs...)
+}
+
+func (s *CosServer) Close() {
+	s.server.Close()
+	_ = os.RemoveAll(s.dir)
+}
+
+func (s *CosServer) path(name string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(strings.TrimPrefix(name, "/")))
+}
+
+func (s *CosServer) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/text/auditing" && r.Method == http.MethodPost {
+		s.audit(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		if source := r.Header.Get("x-cos-copy-source"); source != "" {
+			s.copy(w, r, source)
+			return
+		}
+		s.put(w, r)
+	case http.MethodGet, http.MethodHead:
+		s.get(w, r)
+	case http.MethodDelete:
+		err := os.Remove(s.path(r.URL.Path))
+		if err != nil && !os.IsNotExist(err) {
+			writeCosError(w, http.StatusInternalServerError, "InternalError", err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeCosError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+func (s *CosServer) put(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeCosError(w, http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+	if err = s.write(r.URL.Path, body); err != nil {
+		writeCosError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	setCosChecksum(w, body)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *CosServer) get(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadFile(s.path(r.URL.Path))
+	if os.IsNotExist(err) {
+		writeCosError(w, http.StatusNotFound, "NoSuchKey", r.URL.Path)
+		return
+	}
+	if err != nil {
+		writeCosError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	setCosChecksum(w, body)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(body)
+	}
+}
+
+func (s *CosServer) copy(w http.ResponseWriter, r *http.Request, source string) {
+	parts := strings.SplitN(source, "/", 2)
+	if len(parts) < 2 {
+		writeCosError(w, http.StatusBadRequest, "InvalidArgument", source)
+		return
+	}
+	name, err := url.PathUnescape(strings.SplitN(parts[1], "?", 2)[0])
+	if err != nil {
+		writeCosError(w, http.StatusBadRequest, "InvalidArgument", source)
+		return
+	}
+	body, err := ioutil.ReadFile(s.path(name))
+	if os.IsNotExist(err) {
+		writeCosError(w, http.StatusNotFound, "NoSuchKey", name)
+		return
+	}
+	if err == nil {
+		err = s.write(r.URL.Path, body)
+	}
+	if err != nil {
+		writeCosError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = fmt.Fprintf(w, "<CopyObjectResult><ETag>\"%x\"</ETag></CopyObjectResult>", md5.Sum(body))
+}
+
+func (s *CosServer) audit(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeCosError(w, http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+	s.mu.Lock()
+	s.jobs = append(s.jobs, body)
+	id := len(s.jobs)
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = fmt.Fprintf(w, "<Response><JobsDetail><JobId>testkit-%d</JobId><State>Submitted</State></JobsDetail></Response>", id)
+}
+
+// write stores body under name, or makes a folder of a name ending in a slash.
+func (s *CosServer) write(name string, body []byte) error {
+	path := s.path(name)
+	if strings.HasSuffix(name, "/") {
+		return os.MkdirAll(path, 0755)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, body, 0644)
+}
+
+// setCosChecksum sets the headers the cos client verifies transfers with.
+func setCosChecksum(w http.ResponseWriter, body []byte) {
+	w.Header().Set("ETag", fmt.Sprintf("\"%x\"", md5.Sum(body)))
+	w.Header().Set("x-cos-hash-crc64ecma", strconv.FormatUint(crc64.Checksum(body, crc64.MakeTable(crc64.ECMA)), 10))
+}
+
+func writeCosError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
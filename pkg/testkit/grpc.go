@@ -0,0 +1,54 @@
+package testkit
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/the-zion/matrix-core/pkg/idempotent"
+	ggrpc "google.golang.org/grpc"
+	"net"
+	"time"
+)
+
+// callTimeout is long enough for a call that runs a whole transaction on sqlite.
+const callTimeout = 10 * time.Second
+
+// Serve starts srv, as built by a service's server.NewGRPCServer, on a free
+// local port and returns a connection to it and its cleanup. The connection
+// passes on idempotency keys like the clients of the services do.
+func Serve(srv *grpc.Server) (*ggrpc.ClientConn, func(), error) {
+	u, err := srv.Endpoint()
+	if err != nil {
+		return nil, nil, err
+	}
+	go func() {
+		_ = srv.Start(context.Background())
+	}()
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(u.Host),
+		grpc.WithTimeout(callTimeout),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			idempotent.Client(),
+		),
+	)
+	if err != nil {
+		_ = srv.Stop(context.Background())
+		return nil, nil, err
+	}
+	return conn, func() {
+		_ = conn.Close()
+		_ = srv.Stop(context.Background())
+	}, nil
+}
+
+// Unimplemented returns a connection to a server with no service registered,
+// for the clients a flow is not expected to call.
+func Unimplemented() (*ggrpc.ClientConn, func(), error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, nil, err
+	}
+	return Serve(grpc.NewServer(grpc.Listener(lis)))
+}
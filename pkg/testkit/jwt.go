@@ -0,0 +1,73 @@
+package testkit
+
+import (
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/the-zion/matrix-core/pkg/jwtclaim"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+// Issuer stands in for the user service as the issuer of access tokens. It
+// signs tokens with a key of its own and publishes that key at URL, where the
+// verifiers of the other services fetch it.
+type Issuer struct {
+	signer *jwtclaim.Signer
+	server *httptest.Server
+}
+
+func NewIssuer() (*Issuer, error) {
+	key, err := jwtclaim.GenerateSigningKey()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := jwtclaim.NewSigner([]*jwtclaim.SigningKey{key}, key.Kid)
+	if err != nil {
+		return nil, err
+	}
+	jwks, err := signer.Jwks()
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(jwks)
+	if err != nil {
+		return nil, err
+	}
+	return &Issuer{
+		signer: signer,
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		})),
+	}, nil
+}
+
+// URL is where the key set is published.
+func (i *Issuer) URL() string {
+	return i.server.URL
+}
+
+// Token signs an access token of uuid valid for an hour.
+func (i *Issuer) Token(uuid string) (string, error) {
+	return i.sign(uuid, time.Now().Add(time.Hour))
+}
+
+// ExpiredToken signs an access token of uuid that expired an hour ago.
+func (i *Issuer) ExpiredToken(uuid string) (string, error) {
+	return i.sign(uuid, time.Now().Add(-time.Hour))
+}
+
+func (i *Issuer) sign(uuid string, expiresAt time.Time) (string, error) {
+	return i.signer.Sign(&jwtclaim.JwtCustomClaims{
+		Uuid: uuid,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtclaim.Issuer,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+}
+
+func (i *Issuer) Close() {
+	i.server.Close()
+}
@@ -0,0 +1,70 @@
+package testkit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// NewsItem is an article of the news feed, in the fields the job reads.
+type NewsItem struct {
+	ArticleId        string
+	ArticleTitle     string
+	ArticleAuthor    string
+	ArticleSourceUrl string
+	CreateDateTime   string
+	Tags             []NewsTag
+}
+
+type NewsTag struct {
+	TagName string
+}
+
+// NewsServer is a news feed stand-in answering every request with the items
+// it was given, whatever page is asked for.
+type NewsServer struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	items    []NewsItem
+	requests int
+}
+
+func NewNewsServer(items ...NewsItem) *NewsServer {
+	s := &NewsServer{
+		items: items,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// URL is the address of the feed, which clients append the page to.
+func (s *NewsServer) URL() string {
+	return s.server.URL + "/"
+}
+
+// Requests returns how many times the feed was fetched.
+func (s *NewsServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *NewsServer) Close() {
+	s.server.Close()
+}
+
+func (s *NewsServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	items := make([]NewsItem, len(s.items))
+	copy(items, s.items)
+	s.mu.Unlock()
+	for i := range items {
+		if items[i].Tags == nil {
+			items[i].Tags = []NewsTag{}
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"Data": items})
+}
@@ -0,0 +1,182 @@
+package testkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/elastic/go-elasticsearch/v7"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SearchServer is an elasticsearch stand-in keeping documents in memory. It
+// serves index, get and delete of documents, and answers every search with
+// the documents of the index sorted by descending id and paged by from and
+// size; queries, filters and highlighting are not evaluated.
+type SearchServer struct {
+	server *httptest.Server
+	mu     sync.Mutex
+	docs   map[string]map[string]json.RawMessage
+}
+
+func NewSearchServer() *SearchServer {
+	s := &SearchServer{
+		docs: map[string]map[string]json.RawMessage{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// NewSearch returns a client of a new empty index server and its cleanup.
+func NewSearch() (*elasticsearch.Client, func(), error) {
+	s := NewSearchServer()
+	es, err := s.Client()
+	if err != nil {
+		s.Close()
+		return nil, nil, err
+	}
+	return es, s.Close, nil
+}
+
+func (s *SearchServer) Client() (*elasticsearch.Client, error) {
+	return elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{s.server.URL},
+	})
+}
+
+// Doc returns the source of the document id in index, or nil.
+func (s *SearchServer) Doc(index, id string) json.RawMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.docs[index][id]
+}
+
+// Count returns the number of documents in index.
+func (s *SearchServer) Count(index string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.docs[index])
+}
+
+func (s *SearchServer) Close() {
+	s.server.Close()
+}
+
+func (s *SearchServer) serve(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"version": map[string]interface{}{"number": "7.17.1"},
+			"tagline": "You Know, for Search",
+		})
+	case len(parts) == 2 && parts[1] == "_search":
+		s.search(w, r, parts[0])
+	case len(parts) == 3 && parts[1] == "_doc":
+		s.doc(w, r, parts[0], parts[2])
+	default:
+		writeJSON(w, http.StatusBadRequest, searchError("unsupported request: "+r.Method+" "+r.URL.Path))
+	}
+}
+
+func (s *SearchServer) doc(w http.ResponseWriter, r *http.Request, index, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut, http.MethodPost:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil || !json.Valid(body) {
+			writeJSON(w, http.StatusBadRequest, searchError("invalid document"))
+			return
+		}
+		if s.docs[index] == nil {
+			s.docs[index] = map[string]json.RawMessage{}
+		}
+		s.docs[index][id] = body
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_index": index, "_id": id, "result": "updated"})
+	case http.MethodGet:
+		source, ok := s.docs[index][id]
+		if !ok {
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{"_index": index, "_id": id, "found": false})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_index": index, "_id": id, "found": true, "_source": source})
+	case http.MethodDelete:
+		if _, ok := s.docs[index][id]; !ok {
+			writeJSON(w, http.StatusNotFound, map[string]interface{}{"_index": index, "_id": id, "result": "not_found"})
+			return
+		}
+		delete(s.docs[index], id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_index": index, "_id": id, "result": "deleted"})
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, searchError("unsupported method: "+r.Method))
+	}
+}
+
+func (s *SearchServer) search(w http.ResponseWriter, r *http.Request, index string) {
+	query := struct {
+		From int `json:"from"`
+		Size int `json:"size"`
+	}{Size: 10}
+	body, _ := ioutil.ReadAll(r.Body)
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &query); err != nil {
+			writeJSON(w, http.StatusBadRequest, searchError(err.Error()))
+			return
+		}
+	}
+
+	s.mu.Lock()
+	ids := make([]string, 0, len(s.docs[index]))
+	for id := range s.docs[index] {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, aerr := strconv.Atoi(ids[i])
+		b, berr := strconv.Atoi(ids[j])
+		if aerr == nil && berr == nil {
+			return a > b
+		}
+		return ids[i] > ids[j]
+	})
+	hits := make([]map[string]interface{}, 0, query.Size)
+	for i := query.From; i < len(ids) && i < query.From+query.Size; i++ {
+		hits = append(hits, map[string]interface{}{
+			"_index":    index,
+			"_id":       ids[i],
+			"_source":   s.docs[index][ids[i]],
+			"highlight": map[string]interface{}{},
+		})
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"hits": map[string]interface{}{
+			"total": map[string]interface{}{"value": len(ids), "relation": "eq"},
+			"hits":  hits,
+		},
+	})
+}
+
+func searchError(reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"error": map[string]interface{}{
+			"type":   "testkit_exception",
+			"reason": reason,
+		},
+		"status": http.StatusBadRequest,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		_, _ = fmt.Fprintf(w, "{\"error\":%q}", err.Error())
+	}
+}
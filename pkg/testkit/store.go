@@ -0,0 +1,126 @@
+package testkit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// driverName is sqlite with the functions of mysql that the services use
+// registered.
+const driverName = "sqlite3_testkit"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("greatest", greatest, true)
+		},
+	})
+}
+
+func greatest(values ...int64) int64 {
+	max := values[0]
+	for _, value := range values[1:] {
+		if value > max {
+			max = value
+		}
+	}
+	return max
+}
+
+// NewRedis starts a miniredis server and returns a client of it and its
+// cleanup. The client is a *redis.Client like the one of the services.
+func NewRedis() (redis.Cmdable, func(), error) {
+	s, err := miniredis.Run()
+	if err != nil {
+		return nil, nil, err
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr: s.Addr(),
+	})
+	return client, func() {
+		_ = client.Close()
+		s.Close()
+	}, nil
+}
+
+// LoadScripts loads scripts, the lua scripts a service runs by their sha1,
+// like its tool/lua does.
+func LoadScripts(r redis.Cmdable, scripts map[string]string) error {
+	for name, script := range scripts {
+		if err := r.ScriptLoad(context.Background(), script).Err(); err != nil {
+			return errors.Wrapf(err, fmt.Sprintf("fail to load script: name(%s)", name))
+		}
+	}
+	return nil
+}
+
+// NewDB opens a sqlite database in a temporary directory and returns it and
+// its cleanup. A file rather than an in-memory database is used so that the
+// outbox relay and the transactions of a request can hold connections at the
+// same time. The tables are left to the caller, see Migrate.
+func NewDB() (*gorm.DB, func(), error) {
+	dir, err := ioutil.TempDir("", "testkit-db")
+	if err != nil {
+		return nil, nil, err
+	}
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL", filepath.Join(dir, "matrix.db"))
+	db, err := gorm.Open(&sqlite.Dialector{DriverName: driverName, DSN: dsn}, &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+		Logger:                                   logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, nil, err
+	}
+	return db, func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+		_ = os.RemoveAll(dir)
+	}, nil
+}
+
+// Migrate creates the tables of models in db. Index names, which mysql scopes
+// to a table but sqlite to the database, are prefixed with their table.
+func Migrate(db *gorm.DB, models ...interface{}) error {
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		for _, field := range stmt.Schema.Fields {
+			field.Tag = scopeIndexes(stmt.Schema.Table, field.Tag)
+		}
+	}
+	return db.AutoMigrate(models...)
+}
+
+func scopeIndexes(table string, tag reflect.StructTag) reflect.StructTag {
+	value, ok := tag.Lookup("gorm")
+	if !ok {
+		return tag
+	}
+	settings := strings.Split(value, ";")
+	for i, setting := range settings {
+		kv := strings.SplitN(setting, ":", 2)
+		key := strings.ToUpper(strings.TrimSpace(kv[0]))
+		if (key != "INDEX" && key != "UNIQUEINDEX") || len(kv) < 2 || kv[1] == "" || kv[1][0] == ',' || strings.HasPrefix(kv[1], table+"_") {
+			continue
+		}
+		settings[i] = kv[0] + ":" + table + "_" + kv[1]
+	}
+	return reflect.StructTag(strings.Replace(string(tag), `gorm:"`+value+`"`, `gorm:"`+strings.Join(settings, ";")+`"`, 1))
+}
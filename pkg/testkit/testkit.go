@@ -0,0 +1,26 @@
+// Package testkit provides in-process stand-ins for the infrastructure of the
+// services: a message bus for rocketmq, miniredis, sqlite for mysql, a
+// directory-backed cos bucket, an in-memory search index, an issuer of access
+// tokens, a news feed and local grpc servers.
+//
+// ProviderSet replaces the ClientSet of a service's data package in a wire
+// injector. The Bus is left as an injector argument so that several services
+// built in one process share it, e.g.
+//
+//	wire.Build(testkit.ProviderSet, data.RepoSet, biz.ProviderSet, service.ProviderSet)
+//
+// The <service>test packages under app build whole services this way, with
+// their tables and lua scripts in place, for tests of a flow across services.
+package testkit
+
+import (
+	"github.com/google/wire"
+	"github.com/the-zion/matrix-core/pkg/event"
+)
+
+var ProviderSet = wire.NewSet(NewDB, NewRedis, NewCos, NewSearch, NewTransport)
+
+// NewTransport publishes to b as if it were a rocketmq broker.
+func NewTransport(b *Bus) event.Transport {
+	return event.NewRocketMqTransport(b)
+}